@@ -37,7 +37,7 @@ func deleteActionFunc(manager Manager, filters ...Filter) error {
 // then updates it.
 // Raise method determines if ttlOption needs to be applied based on clear flag.
 func clearActionFunc(manager Manager, filters ...Filter) error {
-	myAlerts, err := manager.Enumerate(filters...)
+	myAlerts, _, err := manager.EnumerateWithOptions([]Option{NewIncludeSilencedOption()}, filters...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,223 @@
+package alerts
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultAlertmanagerResendInterval is the time between the postings
+	// of the alerts still raised, which Alertmanager resolves otherwise
+	DefaultAlertmanagerResendInterval = time.Minute
+
+	// alertmanagerPath is the path of the alerts of the Alertmanager v2 API
+	alertmanagerPath = "/api/v2/alerts"
+)
+
+// AlertmanagerConfig configures a notifier posting the alerts to the v2 API
+// of a Prometheus Alertmanager, so that its routing and silences apply to
+// the storage alerts.
+type AlertmanagerConfig struct {
+	// Name identifies the notifier, its URL if unset
+	Name string `yaml:"name"`
+	// URL of Alertmanager, such as http://alertmanager:9093
+	URL string `yaml:"url"`
+	// Headers are added to the requests, e.g. an Authorization header
+	Headers map[string]string `yaml:"headers"`
+	// Retries is the number of times a failed posting is retried,
+	// DefaultWebhookRetries if unset, none if negative
+	Retries int `yaml:"retries"`
+	// Backoff is the wait before the first retry, doubled on every
+	// following one, DefaultWebhookBackoff if unset
+	Backoff time.Duration `yaml:"backoff"`
+	// Timeout bounds each posting, DefaultWebhookTimeout if unset
+	Timeout time.Duration `yaml:"timeout"`
+	// ResendInterval is the time between the postings of the alerts still
+	// raised, DefaultAlertmanagerResendInterval if unset
+	ResendInterval time.Duration `yaml:"resend_interval"`
+	// Labels are added to the labels of every alert, such as the cluster
+	Labels map[string]string `yaml:"labels"`
+	// GeneratorURL links the alerts back to openstorage
+	GeneratorURL string `yaml:"generator_url"`
+	// ResourceTypes forwards only the alerts of these resource types, all
+	// if empty
+	ResourceTypes []string `yaml:"resource_types"`
+	// AlertTypes forwards only the alerts of these alert types, all if empty
+	AlertTypes []int64 `yaml:"alert_types"`
+	// MinSeverity forwards only the alerts at least this severe, all if unset
+	MinSeverity string `yaml:"min_severity"`
+}
+
+// Filters returns the filters matching the alerts forwarded to Alertmanager,
+// none if all are.
+func (c *AlertmanagerConfig) Filters() ([]Filter, error) {
+	return selectFilters(c.ResourceTypes, c.AlertTypes, c.MinSeverity)
+}
+
+// postableAlert is an alert of the Alertmanager v2 API.
+type postableAlert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	StartsAt     string            `json:"startsAt,omitempty"`
+	EndsAt       string            `json:"endsAt,omitempty"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+type alertmanager struct {
+	*webhook
+	labels       map[string]string
+	generatorURL string
+	resend       time.Duration
+
+	lock sync.Mutex
+	// active are the alerts raised and not cleared, by ID
+	active map[string]*api.Alert
+	stop   chan struct{}
+}
+
+// NewAlertmanagerNotifier provides a notifier posting the alerts to the
+// Alertmanager of c. The alerts are labelled with their reason code as
+// alertname, their resource type, resource id, alert type and severity,
+// and annotated with their message and payload. The alerts raised are
+// posted again on every resend interval until cleared, then posted as
+// resolved. The filters of the notifier are given by c.Filters.
+func NewAlertmanagerNotifier(c *AlertmanagerConfig) (Notifier, error) {
+	if len(c.URL) == 0 {
+		return nil, invalidNotifier.Tag("missing url")
+	}
+	w, err := newWebhook(&WebhookConfig{
+		Name:    c.Name,
+		URL:     strings.TrimSuffix(c.URL, "/") + alertmanagerPath,
+		Headers: c.Headers,
+		Retries: c.Retries,
+		Backoff: c.Backoff,
+		Timeout: c.Timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Name) == 0 {
+		w.name = c.URL
+	}
+	a := &alertmanager{
+		webhook:      w,
+		labels:       c.Labels,
+		generatorURL: c.GeneratorURL,
+		resend:       c.ResendInterval,
+		active:       make(map[string]*api.Alert),
+		stop:         make(chan struct{}),
+	}
+	if a.resend == 0 {
+		a.resend = DefaultAlertmanagerResendInterval
+	}
+	go a.resendActive()
+	return a, nil
+}
+
+func (a *alertmanager) Notify(alert *api.Alert) error {
+	a.lock.Lock()
+	if alert.Cleared {
+		delete(a.active, ID(alert))
+	} else {
+		a.active[ID(alert)] = alert
+	}
+	a.lock.Unlock()
+	return a.post([]*api.Alert{alert})
+}
+
+// Close stops posting the alerts still raised.
+func (a *alertmanager) Close() error {
+	close(a.stop)
+	return nil
+}
+
+func (a *alertmanager) resendActive() {
+	ticker := time.NewTicker(a.resend)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-ticker.C:
+		}
+		a.lock.Lock()
+		active := make([]*api.Alert, 0, len(a.active))
+		for _, alert := range a.active {
+			active = append(active, alert)
+		}
+		a.lock.Unlock()
+		if len(active) == 0 {
+			continue
+		}
+		if err := a.post(active); err != nil {
+			logrus.WithField("pkg", "openstorage/alerts").
+				WithField("notifier", a.name).
+				Errorf("Failed to post %d alerts to Alertmanager: %v", len(active), err)
+		}
+	}
+}
+
+func (a *alertmanager) post(alerts []*api.Alert) error {
+	postings := make([]*postableAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		postings = append(postings, a.posting(alert))
+	}
+	body, err := json.Marshal(postings)
+	if err != nil {
+		return err
+	}
+	return a.deliver(body)
+}
+
+// posting converts alert to an Alertmanager alert. The raised alerts end
+// after a few resend intervals, so that Alertmanager resolves them if this
+// node stops posting them.
+func (a *alertmanager) posting(alert *api.Alert) *postableAlert {
+	p := &postableAlert{
+		Labels:       make(map[string]string, len(a.labels)+5),
+		GeneratorURL: a.generatorURL,
+	}
+	for k, v := range a.labels {
+		p.Labels[k] = v
+	}
+	p.Labels["alertname"] = alert.GetReasonCode()
+	if len(p.Labels["alertname"]) == 0 {
+		p.Labels["alertname"] = "OPENSTORAGE_ALERT_" + strconv.FormatInt(alert.GetAlertType(), 10)
+	}
+	p.Labels["resource_type"] = strings.ToLower(
+		strings.TrimPrefix(alert.GetResource().String(), "RESOURCE_TYPE_"))
+	p.Labels["resource_id"] = alert.GetResourceId()
+	p.Labels["alert_type"] = strconv.FormatInt(alert.GetAlertType(), 10)
+	p.Labels["severity"] = strings.ToLower(
+		strings.TrimPrefix(alert.GetSeverity().String(), "SEVERITY_TYPE_"))
+	if len(alert.GetMessage()) != 0 || len(alert.GetPayload()) != 0 {
+		p.Annotations = make(map[string]string, len(alert.GetPayload())+1)
+		for k, v := range alert.GetPayload() {
+			p.Annotations[k] = v
+		}
+		if len(alert.GetMessage()) != 0 {
+			p.Annotations["summary"] = alert.GetMessage()
+		}
+	}
+
+	starts := alert.GetFirstSeen()
+	if starts == nil {
+		starts = alert.GetTimestamp()
+	}
+	if t, err := ptypes.Timestamp(starts); err == nil {
+		p.StartsAt = t.UTC().Format(time.RFC3339Nano)
+	}
+	ends := time.Now().Add(4 * a.resend)
+	if alert.Cleared {
+		ends = time.Now()
+	}
+	p.EndsAt = ends.UTC().Format(time.RFC3339Nano)
+	return p
+}
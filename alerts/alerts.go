@@ -2,15 +2,19 @@
 package alerts
 
 import (
-	"encoding/json"
+	"context"
+	"io"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/libopenstorage/openstorage/api"
-	"github.com/portworx/kvdb"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 )
 
@@ -34,24 +38,90 @@ const (
 	invalidFilterType    Error = "invalid filter type"
 	invalidOptionType    Error = "invalid option type"
 	incorrectFilterValue Error = "incorrectly set filter value"
+	invalidToken         Error = "invalid continuation token"
 )
 
 const (
 	HalfDay  = Day / 2
 	Day      = 60 * 60 * 24
 	FiveDays = Day * 5
+
+	// DefaultGCInterval is the time between deletions of the expired alerts
+	DefaultGCInterval = 10 * time.Minute
 )
 
 // Manager manages alerts.
 type Manager interface {
 	// FilterDeleter allows read only operation on alerts
 	FilterDeleter
-	// Raise raises an alert.
-	Raise(alert *api.Alert) error
+	// Raise raises an alert. Options such as NewDedupeOption apply to this alert only.
+	// The alert is stamped with the time it is raised unless its timestamp is set, such as
+	// by NewTimestampOption.
+	// The alert carries the reason code registered for its alert type, see RegisterReasons.
+	// The payload of the alert is bounded by MaxPayloadKeys and MaxPayloadSize. With
+	// NewRateLimitOption, the raises beyond the rate are coalesced into a later write. With
+	// NewFlappingOption, the raises of a flapping alert are absorbed.
+	Raise(alert *api.Alert, options ...Option) error
+	// Ack acknowledges the alert identified by id, see ID. An alert raised again is
+	// no longer acknowledged.
+	Ack(id string) error
+	// Annotate attaches annotations to the alert identified by id, see ID, such as a ticket
+	// number or a triage owner, replacing those of the same keys. An empty value removes the
+	// annotation. The annotations are kept when the alert is raised again with
+	// NewDedupeOption, see NewTagFilter.
+	Annotate(id string, annotations map[string]string) error
+	// Clear clears the alert identified by id, see ID. With NewFlappingOption, the clears
+	// of a flapping alert are absorbed.
+	Clear(id string) error
 	// SetRules sets a set of rules to be performed on alert events.
 	SetRules(rules ...Rule)
 	// DeleteRules deletes rules
 	DeleteRules(rules ...Rule)
+	// AddNotifier registers a notifier to which the raised alerts matched by at least one
+	// filter, all alerts if none, are delivered in the background.
+	AddNotifier(n Notifier, filters ...Filter) error
+	// RemoveNotifier unregisters the notifier named name.
+	RemoveNotifier(name string)
+	// AddSilence stores a silence muting the matching alerts until it ends and returns its id.
+	// Silenced alerts are still stored when raised.
+	AddSilence(s *Silence) (string, error)
+	// DeleteSilence deletes the silence identified by id before it ends.
+	DeleteSilence(id string) error
+	// EnumerateSilences lists the silences which have not ended.
+	EnumerateSilences() ([]*Silence, error)
+	// AddMaintenanceWindow stores a maintenance window suppressing the notifications of
+	// the matching alerts while open, once or on a cron schedule, and returns its id. The
+	// alerts raised during the window are stored and enumerated.
+	AddMaintenanceWindow(w *MaintenanceWindow) (string, error)
+	// DeleteMaintenanceWindow deletes the maintenance window identified by id.
+	DeleteMaintenanceWindow(id string) error
+	// EnumerateMaintenanceWindows lists the maintenance windows which have not ended.
+	EnumerateMaintenanceWindows() ([]*MaintenanceWindow, error)
+	// AddEscalationRule stores a rule escalating the alerts of an alert type left
+	// unacknowledged for too long or raised too many times, replacing the rule of the same
+	// name. The rules are evaluated every escalation interval, see NewEscalationIntervalOption.
+	AddEscalationRule(r *EscalationRule) error
+	// DeleteEscalationRule deletes the escalation rule named name.
+	DeleteEscalationRule(name string) error
+	// EnumerateEscalationRules lists the escalation rules by name.
+	EnumerateEscalationRules() ([]*EscalationRule, error)
+	// Export writes the alerts matched by at least one filter, all alerts if none, silenced
+	// or not, to w as a JSON Snapshot, such as for a support bundle.
+	Export(w io.Writer, filters ...Filter) error
+	// Import stores the alerts of the JSON Snapshot read from r, replacing the stored alerts
+	// of the same keys, without notifying them. No alert is stored if one is invalid.
+	Import(r io.Reader) error
+	// SetRoutes replaces the routes selecting the notifiers of the alerts, the first
+	// route matching an alert wins.
+	SetRoutes(routes ...*Route) error
+	// Routes lists the routes in order.
+	Routes() []*Route
+	// PreviewRoute tells which route alert would take and which notifiers it would be
+	// delivered to, without raising it.
+	PreviewRoute(alert *api.Alert) (*RoutePreview, error)
+	// Collector returns the Prometheus collector of the metrics of the manager: the alerts
+	// raised and active, the store enumeration latency and the notifier failures.
+	Collector() prometheus.Collector
 }
 
 // FilterDeleter defines a list and delete interface on alerts.
@@ -59,15 +129,34 @@ type Manager interface {
 type FilterDeleter interface {
 	// Enumerate lists all alerts filtered by a variadic list of filters.
 	// It will fetch a superset such that every alert is matched by at least one filter.
+	// Alerts muted by a silence are skipped.
 	Enumerate(filters ...Filter) ([]*api.Alert, error)
+	// EnumerateWithOptions lists alerts like Enumerate, one page at a time. Options set the
+	// order of the alerts, by resource type, alert type and resource id by default, the
+	// maximum number of alerts of the page, the continuation token returned with the
+	// previous page and whether the silenced alerts are included. The returned token is
+	// empty once the last page is reached.
+	EnumerateWithOptions(options []Option, filters ...Filter) ([]*api.Alert, string, error)
+	// Watch delivers the changes of the alerts matched by at least one filter, all alerts if
+	// none, on the returned channel as they are raised, raised again or deleted. Calling the
+	// returned function stops the watch and closes the channel.
+	Watch(filters ...Filter) (<-chan *WatchEvent, func(), error)
 	// Filter filters given list of alerts successively through each filter.
 	Filter(alerts []*api.Alert, filters ...Filter) ([]*api.Alert, error)
-	// Delete deletes alerts filtered by a chain of filters.
+	// Delete deletes the alerts matched by at least one filter, all alerts if none. The kvdb
+	// sub trees of the efficient filters given without options are deleted at once.
 	Delete(filters ...Filter) error
 }
 
-func newManager(kv kvdb.Kvdb, options ...Option) (*manager, error) {
-	m := &manager{kv: kv, rules: make(map[string]Rule), ttl: HalfDay}
+func newManager(store Store, options ...Option) (*manager, error) {
+	m := &manager{
+		store:     store,
+		rules:     make(map[string]Rule),
+		notifiers: make(map[string]*subscription),
+		ttl:       HalfDay,
+	}
+	m.metrics = newMetrics(m)
+	flaps := make(map[int64]flapping)
 	for _, option := range options {
 		switch option.GetType() {
 		case ttlOption:
@@ -76,27 +165,192 @@ func newManager(kv kvdb.Kvdb, options ...Option) (*manager, error) {
 				return nil, typeAssertionError
 			}
 			m.ttl = v
+		case gcIntervalOption:
+			v, ok := option.GetValue().(time.Duration)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			if v == 0 {
+				v = DefaultGCInterval
+			}
+			m.gcInterval = v
+		case escalationIntervalOption:
+			v, ok := option.GetValue().(time.Duration)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			if v == 0 {
+				v = DefaultEscalationInterval
+			}
+			m.escalationInterval = v
+		case labelsOption:
+			v, ok := option.GetValue().(LabelsFunc)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			m.labels = v
+		case rateLimitOption:
+			v, ok := option.GetValue().(rateLimit)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			if v.rate > 0 {
+				m.limiter = newLimiter(v, m.putCoalesced)
+			}
+		case retentionOption:
+			v, ok := option.GetValue().(retention)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			if m.retention == nil {
+				m.retention = make(map[api.ResourceType]retention)
+			}
+			m.retention[v.resourceType] = v
+		case nodesOption:
+			v, ok := option.GetValue().(NodesFunc)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			m.nodes = v
+		case flappingOption:
+			v, ok := option.GetValue().(flapping)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			if err := v.validate(); err != nil {
+				return nil, err
+			}
+			flaps[v.alertType] = v
+		case cacheOption:
+			v, ok := option.GetValue().(bool)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			if v {
+				cache := newCacheStore(m.store, m.expired)
+				if err := cache.load(); err != nil {
+					return nil, err
+				}
+				m.store = cache
+			}
 		}
 	}
+	if len(flaps) != 0 {
+		m.flapping = newFlapDetector(flaps, m.putSettled)
+	}
 	return m, nil
 }
 
 // manager implements Manager interface.
 type manager struct {
-	kv    kvdb.Kvdb
-	rules map[string]Rule
-	ttl   uint64
+	store      Store
+	rules      map[string]Rule
+	notifiers  map[string]*subscription
+	ttl        uint64
+	gcInterval time.Duration
+	// retention is the retention policy of the alerts of each resource type
+	retention map[api.ResourceType]retention
+	// escalationInterval is the time between evaluations of the escalation
+	// rules, never evaluated if zero
+	escalationInterval time.Duration
+	// escalation tracks the alerts escalated
+	escalation escalations
+	// limiter rate limits the raises, nil if unlimited
+	limiter *limiter
+	// flapping detects the alerts raised and cleared too often, nil if no
+	// alert type is tracked
+	flapping *flapDetector
+	// routes select the notifiers of the alerts, in order
+	routes []*route
+	// labels returns the labels of the resources matched by the routes
+	labels LabelsFunc
+	// nodes returns the sources of the alerts of the other nodes, nil if the
+	// enumerations are never cluster wide
+	nodes NodesFunc
+	// raiseLock serializes the deduplicated raises
+	raiseLock sync.Mutex
+	// metrics instrument the manager, see Collector
+	metrics *metrics
 	sync.Mutex
 }
 
 // getKey is a util func that constructs kvdb key.
 // kvdb tree structure is setup as follows:
 // <baseKey>/<resourceType>/<dec2hex(alertType)>/<resourceID>/<alertObject>
+// and, for the alerts of a namespace, see namespaceKey:
+// <baseKey>/<namespace>/<resourceType>/<dec2hex(alertType)>/<resourceID>/<alertObject>
 func getKey(resourceType string, alertType int64, resourceID string) string {
 	return filepath.Join(kvdbKey, resourceType, strconv.FormatInt(alertType, 16), resourceID)
 }
 
-func (m *manager) Raise(alert *api.Alert) error {
+func (m *manager) Raise(alert *api.Alert, options ...Option) error {
+	dedupe := false
+	for _, option := range options {
+		switch option.GetType() {
+		case dedupeOption:
+			v, ok := option.GetValue().(bool)
+			if !ok {
+				return typeAssertionError
+			}
+			dedupe = v
+		case timestampOption:
+			v, ok := option.GetValue().(time.Time)
+			if !ok {
+				return typeAssertionError
+			}
+			alert.Timestamp = &timestamp.Timestamp{Seconds: v.Unix()}
+		case firstSeenOption:
+			v, ok := option.GetValue().(time.Time)
+			if !ok {
+				return typeAssertionError
+			}
+			alert.FirstSeen = &timestamp.Timestamp{Seconds: v.Unix()}
+		case alertIDOption:
+			v, ok := option.GetValue().(int64)
+			if !ok {
+				return typeAssertionError
+			}
+			alert.Id = v
+		case correlationIDOption:
+			v, ok := option.GetValue().(string)
+			if !ok {
+				return typeAssertionError
+			}
+			if len(v) == 0 {
+				continue
+			}
+			if alert.Payload == nil {
+				alert.Payload = make(map[string]string)
+			}
+			alert.Payload[CorrelationIDPayloadKey] = v
+		case namespaceOption:
+			v, ok := option.GetValue().(Filter)
+			if !ok {
+				return typeAssertionError
+			}
+			namespace, ok := v.GetValue().(string)
+			if !ok {
+				return typeAssertionError
+			}
+			if len(namespace) == 0 {
+				delete(alert.Payload, NamespacePayloadKey)
+				continue
+			}
+			if alert.Payload == nil {
+				alert.Payload = make(map[string]string)
+			}
+			alert.Payload[NamespacePayloadKey] = namespace
+		default:
+			return invalidOptionType.Tag("func Raise")
+		}
+	}
+	if err := checkPayload(alert.Payload); err != nil {
+		return err
+	}
+	if err := checkNamespace(Namespace(alert)); err != nil {
+		return err
+	}
+
 	for _, rule := range m.rules {
 		if rule.GetEvent() == raiseEvent {
 			match, err := rule.GetFilter().Match(alert)
@@ -114,106 +368,279 @@ func (m *manager) Raise(alert *api.Alert) error {
 	if alert.Timestamp == nil {
 		alert.Timestamp = &timestamp.Timestamp{Seconds: time.Now().Unix()}
 	}
+	if len(alert.ReasonCode) == 0 {
+		alert.ReasonCode = ReasonCode(alert.AlertType)
+	}
 
-	key := getKey(alert.Resource.String(), alert.GetAlertType(), alert.ResourceId)
-	if _, err := m.kv.Delete(key); err != nil && err != kvdb.ErrNotFound {
-		logrus.WithField("pkg", "openstorage/alerts").WithField("func", "Raise").Error(err)
+	key := alertKey(alert)
+
+	if m.flapping != nil {
+		switch action, clears := m.flapping.track(key, alert, time.Now()); action {
+		case flapAbsorb:
+			// the stored alert stays raised as flapping
+			return nil
+		case flapStart:
+			markFlapping(alert, clears)
+		}
 	}
+	if m.limiter != nil && !m.limiter.allow(key, alert) {
+		// the raise is coalesced into a later write of the alert
+		return nil
+	}
+	return m.put(alert, dedupe, 1)
+}
 
-	// ttl is time to live. it indicates how long (in seconds) the object should live inside kvdb backend.
-	// kvdb will delete the object once ttl elapses.
+// put stores alert, as occurrences more occurrences of the stored alert if
+// dedupe is set, and delivers it.
+func (m *manager) put(alert *api.Alert, dedupe bool, occurrences int64) error {
+	if dedupe {
+		m.raiseLock.Lock()
+		defer m.raiseLock.Unlock()
+		if err := m.aggregate(alert, occurrences); err != nil {
+			return err
+		}
+	}
+
+	// ttl is time to live. it indicates how long (in seconds) the object should live inside the store.
+	// the store will delete the object once ttl elapses.
+	ttl := alert.Ttl
 	if alert.Cleared {
 		// if the alert is marked Cleared, it is pushed to kvdb with a ttlOption of half day
-		_, err := m.kv.Put(key, alert, m.ttl)
+		ttl = m.ttl
+	}
+	if err := m.store.Put(alert, ttl); err != nil {
 		return err
-	} else {
-		// otherwise use the ttl value embedded in the alert object
-		_, err := m.kv.Put(key, alert, alert.Ttl)
+	}
+	m.metrics.observeRaise(alert)
+	ctx := context.Background()
+	if id := alert.GetPayload()[CorrelationIDPayloadKey]; len(id) != 0 {
+		ctx = correlation.NewContext(ctx, id)
+	}
+	eventbus.PublishContext(ctx, eventbus.EventAlertRaise, alert.ResourceId, alert)
+	m.notify(alert)
+	return nil
+}
+
+// aggregate counts alert as occurrences more occurrences of the stored alert,
+// if any.
+func (m *manager) aggregate(alert *api.Alert, occurrences int64) error {
+	stored, err := m.get(alertQuery(alert))
+	if err == alertNotFound {
+		if alert.Count == 0 {
+			alert.Count = occurrences
+		}
+		if alert.FirstSeen == nil {
+			alert.FirstSeen = alert.Timestamp
+		}
+		return nil
+	} else if err != nil {
 		return err
 	}
+
+	alert.Count = stored.Count + occurrences
+	if alert.Annotations == nil {
+		alert.Annotations = stored.Annotations
+	}
+	firstSeen := stored.FirstSeen
+	if firstSeen == nil {
+		firstSeen = stored.Timestamp
+	}
+	// a first seen time set by the caller is kept if earlier, such as
+	// when replaying historical events
+	if alert.FirstSeen == nil || (firstSeen != nil && firstSeen.Seconds < alert.FirstSeen.Seconds) {
+		alert.FirstSeen = firstSeen
+	}
+	return nil
+}
+
+// get returns the alert selected by q, a QueryResource query. The stores get
+// the alerts of the default namespace, those of the other namespaces are
+// enumerated.
+func (m *manager) get(q Query) (*api.Alert, error) {
+	if len(q.Namespace) == 0 {
+		return m.store.Get(q.ResourceType, q.AlertType, q.ResourceID)
+	}
+	alerts, err := m.store.Enumerate(q)
+	if err != nil {
+		return nil, err
+	}
+	for _, alert := range alerts {
+		// a kvdb prefix also selects the resource ids it prefixes
+		if q.Matches(alert) {
+			return alert, nil
+		}
+	}
+	return nil, alertNotFound
 }
 
 // Enumerate takes a variadic list of filters that are first analyzed to see if one filter
 // is inclusive of other. Only the filters that are unique supersets are retained and their contents
-// is fetched using kvdb enumerate.
+// is fetched from the store.
 func (m *manager) Enumerate(filters ...Filter) ([]*api.Alert, error) {
+	myAlerts, _, err := m.enumeratePage(&page{}, filters...)
+	return myAlerts, err
+}
+
+func (m *manager) EnumerateWithOptions(options []Option, filters ...Filter) ([]*api.Alert, string, error) {
+	p, err := newPage(options)
+	if err != nil {
+		return nil, "", err
+	}
+	return m.enumeratePage(p, filters...)
+}
+
+// enumeratePage returns the alerts of page p matching filters. The token of the next page
+// is returned if there are more alerts. In key order, alerts past the page are not decoded.
+func (m *manager) enumeratePage(p *page, filters ...Filter) ([]*api.Alert, string, error) {
 	myAlerts := make([]*api.Alert, 0, 0)
 	keys, err := getUniqueKeysFromFilters(filters...)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	// enumerate for unique keys
+	var silences []*Silence
+	if !p.includeSilenced {
+		if silences, err = m.EnumerateSilences(); err != nil {
+			return nil, "", err
+		}
+	}
+
+	var nodes map[string]NodeSource
+	if p.clusterWide && m.nodes != nil {
+		if nodes, err = m.nodes(); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// enumerate for unique keys, narrowed down to the search terms of all the filters
+	terms := commonTerms(filters...)
+	var stored []*api.Alert
 	for key := range keys {
-		kvps, err := enumerate(m.kv, key)
+		q, err := queryOf(key)
 		if err != nil {
-			return nil, err
+			return nil, "", err
+		}
+		q.Terms = terms
+		keyAlerts, err := m.storeEnumerate(q)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(nodes) != 0 {
+			keyAlerts = mergeAlerts(keyAlerts, enumerateNodes(nodes, q))
 		}
+		stored = append(stored, keyAlerts...)
+	}
 
-		for _, kvp := range kvps {
-			alert := new(api.Alert)
-			if err := json.Unmarshal(kvp.Value, alert); err != nil {
-				return nil, err
-			}
+	// unique keys do not overlap, sorting the entries by key gives a stable order
+	storedKeys := make(map[*api.Alert]string, len(stored))
+	for _, alert := range stored {
+		storedKeys[alert] = alertKey(alert)
+	}
+	sort.Slice(stored, func(i, j int) bool {
+		return storedKeys[stored[i]] < storedKeys[stored[j]]
+	})
+
+	var entries []*pageEntry
+	for _, alert := range stored {
+		key := storedKeys[alert]
+		if p.sortBy == SortByKey && p.after != nil && key <= p.after.Key {
+			continue
+		}
 
-			if len(filters) == 0 {
-				myAlerts = append(myAlerts, alert)
-				continue
+		match := len(filters) == 0
+		for _, filter := range filters {
+			if match, err = filter.Match(alert); err != nil {
+				return nil, "", err
+			} else if match {
+				// if alert is matched by at least one filter,
+				// include it and break out of loop to avoid further checks.
+				break
 			}
+		}
+		if !match || silenced(silences, alert) {
+			continue
+		}
 
-			for _, filter := range filters {
-				if match, err := filter.Match(alert); err != nil {
-					return nil, err
-				} else {
-					// if alert is matched by at least one filter,
-					// include it and break out of loop to avoid further checks.
-					if match {
-						myAlerts = append(myAlerts, alert)
-						break
-					}
-				}
+		entry := p.entry(key, alert)
+		if p.sortBy == SortByKey {
+			if p.full(len(myAlerts)) {
+				return myAlerts, p.token(entries[len(entries)-1]), nil
 			}
+			myAlerts = append(myAlerts, alert)
 		}
+		entries = append(entries, entry)
+	}
+	if p.sortBy == SortByKey {
+		return myAlerts, "", nil
 	}
 
-	return myAlerts, nil
+	// other orders require all matching alerts
+	myAlerts, token := p.collect(entries)
+	return myAlerts, token, nil
 }
 
-// enumerate recursively fetches kvpairs.
-// Recursive call is required since, unlike mem kv, an etcd or consul based kv will not return
-// leaf objects if the key is a prefix referencing only higher level paths. For instance if the
-// kvdb structure is as follows:
-// a/b/c/<data>
-// a/B/C/<data>
-// then enumerating for keys using "a" will only return "b" and "B".
-func enumerate(kv kvdb.Kvdb, key string) (kvdb.KVPairs, error) {
-	kvps, err := kv.Enumerate(key)
-	if err != nil {
-		return nil, err
+// gc deletes the expired alerts and those beyond the retention policies every gc interval.
+func (m *manager) gc() {
+	for range time.Tick(m.gcInterval) {
+		if n, err := m.collect(time.Now()); err != nil {
+			logrus.WithField("pkg", "openstorage/alerts").WithField("func", "gc").Error(err)
+		} else if n > 0 {
+			logrus.WithField("pkg", "openstorage/alerts").Debugf("Deleted %d expired alerts", n)
+		}
+		if n, err := m.reap(time.Now()); err != nil {
+			logrus.WithField("pkg", "openstorage/alerts").WithField("func", "gc").Error(err)
+		} else if n > 0 {
+			logrus.WithField("pkg", "openstorage/alerts").Debugf("Deleted %d alerts beyond retention", n)
+		}
 	}
+}
 
-	var keys []string
-	var out kvdb.KVPairs
-	for _, kvp := range kvps {
-		kvp := kvp
-		if len(kvp.Value) == 0 {
-			keys = append(keys, kvp.Key)
+// collect deletes the alerts expired at now and returns how many were deleted.
+// The store should expire them on its own, collect deletes those it did not, such as the
+// alerts restored from a backup or stored by a kvdb without TTL support.
+func (m *manager) collect(now time.Time) (int, error) {
+	// the cache skips the expired alerts
+	store := m.store
+	if cache, ok := store.(*cacheStore); ok {
+		store = cache.Store
+	}
+	stored, err := store.Enumerate(Query{})
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, alert := range stored {
+		if !m.expired(alert, now) {
 			continue
 		}
-		out = append(out, kvp)
+		// an alert raised again since it was read is not deleted
+		if deleted, err := m.store.CompareAndDelete(alert); err != nil {
+			return n, err
+		} else if deleted {
+			n++
+		}
 	}
+	return n, nil
+}
 
-	for _, key := range keys {
-		kvps, err := enumerate(kv, key)
-		if err != nil {
-			return nil, err
-		}
-		out = append(out, kvps...)
+// expired returns true if the TTL of alert elapsed at now.
+func (m *manager) expired(alert *api.Alert, now time.Time) bool {
+	ttl := alert.Ttl
+	if alert.Cleared {
+		ttl = m.ttl
 	}
-	return out, nil
+	if ttl == 0 || alert.Timestamp == nil {
+		return false
+	}
+	return alert.Timestamp.Seconds+int64(ttl) <= now.Unix()
 }
 
 func (m *manager) Filter(alerts []*api.Alert, filters ...Filter) ([]*api.Alert, error) {
+	return filterAlerts(alerts, filters...)
+}
+
+// filterAlerts filters alerts successively through each filter.
+func filterAlerts(alerts []*api.Alert, filters ...Filter) ([]*api.Alert, error) {
 	for _, filter := range filters {
 		i := 0
 		for j, alert := range alerts {
@@ -248,42 +675,42 @@ func (m *manager) Delete(filters ...Filter) error {
 		}
 	}
 
-	allFiltersIndexBased := true
-Loop:
+	// alerts matched by at least one filter are deleted, so the filters only querying
+	// kvdb sub trees delete those sub trees and the others delete the alerts they match
+	var subTrees, matching []Filter
 	for _, filter := range filters {
-		switch filter.GetFilterType() {
-		case CustomFilter,
-			timeSpanFilter,
-			alertTypeFilter,
-			countSpanFilter,
-			minSeverityFilter,
-			flagCheckFilter,
-			matchAlertTypeFilter,
-			matchResourceIDFilter:
-			allFiltersIndexBased = false
-			break Loop
+		if isIndexBased(filter) {
+			subTrees = append(subTrees, filter)
+		} else {
+			matching = append(matching, filter)
 		}
 	}
 
-	if allFiltersIndexBased {
-		keys, err := getUniqueKeysFromFilters(filters...)
+	if len(matching) > 0 {
+		myAlerts, _, err := m.enumeratePage(&page{includeSilenced: true}, matching...)
 		if err != nil {
 			return err
 		}
 
-		for key := range keys {
-			if err := m.kv.DeleteTree(key); err != nil {
+		for _, alert := range myAlerts {
+			if err := m.store.Delete(alertQuery(alert)); err != nil {
 				return err
 			}
 		}
-	} else {
-		myAlerts, err := m.Enumerate(filters...)
+	}
+
+	if len(subTrees) > 0 || len(filters) == 0 {
+		keys, err := getUniqueKeysFromFilters(subTrees...)
 		if err != nil {
 			return err
 		}
 
-		for _, alert := range myAlerts {
-			if _, err := m.kv.Delete(getKey(alert.Resource.String(), alert.GetAlertType(), alert.ResourceId)); err != nil {
+		for key := range keys {
+			q, err := queryOf(key)
+			if err != nil {
+				return err
+			}
+			if err := m.store.Delete(q); err != nil {
 				return err
 			}
 		}
@@ -292,6 +719,21 @@ Loop:
 	return nil
 }
 
+// isIndexBased returns true if filter matches every alert of the kvdb sub trees it queries.
+func isIndexBased(f Filter) bool {
+	switch f.GetFilterType() {
+	case namespaceFilter, resourceTypeFilter, alertTypeFilter, resourceIDFilter:
+	default:
+		return false
+	}
+	// options are only applied when matching, deleting the whole
+	// sub tree would ignore them
+	if v, ok := f.(*filter); ok && len(v.options) > 0 {
+		return false
+	}
+	return true
+}
+
 func (m *manager) SetRules(rules ...Rule) {
 	m.Lock()
 	defer m.Unlock()
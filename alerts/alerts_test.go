@@ -1,13 +1,28 @@
 package alerts
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/portworx/kvdb"
 	"github.com/portworx/kvdb/mem"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 )
 
 // helper function go get a new kvdb instance
@@ -842,6 +857,3046 @@ func TestManager_Delete(t *testing.T) {
 	}
 }
 
+// TestManager_ResourceIDPatterns tests filters and options matching resource ids by pattern.
+func TestManager_ResourceIDPatterns(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := raiseAlerts(manager); err != nil {
+		t.Fatal(err)
+	}
+
+	// prepare a test configuration table
+	configs := []struct {
+		name          string
+		filters       []Filter
+		expectedCount int
+	}{
+		{
+			name: "by regex",
+			filters: []Filter{
+				NewMatchResourceIDRegexFilter(regexp.MustCompile("^(inca|maya)$")),
+			},
+			expectedCount: 4,
+		},
+		{
+			name: "by glob",
+			filters: []Filter{
+				NewMatchResourceIDGlobFilter("a*"),
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "by resource type and glob option",
+			filters: []Filter{
+				NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE,
+					NewResourceIDGlobOption("*a")),
+			},
+			expectedCount: 3,
+		},
+		{
+			name: "by alert type and regex option",
+			filters: []Filter{
+				NewAlertTypeFilter(10, api.ResourceType_RESOURCE_TYPE_DRIVE,
+					NewResourceIDRegexOption(regexp.MustCompile("^m"))),
+			},
+			expectedCount: 1,
+		},
+	}
+
+	// iterate over all configs and test
+	for _, config := range configs {
+		myAlerts, err := manager.Enumerate(config.filters...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(myAlerts) != config.expectedCount {
+			t.Fatal("test:", config.name, ", alert count: expected:", config.expectedCount, ", found:", len(myAlerts))
+		}
+	}
+
+	if _, err := manager.Enumerate(NewMatchResourceIDGlobFilter("[")); err == nil {
+		t.Fatal("expected an error for a malformed pattern")
+	}
+
+	// options restrict deletion to the matching alerts of the sub tree
+	if err := manager.Delete(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE,
+		NewResourceIDRegexOption(regexp.MustCompile("^maya$")))); err != nil {
+		t.Fatal(err)
+	}
+	myAlerts, err := manager.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 4 {
+		t.Fatal("alert count after delete: expected: 4, found:", len(myAlerts))
+	}
+}
+
+func TestManager_Severity(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := raiseAlerts(manager); err != nil {
+		t.Fatal(err)
+	}
+
+	// prepare a test configuration table
+	configs := []struct {
+		name          string
+		filters       []Filter
+		expectedCount int
+	}{
+		{
+			name: "at least warning",
+			filters: []Filter{
+				NewSeverityFilter(SeverityAtLeast, api.SeverityType_SEVERITY_TYPE_WARNING),
+			},
+			expectedCount: 3,
+		},
+		{
+			name: "at most warning",
+			filters: []Filter{
+				NewSeverityFilter(SeverityAtMost, api.SeverityType_SEVERITY_TYPE_WARNING),
+			},
+			expectedCount: 4,
+		},
+		{
+			name: "equal to alarm",
+			filters: []Filter{
+				NewSeverityFilter(SeverityEqual, api.SeverityType_SEVERITY_TYPE_ALARM),
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "by resource type and at least warning option",
+			filters: []Filter{
+				NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE,
+					NewSeverityOption(SeverityAtLeast, api.SeverityType_SEVERITY_TYPE_WARNING)),
+			},
+			expectedCount: 3,
+		},
+		{
+			name: "by resource type and equal to notify option",
+			filters: []Filter{
+				NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME,
+					NewSeverityOption(SeverityEqual, api.SeverityType_SEVERITY_TYPE_NOTIFY)),
+			},
+			expectedCount: 1,
+		},
+	}
+
+	// iterate over all configs and test
+	for _, config := range configs {
+		myAlerts, err := manager.Enumerate(config.filters...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(myAlerts) != config.expectedCount {
+			t.Fatal("test:", config.name, ", alert count: expected:", config.expectedCount, ", found:", len(myAlerts))
+		}
+	}
+
+	if _, err := manager.Enumerate(NewSeverityFilter(SeverityOperator(-1),
+		api.SeverityType_SEVERITY_TYPE_ALARM)); err == nil {
+		t.Fatal("expected an error for an invalid operator")
+	}
+
+	if err := manager.Delete(NewSeverityFilter(SeverityEqual, api.SeverityType_SEVERITY_TYPE_NOTIFY)); err != nil {
+		t.Fatal(err)
+	}
+	myAlerts, err := manager.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 3 {
+		t.Fatal("alert count after delete: expected: 3, found:", len(myAlerts))
+	}
+}
+
+func TestManager_Combinators(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := raiseAlerts(manager); err != nil {
+		t.Fatal(err)
+	}
+
+	// prepare a test configuration table
+	configs := []struct {
+		name          string
+		filters       []Filter
+		keys          []string
+		expectedCount int
+	}{
+		{
+			name: "and picks the most selective sub tree",
+			filters: []Filter{
+				NewAndFilter(
+					NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE),
+					NewAlertTypeFilter(10, api.ResourceType_RESOURCE_TYPE_DRIVE),
+					NewSeverityFilter(SeverityAtLeast, api.SeverityType_SEVERITY_TYPE_WARNING)),
+			},
+			keys:          []string{"alerts/RESOURCE_TYPE_DRIVE/a"},
+			expectedCount: 1,
+		},
+		{
+			name: "or of sub trees",
+			filters: []Filter{
+				NewOrFilter(
+					NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME),
+					NewAlertTypeFilter(12, api.ResourceType_RESOURCE_TYPE_CLUSTER)),
+			},
+			keys:          []string{"alerts/RESOURCE_TYPE_VOLUME", "alerts/RESOURCE_TYPE_CLUSTER/c"},
+			expectedCount: 2,
+		},
+		{
+			name: "not",
+			filters: []Filter{
+				NewNotFilter(NewMatchResourceIDFilter("inca")),
+			},
+			keys:          []string{"alerts"},
+			expectedCount: 4,
+		},
+		{
+			name: "and with not",
+			filters: []Filter{
+				NewAndFilter(
+					NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE),
+					NewNotFilter(NewMatchAlertTypeFilter(10))),
+			},
+			keys:          []string{"alerts/RESOURCE_TYPE_DRIVE"},
+			expectedCount: 2,
+		},
+		{
+			name: "or with an inefficient filter",
+			filters: []Filter{
+				NewOrFilter(
+					NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME),
+					NewMatchResourceIDFilter("aztec")),
+			},
+			keys:          []string{"alerts"},
+			expectedCount: 3,
+		},
+	}
+
+	// iterate over all configs and test
+	for _, config := range configs {
+		m, err := getUniqueKeysFromFilters(config.filters...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(m) != len(config.keys) {
+			t.Fatal("test:", config.name, ",", len(config.keys), "number of keys expected, got", len(m), "instead")
+		}
+		for _, key := range config.keys {
+			if _, ok := m[key]; !ok {
+				t.Fatal("test:", config.name, ", expected key", key, "to be present in one of the unique keys")
+			}
+		}
+
+		myAlerts, err := manager.Enumerate(config.filters...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(myAlerts) != config.expectedCount {
+			t.Fatal("test:", config.name, ", alert count: expected:", config.expectedCount, ", found:", len(myAlerts))
+		}
+	}
+
+	if err := manager.Delete(NewAndFilter(
+		NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE),
+		NewNotFilter(NewMatchResourceIDFilter("maya")))); err != nil {
+		t.Fatal(err)
+	}
+	myAlerts, err := manager.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 4 {
+		t.Fatal("alert count after delete: expected: 4, found:", len(myAlerts))
+	}
+}
+
+func TestManager_EnumerateWithOptions(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := raiseAlerts(manager); err != nil {
+		t.Fatal(err)
+	}
+
+	// page through all alerts two at a time
+	var pages [][]*api.Alert
+	token := ""
+	for {
+		page, next, err := manager.EnumerateWithOptions(
+			[]Option{NewMaxResultsOption(2), NewContinuationTokenOption(token)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, page)
+		if len(next) == 0 {
+			break
+		}
+		token = next
+	}
+	if len(pages) != 3 {
+		t.Fatal("page count: expected: 3, found:", len(pages))
+	}
+	seen := make(map[string]bool)
+	var last string
+	for _, page := range pages {
+		if len(page) != 2 {
+			t.Fatal("page size: expected: 2, found:", len(page))
+		}
+		for _, alert := range page {
+			key := getKey(alert.Resource.String(), alert.AlertType, alert.ResourceId)
+			if seen[key] {
+				t.Fatal("alert", key, "returned twice")
+			}
+			if key < last {
+				t.Fatal("alert", key, "returned after", last)
+			}
+			seen[key] = true
+			last = key
+		}
+	}
+
+	// filters apply before the limit
+	page, next, err := manager.EnumerateWithOptions([]Option{NewMaxResultsOption(2)},
+		NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE,
+			NewSeverityOption(SeverityEqual, api.SeverityType_SEVERITY_TYPE_ALARM)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 2 || len(next) != 0 {
+		t.Fatal("expected a single page of 2 alarms, found:", len(page), "with token", next)
+	}
+
+	if _, _, err := manager.EnumerateWithOptions([]Option{NewContinuationTokenOption("%")}); err == nil {
+		t.Fatal("expected an error for an invalid token")
+	}
+	if _, _, err := manager.EnumerateWithOptions([]Option{NewTTLOption(1)}); err == nil {
+		t.Fatal("expected an error for an invalid option")
+	}
+}
+
+func TestManager_SortOption(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := raiseAlerts(manager); err != nil {
+		t.Fatal(err)
+	}
+
+	// latest alerts first, paged three at a time
+	var alerts []*api.Alert
+	token := ""
+	for {
+		page, next, err := manager.EnumerateWithOptions([]Option{NewSortOption(SortByTimestamp, true),
+			NewMaxResultsOption(3), NewContinuationTokenOption(token)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		alerts = append(alerts, page...)
+		if len(next) == 0 {
+			break
+		}
+		token = next
+	}
+	if len(alerts) != 6 {
+		t.Fatal("alert count: expected: 6, found:", len(alerts))
+	}
+	for i := 1; i < len(alerts); i++ {
+		if alerts[i].Timestamp.Seconds > alerts[i-1].Timestamp.Seconds {
+			t.Fatal("alert", i, "is later than alert", i-1)
+		}
+	}
+
+	// least severe alerts first
+	alerts, next, err := manager.EnumerateWithOptions([]Option{NewSortOption(SortBySeverity, false)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 6 || len(next) != 0 {
+		t.Fatal("expected a single page of 6 alerts, found:", len(alerts), "with token", next)
+	}
+	expected := []api.SeverityType{
+		api.SeverityType_SEVERITY_TYPE_NOTIFY,
+		api.SeverityType_SEVERITY_TYPE_NOTIFY,
+		api.SeverityType_SEVERITY_TYPE_NOTIFY,
+		api.SeverityType_SEVERITY_TYPE_WARNING,
+		api.SeverityType_SEVERITY_TYPE_ALARM,
+		api.SeverityType_SEVERITY_TYPE_ALARM,
+	}
+	for i, alert := range alerts {
+		if alert.Severity != expected[i] {
+			t.Fatal("severity of alert", i, "expected:", expected[i], "found:", alert.Severity)
+		}
+	}
+
+	// filters apply before sorting
+	alerts, _, err = manager.EnumerateWithOptions([]Option{NewSortOption(SortBySeverity, true)},
+		NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 4 || alerts[0].Severity != api.SeverityType_SEVERITY_TYPE_ALARM {
+		t.Fatal("expected 4 drive alerts, alarms first, found:", len(alerts))
+	}
+
+	if _, _, err := manager.EnumerateWithOptions([]Option{NewSortOption(SortBy(-1), false)}); err == nil {
+		t.Fatal("expected an error for an invalid sort order")
+	}
+}
+
+func TestManager_GC(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := newManager(NewKvdbStore(kv), NewTTLOption(HalfDay), NewGCIntervalOption(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.gcInterval != DefaultGCInterval {
+		t.Fatal("gc interval: expected:", DefaultGCInterval, "found:", m.gcInterval)
+	}
+
+	now := time.Now()
+	raise := func(resourceID string, ttl uint64, cleared bool, raised time.Time) {
+		alert := &api.Alert{
+			AlertType:  10,
+			Severity:   api.SeverityType_SEVERITY_TYPE_NOTIFY,
+			Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: resourceID,
+			Ttl:        ttl,
+			Cleared:    cleared,
+			Timestamp:  &timestamp.Timestamp{Seconds: raised.Unix()},
+		}
+		if err := m.Raise(alert); err != nil {
+			t.Fatal(err)
+		}
+	}
+	raise("expired", Day, false, now.AddDate(0, 0, -2))
+	raise("live", Day, false, now)
+	raise("cleared", 0, true, now.AddDate(0, 0, -1))
+	raise("forever", 0, false, now.AddDate(-1, 0, 0))
+
+	n, err := m.collect(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatal("deleted alerts: expected: 2, found:", n)
+	}
+
+	myAlerts, err := m.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	left := make(map[string]bool)
+	for _, alert := range myAlerts {
+		left[alert.ResourceId] = true
+	}
+	if len(left) != 2 || !left["live"] || !left["forever"] {
+		t.Fatal("expected live and forever alerts to be kept, found:", left)
+	}
+}
+
+func TestManager_Retention(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &RetentionConfig{ResourceType: "drive", MaxAge: 24 * time.Hour, MaxCount: 2}
+	opt, err := c.Option()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&RetentionConfig{ResourceType: "disk"}).Option(); err == nil {
+		t.Fatal("expected an error for an unknown resource type")
+	}
+
+	m, err := newManager(NewKvdbStore(kv), opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	raise := func(resourceType api.ResourceType, resourceID string, raised time.Time) {
+		alert := &api.Alert{
+			AlertType:  10,
+			Severity:   api.SeverityType_SEVERITY_TYPE_NOTIFY,
+			Resource:   resourceType,
+			ResourceId: resourceID,
+			Timestamp:  &timestamp.Timestamp{Seconds: raised.Unix()},
+		}
+		if err := m.Raise(alert); err != nil {
+			t.Fatal(err)
+		}
+	}
+	raise(api.ResourceType_RESOURCE_TYPE_DRIVE, "old", now.AddDate(0, 0, -2))
+	raise(api.ResourceType_RESOURCE_TYPE_DRIVE, "first", now.Add(-3*time.Hour))
+	raise(api.ResourceType_RESOURCE_TYPE_DRIVE, "second", now.Add(-2*time.Hour))
+	raise(api.ResourceType_RESOURCE_TYPE_DRIVE, "third", now.Add(-time.Hour))
+	raise(api.ResourceType_RESOURCE_TYPE_VOLUME, "volume", now.AddDate(0, 0, -2))
+
+	n, err := m.reap(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatal("deleted alerts: expected: 2, found:", n)
+	}
+
+	myAlerts, err := m.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	left := make(map[string]bool)
+	for _, alert := range myAlerts {
+		left[alert.ResourceId] = true
+	}
+	if len(left) != 3 || !left["second"] || !left["third"] || !left["volume"] {
+		t.Fatal("expected the two most recent drive alerts and the volume alert to be kept, found:", left)
+	}
+}
+
+// failingNode is the alerts source of a node which cannot be reached.
+type failingNode struct{}
+
+func (failingNode) Enumerate(q Query) ([]*api.Alert, error) {
+	return nil, errors.New("node down")
+}
+
+func TestManager_ClusterWide(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the node-local store of another node
+	remote := NewMemStore()
+	m, err := newManager(NewKvdbStore(kv), NewNodesOption(func() (map[string]NodeSource, error) {
+		return map[string]NodeSource{"node2": remote, "node3": failingNode{}}, nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	alert := func(resourceID, message string, raised time.Time) *api.Alert {
+		return &api.Alert{
+			AlertType:  10,
+			Severity:   api.SeverityType_SEVERITY_TYPE_NOTIFY,
+			Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: resourceID,
+			Message:    message,
+			Timestamp:  &timestamp.Timestamp{Seconds: raised.Unix()},
+		}
+	}
+	for _, a := range []*api.Alert{
+		alert("vol1", "local", now.Add(-time.Hour)),
+		alert("vol2", "local", now),
+	} {
+		if err := m.Raise(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, a := range []*api.Alert{
+		alert("vol1", "remote", now),
+		alert("vol2", "remote", now),
+		alert("vol3", "remote", now),
+	} {
+		if err := remote.Put(a, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	myAlerts, err := m.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 2 {
+		t.Fatal("local alerts: expected: 2, found:", len(myAlerts))
+	}
+
+	options := []Option{NewClusterWideOption()}
+	myAlerts, _, err = m.EnumerateWithOptions(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := make(map[string]string)
+	for _, a := range myAlerts {
+		messages[a.ResourceId] = a.Message
+	}
+	// the alert raised last wins, the local one if raised at the same time
+	expected := map[string]string{"vol1": "remote", "vol2": "local", "vol3": "remote"}
+	if !reflect.DeepEqual(messages, expected) {
+		t.Fatal("cluster wide alerts: expected:", expected, "found:", messages)
+	}
+
+	myAlerts, _, err = m.EnumerateWithOptions(options,
+		NewResourceIDFilter("vol3", 10, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].ResourceId != "vol3" {
+		t.Fatal("expected the alert of vol3 of the other node, found:", myAlerts)
+	}
+}
+
+func TestManager_Watch(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, stop, err := manager.Watch(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE,
+		NewSeverityOption(SeverityEqual, api.SeverityType_SEVERITY_TYPE_ALARM)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// only the two drive alarms are delivered
+	if err := raiseAlerts(manager); err != nil {
+		t.Fatal(err)
+	}
+	next := func() *WatchEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a watch event")
+		}
+		return nil
+	}
+	for _, id := range []string{"aztec", "maya"} {
+		event := next()
+		if event.Action != AlertRaised || event.Alert.ResourceId != id {
+			t.Fatal("expected raise of", id, "found:", event.Action, event.Alert.ResourceId)
+		}
+	}
+
+	alert := &api.Alert{
+		AlertType:  14,
+		Severity:   api.SeverityType_SEVERITY_TYPE_ALARM,
+		Resource:   api.ResourceType_RESOURCE_TYPE_DRIVE,
+		ResourceId: "aztec",
+		Count:      1,
+	}
+	if err := manager.Raise(alert); err != nil {
+		t.Fatal(err)
+	}
+	if event := next(); event.Action != AlertUpdated || event.Alert.Count != 1 {
+		t.Fatal("expected update of aztec, found:", event.Action, event.Alert.ResourceId)
+	}
+
+	if err := manager.Delete(NewResourceIDFilter("aztec", 14, api.ResourceType_RESOURCE_TYPE_DRIVE)); err != nil {
+		t.Fatal(err)
+	}
+	if event := next(); event.Action != AlertDeleted || event.Alert.ResourceId != "aztec" {
+		t.Fatal("expected deletion of aztec, found:", event.Action, event.Alert.ResourceId)
+	}
+
+	stop()
+	if _, ok := <-events; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	// stopping twice is allowed
+	stop()
+}
+
+// TestManager_AckClear tests the acknowledgement workflow of alerts.
+func TestManager_AckClear(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, resourceID := range []string{"a", "b", "c"} {
+		if err := m.Raise(&api.Alert{
+			AlertType:  10,
+			Severity:   api.SeverityType_SEVERITY_TYPE_NOTIFY,
+			Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: resourceID,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	id := ID(&api.Alert{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "a"})
+	if id != "RESOURCE_TYPE_VOLUME/a/a" {
+		t.Fatal("id: expected: RESOURCE_TYPE_VOLUME/a/a, found:", id)
+	}
+	if err := m.Ack(id); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Clear("RESOURCE_TYPE_VOLUME/a/b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Ack("RESOURCE_TYPE_VOLUME/a/d"); err != alertNotFound {
+		t.Fatal("expected:", alertNotFound, "found:", err)
+	}
+	if err := m.Clear("d"); err != invalidID {
+		t.Fatal("expected:", invalidID, "found:", err)
+	}
+
+	states := map[State]string{StateNew: "c", StateAcknowledged: "a", StateCleared: "b"}
+	for state, resourceID := range states {
+		myAlerts, err := m.Enumerate(
+			NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME, NewStateOption(state)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(myAlerts) != 1 || myAlerts[0].ResourceId != resourceID {
+			t.Fatal("state", state, "expected:", resourceID, "found:", myAlerts)
+		}
+		if StateOf(myAlerts[0]) != state {
+			t.Fatal("state: expected:", state, "found:", StateOf(myAlerts[0]))
+		}
+	}
+
+	// unacknowledged alerts
+	myAlerts, err := m.Enumerate(NewStateFilter(StateNew))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].ResourceId != "c" {
+		t.Fatal("expected only alert c to be unacknowledged, found:", myAlerts)
+	}
+
+	// acknowledging a cleared alert keeps it cleared
+	if err := m.Ack("RESOURCE_TYPE_VOLUME/a/b"); err != nil {
+		t.Fatal(err)
+	}
+	myAlerts, err = m.Enumerate(NewAlertTypeFilter(10, api.ResourceType_RESOURCE_TYPE_VOLUME, NewStateOption(StateCleared)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || !myAlerts[0].Acknowledged {
+		t.Fatal("expected alert b to be cleared and acknowledged, found:", myAlerts)
+	}
+}
+
+// TestManager_Annotate tests if annotations are attached to stored alerts, kept when they are
+// raised again with the dedupe option and matched by tag filters.
+func TestManager_Annotate(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, resourceID := range []string{"a", "b"} {
+		if err := m.Raise(&api.Alert{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: resourceID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := m.Annotate("RESOURCE_TYPE_VOLUME/a/a",
+		map[string]string{"ticket": "OPS-1", "owner": "storage"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Annotate("RESOURCE_TYPE_VOLUME/a/b", map[string]string{"owner": "network"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Annotate("RESOURCE_TYPE_VOLUME/a/c", map[string]string{"owner": "network"}); err != ErrNotFound {
+		t.Fatal("expected:", ErrNotFound, "found:", err)
+	}
+	if err := m.Annotate("RESOURCE_TYPE_VOLUME/a/a", map[string]string{"bad key": "x"}); err == nil {
+		t.Fatal("expected an error annotating an alert with an invalid key")
+	}
+
+	// an empty value removes the annotation
+	if err := m.Annotate("RESOURCE_TYPE_VOLUME/a/a", map[string]string{"owner": ""}); err != nil {
+		t.Fatal(err)
+	}
+	// the annotations are kept when the alert is raised again
+	if err := m.Raise(&api.Alert{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "a"}, NewDedupeOption()); err != nil {
+		t.Fatal(err)
+	}
+
+	myAlerts, err := m.Enumerate(NewTagFilter("ticket", "OPS-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].ResourceId != "a" || len(myAlerts[0].Annotations) != 1 {
+		t.Fatal("expected alert a with its ticket only, found:", myAlerts)
+	}
+
+	myAlerts, err = m.Enumerate(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME,
+		NewTagOption("owner")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].ResourceId != "b" {
+		t.Fatal("expected alert b with an owner, found:", myAlerts)
+	}
+}
+
+// TestManager_RaiseDedupe tests if alerts raised again with the dedupe option are aggregated.
+func TestManager_RaiseDedupe(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := time.Now().Add(-time.Hour)
+	for i := 0; i < 3; i++ {
+		alert := &api.Alert{
+			AlertType:  10,
+			Severity:   api.SeverityType_SEVERITY_TYPE_NOTIFY,
+			Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: "inca",
+			Timestamp:  &timestamp.Timestamp{Seconds: first.Add(time.Duration(i) * time.Minute).Unix()},
+		}
+		if err := m.Raise(alert, NewDedupeOption()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	myAlerts, err := m.Enumerate(NewResourceIDFilter("inca", 10, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 {
+		t.Fatal("alerts: expected: 1, found:", len(myAlerts))
+	}
+	if myAlerts[0].Count != 3 {
+		t.Fatal("count: expected: 3, found:", myAlerts[0].Count)
+	}
+	if myAlerts[0].FirstSeen.GetSeconds() != first.Unix() {
+		t.Fatal("first seen: expected:", first.Unix(), "found:", myAlerts[0].FirstSeen.GetSeconds())
+	}
+	if last := first.Add(2 * time.Minute).Unix(); myAlerts[0].Timestamp.GetSeconds() != last {
+		t.Fatal("timestamp: expected:", last, "found:", myAlerts[0].Timestamp.GetSeconds())
+	}
+
+	// without the option the stored alert is replaced
+	if err := m.Raise(&api.Alert{
+		AlertType:  10,
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "inca",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	myAlerts, err = m.Enumerate(NewResourceIDFilter("inca", 10, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].Count != 0 {
+		t.Fatal("expected the alert to be replaced, found:", myAlerts)
+	}
+
+	if err := m.Raise(&api.Alert{}, NewTTLOption(HalfDay)); err == nil {
+		t.Fatal("expected an error raising with a manager option")
+	}
+}
+
+// TestManager_RaiseOptions tests if the timestamp, first seen time and id set by the
+// caller are stored.
+func TestManager_RaiseOptions(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := time.Now().Add(-48 * time.Hour)
+	last := first.Add(time.Hour)
+	if err := m.Raise(&api.Alert{
+		AlertType:  10,
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "inca",
+	}, NewTimestampOption(last), NewFirstSeenOption(first), NewAlertIDOption(42)); err != nil {
+		t.Fatal(err)
+	}
+
+	myAlerts, err := m.Enumerate(NewResourceIDFilter("inca", 10, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 {
+		t.Fatal("alerts: expected: 1, found:", len(myAlerts))
+	}
+	if myAlerts[0].Timestamp.GetSeconds() != last.Unix() {
+		t.Fatal("timestamp: expected:", last.Unix(), "found:", myAlerts[0].Timestamp.GetSeconds())
+	}
+	if myAlerts[0].FirstSeen.GetSeconds() != first.Unix() {
+		t.Fatal("first seen: expected:", first.Unix(), "found:", myAlerts[0].FirstSeen.GetSeconds())
+	}
+	if myAlerts[0].Id != 42 {
+		t.Fatal("id: expected: 42, found:", myAlerts[0].Id)
+	}
+
+	// deduplicated, the earliest first seen time is kept
+	for _, seen := range []time.Time{first.Add(time.Minute), first.Add(-time.Minute)} {
+		if err := m.Raise(&api.Alert{
+			AlertType:  10,
+			Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: "inca",
+		}, NewDedupeOption(), NewTimestampOption(last), NewFirstSeenOption(seen)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	myAlerts, err = m.Enumerate(NewResourceIDFilter("inca", 10, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := first.Add(-time.Minute).Unix(); myAlerts[0].FirstSeen.GetSeconds() != expected {
+		t.Fatal("first seen: expected:", expected, "found:", myAlerts[0].FirstSeen.GetSeconds())
+	}
+
+	if err := m.Raise(&api.Alert{}, &option{optionType: timestampOption, value: 1}); err == nil {
+		t.Fatal("expected an error raising with an invalid timestamp")
+	}
+}
+
+// TestManager_Namespaces tests if the alerts of a namespace are stored apart and
+// enumerated, acknowledged and deleted by namespace.
+func TestManager_Namespaces(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+	kvdbManager, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	memManager, err := NewManagerWithStore(NewMemStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, m := range map[string]Manager{"kvdb": kvdbManager, "mem": memManager} {
+		for _, namespace := range []string{"", "tenant-a", "tenant-b"} {
+			if err := m.Raise(&api.Alert{
+				AlertType:  10,
+				Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+				ResourceId: "inca",
+				Message:    namespace,
+			}, NewNamespaceOption(namespace), NewDedupeOption()); err != nil {
+				t.Fatal(name, err)
+			}
+		}
+		if err := m.Raise(&api.Alert{}, NewNamespaceOption("tenant/a")); err == nil {
+			t.Fatal(name, "expected an error raising in an invalid namespace")
+		}
+
+		if kvdbManager == m {
+			if _, err := kv.Get("alerts/tenant-a/RESOURCE_TYPE_VOLUME/a/inca"); err != nil {
+				t.Fatal(name, "expected the alert under its namespace:", err)
+			}
+		}
+
+		myAlerts, err := m.Enumerate()
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if len(myAlerts) != 3 {
+			t.Fatal(name, "alerts: expected: 3, found:", len(myAlerts))
+		}
+
+		myAlerts, err = m.Enumerate(NewNamespaceFilter("tenant-a"))
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if len(myAlerts) != 1 || myAlerts[0].Message != "tenant-a" || Namespace(myAlerts[0]) != "tenant-a" {
+			t.Fatal(name, "expected the alert of tenant-a, found:", myAlerts)
+		}
+		if myAlerts[0].Count != 1 {
+			t.Fatal(name, "count: expected: 1, found:", myAlerts[0].Count)
+		}
+
+		// efficient filters match the default namespace unless given one
+		myAlerts, err = m.Enumerate(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME))
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if len(myAlerts) != 1 || myAlerts[0].Message != "" {
+			t.Fatal(name, "expected the alert of the default namespace, found:", myAlerts)
+		}
+		myAlerts, err = m.Enumerate(NewResourceIDFilter("inca", 10, api.ResourceType_RESOURCE_TYPE_VOLUME,
+			NewNamespaceOption("tenant-b")))
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if len(myAlerts) != 1 || myAlerts[0].Message != "tenant-b" {
+			t.Fatal(name, "expected the alert of tenant-b, found:", myAlerts)
+		}
+
+		if id := ID(myAlerts[0]); id != "tenant-b/RESOURCE_TYPE_VOLUME/a/inca" {
+			t.Fatal(name, "unexpected id:", id)
+		}
+		if err := m.Ack(ID(myAlerts[0])); err != nil {
+			t.Fatal(name, err)
+		}
+		myAlerts, err = m.Enumerate(NewNamespaceFilter("tenant-b", NewStateOption(StateAcknowledged)))
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if len(myAlerts) != 1 {
+			t.Fatal(name, "expected the alert of tenant-b acknowledged, found:", myAlerts)
+		}
+
+		if err := m.Delete(NewNamespaceFilter("tenant-a")); err != nil {
+			t.Fatal(name, err)
+		}
+		myAlerts, err = m.Enumerate()
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if len(myAlerts) != 2 {
+			t.Fatal(name, "alerts: expected: 2, found:", len(myAlerts))
+		}
+		for _, alert := range myAlerts {
+			if Namespace(alert) == "tenant-a" {
+				t.Fatal(name, "expected the alerts of tenant-a deleted, found:", alert)
+			}
+		}
+	}
+}
+
+// TestManager_DeleteMixedFilters tests if delete removes the alerts matched by either
+// index based or matching filters.
+func TestManager_DeleteMixedFilters(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raise := func(resourceType api.ResourceType, alertType int64, resourceID string, severity api.SeverityType) {
+		if err := m.Raise(&api.Alert{
+			AlertType:  alertType,
+			Severity:   severity,
+			Resource:   resourceType,
+			ResourceId: resourceID,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	raise(api.ResourceType_RESOURCE_TYPE_VOLUME, 10, "a", api.SeverityType_SEVERITY_TYPE_NOTIFY)
+	raise(api.ResourceType_RESOURCE_TYPE_VOLUME, 10, "b", api.SeverityType_SEVERITY_TYPE_ALARM)
+	raise(api.ResourceType_RESOURCE_TYPE_VOLUME, 20, "c", api.SeverityType_SEVERITY_TYPE_NOTIFY)
+	raise(api.ResourceType_RESOURCE_TYPE_NODE, 10, "d", api.SeverityType_SEVERITY_TYPE_ALARM)
+	raise(api.ResourceType_RESOURCE_TYPE_NODE, 20, "e", api.SeverityType_SEVERITY_TYPE_NOTIFY)
+
+	if err := m.Delete(
+		NewAlertTypeFilter(10, api.ResourceType_RESOURCE_TYPE_VOLUME),
+		NewMinSeverityFilter(api.SeverityType_SEVERITY_TYPE_ALARM),
+		NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_NODE,
+			NewSeverityOption(SeverityEqual, api.SeverityType_SEVERITY_TYPE_ALARM)),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	myAlerts, err := m.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	left := make(map[string]bool)
+	for _, alert := range myAlerts {
+		left[alert.ResourceId] = true
+	}
+	if len(left) != 2 || !left["c"] || !left["e"] {
+		t.Fatal("expected alerts c and e to be kept, found:", left)
+	}
+
+	// no filters delete all alerts
+	if err := m.Delete(); err != nil {
+		t.Fatal(err)
+	}
+	myAlerts, err = m.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 0 {
+		t.Fatal("alerts: expected: 0, found:", len(myAlerts))
+	}
+}
+
+// TestManager_WebhookNotifier tests if raised alerts matched by the filters of a webhook are
+// posted to it and if failed deliveries are retried.
+func TestManager_WebhookNotifier(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var calls int32
+	received := make(chan *api.Alert, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token abc" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// fail the first attempt to check retries
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		alert := new(api.Alert)
+		if err := jsonpb.Unmarshal(r.Body, alert); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- alert
+	}))
+	defer ts.Close()
+
+	config := &WebhookConfig{
+		Name:          "pagerduty",
+		URL:           ts.URL,
+		Headers:       map[string]string{"Authorization": "Token abc"},
+		Backoff:       time.Millisecond,
+		ResourceTypes: []string{"volume"},
+		MinSeverity:   "alarm",
+	}
+	n, err := NewWebhookNotifier(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filters, err := config.Filters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.AddNotifier(n, filters...); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.AddNotifier(n); err == nil {
+		t.Fatal("expected an error adding a notifier twice")
+	}
+
+	for _, alert := range []*api.Alert{
+		{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_NODE, ResourceId: "node",
+			Severity: api.SeverityType_SEVERITY_TYPE_ALARM},
+		{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "notify",
+			Severity: api.SeverityType_SEVERITY_TYPE_NOTIFY},
+		{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "alarm",
+			Severity: api.SeverityType_SEVERITY_TYPE_ALARM},
+	} {
+		if err := m.Raise(alert); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	select {
+	case alert := <-received:
+		if alert.ResourceId != "alarm" {
+			t.Fatal("expected alert alarm to be posted, found:", alert.ResourceId)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("alert was not posted to the webhook")
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Fatal("expected 2 attempts, found:", n)
+	}
+
+	m.RemoveNotifier("pagerduty")
+	if err := m.Raise(&api.Alert{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "removed", Severity: api.SeverityType_SEVERITY_TYPE_ALARM}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case alert := <-received:
+		t.Fatal("unexpected alert posted after the notifier was removed:", alert.ResourceId)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if _, err := (&WebhookConfig{URL: ts.URL, MinSeverity: "loud"}).Filters(); err == nil {
+		t.Fatal("expected an error for an unknown severity")
+	}
+}
+
+// TestEmailNotifier tests if alerts are emailed in batches, once the batch is full or at the
+// end of the batch interval.
+func TestEmailNotifier(t *testing.T) {
+	config := &EmailConfig{
+		Server:        "smtp.example.com:25",
+		From:          "osd@example.com",
+		To:            []string{"oncall@example.com"},
+		BatchInterval: 20 * time.Millisecond,
+		MaxBatch:      2,
+	}
+	n, err := NewEmailNotifier(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent := make(chan string, 10)
+	n.(*email).send = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		if addr != config.Server || from != config.From || len(to) != 1 {
+			t.Error("unexpected message envelope:", addr, from, to)
+		}
+		sent <- string(msg)
+		return nil
+	}
+
+	alert := func(id string) *api.Alert {
+		return &api.Alert{
+			Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: id,
+			Severity:   api.SeverityType_SEVERITY_TYPE_ALARM,
+			Message:    "volume is down",
+			Timestamp:  &timestamp.Timestamp{Seconds: time.Now().Unix()},
+		}
+	}
+
+	// a full batch is sent at once
+	if err := n.Notify(alert("a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Notify(alert("b")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case msg := <-sent:
+		if !strings.Contains(msg, "Subject: [openstorage] 2 alert(s) raised") ||
+			!strings.Contains(msg, "RESOURCE_TYPE_VOLUME a: volume is down") ||
+			!strings.Contains(msg, "RESOURCE_TYPE_VOLUME b: volume is down") {
+			t.Fatal("unexpected message:", msg)
+		}
+	default:
+		t.Fatal("expected a full batch to be sent")
+	}
+
+	// the rest is sent at the end of the interval
+	if err := n.Notify(alert("c")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case msg := <-sent:
+		if !strings.Contains(msg, "1 alert(s)") || !strings.Contains(msg, " c: ") {
+			t.Fatal("unexpected message:", msg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the batch to be sent at the end of the interval")
+	}
+
+	filters, err := config.Filters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match, _ := filters[0].Match(&api.Alert{Severity: api.SeverityType_SEVERITY_TYPE_WARNING}); match {
+		t.Fatal("expected warnings not to be emailed by default")
+	}
+	if _, err := NewEmailNotifier(&EmailConfig{Server: "smtp.example.com"}); err == nil {
+		t.Fatal("expected an error for a server without port")
+	}
+}
+
+// TestManager_ReasonCodes tests if raised alerts carry the reason code of their alert type.
+func TestManager_ReasonCodes(t *testing.T) {
+	defer func() {
+		reasonsLock.Lock()
+		defer reasonsLock.Unlock()
+		for _, alertType := range []int64{9001, 9002} {
+			delete(reasonCodes, reasons[alertType].Code)
+			delete(reasons, alertType)
+		}
+	}()
+	if err := RegisterReasons(
+		Reason{AlertType: 9001, Code: "TEST_VOLUME_DOWN", Resource: api.ResourceType_RESOURCE_TYPE_VOLUME},
+		Reason{AlertType: 9002, Code: "TEST_NODE_DOWN", Resource: api.ResourceType_RESOURCE_TYPE_NODE},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterReasons(Reason{AlertType: 9001, Code: "TEST_OTHER"}); err == nil {
+		t.Fatal("expected an error registering an alert type twice")
+	}
+	if err := RegisterReasons(Reason{AlertType: 9003, Code: "TEST_NODE_DOWN"}); err == nil {
+		t.Fatal("expected an error registering a code twice")
+	}
+	if err := RegisterReasons(Reason{AlertType: 9004, Code: "test-lower"}); err == nil {
+		t.Fatal("expected an error registering an invalid code")
+	}
+	if code := ReasonCode(9003); len(code) != 0 {
+		t.Fatal("alert type 9003 should not be registered, found:", code)
+	}
+	if r, ok := ReasonOf("TEST_NODE_DOWN"); !ok || r.AlertType != 9002 {
+		t.Fatal("expected TEST_NODE_DOWN to be registered for 9002, found:", r)
+	}
+	found := 0
+	last := int64(-1)
+	for _, r := range Reasons() {
+		if r.AlertType <= last {
+			t.Fatal("reasons are not sorted by alert type")
+		}
+		last = r.AlertType
+		if r.AlertType == 9001 || r.AlertType == 9002 {
+			found++
+		}
+	}
+	if found != 2 {
+		t.Fatal("expected the registered reasons to be listed, found:", found)
+	}
+
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Raise(&api.Alert{AlertType: 9001, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol", Message: "volume vol is down"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Raise(&api.Alert{AlertType: 9005, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol"}); err != nil {
+		t.Fatal(err)
+	}
+	myAlerts, err := m.Enumerate(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, alert := range myAlerts {
+		expected := ""
+		if alert.AlertType == 9001 {
+			expected = "TEST_VOLUME_DOWN"
+		}
+		if alert.ReasonCode != expected {
+			t.Fatal("alert type", alert.AlertType, "expected reason code", expected, "found:", alert.ReasonCode)
+		}
+	}
+}
+
+// TestComponents tests if components own their block of alert types and
+// registrations colliding with another component fail.
+func TestComponents(t *testing.T) {
+	defer func() {
+		reasonsLock.Lock()
+		defer reasonsLock.Unlock()
+		for _, alertType := range []int64{9101, 9102} {
+			delete(reasonCodes, reasons[alertType].Code)
+			delete(reasons, alertType)
+		}
+		delete(components, "test")
+		delete(componentsByBase, 9100)
+	}()
+	c, err := RegisterComponent("test", 9100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RegisterComponent("test", 9200); err == nil {
+		t.Fatal("expected an error registering a component twice")
+	}
+	if _, err := RegisterComponent("other", 9100); err == nil {
+		t.Fatal("expected an error registering a block twice")
+	}
+	if _, err := RegisterComponent("other", 9150); err == nil {
+		t.Fatal("expected an error registering a base not a multiple of the block size")
+	}
+	if alertType := c.AlertType(1); alertType != 9101 {
+		t.Fatal("expected alert type 9101, found:", alertType)
+	}
+	if err := c.RegisterReasons(
+		Reason{AlertType: c.AlertType(1), Code: "TEST_COMPONENT_DOWN"},
+		Reason{AlertType: c.AlertType(2), Code: "TEST_COMPONENT_UP"},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RegisterReasons(Reason{AlertType: 9201, Code: "TEST_COMPONENT_OUTSIDE"}); err == nil {
+		t.Fatal("expected an error registering an alert type outside of the component")
+	}
+	if err := RegisterReasons(Reason{AlertType: 9103, Code: "TEST_COMPONENT_STOLEN"}); err == nil {
+		t.Fatal("expected an error registering an alert type of the component outside of it")
+	}
+
+	rs := ComponentReasons("test")
+	if len(rs) != 2 || rs[0].AlertType != 9101 || rs[1].AlertType != 9102 {
+		t.Fatal("expected the reasons of the component, found:", rs)
+	}
+	if rs[0].Component != "test" {
+		t.Fatal("expected the reason to be owned by the component, found:", rs[0].Component)
+	}
+	found := false
+	for _, component := range Components() {
+		if component.Name == "test" {
+			found = component.Base == 9100
+		}
+	}
+	if !found {
+		t.Fatal("expected the component to be listed")
+	}
+}
+
+// TestManager_AlertmanagerNotifier tests if raised alerts are posted to Alertmanager, posted
+// again while raised, and posted as resolved once cleared.
+func TestManager_AlertmanagerNotifier(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan []map[string]interface{}, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/alerts" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var postings []map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&postings); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- postings
+	}))
+	defer ts.Close()
+
+	n, err := NewAlertmanagerNotifier(&AlertmanagerConfig{
+		URL:            ts.URL + "/",
+		ResendInterval: 50 * time.Millisecond,
+		Labels:         map[string]string{"cluster": "east"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.AddNotifier(n); err != nil {
+		t.Fatal(err)
+	}
+	defer m.RemoveNotifier(n.Name())
+
+	next := func() map[string]interface{} {
+		select {
+		case postings := <-received:
+			if len(postings) != 1 {
+				t.Fatal("expected 1 alert to be posted, found:", len(postings))
+			}
+			return postings[0]
+		case <-time.After(5 * time.Second):
+			t.Fatal("no alert posted to Alertmanager")
+		}
+		return nil
+	}
+
+	alert := &api.Alert{
+		AlertType:  10,
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol",
+		Severity:   api.SeverityType_SEVERITY_TYPE_ALARM,
+		Message:    "volume vol is down",
+	}
+	if err := m.Raise(alert); err != nil {
+		t.Fatal(err)
+	}
+	posted := next()
+	labels := posted["labels"].(map[string]interface{})
+	if labels["alertname"] != "OPENSTORAGE_ALERT_10" || labels["resource_type"] != "volume" ||
+		labels["resource_id"] != "vol" || labels["severity"] != "alarm" || labels["cluster"] != "east" {
+		t.Fatal("unexpected labels:", labels)
+	}
+	if posted["annotations"].(map[string]interface{})["summary"] != alert.Message {
+		t.Fatal("unexpected annotations:", posted["annotations"])
+	}
+	ends, err := time.Parse(time.RFC3339, posted["endsAt"].(string))
+	if err != nil || !ends.After(time.Now()) {
+		t.Fatal("expected a raised alert to end in the future, found:", posted["endsAt"])
+	}
+
+	// the raised alert is posted again
+	next()
+
+	// and resolved once cleared
+	if err := m.Clear(ID(alert)); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.After(5 * time.Second)
+	for {
+		var postings []map[string]interface{}
+		select {
+		case postings = <-received:
+		case <-deadline:
+			t.Fatal("the cleared alert was not resolved")
+		}
+		ends, err := time.Parse(time.RFC3339, postings[0]["endsAt"].(string))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ends.After(time.Now()) {
+			break
+		}
+	}
+}
+
+// TestManager_Silences tests if silenced alerts are stored but skipped by the notifiers and
+// the enumerations without the include silenced option.
+func TestManager_Silences(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan string, 10)
+	if err := m.AddNotifier(&testNotifier{name: "test", received: received}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.AddSilence(&Silence{EndsAt: time.Now().Add(-time.Minute)}); err == nil {
+		t.Fatal("expected an error adding a silence ended in the past")
+	}
+	id, err := m.AddSilence(&Silence{
+		ResourceType:      api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceIDPattern: "pvc-*",
+		EndsAt:            time.Now().Add(time.Hour),
+		Comment:           "maintenance",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	silences, err := m.EnumerateSilences()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(silences) != 1 || silences[0].ID != id || silences[0].Comment != "maintenance" {
+		t.Fatal("unexpected silences:", silences)
+	}
+
+	for _, resourceID := range []string{"pvc-1", "vol-1"} {
+		if err := m.Raise(&api.Alert{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: resourceID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case resourceID := <-received:
+		if resourceID != "vol-1" {
+			t.Fatal("expected only vol-1 to be notified, found:", resourceID)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("alert vol-1 was not notified")
+	}
+
+	myAlerts, err := m.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].ResourceId != "vol-1" {
+		t.Fatal("expected only vol-1 to be enumerated, found:", myAlerts)
+	}
+	myAlerts, _, err = m.EnumerateWithOptions([]Option{NewIncludeSilencedOption()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 2 {
+		t.Fatal("alerts: expected: 2, found:", len(myAlerts))
+	}
+
+	// silenced alerts are deleted too
+	if err := m.Delete(NewMatchResourceIDGlobFilter("pvc-*")); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.DeleteSilence(id); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.DeleteSilence(id); err == nil {
+		t.Fatal("expected an error deleting a silence twice")
+	}
+	myAlerts, err = m.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].ResourceId != "vol-1" {
+		t.Fatal("expected pvc-1 to be deleted, found:", myAlerts)
+	}
+}
+
+// testNotifier sends the resource ids of the alerts it is notified of.
+type testNotifier struct {
+	name     string
+	received chan string
+}
+
+func (n *testNotifier) Name() string {
+	return n.name
+}
+
+func (n *testNotifier) Notify(alert *api.Alert) error {
+	n.received <- alert.ResourceId
+	return nil
+}
+
+// TestManager_Payload tests if alerts carry their payload, payloads beyond the limits are
+// rejected and filters match payload keys.
+func TestManager_Payload(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Raise(&api.Alert{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_DRIVE,
+		ResourceId: "sdb", Payload: map[string]string{"device_path": "/dev/sdb", "io_errors": "12"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Raise(&api.Alert{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_DRIVE,
+		ResourceId: "sdc", Payload: map[string]string{"device_path": "/dev/sdc"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Raise(&api.Alert{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_DRIVE,
+		ResourceId: "sdd"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tooMany := make(map[string]string)
+	for i := 0; i <= MaxPayloadKeys; i++ {
+		tooMany["key"+strconv.Itoa(i)] = "value"
+	}
+	for _, payload := range []map[string]string{
+		tooMany,
+		{"large": strings.Repeat("x", MaxPayloadSize)},
+		{"bad key": "value"},
+		{strings.Repeat("k", MaxPayloadKeyLength+1): "value"},
+	} {
+		if err := m.Raise(&api.Alert{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_DRIVE,
+			ResourceId: "sde", Payload: payload}); err == nil {
+			t.Fatal("expected an error raising an alert with an invalid payload")
+		}
+	}
+
+	myAlerts, err := m.Enumerate(NewResourceIDFilter("sdb", 1, api.ResourceType_RESOURCE_TYPE_DRIVE))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].Payload["io_errors"] != "12" {
+		t.Fatal("expected the alert to carry its payload, found:", myAlerts)
+	}
+
+	myAlerts, err = m.Enumerate(NewMatchPayloadFilter("device_path"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 2 {
+		t.Fatal("expected 2 alerts with a device path, found:", len(myAlerts))
+	}
+
+	myAlerts, err = m.Enumerate(NewMatchPayloadFilter("device_path", "/dev/sdc", "/dev/sdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].ResourceId != "sdc" {
+		t.Fatal("expected the alert of /dev/sdc, found:", myAlerts)
+	}
+
+	myAlerts, err = m.Enumerate(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE,
+		NewPayloadOption("io_errors")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].ResourceId != "sdb" {
+		t.Fatal("expected the alert with io errors, found:", myAlerts)
+	}
+}
+
+// TestManager_Flapping tests if an alert raised and cleared too often is stored and delivered
+// once as flapping, until it is cleared rarely enough again.
+func TestManager_Flapping(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, option := range []Option{
+		NewFlappingOption(1, 0, 3, 1),
+		NewFlappingOption(1, time.Second, 3, 3),
+	} {
+		if _, err := NewManager(kv, option); err == nil {
+			t.Fatal("expected an error creating a manager with an invalid flapping detection")
+		}
+	}
+
+	window := 500 * time.Millisecond
+	m, err := NewManager(kv, NewFlappingOption(1, window, 3, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := make(chan string, 20)
+	if err := m.AddNotifier(&testNotifier{name: "test", received: received}); err != nil {
+		t.Fatal(err)
+	}
+	drain := func() int {
+		n := 0
+		for {
+			select {
+			case <-received:
+				n++
+			case <-time.After(100 * time.Millisecond):
+				return n
+			}
+		}
+	}
+	stored := func() *api.Alert {
+		myAlerts, err := m.Enumerate(NewResourceIDFilter("sdb", 1, api.ResourceType_RESOURCE_TYPE_DRIVE))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(myAlerts) != 1 {
+			t.Fatal("expected a single alert, found:", myAlerts)
+		}
+		return myAlerts[0]
+	}
+	newAlert := func() *api.Alert {
+		return &api.Alert{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_DRIVE, ResourceId: "sdb"}
+	}
+	id := ID(newAlert())
+
+	// the third clear within the window starts the flapping
+	for i := 0; i < 3; i++ {
+		if err := m.Raise(newAlert()); err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Clear(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := drain(); n != 6 {
+		t.Fatal("expected 5 notifications and the flapping one, found:", n)
+	}
+	if a := stored(); a.Cleared || a.Payload[FlappingPayloadKey] != "3" {
+		t.Fatal("expected the alert raised as flapping, found:", a)
+	}
+
+	// the raises and clears of a flapping alert are absorbed
+	for i := 0; i < 2; i++ {
+		if err := m.Raise(newAlert()); err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Clear(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := drain(); n != 0 {
+		t.Fatal("expected the flapping alert not to be notified again, found:", n)
+	}
+	if a := stored(); a.Cleared || a.Payload[FlappingPayloadKey] != "3" {
+		t.Fatal("expected the alert still raised as flapping, found:", a)
+	}
+
+	// once the clears left the window, the alert is stored as cleared last
+	time.Sleep(window)
+	if n := drain(); n != 1 {
+		t.Fatal("expected the alert which stopped flapping to be notified, found:", n)
+	}
+	if a := stored(); !a.Cleared || len(a.Payload[FlappingPayloadKey]) != 0 {
+		t.Fatal("expected the alert cleared and no longer flapping, found:", a)
+	}
+}
+
+// TestManager_CorrelationID tests if the correlation id of the request raising an alert is
+// kept in its payload.
+func TestManager_CorrelationID(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Raise(&api.Alert{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol1"}, NewCorrelationIDOption("abc")); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Raise(&api.Alert{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol2"}, NewCorrelationIDOption("")); err != nil {
+		t.Fatal(err)
+	}
+
+	myAlerts, err := m.Enumerate(NewMatchPayloadFilter(CorrelationIDPayloadKey, "abc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 || myAlerts[0].ResourceId != "vol1" {
+		t.Fatal("expected the alert raised with the correlation id, found:", myAlerts)
+	}
+
+	myAlerts, err = m.Enumerate(NewMatchPayloadFilter(CorrelationIDPayloadKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 1 {
+		t.Fatal("expected a single alert with a correlation id, found:", len(myAlerts))
+	}
+}
+
+// TestManager_RateLimit tests if the raises beyond the rate limit are coalesced into a single
+// write of the last alert, counting them and marked as rate limited.
+func TestManager_RateLimit(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv, NewRateLimitOption(2, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10; i++ {
+		if err := m.Raise(&api.Alert{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: "vol", Message: "raise " + strconv.Itoa(i)}, NewDedupeOption()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// another alert type has its own bucket
+	if err := m.Raise(&api.Alert{AlertType: 2, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol"}); err != nil {
+		t.Fatal(err)
+	}
+
+	myAlerts, err := m.Enumerate(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 2 {
+		t.Fatal("expected 2 alerts, found:", len(myAlerts))
+	}
+	for _, alert := range myAlerts {
+		if alert.AlertType == 1 && (alert.Count != 2 || alert.Message != "raise 1") {
+			t.Fatal("expected the raises beyond the burst to be coalesced, found:", alert)
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		myAlerts, err = m.Enumerate(NewAlertTypeFilter(1, api.ResourceType_RESOURCE_TYPE_VOLUME))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(myAlerts) == 1 && myAlerts[0].Count == 10 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the coalesced raises to be written, found:", myAlerts)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if myAlerts[0].Message != "raise 9" {
+		t.Fatal("expected the last alert raised to be written, found:", myAlerts[0].Message)
+	}
+	if myAlerts[0].Payload[RateLimitedPayloadKey] != "8" {
+		t.Fatal("expected the alert to be marked as rate limited, found:", myAlerts[0].Payload)
+	}
+}
+
+// TestManager_Routes tests if alerts are delivered to the notifiers of the first route
+// matching them, and previewed without being raised.
+func TestManager_Routes(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv, NewLabelsOption(
+		func(resourceType api.ResourceType, resourceID string) (map[string]string, error) {
+			if resourceID == "gold-vol" {
+				return map[string]string{"tier": "gold"}, nil
+			}
+			return nil, nil
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notifiers := make(map[string]*testNotifier)
+	for _, name := range []string{"pager", "email"} {
+		notifiers[name] = &testNotifier{name: name, received: make(chan string, 10)}
+		if err := m.AddNotifier(notifiers[name]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := m.SetRoutes(&Route{Name: "bad", MinSeverity: "urgent"}); err == nil {
+		t.Fatal("expected an error setting a route with an invalid severity")
+	}
+	if err := m.SetRoutes(
+		&Route{Name: "gold", ResourceTypes: []string{"volume"}, Labels: map[string]string{"tier": "gold"},
+			Notifiers: []string{"pager"}},
+		&Route{Name: "alarms", MinSeverity: "alarm", Notifiers: []string{"email", "pager"}},
+		&Route{Name: "nodes", ResourceTypes: []string{"node"}},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if routes := m.Routes(); len(routes) != 3 || routes[0].Name != "gold" {
+		t.Fatal("expected the routes in order, found:", routes)
+	}
+
+	for _, alert := range []*api.Alert{
+		{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "gold-vol",
+			Severity: api.SeverityType_SEVERITY_TYPE_ALARM},
+		{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "vol",
+			Severity: api.SeverityType_SEVERITY_TYPE_ALARM},
+		{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_NODE, ResourceId: "node"},
+		{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_DRIVE, ResourceId: "drive"},
+	} {
+		if err := m.Raise(alert); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := map[string][]string{
+		"pager": {"gold-vol", "vol", "drive"},
+		"email": {"vol", "drive"},
+	}
+	for name, ids := range expected {
+		for _, id := range ids {
+			select {
+			case received := <-notifiers[name].received:
+				if received != id {
+					t.Fatal(name, "expected alert of", id, "found:", received)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal(name, "expected alert of", id)
+			}
+		}
+		select {
+		case received := <-notifiers[name].received:
+			t.Fatal(name, "expected no more alerts, found:", received)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	preview, err := m.PreviewRoute(&api.Alert{AlertType: 2, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "gold-vol"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.Route != "gold" || len(preview.Notifiers) != 1 || preview.Notifiers[0] != "pager" {
+		t.Fatal("expected the gold route to the pager, found:", preview)
+	}
+	preview, err = m.PreviewRoute(&api.Alert{AlertType: 2, Resource: api.ResourceType_RESOURCE_TYPE_NODE,
+		ResourceId: "node"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.Route != "nodes" || len(preview.Notifiers) != 0 {
+		t.Fatal("expected the nodes route dropping the alert, found:", preview)
+	}
+	myAlerts, err := m.Enumerate(NewAlertTypeFilter(2, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(myAlerts) != 0 {
+		t.Fatal("expected the previewed alert not to be raised, found:", myAlerts)
+	}
+}
+
+func TestManager_MemStore(t *testing.T) {
+	m, err := NewManagerWithStore(NewMemStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, stop, err := m.Watch(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stop()
+
+	for _, alert := range []*api.Alert{
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "inca"},
+		{AlertType: 12, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "maya"},
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_NODE, ResourceId: "inca"},
+	} {
+		if err := m.Raise(alert); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, id := range []string{"inca", "maya"} {
+		select {
+		case event := <-events:
+			if event.Action != AlertRaised || event.Alert.GetResourceId() != id {
+				t.Fatal("unexpected event:", event.Action, event.Alert)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected the raise of", id)
+		}
+	}
+
+	alerts, err := m.Enumerate(NewAlertTypeFilter(10, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 || alerts[0].GetResourceId() != "inca" {
+		t.Fatal("unexpected alerts:", alerts)
+	}
+	if alerts, err = m.Enumerate(); err != nil {
+		t.Fatal(err)
+	} else if len(alerts) != 3 {
+		t.Fatal("expected 3 alerts, found:", len(alerts))
+	}
+
+	if err := m.Delete(NewResourceIDFilter("maya", 12, api.ResourceType_RESOURCE_TYPE_VOLUME)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case event := <-events:
+		if event.Action != AlertDeleted || event.Alert.GetResourceId() != "maya" {
+			t.Fatal("unexpected event:", event.Action, event.Alert)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the deletion of maya")
+	}
+	if err := m.Delete(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_NODE)); err != nil {
+		t.Fatal(err)
+	}
+	if alerts, err = m.Enumerate(); err != nil {
+		t.Fatal(err)
+	} else if len(alerts) != 1 {
+		t.Fatal("expected 1 alert, found:", len(alerts))
+	}
+
+	id, err := m.AddSilence(&Silence{EndsAt: time.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if silences, err := m.EnumerateSilences(); err != nil {
+		t.Fatal(err)
+	} else if len(silences) != 1 || silences[0].ID != id {
+		t.Fatal("unexpected silences:", silences)
+	}
+	if err := m.DeleteSilence(id); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.DeleteSilence(id); err == nil {
+		t.Fatal("expected an error deleting a deleted silence")
+	}
+}
+
+func TestSQLWhere(t *testing.T) {
+	now := time.Unix(1000, 0)
+	testCases := []struct {
+		name     string
+		dialect  SQLDialect
+		query    Query
+		now      time.Time
+		expected string
+		args     []interface{}
+	}{
+		{
+			name:     "all",
+			query:    Query{},
+			expected: "SELECT data FROM alerts",
+		},
+		{
+			name:     "unexpired",
+			query:    Query{},
+			now:      now,
+			expected: "SELECT data FROM alerts WHERE (expires_at = 0 OR expires_at > ?)",
+			args:     []interface{}{int64(1000)},
+		},
+		{
+			name:     "resource type",
+			query:    Query{Level: QueryResourceType, ResourceType: api.ResourceType_RESOURCE_TYPE_VOLUME},
+			expected: "SELECT data FROM alerts WHERE resource_type = ?",
+			args:     []interface{}{"RESOURCE_TYPE_VOLUME"},
+		},
+		{
+			name:     "namespace",
+			query:    Query{Namespace: "tenant-a"},
+			expected: "SELECT data FROM alerts WHERE resource_type LIKE ?",
+			args:     []interface{}{"tenant-a/%"},
+		},
+		{
+			name:     "unexpired namespace",
+			dialect:  SQLDollar,
+			query:    Query{Namespace: "tenant-a"},
+			now:      now,
+			expected: "SELECT data FROM alerts WHERE (expires_at = 0 OR expires_at > $1) AND resource_type LIKE $2",
+			args:     []interface{}{int64(1000), "tenant-a/%"},
+		},
+		{
+			name:     "namespace resource type",
+			query:    Query{Level: QueryResourceType, Namespace: "tenant-a", ResourceType: api.ResourceType_RESOURCE_TYPE_VOLUME},
+			expected: "SELECT data FROM alerts WHERE resource_type = ?",
+			args:     []interface{}{"tenant-a/RESOURCE_TYPE_VOLUME"},
+		},
+		{
+			name:    "resource",
+			dialect: SQLDollar,
+			query: Query{Level: QueryResource, ResourceType: api.ResourceType_RESOURCE_TYPE_NODE,
+				AlertType: 12, ResourceID: "inca"},
+			now: now,
+			expected: "SELECT data FROM alerts WHERE (expires_at = 0 OR expires_at > $1) AND " +
+				"resource_type = $2 AND alert_type = $3 AND resource_id = $4",
+			args: []interface{}{int64(1000), "RESOURCE_TYPE_NODE", int64(12), "inca"},
+		},
+	}
+	for _, tc := range testCases {
+		stmt := &sqlStatement{dialect: tc.dialect}
+		stmt.add("SELECT data FROM alerts")
+		sqlWhere(stmt, tc.query, tc.now)
+		if stmt.String() != tc.expected {
+			t.Fatal(tc.name, ": unexpected statement:", stmt.String())
+		}
+		if !reflect.DeepEqual(stmt.args, tc.args) {
+			t.Fatal(tc.name, ": unexpected args:", stmt.args)
+		}
+	}
+
+	// the filters of an enumeration translate into the queries
+	keys, err := getUniqueKeysFromFilters(NewAlertTypeFilter(12, api.ResourceType_RESOURCE_TYPE_DRIVE))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key := range keys {
+		q, err := queryOf(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.Level != QueryAlertType || q.ResourceType != api.ResourceType_RESOURCE_TYPE_DRIVE || q.AlertType != 12 {
+			t.Fatal("unexpected query of", key, ":", q)
+		}
+		if q.key() != key {
+			t.Fatal("expected the key of the query", key, "found:", q.key())
+		}
+	}
+}
+
+func TestManager_MaintenanceWindows(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan string, 10)
+	if err := m.AddNotifier(&testNotifier{name: "test", received: received}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, w := range []*MaintenanceWindow{
+		{EndsAt: time.Now().Add(-time.Minute)},
+		{Schedule: "0 25 * * *", Duration: time.Hour},
+		{Schedule: "0 2 * * 6"},
+	} {
+		if _, err := m.AddMaintenanceWindow(w); err == nil {
+			t.Fatal("expected an error adding the maintenance window:", w)
+		}
+	}
+	id, err := m.AddMaintenanceWindow(&MaintenanceWindow{
+		Name:              "upgrade",
+		ResourceType:      api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceIDPattern: "pvc-*",
+		EndsAt:            time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	windows, err := m.EnumerateMaintenanceWindows()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(windows) != 1 || windows[0].ID != id || windows[0].Name != "upgrade" {
+		t.Fatal("unexpected maintenance windows:", windows)
+	}
+
+	for _, resourceID := range []string{"pvc-1", "vol-1"} {
+		if err := m.Raise(&api.Alert{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: resourceID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case resourceID := <-received:
+		if resourceID != "vol-1" {
+			t.Fatal("expected the notification of vol-1 only, received:", resourceID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the notification of vol-1")
+	}
+	// the alerts raised during the window are recorded
+	if alerts, err := m.Enumerate(); err != nil {
+		t.Fatal(err)
+	} else if len(alerts) != 2 {
+		t.Fatal("expected 2 alerts, found:", len(alerts))
+	}
+
+	if err := m.DeleteMaintenanceWindow(id); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.DeleteMaintenanceWindow(id); err == nil {
+		t.Fatal("expected an error deleting a deleted maintenance window")
+	}
+
+	// saturdays from 02:00 to 04:00 UTC
+	w := &MaintenanceWindow{Schedule: "0 2 * * 6", Duration: 2 * time.Hour}
+	for at, open := range map[string]bool{
+		"2026-10-17T01:59:00Z": false,
+		"2026-10-17T02:00:00Z": true,
+		"2026-10-17T03:59:59Z": true,
+		"2026-10-17T04:00:00Z": false,
+		"2026-10-16T03:00:00Z": false,
+	} {
+		now, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w.Open(now) != open {
+			t.Fatal("expected the window open", open, "at", at)
+		}
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		if _, err := parseSchedule(expr); err == nil {
+			t.Fatal("expected an error parsing", expr)
+		}
+	}
+	s, err := parseSchedule("*/15 9-17 * 1,6 1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for at, match := range map[string]bool{
+		"2026-06-01T09:15:00Z": true,
+		"2026-06-01T09:10:00Z": false,
+		"2026-06-01T18:00:00Z": false,
+		"2026-06-06T09:15:00Z": false,
+		"2026-07-01T09:15:00Z": false,
+	} {
+		now, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.matches(now) != match {
+			t.Fatal("expected", match, "matching at", at)
+		}
+	}
+	// the day of the month or the day of the week matches when both are set
+	if s, err = parseSchedule("0 0 13 * 5"); err != nil {
+		t.Fatal(err)
+	}
+	for _, at := range []string{"2026-10-13T00:00:00Z", "2026-10-16T00:00:00Z"} {
+		now, _ := time.Parse(time.RFC3339, at)
+		if !s.matches(now) {
+			t.Fatal("expected matching at", at)
+		}
+	}
+}
+
+func TestManager_Cache(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// other writes the alerts as another node would
+	other, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Raise(&api.Alert{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "inca", Count: 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv, NewCacheOption())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.(*manager).store.(*cacheStore); !ok {
+		t.Fatal("expected the manager to read the cache")
+	}
+	if err := m.Raise(&api.Alert{AlertType: 12, Resource: api.ResourceType_RESOURCE_TYPE_DRIVE,
+		ResourceId: "maya", Count: 1}); err != nil {
+		t.Fatal(err)
+	}
+	alerts, err := m.Enumerate(NewCountSpanFilter(2, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 || alerts[0].GetResourceId() != "inca" {
+		t.Fatal("unexpected alerts:", alerts)
+	}
+
+	// the changes of the other nodes are delivered by the watch
+	waitFor := func(n int) {
+		for i := 0; i < 100; i++ {
+			if alerts, err := m.Enumerate(); err != nil {
+				t.Fatal(err)
+			} else if len(alerts) == n {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatal("expected", n, "cached alerts")
+	}
+	if err := other.Raise(&api.Alert{AlertType: 12, Resource: api.ResourceType_RESOURCE_TYPE_NODE,
+		ResourceId: "node"}); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(3)
+	if err := other.Delete(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME)); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(2)
+
+	// the writes of the manager are cached at once
+	if err := m.Delete(NewResourceIDFilter("maya", 12, api.ResourceType_RESOURCE_TYPE_DRIVE)); err != nil {
+		t.Fatal(err)
+	}
+	if alerts, err := m.Enumerate(); err != nil {
+		t.Fatal(err)
+	} else if len(alerts) != 1 || alerts[0].GetResourceId() != "node" {
+		t.Fatal("unexpected alerts:", alerts)
+	}
+
+	// the cache loads again once its watch ends
+	cache := m.(*manager).store.(*cacheStore)
+	cache.lock.Lock()
+	generation := cache.generation
+	cache.lock.Unlock()
+	cache.apply(generation, AlertDeleted, nil, errors.New("watch ended"))
+	if err := other.Raise(&api.Alert{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "inca"}); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(2)
+}
+
+func TestManager_Search(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plain, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cached, err := NewManager(kv, NewCacheOption())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, alert := range []*api.Alert{
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_DRIVE, ResourceId: "sdb",
+			Message: "Device /dev/sdb FAILED health check"},
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_DRIVE, ResourceId: "sdc",
+			Message: "Device failed", Payload: map[string]string{"device_path": "/dev/sdc"}},
+		{AlertType: 11, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "vol",
+			Message: "Volume degraded on /dev/sdb"},
+	} {
+		if err := cached.Raise(alert); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		filters  []Filter
+		expected []string
+	}{
+		{[]Filter{NewSearchFilter("sdb fail")}, []string{"sdb"}},
+		{[]Filter{NewSearchFilter("DEV")}, []string{"sdb", "sdc", "vol"}},
+		{[]Filter{NewSearchFilter("sdc")}, []string{"sdc"}},
+		{[]Filter{NewSearchFilter("missing")}, nil},
+		{[]Filter{NewAndFilter(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME),
+			NewSearchFilter("sdb"))}, []string{"vol"}},
+		{[]Filter{NewSearchFilter("failed"), NewSearchFilter("degraded")}, []string{"sdb", "sdc", "vol"}},
+	}
+	for _, tc := range testCases {
+		for _, m := range []Manager{plain, cached} {
+			alerts, err := m.Enumerate(tc.filters...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var found []string
+			for _, alert := range alerts {
+				found = append(found, alert.GetResourceId())
+			}
+			if !reflect.DeepEqual(found, tc.expected) {
+				t.Fatal("expected", tc.expected, "found:", found)
+			}
+		}
+	}
+
+	// the terms of all the filters are pushed down to the store
+	if terms := commonTerms(NewSearchFilter("sdb fail"),
+		NewAndFilter(NewSearchFilter("fail"), NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE))); !reflect.DeepEqual(terms, []string{"fail"}) {
+		t.Fatal("unexpected common terms:", terms)
+	}
+	if terms := commonTerms(NewSearchFilter("sdb"), NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE)); terms != nil {
+		t.Fatal("expected no common terms, found:", terms)
+	}
+}
+
+func TestManager_OpenEndedTimeSpan(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	for i, age := range []time.Duration{0, 2 * time.Hour, 48 * time.Hour} {
+		if err := m.Raise(&api.Alert{AlertType: int64(i), Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: "inca", Timestamp: &timestamp.Timestamp{Seconds: now.Add(-age).Unix()}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		filter   Filter
+		expected int
+	}{
+		{"last hour", NewSinceFilter(now.Add(-time.Hour)), 1},
+		{"until yesterday", NewUntilFilter(now.Add(-24 * time.Hour)), 1},
+		{"unbounded", NewTimeSpanFilter(time.Time{}, time.Time{}), 3},
+		{"since option", NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME,
+			NewTimeSpanOption(now.Add(-3*time.Hour), time.Time{})), 2},
+	}
+	for _, tc := range testCases {
+		alerts, err := m.Enumerate(tc.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(alerts) != tc.expected {
+			t.Fatal(tc.name, ": expected", tc.expected, "alerts, found:", len(alerts))
+		}
+	}
+}
+
+func TestManager_CountRange(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, count := range []int64{1, 10, 11, 500} {
+		if err := m.Raise(&api.Alert{AlertType: int64(i), Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: "inca", Count: count}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		filter   Filter
+		expected int
+	}{
+		{"more than 10 times", NewCountRangeFilter(11, -1), 2},
+		{"at most 10 times", NewCountRangeFilter(0, 10), 2},
+		{"exactly 10 times", NewCountRangeFilter(10, 10), 1},
+		{"empty range", NewCountRangeFilter(12, 11), 0},
+		{"option", NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME,
+			NewCountSpanOption(10, -1)), 3},
+	}
+	for _, tc := range testCases {
+		alerts, err := m.Enumerate(tc.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(alerts) != tc.expected {
+			t.Fatal(tc.name, ": expected", tc.expected, "alerts, found:", len(alerts))
+		}
+	}
+}
+
+// sourceFunc is a Source of the alerts it returns.
+type sourceFunc func() ([]*api.Alert, error)
+
+func (f sourceFunc) Enumerate() ([]*api.Alert, error) {
+	return f()
+}
+
+func TestFederation(t *testing.T) {
+	f := NewFederation(nil)
+	for _, id := range []string{"west", "east"} {
+		if err := f.AddCluster(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.AddCluster("east"); err == nil {
+		t.Fatal("expected an error federating a cluster twice")
+	}
+	if clusters := f.Clusters(); !reflect.DeepEqual(clusters, []string{"east", "west"}) {
+		t.Fatal("unexpected clusters:", clusters)
+	}
+
+	events, stop, err := f.Watch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stop()
+
+	// west pushes its alerts
+	if err := f.Receive("west", &api.Alert{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "inca", Count: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Receive("north", &api.Alert{}); err == nil {
+		t.Fatal("expected an error receiving the alerts of an unknown cluster")
+	}
+	select {
+	case event := <-events:
+		if event.Action != AlertRaised || event.Alert.Payload[ClusterIDPayloadKey] != "west" {
+			t.Fatal("unexpected event:", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+
+	// east is pulled
+	pulled := make(chan struct{}, 1)
+	source := sourceFunc(func() ([]*api.Alert, error) {
+		defer func() { pulled <- struct{}{} }()
+		return []*api.Alert{
+			{AlertType: 1, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "inca", Count: 1},
+			{AlertType: 2, Resource: api.ResourceType_RESOURCE_TYPE_NODE, ResourceId: "maya", Count: 7},
+		}, nil
+	})
+	stopPull, err := f.Pull("east", source, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-pulled
+	stopPull()
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-events:
+			if event.Alert.Payload[ClusterIDPayloadKey] != "east" {
+				t.Fatal("unexpected event:", event)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the event")
+		}
+	}
+
+	alerts, err := f.Enumerate(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 2 || alerts[0].Payload[ClusterIDPayloadKey] != "east" ||
+		alerts[1].Payload[ClusterIDPayloadKey] != "west" {
+		t.Fatal("unexpected alerts:", alerts)
+	}
+
+	// the pages span the clusters
+	alerts, token, err := f.EnumerateWithOptions([]Option{NewMaxResultsOption(2), NewSortOption(SortByCount, true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 2 || alerts[0].Count != 7 || alerts[1].Count != 3 || len(token) == 0 {
+		t.Fatal("unexpected first page:", alerts)
+	}
+	alerts, token, err = f.EnumerateWithOptions([]Option{NewMaxResultsOption(2), NewSortOption(SortByCount, true),
+		NewContinuationTokenOption(token)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 || alerts[0].Count != 1 || len(token) != 0 {
+		t.Fatal("unexpected last page:", alerts)
+	}
+
+	// the west pushes through the handler
+	ts := httptest.NewServer(f.Handler())
+	defer ts.Close()
+	body, err := (&jsonpb.Marshaler{}).MarshalToString(&api.Alert{AlertType: 3,
+		Resource: api.ResourceType_RESOURCE_TYPE_DRIVE, ResourceId: "sda"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		cluster  string
+		expected int
+	}{
+		{"west", http.StatusNoContent},
+		{"north", http.StatusNotFound},
+		{"", http.StatusBadRequest},
+	} {
+		req, err := http.NewRequest(http.MethodPost, ts.URL, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set(ClusterIDHeader, tc.cluster)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tc.expected {
+			t.Fatal(tc.cluster, ": expected status", tc.expected, "found:", resp.StatusCode)
+		}
+	}
+	if alerts, err := f.Enumerate(NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_DRIVE)); err != nil {
+		t.Fatal(err)
+	} else if len(alerts) != 1 || alerts[0].Payload[ClusterIDPayloadKey] != "west" {
+		t.Fatal("unexpected alerts:", alerts)
+	}
+
+	// removing a cluster drops its alerts
+	if err := f.RemoveCluster("west"); err != nil {
+		t.Fatal(err)
+	}
+	if alerts, err := f.Enumerate(); err != nil {
+		t.Fatal(err)
+	} else if len(alerts) != 2 {
+		t.Fatal("expected the alerts of east only, found:", alerts)
+	}
+}
+
+// TestManager_Escalations tests if the alerts left unacknowledged or raised too many times are
+// escalated once, and if the rules are read anew on every evaluation.
+func TestManager_Escalations(t *testing.T) {
+	m, err := newManager(NewMemStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := make(chan string, 10)
+	if err := m.AddNotifier(&testNotifier{name: "pager", received: received},
+		NewAlertTypeFilter(1000, api.ResourceType_RESOURCE_TYPE_NODE)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range []*EscalationRule{
+		{AlertType: 10, After: time.Minute, EscalateTo: 11},
+		{Name: "noop", AlertType: 10, EscalateTo: 11},
+		{Name: "nowhere", AlertType: 10, After: time.Minute},
+		{Name: "self", AlertType: 10, After: time.Minute, EscalateTo: 10},
+	} {
+		if err := m.AddEscalationRule(r); err == nil {
+			t.Fatal("expected an error adding the escalation rule:", r)
+		}
+	}
+	if err := m.AddEscalationRule(&EscalationRule{
+		Name:         "stale",
+		ResourceType: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		AlertType:    10,
+		After:        time.Hour,
+		EscalateTo:   11,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	past := &timestamp.Timestamp{Seconds: now.Add(-2 * time.Hour).Unix()}
+	for _, alert := range []*api.Alert{
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "stale", Timestamp: past},
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "acked", Timestamp: past,
+			Acknowledged: true},
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "recent"},
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_NODE, ResourceId: "node", Timestamp: past},
+	} {
+		if err := m.Raise(alert); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		n, err := m.escalate(now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := 1 - i; n != expected {
+			t.Fatalf("expected %d escalations on evaluation %d, found %d", expected, i, n)
+		}
+	}
+	escalated, err := m.Enumerate(NewAlertTypeFilter(11, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(escalated) != 1 || escalated[0].ResourceId != "stale" ||
+		escalated[0].Severity != api.SeverityType_SEVERITY_TYPE_ALARM ||
+		escalated[0].Payload[EscalatedPayloadKey] != "RESOURCE_TYPE_VOLUME/a/stale" ||
+		escalated[0].Payload[EscalationRulePayloadKey] != "stale" {
+		t.Fatal("unexpected escalated alerts:", escalated)
+	}
+
+	// a rule added later applies on the next evaluation, the notifier receiving the
+	// escalated alert regardless of its filters
+	if err := m.AddEscalationRule(&EscalationRule{
+		Name:      "flapping",
+		AlertType: 10,
+		MaxCount:  2,
+		Notifiers: []string{"pager", "unknown"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := m.Raise(&api.Alert{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_NODE,
+			ResourceId: "node"}, NewDedupeOption()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, err := m.escalate(now); err != nil {
+		t.Fatal(err)
+	} else if n != 1 {
+		t.Fatal("expected 1 escalation, found:", n)
+	}
+	select {
+	case resourceID := <-received:
+		if resourceID != "node" {
+			t.Fatal("expected the escalation of node, received:", resourceID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the escalation of node")
+	}
+
+	rules, err := m.EnumerateEscalationRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 || rules[0].Name != "flapping" || rules[1].Name != "stale" {
+		t.Fatal("unexpected escalation rules:", rules)
+	}
+	if err := m.DeleteEscalationRule("stale"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.DeleteEscalationRule("stale"); err == nil {
+		t.Fatal("expected an error deleting a deleted escalation rule")
+	}
+}
+
+// TestManager_ExportImport tests if the exported alerts are imported unchanged into another
+// manager, and if invalid snapshots are rejected.
+func TestManager_ExportImport(t *testing.T) {
+	m, err := newManager(NewMemStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, resourceID := range []string{"vol-1", "vol-2"} {
+		if err := m.Raise(&api.Alert{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: resourceID, Payload: map[string]string{"device_path": "/dev/" + resourceID}},
+			NewDedupeOption()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.Raise(&api.Alert{AlertType: 20, Resource: api.ResourceType_RESOURCE_TYPE_NODE,
+		ResourceId: "node-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.AddSilence(&Silence{ResourceType: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceIDPattern: "vol-2", EndsAt: time.Now().Add(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := m.Export(&buf, NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME)); err != nil {
+		t.Fatal(err)
+	}
+
+	lab, err := newManager(NewMemStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lab.Import(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	exported, _, err := m.EnumerateWithOptions([]Option{NewIncludeSilencedOption()},
+		NewResourceTypeFilter(api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := lab.Enumerate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exported) != 2 || !reflect.DeepEqual(exported, imported) {
+		t.Fatal("expected the silenced volume alerts imported unchanged, found:", imported)
+	}
+
+	for _, snapshot := range []string{
+		"alerts",
+		`{"version": 2, "alerts": []}`,
+		`{"version": 1, "alerts": [{"resource_id": "vol-3", "payload": {"1": "invalid"}}]}`,
+	} {
+		if err := lab.Import(strings.NewReader(snapshot)); err == nil {
+			t.Fatal("expected an error importing:", snapshot)
+		}
+	}
+	if alerts, err := lab.Enumerate(); err != nil {
+		t.Fatal(err)
+	} else if len(alerts) != 2 {
+		t.Fatal("expected no alert imported from invalid snapshots, found:", alerts)
+	}
+}
+
+// failingNotifier fails to deliver the alerts.
+type failingNotifier struct {
+	testNotifier
+}
+
+func (n *failingNotifier) Notify(alert *api.Alert) error {
+	n.testNotifier.Notify(alert)
+	return errors.New("unreachable")
+}
+
+// TestManager_Metrics tests if the metrics count the raised and active alerts and the
+// notifier failures.
+func TestManager_Metrics(t *testing.T) {
+	m, err := newManager(NewMemStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := &failingNotifier{testNotifier{name: "failing", received: make(chan string, 10)}}
+	if err := m.AddNotifier(n); err != nil {
+		t.Fatal(err)
+	}
+	for _, alert := range []*api.Alert{
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "vol-1",
+			Severity: api.SeverityType_SEVERITY_TYPE_ALARM},
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "vol-2",
+			Severity: api.SeverityType_SEVERITY_TYPE_ALARM},
+		{AlertType: 20, Resource: api.ResourceType_RESOURCE_TYPE_NODE, ResourceId: "node-1",
+			Severity: api.SeverityType_SEVERITY_TYPE_WARNING, Cleared: true},
+	} {
+		if err := m.Raise(alert); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		<-n.received
+	}
+	if _, err := m.Enumerate(); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(m.Collector()); err != nil {
+		t.Fatal(err)
+	}
+	// the failures are counted once Notify returns
+	var families map[string]*dto.MetricFamily
+	for i := 0; i < 100; i++ {
+		gathered, err := registry.Gather()
+		if err != nil {
+			t.Fatal(err)
+		}
+		families = make(map[string]*dto.MetricFamily)
+		for _, family := range gathered {
+			families[family.GetName()] = family
+		}
+		if f := families["openstorage_alerts_notifier_failures_total"]; f != nil &&
+			f.GetMetric()[0].GetCounter().GetValue() == 3 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	values := func(name string) map[string]float64 {
+		v := make(map[string]float64)
+		family, ok := families[name]
+		if !ok {
+			t.Fatal("expected metric", name)
+		}
+		for _, metric := range family.GetMetric() {
+			var labels []string
+			for _, label := range metric.GetLabel() {
+				labels = append(labels, label.GetValue())
+			}
+			key := strings.Join(labels, ",")
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				v[key] = metric.GetCounter().GetValue()
+			case dto.MetricType_GAUGE:
+				v[key] = metric.GetGauge().GetValue()
+			case dto.MetricType_HISTOGRAM:
+				v[key] = float64(metric.GetHistogram().GetSampleCount())
+			}
+		}
+		return v
+	}
+	if v := values("openstorage_alerts_raised_total"); !reflect.DeepEqual(v, map[string]float64{
+		"10,volume,alarm": 2, "20,node,warning": 1}) {
+		t.Fatal("unexpected raised alerts:", v)
+	}
+	if v := values("openstorage_alerts_active"); !reflect.DeepEqual(v, map[string]float64{
+		"volume,alarm": 2}) {
+		t.Fatal("unexpected active alerts:", v)
+	}
+	if v := values("openstorage_alerts_notifier_failures_total"); !reflect.DeepEqual(v, map[string]float64{
+		"failing": 3}) {
+		t.Fatal("unexpected notifier failures:", v)
+	}
+	if v := values("openstorage_alerts_store_enumerate_seconds"); v[""] < 1 {
+		t.Fatal("expected the enumerations timed, found:", v)
+	}
+}
+
+func TestManager_ExpressionFilter(t *testing.T) {
+	kv, err := newInMemKvdb()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, alert := range []*api.Alert{
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "inca",
+			Severity: api.SeverityType_SEVERITY_TYPE_ALARM, Count: 6, Message: "Volume degraded"},
+		{AlertType: 11, Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, ResourceId: "maya",
+			Severity: api.SeverityType_SEVERITY_TYPE_WARNING, Count: 2,
+			Payload: map[string]string{"zone": "east"}},
+		{AlertType: 10, Resource: api.ResourceType_RESOURCE_TYPE_DRIVE, ResourceId: "sdb",
+			Severity: api.SeverityType_SEVERITY_TYPE_ALARM, Count: 8, Message: "Device /dev/sdb failed"},
+	} {
+		if err := m.Raise(alert); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		expression string
+		expected   []string
+	}{
+		{"alert.Count > 5 && alert.Resource == 'VOLUME'", []string{"inca"}},
+		{"alert.count > 5 && alert.resource == 'RESOURCE_TYPE_VOLUME'", []string{"inca"}},
+		{"'ALARM' == alert.Severity", []string{"inca", "sdb"}},
+		{"alert.Payload['zone'] == \"east\"", []string{"maya"}},
+		{"alert.Message =~ '^Device .*failed$'", []string{"sdb"}},
+		{"!(alert.ResourceId == 'inca' || alert.resource_id == 'sdb')", []string{"maya"}},
+		{"alert.AlertType == 10 && !alert.Cleared && alert.Message !~ 'Device'", []string{"inca"}},
+		{"alert.Count >= 2 && alert.Count <= 6 && alert.Severity != 'alarm'", []string{"maya"}},
+	}
+	for _, tc := range testCases {
+		e, err := CompileExpression(tc.expression)
+		if err != nil {
+			t.Fatal(tc.expression, ":", err)
+		}
+		if e.String() != tc.expression {
+			t.Fatal("expected", tc.expression, "found:", e.String())
+		}
+		alerts, err := m.Enumerate(NewExpressionFilter(e))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var found []string
+		for _, alert := range alerts {
+			found = append(found, alert.GetResourceId())
+		}
+		sort.Strings(found)
+		if !reflect.DeepEqual(found, tc.expected) {
+			t.Fatal(tc.expression, ": expected", tc.expected, "found:", found)
+		}
+	}
+
+	for _, expression := range []string{
+		"",
+		"alert.Missing == 1",
+		"alert.Resource == 'DISK'",
+		"alert.Count == 'five'",
+		"alert.Count",
+		"alert.Message == 'unterminated",
+		"alert.Message =~ '('",
+		"alert.Count > 5 &&",
+		"(alert.Count > 5",
+	} {
+		if _, err := CompileExpression(expression); err == nil {
+			t.Fatal("expected an error compiling", expression)
+		}
+	}
+}
+
 // TestManager_DeleteMultipleTimes tests if delete works without errors if called multiple times.
 func TestManager_DeleteMultipleTimes(t *testing.T) {
 	kv, err := newInMemKvdb()
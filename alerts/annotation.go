@@ -0,0 +1,64 @@
+package alerts
+
+import (
+	"fmt"
+)
+
+const (
+	// MaxAnnotations is the number of annotations an alert holds at most
+	MaxAnnotations = 32
+	// MaxAnnotationsSize is the total length of the annotation keys and values
+	// at most
+	MaxAnnotationsSize = 4096
+
+	// ErrInvalidAnnotations is returned by Annotate for annotations beyond the
+	// limits or with an invalid key, tagged with the cause
+	ErrInvalidAnnotations Error = "invalid alert annotations"
+)
+
+// checkAnnotations returns an error if the annotations of an alert exceed the
+// limits or have an invalid key, the keys being those of the payloads.
+func checkAnnotations(annotations map[string]string) error {
+	if len(annotations) > MaxAnnotations {
+		return ErrInvalidAnnotations.Tag(Error(fmt.Sprintf("%d keys, at most %d", len(annotations), MaxAnnotations)))
+	}
+	size := 0
+	for k, v := range annotations {
+		if len(k) > MaxPayloadKeyLength || !payloadKeyPattern.MatchString(k) {
+			return ErrInvalidAnnotations.Tag(Error(fmt.Sprintf("key %q", k)))
+		}
+		size += len(k) + len(v)
+	}
+	if size > MaxAnnotationsSize {
+		return ErrInvalidAnnotations.Tag(Error(fmt.Sprintf("%d bytes, at most %d", size, MaxAnnotationsSize)))
+	}
+	return nil
+}
+
+func (m *manager) Annotate(id string, annotations map[string]string) error {
+	alert, err := m.lookup(id)
+	if err != nil {
+		return err
+	}
+	merged := make(map[string]string, len(alert.Annotations)+len(annotations))
+	for k, v := range alert.Annotations {
+		merged[k] = v
+	}
+	for k, v := range annotations {
+		if len(v) == 0 {
+			delete(merged, k)
+			continue
+		}
+		merged[k] = v
+	}
+	if err := checkAnnotations(merged); err != nil {
+		return err
+	}
+	alert.Annotations = merged
+
+	ttl := alert.Ttl
+	if alert.Cleared {
+		ttl = m.ttl
+	}
+	return m.store.Put(alert, ttl)
+}
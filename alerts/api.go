@@ -1,20 +1,68 @@
 package alerts
 
 import (
+	"errors"
+	"regexp"
 	"time"
 
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/portworx/kvdb"
 )
 
-// NewManager obtains instance of Manager for alerts management.
+var (
+	// ErrNotInitialized is returned when the alerts manager has not been initialized
+	ErrNotInitialized = errors.New("openstorage.alerts: not initialized")
+	// ErrInitialized is returned when the alerts manager is initialized twice
+	ErrInitialized = errors.New("openstorage.alerts: already initialized")
+
+	inst Manager
+	// Inst returns the alerts manager singleton. This function can be
+	// overridden for testing purposes
+	Inst = func() (Manager, error) {
+		return alertsInst()
+	}
+)
+
+// Init instantiates the alerts manager singleton.
+func Init(m Manager) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = m
+	return nil
+}
+
+func alertsInst() (Manager, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// NewManager obtains instance of Manager for alerts management, storing the alerts in kv.
 func NewManager(kv kvdb.Kvdb, options ...Option) (Manager, error) {
-	return newManager(kv, options...)
+	return NewManagerWithStore(NewKvdbStore(kv), options...)
+}
+
+// NewManagerWithStore obtains instance of Manager for alerts management, storing the alerts
+// in store, such as NewMemStore or NewSQLStore.
+func NewManagerWithStore(store Store, options ...Option) (Manager, error) {
+	m, err := newManager(store, options...)
+	if err != nil {
+		return nil, err
+	}
+	if m.gcInterval > 0 {
+		go m.gc()
+	}
+	if m.escalationInterval > 0 {
+		go m.escalations()
+	}
+	return m, nil
 }
 
 // NewFilterDeleter obtains instance of FilterDeleter for alerts enumeration and deletion.
 func NewFilterDeleter(kv kvdb.Kvdb, options ...Option) (FilterDeleter, error) {
-	return newManager(kv, options...)
+	return newManager(NewKvdbStore(kv), options...)
 }
 
 // Option API
@@ -24,15 +72,124 @@ func NewTTLOption(ttl uint64) Option {
 	return &option{optionType: ttlOption, value: ttl}
 }
 
+// NewGCIntervalOption provides an option to be used in manager creation. The manager deletes
+// the expired alerts every interval, DefaultGCInterval if zero. An alert expires its Ttl
+// seconds after its timestamp, or the manager TTL after it if it is cleared.
+func NewGCIntervalOption(interval time.Duration) Option {
+	return &option{optionType: gcIntervalOption, value: interval}
+}
+
+// NewEscalationIntervalOption provides an option to be used in manager creation. The manager
+// evaluates the escalation rules every interval, DefaultEscalationInterval if zero. The rules
+// are not evaluated without this option.
+func NewEscalationIntervalOption(interval time.Duration) Option {
+	return &option{optionType: escalationIntervalOption, value: interval}
+}
+
+// NewRateLimitOption provides an option to be used in manager creation. The raises of every
+// resource type and alert type are limited to rate per second, in bursts of up to burst
+// raises. The raises beyond the limit are coalesced: the last alert raised for a resource
+// is written once the rate allows it, with its count incremented by the coalesced raises
+// and their number in its payload under RateLimitedPayloadKey. No limit if rate is zero.
+func NewRateLimitOption(rate float64, burst int) Option {
+	return &option{optionType: rateLimitOption, value: rateLimit{rate: rate, burst: burst}}
+}
+
+// NewLabelsOption provides an option to be used in manager creation. The labels of the
+// resources selected by the routes are given by labels.
+func NewLabelsOption(labels LabelsFunc) Option {
+	return &option{optionType: labelsOption, value: labels}
+}
+
+// NewCacheOption provides an option to be used in manager creation. The manager reads the
+// alerts from an in-memory cache kept consistent by a watch of its store, so that the filters
+// which cannot be pushed down to the store, such as time, count and custom filters, are
+// evaluated without reading every alert from the store. The store must support watches.
+func NewCacheOption() Option {
+	return &option{optionType: cacheOption, value: true}
+}
+
+// NewRetentionOption provides an option to be used in manager creation. The alerts of
+// resourceType of the default namespace are deleted maxAge after their timestamp, and beyond
+// the maxCount most recent ones, every gc interval. No limit if zero. Given again for the
+// same resource type, the last option wins.
+func NewRetentionOption(resourceType api.ResourceType, maxAge time.Duration, maxCount int) Option {
+	return &option{
+		optionType: retentionOption,
+		value:      retention{resourceType: resourceType, maxAge: maxAge, maxCount: maxCount},
+	}
+}
+
+// NewFlappingOption provides an option to be used in manager creation. An alert of alertType
+// cleared start times within window is flapping: it is stored and delivered once, raised and
+// marked under FlappingPayloadKey, and its raises and clears are absorbed until it is cleared
+// stop times or less within window, when the alert raised or cleared last is stored and
+// delivered. Given again for the same alert type, the last option wins.
+func NewFlappingOption(alertType int64, window time.Duration, start, stop int) Option {
+	return &option{
+		optionType: flappingOption,
+		value:      flapping{alertType: alertType, window: window, start: start, stop: stop},
+	}
+}
+
+// NewNodesOption provides an option to be used in manager creation. The enumerations given
+// NewClusterWideOption also list the alerts of the nodes returned by nodes, such as when the
+// nodes keep their alerts in node-local stores.
+func NewNodesOption(nodes NodesFunc) Option {
+	return &option{optionType: nodesOption, value: nodes}
+}
+
+// NewDedupeOption provides an option to be used in Raise. An alert raised again for the same
+// resource type, alert type and resource id updates the stored one: its count is incremented,
+// its timestamp is the time it was last seen and its first seen time is kept.
+func NewDedupeOption() Option {
+	return &option{optionType: dedupeOption, value: true}
+}
+
+// NewTimestampOption provides an option to be used in Raise. The alert is stamped with t
+// rather than the time it is raised, such as when replaying historical events.
+func NewTimestampOption(t time.Time) Option {
+	return &option{optionType: timestampOption, value: t}
+}
+
+// NewFirstSeenOption provides an option to be used in Raise. The alert was first seen at t,
+// unless deduplicated into a stored alert first seen earlier.
+func NewFirstSeenOption(t time.Time) Option {
+	return &option{optionType: firstSeenOption, value: t}
+}
+
+// NewAlertIDOption provides an option to be used in Raise. The alert is given the id id, such
+// as the id it had in the system it is imported from.
+func NewAlertIDOption(id int64) Option {
+	return &option{optionType: alertIDOption, value: id}
+}
+
+// NewCorrelationIDOption provides an option to be used in Raise. The alert carries id, the
+// correlation id of the request raising it, in its payload under CorrelationIDPayloadKey, so
+// that the alerts of a request are found with a payload filter. Ignored if id is empty.
+func NewCorrelationIDOption(id string) Option {
+	return &option{optionType: correlationIDOption, value: id}
+}
+
+// NewNamespaceOption provides an option to be used in Raise and in the definition of the
+// efficient filters. A raised alert is stored in the kvdb sub tree of namespace, and tagged
+// with it in the NamespacePayloadKey payload key. An efficient filter matches the alerts of
+// namespace, rather than those of the default namespace, fetching them from its sub tree.
+func NewNamespaceOption(namespace string) Option {
+	return &option{optionType: namespaceOption, value: NewNamespaceFilter(namespace)}
+}
+
 // NewTimeSpanOption provides an option to be used in filter definition.
 // Filters that take options, apply options only during matching alerts.
+// A zero start or stop leaves the time span unbounded on that side.
 func NewTimeSpanOption(start, stop time.Time) Option {
 	return &option{optionType: timeSpanOption, value: NewTimeSpanFilter(start, stop)}
 }
 
 // NewCountSpanOption provides an option to be used in filter definition that
 // accept options. Only filters that are efficient in querying kvdb accept options
-// and apply these options during matching alerts.
+// and apply these options during matching alerts. A negative maxCount leaves the
+// count range unbounded above.
 func NewCountSpanOption(minCount, maxCount int64) Option {
 	return &option{optionType: countSpanOption, value: NewCountSpanFilter(minCount, maxCount)}
 }
@@ -44,6 +201,13 @@ func NewMinSeverityOption(minSev api.SeverityType) Option {
 	return &option{optionType: minSeverityOption, value: NewMinSeverityFilter(minSev)}
 }
 
+// NewSeverityOption provides an option to be used during filter creation that
+// accept such options. Only filters that are efficient in querying kvdb accept options
+// and apply these options during matching alerts.
+func NewSeverityOption(operator SeverityOperator, severity api.SeverityType) Option {
+	return &option{optionType: severityOption, value: NewSeverityFilter(operator, severity)}
+}
+
 // NewFlagCheckOptions provides an option to be used during filter creation that
 // accept such options. Only filters that are efficient in querying kvdb accept options
 // and apply these options during matching alerts.
@@ -51,6 +215,13 @@ func NewFlagCheckOption(flag bool) Option {
 	return &option{optionType: flagCheckOption, value: NewFlagCheckFilter(flag)}
 }
 
+// NewStateOption provides an option to be used during filter creation that
+// accept such options. Only filters that are efficient in querying kvdb accept options
+// and apply these options during matching alerts.
+func NewStateOption(state State) Option {
+	return &option{optionType: stateOption, value: NewStateFilter(state)}
+}
+
 // NewResourceIdOption provides an option to be used during filter creation that
 // accept such options. Only filters that are efficient in querying kvdb accept options
 // and apply these options during matching alerts.
@@ -58,8 +229,75 @@ func NewResourceIdOption(resourceId string) Option {
 	return &option{optionType: resourceIdOption, value: NewMatchResourceIDFilter(resourceId)}
 }
 
+// NewResourceIDRegexOption provides an option to be used during filter creation that
+// accept such options. Only filters that are efficient in querying kvdb accept options
+// and apply these options during matching alerts.
+func NewResourceIDRegexOption(re *regexp.Regexp) Option {
+	return &option{optionType: resourceIDRegexOption, value: NewMatchResourceIDRegexFilter(re)}
+}
+
+// NewResourceIDGlobOption provides an option to be used during filter creation that
+// accept such options. Only filters that are efficient in querying kvdb accept options
+// and apply these options during matching alerts.
+func NewResourceIDGlobOption(pattern string) Option {
+	return &option{optionType: resourceIDGlobOption, value: NewMatchResourceIDGlobFilter(pattern)}
+}
+
+// NewPayloadOption provides an option to be used during filter creation that
+// accept such options. Only filters that are efficient in querying kvdb accept options
+// and apply these options during matching alerts.
+func NewPayloadOption(key string, values ...string) Option {
+	return &option{optionType: payloadOption, value: NewMatchPayloadFilter(key, values...)}
+}
+
+// NewTagOption provides an option to be used during filter creation that
+// accept such options. Only alerts annotated with key, with one of values if any,
+// are matched.
+func NewTagOption(key string, values ...string) Option {
+	return &option{optionType: tagOption, value: NewTagFilter(key, values...)}
+}
+
+// NewMaxResultsOption provides an option to limit the number of alerts returned by
+// EnumerateWithOptions, all matching alerts are returned if zero.
+func NewMaxResultsOption(maxResults int64) Option {
+	return &option{optionType: maxResultsOption, value: maxResults}
+}
+
+// NewContinuationTokenOption provides an option for EnumerateWithOptions to return the page
+// following the one that returned token.
+func NewContinuationTokenOption(token string) Option {
+	return &option{optionType: continuationTokenOption, value: token}
+}
+
+// NewSortOption provides an option for EnumerateWithOptions to order the alerts by sortBy,
+// in descending order if descending is set.
+func NewSortOption(sortBy SortBy, descending bool) Option {
+	return &option{optionType: sortOption, value: sortInfo{sortBy: sortBy, descending: descending}}
+}
+
+// NewIncludeSilencedOption provides an option for EnumerateWithOptions to also return the
+// alerts muted by a silence.
+func NewIncludeSilencedOption() Option {
+	return &option{optionType: includeSilencedOption, value: true}
+}
+
+// NewClusterWideOption provides an option for EnumerateWithOptions to also list the alerts of
+// the other nodes of the cluster, see NewNodesOption. The queries of the filters are sent to
+// every node and the results are merged, keeping the alert raised last of each resource type,
+// alert type and resource id. The nodes which fail are skipped, and those which are not given
+// the option do not fan out again. The alerts silenced on their node are not returned.
+func NewClusterWideOption() Option {
+	return &option{optionType: clusterWideOption, value: true}
+}
+
 // Filter API
 
+// NewNamespaceFilter creates a filter that matches on <namespace>, i.e., on the alerts raised
+// with NewNamespaceOption(namespace).
+func NewNamespaceFilter(namespace string, options ...Option) Filter {
+	return &filter{filterType: namespaceFilter, value: namespace, options: options}
+}
+
 // NewResourceTypeFilter creates a filter that matches on <resourceType>
 func NewResourceTypeFilter(resourceType api.ResourceType, options ...Option) Filter {
 	return &filter{filterType: resourceTypeFilter, value: resourceType, options: options}
@@ -80,10 +318,22 @@ func NewResourceIDFilter(resourceID string, alertType int64, resourceType api.Re
 }
 
 // NewTimeSpanFilter creates a filter that matches on alert raised in a given time window.
+// A zero start or stop leaves the window unbounded on that side.
 func NewTimeSpanFilter(start, stop time.Time) Filter {
 	return &filter{filterType: timeSpanFilter, value: timeZone{start: start, stop: stop}}
 }
 
+// NewSinceFilter creates a filter that matches on alert raised at start or later, such as
+// NewSinceFilter(time.Now().Add(-time.Hour)) for the alerts of the last hour.
+func NewSinceFilter(start time.Time) Filter {
+	return NewTimeSpanFilter(start, time.Time{})
+}
+
+// NewUntilFilter creates a filter that matches on alert raised at stop or earlier.
+func NewUntilFilter(stop time.Time) Filter {
+	return NewTimeSpanFilter(time.Time{}, stop)
+}
+
 // NewMatchAlertTypeFilter provides a filter that matches on alert type.
 // Please use NewAlertTypeFilter if other inputs are known.
 func NewMatchAlertTypeFilter(alertType int64) Filter {
@@ -96,8 +346,53 @@ func NewMatchResourceIDFilter(resourceID string) Filter {
 	return &filter{filterType: matchResourceIDFilter, value: resourceID}
 }
 
-// NewCountSpanFilter provides a filter that matches on alert count.
+// NewMatchResourceIDRegexFilter provides a filter that matches on resource ids matching
+// a regular expression, such as regexp.MustCompile("^pvc-").
+func NewMatchResourceIDRegexFilter(re *regexp.Regexp) Filter {
+	return &filter{filterType: matchResourceIDRegexFilter, value: re}
+}
+
+// NewMatchResourceIDGlobFilter provides a filter that matches on resource ids matching
+// a shell pattern, such as "pvc-*", using path.Match syntax. Match returns an error if
+// the pattern is malformed.
+func NewMatchResourceIDGlobFilter(pattern string) Filter {
+	return &filter{filterType: matchResourceIDGlobFilter, value: pattern}
+}
+
+// NewMatchPayloadFilter provides a filter that matches on alerts whose payload has key,
+// with one of values if any, e.g. NewMatchPayloadFilter("device_path", "/dev/sdb").
+func NewMatchPayloadFilter(key string, values ...string) Filter {
+	return &filter{filterType: matchPayloadFilter, value: payloadInfo{key: key, values: values}}
+}
+
+// NewTagFilter provides a filter that matches on alerts annotated with key, with one of
+// values if any, e.g. NewTagFilter("owner", "storage-team"). See Annotate.
+func NewTagFilter(key string, values ...string) Filter {
+	return &filter{filterType: tagFilter, value: payloadInfo{key: key, values: values}}
+}
+
+// NewSearchFilter provides a filter that matches on alerts whose message or payload values have
+// a word starting with every word of search, case insensitively, such as "sdb fail" matching
+// "Device /dev/sdb failed".
+func NewSearchFilter(search string) Filter {
+	return &filter{filterType: searchFilter, value: words(search)}
+}
+
+// NewExpressionFilter provides a filter that matches on alerts for which e is true, see
+// CompileExpression.
+func NewExpressionFilter(e *Expression) Filter {
+	return &filter{filterType: expressionFilter, value: e}
+}
+
+// NewCountSpanFilter provides a filter that matches on alert count, see NewCountRangeFilter.
 func NewCountSpanFilter(minCount, maxCount int64) Filter {
+	return NewCountRangeFilter(minCount, maxCount)
+}
+
+// NewCountRangeFilter provides a filter that matches on alerts whose count is within
+// [minCount, maxCount], unbounded above if maxCount is negative, such as
+// NewCountRangeFilter(11, -1) for the alerts raised more than 10 times.
+func NewCountRangeFilter(minCount, maxCount int64) Filter {
 	return &filter{filterType: countSpanFilter, value: []int64{minCount, maxCount}}
 }
 
@@ -107,11 +402,40 @@ func NewMinSeverityFilter(minSev api.SeverityType) Filter {
 	return &filter{filterType: minSeverityFilter, value: minSev}
 }
 
+// NewSeverityFilter provides a filter that compares the alert severity with severity using
+// operator, e.g. NewSeverityFilter(SeverityAtLeast, api.SeverityType_SEVERITY_TYPE_WARNING)
+// matches WARNING and ALARM alerts.
+func NewSeverityFilter(operator SeverityOperator, severity api.SeverityType) Filter {
+	return &filter{filterType: severityFilter, value: severityInfo{operator: operator, severity: severity}}
+}
+
 // NewFlagCheckFilter provides a filter that matches on alert clear flag.
 func NewFlagCheckFilter(flag bool) Filter {
 	return &filter{filterType: flagCheckFilter, value: flag}
 }
 
+// NewStateFilter provides a filter that matches on alert lifecycle state, e.g.
+// NewStateFilter(StateNew) matches the alerts that are neither acknowledged nor cleared.
+func NewStateFilter(state State) Filter {
+	return &filter{filterType: stateFilter, value: state}
+}
+
+// NewAndFilter provides a filter that matches on alerts matched by all filters.
+// Alerts are fetched from the most selective sub tree of the filters.
+func NewAndFilter(filters ...Filter) Filter {
+	return &filter{filterType: andFilter, value: filters}
+}
+
+// NewOrFilter provides a filter that matches on alerts matched by at least one of filters.
+func NewOrFilter(filters ...Filter) Filter {
+	return &filter{filterType: orFilter, value: filters}
+}
+
+// NewNotFilter provides a filter that matches on alerts not matched by f.
+func NewNotFilter(f Filter) Filter {
+	return &filter{filterType: notFilter, value: f}
+}
+
 // NewCustomFilter creates a filter that matches on UDF (user defined function)
 func NewCustomFilter(f func(alert *api.Alert) (bool, error)) Filter {
 	return &filter{filterType: CustomFilter, value: f}
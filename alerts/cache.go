@@ -0,0 +1,222 @@
+package alerts
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+)
+
+const staleCache Error = "stale alerts cache"
+
+// cacheStore caches the alerts of a store in memory. The cache is loaded on
+// first use and kept consistent by a watch of the store, the writes going
+// through to the store. The alerts are read from the store while the cache
+// cannot be loaded, and again from the cache once the watch is restarted.
+type cacheStore struct {
+	Store
+	// expired tells if an alert expired, the store expiring the alerts on
+	// its own
+	expired func(alert *api.Alert, now time.Time) bool
+
+	// loadLock serializes the loads of the cache
+	loadLock sync.Mutex
+	lock     sync.Mutex
+	alerts   map[string]*api.Alert
+	// index indexes the words of the alerts for the queries with terms
+	index *searchIndex
+	// changed are the keys of the alerts changed while loading, whose
+	// enumerated values are stale, nil if not loading
+	changed map[string]bool
+	ready   bool
+	// generation identifies the current watch, the events of the previous
+	// ones are dropped
+	generation int
+}
+
+func newCacheStore(store Store, expired func(alert *api.Alert, now time.Time) bool) *cacheStore {
+	return &cacheStore{Store: store, expired: expired}
+}
+
+// load loads the cache and watches the store, unless loaded.
+func (s *cacheStore) load() error {
+	s.loadLock.Lock()
+	defer s.loadLock.Unlock()
+
+	s.lock.Lock()
+	if s.ready {
+		s.lock.Unlock()
+		return nil
+	}
+	s.generation++
+	generation := s.generation
+	s.alerts = make(map[string]*api.Alert)
+	s.index = newSearchIndex()
+	s.changed = make(map[string]bool)
+	s.lock.Unlock()
+
+	// the store is not called with s.lock held since it may deliver the
+	// events of the watch synchronously
+	err := s.Store.Watch(Query{}, func(action WatchAction, alert *api.Alert, err error) error {
+		return s.apply(generation, action, alert, err)
+	})
+	var alerts []*api.Alert
+	if err == nil {
+		alerts, err = s.Store.Enumerate(Query{})
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err != nil || generation != s.generation {
+		// ends the watch
+		s.generation++
+		s.changed = nil
+		if err == nil {
+			err = staleCache
+		}
+		return err
+	}
+	for _, alert := range alerts {
+		if key := alertKey(alert); !s.changed[key] {
+			s.alerts[key] = alert
+			s.index.add(key, alert)
+		}
+	}
+	s.changed = nil
+	s.ready = true
+	return nil
+}
+
+// apply applies the change of alert delivered by the watch of generation.
+// An error ends the watch and the cache is loaded again on next read.
+func (s *cacheStore) apply(generation int, action WatchAction, alert *api.Alert, err error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if generation != s.generation {
+		return staleCache
+	}
+	if err != nil {
+		s.ready = false
+		s.generation++
+		return err
+	}
+	if action == AlertDeleted {
+		s.delete(alertKey(alert))
+	} else {
+		s.set(alert)
+	}
+	return nil
+}
+
+// set caches alert, s.lock must be held.
+func (s *cacheStore) set(alert *api.Alert) {
+	key := alertKey(alert)
+	if s.changed != nil {
+		s.changed[key] = true
+	}
+	if s.alerts != nil {
+		s.alerts[key] = alert
+		s.index.add(key, alert)
+	}
+}
+
+// delete deletes the alert of key from the cache, s.lock must be held.
+func (s *cacheStore) delete(key string) {
+	if s.changed != nil {
+		s.changed[key] = true
+	}
+	if s.alerts != nil {
+		delete(s.alerts, key)
+		s.index.remove(key)
+	}
+}
+
+// cached returns true if the alerts are read from the cache, loading it if
+// needed.
+func (s *cacheStore) cached() bool {
+	if err := s.load(); err != nil {
+		logrus.WithField("pkg", "openstorage/alerts").
+			Warnf("Failed to load the alerts cache, reading the store: %v", err)
+		return false
+	}
+	return true
+}
+
+func (s *cacheStore) Put(alert *api.Alert, ttl uint64) error {
+	if err := s.Store.Put(alert, ttl); err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.set(proto.Clone(alert).(*api.Alert))
+	return nil
+}
+
+func (s *cacheStore) Get(resourceType api.ResourceType, alertType int64, resourceID string) (*api.Alert, error) {
+	if !s.cached() {
+		return s.Store.Get(resourceType, alertType, resourceID)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	alert, ok := s.alerts[getKey(resourceType.String(), alertType, resourceID)]
+	if !ok || s.expired(alert, time.Now()) {
+		return nil, alertNotFound
+	}
+	return proto.Clone(alert).(*api.Alert), nil
+}
+
+func (s *cacheStore) Enumerate(q Query) ([]*api.Alert, error) {
+	if !s.cached() {
+		return s.Store.Enumerate(q)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now()
+	var alerts []*api.Alert
+	matches := func(alert *api.Alert) bool {
+		return q.Matches(alert) && !s.expired(alert, now)
+	}
+	if terms := q.Terms; len(terms) != 0 {
+		// the index gives the alerts having the terms
+		q.Terms = nil
+		for key := range s.index.lookup(terms) {
+			if alert := s.alerts[key]; matches(alert) {
+				alerts = append(alerts, proto.Clone(alert).(*api.Alert))
+			}
+		}
+		return alerts, nil
+	}
+	for _, alert := range s.alerts {
+		if matches(alert) {
+			alerts = append(alerts, proto.Clone(alert).(*api.Alert))
+		}
+	}
+	return alerts, nil
+}
+
+func (s *cacheStore) Delete(q Query) error {
+	if err := s.Store.Delete(q); err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for key, alert := range s.alerts {
+		if q.Matches(alert) {
+			s.delete(key)
+		}
+	}
+	return nil
+}
+
+func (s *cacheStore) CompareAndDelete(alert *api.Alert) (bool, error) {
+	deleted, err := s.Store.CompareAndDelete(alert)
+	if err != nil || !deleted {
+		return deleted, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.delete(alertKey(alert))
+	return true, nil
+}
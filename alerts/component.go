@@ -0,0 +1,124 @@
+package alerts
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ComponentBlockSize is the number of alert types owned by a component. The
+// alert types of a component are its base plus a local id from 1 to
+// ComponentBlockSize-1.
+const ComponentBlockSize = 100
+
+// Component is a namespace of alert types owned by a driver or a subsystem,
+// so that the alert types of two components never collide.
+type Component struct {
+	// Name of the component, such as kvdbhealth
+	Name string
+	// Base of the alert types of the component, a multiple of
+	// ComponentBlockSize
+	Base int64
+}
+
+var (
+	components       = make(map[string]*Component)
+	componentsByBase = make(map[int64]*Component)
+)
+
+// RegisterComponent registers the component name owning the block of alert
+// types starting at base. A name or a block can only be registered once.
+func RegisterComponent(name string, base int64) (*Component, error) {
+	if len(name) == 0 {
+		return nil, fmt.Errorf("component of alert types %d has no name", base)
+	}
+	if base <= 0 || base%ComponentBlockSize != 0 {
+		return nil, fmt.Errorf("base %d of component %s is not a positive multiple of %d",
+			base, name, ComponentBlockSize)
+	}
+	reasonsLock.Lock()
+	defer reasonsLock.Unlock()
+	if c, ok := components[name]; ok {
+		return nil, fmt.Errorf("component %s is already registered with base %d", name, c.Base)
+	}
+	if c, ok := componentsByBase[base]; ok {
+		return nil, fmt.Errorf("alert types %d of component %s are owned by component %s", base, name, c.Name)
+	}
+	for alertType, r := range reasons {
+		if alertType >= base && alertType < base+ComponentBlockSize {
+			return nil, fmt.Errorf("alert type %d of component %s is already registered as %s",
+				alertType, name, r.Code)
+		}
+	}
+	c := &Component{Name: name, Base: base}
+	components[name] = c
+	componentsByBase[base] = c
+	return c, nil
+}
+
+// MustRegisterComponent registers a component like RegisterComponent and
+// panics on error. It is meant for the init functions of the packages
+// raising alerts.
+func MustRegisterComponent(name string, base int64) *Component {
+	c, err := RegisterComponent(name, base)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// AlertType returns the alert type of the local id of the component.
+func (c *Component) AlertType(local int64) int64 {
+	return c.Base + local
+}
+
+// RegisterReasons registers the reasons of the alert types of the
+// component, which must be in its block.
+func (c *Component) RegisterReasons(rs ...Reason) error {
+	for i := range rs {
+		if rs[i].AlertType <= c.Base || rs[i].AlertType >= c.Base+ComponentBlockSize {
+			return fmt.Errorf("alert type %d is outside of the alert types %d to %d of component %s",
+				rs[i].AlertType, c.Base+1, c.Base+ComponentBlockSize-1, c.Name)
+		}
+		rs[i].Component = c.Name
+	}
+	return RegisterReasons(rs...)
+}
+
+// MustRegisterReasons registers the reasons of the alert types of the
+// component like RegisterReasons and panics on error.
+func (c *Component) MustRegisterReasons(rs ...Reason) {
+	if err := c.RegisterReasons(rs...); err != nil {
+		panic(err)
+	}
+}
+
+// Components lists the registered components by base.
+func Components() []Component {
+	reasonsLock.RLock()
+	defer reasonsLock.RUnlock()
+	cs := make([]Component, 0, len(components))
+	for _, c := range components {
+		cs = append(cs, *c)
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Base < cs[j].Base })
+	return cs
+}
+
+// ComponentReasons lists the reasons registered by the component name, by
+// alert type.
+func ComponentReasons(name string) []Reason {
+	var rs []Reason
+	for _, r := range Reasons() {
+		if r.Component == name {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
+
+// componentOf returns the component owning alertType, nil if none,
+// reasonsLock must be held.
+func componentOf(alertType int64) *Component {
+	base := alertType - alertType%ComponentBlockSize
+	return componentsByBase[base]
+}
@@ -0,0 +1,35 @@
+package alerts
+
+import (
+	"time"
+
+	"github.com/libopenstorage/openstorage/pkg/cron"
+)
+
+const invalidSchedule Error = "invalid schedule"
+
+// schedule is a parsed cron schedule.
+type schedule struct {
+	s *cron.Schedule
+}
+
+// parseSchedule parses a cron expression, such as "0 2 * * 6", see
+// cron.Parse.
+func parseSchedule(expr string) (*schedule, error) {
+	s, err := cron.Parse(expr)
+	if err != nil {
+		return nil, invalidSchedule.Tag(Error(err.Error()))
+	}
+	return &schedule{s: s}, nil
+}
+
+// matches returns true if the schedule fires at the minute of t.
+func (s *schedule) matches(t time.Time) bool {
+	return s.s.Matches(t)
+}
+
+// last returns the last time the schedule fired after from, up to t, and
+// false if it did not.
+func (s *schedule) last(from, t time.Time) (time.Time, bool) {
+	return s.s.Last(from, t)
+}
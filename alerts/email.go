@@ -0,0 +1,230 @@
+package alerts
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultEmailBatchInterval is how long alerts are collected before
+	// being sent in a single message
+	DefaultEmailBatchInterval = time.Minute
+	// DefaultEmailMaxBatch is the number of alerts which sends a message
+	// before the end of the batch interval
+	DefaultEmailMaxBatch = 100
+	// DefaultEmailMinSeverity is the least severe alert emailed
+	DefaultEmailMinSeverity = api.SeverityType_SEVERITY_TYPE_ALARM
+	// DefaultEmailSubject is the template of the subject of the messages
+	DefaultEmailSubject = "[openstorage] {{len .Alerts}} alert(s) raised"
+	// DefaultEmailBody is the template of the body of the messages
+	DefaultEmailBody = `{{range .Alerts}}{{.Timestamp | time}} {{.Severity}} {{.Resource}} {{.ResourceId}}: {{.Message}}
+{{end}}`
+
+	invalidEmail Error = "invalid email notifier"
+)
+
+// EmailConfig configures a notifier emailing the raised alerts through an
+// SMTP server. Alerts are batched so that a storm of alerts sends a few
+// messages only.
+type EmailConfig struct {
+	// Name identifies the notifier, its server and recipients if unset
+	Name string `yaml:"name"`
+	// Server is the host:port of the SMTP server
+	Server string `yaml:"server"`
+	// Username authenticates with the server, no authentication if unset
+	Username string `yaml:"username"`
+	// Password authenticates with the server
+	Password string `yaml:"password"`
+	// From is the sender of the messages
+	From string `yaml:"from"`
+	// To are the recipients of the messages
+	To []string `yaml:"to"`
+	// Subject is the text/template of the subject of the messages, given
+	// the batched Alerts, DefaultEmailSubject if unset
+	Subject string `yaml:"subject"`
+	// Body is the text/template of the body of the messages, given the
+	// batched Alerts, DefaultEmailBody if unset
+	Body string `yaml:"body"`
+	// MinSeverity emails only the alerts at least this severe, such as
+	// SEVERITY_TYPE_WARNING or warning, DefaultEmailMinSeverity if unset
+	MinSeverity string `yaml:"min_severity"`
+	// BatchInterval is how long alerts are collected before being sent,
+	// DefaultEmailBatchInterval if unset
+	BatchInterval time.Duration `yaml:"batch_interval"`
+	// MaxBatch sends the collected alerts once there are that many,
+	// DefaultEmailMaxBatch if unset
+	MaxBatch int `yaml:"max_batch"`
+}
+
+// Filters returns the filters matching the alerts emailed, the raised ones
+// at least as severe as MinSeverity.
+func (c *EmailConfig) Filters() ([]Filter, error) {
+	minSev := DefaultEmailMinSeverity
+	if len(c.MinSeverity) != 0 {
+		v, ok := enumValue(api.SeverityType_value, "SEVERITY_TYPE_", c.MinSeverity)
+		if !ok {
+			return nil, invalidEmail.Tag(Error("severity " + c.MinSeverity))
+		}
+		minSev = api.SeverityType(v)
+	}
+	// the alerts cleared are not emailed
+	return []Filter{NewAndFilter(NewMinSeverityFilter(minSev), NewFlagCheckFilter(false))}, nil
+}
+
+// emailData is given to the templates of the messages.
+type emailData struct {
+	Alerts []*api.Alert
+}
+
+type email struct {
+	name     string
+	addr     string
+	auth     smtp.Auth
+	from     string
+	to       []string
+	subject  *template.Template
+	body     *template.Template
+	interval time.Duration
+	maxBatch int
+	// send is smtp.SendMail, replaced in tests
+	send func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+	lock    sync.Mutex
+	pending []*api.Alert
+	timer   *time.Timer
+}
+
+// NewEmailNotifier provides a notifier emailing the alerts through the SMTP
+// server of c, in batches. The filters of the notifier are given by
+// c.Filters.
+func NewEmailNotifier(c *EmailConfig) (Notifier, error) {
+	host, _, err := net.SplitHostPort(c.Server)
+	if err != nil {
+		return nil, invalidEmail.Tag(Error("server " + c.Server))
+	}
+	if len(c.From) == 0 || len(c.To) == 0 {
+		return nil, invalidEmail.Tag("missing sender or recipients")
+	}
+	e := &email{
+		name:     c.Name,
+		addr:     c.Server,
+		from:     c.From,
+		to:       c.To,
+		interval: c.BatchInterval,
+		maxBatch: c.MaxBatch,
+		send:     smtp.SendMail,
+	}
+	if len(e.name) == 0 {
+		e.name = "smtp://" + c.Server + "/" + strings.Join(c.To, ",")
+	}
+	if len(c.Username) != 0 {
+		e.auth = smtp.PlainAuth("", c.Username, c.Password, host)
+	}
+	if e.interval == 0 {
+		e.interval = DefaultEmailBatchInterval
+	}
+	if e.maxBatch == 0 {
+		e.maxBatch = DefaultEmailMaxBatch
+	}
+	subject, body := c.Subject, c.Body
+	if len(subject) == 0 {
+		subject = DefaultEmailSubject
+	}
+	if len(body) == 0 {
+		body = DefaultEmailBody
+	}
+	funcs := template.FuncMap{"time": emailTime}
+	if e.subject, err = template.New("subject").Funcs(funcs).Parse(subject); err != nil {
+		return nil, invalidEmail.Tag(Error(err.Error()))
+	}
+	if e.body, err = template.New("body").Funcs(funcs).Parse(body); err != nil {
+		return nil, invalidEmail.Tag(Error(err.Error()))
+	}
+	return e, nil
+}
+
+func (e *email) Name() string {
+	return e.name
+}
+
+// Notify adds alert to the batch, sending it if full.
+func (e *email) Notify(alert *api.Alert) error {
+	e.lock.Lock()
+	e.pending = append(e.pending, alert)
+	if len(e.pending) < e.maxBatch {
+		if e.timer == nil {
+			e.timer = time.AfterFunc(e.interval, e.flush)
+		}
+		e.lock.Unlock()
+		return nil
+	}
+	alerts := e.take()
+	e.lock.Unlock()
+	return e.sendBatch(alerts)
+}
+
+// flush sends the batch at the end of the batch interval.
+func (e *email) flush() {
+	e.lock.Lock()
+	alerts := e.take()
+	e.lock.Unlock()
+	if len(alerts) == 0 {
+		return
+	}
+	if err := e.sendBatch(alerts); err != nil {
+		logrus.WithField("pkg", "openstorage/alerts").
+			WithField("notifier", e.name).
+			Errorf("Failed to email %d alerts: %v", len(alerts), err)
+	}
+}
+
+// take empties the batch, e.lock must be held.
+func (e *email) take() []*api.Alert {
+	if e.timer != nil {
+		e.timer.Stop()
+		e.timer = nil
+	}
+	alerts := e.pending
+	e.pending = nil
+	return alerts
+}
+
+func (e *email) sendBatch(alerts []*api.Alert) error {
+	data := &emailData{Alerts: alerts}
+	var subject, body bytes.Buffer
+	if err := e.subject.Execute(&subject, data); err != nil {
+		return err
+	}
+	if err := e.body.Execute(&body, data); err != nil {
+		return err
+	}
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", e.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(e.to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", strings.Replace(subject.String(), "\n", " ", -1))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.Replace(body.String(), "\n", "\r\n", -1))
+	return e.send(e.addr, e.auth, e.from, e.to, msg.Bytes())
+}
+
+// emailTime formats the timestamp of an alert for the templates.
+func emailTime(ts *timestamp.Timestamp) string {
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,256 @@
+package alerts
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// escalationKey is the kvdb tree of the escalation rules, outside of the
+	// alerts tree
+	escalationKey = "escalations/alerts"
+
+	// DefaultEscalationInterval is the time between evaluations of the
+	// escalation rules
+	DefaultEscalationInterval = time.Minute
+
+	// EscalatedPayloadKey is the payload key of an escalated alert holding
+	// the id of the alert it escalates, see ID.
+	EscalatedPayloadKey = "escalated_from"
+	// EscalationRulePayloadKey is the payload key of an escalated alert, or
+	// of an alert delivered to the notifiers of a rule, holding the name of
+	// the rule.
+	EscalationRulePayloadKey = "escalation_rule"
+
+	escalationNotFound Error = "escalation rule not found"
+	invalidEscalation  Error = "invalid escalation rule"
+)
+
+// EscalationRule escalates the alerts of an alert type left unacknowledged
+// for too long or raised too many times. An alert is escalated once it is
+// older than After, since first seen, or once its Count exceeds MaxCount,
+// by raising an alert of type EscalateTo on the same resource, with
+// Severity, and by delivering it to the Notifiers, regardless of their
+// filters and of the routes. The acknowledged, cleared, silenced alerts and
+// those in maintenance are not escalated. An alert is escalated once by a
+// rule, again if raised anew without deduplication.
+type EscalationRule struct {
+	// Name identifies the rule
+	Name string `json:"name"`
+	// ResourceType of the alerts escalated, all if RESOURCE_TYPE_NONE
+	ResourceType api.ResourceType `json:"resource_type"`
+	// AlertType of the alerts escalated
+	AlertType int64 `json:"alert_type"`
+	// After escalates the alerts unacknowledged for this long, never if zero
+	After time.Duration `json:"after,omitempty"`
+	// MaxCount escalates the alerts raised more than this many times, never
+	// if zero
+	MaxCount int64 `json:"max_count,omitempty"`
+	// EscalateTo is the alert type of the alert raised, none if zero
+	EscalateTo int64 `json:"escalate_to,omitempty"`
+	// Severity of the alert raised, SEVERITY_TYPE_ALARM if unset
+	Severity api.SeverityType `json:"severity,omitempty"`
+	// Notifiers are the names of the notifiers the escalated alert is
+	// delivered to
+	Notifiers []string `json:"notifiers,omitempty"`
+}
+
+// Matches returns true if r escalates alert at time now.
+func (r *EscalationRule) Matches(alert *api.Alert, now time.Time) bool {
+	if r.ResourceType != api.ResourceType_RESOURCE_TYPE_NONE && r.ResourceType != alert.GetResource() {
+		return false
+	}
+	if r.AlertType != alert.GetAlertType() || alert.GetAcknowledged() || alert.GetCleared() {
+		return false
+	}
+	if r.MaxCount > 0 && alert.GetCount() > r.MaxCount {
+		return true
+	}
+	if r.After > 0 {
+		if since := firstSeen(alert); since != 0 && now.Unix()-since >= int64(r.After/time.Second) {
+			return true
+		}
+	}
+	return false
+}
+
+// escalations tracks the alerts escalated by every rule.
+type escalations struct {
+	sync.Mutex
+	// escalated is the first seen time of the alerts escalated
+	escalated map[escalatedKey]int64
+}
+
+// escalatedKey identifies the escalation of an alert by a rule.
+type escalatedKey struct {
+	rule  string
+	alert string
+}
+
+// firstSeen returns the unix time when alert was first raised, zero if
+// unknown.
+func firstSeen(alert *api.Alert) int64 {
+	if alert.GetFirstSeen() != nil {
+		return alert.GetFirstSeen().Seconds
+	}
+	return alert.GetTimestamp().GetSeconds()
+}
+
+func (m *manager) AddEscalationRule(r *EscalationRule) error {
+	if len(r.Name) == 0 || strings.Contains(r.Name, "/") {
+		return invalidEscalation.Tag("invalid name")
+	}
+	if r.After < 0 || r.MaxCount < 0 || (r.After == 0 && r.MaxCount == 0) {
+		return invalidEscalation.Tag("missing after or max count")
+	}
+	if r.EscalateTo == 0 && len(r.Notifiers) == 0 {
+		return invalidEscalation.Tag("missing alert type or notifiers to escalate to")
+	}
+	if r.EscalateTo == r.AlertType {
+		return invalidEscalation.Tag("escalates to the same alert type")
+	}
+	if r.Severity == api.SeverityType_SEVERITY_TYPE_NONE {
+		r.Severity = api.SeverityType_SEVERITY_TYPE_ALARM
+	}
+	return m.store.PutEscalationRule(r)
+}
+
+func (m *manager) DeleteEscalationRule(name string) error {
+	return m.store.DeleteEscalationRule(name)
+}
+
+func (m *manager) EnumerateEscalationRules() ([]*EscalationRule, error) {
+	rules, err := m.store.EnumerateEscalationRules()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Name < rules[j].Name
+	})
+	return rules, nil
+}
+
+// escalations evaluates the escalation rules every escalation interval.
+func (m *manager) escalations() {
+	for range time.Tick(m.escalationInterval) {
+		if n, err := m.escalate(time.Now()); err != nil {
+			logrus.WithField("pkg", "openstorage/alerts").WithField("func", "escalate").Error(err)
+		} else if n > 0 {
+			logrus.WithField("pkg", "openstorage/alerts").Infof("Escalated %d alerts", n)
+		}
+	}
+}
+
+// escalate escalates the alerts matched by the escalation rules at now and
+// returns how many were escalated. The rules are read from the store on
+// every evaluation, so that their changes apply without a restart.
+func (m *manager) escalate(now time.Time) (int, error) {
+	rules, err := m.EnumerateEscalationRules()
+	if err != nil || len(rules) == 0 {
+		return 0, err
+	}
+	stored, err := m.store.Enumerate(Query{})
+	if err != nil {
+		return 0, err
+	}
+	silences, err := m.EnumerateSilences()
+	if err != nil {
+		return 0, err
+	}
+	windows, err := m.EnumerateMaintenanceWindows()
+	if err != nil {
+		return 0, err
+	}
+
+	m.escalation.Lock()
+	defer m.escalation.Unlock()
+	if m.escalation.escalated == nil {
+		m.escalation.escalated = make(map[escalatedKey]int64)
+	}
+	// the escalations of the alerts deleted or raised anew are forgotten
+	current := make(map[string]int64, len(stored))
+	for _, alert := range stored {
+		current[alertKey(alert)] = firstSeen(alert)
+	}
+	for key, seen := range m.escalation.escalated {
+		if s, ok := current[key.alert]; !ok || s != seen {
+			delete(m.escalation.escalated, key)
+		}
+	}
+
+	n := 0
+	for _, r := range rules {
+		for _, alert := range stored {
+			key := escalatedKey{rule: r.Name, alert: alertKey(alert)}
+			if _, ok := m.escalation.escalated[key]; ok {
+				continue
+			}
+			if !r.Matches(alert, now) || m.expired(alert, now) ||
+				silenced(silences, alert) || inMaintenance(windows, alert) {
+				continue
+			}
+			if err := m.escalateAlert(r, alert); err != nil {
+				return n, err
+			}
+			m.escalation.escalated[key] = firstSeen(alert)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// escalateAlert raises the alert escalating alert per r and delivers it to the
+// notifiers of r.
+func (m *manager) escalateAlert(r *EscalationRule, alert *api.Alert) error {
+	escalated := alert
+	if r.EscalateTo != 0 {
+		escalated = &api.Alert{
+			AlertType:  r.EscalateTo,
+			Resource:   alert.GetResource(),
+			ResourceId: alert.GetResourceId(),
+			Severity:   r.Severity,
+			Message:    alert.GetMessage(),
+			Payload: map[string]string{
+				EscalatedPayloadKey:      ID(alert),
+				EscalationRulePayloadKey: r.Name,
+			},
+		}
+		if err := m.Raise(escalated, NewDedupeOption()); err != nil {
+			return err
+		}
+	}
+	if len(r.Notifiers) == 0 {
+		return nil
+	}
+	escalated = proto.Clone(escalated).(*api.Alert)
+	if escalated.Payload == nil {
+		escalated.Payload = make(map[string]string)
+	}
+	escalated.Payload[EscalationRulePayloadKey] = r.Name
+
+	m.Lock()
+	defer m.Unlock()
+	for _, name := range r.Notifiers {
+		s, ok := m.notifiers[name]
+		if !ok {
+			logrus.WithField("pkg", "openstorage/alerts").
+				WithField("notifier", name).
+				Warnf("Escalation rule %s names an unknown notifier", r.Name)
+			continue
+		}
+		select {
+		case s.alerts <- proto.Clone(escalated).(*api.Alert):
+		default:
+			logrus.WithField("pkg", "openstorage/alerts").
+				WithField("notifier", name).
+				Warnf("Dropped escalated alert %s, the notifier is not keeping up", ID(escalated))
+		}
+	}
+	return nil
+}
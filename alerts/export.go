@@ -0,0 +1,67 @@
+package alerts
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	// SnapshotVersion is the version of the snapshots written by Export
+	SnapshotVersion = 1
+
+	invalidSnapshot Error = "invalid alerts snapshot"
+)
+
+// Snapshot is the JSON document of the alerts written by Export and read by
+// Import.
+type Snapshot struct {
+	// Version of the snapshot format, SnapshotVersion
+	Version int `json:"version"`
+	// ExportedAt is when the alerts were exported
+	ExportedAt time.Time `json:"exported_at"`
+	// Alerts exported, in key order
+	Alerts []*api.Alert `json:"alerts"`
+}
+
+func (m *manager) Export(w io.Writer, filters ...Filter) error {
+	alerts, _, err := m.enumeratePage(&page{includeSilenced: true}, filters...)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(&Snapshot{
+		Version:    SnapshotVersion,
+		ExportedAt: time.Now().UTC(),
+		Alerts:     alerts,
+	})
+}
+
+func (m *manager) Import(r io.Reader) error {
+	var s Snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return invalidSnapshot.Tag(Error(err.Error()))
+	}
+	if s.Version != SnapshotVersion {
+		return invalidSnapshot.Tag("unsupported version")
+	}
+	for _, alert := range s.Alerts {
+		if err := checkPayload(alert.Payload); err != nil {
+			return err
+		}
+	}
+	for _, alert := range s.Alerts {
+		ttl := alert.Ttl
+		if alert.Cleared {
+			ttl = m.ttl
+		}
+		// the alerts are restored as exported, without notifying them again
+		if err := m.store.Put(alert, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
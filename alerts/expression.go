@@ -0,0 +1,521 @@
+package alerts
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const invalidExpression Error = "invalid expression"
+
+// Expression is a compiled boolean expression over the fields of an alert, such
+// as "alert.Count > 5 && alert.Resource == 'VOLUME'", matched by
+// NewExpressionFilter. Unlike the function of a custom filter, an expression is
+// a string which crosses the API boundary.
+//
+// The fields are those of api.Alert, named as in Go or in the API, such as
+// alert.ResourceId or alert.resource_id, and alert.Payload['key'] for the
+// values of the payload, empty if missing. Timestamp and FirstSeen are in
+// seconds since the epoch. Resource and Severity compare to the names of their
+// values, with or without their prefix, such as 'VOLUME' or 'ALARM'.
+//
+// The operators are, by increasing precedence, ||, && and !, then the
+// comparisons ==, !=, <, <=, >, >= and =~, !~ matching a regular expression.
+// Strings are quoted with single or double quotes.
+type Expression struct {
+	source string
+	root   exprNode
+}
+
+// CompileExpression parses expression and returns it if its fields, literals
+// and operators are valid and it is boolean.
+func CompileExpression(expression string) (*Expression, error) {
+	p := &exprParser{source: expression}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, p.errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	if root.kind() != exprBool {
+		return nil, invalidExpression.Tag(Error(fmt.Sprintf("%q is not boolean", expression)))
+	}
+	return &Expression{source: expression, root: root}, nil
+}
+
+// String returns the source of the expression.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Match evaluates the expression for alert.
+func (e *Expression) Match(alert *api.Alert) bool {
+	return e.root.eval(alert).b
+}
+
+// exprKind is the type of the value of an expression node.
+type exprKind int
+
+const (
+	exprBool exprKind = iota
+	exprNumber
+	exprString
+	// exprEnum is the name of an enum value, its prefix trimmed
+	exprEnum
+)
+
+func (k exprKind) String() string {
+	return [...]string{"boolean", "number", "string", "enum"}[k]
+}
+
+// exprValue is the value of an expression node.
+type exprValue struct {
+	b bool
+	n float64
+	s string
+}
+
+// exprNode is a node of the syntax tree of an expression. The kinds of the
+// nodes are checked when compiled, their evaluation does not fail.
+type exprNode interface {
+	kind() exprKind
+	eval(alert *api.Alert) exprValue
+}
+
+// exprField is a field of an alert.
+type exprField struct {
+	k exprKind
+	// prefix of the enum values
+	prefix string
+	// names are the names of the enum values, by name without prefix
+	names map[string]bool
+	get   func(alert *api.Alert) exprValue
+}
+
+func (f *exprField) kind() exprKind                  { return f.k }
+func (f *exprField) eval(alert *api.Alert) exprValue { return f.get(alert) }
+
+// enumNames returns the names of the values of an enum, without prefix.
+func enumNames(values map[string]int32, prefix string) map[string]bool {
+	names := make(map[string]bool, len(values))
+	for name := range values {
+		names[strings.TrimPrefix(name, prefix)] = true
+	}
+	return names
+}
+
+// exprFields are the fields of the alerts, by lowercase name without
+// underscores.
+var exprFields = map[string]*exprField{
+	"id": {k: exprNumber, get: func(a *api.Alert) exprValue {
+		return exprValue{n: float64(a.GetId())}
+	}},
+	"alerttype": {k: exprNumber, get: func(a *api.Alert) exprValue {
+		return exprValue{n: float64(a.GetAlertType())}
+	}},
+	"severity": {k: exprEnum, prefix: "SEVERITY_TYPE_",
+		names: enumNames(api.SeverityType_value, "SEVERITY_TYPE_"),
+		get: func(a *api.Alert) exprValue {
+			return exprValue{s: strings.TrimPrefix(a.GetSeverity().String(), "SEVERITY_TYPE_")}
+		}},
+	"timestamp": {k: exprNumber, get: func(a *api.Alert) exprValue {
+		return exprValue{n: float64(a.GetTimestamp().GetSeconds())}
+	}},
+	"resourceid": {k: exprString, get: func(a *api.Alert) exprValue {
+		return exprValue{s: a.GetResourceId()}
+	}},
+	"resource": {k: exprEnum, prefix: "RESOURCE_TYPE_",
+		names: enumNames(api.ResourceType_value, "RESOURCE_TYPE_"),
+		get: func(a *api.Alert) exprValue {
+			return exprValue{s: strings.TrimPrefix(a.GetResource().String(), "RESOURCE_TYPE_")}
+		}},
+	"message": {k: exprString, get: func(a *api.Alert) exprValue {
+		return exprValue{s: a.GetMessage()}
+	}},
+	"cleared": {k: exprBool, get: func(a *api.Alert) exprValue {
+		return exprValue{b: a.GetCleared()}
+	}},
+	"ttl": {k: exprNumber, get: func(a *api.Alert) exprValue {
+		return exprValue{n: float64(a.GetTtl())}
+	}},
+	"uniquetag": {k: exprString, get: func(a *api.Alert) exprValue {
+		return exprValue{s: a.GetUniqueTag()}
+	}},
+	"count": {k: exprNumber, get: func(a *api.Alert) exprValue {
+		return exprValue{n: float64(a.GetCount())}
+	}},
+	"firstseen": {k: exprNumber, get: func(a *api.Alert) exprValue {
+		return exprValue{n: float64(a.GetFirstSeen().GetSeconds())}
+	}},
+	"acknowledged": {k: exprBool, get: func(a *api.Alert) exprValue {
+		return exprValue{b: a.GetAcknowledged()}
+	}},
+	"reasoncode": {k: exprString, get: func(a *api.Alert) exprValue {
+		return exprValue{s: a.GetReasonCode()}
+	}},
+}
+
+// exprLiteral is a constant.
+type exprLiteral struct {
+	k exprKind
+	v exprValue
+}
+
+func (l *exprLiteral) kind() exprKind            { return l.k }
+func (l *exprLiteral) eval(*api.Alert) exprValue { return l.v }
+
+// exprNot negates a boolean node.
+type exprNot struct {
+	x exprNode
+}
+
+func (n *exprNot) kind() exprKind { return exprBool }
+func (n *exprNot) eval(alert *api.Alert) exprValue {
+	return exprValue{b: !n.x.eval(alert).b}
+}
+
+// exprLogical is a && or || of boolean nodes, evaluated lazily.
+type exprLogical struct {
+	and         bool
+	left, right exprNode
+}
+
+func (l *exprLogical) kind() exprKind { return exprBool }
+func (l *exprLogical) eval(alert *api.Alert) exprValue {
+	left := l.left.eval(alert).b
+	if l.and != left {
+		return exprValue{b: left}
+	}
+	return l.right.eval(alert)
+}
+
+// exprCompare compares two nodes of the same kind.
+type exprCompare struct {
+	op          string
+	left, right exprNode
+	// re is the regular expression of =~ and !~
+	re *regexp.Regexp
+}
+
+func (c *exprCompare) kind() exprKind { return exprBool }
+func (c *exprCompare) eval(alert *api.Alert) exprValue {
+	left := c.left.eval(alert)
+	if c.re != nil {
+		return exprValue{b: c.re.MatchString(left.s) == (c.op == "=~")}
+	}
+	right := c.right.eval(alert)
+	var cmp int
+	switch c.left.kind() {
+	case exprNumber:
+		switch {
+		case left.n < right.n:
+			cmp = -1
+		case left.n > right.n:
+			cmp = 1
+		}
+	case exprBool:
+		if left.b != right.b {
+			cmp = 1
+		}
+	default:
+		cmp = strings.Compare(left.s, right.s)
+	}
+	switch c.op {
+	case "==":
+		return exprValue{b: cmp == 0}
+	case "!=":
+		return exprValue{b: cmp != 0}
+	case "<":
+		return exprValue{b: cmp < 0}
+	case "<=":
+		return exprValue{b: cmp <= 0}
+	case ">":
+		return exprValue{b: cmp > 0}
+	}
+	return exprValue{b: cmp >= 0}
+}
+
+// exprPayload is the value of a key of the payload.
+type exprPayload struct {
+	key string
+}
+
+func (p *exprPayload) kind() exprKind { return exprString }
+func (p *exprPayload) eval(alert *api.Alert) exprValue {
+	return exprValue{s: alert.GetPayload()[p.key]}
+}
+
+// exprToken is a lexical token of an expression.
+type exprToken struct {
+	// typ is one of ident, number, string or op
+	typ  string
+	text string
+	// value of a string token, unquoted
+	value string
+	pos   int
+}
+
+// exprParser parses an expression by recursive descent.
+type exprParser struct {
+	source string
+	tokens []exprToken
+	pos    int
+}
+
+func (p *exprParser) errorf(format string, args ...interface{}) error {
+	return invalidExpression.Tag(Error(fmt.Sprintf("%q: ", p.source) + fmt.Sprintf(format, args...)))
+}
+
+// exprOperators are the operators, longest first.
+var exprOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "=~", "!~", "<", ">", "!", "(", ")", "[", "]", "."}
+
+func (p *exprParser) tokenize() error {
+	s := p.source
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '_' || unicode.IsLetter(c):
+			j := i
+			for j < len(s) && (s[j] == '_' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			p.tokens = append(p.tokens, exprToken{typ: "ident", text: s[i:j], pos: i})
+			i = j
+		case unicode.IsDigit(c) || (c == '-' && i+1 < len(s) && unicode.IsDigit(rune(s[i+1]))):
+			j := i + 1
+			for j < len(s) && (unicode.IsDigit(rune(s[j])) || s[j] == '.') {
+				j++
+			}
+			p.tokens = append(p.tokens, exprToken{typ: "number", text: s[i:j], pos: i})
+			i = j
+		case c == '\'' || c == '"':
+			var value []byte
+			j := i + 1
+			for ; j < len(s) && rune(s[j]) != c; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				value = append(value, s[j])
+			}
+			if j == len(s) {
+				return p.errorf("unterminated string at %d", i)
+			}
+			p.tokens = append(p.tokens, exprToken{typ: "string", text: s[i : j+1], value: string(value), pos: i})
+			i = j + 1
+		default:
+			op := ""
+			for _, o := range exprOperators {
+				if strings.HasPrefix(s[i:], o) {
+					op = o
+					break
+				}
+			}
+			if len(op) == 0 {
+				return p.errorf("unexpected %q at %d", s[i], i)
+			}
+			p.tokens = append(p.tokens, exprToken{typ: "op", text: op, pos: i})
+			i += len(op)
+		}
+	}
+	return nil
+}
+
+// next returns the next token if it is the operator op.
+func (p *exprParser) next(op string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].typ == "op" && p.tokens[p.pos].text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) expect(op string) error {
+	if !p.next(op) {
+		if p.pos < len(p.tokens) {
+			return p.errorf("expected %q at %d, found %q", op, p.tokens[p.pos].pos, p.tokens[p.pos].text)
+		}
+		return p.errorf("expected %q at the end", op)
+	}
+	return nil
+}
+
+// parseOr parses and { "||" and }.
+func (p *exprParser) parseOr() (exprNode, error) {
+	return p.parseLogical(false, p.parseAnd)
+}
+
+// parseAnd parses unary { "&&" unary }.
+func (p *exprParser) parseAnd() (exprNode, error) {
+	return p.parseLogical(true, p.parseUnary)
+}
+
+func (p *exprParser) parseLogical(and bool, parse func() (exprNode, error)) (exprNode, error) {
+	op := "||"
+	if and {
+		op = "&&"
+	}
+	left, err := parse()
+	if err != nil {
+		return nil, err
+	}
+	for p.next(op) {
+		right, err := parse()
+		if err != nil {
+			return nil, err
+		}
+		if left.kind() != exprBool || right.kind() != exprBool {
+			return nil, p.errorf("operands of %s must be boolean", op)
+		}
+		left = &exprLogical{and: and, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseUnary parses "!" unary or a comparison.
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.next("!") {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if x.kind() != exprBool {
+			return nil, p.errorf("operand of ! must be boolean")
+		}
+		return &exprNot{x: x}, nil
+	}
+	return p.parseComparison()
+}
+
+// parseComparison parses operand [ op operand ].
+func (p *exprParser) parseComparison() (exprNode, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos == len(p.tokens) || p.tokens[p.pos].typ != "op" {
+		return left, nil
+	}
+	op := p.tokens[p.pos].text
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=", "=~", "!~":
+		p.pos++
+	default:
+		return left, nil
+	}
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	// strings compare to the enums by name, on either side
+	if l, ok := left.(*exprLiteral); ok && right.kind() == exprEnum && (op == "==" || op == "!=") {
+		left, right = right, l
+	}
+	if f, ok := left.(*exprField); ok && f.k == exprEnum {
+		if l, ok := right.(*exprLiteral); ok && l.k == exprString && op != "=~" && op != "!~" {
+			name := strings.TrimPrefix(strings.ToUpper(l.v.s), f.prefix)
+			if !f.names[name] {
+				return nil, p.errorf("unknown value %q", l.v.s)
+			}
+			right = &exprLiteral{k: exprEnum, v: exprValue{s: name}}
+		}
+	}
+
+	c := &exprCompare{op: op, left: left, right: right}
+	switch op {
+	case "=~", "!~":
+		l, ok := right.(*exprLiteral)
+		if !ok || l.k != exprString {
+			return nil, p.errorf("operand of %s must be a string regular expression", op)
+		}
+		if left.kind() != exprString && left.kind() != exprEnum {
+			return nil, p.errorf("%s only matches strings", op)
+		}
+		if c.re, err = regexp.Compile(l.v.s); err != nil {
+			return nil, p.errorf("%v", err)
+		}
+	case "<", "<=", ">", ">=":
+		if left.kind() != exprNumber && left.kind() != exprString {
+			return nil, p.errorf("%s does not compare %s values", op, left.kind())
+		}
+		fallthrough
+	default:
+		if left.kind() != right.kind() {
+			return nil, p.errorf("%s compares a %s with a %s", op, left.kind(), right.kind())
+		}
+	}
+	return c, nil
+}
+
+// parseOperand parses "(" or ")", a literal or a field.
+func (p *exprParser) parseOperand() (exprNode, error) {
+	if p.pos == len(p.tokens) {
+		return nil, p.errorf("unexpected end")
+	}
+	if p.next("(") {
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return x, p.expect(")")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	switch t.typ {
+	case "number":
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", t.text)
+		}
+		return &exprLiteral{k: exprNumber, v: exprValue{n: n}}, nil
+	case "string":
+		return &exprLiteral{k: exprString, v: exprValue{s: t.value}}, nil
+	case "ident":
+		switch t.text {
+		case "true", "false":
+			return &exprLiteral{k: exprBool, v: exprValue{b: t.text == "true"}}, nil
+		case "alert":
+			return p.parseField()
+		}
+	}
+	return nil, p.errorf("unexpected %q at %d", t.text, t.pos)
+}
+
+// parseField parses "." name [ "[" string "]" ], alert parsed.
+func (p *exprParser) parseField() (exprNode, error) {
+	if err := p.expect("."); err != nil {
+		return nil, err
+	}
+	if p.pos == len(p.tokens) || p.tokens[p.pos].typ != "ident" {
+		return nil, p.errorf("expected a field of alert")
+	}
+	name := p.tokens[p.pos].text
+	p.pos++
+	key := strings.ToLower(strings.Replace(name, "_", "", -1))
+	if key == "payload" {
+		if err := p.expect("["); err != nil {
+			return nil, err
+		}
+		if p.pos == len(p.tokens) || p.tokens[p.pos].typ != "string" {
+			return nil, p.errorf("expected a payload key")
+		}
+		payloadKey := p.tokens[p.pos].value
+		p.pos++
+		return &exprPayload{key: payloadKey}, p.expect("]")
+	}
+	f, ok := exprFields[key]
+	if !ok {
+		return nil, p.errorf("unknown field alert.%s", name)
+	}
+	return f, nil
+}
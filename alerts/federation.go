@@ -0,0 +1,463 @@
+package alerts
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ClusterIDPayloadKey is the payload key tagging the federated alerts with
+	// the id of their cluster.
+	ClusterIDPayloadKey = "cluster_id"
+	// ClusterIDHeader is the header of the requests pushing the alerts of a
+	// cluster to the handler of a federation.
+	ClusterIDHeader = "X-Cluster-Id"
+	// DefaultPullInterval is the interval of the pulls of the alerts of a
+	// cluster if none is set.
+	DefaultPullInterval = time.Minute
+	// pullTimeout bounds a pull of the alerts of a cluster
+	pullTimeout = 30 * time.Second
+
+	unknownCluster Error = "unknown cluster"
+	clusterExists  Error = "cluster already federated"
+)
+
+// Source provides the alerts of a cluster pulled by a federation.
+type Source interface {
+	// Enumerate lists all the alerts of the cluster.
+	Enumerate() ([]*api.Alert, error)
+}
+
+// Federation aggregates the alerts of several clusters, pulled from them or
+// pushed by them, behind a single enumerate and watch API. The federated
+// alerts are tagged with the id of their cluster in the ClusterIDPayloadKey
+// payload key, and are ordered by cluster first.
+type Federation interface {
+	FilterDeleter
+	// AddCluster federates the cluster identified by id.
+	AddCluster(id string) error
+	// RemoveCluster stops federating the cluster identified by id, dropping its alerts.
+	RemoveCluster(id string) error
+	// Clusters lists the ids of the federated clusters in order.
+	Clusters() []string
+	// Receive stores the alerts pushed by the cluster identified by id.
+	Receive(id string, alerts ...*api.Alert) error
+	// Pull replaces the alerts of the cluster identified by id by those of source every
+	// interval, DefaultPullInterval if zero, until the returned function is called.
+	Pull(id string, source Source, interval time.Duration) (func(), error)
+	// Handler receives the alerts pushed by the webhook notifiers of the clusters, each
+	// request posting an alert in JSON with the id of the cluster in the ClusterIDHeader.
+	Handler() http.Handler
+}
+
+type federation struct {
+	newStore func(id string) Store
+	lock     sync.Mutex
+	members  map[string]*manager
+	watches  map[*federatedWatch]bool
+}
+
+// NewFederation provides a federation storing the alerts of each cluster in the
+// store returned by newStore, in memory if nil.
+func NewFederation(newStore func(id string) Store) Federation {
+	if newStore == nil {
+		newStore = func(string) Store { return NewMemStore() }
+	}
+	return &federation{
+		newStore: newStore,
+		members:  make(map[string]*manager),
+		watches:  make(map[*federatedWatch]bool),
+	}
+}
+
+func (f *federation) AddCluster(id string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.members[id]; ok {
+		return clusterExists.Tag(Error(id))
+	}
+	m, err := newManager(f.newStore(id))
+	if err != nil {
+		return err
+	}
+	f.members[id] = m
+	for w := range f.watches {
+		w.attach(id, m)
+	}
+	return nil
+}
+
+func (f *federation) RemoveCluster(id string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	m, ok := f.members[id]
+	if !ok {
+		return unknownCluster.Tag(Error(id))
+	}
+	delete(f.members, id)
+	for w := range f.watches {
+		w.detach(id)
+	}
+	return m.store.Delete(Query{})
+}
+
+func (f *federation) Clusters() []string {
+	ids, _ := f.clusters()
+	return ids
+}
+
+// member returns the manager of the alerts of cluster id.
+func (f *federation) member(id string) (*manager, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	m, ok := f.members[id]
+	if !ok {
+		return nil, unknownCluster.Tag(Error(id))
+	}
+	return m, nil
+}
+
+// clusters returns the federated clusters and their managers, in order.
+func (f *federation) clusters() ([]string, []*manager) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	ids := make([]string, 0, len(f.members))
+	for id := range f.members {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	members := make([]*manager, len(ids))
+	for i, id := range ids {
+		members[i] = f.members[id]
+	}
+	return ids, members
+}
+
+func (f *federation) Receive(id string, alerts ...*api.Alert) error {
+	m, err := f.member(id)
+	if err != nil {
+		return err
+	}
+	for _, alert := range alerts {
+		if err := storeFederated(m, alert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storeFederated stores alert as received from its cluster, the cluster having
+// counted and notified it already.
+func storeFederated(m *manager, alert *api.Alert) error {
+	ttl := alert.Ttl
+	if alert.Cleared {
+		ttl = m.ttl
+	}
+	return m.store.Put(alert, ttl)
+}
+
+func (f *federation) Pull(id string, source Source, interval time.Duration) (func(), error) {
+	if _, err := f.member(id); err != nil {
+		return nil, err
+	}
+	if interval <= 0 {
+		interval = DefaultPullInterval
+	}
+	done := make(chan struct{})
+	var once sync.Once
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if _, err := f.member(id); err != nil {
+				// the cluster was removed
+				return
+			}
+			if err := f.sync(id, source); err != nil {
+				logrus.WithField("pkg", "openstorage/alerts").WithField("cluster", id).
+					Errorf("Failed to pull the alerts: %v", err)
+			}
+			select {
+			case <-ticker.C:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() { once.Do(func() { close(done) }) }, nil
+}
+
+// sync replaces the alerts of cluster id by those of source, leaving the
+// unchanged alerts as they are.
+func (f *federation) sync(id string, source Source) error {
+	pulled, err := source.Enumerate()
+	if err != nil {
+		return err
+	}
+	m, err := f.member(id)
+	if err != nil {
+		return err
+	}
+	stored, err := m.store.Enumerate(Query{})
+	if err != nil {
+		return err
+	}
+	current := make(map[string]*api.Alert, len(stored))
+	for _, alert := range stored {
+		current[alertKey(alert)] = alert
+	}
+	for _, alert := range pulled {
+		key := alertKey(alert)
+		old, ok := current[key]
+		delete(current, key)
+		if ok && proto.Equal(old, alert) {
+			continue
+		}
+		if err := storeFederated(m, alert); err != nil {
+			return err
+		}
+	}
+	// the alerts left were deleted from the cluster
+	for _, alert := range current {
+		if _, err := m.store.CompareAndDelete(alert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tagCluster returns a copy of alert tagged with cluster id.
+func tagCluster(id string, alert *api.Alert) *api.Alert {
+	tagged := proto.Clone(alert).(*api.Alert)
+	payload := make(map[string]string, len(alert.Payload)+1)
+	for k, v := range alert.Payload {
+		payload[k] = v
+	}
+	payload[ClusterIDPayloadKey] = id
+	tagged.Payload = payload
+	return tagged
+}
+
+func (f *federation) Enumerate(filters ...Filter) ([]*api.Alert, error) {
+	alerts, _, err := f.enumeratePage(&page{}, filters...)
+	return alerts, err
+}
+
+func (f *federation) EnumerateWithOptions(options []Option, filters ...Filter) ([]*api.Alert, string, error) {
+	p, err := newPage(options)
+	if err != nil {
+		return nil, "", err
+	}
+	return f.enumeratePage(p, filters...)
+}
+
+// enumeratePage returns the alerts of page p matching filters across the
+// clusters, keyed by cluster id and alert key.
+func (f *federation) enumeratePage(p *page, filters ...Filter) ([]*api.Alert, string, error) {
+	var entries []*pageEntry
+	ids, members := f.clusters()
+	for i, m := range members {
+		alerts, _, err := m.enumeratePage(&page{includeSilenced: p.includeSilenced}, filters...)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, alert := range alerts {
+			entries = append(entries, p.entry(ids[i]+"/"+alertKey(alert), tagCluster(ids[i], alert)))
+		}
+	}
+	alerts, token := p.collect(entries)
+	return alerts, token, nil
+}
+
+func (f *federation) Filter(alerts []*api.Alert, filters ...Filter) ([]*api.Alert, error) {
+	return filterAlerts(alerts, filters...)
+}
+
+func (f *federation) Delete(filters ...Filter) error {
+	_, members := f.clusters()
+	for _, m := range members {
+		if err := m.Delete(filters...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// federatedWatch merges the watches of the clusters, including those
+// federated once the watch started.
+type federatedWatch struct {
+	filters []Filter
+	events  chan *WatchEvent
+	done    chan struct{}
+	once    sync.Once
+	lock    sync.Mutex
+	stops   map[string]func()
+	wg      sync.WaitGroup
+}
+
+func (f *federation) Watch(filters ...Filter) (<-chan *WatchEvent, func(), error) {
+	// the filters are checked once, rather than on the next cluster federated
+	if _, err := getUniqueKeysFromFilters(filters...); err != nil {
+		return nil, nil, err
+	}
+	w := &federatedWatch{
+		filters: filters,
+		events:  make(chan *WatchEvent, watchBuffer),
+		done:    make(chan struct{}),
+		stops:   make(map[string]func()),
+	}
+	f.lock.Lock()
+	for id, m := range f.members {
+		w.attach(id, m)
+	}
+	f.watches[w] = true
+	f.lock.Unlock()
+
+	stop := func() {
+		f.lock.Lock()
+		delete(f.watches, w)
+		f.lock.Unlock()
+		w.stop()
+	}
+	return w.events, stop, nil
+}
+
+// attach watches the alerts of cluster id managed by m.
+func (w *federatedWatch) attach(id string, m *manager) {
+	events, stop, err := m.Watch(w.filters...)
+	if err != nil {
+		logrus.WithField("pkg", "openstorage/alerts").WithField("cluster", id).
+			Errorf("Failed to watch the alerts: %v", err)
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	select {
+	case <-w.done:
+		stop()
+		return
+	default:
+	}
+	w.stops[id] = stop
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		for event := range events {
+			event.Alert = tagCluster(id, event.Alert)
+			select {
+			case w.events <- event:
+			case <-w.done:
+				stop()
+				return
+			}
+		}
+	}()
+}
+
+// detach stops watching the alerts of cluster id.
+func (w *federatedWatch) detach(id string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if stop, ok := w.stops[id]; ok {
+		delete(w.stops, id)
+		stop()
+	}
+}
+
+// stop stops the watches of the clusters and closes the channel of events once
+// their events are forwarded or dropped.
+func (w *federatedWatch) stop() {
+	w.once.Do(func() {
+		close(w.done)
+		w.lock.Lock()
+		for id, stop := range w.stops {
+			delete(w.stops, id)
+			stop()
+		}
+		w.lock.Unlock()
+		w.wg.Wait()
+		close(w.events)
+	})
+}
+
+func (f *federation) Handler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.Header().Set("Allow", http.MethodPost)
+			http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		id := req.Header.Get(ClusterIDHeader)
+		if len(id) == 0 {
+			http.Error(rw, "missing "+ClusterIDHeader+" header", http.StatusBadRequest)
+			return
+		}
+		m, err := f.member(id)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusNotFound)
+			return
+		}
+		alert := new(api.Alert)
+		if err := jsonpb.Unmarshal(io.LimitReader(req.Body, 1<<20), alert); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := storeFederated(m, alert); err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		rw.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// sdkSource pulls the alerts of a cluster through its SDK.
+type sdkSource struct {
+	client api.OpenStorageAlertsClient
+}
+
+// NewSDKSource provides a source pulling the alerts of a cluster through the
+// alerts service of its SDK on conn.
+func NewSDKSource(conn *grpc.ClientConn) Source {
+	return &sdkSource{client: api.NewOpenStorageAlertsClient(conn)}
+}
+
+func (s *sdkSource) Enumerate() ([]*api.Alert, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), pullTimeout)
+	defer cancel()
+
+	// a query per resource type selects all the alerts
+	req := new(api.SdkAlertsEnumerateRequest)
+	for v := range api.ResourceType_name {
+		if resourceType := api.ResourceType(v); resourceType != api.ResourceType_RESOURCE_TYPE_NONE {
+			req.Queries = append(req.Queries, &api.SdkAlertsQuery{
+				Query: &api.SdkAlertsQuery_ResourceTypeQuery{
+					ResourceTypeQuery: &api.SdkAlertsResourceTypeQuery{ResourceType: resourceType},
+				},
+			})
+		}
+	}
+	stream, err := s.client.Enumerate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var alerts []*api.Alert
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return alerts, nil
+		} else if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, resp.GetAlerts()...)
+	}
+}
@@ -1,7 +1,9 @@
 package alerts
 
 import (
+	"path"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -40,7 +42,8 @@ type FilterType int
 // since filters are sorted based on this ordering and such sorting is done to
 // properly query kvdb tree structure.
 //
-// kvdb tree struct is defined as alerts/<resourceType>/<alertType>/<resourceID>/data
+// kvdb tree struct is defined as alerts/<resourceType>/<alertType>/<resourceID>/data, the alerts
+// of a namespace being at alerts/<namespace>/<resourceType>/<alertType>/<resourceID>/data
 //
 // Input filters are sorted based
 const (
@@ -55,10 +58,11 @@ const (
 	CustomFilter FilterType = iota
 	// timeSpanFilter is based on a start and end timestamp. All alert entries are fetched from kvdb
 	// and then parsed to see if the timestamp for each entry falls within the start and end timestamp
-	// of this filter. Matching entries are returned.
+	// of this filter, a zero start or end leaving the span unbounded. Matching entries are returned.
 	// This filter is not an efficient filter.
 	timeSpanFilter
-	// countSpanFilter parses on the count value of alert entries. This filter requires pulling all entries
+	// countSpanFilter parses on the count value of alert entries, matching a count within a range,
+	// unbounded above if its max is negative. This filter requires pulling all entries
 	// and is, therefore, not an efficient filter.
 	countSpanFilter
 	// minSeverityFilter matches on the alert severity if it is more than or equal to severity set in this filter.
@@ -66,9 +70,16 @@ const (
 	// recommended approach is to fetch alerts from kvdb using one of the efficient filters, then
 	// filter the fetched alerts using this filter.
 	minSeverityFilter
+	// severityFilter compares the alert severity with the severity set in this filter using a
+	// SeverityOperator. Like minSeverityFilter, it is not an efficient filter and is best used as
+	// an option of one of the efficient filters.
+	severityFilter
 	// flagCheckFilter matches on the clear alert flag. This filter should be used for filtering the fetched
 	// alerts and not directly for fetching alerts from kvdb since this is not an efficient filter.
 	flagCheckFilter
+	// stateFilter matches on the lifecycle state of alerts. Like flagCheckFilter, it is not an
+	// efficient filter and is best used as an option of one of the efficient filters.
+	stateFilter
 	// matchResourceIDFilter takes only one argument, i.e., resource id. It fetches all entries from kvdb
 	// then parses them to see resource id's are matching. Matching entries are returned.
 	// This filter is not an efficient filter since it requires pulling all entries.
@@ -81,11 +92,47 @@ const (
 	// Recommend to use alertTypeFilter if resource type info is also known for
 	// efficient querying.
 	matchAlertTypeFilter
+	// matchResourceIDRegexFilter takes a regular expression and matches alerts whose resource id
+	// it matches, such as all volumes with a common prefix. It fetches all entries from kvdb,
+	// therefore, it is not an efficient filter. Use it as an option of an efficient filter
+	// if resource type and alert type info is also known.
+	matchResourceIDRegexFilter
+	// matchResourceIDGlobFilter is similar to matchResourceIDRegexFilter but takes a shell
+	// pattern, such as "pvc-*", instead of a regular expression.
+	matchResourceIDGlobFilter
+	// matchPayloadFilter matches alerts whose payload has a key, with one of the given values
+	// if any. It fetches all entries from kvdb, therefore, it is not an efficient filter.
+	matchPayloadFilter
+	// tagFilter matches alerts annotated with a key, with one of the given values if any. It
+	// fetches all entries from kvdb, therefore, it is not an efficient filter.
+	tagFilter
+	// searchFilter matches alerts whose message or payload values have a word starting with
+	// every term of a search. It fetches all entries from kvdb, therefore, it is not an
+	// efficient filter, unless the manager caches the alerts and their words.
+	searchFilter
+	// expressionFilter matches alerts for which a compiled Expression is true. It fetches all
+	// entries from kvdb, therefore, it is not an efficient filter. Use it as an option of an
+	// efficient filter, or with one in an andFilter, to narrow the alerts fetched.
+	expressionFilter
+	// andFilter matches alerts matched by all the filters it wraps. It fetches from the most
+	// selective sub tree of the wrapped filters, therefore, it is as efficient as its most
+	// efficient filter.
+	andFilter
+	// orFilter matches alerts matched by at least one of the filters it wraps. It fetches from
+	// the sub trees of all the wrapped filters and is only efficient if they all are.
+	orFilter
+	// notFilter matches alerts not matched by the filter it wraps. It fetches all entries from
+	// kvdb and is, therefore, not an efficient filter.
+	notFilter
 
 	// Filter types listed below provide more efficient querying into kvdb by directly querying kvdb sub tree.
 	// These filters reach a sub tree in kvdb and only fetch some alerts, therefore, these are called efficient
-	// filters.
+	// filters. The resource type, alert type and resource id filters match the alerts of the default
+	// namespace, or of the namespace of their namespace option.
 
+	// namespaceFilter takes a namespace and fetches all alert entries under that namespace prefix,
+	// i.e., the alerts of a tenant.
+	namespaceFilter
 	// resourceTypeFilter takes resource type and fetches all alert entries under that resource type prefix.
 	// Since resource type is a top level indexing of data, it always performs querying efficiently without
 	// requiring any further filtering.
@@ -113,6 +160,46 @@ type timeZone struct {
 	stop  time.Time
 }
 
+// SeverityOperator defines how a severity filter compares alert severities. Severities are
+// ordered from NOTIFY, the least severe, to ALARM, the most severe.
+type SeverityOperator int
+
+// SeverityOperator constants.
+const (
+	// SeverityEqual matches alerts of exactly the filter severity.
+	SeverityEqual SeverityOperator = iota
+	// SeverityAtLeast matches alerts at least as severe as the filter severity (>=).
+	SeverityAtLeast
+	// SeverityAtMost matches alerts at most as severe as the filter severity (<=).
+	SeverityAtMost
+)
+
+// severityInfo contains information about a severity comparison.
+type severityInfo struct {
+	operator SeverityOperator
+	severity api.SeverityType
+}
+
+// severityLevel ranks severities so that more severe alerts have a higher level.
+// Alerts without severity rank lowest.
+func severityLevel(severity api.SeverityType) int {
+	switch severity {
+	case api.SeverityType_SEVERITY_TYPE_NOTIFY:
+		return 1
+	case api.SeverityType_SEVERITY_TYPE_WARNING:
+		return 2
+	case api.SeverityType_SEVERITY_TYPE_ALARM:
+		return 3
+	}
+	return 0
+}
+
+// payloadInfo contains information about a payload or an annotation match.
+type payloadInfo struct {
+	key    string
+	values []string
+}
+
 type alertInfo struct {
 	alertType    int64
 	resourceType api.ResourceType
@@ -144,8 +231,10 @@ func (f *filter) Match(alert *api.Alert) (bool, error) {
 				Tag("timeSpanFilter").
 				Tag("func Match")
 		}
-		if alert.Timestamp.Seconds >= v.start.Unix() &&
-			alert.Timestamp.Seconds <= v.stop.Unix() {
+		// a zero start or stop leaves the span open ended
+		seconds := alert.GetTimestamp().GetSeconds()
+		if (v.start.IsZero() || seconds >= v.start.Unix()) &&
+			(v.stop.IsZero() || seconds <= v.stop.Unix()) {
 			return true, nil
 		}
 		return false, nil
@@ -160,6 +249,84 @@ func (f *filter) Match(alert *api.Alert) (bool, error) {
 			return true, nil
 		}
 		return false, nil
+	case matchResourceIDRegexFilter:
+		v, ok := f.value.(*regexp.Regexp)
+		if !ok || v == nil {
+			return false, typeAssertionError.
+				Tag("matchResourceIDRegexFilter").
+				Tag("func Match")
+		}
+		return v.MatchString(alert.ResourceId), nil
+	case matchResourceIDGlobFilter:
+		v, ok := f.value.(string)
+		if !ok {
+			return false, typeAssertionError.
+				Tag("matchResourceIDGlobFilter").
+				Tag("func Match")
+		}
+		matched, err := path.Match(v, alert.ResourceId)
+		if err != nil {
+			return false, incorrectFilterValue.
+				Tag("matchResourceIDGlobFilter").
+				Tag("func Match")
+		}
+		return matched, nil
+	case matchPayloadFilter:
+		v, ok := f.value.(payloadInfo)
+		if !ok {
+			return false, typeAssertionError.
+				Tag("matchPayloadFilter").
+				Tag("func Match")
+		}
+		value, ok := alert.Payload[v.key]
+		if !ok {
+			return false, nil
+		}
+		if len(v.values) == 0 {
+			return true, nil
+		}
+		for _, expected := range v.values {
+			if value == expected {
+				return true, nil
+			}
+		}
+		return false, nil
+	case tagFilter:
+		v, ok := f.value.(payloadInfo)
+		if !ok {
+			return false, typeAssertionError.
+				Tag("tagFilter").
+				Tag("func Match")
+		}
+		value, ok := alert.Annotations[v.key]
+		if !ok {
+			return false, nil
+		}
+		if len(v.values) == 0 {
+			return true, nil
+		}
+		for _, expected := range v.values {
+			if value == expected {
+				return true, nil
+			}
+		}
+		return false, nil
+	case searchFilter:
+		v, ok := f.value.([]string)
+		if !ok {
+			return false, typeAssertionError.
+				Tag("searchFilter").
+				Tag("func Match")
+		}
+		return hasTerms(alertWords(alert), v), nil
+	case expressionFilter:
+		v, ok := f.value.(*Expression)
+		if !ok || v == nil {
+			return false, typeAssertionError.
+				Tag("expressionFilter").
+				Tag("func Match")
+		}
+		return v.Match(alert), nil
 	case matchAlertTypeFilter:
 		v, ok := f.value.(int64)
 		if !ok {
@@ -183,7 +350,8 @@ func (f *filter) Match(alert *api.Alert) (bool, error) {
 				Tag("countSpanFilter").
 				Tag("func Match")
 		}
-		if alert.Count >= v[0] && alert.Count <= v[1] {
+		// a negative max leaves the range unbounded above
+		if alert.Count >= v[0] && (v[1] < 0 || alert.Count <= v[1]) {
 			return true, nil
 		}
 		return false, nil
@@ -206,6 +374,63 @@ func (f *filter) Match(alert *api.Alert) (bool, error) {
 			}
 		}
 		return false, nil
+	case severityFilter:
+		v, ok := f.value.(severityInfo)
+		if !ok {
+			return false, typeAssertionError.
+				Tag("severityFilter").
+				Tag("func Match")
+		}
+		level, target := severityLevel(alert.Severity), severityLevel(v.severity)
+		switch v.operator {
+		case SeverityEqual:
+			return level == target, nil
+		case SeverityAtLeast:
+			return level >= target, nil
+		case SeverityAtMost:
+			return level <= target, nil
+		}
+		return false, incorrectFilterValue.
+			Tag("severityFilter").
+			Tag("func Match")
+	case andFilter:
+		v, ok := f.value.([]Filter)
+		if !ok {
+			return false, typeAssertionError.
+				Tag("andFilter").
+				Tag("func Match")
+		}
+		for _, w := range v {
+			if matched, err := w.Match(alert); err != nil || !matched {
+				return false, err
+			}
+		}
+		return true, nil
+	case orFilter:
+		v, ok := f.value.([]Filter)
+		if !ok {
+			return false, typeAssertionError.
+				Tag("orFilter").
+				Tag("func Match")
+		}
+		for _, w := range v {
+			if matched, err := w.Match(alert); err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	case notFilter:
+		v, ok := f.value.(Filter)
+		if !ok {
+			return false, typeAssertionError.
+				Tag("notFilter").
+				Tag("func Match")
+		}
+		matched, err := v.Match(alert)
+		if err != nil {
+			return false, err
+		}
+		return !matched, nil
 	case flagCheckFilter:
 		v, ok := f.value.(bool)
 		if !ok {
@@ -217,8 +442,44 @@ func (f *filter) Match(alert *api.Alert) (bool, error) {
 			return true, nil
 		}
 		return false, nil
+	case stateFilter:
+		v, ok := f.value.(State)
+		if !ok {
+			return false, typeAssertionError.
+				Tag("stateFilter").
+				Tag("func Match")
+		}
+		return StateOf(alert) == v, nil
 	// Cases below are for efficient filters
 	// -------------------------------------
+	case namespaceFilter:
+		v, ok := f.value.(string)
+		if !ok {
+			return false, typeAssertionError.
+				Tag("namespaceFilter").
+				Tag("func Match")
+		}
+		if Namespace(alert) == v {
+			// iterate through options and match alert
+			for _, opt := range f.options {
+				if w, ok := opt.GetValue().(Filter); !ok {
+					return false, typeAssertionError.
+						Tag("invalid option").
+						Tag("namespaceFilter").
+						Tag("func Match")
+				} else {
+					if matched, err := w.Match(alert); err != nil {
+						return false, err
+					} else {
+						if !matched {
+							return false, nil
+						}
+					}
+				}
+			}
+			return true, nil
+		}
+		return false, nil
 	case resourceTypeFilter:
 		v, ok := f.value.(api.ResourceType)
 		if !ok {
@@ -226,7 +487,7 @@ func (f *filter) Match(alert *api.Alert) (bool, error) {
 				Tag("resourceTypeFilter").
 				Tag("func Match")
 		}
-		if alert.Resource == v {
+		if Namespace(alert) == filterNamespace(f) && alert.Resource == v {
 			// iterate through options and match alert
 			for _, opt := range f.options {
 				if w, ok := opt.GetValue().(Filter); !ok {
@@ -254,7 +515,8 @@ func (f *filter) Match(alert *api.Alert) (bool, error) {
 				Tag("alertTypeFilter").
 				Tag("func Match")
 		}
-		if alert.AlertType == v.alertType &&
+		if Namespace(alert) == filterNamespace(f) &&
+			alert.AlertType == v.alertType &&
 			alert.Resource == v.resourceType {
 			// iterate through options and match alert
 			for _, opt := range f.options {
@@ -282,7 +544,8 @@ func (f *filter) Match(alert *api.Alert) (bool, error) {
 				Tag("resourceIDFilter").
 				Tag("func Match")
 		}
-		if alert.AlertType == v.alertType &&
+		if Namespace(alert) == filterNamespace(f) &&
+			alert.AlertType == v.alertType &&
 			alert.Resource == v.resourceType &&
 			alert.ResourceId == v.resourceID {
 			// iterate through options and match alert
@@ -319,38 +582,13 @@ func getUniqueKeysFromFilters(filters ...Filter) (map[string]bool, error) {
 		sort.Sort(Filters(filters))
 
 		for _, filter := range filters {
-			key := kvdbKey
-			switch filter.GetFilterType() {
-			// only these filter types benefit from efficient kvdb querying.
-			// for everything else we enumerate and then filter.
-			case resourceTypeFilter:
-				v, ok := filter.GetValue().(api.ResourceType)
-				if !ok {
-					return nil, typeAssertionError
-				}
-				key = filepath.Join(key, v.String())
-			case alertTypeFilter:
-				v, ok := filter.GetValue().(alertInfo)
-				if !ok {
-					return nil, typeAssertionError
-				}
-				key = filepath.Join(key,
-					v.resourceType.String(), strconv.FormatInt(v.alertType, 16))
-			case resourceIDFilter:
-				v, ok := filter.GetValue().(alertInfo)
-				if !ok {
-					return nil, typeAssertionError
-				}
-				key = filepath.Join(key,
-					v.resourceType.String(), strconv.FormatInt(v.alertType, 16), v.resourceID)
+			filterKeys, err := getKeysFromFilter(filter)
+			if err != nil {
+				return nil, err
 			}
-
-			keyPath, _ := filepath.Split(key)
-			keyPath = strings.Trim(keyPath, "/")
-			if !keys[keyPath] {
+			for _, key := range filterKeys {
 				keys[key] = true
 			}
-
 		}
 	} else {
 		keys[kvdbKey] = true
@@ -376,3 +614,97 @@ func getUniqueKeysFromFilters(filters ...Filter) (map[string]bool, error) {
 
 	return keys, nil
 }
+
+// getKeysFromFilter returns the kvdb keys holding all the alerts filter may match.
+func getKeysFromFilter(filter Filter) ([]string, error) {
+	if err := checkNamespace(filterNamespace(filter)); err != nil {
+		return nil, err
+	}
+	key := kvdbKey
+	switch filter.GetFilterType() {
+	// only these filter types benefit from efficient kvdb querying.
+	// for everything else we enumerate and then filter.
+	case namespaceFilter:
+		v, ok := filter.GetValue().(string)
+		if !ok {
+			return nil, typeAssertionError
+		}
+		if err := checkNamespace(v); err != nil {
+			return nil, err
+		}
+		key = namespaceKey(v)
+	case resourceTypeFilter:
+		v, ok := filter.GetValue().(api.ResourceType)
+		if !ok {
+			return nil, typeAssertionError
+		}
+		key = filepath.Join(namespaceKey(filterNamespace(filter)), v.String())
+	case alertTypeFilter:
+		v, ok := filter.GetValue().(alertInfo)
+		if !ok {
+			return nil, typeAssertionError
+		}
+		key = filepath.Join(namespaceKey(filterNamespace(filter)),
+			v.resourceType.String(), strconv.FormatInt(v.alertType, 16))
+	case resourceIDFilter:
+		v, ok := filter.GetValue().(alertInfo)
+		if !ok {
+			return nil, typeAssertionError
+		}
+		key = filepath.Join(namespaceKey(filterNamespace(filter)),
+			v.resourceType.String(), strconv.FormatInt(v.alertType, 16), v.resourceID)
+	case andFilter:
+		v, ok := filter.GetValue().([]Filter)
+		if !ok {
+			return nil, typeAssertionError
+		}
+		// every wrapped filter bounds the matches, fetch from the most selective one
+		var best []string
+		for _, f := range v {
+			keys, err := getKeysFromFilter(f)
+			if err != nil {
+				return nil, err
+			}
+			if best == nil || moreSelective(keys, best) {
+				best = keys
+			}
+		}
+		if best != nil {
+			return best, nil
+		}
+	case orFilter:
+		v, ok := filter.GetValue().([]Filter)
+		if !ok {
+			return nil, typeAssertionError
+		}
+		var keys []string
+		for _, f := range v {
+			fKeys, err := getKeysFromFilter(f)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, fKeys...)
+		}
+		return keys, nil
+	}
+	return []string{key}, nil
+}
+
+// moreSelective returns true if keys reach deeper sub trees than other, or as deep
+// sub trees but fewer of them.
+func moreSelective(keys, other []string) bool {
+	if d, o := minDepth(keys), minDepth(other); d != o {
+		return d > o
+	}
+	return len(keys) < len(other)
+}
+
+func minDepth(keys []string) int {
+	depth := -1
+	for _, key := range keys {
+		if d := strings.Count(key, "/"); depth < 0 || d < depth {
+			depth = d
+		}
+	}
+	return depth
+}
@@ -0,0 +1,213 @@
+package alerts
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+)
+
+const invalidFlapping Error = "invalid flapping detection"
+
+// FlappingPayloadKey is the payload key of an alert raised and cleared too
+// often, with the number of times it was cleared within the window as value.
+const FlappingPayloadKey = "flapping"
+
+// FlappingConfig configures the flapping detection of the alerts of an alert
+// type, see NewFlappingOption.
+type FlappingConfig struct {
+	// AlertType is the alert type of the alerts
+	AlertType int64 `yaml:"alert_type"`
+	// Window is the time over which the clears of an alert are counted
+	Window time.Duration `yaml:"window"`
+	// Start is the number of clears within the window from which an alert
+	// is flapping
+	Start int `yaml:"start"`
+	// Stop is the number of clears within the window at or below which an
+	// alert stops flapping, lower than Start
+	Stop int `yaml:"stop"`
+}
+
+// Option returns the option of the manager creation setting the flapping
+// detection of c.
+func (c *FlappingConfig) Option() (Option, error) {
+	f := flapping{alertType: c.AlertType, window: c.Window, start: c.Start, stop: c.Stop}
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	return NewFlappingOption(c.AlertType, c.Window, c.Start, c.Stop), nil
+}
+
+type flapping struct {
+	alertType int64
+	window    time.Duration
+	start     int
+	stop      int
+}
+
+// validate checks that the window is set and that the alerts start flapping
+// above the number of clears at which they stop.
+func (f flapping) validate() error {
+	if f.window <= 0 || f.start < 1 || f.stop < 0 || f.stop >= f.start {
+		return invalidFlapping.Tag(Error("alert type " + strconv.FormatInt(f.alertType, 10)))
+	}
+	return nil
+}
+
+// flapAction tells how a raise or a clear of an alert is handled.
+type flapAction int
+
+const (
+	// flapNone stores and delivers the alert as usual
+	flapNone flapAction = iota
+	// flapStart stores and delivers the alert once, raised and marked as
+	// flapping
+	flapStart
+	// flapAbsorb neither stores nor delivers the alert, the stored alert
+	// stays raised and marked as flapping
+	flapAbsorb
+)
+
+// flapState tracks the clears of an alert.
+type flapState struct {
+	// clears are the times the alert was cleared within the window, oldest
+	// first
+	clears []time.Time
+	// cleared is true if the alert was cleared last
+	cleared bool
+	// last is the alert raised or cleared last while flapping, nil if not
+	// flapping
+	last *api.Alert
+	// timer stops the flapping once the clears leave the window
+	timer *time.Timer
+}
+
+// flapDetector detects the alerts raised and cleared too often, by alert key.
+type flapDetector struct {
+	config map[int64]flapping
+	// settle stores and delivers the alert raised or cleared last by an alert
+	// which stopped flapping
+	settle func(alert *api.Alert)
+
+	lock   sync.Mutex
+	states map[string]*flapState
+}
+
+func newFlapDetector(config map[int64]flapping, settle func(alert *api.Alert)) *flapDetector {
+	return &flapDetector{
+		config: config,
+		settle: settle,
+		states: make(map[string]*flapState),
+	}
+}
+
+// track records the raise or the clear of alert, at key, at now and returns
+// how it is handled, with the number of clears within the window.
+func (d *flapDetector) track(key string, alert *api.Alert, now time.Time) (flapAction, int) {
+	f, ok := d.config[alert.GetAlertType()]
+	if !ok {
+		return flapNone, 0
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	s, ok := d.states[key]
+	if !ok {
+		s = &flapState{}
+		d.states[key] = s
+	}
+	if alert.Cleared && !s.cleared {
+		s.clears = append(s.clears, now)
+	}
+	s.cleared = alert.Cleared
+	s.expire(f, now)
+
+	switch {
+	case s.last != nil && len(s.clears) > f.stop:
+		s.last = proto.Clone(alert).(*api.Alert)
+		d.arm(key, s, f, now)
+		return flapAbsorb, len(s.clears)
+	case s.last == nil && len(s.clears) >= f.start:
+		s.last = proto.Clone(alert).(*api.Alert)
+		d.arm(key, s, f, now)
+		return flapStart, len(s.clears)
+	}
+	// the alert is not flapping, or stops with this raise or clear
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	s.last, s.timer = nil, nil
+	if len(s.clears) == 0 {
+		delete(d.states, key)
+	}
+	return flapNone, len(s.clears)
+}
+
+// expire forgets the clears out of the window of f at now.
+func (s *flapState) expire(f flapping, now time.Time) {
+	i := 0
+	for i < len(s.clears) && !s.clears[i].After(now.Add(-f.window)) {
+		i++
+	}
+	s.clears = s.clears[i:]
+}
+
+// arm sets the timer of the flapping alert at key to fire once enough clears
+// left the window for the alert to stop flapping. d.lock must be held.
+func (d *flapDetector) arm(key string, s *flapState, f flapping, now time.Time) {
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	wait := s.clears[len(s.clears)-f.stop-1].Add(f.window).Sub(now)
+	s.timer = time.AfterFunc(wait, func() { d.release(key, f) })
+}
+
+// release settles the alert at key if it stopped flapping, or arms its timer
+// again if it was cleared since.
+func (d *flapDetector) release(key string, f flapping) {
+	d.lock.Lock()
+	s, ok := d.states[key]
+	if !ok || s.last == nil {
+		d.lock.Unlock()
+		return
+	}
+	now := time.Now()
+	s.expire(f, now)
+	if len(s.clears) > f.stop {
+		d.arm(key, s, f, now)
+		d.lock.Unlock()
+		return
+	}
+	last := s.last
+	s.last, s.timer = nil, nil
+	if len(s.clears) == 0 {
+		delete(d.states, key)
+	}
+	d.lock.Unlock()
+	d.settle(last)
+}
+
+// markFlapping raises alert, marked as flapping with the number of clears
+// within the window.
+func markFlapping(alert *api.Alert, clears int) {
+	payload := make(map[string]string, len(alert.Payload)+1)
+	for k, v := range alert.Payload {
+		payload[k] = v
+	}
+	payload[FlappingPayloadKey] = strconv.Itoa(clears)
+	alert.Payload = payload
+	alert.Cleared = false
+}
+
+// putSettled stores and delivers the alert raised or cleared last by an alert
+// which stopped flapping, no longer marked as flapping.
+func (m *manager) putSettled(alert *api.Alert) {
+	delete(alert.Payload, FlappingPayloadKey)
+	if err := m.put(alert, false, 1); err != nil {
+		logrus.WithField("pkg", "openstorage/alerts").
+			Errorf("Failed to write %s which stopped flapping: %v", alertKey(alert), err)
+	}
+}
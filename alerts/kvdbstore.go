@@ -0,0 +1,270 @@
+package alerts
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/portworx/kvdb"
+)
+
+// kvdbStore stores the alerts in kvdb, at
+// <baseKey>/<resourceType>/<dec2hex(alertType)>/<resourceID>, under
+// <baseKey>/<namespace> for the alerts of a namespace, the silences
+// at silenceKey, the maintenance windows at maintenanceKey and the escalation
+// rules at escalationKey. A query is the prefix of its sub tree.
+type kvdbStore struct {
+	kv kvdb.Kvdb
+}
+
+// NewKvdbStore provides a store of the alerts in kvdb, the default store of
+// the managers.
+func NewKvdbStore(kv kvdb.Kvdb) Store {
+	return &kvdbStore{kv: kv}
+}
+
+// prefix returns the kvdb prefix of the alerts selected by q. The tree of a
+// namespace ends with a slash, so that it does not hold the alerts of the
+// namespaces it prefixes.
+func (s *kvdbStore) prefix(q Query) string {
+	if len(q.Namespace) != 0 && q.Level == QueryAll {
+		return q.key() + "/"
+	}
+	return q.key()
+}
+
+func (s *kvdbStore) Put(alert *api.Alert, ttl uint64) error {
+	_, err := s.kv.Put(alertKey(alert), alert, ttl)
+	return err
+}
+
+func (s *kvdbStore) Get(resourceType api.ResourceType, alertType int64, resourceID string) (*api.Alert, error) {
+	alert := new(api.Alert)
+	if _, err := s.kv.GetVal(getKey(resourceType.String(), alertType, resourceID), alert); err == kvdb.ErrNotFound {
+		return nil, alertNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+func (s *kvdbStore) Enumerate(q Query) ([]*api.Alert, error) {
+	kvps, err := enumerate(s.kv, s.prefix(q))
+	if err != nil {
+		return nil, err
+	}
+	alerts := make([]*api.Alert, 0, len(kvps))
+	for _, kvp := range kvps {
+		alert := new(api.Alert)
+		if err := json.Unmarshal(kvp.Value, alert); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
+
+func (s *kvdbStore) Delete(q Query) error {
+	if q.Level == QueryResource {
+		if _, err := s.kv.Delete(q.key()); err != nil && err != kvdb.ErrNotFound {
+			return err
+		}
+		return nil
+	}
+	return s.kv.DeleteTree(s.prefix(q))
+}
+
+func (s *kvdbStore) CompareAndDelete(alert *api.Alert) (bool, error) {
+	value, err := json.Marshal(alert)
+	if err != nil {
+		return false, err
+	}
+	kvp := &kvdb.KVPair{Key: alertKey(alert), Value: value}
+	if _, err := s.kv.CompareAndDelete(kvp, kvdb.KVFlags(0)); err == nil {
+		return true, nil
+	} else if err != kvdb.ErrNotFound && err != kvdb.ErrValueMismatch {
+		return false, err
+	}
+	return false, nil
+}
+
+func (s *kvdbStore) Watch(q Query, fn WatchFunc) error {
+	return s.kv.WatchTree(s.prefix(q), 0, nil,
+		func(prefix string, opaque interface{}, kvp *kvdb.KVPair, err error) error {
+			if err != nil {
+				return fn(AlertDeleted, nil, err)
+			}
+			if kvp == nil {
+				return nil
+			}
+			action := AlertUpdated
+			switch kvp.Action {
+			case kvdb.KVCreate:
+				action = AlertRaised
+			case kvdb.KVDelete, kvdb.KVExpire:
+				action = AlertDeleted
+			}
+			alert, err := alertFromKVPair(kvp)
+			if err != nil {
+				// not an alert, such as an intermediate key
+				return nil
+			}
+			return fn(action, alert, nil)
+		})
+}
+
+func (s *kvdbStore) PutSilence(silence *Silence, ttl uint64) error {
+	_, err := s.kv.Put(silenceKey+"/"+silence.ID, silence, ttl)
+	return err
+}
+
+func (s *kvdbStore) DeleteSilence(id string) error {
+	return s.deleteRecord(silenceKey+"/"+id, silenceNotFound)
+}
+
+func (s *kvdbStore) EnumerateSilences() ([]*Silence, error) {
+	var silences []*Silence
+	err := s.enumerateRecords(silenceKey, func(value []byte) error {
+		silence := new(Silence)
+		if err := json.Unmarshal(value, silence); err != nil {
+			return err
+		}
+		silences = append(silences, silence)
+		return nil
+	})
+	return silences, err
+}
+
+func (s *kvdbStore) PutMaintenanceWindow(w *MaintenanceWindow, ttl uint64) error {
+	_, err := s.kv.Put(maintenanceKey+"/"+w.ID, w, ttl)
+	return err
+}
+
+func (s *kvdbStore) DeleteMaintenanceWindow(id string) error {
+	return s.deleteRecord(maintenanceKey+"/"+id, windowNotFound)
+}
+
+func (s *kvdbStore) EnumerateMaintenanceWindows() ([]*MaintenanceWindow, error) {
+	var windows []*MaintenanceWindow
+	err := s.enumerateRecords(maintenanceKey, func(value []byte) error {
+		w := new(MaintenanceWindow)
+		if err := json.Unmarshal(value, w); err != nil {
+			return err
+		}
+		windows = append(windows, w)
+		return nil
+	})
+	return windows, err
+}
+
+func (s *kvdbStore) PutEscalationRule(r *EscalationRule) error {
+	_, err := s.kv.Put(escalationKey+"/"+r.Name, r, 0)
+	return err
+}
+
+func (s *kvdbStore) DeleteEscalationRule(name string) error {
+	return s.deleteRecord(escalationKey+"/"+name, escalationNotFound)
+}
+
+func (s *kvdbStore) EnumerateEscalationRules() ([]*EscalationRule, error) {
+	var rules []*EscalationRule
+	err := s.enumerateRecords(escalationKey, func(value []byte) error {
+		r := new(EscalationRule)
+		if err := json.Unmarshal(value, r); err != nil {
+			return err
+		}
+		rules = append(rules, r)
+		return nil
+	})
+	return rules, err
+}
+
+// deleteRecord deletes the silence, maintenance window or escalation rule at key, notFound
+// if none.
+func (s *kvdbStore) deleteRecord(key string, notFound Error) error {
+	if _, err := s.kv.Delete(key); err == kvdb.ErrNotFound {
+		return notFound
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// enumerateRecords calls decode with the value of every silence, maintenance
+// window or escalation rule of the tree at key.
+func (s *kvdbStore) enumerateRecords(key string, decode func(value []byte) error) error {
+	kvps, err := s.kv.Enumerate(key)
+	if err != nil {
+		return err
+	}
+	for _, kvp := range kvps {
+		if err := decode(kvp.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enumerate recursively fetches kvpairs.
+// Recursive call is required since, unlike mem kv, an etcd or consul based kv will not return
+// leaf objects if the key is a prefix referencing only higher level paths. For instance if the
+// kvdb structure is as follows:
+// a/b/c/<data>
+// a/B/C/<data>
+// then enumerating for keys using "a" will only return "b" and "B".
+func enumerate(kv kvdb.Kvdb, key string) (kvdb.KVPairs, error) {
+	kvps, err := kv.Enumerate(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	var out kvdb.KVPairs
+	for _, kvp := range kvps {
+		kvp := kvp
+		if len(kvp.Value) == 0 {
+			keys = append(keys, kvp.Key)
+			continue
+		}
+		out = append(out, kvp)
+	}
+
+	for _, key := range keys {
+		kvps, err := enumerate(kv, key)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, kvps...)
+	}
+	return out, nil
+}
+
+// alertFromKVPair decodes the alert of kvp. The alert of a deletion may only be known
+// from its key: <baseKey>/<resourceType>/<dec2hex(alertType)>/<resourceID>.
+func alertFromKVPair(kvp *kvdb.KVPair) (*api.Alert, error) {
+	alert := new(api.Alert)
+	if len(kvp.Value) != 0 {
+		if err := json.Unmarshal(kvp.Value, alert); err != nil {
+			return nil, err
+		}
+		return alert, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(kvp.Key, "/"), "/")
+	if len(parts) < 4 || parts[len(parts)-4] != kvdbKey {
+		return nil, incorrectFilterValue
+	}
+	parts = parts[len(parts)-3:]
+	resource, ok := api.ResourceType_value[parts[0]]
+	if !ok {
+		return nil, incorrectFilterValue
+	}
+	alertType, err := strconv.ParseInt(parts[1], 16, 64)
+	if err != nil {
+		return nil, err
+	}
+	alert.Resource = api.ResourceType(resource)
+	alert.AlertType = alertType
+	alert.ResourceId = parts[2]
+	return alert, nil
+}
@@ -0,0 +1,169 @@
+package alerts
+
+import (
+	"path"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/pborman/uuid"
+)
+
+const (
+	// maintenanceKey is the kvdb tree of the maintenance windows, outside of
+	// the alerts tree
+	maintenanceKey = "maintenance/alerts"
+
+	// MaxMaintenanceDuration bounds the duration of a recurring maintenance
+	// window.
+	MaxMaintenanceDuration = 7 * 24 * time.Hour
+
+	windowNotFound Error = "maintenance window not found"
+	invalidWindow  Error = "invalid maintenance window"
+)
+
+// MaintenanceWindow suppresses the notification of the matching alerts
+// during planned maintenance. Unlike a silence, the alerts raised during a
+// window are still stored and enumerated, only their notifications, and
+// escalations, are suppressed. A window without Schedule is open once, from
+// StartsAt to EndsAt. A window with a Schedule opens every time the cron
+// schedule fires, in UTC, for Duration, between StartsAt and EndsAt if set.
+type MaintenanceWindow struct {
+	// ID identifies the window, set on creation
+	ID string `json:"id"`
+	// Name describes the window
+	Name string `json:"name"`
+	// ResourceType of the alerts suppressed, all if RESOURCE_TYPE_NONE
+	ResourceType api.ResourceType `json:"resource_type"`
+	// AlertTypes of the alerts suppressed, all if empty
+	AlertTypes []int64 `json:"alert_types,omitempty"`
+	// ResourceIDPattern is a shell pattern, such as "pvc-*", matching the
+	// resource ids of the alerts suppressed, all if empty
+	ResourceIDPattern string `json:"resource_id_pattern"`
+	// Schedule is the cron schedule, such as "0 2 * * 6", opening a
+	// recurring window, empty for a one-shot window
+	Schedule string `json:"schedule,omitempty"`
+	// Duration is how long a recurring window stays open
+	Duration time.Duration `json:"duration,omitempty"`
+	// StartsAt is when the window starts, on creation if zero
+	StartsAt time.Time `json:"starts_at"`
+	// EndsAt is when the window ends, the window is deleted then. A
+	// recurring window without EndsAt never ends.
+	EndsAt time.Time `json:"ends_at"`
+	// CreatedBy is the operator who created the window
+	CreatedBy string `json:"created_by"`
+}
+
+// Open returns true if the window is open at time now.
+func (w *MaintenanceWindow) Open(now time.Time) bool {
+	if now.Before(w.StartsAt) || (!w.EndsAt.IsZero() && !now.Before(w.EndsAt)) {
+		return false
+	}
+	if len(w.Schedule) == 0 {
+		return true
+	}
+	s, err := parseSchedule(w.Schedule)
+	if err != nil {
+		return false
+	}
+	// the window is open if the schedule fired within its duration
+	now = now.UTC()
+	_, fired := s.last(now.Add(-w.Duration), now)
+	return fired
+}
+
+// Matches returns true if the window suppresses the notification of alert
+// at time now.
+func (w *MaintenanceWindow) Matches(alert *api.Alert, now time.Time) bool {
+	if !w.Open(now) {
+		return false
+	}
+	if w.ResourceType != api.ResourceType_RESOURCE_TYPE_NONE && w.ResourceType != alert.GetResource() {
+		return false
+	}
+	if len(w.AlertTypes) != 0 {
+		match := false
+		for _, alertType := range w.AlertTypes {
+			if alertType == alert.GetAlertType() {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	if len(w.ResourceIDPattern) != 0 {
+		if match, err := path.Match(w.ResourceIDPattern, alert.GetResourceId()); err != nil || !match {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *manager) AddMaintenanceWindow(w *MaintenanceWindow) (string, error) {
+	now := time.Now()
+	if w.StartsAt.IsZero() {
+		w.StartsAt = now
+	}
+	if len(w.Schedule) == 0 {
+		if !w.EndsAt.After(w.StartsAt) || !w.EndsAt.After(now) {
+			return "", invalidWindow.Tag("ends before it starts or in the past")
+		}
+	} else {
+		if _, err := parseSchedule(w.Schedule); err != nil {
+			return "", invalidWindow.Tag(Error(err.Error()))
+		}
+		if w.Duration < time.Minute || w.Duration > MaxMaintenanceDuration {
+			return "", invalidWindow.Tag("duration out of range")
+		}
+		if !w.EndsAt.IsZero() && !w.EndsAt.After(now) {
+			return "", invalidWindow.Tag("ends in the past")
+		}
+	}
+	if _, err := path.Match(w.ResourceIDPattern, ""); err != nil {
+		return "", invalidWindow.Tag(Error(err.Error()))
+	}
+	w.ID = uuid.New()
+	// the store deletes the window once it ends
+	var ttl uint64
+	if !w.EndsAt.IsZero() {
+		ttl = uint64(w.EndsAt.Sub(now)/time.Second) + 1
+	}
+	if err := m.store.PutMaintenanceWindow(w, ttl); err != nil {
+		return "", err
+	}
+	return w.ID, nil
+}
+
+func (m *manager) DeleteMaintenanceWindow(id string) error {
+	return m.store.DeleteMaintenanceWindow(id)
+}
+
+func (m *manager) EnumerateMaintenanceWindows() ([]*MaintenanceWindow, error) {
+	stored, err := m.store.EnumerateMaintenanceWindows()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	windows := make([]*MaintenanceWindow, 0, len(stored))
+	for _, w := range stored {
+		// the store may not have expired an ended window yet
+		if !w.EndsAt.IsZero() && !now.Before(w.EndsAt) {
+			continue
+		}
+		windows = append(windows, w)
+	}
+	return windows, nil
+}
+
+// inMaintenance returns true if one of windows suppresses the notification
+// of alert now.
+func inMaintenance(windows []*MaintenanceWindow, alert *api.Alert) bool {
+	now := time.Now()
+	for _, w := range windows {
+		if w.Matches(alert, now) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,309 @@
+package alerts
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// memStore stores the alerts in memory, by key, for the tests and the
+// single node deployments.
+type memStore struct {
+	lock     sync.Mutex
+	alerts   map[string]*memEntry
+	silences map[string]*memEntry
+	windows  map[string]*memEntry
+	// escalations are the escalation rules, by name
+	escalations map[string]*memEntry
+	watches     map[int]*memWatch
+	next        int
+}
+
+// memEntry is the encoded value of an alert or a silence, and when it
+// expires.
+type memEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+type memWatch struct {
+	query Query
+	fn    WatchFunc
+}
+
+// memEvent is a change delivered to a watch once the lock is released.
+type memEvent struct {
+	watch  int
+	action WatchAction
+	alert  *api.Alert
+}
+
+// NewMemStore provides a store of the alerts in memory, lost on restart.
+func NewMemStore() Store {
+	return &memStore{
+		alerts:      make(map[string]*memEntry),
+		silences:    make(map[string]*memEntry),
+		windows:     make(map[string]*memEntry),
+		escalations: make(map[string]*memEntry),
+		watches:     make(map[int]*memWatch),
+	}
+}
+
+func newMemEntry(v interface{}, ttl uint64) (*memEntry, error) {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	e := &memEntry{value: value}
+	if ttl > 0 {
+		e.expires = time.Now().Add(time.Duration(ttl) * time.Second)
+	}
+	return e, nil
+}
+
+func (e *memEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+func (e *memEntry) alert() *api.Alert {
+	alert := new(api.Alert)
+	// the entries are encoded by the store
+	json.Unmarshal(e.value, alert)
+	return alert
+}
+
+func (s *memStore) Put(alert *api.Alert, ttl uint64) error {
+	e, err := newMemEntry(alert, ttl)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	key := alertKey(alert)
+	action := AlertRaised
+	if old, ok := s.alerts[key]; ok && !old.expired(time.Now()) {
+		action = AlertUpdated
+	}
+	s.alerts[key] = e
+	events := s.events(action, e.alert())
+	s.lock.Unlock()
+	s.deliver(events)
+	return nil
+}
+
+func (s *memStore) Get(resourceType api.ResourceType, alertType int64, resourceID string) (*api.Alert, error) {
+	s.lock.Lock()
+	events := s.expire(time.Now())
+	e, ok := s.alerts[getKey(resourceType.String(), alertType, resourceID)]
+	s.lock.Unlock()
+	s.deliver(events)
+	if !ok {
+		return nil, alertNotFound
+	}
+	return e.alert(), nil
+}
+
+func (s *memStore) Enumerate(q Query) ([]*api.Alert, error) {
+	s.lock.Lock()
+	events := s.expire(time.Now())
+	var alerts []*api.Alert
+	for _, e := range s.alerts {
+		if alert := e.alert(); q.Matches(alert) {
+			alerts = append(alerts, alert)
+		}
+	}
+	s.lock.Unlock()
+	s.deliver(events)
+	return alerts, nil
+}
+
+func (s *memStore) Delete(q Query) error {
+	s.lock.Lock()
+	var events []*memEvent
+	for key, e := range s.alerts {
+		if alert := e.alert(); q.Matches(alert) {
+			delete(s.alerts, key)
+			events = append(events, s.events(AlertDeleted, alert)...)
+		}
+	}
+	s.lock.Unlock()
+	s.deliver(events)
+	return nil
+}
+
+func (s *memStore) CompareAndDelete(alert *api.Alert) (bool, error) {
+	value, err := json.Marshal(alert)
+	if err != nil {
+		return false, err
+	}
+	s.lock.Lock()
+	key := alertKey(alert)
+	e, ok := s.alerts[key]
+	if !ok || !bytes.Equal(e.value, value) {
+		s.lock.Unlock()
+		return false, nil
+	}
+	delete(s.alerts, key)
+	events := s.events(AlertDeleted, alert)
+	s.lock.Unlock()
+	s.deliver(events)
+	return true, nil
+}
+
+func (s *memStore) Watch(q Query, fn WatchFunc) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.watches[s.next] = &memWatch{query: q, fn: fn}
+	s.next++
+	return nil
+}
+
+func (s *memStore) PutSilence(silence *Silence, ttl uint64) error {
+	return s.putRecord(s.silences, silence.ID, silence, ttl)
+}
+
+func (s *memStore) DeleteSilence(id string) error {
+	return s.deleteRecord(s.silences, id, silenceNotFound)
+}
+
+func (s *memStore) EnumerateSilences() ([]*Silence, error) {
+	var silences []*Silence
+	err := s.enumerateRecords(s.silences, func(value []byte) error {
+		silence := new(Silence)
+		if err := json.Unmarshal(value, silence); err != nil {
+			return err
+		}
+		silences = append(silences, silence)
+		return nil
+	})
+	return silences, err
+}
+
+func (s *memStore) PutMaintenanceWindow(w *MaintenanceWindow, ttl uint64) error {
+	return s.putRecord(s.windows, w.ID, w, ttl)
+}
+
+func (s *memStore) DeleteMaintenanceWindow(id string) error {
+	return s.deleteRecord(s.windows, id, windowNotFound)
+}
+
+func (s *memStore) EnumerateMaintenanceWindows() ([]*MaintenanceWindow, error) {
+	var windows []*MaintenanceWindow
+	err := s.enumerateRecords(s.windows, func(value []byte) error {
+		w := new(MaintenanceWindow)
+		if err := json.Unmarshal(value, w); err != nil {
+			return err
+		}
+		windows = append(windows, w)
+		return nil
+	})
+	return windows, err
+}
+
+func (s *memStore) PutEscalationRule(r *EscalationRule) error {
+	return s.putRecord(s.escalations, r.Name, r, 0)
+}
+
+func (s *memStore) DeleteEscalationRule(name string) error {
+	return s.deleteRecord(s.escalations, name, escalationNotFound)
+}
+
+func (s *memStore) EnumerateEscalationRules() ([]*EscalationRule, error) {
+	var rules []*EscalationRule
+	err := s.enumerateRecords(s.escalations, func(value []byte) error {
+		r := new(EscalationRule)
+		if err := json.Unmarshal(value, r); err != nil {
+			return err
+		}
+		rules = append(rules, r)
+		return nil
+	})
+	return rules, err
+}
+
+// putRecord stores v, a silence, a maintenance window or an escalation rule,
+// in records by id.
+func (s *memStore) putRecord(records map[string]*memEntry, id string, v interface{}, ttl uint64) error {
+	e, err := newMemEntry(v, ttl)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	records[id] = e
+	return nil
+}
+
+// deleteRecord deletes the record of id, notFound if none.
+func (s *memStore) deleteRecord(records map[string]*memEntry, id string, notFound Error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := records[id]; !ok {
+		return notFound
+	}
+	delete(records, id)
+	return nil
+}
+
+// enumerateRecords calls decode with the value of every unexpired record,
+// deleting the expired ones.
+func (s *memStore) enumerateRecords(records map[string]*memEntry, decode func(value []byte) error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now()
+	for id, e := range records {
+		if e.expired(now) {
+			delete(records, id)
+			continue
+		}
+		if err := decode(e.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expire deletes the alerts expired at now and returns their deletion
+// events, s.lock must be held.
+func (s *memStore) expire(now time.Time) []*memEvent {
+	var events []*memEvent
+	for key, e := range s.alerts {
+		if e.expired(now) {
+			delete(s.alerts, key)
+			events = append(events, s.events(AlertDeleted, e.alert())...)
+		}
+	}
+	return events
+}
+
+// events returns the events of the change of alert for the watches selecting
+// it, s.lock must be held.
+func (s *memStore) events(action WatchAction, alert *api.Alert) []*memEvent {
+	var events []*memEvent
+	for id, w := range s.watches {
+		if w.query.Matches(alert) {
+			events = append(events, &memEvent{watch: id, action: action, alert: alert})
+		}
+	}
+	return events
+}
+
+// deliver delivers events without holding s.lock, so that the watches may
+// use the store. A watch returning an error is removed.
+func (s *memStore) deliver(events []*memEvent) {
+	for _, event := range events {
+		s.lock.Lock()
+		w, ok := s.watches[event.watch]
+		s.lock.Unlock()
+		if !ok {
+			continue
+		}
+		if err := w.fn(event.action, event.alert, nil); err != nil {
+			s.lock.Lock()
+			delete(s.watches, event.watch)
+			s.lock.Unlock()
+		}
+	}
+}
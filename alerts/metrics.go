@@ -0,0 +1,132 @@
+package alerts
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const metricsNamespace = "openstorage"
+
+// metrics instruments a manager. It is the prometheus.Collector returned by
+// Collector, the active alerts are counted from the store when collected.
+type metrics struct {
+	m *manager
+
+	raised           *prometheus.CounterVec
+	notifierFailures *prometheus.CounterVec
+	notifierDropped  *prometheus.CounterVec
+	enumerate        prometheus.Histogram
+	active           *prometheus.Desc
+}
+
+func newMetrics(m *manager) *metrics {
+	return &metrics{
+		m: m,
+		raised: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "alerts",
+			Name:      "raised_total",
+			Help:      "Number of alerts raised, cleared ones included.",
+		}, []string{"resource_type", "alert_type", "severity"}),
+		notifierFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "alerts",
+			Name:      "notifier_failures_total",
+			Help:      "Number of alerts a notifier failed to deliver.",
+		}, []string{"notifier"}),
+		notifierDropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "alerts",
+			Name:      "notifier_dropped_total",
+			Help:      "Number of alerts dropped because a notifier was not keeping up.",
+		}, []string{"notifier"}),
+		enumerate: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "alerts",
+			Name:      "store_enumerate_seconds",
+			Help:      "Latency of the enumerations of the alerts in the store.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 8),
+		}),
+		active: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "alerts", "active"),
+			"Number of alerts neither cleared nor expired.",
+			[]string{"resource_type", "severity"}, nil),
+	}
+}
+
+// Collector returns the collector of the metrics of the manager.
+func (m *manager) Collector() prometheus.Collector {
+	return m.metrics
+}
+
+func (c *metrics) Describe(ch chan<- *prometheus.Desc) {
+	c.raised.Describe(ch)
+	c.notifierFailures.Describe(ch)
+	c.notifierDropped.Describe(ch)
+	c.enumerate.Describe(ch)
+	ch <- c.active
+}
+
+func (c *metrics) Collect(ch chan<- prometheus.Metric) {
+	c.raised.Collect(ch)
+	c.notifierFailures.Collect(ch)
+	c.notifierDropped.Collect(ch)
+	c.enumerate.Collect(ch)
+
+	stored, err := c.m.storeEnumerate(Query{})
+	if err != nil {
+		logrus.WithField("pkg", "openstorage/alerts").
+			Warnf("Failed to count the active alerts: %v", err)
+		ch <- prometheus.NewInvalidMetric(c.active, err)
+		return
+	}
+	type activeKey struct {
+		resourceType, severity string
+	}
+	now := time.Now()
+	active := make(map[activeKey]float64)
+	for _, alert := range stored {
+		if alert.Cleared || c.m.expired(alert, now) {
+			continue
+		}
+		active[activeKey{resourceTypeLabel(alert), severityLabel(alert)}]++
+	}
+	for k, v := range active {
+		ch <- prometheus.MustNewConstMetric(c.active, prometheus.GaugeValue, v, k.resourceType, k.severity)
+	}
+}
+
+// observeRaise counts alert as raised.
+func (c *metrics) observeRaise(alert *api.Alert) {
+	c.raised.WithLabelValues(
+		resourceTypeLabel(alert),
+		strconv.FormatInt(alert.GetAlertType(), 10),
+		severityLabel(alert),
+	).Inc()
+}
+
+// storeEnumerate enumerates the alerts of q in the store, timing the
+// enumeration.
+func (m *manager) storeEnumerate(q Query) ([]*api.Alert, error) {
+	start := time.Now()
+	defer func() {
+		m.metrics.enumerate.Observe(time.Since(start).Seconds())
+	}()
+	return m.store.Enumerate(q)
+}
+
+// resourceTypeLabel is the resource type of alert as a metric label, such as
+// volume.
+func resourceTypeLabel(alert *api.Alert) string {
+	return strings.ToLower(strings.TrimPrefix(alert.GetResource().String(), "RESOURCE_TYPE_"))
+}
+
+// severityLabel is the severity of alert as a metric label, such as alarm.
+func severityLabel(alert *api.Alert) string {
+	return strings.ToLower(strings.TrimPrefix(alert.GetSeverity().String(), "SEVERITY_TYPE_"))
+}
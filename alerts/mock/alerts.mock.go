@@ -67,6 +67,25 @@ func (mr *MockFilterDeleterMockRecorder) Enumerate(arg0 ...interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enumerate", reflect.TypeOf((*MockFilterDeleter)(nil).Enumerate), arg0...)
 }
 
+// EnumerateWithOptions mocks base method
+func (m *MockFilterDeleter) EnumerateWithOptions(arg0 []alerts.Option, arg1 ...alerts.Filter) ([]*api.Alert, string, error) {
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnumerateWithOptions", varargs...)
+	ret0, _ := ret[0].([]*api.Alert)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnumerateWithOptions indicates an expected call of EnumerateWithOptions
+func (mr *MockFilterDeleterMockRecorder) EnumerateWithOptions(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnumerateWithOptions", reflect.TypeOf((*MockFilterDeleter)(nil).EnumerateWithOptions), varargs...)
+}
+
 // Filter mocks base method
 func (m *MockFilterDeleter) Filter(arg0 []*api.Alert, arg1 ...alerts.Filter) ([]*api.Alert, error) {
 	varargs := []interface{}{arg0}
@@ -84,3 +103,21 @@ func (mr *MockFilterDeleterMockRecorder) Filter(arg0 interface{}, arg1 ...interf
 	varargs := append([]interface{}{arg0}, arg1...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockFilterDeleter)(nil).Filter), varargs...)
 }
+
+// Watch mocks base method
+func (m *MockFilterDeleter) Watch(arg0 ...alerts.Filter) (<-chan *alerts.WatchEvent, func(), error) {
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Watch", varargs...)
+	ret0, _ := ret[0].(<-chan *alerts.WatchEvent)
+	ret1, _ := ret[1].(func())
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Watch indicates an expected call of Watch
+func (mr *MockFilterDeleterMockRecorder) Watch(arg0 ...interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockFilterDeleter)(nil).Watch), arg0...)
+}
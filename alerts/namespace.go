@@ -0,0 +1,66 @@
+package alerts
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	// NamespacePayloadKey is the payload key holding the namespace of an alert,
+	// such as the tenant owning its resource. The alerts of no namespace are
+	// those of the default namespace.
+	NamespacePayloadKey = "namespace"
+
+	invalidNamespace Error = "invalid alert namespace"
+)
+
+// namespacePattern matches the namespaces, such as the names of the tenants or
+// of the Kubernetes namespaces. They never collide with the names of the
+// resource types, which have underscores.
+var namespacePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9.-]*$`)
+
+// Namespace returns the namespace of alert, empty for the default namespace.
+func Namespace(alert *api.Alert) string {
+	return alert.GetPayload()[NamespacePayloadKey]
+}
+
+// checkNamespace returns an error if namespace cannot be a level of the kvdb
+// keys.
+func checkNamespace(namespace string) error {
+	if len(namespace) != 0 && !namespacePattern.MatchString(namespace) {
+		return invalidNamespace.Tag(Error(namespace))
+	}
+	return nil
+}
+
+// namespaceKey returns the kvdb key of the tree of the alerts of namespace,
+// i.e., <baseKey>/<namespace>, or the base key itself for the default
+// namespace.
+func namespaceKey(namespace string) string {
+	if len(namespace) == 0 {
+		return kvdbKey
+	}
+	return filepath.Join(kvdbKey, namespace)
+}
+
+// filterNamespace returns the namespace of the alerts of an efficient filter,
+// set by a NewNamespaceOption, the default one if none.
+func filterNamespace(f Filter) string {
+	v, ok := f.(*filter)
+	if !ok {
+		return ""
+	}
+	for _, opt := range v.options {
+		if opt.GetType() != namespaceOption {
+			continue
+		}
+		if w, ok := opt.GetValue().(Filter); ok {
+			if namespace, ok := w.GetValue().(string); ok {
+				return namespace
+			}
+		}
+	}
+	return ""
+}
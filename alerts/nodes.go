@@ -0,0 +1,80 @@
+package alerts
+
+import (
+	"sync"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+)
+
+// NodeSource provides the alerts of the manager of another node of the
+// cluster, for the cluster wide enumerations.
+type NodeSource interface {
+	// Enumerate lists the alerts selected by q. The source may return a
+	// superset, the alerts are filtered again by the manager.
+	Enumerate(q Query) ([]*api.Alert, error)
+}
+
+// NodesFunc returns the sources of the alerts of the other nodes of the
+// cluster, by node id.
+type NodesFunc func() (map[string]NodeSource, error)
+
+// enumerateNodes returns the alerts selected by q on every node of sources.
+// The nodes which fail are skipped, so that one node down does not fail
+// the enumeration.
+func enumerateNodes(sources map[string]NodeSource, q Query) []*api.Alert {
+	var (
+		lock   sync.Mutex
+		wg     sync.WaitGroup
+		alerts []*api.Alert
+	)
+	for id, source := range sources {
+		wg.Add(1)
+		go func(id string, source NodeSource) {
+			defer wg.Done()
+			nodeAlerts, err := source.Enumerate(q)
+			if err != nil {
+				logrus.WithField("pkg", "openstorage/alerts").
+					WithField("node", id).
+					Warnf("failed to enumerate the alerts of node: %v", err)
+				return
+			}
+			lock.Lock()
+			alerts = append(alerts, nodeAlerts...)
+			lock.Unlock()
+		}(id, source)
+	}
+	wg.Wait()
+	return alerts
+}
+
+// mergeAlerts merges the alerts of the other nodes into local, keeping a
+// single alert per key: the one raised last, the local one if they were
+// raised at the same time, such as when the nodes share their store.
+func mergeAlerts(local, nodes []*api.Alert) []*api.Alert {
+	merged := make(map[string]*api.Alert, len(local))
+	for _, alert := range local {
+		merged[alertKey(alert)] = alert
+	}
+	for _, alert := range nodes {
+		key := alertKey(alert)
+		if other, ok := merged[key]; ok && !raisedAfter(alert, other) {
+			continue
+		}
+		merged[key] = alert
+	}
+	alerts := make([]*api.Alert, 0, len(merged))
+	for _, alert := range merged {
+		alerts = append(alerts, alert)
+	}
+	return alerts
+}
+
+// raisedAfter returns true if a was raised after b.
+func raisedAfter(a, b *api.Alert) bool {
+	ta, tb := a.GetTimestamp(), b.GetTimestamp()
+	if ta.GetSeconds() != tb.GetSeconds() {
+		return ta.GetSeconds() > tb.GetSeconds()
+	}
+	return ta.GetNanos() > tb.GetNanos()
+}
@@ -0,0 +1,137 @@
+package alerts
+
+import (
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	notifierExists Error = "notifier already registered"
+	// notifyBuffer is the number of alerts queued for a slow notifier
+	// before the following ones are dropped
+	notifyBuffer = 64
+)
+
+// Notifier delivers raised alerts, and the cleared ones, to an external system, such
+// as a webhook. A notifier which is an io.Closer is closed once removed.
+type Notifier interface {
+	// Name identifies the notifier in the registry of the manager.
+	Name() string
+	// Notify delivers alert. It may block while retrying.
+	Notify(alert *api.Alert) error
+}
+
+// subscription delivers the alerts matched by its filters to a notifier in
+// the background, in the order they are raised.
+type subscription struct {
+	notifier Notifier
+	filters  []Filter
+	alerts   chan *api.Alert
+	// failures counts the alerts the notifier failed to deliver
+	failures prometheus.Counter
+}
+
+func (m *manager) AddNotifier(n Notifier, filters ...Filter) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.notifiers[n.Name()]; ok {
+		return notifierExists.Tag(Error(n.Name()))
+	}
+	s := &subscription{
+		notifier: n,
+		filters:  filters,
+		alerts:   make(chan *api.Alert, notifyBuffer),
+		failures: m.metrics.notifierFailures.WithLabelValues(n.Name()),
+	}
+	m.notifiers[n.Name()] = s
+	go s.deliver()
+	return nil
+}
+
+func (m *manager) RemoveNotifier(name string) {
+	m.Lock()
+	defer m.Unlock()
+	if s, ok := m.notifiers[name]; ok {
+		close(s.alerts)
+		delete(m.notifiers, name)
+	}
+}
+
+// notify queues a copy of alert for the notifiers whose filters match it, and
+// selected by its route if any, unless it is silenced or in maintenance.
+func (m *manager) notify(alert *api.Alert) {
+	m.Lock()
+	routes, notifiers := m.routes, len(m.notifiers)
+	m.Unlock()
+	if notifiers == 0 {
+		return
+	}
+	// the labels of the resource are fetched without holding the lock
+	r := m.route(routes, alert)
+
+	m.Lock()
+	defer m.Unlock()
+	silences, err := m.EnumerateSilences()
+	if err != nil {
+		logrus.WithField("pkg", "openstorage/alerts").
+			Warnf("Failed to get the silences, notifying alert %s: %v", ID(alert), err)
+	}
+	if silenced(silences, alert) {
+		return
+	}
+	windows, err := m.EnumerateMaintenanceWindows()
+	if err != nil {
+		logrus.WithField("pkg", "openstorage/alerts").
+			Warnf("Failed to get the maintenance windows, notifying alert %s: %v", ID(alert), err)
+	}
+	if inMaintenance(windows, alert) {
+		return
+	}
+	for name, s := range m.notifiers {
+		if !s.match(alert) || !r.sends(name) {
+			continue
+		}
+		select {
+		case s.alerts <- proto.Clone(alert).(*api.Alert):
+		default:
+			m.metrics.notifierDropped.WithLabelValues(name).Inc()
+			logrus.WithField("pkg", "openstorage/alerts").
+				WithField("notifier", name).
+				Warnf("Dropped alert %s, the notifier is not keeping up", ID(alert))
+		}
+	}
+}
+
+// match returns true if alert is matched by at least one filter, or if there
+// are none.
+func (s *subscription) match(alert *api.Alert) bool {
+	if len(s.filters) == 0 {
+		return true
+	}
+	for _, filter := range s.filters {
+		if match, err := filter.Match(alert); err == nil && match {
+			return true
+		}
+	}
+	return false
+}
+
+// deliver delivers the alerts until the notifier is removed, closing it then
+// if it is an io.Closer.
+func (s *subscription) deliver() {
+	for alert := range s.alerts {
+		if err := s.notifier.Notify(alert); err != nil {
+			s.failures.Inc()
+			logrus.WithField("pkg", "openstorage/alerts").
+				WithField("notifier", s.notifier.Name()).
+				Errorf("Failed to deliver alert %s: %v", ID(alert), err)
+		}
+	}
+	if c, ok := s.notifier.(io.Closer); ok {
+		c.Close()
+	}
+}
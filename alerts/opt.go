@@ -7,6 +7,49 @@ const (
 	// ttlOption defines the time to live for alerts that are cleared (default half day)
 	// ttlOption is only valid for alerts manager creation.
 	ttlOption OptionType = iota
+	// gcIntervalOption starts a worker deleting the expired alerts at the given interval.
+	// gcIntervalOption is only valid for alerts manager creation.
+	gcIntervalOption
+	// escalationIntervalOption starts a worker evaluating the escalation rules at the given
+	// interval. escalationIntervalOption is only valid for alerts manager creation.
+	escalationIntervalOption
+	// rateLimitOption limits the rate of the raises of every resource type and alert type.
+	// rateLimitOption is only valid for alerts manager creation.
+	rateLimitOption
+	// labelsOption sets the function returning the labels of the resources matched by routes.
+	// labelsOption is only valid for alerts manager creation.
+	labelsOption
+	// cacheOption caches the alerts in memory, kept up to date by a watch of the store.
+	// cacheOption is only valid for alerts manager creation.
+	cacheOption
+	// retentionOption sets the maximum age and count of the alerts of a resource type,
+	// enforced by the gc worker. retentionOption is only valid for alerts manager creation.
+	retentionOption
+	// nodesOption sets the function returning the sources of the alerts of the other nodes,
+	// enumerated by the cluster wide enumerations. nodesOption is only valid for alerts
+	// manager creation.
+	nodesOption
+	// flappingOption sets the flapping detection of the alerts of an alert type.
+	// flappingOption is only valid for alerts manager creation.
+	flappingOption
+	// dedupeOption aggregates an alert raised again into the stored one, incrementing its count.
+	// dedupeOption is only valid for Raise.
+	dedupeOption
+	// timestampOption sets the timestamp of an alert instead of the time it is raised.
+	// timestampOption is only valid for Raise.
+	timestampOption
+	// firstSeenOption sets the time an alert was first seen.
+	// firstSeenOption is only valid for Raise.
+	firstSeenOption
+	// alertIDOption sets the id of an alert.
+	// alertIDOption is only valid for Raise.
+	alertIDOption
+	// correlationIDOption sets the correlation id of the request raising an alert.
+	// correlationIDOption is only valid for Raise.
+	correlationIDOption
+	// namespaceOption sets the namespace of an alert in Raise, and the namespace of the alerts
+	// of an efficient filter, whose kvdb sub tree is then under the namespace.
+	namespaceOption
 	// timeSpanOption provides a way to tell a filter that it should also apply filtering based
 	// on the time span. Such option is useful for creating efficient filters that fetch efficiently
 	// from kvdb and apply filtering after fetching.
@@ -19,14 +62,45 @@ const (
 	// the severity. Such option is useful for creating efficient filters that fetch efficiently
 	// from kvdb and apply filtering after fetching.
 	minSeverityOption
+	// severityOption provides a way to tell filter that it should apply filtering based on
+	// a comparison with a severity. Such option is useful for creating efficient filters that
+	// fetch efficiently from kvdb and apply filtering after fetching.
+	severityOption
 	// flagCheckOption provides a way to tell filter that it should apply filtering based on
 	// the clear flag. Such option is useful for creating efficient filters that fetch efficiently
 	// from kvdb and apply filtering after fetching.
 	flagCheckOption
+	// stateOption provides a way to tell filter that it should apply filtering based on
+	// the lifecycle state, such as selecting only the unacknowledged alerts.
+	stateOption
 	// resourceIDOption provides a way to tell filter that it should apply filtering based on
 	// the resource id. Such option is useful for creating efficient filters that fetch efficiently
 	// from kvdb and apply filtering after fetching.
 	resourceIdOption
+	// resourceIDRegexOption provides a way to tell filter that it should apply filtering based on
+	// a regular expression matching the resource id. Such option is useful for creating efficient
+	// filters that fetch efficiently from kvdb and apply filtering after fetching.
+	resourceIDRegexOption
+	// resourceIDGlobOption is similar to resourceIDRegexOption but matches the resource id
+	// with a shell pattern.
+	resourceIDGlobOption
+	// payloadOption provides a way to tell filter that it should apply filtering based on
+	// a key of the alert payload.
+	payloadOption
+	// tagOption provides a way to tell filter that it should apply filtering based on
+	// an annotation of the alert.
+	tagOption
+	// maxResultsOption limits the number of alerts returned by a paged enumeration.
+	maxResultsOption
+	// continuationTokenOption continues a paged enumeration after the page that returned
+	// the token.
+	continuationTokenOption
+	// sortOption sets the order of the alerts returned by a paged enumeration.
+	sortOption
+	// includeSilencedOption returns the silenced alerts in a paged enumeration.
+	includeSilencedOption
+	// clusterWideOption merges the alerts of the other nodes into a paged enumeration.
+	clusterWideOption
 )
 
 // Option defines what is an option.
@@ -0,0 +1,159 @@
+package alerts
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// SortBy defines the order of the alerts returned by EnumerateWithOptions.
+type SortBy int
+
+// SortBy constants.
+const (
+	// SortByKey orders alerts by resource type, alert type and resource id. It is the default
+	// order and the only one that does not require decoding all matching alerts.
+	SortByKey SortBy = iota
+	// SortByTimestamp orders alerts by the time they were last raised.
+	SortByTimestamp
+	// SortBySeverity orders alerts from NOTIFY to ALARM, or ALARM to NOTIFY if descending.
+	SortBySeverity
+	// SortByCount orders alerts by the number of times they were raised.
+	SortByCount
+)
+
+// sortInfo contains information about the order of a paged enumeration.
+type sortInfo struct {
+	sortBy     SortBy
+	descending bool
+}
+
+// page describes a page of a paged enumeration.
+type page struct {
+	maxResults int64
+	sortBy     SortBy
+	descending bool
+	// after is the last entry of the previous page
+	after *pageEntry
+	// includeSilenced returns the alerts muted by a silence
+	includeSilenced bool
+	// clusterWide merges the alerts of the other nodes
+	clusterWide bool
+}
+
+// pageEntry is an alert and its position in the enumeration order.
+type pageEntry struct {
+	Key   string
+	Value int64
+	alert *api.Alert
+}
+
+// newPage returns the page described by options.
+func newPage(options []Option) (*page, error) {
+	p := &page{}
+	token := ""
+	for _, option := range options {
+		switch option.GetType() {
+		case maxResultsOption:
+			v, ok := option.GetValue().(int64)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			p.maxResults = v
+		case continuationTokenOption:
+			v, ok := option.GetValue().(string)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			token = v
+		case sortOption:
+			v, ok := option.GetValue().(sortInfo)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			if v.sortBy < SortByKey || v.sortBy > SortByCount {
+				return nil, invalidOptionType
+			}
+			p.sortBy, p.descending = v.sortBy, v.descending
+		case includeSilencedOption:
+			v, ok := option.GetValue().(bool)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			p.includeSilenced = v
+		case clusterWideOption:
+			v, ok := option.GetValue().(bool)
+			if !ok {
+				return nil, typeAssertionError
+			}
+			p.clusterWide = v
+		default:
+			return nil, invalidOptionType
+		}
+	}
+	if len(token) != 0 {
+		data, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, invalidToken
+		}
+		p.after = new(pageEntry)
+		if err := json.Unmarshal(data, p.after); err != nil {
+			return nil, invalidToken
+		}
+	}
+	return p, nil
+}
+
+// entry returns the position of alert stored at key.
+func (p *page) entry(key string, alert *api.Alert) *pageEntry {
+	e := &pageEntry{Key: key, alert: alert}
+	switch p.sortBy {
+	case SortByTimestamp:
+		e.Value = alert.GetTimestamp().GetSeconds()
+	case SortBySeverity:
+		e.Value = int64(severityLevel(alert.Severity))
+	case SortByCount:
+		e.Value = alert.Count
+	}
+	return e
+}
+
+// less returns true if a comes before b. Alerts with equal values are ordered by key.
+func (p *page) less(a, b *pageEntry) bool {
+	if a.Value != b.Value {
+		return a.Value < b.Value != p.descending
+	}
+	return a.Key < b.Key
+}
+
+// full returns true if a page of n alerts is complete.
+func (p *page) full(n int) bool {
+	return p.maxResults > 0 && int64(n) == p.maxResults
+}
+
+// token returns the continuation token of the page ending at last.
+func (p *page) token(last *pageEntry) string {
+	data, _ := json.Marshal(last)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// collect sorts entries and returns the alerts of the page, with the token of
+// the next page if there are more entries.
+func (p *page) collect(entries []*pageEntry) ([]*api.Alert, string) {
+	sort.Slice(entries, func(i, j int) bool {
+		return p.less(entries[i], entries[j])
+	})
+	alerts := make([]*api.Alert, 0, 0)
+	for i, entry := range entries {
+		if p.after != nil && !p.less(p.after, entry) {
+			continue
+		}
+		if p.full(len(alerts)) {
+			return alerts, p.token(entries[i-1])
+		}
+		alerts = append(alerts, entry.alert)
+	}
+	return alerts, ""
+}
@@ -0,0 +1,45 @@
+package alerts
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+)
+
+const (
+	// MaxPayloadKeys is the number of keys an alert payload holds at most
+	MaxPayloadKeys = 32
+	// MaxPayloadKeyLength is the length of a payload key at most
+	MaxPayloadKeyLength = 63
+	// MaxPayloadSize is the total length of the payload keys and values at most
+	MaxPayloadSize = 4096
+
+	// CorrelationIDPayloadKey is the payload key holding the correlation id of
+	// the request which raised an alert, see NewCorrelationIDOption
+	CorrelationIDPayloadKey = correlation.Key
+
+	invalidPayload Error = "invalid alert payload"
+)
+
+// payloadKeyPattern matches the payload keys, such as device_path or io.errors.
+var payloadKeyPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.-]*$`)
+
+// checkPayload returns an error if the payload of an alert exceeds the limits
+// or has an invalid key.
+func checkPayload(payload map[string]string) error {
+	if len(payload) > MaxPayloadKeys {
+		return invalidPayload.Tag(Error(fmt.Sprintf("%d keys, at most %d", len(payload), MaxPayloadKeys)))
+	}
+	size := 0
+	for k, v := range payload {
+		if len(k) > MaxPayloadKeyLength || !payloadKeyPattern.MatchString(k) {
+			return invalidPayload.Tag(Error(fmt.Sprintf("key %q", k)))
+		}
+		size += len(k) + len(v)
+	}
+	if size > MaxPayloadSize {
+		return invalidPayload.Tag(Error(fmt.Sprintf("%d bytes, at most %d", size, MaxPayloadSize)))
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package alerts
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+)
+
+// RateLimitedPayloadKey is the payload key of an alert coalescing the raises
+// beyond the rate limit, with their number as value.
+const RateLimitedPayloadKey = "rate_limited"
+
+// RateLimitConfig configures the rate limit of the raises of every resource
+// type and alert type, see NewRateLimitOption.
+type RateLimitConfig struct {
+	// Rate is the number of raises per second, no limit if unset
+	Rate float64 `yaml:"rate"`
+	// Burst is the number of raises allowed at once, 1 if unset
+	Burst int `yaml:"burst"`
+}
+
+type rateLimit struct {
+	rate  float64
+	burst int
+}
+
+// bucketKey identifies the token bucket of the raises.
+type bucketKey struct {
+	resourceType api.ResourceType
+	alertType    int64
+}
+
+// bucket is a token bucket holding the raises coalesced until a token is
+// available.
+type bucket struct {
+	tokens float64
+	last   time.Time
+	// pending are the coalesced alerts by kvdb key
+	pending map[string]*coalesced
+	timer   *time.Timer
+}
+
+// coalesced is the last alert raised at a key beyond the rate limit and the
+// number of raises it coalesces.
+type coalesced struct {
+	key    string
+	alert  *api.Alert
+	raises int64
+}
+
+// limiter rate limits the raises with a token bucket per resource type and
+// alert type.
+type limiter struct {
+	rate  float64
+	burst float64
+	// flush writes the coalesced alerts once a token is available
+	flush func([]*coalesced)
+
+	lock    sync.Mutex
+	buckets map[bucketKey]*bucket
+}
+
+func newLimiter(r rateLimit, flush func([]*coalesced)) *limiter {
+	burst := float64(r.burst)
+	if burst < 1 {
+		burst = 1
+	}
+	return &limiter{
+		rate:    r.rate,
+		burst:   burst,
+		flush:   flush,
+		buckets: make(map[bucketKey]*bucket),
+	}
+}
+
+// refill adds the tokens earned since the last refill of b, l.lock must be
+// held.
+func (l *limiter) refill(b *bucket, now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+}
+
+// allow returns true if alert can be written at key now. Otherwise alert is
+// coalesced with the raises of key beyond the limit, to be flushed later.
+func (l *limiter) allow(key string, alert *api.Alert) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	bk := bucketKey{resourceType: alert.GetResource(), alertType: alert.GetAlertType()}
+	b, ok := l.buckets[bk]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now, pending: make(map[string]*coalesced)}
+		l.buckets[bk] = b
+	}
+	l.refill(b, now)
+
+	// a raise of a key already coalesced is coalesced too, so that the
+	// writes of the key stay in order
+	c, pending := b.pending[key]
+	if !pending && b.tokens >= 1 {
+		b.tokens--
+		return true
+	}
+	if !pending {
+		c = &coalesced{key: key}
+		b.pending[key] = c
+	}
+	c.alert = proto.Clone(alert).(*api.Alert)
+	c.raises++
+	if b.timer == nil {
+		wait := time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+		b.timer = time.AfterFunc(wait, func() { l.release(bk) })
+	}
+	return false
+}
+
+// release flushes the alerts coalesced in the bucket of bk. Every alert
+// flushed spends a token, which may leave the bucket in debt.
+func (l *limiter) release(bk bucketKey) {
+	l.lock.Lock()
+	b := l.buckets[bk]
+	l.refill(b, time.Now())
+	cs := make([]*coalesced, 0, len(b.pending))
+	for _, c := range b.pending {
+		cs = append(cs, c)
+	}
+	b.tokens -= float64(len(cs))
+	b.pending = make(map[string]*coalesced)
+	b.timer = nil
+	l.lock.Unlock()
+	l.flush(cs)
+}
+
+// putCoalesced writes the alerts coalesced by the rate limiter, marked as
+// rate limited.
+func (m *manager) putCoalesced(cs []*coalesced) {
+	for _, c := range cs {
+		payload := make(map[string]string, len(c.alert.Payload)+1)
+		for k, v := range c.alert.Payload {
+			payload[k] = v
+		}
+		payload[RateLimitedPayloadKey] = strconv.FormatInt(c.raises, 10)
+		c.alert.Payload = payload
+		if err := m.put(c.alert, true, c.raises); err != nil {
+			logrus.WithField("pkg", "openstorage/alerts").
+				Errorf("Failed to write %d rate limited raises of %s: %v", c.raises, c.key, err)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package alerts
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// Reason documents an alert type with a stable, machine readable code, so
+// that automation keys off the code while the messages of the alerts remain
+// free to change, or to be localized by the clients.
+type Reason struct {
+	// AlertType is the alert type the reason documents
+	AlertType int64
+	// Code is the stable code of the alert type, upper case words joined
+	// with underscores such as KVDB_QUORUM_LOST. Codes are never reused.
+	Code string
+	// Resource is the resource type the alerts are raised on
+	Resource api.ResourceType
+	// Description explains when the alert is raised
+	Description string
+	// Component is the component owning the alert type, set when registered
+	// with Component.RegisterReasons
+	Component string
+}
+
+var (
+	reasonsLock sync.RWMutex
+	reasons     = make(map[int64]Reason)
+	reasonCodes = make(map[string]int64)
+
+	reasonCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+)
+
+// RegisterReasons registers the reason codes of alert types. The alerts
+// raised with a registered alert type carry its code. An alert type or a
+// code can only be registered once. The alert types owned by a component
+// are registered with Component.RegisterReasons.
+func RegisterReasons(rs ...Reason) error {
+	reasonsLock.Lock()
+	defer reasonsLock.Unlock()
+	for _, r := range rs {
+		if !reasonCodePattern.MatchString(r.Code) {
+			return fmt.Errorf("invalid reason code %q of alert type %d", r.Code, r.AlertType)
+		}
+		if existing, ok := reasons[r.AlertType]; ok {
+			return fmt.Errorf("alert type %d is already registered as %s", r.AlertType, existing.Code)
+		}
+		if alertType, ok := reasonCodes[r.Code]; ok {
+			return fmt.Errorf("reason code %s is already registered for alert type %d", r.Code, alertType)
+		}
+		if c := componentOf(r.AlertType); c != nil && c.Name != r.Component {
+			return fmt.Errorf("alert type %d is owned by component %s", r.AlertType, c.Name)
+		} else if c == nil && len(r.Component) != 0 {
+			return fmt.Errorf("alert type %d is not owned by component %s", r.AlertType, r.Component)
+		}
+	}
+	for _, r := range rs {
+		reasons[r.AlertType] = r
+		reasonCodes[r.Code] = r.AlertType
+	}
+	return nil
+}
+
+// MustRegisterReasons registers the reason codes of alert types like
+// RegisterReasons and panics on error. It is meant for the init functions
+// of the packages raising alerts.
+func MustRegisterReasons(rs ...Reason) {
+	if err := RegisterReasons(rs...); err != nil {
+		panic(err)
+	}
+}
+
+// ReasonCode returns the reason code of alertType, empty if not registered.
+func ReasonCode(alertType int64) string {
+	reasonsLock.RLock()
+	defer reasonsLock.RUnlock()
+	return reasons[alertType].Code
+}
+
+// ReasonOf returns the reason registered with code.
+func ReasonOf(code string) (Reason, bool) {
+	reasonsLock.RLock()
+	defer reasonsLock.RUnlock()
+	alertType, ok := reasonCodes[code]
+	if !ok {
+		return Reason{}, false
+	}
+	return reasons[alertType], true
+}
+
+// Reasons lists the registered reasons by alert type.
+func Reasons() []Reason {
+	reasonsLock.RLock()
+	defer reasonsLock.RUnlock()
+	rs := make([]Reason, 0, len(reasons))
+	for _, r := range reasons {
+		rs = append(rs, r)
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].AlertType < rs[j].AlertType })
+	return rs
+}
@@ -0,0 +1,102 @@
+package alerts
+
+import (
+	"sort"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const invalidRetention Error = "invalid retention policy"
+
+// RetentionConfig configures the retention policy of the alerts of a resource
+// type, see NewRetentionOption.
+type RetentionConfig struct {
+	// ResourceType is the resource type of the alerts, such as
+	// RESOURCE_TYPE_DRIVE or drive
+	ResourceType string `yaml:"resource_type"`
+	// MaxAge is how long the alerts are kept after their timestamp, no limit
+	// if unset
+	MaxAge time.Duration `yaml:"max_age"`
+	// MaxCount is the number of alerts kept, the most recent ones, no limit
+	// if unset
+	MaxCount int `yaml:"max_count"`
+}
+
+// Option returns the option of the manager creation setting the retention
+// policy of c.
+func (c *RetentionConfig) Option() (Option, error) {
+	v, ok := enumValue(api.ResourceType_value, "RESOURCE_TYPE_", c.ResourceType)
+	if !ok {
+		return nil, invalidRetention.Tag(Error("resource type " + c.ResourceType))
+	}
+	if c.MaxAge < 0 || c.MaxCount < 0 {
+		return nil, invalidRetention.Tag(Error(c.ResourceType))
+	}
+	return NewRetentionOption(api.ResourceType(v), c.MaxAge, c.MaxCount), nil
+}
+
+type retention struct {
+	resourceType api.ResourceType
+	maxAge       time.Duration
+	maxCount     int
+}
+
+// reap deletes the alerts beyond the retention policies at now and returns
+// how many were deleted. The alerts of a resource type are read from the kvdb
+// sub tree of its efficient filter.
+func (m *manager) reap(now time.Time) (int, error) {
+	n := 0
+	for _, r := range m.retention {
+		keys, err := getKeysFromFilter(NewResourceTypeFilter(r.resourceType))
+		if err != nil {
+			return n, err
+		}
+		for _, key := range keys {
+			q, err := queryOf(key)
+			if err != nil {
+				return n, err
+			}
+			stored, err := m.store.Enumerate(q)
+			if err != nil {
+				return n, err
+			}
+			deleted, err := m.reapAlerts(r, stored, now)
+			n += deleted
+			if err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// reapAlerts deletes the alerts older than the maximum age of r and the
+// oldest ones beyond its maximum count.
+func (m *manager) reapAlerts(r retention, stored []*api.Alert, now time.Time) (int, error) {
+	// most recent first
+	sort.Slice(stored, func(i, j int) bool {
+		ti, tj := stored[i].GetTimestamp(), stored[j].GetTimestamp()
+		if ti.GetSeconds() != tj.GetSeconds() {
+			return ti.GetSeconds() > tj.GetSeconds()
+		}
+		return ti.GetNanos() > tj.GetNanos()
+	})
+	n := 0
+	for i, alert := range stored {
+		keep := r.maxCount == 0 || i < r.maxCount
+		if keep && r.maxAge > 0 && alert.GetTimestamp() != nil {
+			keep = now.Unix()-alert.GetTimestamp().GetSeconds() < int64(r.maxAge/time.Second)
+		}
+		if keep {
+			continue
+		}
+		// an alert raised again since it was read is not deleted
+		if deleted, err := m.store.CompareAndDelete(alert); err != nil {
+			return n, err
+		} else if deleted {
+			n++
+		}
+	}
+	return n, nil
+}
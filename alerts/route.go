@@ -0,0 +1,184 @@
+package alerts
+
+import (
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+)
+
+const invalidRoute Error = "invalid alert route"
+
+// Route selects the notifiers the alerts it matches are delivered to. The
+// routes are evaluated in order and the first one matching an alert wins.
+// The alerts matched by no route are delivered to every notifier, as
+// without routes.
+// swagger:model
+type Route struct {
+	// Name identifies the route
+	Name string `json:"name" yaml:"name"`
+	// ResourceTypes matches the alerts of these resource types, all if empty
+	ResourceTypes []string `json:"resource_types,omitempty" yaml:"resource_types"`
+	// AlertTypes matches the alerts of these alert types, all if empty
+	AlertTypes []int64 `json:"alert_types,omitempty" yaml:"alert_types"`
+	// MinSeverity matches the alerts at least this severe, all if unset
+	MinSeverity string `json:"min_severity,omitempty" yaml:"min_severity"`
+	// Labels matches the alerts whose resource has all these labels,
+	// given by the labels function of the manager
+	Labels map[string]string `json:"labels,omitempty" yaml:"labels"`
+	// Notifiers are the names of the notifiers the alerts are delivered
+	// to, none drops the alerts
+	Notifiers []string `json:"notifiers,omitempty" yaml:"notifiers"`
+}
+
+// RoutePreview tells how an alert would be delivered.
+// swagger:model
+type RoutePreview struct {
+	// Route is the name of the route matching the alert, empty if none
+	Route string `json:"route,omitempty"`
+	// Notifiers are the names of the notifiers the alert would be
+	// delivered to
+	Notifiers []string `json:"notifiers"`
+	// Silenced is set if a silence mutes the alert
+	Silenced bool `json:"silenced,omitempty"`
+	// Maintenance is set if a maintenance window suppresses the
+	// notifications of the alert
+	Maintenance bool `json:"maintenance,omitempty"`
+}
+
+// LabelsFunc returns the labels of a resource, such as the labels of a
+// volume or of a node.
+type LabelsFunc func(resourceType api.ResourceType, resourceID string) (map[string]string, error)
+
+// route is a Route with its filters.
+type route struct {
+	*Route
+	filters []Filter
+}
+
+// sends returns true if the route delivers to the notifier name, a nil
+// route delivering to every notifier.
+func (r *route) sends(name string) bool {
+	if r == nil {
+		return true
+	}
+	for _, n := range r.Notifiers {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *manager) SetRoutes(routes ...*Route) error {
+	compiled := make([]*route, 0, len(routes))
+	for _, r := range routes {
+		filters, err := selectFilters(r.ResourceTypes, r.AlertTypes, r.MinSeverity)
+		if err != nil {
+			return invalidRoute.Tag(Error(r.Name + ": " + err.Error()))
+		}
+		compiled = append(compiled, &route{Route: r, filters: filters})
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.routes = compiled
+	return nil
+}
+
+func (m *manager) Routes() []*Route {
+	m.Lock()
+	defer m.Unlock()
+	routes := make([]*Route, 0, len(m.routes))
+	for _, r := range m.routes {
+		routes = append(routes, r.Route)
+	}
+	return routes
+}
+
+func (m *manager) PreviewRoute(alert *api.Alert) (*RoutePreview, error) {
+	silences, err := m.EnumerateSilences()
+	if err != nil {
+		return nil, err
+	}
+	windows, err := m.EnumerateMaintenanceWindows()
+	if err != nil {
+		return nil, err
+	}
+	m.Lock()
+	routes := m.routes
+	m.Unlock()
+	r, err := m.matchRoute(routes, alert)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &RoutePreview{
+		Notifiers:   []string{},
+		Silenced:    silenced(silences, alert),
+		Maintenance: inMaintenance(windows, alert),
+	}
+	if r != nil {
+		preview.Route = r.Name
+	}
+	m.Lock()
+	defer m.Unlock()
+	for name, s := range m.notifiers {
+		if s.match(alert) && r.sends(name) {
+			preview.Notifiers = append(preview.Notifiers, name)
+		}
+	}
+	return preview, nil
+}
+
+// matchRoute returns the first of routes matching alert, nil if none.
+func (m *manager) matchRoute(routes []*route, alert *api.Alert) (*route, error) {
+	var labels map[string]string
+	labelsFetched := false
+	for _, r := range routes {
+		match := true
+		for _, f := range r.filters {
+			if match, _ = f.Match(alert); !match {
+				break
+			}
+		}
+		if !match {
+			continue
+		}
+		if len(r.Labels) != 0 {
+			if m.labels == nil {
+				continue
+			}
+			if !labelsFetched {
+				var err error
+				if labels, err = m.labels(alert.GetResource(), alert.GetResourceId()); err != nil {
+					return nil, err
+				}
+				labelsFetched = true
+			}
+			if !hasLabels(labels, r.Labels) {
+				continue
+			}
+		}
+		return r, nil
+	}
+	return nil, nil
+}
+
+// route returns the route of alert, nil if none, falling back to no route
+// if the labels of its resource cannot be fetched.
+func (m *manager) route(routes []*route, alert *api.Alert) *route {
+	r, err := m.matchRoute(routes, alert)
+	if err != nil {
+		logrus.WithField("pkg", "openstorage/alerts").
+			Warnf("Failed to route alert %s, delivering it to every notifier: %v", ID(alert), err)
+	}
+	return r
+}
+
+// hasLabels returns true if labels has all the selected labels.
+func hasLabels(labels, selected map[string]string) bool {
+	for k, v := range selected {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
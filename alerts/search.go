@@ -0,0 +1,150 @@
+package alerts
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// words returns the lower case words of text, split on anything but letters
+// and digits.
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// alertWords returns the words of the message and payload values of alert.
+func alertWords(alert *api.Alert) []string {
+	ws := words(alert.GetMessage())
+	for _, value := range alert.GetPayload() {
+		ws = append(ws, words(value)...)
+	}
+	return ws
+}
+
+// hasTerms returns true if every term starts one of words.
+func hasTerms(words, terms []string) bool {
+	for _, term := range terms {
+		found := false
+		for _, w := range words {
+			if strings.HasPrefix(w, term) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// filterTerms returns the terms every alert matched by f has, nil if none.
+func filterTerms(f Filter) []string {
+	switch f.GetFilterType() {
+	case searchFilter:
+		v, _ := f.GetValue().([]string)
+		return v
+	case andFilter:
+		v, _ := f.GetValue().([]Filter)
+		var terms []string
+		for _, w := range v {
+			terms = append(terms, filterTerms(w)...)
+		}
+		return terms
+	case orFilter:
+		v, _ := f.GetValue().([]Filter)
+		return commonTerms(v...)
+	}
+	return nil
+}
+
+// commonTerms returns the terms every alert matched by one of filters has,
+// nil if none.
+func commonTerms(filters ...Filter) []string {
+	var common map[string]bool
+	for _, f := range filters {
+		terms := make(map[string]bool)
+		for _, term := range filterTerms(f) {
+			if common == nil || common[term] {
+				terms[term] = true
+			}
+		}
+		if len(terms) == 0 {
+			return nil
+		}
+		common = terms
+	}
+	out := make([]string, 0, len(common))
+	for term := range common {
+		out = append(out, term)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// searchIndex is an inverted index of the words of the alerts by key.
+type searchIndex struct {
+	// keys are the keys of the alerts by word
+	keys map[string]map[string]bool
+	// words are the words of the alerts by key
+	words map[string][]string
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		keys:  make(map[string]map[string]bool),
+		words: make(map[string][]string),
+	}
+}
+
+// add indexes the words of alert, replacing those of its key.
+func (x *searchIndex) add(key string, alert *api.Alert) {
+	x.remove(key)
+	ws := alertWords(alert)
+	for _, w := range ws {
+		if x.keys[w] == nil {
+			x.keys[w] = make(map[string]bool)
+		}
+		x.keys[w][key] = true
+	}
+	x.words[key] = ws
+}
+
+// remove removes the words of the alert of key.
+func (x *searchIndex) remove(key string) {
+	for _, w := range x.words[key] {
+		delete(x.keys[w], key)
+		if len(x.keys[w]) == 0 {
+			delete(x.keys, w)
+		}
+	}
+	delete(x.words, key)
+}
+
+// lookup returns the keys of the alerts having a word starting with every
+// term.
+func (x *searchIndex) lookup(terms []string) map[string]bool {
+	var found map[string]bool
+	for _, term := range terms {
+		keys := make(map[string]bool)
+		for w, wKeys := range x.keys {
+			if !strings.HasPrefix(w, term) {
+				continue
+			}
+			for key := range wKeys {
+				if found == nil || found[key] {
+					keys[key] = true
+				}
+			}
+		}
+		found = keys
+		if len(found) == 0 {
+			break
+		}
+	}
+	return found
+}
@@ -0,0 +1,111 @@
+package alerts
+
+import (
+	"path"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/pborman/uuid"
+)
+
+const (
+	// silenceKey is the kvdb tree of the silences, outside of the alerts tree
+	silenceKey = "silences/alerts"
+
+	silenceNotFound Error = "silence not found"
+	invalidSilence  Error = "invalid silence"
+)
+
+// Silence mutes the matching alerts from StartsAt to EndsAt: they are
+// still stored when raised, but neither delivered to the notifiers nor
+// returned by enumerations without NewIncludeSilencedOption.
+type Silence struct {
+	// ID identifies the silence, set on creation
+	ID string `json:"id"`
+	// ResourceType of the alerts silenced, all if RESOURCE_TYPE_NONE
+	ResourceType api.ResourceType `json:"resource_type"`
+	// AlertType of the alerts silenced, all if zero
+	AlertType int64 `json:"alert_type"`
+	// ResourceIDPattern is a shell pattern, such as "pvc-*", matching the
+	// resource ids of the alerts silenced, all if empty
+	ResourceIDPattern string `json:"resource_id_pattern"`
+	// StartsAt is when the silence starts, on creation if zero
+	StartsAt time.Time `json:"starts_at"`
+	// EndsAt is when the silence ends, the silence is deleted then
+	EndsAt time.Time `json:"ends_at"`
+	// CreatedBy is the operator who created the silence
+	CreatedBy string `json:"created_by"`
+	// Comment explains the silence, such as a maintenance ticket
+	Comment string `json:"comment"`
+}
+
+// Matches returns true if the silence mutes alert at time now.
+func (s *Silence) Matches(alert *api.Alert, now time.Time) bool {
+	if now.Before(s.StartsAt) || !now.Before(s.EndsAt) {
+		return false
+	}
+	if s.ResourceType != api.ResourceType_RESOURCE_TYPE_NONE && s.ResourceType != alert.GetResource() {
+		return false
+	}
+	if s.AlertType != 0 && s.AlertType != alert.GetAlertType() {
+		return false
+	}
+	if len(s.ResourceIDPattern) != 0 {
+		if match, err := path.Match(s.ResourceIDPattern, alert.GetResourceId()); err != nil || !match {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *manager) AddSilence(s *Silence) (string, error) {
+	now := time.Now()
+	if s.StartsAt.IsZero() {
+		s.StartsAt = now
+	}
+	if !s.EndsAt.After(s.StartsAt) || !s.EndsAt.After(now) {
+		return "", invalidSilence.Tag("ends before it starts or in the past")
+	}
+	if _, err := path.Match(s.ResourceIDPattern, ""); err != nil {
+		return "", invalidSilence.Tag(Error(err.Error()))
+	}
+	s.ID = uuid.New()
+	// the store deletes the silence once it ends
+	ttl := uint64(s.EndsAt.Sub(now)/time.Second) + 1
+	if err := m.store.PutSilence(s, ttl); err != nil {
+		return "", err
+	}
+	return s.ID, nil
+}
+
+func (m *manager) DeleteSilence(id string) error {
+	return m.store.DeleteSilence(id)
+}
+
+func (m *manager) EnumerateSilences() ([]*Silence, error) {
+	stored, err := m.store.EnumerateSilences()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	silences := make([]*Silence, 0, len(stored))
+	for _, s := range stored {
+		// the store may not have expired an ended silence yet
+		if !now.Before(s.EndsAt) {
+			continue
+		}
+		silences = append(silences, s)
+	}
+	return silences, nil
+}
+
+// silenced returns true if one of silences mutes alert now.
+func silenced(silences []*Silence, alert *api.Alert) bool {
+	now := time.Now()
+	for _, s := range silences {
+		if s.Matches(alert, now) {
+			return true
+		}
+	}
+	return false
+}
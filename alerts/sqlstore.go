@@ -0,0 +1,382 @@
+package alerts
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// SQLDialect tells how the statements of a SQL store bind their parameters.
+type SQLDialect int
+
+// SQLDialect constants.
+const (
+	// SQLQuestion binds the parameters with ?, as MySQL and SQLite.
+	SQLQuestion SQLDialect = iota
+	// SQLDollar binds the parameters with $1, $2..., as PostgreSQL.
+	SQLDollar
+)
+
+const (
+	sqlAlertsTable      = "alerts"
+	sqlSilencesTable    = "alert_silences"
+	sqlWindowsTable     = "alert_maintenance_windows"
+	sqlEscalationsTable = "alert_escalation_rules"
+)
+
+// sqlSchema creates the tables of a SQL store. The expiry is a unix time in
+// seconds, zero for never.
+var sqlSchema = []string{
+	"CREATE TABLE IF NOT EXISTS " + sqlAlertsTable + " (" +
+		"resource_type VARCHAR(64) NOT NULL, " +
+		"alert_type BIGINT NOT NULL, " +
+		"resource_id VARCHAR(255) NOT NULL, " +
+		"data TEXT NOT NULL, " +
+		"expires_at BIGINT NOT NULL, " +
+		"PRIMARY KEY (resource_type, alert_type, resource_id))",
+	"CREATE TABLE IF NOT EXISTS " + sqlSilencesTable + " (" +
+		"id VARCHAR(255) NOT NULL PRIMARY KEY, " +
+		"data TEXT NOT NULL, " +
+		"expires_at BIGINT NOT NULL)",
+	"CREATE TABLE IF NOT EXISTS " + sqlWindowsTable + " (" +
+		"id VARCHAR(255) NOT NULL PRIMARY KEY, " +
+		"data TEXT NOT NULL, " +
+		"expires_at BIGINT NOT NULL)",
+	"CREATE TABLE IF NOT EXISTS " + sqlEscalationsTable + " (" +
+		"id VARCHAR(255) NOT NULL PRIMARY KEY, " +
+		"data TEXT NOT NULL, " +
+		"expires_at BIGINT NOT NULL)",
+}
+
+// sqlStore stores the alerts in the alerts table of a SQL database, keyed by
+// resource type, alert type and resource id, the silences in the
+// alert_silences table, the maintenance windows in the
+// alert_maintenance_windows table and the escalation rules, by name, in the
+// alert_escalation_rules table. The resource type of the alerts of a namespace
+// is prefixed by <namespace>/, as their kvdb keys are. A query is a where clause
+// on the key columns. The SQL stores cannot be watched.
+type sqlStore struct {
+	db      *sql.DB
+	dialect SQLDialect
+}
+
+// NewSQLStore provides a store of the alerts in the SQL database db, creating
+// its tables if needed. The driver of db is registered by the caller.
+func NewSQLStore(db *sql.DB, dialect SQLDialect) (Store, error) {
+	for _, stmt := range sqlSchema {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, err
+		}
+	}
+	return &sqlStore{db: db, dialect: dialect}, nil
+}
+
+// sqlStatement builds statements, binding their parameters per dialect.
+type sqlStatement struct {
+	dialect SQLDialect
+	sql     []string
+	args    []interface{}
+}
+
+// add appends clause, whose ? are bound to args.
+func (s *sqlStatement) add(clause string, args ...interface{}) {
+	for _, arg := range args {
+		s.args = append(s.args, arg)
+		if s.dialect == SQLDollar {
+			clause = strings.Replace(clause, "?", "$"+strconv.Itoa(len(s.args)), 1)
+		}
+	}
+	s.sql = append(s.sql, clause)
+}
+
+func (s *sqlStatement) String() string {
+	return strings.Join(s.sql, " ")
+}
+
+// sqlWhere appends to stmt the where clause of the alerts selected by q,
+// unexpired at now unless zero.
+func sqlWhere(stmt *sqlStatement, q Query, now time.Time) {
+	var conds []string
+	var args []interface{}
+	if !now.IsZero() {
+		conds = append(conds, "(expires_at = 0 OR expires_at > ?)")
+		args = append(args, now.Unix())
+	}
+	if q.Level >= QueryResourceType {
+		conds = append(conds, "resource_type = ?")
+		args = append(args, sqlResourceType(q.Namespace, q.ResourceType))
+	} else if len(q.Namespace) != 0 {
+		// namespaces have no wildcard character
+		conds = append(conds, "resource_type LIKE ?")
+		args = append(args, q.Namespace+"/%")
+	}
+	if q.Level >= QueryAlertType {
+		conds = append(conds, "alert_type = ?")
+		args = append(args, q.AlertType)
+	}
+	if q.Level >= QueryResource {
+		conds = append(conds, "resource_id = ?")
+		args = append(args, q.ResourceID)
+	}
+	if len(conds) != 0 {
+		stmt.add("WHERE "+strings.Join(conds, " AND "), args...)
+	}
+}
+
+// sqlResourceType returns the resource type column of the alerts of
+// resourceType in namespace.
+func sqlResourceType(namespace string, resourceType api.ResourceType) string {
+	if len(namespace) == 0 {
+		return resourceType.String()
+	}
+	return namespace + "/" + resourceType.String()
+}
+
+func sqlExpiry(ttl uint64) int64 {
+	if ttl == 0 {
+		return 0
+	}
+	return time.Now().Add(time.Duration(ttl) * time.Second).Unix()
+}
+
+func (s *sqlStore) statement() *sqlStatement {
+	return &sqlStatement{dialect: s.dialect}
+}
+
+func (s *sqlStore) Put(alert *api.Alert, ttl uint64) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	del := s.statement()
+	del.add("DELETE FROM " + sqlAlertsTable)
+	del.add("WHERE resource_type = ? AND alert_type = ? AND resource_id = ?",
+		sqlResourceType(Namespace(alert), alert.GetResource()), alert.GetAlertType(), alert.GetResourceId())
+	if _, err := tx.Exec(del.String(), del.args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	ins := s.statement()
+	ins.add("INSERT INTO " + sqlAlertsTable + " (resource_type, alert_type, resource_id, data, expires_at)")
+	ins.add("VALUES (?, ?, ?, ?, ?)",
+		sqlResourceType(Namespace(alert), alert.GetResource()), alert.GetAlertType(), alert.GetResourceId(),
+		string(data), sqlExpiry(ttl))
+	if _, err := tx.Exec(ins.String(), ins.args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) Get(resourceType api.ResourceType, alertType int64, resourceID string) (*api.Alert, error) {
+	alerts, err := s.Enumerate(Query{
+		Level:        QueryResource,
+		ResourceType: resourceType,
+		AlertType:    alertType,
+		ResourceID:   resourceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(alerts) == 0 {
+		return nil, alertNotFound
+	}
+	return alerts[0], nil
+}
+
+func (s *sqlStore) Enumerate(q Query) ([]*api.Alert, error) {
+	stmt := s.statement()
+	stmt.add("SELECT data FROM " + sqlAlertsTable)
+	sqlWhere(stmt, q, time.Now())
+	rows, err := s.db.Query(stmt.String(), stmt.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alerts []*api.Alert
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		alert := new(api.Alert)
+		if err := json.Unmarshal([]byte(data), alert); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts, rows.Err()
+}
+
+func (s *sqlStore) Delete(q Query) error {
+	stmt := s.statement()
+	stmt.add("DELETE FROM " + sqlAlertsTable)
+	// the expired alerts are deleted along
+	sqlWhere(stmt, q, time.Time{})
+	_, err := s.db.Exec(stmt.String(), stmt.args...)
+	return err
+}
+
+func (s *sqlStore) CompareAndDelete(alert *api.Alert) (bool, error) {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return false, err
+	}
+	stmt := s.statement()
+	stmt.add("DELETE FROM " + sqlAlertsTable)
+	stmt.add("WHERE resource_type = ? AND alert_type = ? AND resource_id = ? AND data = ?",
+		sqlResourceType(Namespace(alert), alert.GetResource()), alert.GetAlertType(), alert.GetResourceId(),
+		string(data))
+	res, err := s.db.Exec(stmt.String(), stmt.args...)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n != 0, nil
+}
+
+func (s *sqlStore) Watch(q Query, fn WatchFunc) error {
+	return storeNotSupported
+}
+
+func (s *sqlStore) PutSilence(silence *Silence, ttl uint64) error {
+	return s.putRecord(sqlSilencesTable, silence.ID, silence, ttl)
+}
+
+func (s *sqlStore) DeleteSilence(id string) error {
+	return s.deleteRecord(sqlSilencesTable, id, silenceNotFound)
+}
+
+func (s *sqlStore) EnumerateSilences() ([]*Silence, error) {
+	var silences []*Silence
+	err := s.enumerateRecords(sqlSilencesTable, func(value []byte) error {
+		silence := new(Silence)
+		if err := json.Unmarshal(value, silence); err != nil {
+			return err
+		}
+		silences = append(silences, silence)
+		return nil
+	})
+	return silences, err
+}
+
+func (s *sqlStore) PutMaintenanceWindow(w *MaintenanceWindow, ttl uint64) error {
+	return s.putRecord(sqlWindowsTable, w.ID, w, ttl)
+}
+
+func (s *sqlStore) DeleteMaintenanceWindow(id string) error {
+	return s.deleteRecord(sqlWindowsTable, id, windowNotFound)
+}
+
+func (s *sqlStore) EnumerateMaintenanceWindows() ([]*MaintenanceWindow, error) {
+	var windows []*MaintenanceWindow
+	err := s.enumerateRecords(sqlWindowsTable, func(value []byte) error {
+		w := new(MaintenanceWindow)
+		if err := json.Unmarshal(value, w); err != nil {
+			return err
+		}
+		windows = append(windows, w)
+		return nil
+	})
+	return windows, err
+}
+
+func (s *sqlStore) PutEscalationRule(r *EscalationRule) error {
+	return s.putRecord(sqlEscalationsTable, r.Name, r, 0)
+}
+
+func (s *sqlStore) DeleteEscalationRule(name string) error {
+	return s.deleteRecord(sqlEscalationsTable, name, escalationNotFound)
+}
+
+func (s *sqlStore) EnumerateEscalationRules() ([]*EscalationRule, error) {
+	var rules []*EscalationRule
+	err := s.enumerateRecords(sqlEscalationsTable, func(value []byte) error {
+		r := new(EscalationRule)
+		if err := json.Unmarshal(value, r); err != nil {
+			return err
+		}
+		rules = append(rules, r)
+		return nil
+	})
+	return rules, err
+}
+
+// putRecord stores v, a silence, a maintenance window or an escalation rule,
+// in the table by id.
+func (s *sqlStore) putRecord(table, id string, v interface{}, ttl uint64) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	del := s.statement()
+	del.add("DELETE FROM "+table+" WHERE id = ?", id)
+	if _, err := tx.Exec(del.String(), del.args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	ins := s.statement()
+	ins.add("INSERT INTO "+table+" (id, data, expires_at) VALUES (?, ?, ?)",
+		id, string(data), sqlExpiry(ttl))
+	if _, err := tx.Exec(ins.String(), ins.args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// deleteRecord deletes the record of id from the table, notFound if none.
+func (s *sqlStore) deleteRecord(table, id string, notFound Error) error {
+	stmt := s.statement()
+	stmt.add("DELETE FROM "+table+" WHERE id = ?", id)
+	res, err := s.db.Exec(stmt.String(), stmt.args...)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return notFound
+	}
+	return nil
+}
+
+// enumerateRecords calls decode with the data of every unexpired record of
+// the table.
+func (s *sqlStore) enumerateRecords(table string, decode func(value []byte) error) error {
+	stmt := s.statement()
+	stmt.add("SELECT data FROM " + table)
+	stmt.add("WHERE expires_at = 0 OR expires_at > ?", time.Now().Unix())
+	rows, err := s.db.Query(stmt.String(), stmt.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return err
+		}
+		if err := decode([]byte(data)); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,336 @@
+package alerts
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// fakeSQLDriverName is the database/sql driver of the fake databases, which
+// are opened by name.
+const fakeSQLDriverName = "alerts_fake"
+
+var (
+	fakeSQLLock sync.Mutex
+	fakeSQLDBs  = make(map[string]*fakeSQL)
+)
+
+func init() {
+	sql.Register(fakeSQLDriverName, fakeSQLDriver{})
+}
+
+// fakeSQLExec is a statement run on a fake database, or a transaction
+// boundary: BEGIN, COMMIT or ROLLBACK.
+type fakeSQLExec struct {
+	query string
+	args  []driver.Value
+}
+
+// fakeSQL is a database recording the statements run on it. Its queries
+// return rows, one data column each, and the statements starting with fail
+// fail.
+type fakeSQL struct {
+	lock  sync.Mutex
+	execs []fakeSQLExec
+	rows  []string
+	fail  string
+}
+
+func (f *fakeSQL) exec(query string, args []driver.Value) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.execs = append(f.execs, fakeSQLExec{query: query, args: args})
+	if len(f.fail) != 0 && strings.HasPrefix(query, f.fail) {
+		return errors.New("fake failure of " + query)
+	}
+	return nil
+}
+
+// reset forgets the statements run, returning them.
+func (f *fakeSQL) reset() []fakeSQLExec {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	execs := f.execs
+	f.execs = nil
+	return execs
+}
+
+type fakeSQLDriver struct{}
+
+func (fakeSQLDriver) Open(name string) (driver.Conn, error) {
+	fakeSQLLock.Lock()
+	defer fakeSQLLock.Unlock()
+	db, ok := fakeSQLDBs[name]
+	if !ok {
+		return nil, errors.New("no fake database " + name)
+	}
+	return &fakeSQLConn{db: db}, nil
+}
+
+type fakeSQLConn struct {
+	db *fakeSQL
+}
+
+func (c *fakeSQLConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeSQLStmt{db: c.db, query: query}, nil
+}
+
+func (c *fakeSQLConn) Close() error {
+	return nil
+}
+
+func (c *fakeSQLConn) Begin() (driver.Tx, error) {
+	return &fakeSQLTx{db: c.db}, c.db.exec("BEGIN", nil)
+}
+
+type fakeSQLTx struct {
+	db *fakeSQL
+}
+
+func (tx *fakeSQLTx) Commit() error {
+	return tx.db.exec("COMMIT", nil)
+}
+
+func (tx *fakeSQLTx) Rollback() error {
+	return tx.db.exec("ROLLBACK", nil)
+}
+
+type fakeSQLStmt struct {
+	db    *fakeSQL
+	query string
+}
+
+func (s *fakeSQLStmt) Close() error {
+	return nil
+}
+
+func (s *fakeSQLStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeSQLStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if err := s.db.exec(s.query, args); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeSQLStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if err := s.db.exec(s.query, args); err != nil {
+		return nil, err
+	}
+	s.db.lock.Lock()
+	defer s.db.lock.Unlock()
+	return &fakeSQLRows{rows: append([]string(nil), s.db.rows...)}, nil
+}
+
+type fakeSQLRows struct {
+	rows []string
+}
+
+func (r *fakeSQLRows) Columns() []string {
+	return []string{"data"}
+}
+
+func (r *fakeSQLRows) Close() error {
+	return nil
+}
+
+func (r *fakeSQLRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	dest[0] = r.rows[0]
+	r.rows = r.rows[1:]
+	return nil
+}
+
+// newFakeSQLStore returns a SQL store of dialect on a new fake database.
+func newFakeSQLStore(t *testing.T, dialect SQLDialect) (Store, *fakeSQL) {
+	name := t.Name() + "/" + map[SQLDialect]string{SQLQuestion: "question", SQLDollar: "dollar"}[dialect]
+	fake := &fakeSQL{}
+	fakeSQLLock.Lock()
+	fakeSQLDBs[name] = fake
+	fakeSQLLock.Unlock()
+
+	db, err := sql.Open(fakeSQLDriverName, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSQLStore(db, dialect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if execs := fake.reset(); len(execs) != len(sqlSchema) {
+		t.Fatal("schema: expected:", len(sqlSchema), "statements, found:", execs)
+	}
+	return s, fake
+}
+
+// checkSQLExecs checks the statements run, but for the arguments at the
+// positions of expected set to nil.
+func checkSQLExecs(t *testing.T, expected, found []fakeSQLExec) {
+	if len(found) != len(expected) {
+		t.Fatal("statements: expected:", expected, "found:", found)
+	}
+	for i := range expected {
+		if found[i].query != expected[i].query {
+			t.Fatal("statement", i, "expected:", expected[i].query, "found:", found[i].query)
+		}
+		if len(found[i].args) != len(expected[i].args) {
+			t.Fatal("statement", i, "arguments: expected:", expected[i].args, "found:", found[i].args)
+		}
+		for j, arg := range expected[i].args {
+			if arg != nil && !reflect.DeepEqual(arg, found[i].args[j]) {
+				t.Fatal("statement", i, "argument", j, "expected:", arg, "found:", found[i].args[j])
+			}
+		}
+	}
+}
+
+func TestSQLStatement(t *testing.T) {
+	for dialect, expected := range map[SQLDialect]string{
+		SQLQuestion: "SELECT data FROM alerts WHERE a = ? AND b = ? AND c = ? AND d = 'e'",
+		SQLDollar:   "SELECT data FROM alerts WHERE a = $1 AND b = $2 AND c = $3 AND d = 'e'",
+	} {
+		stmt := &sqlStatement{dialect: dialect}
+		stmt.add("SELECT data FROM alerts")
+		stmt.add("WHERE a = ? AND b = ?", 1, "two")
+		stmt.add("AND c = ?", 3)
+		stmt.add("AND d = 'e'")
+		if stmt.String() != expected {
+			t.Fatal("statement: expected:", expected, "found:", stmt.String())
+		}
+		if !reflect.DeepEqual(stmt.args, []interface{}{1, "two", 3}) {
+			t.Fatal("arguments: expected: [1 two 3], found:", stmt.args)
+		}
+	}
+}
+
+func TestSQLStore_Put(t *testing.T) {
+	alert := &api.Alert{
+		AlertType:  10,
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol1",
+		Payload:    map[string]string{NamespacePayloadKey: "ns1"},
+	}
+	data, err := json.Marshal(alert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceType := "ns1/" + api.ResourceType_RESOURCE_TYPE_VOLUME.String()
+
+	for _, dialect := range []SQLDialect{SQLQuestion, SQLDollar} {
+		s, fake := newFakeSQLStore(t, dialect)
+		del := "DELETE FROM alerts WHERE resource_type = ? AND alert_type = ? AND resource_id = ?"
+		ins := "INSERT INTO alerts (resource_type, alert_type, resource_id, data, expires_at) VALUES (?, ?, ?, ?, ?)"
+		if dialect == SQLDollar {
+			del = "DELETE FROM alerts WHERE resource_type = $1 AND alert_type = $2 AND resource_id = $3"
+			ins = "INSERT INTO alerts (resource_type, alert_type, resource_id, data, expires_at) VALUES ($1, $2, $3, $4, $5)"
+		}
+
+		// the alert is replaced in a transaction, never expiring
+		if err := s.Put(alert, 0); err != nil {
+			t.Fatal(err)
+		}
+		checkSQLExecs(t, []fakeSQLExec{
+			{query: "BEGIN"},
+			{query: del, args: []driver.Value{resourceType, int64(10), "vol1"}},
+			{query: ins, args: []driver.Value{resourceType, int64(10), "vol1", string(data), int64(0)}},
+			{query: "COMMIT"},
+		}, fake.reset())
+
+		// the expiry is a unix time
+		before := time.Now().Add(time.Minute).Unix()
+		if err := s.Put(alert, 60); err != nil {
+			t.Fatal(err)
+		}
+		after := time.Now().Add(time.Minute).Unix()
+		execs := fake.reset()
+		checkSQLExecs(t, []fakeSQLExec{
+			{query: "BEGIN"},
+			{query: del, args: []driver.Value{resourceType, int64(10), "vol1"}},
+			{query: ins, args: []driver.Value{resourceType, int64(10), "vol1", string(data), nil}},
+			{query: "COMMIT"},
+		}, execs)
+		if expiry := execs[2].args[4].(int64); expiry < before || expiry > after {
+			t.Fatal("expiry: expected: between", before, "and", after, "found:", expiry)
+		}
+
+		// the transaction is rolled back if the insert fails
+		fake.fail = "INSERT"
+		if err := s.Put(alert, 0); err == nil {
+			t.Fatal("expected: an error, found: nil")
+		}
+		checkSQLExecs(t, []fakeSQLExec{
+			{query: "BEGIN"},
+			{query: del, args: []driver.Value{resourceType, int64(10), "vol1"}},
+			{query: ins, args: []driver.Value{resourceType, int64(10), "vol1", string(data), int64(0)}},
+			{query: "ROLLBACK"},
+		}, fake.reset())
+	}
+}
+
+func TestSQLStore_EnumerateDelete(t *testing.T) {
+	alert := &api.Alert{
+		AlertType:  10,
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol1",
+		Payload:    map[string]string{NamespacePayloadKey: "ns1"},
+	}
+	data, err := json.Marshal(alert)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dialect := range []SQLDialect{SQLQuestion, SQLDollar} {
+		s, fake := newFakeSQLStore(t, dialect)
+		sel := "SELECT data FROM alerts WHERE (expires_at = 0 OR expires_at > ?) AND resource_type LIKE ?"
+		del := "DELETE FROM alerts WHERE resource_type LIKE ?"
+		silences := "SELECT data FROM alert_silences WHERE expires_at = 0 OR expires_at > ?"
+		if dialect == SQLDollar {
+			sel = "SELECT data FROM alerts WHERE (expires_at = 0 OR expires_at > $1) AND resource_type LIKE $2"
+			del = "DELETE FROM alerts WHERE resource_type LIKE $1"
+			silences = "SELECT data FROM alert_silences WHERE expires_at = 0 OR expires_at > $1"
+		}
+
+		// the expired alerts are not enumerated
+		fake.rows = []string{string(data)}
+		before := time.Now().Unix()
+		alerts, err := s.Enumerate(Query{Level: QueryAll, Namespace: "ns1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		after := time.Now().Unix()
+		if len(alerts) != 1 || alerts[0].GetResourceId() != "vol1" || Namespace(alerts[0]) != "ns1" {
+			t.Fatal("alerts: expected: vol1 of ns1, found:", alerts)
+		}
+		execs := fake.reset()
+		checkSQLExecs(t, []fakeSQLExec{{query: sel, args: []driver.Value{nil, "ns1/%"}}}, execs)
+		if now := execs[0].args[0].(int64); now < before || now > after {
+			t.Fatal("expiry: expected: between", before, "and", after, "found:", now)
+		}
+
+		// but they are deleted
+		if err := s.Delete(Query{Level: QueryAll, Namespace: "ns1"}); err != nil {
+			t.Fatal(err)
+		}
+		checkSQLExecs(t, []fakeSQLExec{{query: del, args: []driver.Value{"ns1/%"}}}, fake.reset())
+
+		// nor are the expired records
+		fake.rows = nil
+		if _, err := s.EnumerateSilences(); err != nil {
+			t.Fatal(err)
+		}
+		checkSQLExecs(t, []fakeSQLExec{{query: silences, args: []driver.Value{nil}}}, fake.reset())
+	}
+}
@@ -0,0 +1,133 @@
+package alerts
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	alertNotFound Error = "alert not found"
+	invalidID     Error = "invalid alert id"
+
+	// ErrNotFound is returned by Ack, Clear and Annotate for an alert which
+	// is not stored
+	ErrNotFound = alertNotFound
+	// ErrInvalidID is returned by Ack, Clear and Annotate for an id which is
+	// not one of those returned by ID
+	ErrInvalidID = invalidID
+)
+
+// State defines the lifecycle state of an alert.
+type State int
+
+// State constants.
+const (
+	// StateNew is the state of a raised alert no operator has acknowledged yet.
+	StateNew State = iota
+	// StateAcknowledged is the state of an alert acknowledged by an operator.
+	StateAcknowledged
+	// StateCleared is the state of an alert that was cleared, whether acknowledged or not.
+	StateCleared
+)
+
+// StateOf returns the lifecycle state of alert.
+func StateOf(alert *api.Alert) State {
+	switch {
+	case alert.Cleared:
+		return StateCleared
+	case alert.Acknowledged:
+		return StateAcknowledged
+	}
+	return StateNew
+}
+
+// ID returns the id identifying alert in Ack and Clear, i.e.,
+// <resourceType>/<dec2hex(alertType)>/<resourceID>, prefixed by
+// <namespace>/ for the alerts of a namespace.
+func ID(alert *api.Alert) string {
+	parts := []string{
+		alert.Resource.String(),
+		strconv.FormatInt(alert.GetAlertType(), 16),
+		alert.ResourceId,
+	}
+	if namespace := Namespace(alert); len(namespace) != 0 {
+		parts = append([]string{namespace}, parts...)
+	}
+	return strings.Join(parts, "/")
+}
+
+func (m *manager) Ack(id string) error {
+	return m.update(id, func(alert *api.Alert) uint64 {
+		alert.Acknowledged = true
+		if alert.Cleared {
+			return m.ttl
+		}
+		return alert.Ttl
+	})
+}
+
+func (m *manager) Clear(id string) error {
+	alert, err := m.lookup(id)
+	if err != nil {
+		return err
+	}
+	alert.Cleared = true
+	if m.flapping != nil {
+		switch action, clears := m.flapping.track(alertKey(alert), alert, time.Now()); action {
+		case flapAbsorb:
+			// the stored alert stays raised as flapping
+			return nil
+		case flapStart:
+			markFlapping(alert, clears)
+			return m.put(alert, false, 1)
+		}
+		delete(alert.Payload, FlappingPayloadKey)
+	}
+	if err := m.store.Put(alert, m.ttl); err != nil {
+		return err
+	}
+	// tell the notifiers the alert is resolved
+	m.notify(alert)
+	return nil
+}
+
+// update applies change to the alert identified by id and stores it back with the
+// returned ttl.
+func (m *manager) update(id string, change func(alert *api.Alert) uint64) error {
+	alert, err := m.lookup(id)
+	if err != nil {
+		return err
+	}
+	ttl := change(alert)
+	return m.store.Put(alert, ttl)
+}
+
+// lookup returns the stored alert identified by id.
+func (m *manager) lookup(id string) (*api.Alert, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) == 4 {
+		if len(parts[0]) == 0 || checkNamespace(parts[0]) != nil {
+			return nil, invalidID
+		}
+		parts = parts[1:]
+	}
+	if len(parts) != 3 {
+		return nil, invalidID
+	}
+	if _, ok := api.ResourceType_value[parts[0]]; !ok {
+		return nil, invalidID
+	}
+	if _, err := strconv.ParseInt(parts[1], 16, 64); err != nil {
+		return nil, invalidID
+	}
+
+	q, err := queryOf(filepath.Join(kvdbKey, id))
+	if err != nil || q.Level != QueryResource {
+		return nil, invalidID
+	}
+	return m.get(q)
+}
@@ -0,0 +1,179 @@
+package alerts
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const storeNotSupported Error = "not supported by the alerts store"
+
+// QueryLevel tells how many of the fields of a Query select the alerts.
+type QueryLevel int
+
+// QueryLevel constants.
+const (
+	// QueryAll selects every alert, or those of a namespace.
+	QueryAll QueryLevel = iota
+	// QueryResourceType selects the alerts of a resource type.
+	QueryResourceType
+	// QueryAlertType selects the alerts of a resource type and alert type.
+	QueryAlertType
+	// QueryResource selects the alert of a resource type, alert type and resource id.
+	QueryResource
+)
+
+// Query selects the alerts of a store. The filters of an enumeration are
+// translated into the queries of the alerts they may match, which the store
+// translates into its own queries, such as a kvdb prefix or a SQL where
+// clause.
+type Query struct {
+	Level QueryLevel
+	// Namespace selects the alerts of a namespace, those of the default
+	// namespace if empty. A QueryAll query without namespace selects the
+	// alerts of every namespace.
+	Namespace    string
+	ResourceType api.ResourceType
+	AlertType    int64
+	ResourceID   string
+	// Terms, if any, select the alerts whose message or payload values have a
+	// word starting with every term. A store may ignore them, the alerts are
+	// filtered again by the manager.
+	Terms []string
+}
+
+// WatchFunc is called by a store on every change of the alerts it watches,
+// or with an error once the watch ends. Returning an error ends the watch.
+type WatchFunc func(action WatchAction, alert *api.Alert, err error) error
+
+// Store stores the alerts of a manager, by namespace, resource type, alert
+// type and resource id, and its silences and maintenance windows.
+type Store interface {
+	// Put stores alert, replacing the one of the same resource type, alert type
+	// and resource id, for ttl seconds, forever if zero.
+	Put(alert *api.Alert, ttl uint64) error
+	// Get returns the alert of a resource type, alert type and resource id of
+	// the default namespace, an alert not found error if none.
+	Get(resourceType api.ResourceType, alertType int64, resourceID string) (*api.Alert, error)
+	// Enumerate returns the alerts selected by q.
+	Enumerate(q Query) ([]*api.Alert, error)
+	// Delete deletes the alerts selected by q.
+	Delete(q Query) error
+	// CompareAndDelete deletes alert if it is stored unchanged and returns true
+	// if it was deleted.
+	CompareAndDelete(alert *api.Alert) (bool, error)
+	// Watch calls fn on every change of the alerts selected by q until fn
+	// returns an error.
+	Watch(q Query, fn WatchFunc) error
+	// PutSilence stores s for ttl seconds.
+	PutSilence(s *Silence, ttl uint64) error
+	// DeleteSilence deletes the silence identified by id, a silence not found
+	// error if none.
+	DeleteSilence(id string) error
+	// EnumerateSilences returns the stored silences.
+	EnumerateSilences() ([]*Silence, error)
+	// PutMaintenanceWindow stores w for ttl seconds, forever if zero.
+	PutMaintenanceWindow(w *MaintenanceWindow, ttl uint64) error
+	// DeleteMaintenanceWindow deletes the maintenance window identified by id,
+	// a maintenance window not found error if none.
+	DeleteMaintenanceWindow(id string) error
+	// EnumerateMaintenanceWindows returns the stored maintenance windows.
+	EnumerateMaintenanceWindows() ([]*MaintenanceWindow, error)
+	// PutEscalationRule stores r, replacing the rule of the same name.
+	PutEscalationRule(r *EscalationRule) error
+	// DeleteEscalationRule deletes the escalation rule named name, an
+	// escalation rule not found error if none.
+	DeleteEscalationRule(name string) error
+	// EnumerateEscalationRules returns the stored escalation rules.
+	EnumerateEscalationRules() ([]*EscalationRule, error)
+}
+
+// queryOf returns the query of the tree of alerts at key, see getKey and
+// namespaceKey.
+func queryOf(key string) (Query, error) {
+	parts := strings.SplitN(strings.Trim(key, "/"), "/", 2)
+	if parts[0] != kvdbKey {
+		return Query{}, incorrectFilterValue
+	}
+	var namespace string
+	if len(parts) > 1 {
+		// the level below the base key is a namespace unless a resource type
+		rest := strings.SplitN(parts[1], "/", 2)
+		if _, ok := api.ResourceType_value[rest[0]]; !ok {
+			namespace = rest[0]
+			parts = append([]string{kvdbKey}, rest[1:]...)
+		}
+	}
+	if len(parts) > 1 {
+		parts = append(parts[:1], strings.SplitN(parts[1], "/", 3)...)
+	}
+	q := Query{Level: QueryLevel(len(parts) - 1), Namespace: namespace}
+	if q.Level >= QueryResourceType {
+		v, ok := api.ResourceType_value[parts[1]]
+		if !ok {
+			return Query{}, incorrectFilterValue
+		}
+		q.ResourceType = api.ResourceType(v)
+	}
+	if q.Level >= QueryAlertType {
+		v, err := strconv.ParseInt(parts[2], 16, 64)
+		if err != nil {
+			return Query{}, incorrectFilterValue
+		}
+		q.AlertType = v
+	}
+	if q.Level >= QueryResource {
+		q.ResourceID = parts[3]
+	}
+	return q, nil
+}
+
+// key returns the kvdb key of the tree of alerts selected by q.
+func (q Query) key() string {
+	base := namespaceKey(q.Namespace)
+	switch q.Level {
+	case QueryResourceType:
+		return filepath.Join(base, q.ResourceType.String())
+	case QueryAlertType:
+		return filepath.Join(base, q.ResourceType.String(), strconv.FormatInt(q.AlertType, 16))
+	case QueryResource:
+		return filepath.Join(base, q.ResourceType.String(), strconv.FormatInt(q.AlertType, 16), q.ResourceID)
+	}
+	return base
+}
+
+// Matches returns true if q selects alert.
+func (q Query) Matches(alert *api.Alert) bool {
+	switch {
+	case (q.Level >= QueryResourceType || len(q.Namespace) != 0) && Namespace(alert) != q.Namespace:
+		return false
+	case q.Level >= QueryResourceType && alert.GetResource() != q.ResourceType:
+		return false
+	case q.Level >= QueryAlertType && alert.GetAlertType() != q.AlertType:
+		return false
+	case q.Level >= QueryResource && alert.GetResourceId() != q.ResourceID:
+		return false
+	case len(q.Terms) != 0 && !hasTerms(alertWords(alert), q.Terms):
+		return false
+	}
+	return true
+}
+
+// alertQuery returns the query selecting alert.
+func alertQuery(alert *api.Alert) Query {
+	return Query{
+		Level:        QueryResource,
+		Namespace:    Namespace(alert),
+		ResourceType: alert.GetResource(),
+		AlertType:    alert.GetAlertType(),
+		ResourceID:   alert.GetResourceId(),
+	}
+}
+
+// alertKey returns the key of alert, which orders the alerts of every store
+// alike.
+func alertKey(alert *api.Alert) string {
+	return alertQuery(alert).key()
+}
@@ -0,0 +1,113 @@
+package alerts
+
+import (
+	"sync"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	watchStopped Error = "watch stopped"
+	// watchBuffer is the number of events buffered for a slow consumer
+	watchBuffer = 16
+)
+
+// WatchAction defines the change of an alert delivered by Watch.
+type WatchAction int
+
+// WatchAction constants.
+const (
+	// AlertRaised is delivered when an alert is raised for the first time.
+	AlertRaised WatchAction = iota
+	// AlertUpdated is delivered when an alert is raised again.
+	AlertUpdated
+	// AlertDeleted is delivered when an alert is deleted or expires.
+	AlertDeleted
+)
+
+// WatchEvent is a change of an alert delivered by Watch.
+type WatchEvent struct {
+	// Action is the change of the alert
+	Action WatchAction
+	// Alert is the alert after the change, or before it was deleted
+	Alert *api.Alert
+}
+
+// watcher delivers the events of a watch until it is stopped.
+type watcher struct {
+	filters []Filter
+	events  chan *WatchEvent
+	done    chan struct{}
+	once    sync.Once
+	lock    sync.Mutex
+	stopped bool
+}
+
+func (m *manager) Watch(filters ...Filter) (<-chan *WatchEvent, func(), error) {
+	keys, err := getUniqueKeysFromFilters(filters...)
+	if err != nil {
+		return nil, nil, err
+	}
+	w := &watcher{
+		filters: filters,
+		events:  make(chan *WatchEvent, watchBuffer),
+		done:    make(chan struct{}),
+	}
+	for key := range keys {
+		q, err := queryOf(key)
+		if err == nil {
+			err = m.store.Watch(q, w.callback)
+		}
+		if err != nil {
+			w.stop()
+			return nil, nil, err
+		}
+	}
+	return w.events, w.stop, nil
+}
+
+// stop ends the watches of the store on their next update and closes the channel of events.
+func (w *watcher) stop() {
+	w.once.Do(func() {
+		close(w.done)
+		w.lock.Lock()
+		defer w.lock.Unlock()
+		w.stopped = true
+		close(w.events)
+	})
+}
+
+// callback delivers the change of alert if it matches the filters of the watch.
+// Returning an error ends the watch of the store.
+func (w *watcher) callback(action WatchAction, alert *api.Alert, err error) error {
+	if err != nil {
+		// the watch of the store ended, consumers learn it from the closed channel
+		w.stop()
+		return err
+	}
+	event := &WatchEvent{Action: action, Alert: alert}
+
+	match := len(w.filters) == 0
+	for _, filter := range w.filters {
+		if match, err = filter.Match(event.Alert); err != nil {
+			return nil
+		} else if match {
+			break
+		}
+	}
+	if !match {
+		return nil
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.stopped {
+		return watchStopped
+	}
+	select {
+	case w.events <- event:
+		return nil
+	case <-w.done:
+		return watchStopped
+	}
+}
@@ -0,0 +1,197 @@
+package alerts
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	// DefaultWebhookRetries is the number of times a failed delivery is retried
+	DefaultWebhookRetries = 3
+	// DefaultWebhookBackoff is the wait before the first retry, doubled on
+	// every following one
+	DefaultWebhookBackoff = time.Second
+	// DefaultWebhookTimeout bounds each delivery attempt
+	DefaultWebhookTimeout = 10 * time.Second
+
+	invalidNotifier Error = "invalid notifier"
+)
+
+// WebhookConfig configures a notifier posting the raised alerts as JSON to
+// an HTTP endpoint, such as a PagerDuty or Slack integration.
+type WebhookConfig struct {
+	// Name identifies the webhook, its URL if unset
+	Name string `yaml:"name"`
+	// URL the alerts are posted to
+	URL string `yaml:"url"`
+	// Headers are added to the requests, e.g. an Authorization header
+	Headers map[string]string `yaml:"headers"`
+	// Retries is the number of times a failed delivery is retried,
+	// DefaultWebhookRetries if unset, none if negative
+	Retries int `yaml:"retries"`
+	// Backoff is the wait before the first retry, doubled on every
+	// following one, DefaultWebhookBackoff if unset
+	Backoff time.Duration `yaml:"backoff"`
+	// Timeout bounds each delivery attempt, DefaultWebhookTimeout if unset
+	Timeout time.Duration `yaml:"timeout"`
+	// ResourceTypes forwards only the alerts of these resource types, such
+	// as RESOURCE_TYPE_VOLUME or volume, all if empty
+	ResourceTypes []string `yaml:"resource_types"`
+	// AlertTypes forwards only the alerts of these alert types, all if empty
+	AlertTypes []int64 `yaml:"alert_types"`
+	// MinSeverity forwards only the alerts at least this severe, such as
+	// SEVERITY_TYPE_ALARM or alarm, all if unset
+	MinSeverity string `yaml:"min_severity"`
+}
+
+// Filters returns the filters matching the alerts forwarded by the webhook,
+// none if all are.
+func (c *WebhookConfig) Filters() ([]Filter, error) {
+	return selectFilters(c.ResourceTypes, c.AlertTypes, c.MinSeverity)
+}
+
+// selectFilters returns the filters matching the alerts of one of
+// resourceTypes and one of alertTypes, at least as severe as minSeverity,
+// none if all alerts match.
+func selectFilters(resourceTypes []string, alertTypes []int64, minSeverity string) ([]Filter, error) {
+	var all []Filter
+	if len(resourceTypes) != 0 {
+		var any []Filter
+		for _, name := range resourceTypes {
+			v, ok := enumValue(api.ResourceType_value, "RESOURCE_TYPE_", name)
+			if !ok {
+				return nil, invalidNotifier.Tag(Error("resource type " + name))
+			}
+			any = append(any, NewResourceTypeFilter(api.ResourceType(v)))
+		}
+		all = append(all, NewOrFilter(any...))
+	}
+	if len(alertTypes) != 0 {
+		var any []Filter
+		for _, alertType := range alertTypes {
+			any = append(any, NewMatchAlertTypeFilter(alertType))
+		}
+		all = append(all, NewOrFilter(any...))
+	}
+	if len(minSeverity) != 0 {
+		v, ok := enumValue(api.SeverityType_value, "SEVERITY_TYPE_", minSeverity)
+		if !ok {
+			return nil, invalidNotifier.Tag(Error("severity " + minSeverity))
+		}
+		all = append(all, NewMinSeverityFilter(api.SeverityType(v)))
+	}
+	if len(all) == 0 {
+		return nil, nil
+	}
+	return []Filter{NewAndFilter(all...)}, nil
+}
+
+// enumValue returns the value of the enum named name, with or without
+// prefix, in any case.
+func enumValue(values map[string]int32, prefix, name string) (int32, bool) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, prefix) {
+		name = prefix + name
+	}
+	v, ok := values[name]
+	return v, ok
+}
+
+type webhook struct {
+	name    string
+	url     string
+	headers map[string]string
+	retries int
+	backoff time.Duration
+	client  *http.Client
+}
+
+// NewWebhookNotifier provides a notifier posting the alerts as JSON to the
+// URL of c. Failed deliveries, on network errors, 5xx and 429 responses,
+// are retried with an exponential backoff. The filters of the notifier are
+// given by c.Filters.
+func NewWebhookNotifier(c *WebhookConfig) (Notifier, error) {
+	return newWebhook(c)
+}
+
+func newWebhook(c *WebhookConfig) (*webhook, error) {
+	if len(c.URL) == 0 {
+		return nil, invalidNotifier.Tag("missing url")
+	}
+	w := &webhook{
+		name:    c.Name,
+		url:     c.URL,
+		headers: c.Headers,
+		retries: c.Retries,
+		backoff: c.Backoff,
+		client:  &http.Client{Timeout: c.Timeout},
+	}
+	if len(w.name) == 0 {
+		w.name = c.URL
+	}
+	if w.retries == 0 {
+		w.retries = DefaultWebhookRetries
+	} else if w.retries < 0 {
+		w.retries = 0
+	}
+	if w.backoff == 0 {
+		w.backoff = DefaultWebhookBackoff
+	}
+	if w.client.Timeout == 0 {
+		w.client.Timeout = DefaultWebhookTimeout
+	}
+	return w, nil
+}
+
+func (w *webhook) Name() string {
+	return w.name
+}
+
+func (w *webhook) Notify(alert *api.Alert) error {
+	var body bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&body, alert); err != nil {
+		return err
+	}
+	return w.deliver(body.Bytes())
+}
+
+// deliver posts body, retrying with an exponential backoff.
+func (w *webhook) deliver(body []byte) error {
+	backoff := w.backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := w.post(body)
+		if err == nil || !retry || attempt == w.retries {
+			return err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// post posts body once and returns whether a failure is worth a retry.
+func (w *webhook) post(body []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		return false, nil
+	}
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("webhook answered %s", resp.Status)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 	"math"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -56,6 +57,53 @@ const (
 	// the VolumeSpec.force_unsupported_fs_type. When set to true it asks
 	// the driver to use an unsupported value of VolumeSpec.format if possible
 	SpecForceUnsupportedFsType = "force_unsupported_fs_type"
+	// SpecCipher is the dm-crypt cipher used for an encrypted volume
+	SpecCipher = "cipher"
+	// SpecKDF is the key derivation function used for the volume passphrase
+	SpecKDF = "kdf"
+	// SpecSecureDelete is the method used to wipe the backing storage of a
+	// volume when it is deleted, one of the SecureDelete* values
+	SpecSecureDelete = "secure_delete"
+)
+
+// Well known volume labels
+const (
+	// LabelOwner identifies the owner of a volume
+	LabelOwner = "owner"
+	// LabelTenant identifies the tenant a volume belongs to
+	LabelTenant = "tenant"
+	// LabelNamespace identifies the namespace a volume belongs to
+	LabelNamespace = "namespace"
+	// LabelLineage lists the ids of the ancestors of a snapshot or a clone,
+	// parent first, comma separated
+	LabelLineage = "lineage"
+	// LabelManagers records the manager of each label set by a label patch,
+	// as a JSON object of the label keys to their managers
+	LabelManagers = "label_managers"
+	// LabelOrphanedSince is set on a snapshot whose parent volume was deleted
+	// to the time the deletion was found, in RFC 3339 format
+	LabelOrphanedSince = "orphaned_since"
+	// LabelPool is the id of the storage pool a volume is provisioned from,
+	// pool 0 if not set
+	LabelPool = "pool"
+)
+
+// Well known node labels
+const (
+	// NodeLabelZone identifies the failure zone of a node
+	NodeLabelZone = "zone"
+	// NodeLabelRegion identifies the region of a node
+	NodeLabelRegion = "region"
+)
+
+// Secure delete methods
+const (
+	// SecureDeleteOverwrite overwrites the backing storage with zeros
+	SecureDeleteOverwrite = "overwrite"
+	// SecureDeleteDiscard discards all blocks of the backing device
+	SecureDeleteDiscard = "discard"
+	// SecureDeleteCryptoErase destroys the encryption key of the volume
+	SecureDeleteCryptoErase = "crypto-erase"
 )
 
 // OptionKey specifies a set of recognized query params.
@@ -76,6 +124,8 @@ const (
 	OptTimeoutSec = "TimeoutSec"
 	// OptQuiesceID query parameter use for quiesce
 	OptQuiesceID = "QuiesceID"
+	// OptNewSize query parameter used to resize a volume, in bytes
+	OptNewSize = "NewSize"
 	// OptCredUUID is the UUID of the credential
 	OptCredUUID = "CredUUID"
 	// OptCredName indicates unique name of credential
@@ -84,6 +134,8 @@ const (
 	OptCredType = "CredType"
 	// OptCredEncrKey is the key used to encrypt data
 	OptCredEncrKey = "CredEncrypt"
+	// OptCredEncrCipher is the cipher used to encrypt data
+	OptCredEncrCipher = "CredEncryptCipher"
 	// OptCredRegion indicates the region for s3
 	OptCredRegion = "CredRegion"
 	// OptCredDisableSSL indicated if SSL should be disabled
@@ -119,6 +171,10 @@ const (
 	OptCatalogSubFolder = "subfolder"
 	// OptCatalogMaxDepth query parameter used to limit the depth we return
 	OptCatalogMaxDepth = "depth"
+	// OptCostGroupBy query parameter used to group a cost report
+	OptCostGroupBy = "groupby"
+	// OptCostFormat query parameter used to request a cost report as csv
+	OptCostFormat = "format"
 )
 
 // Api clientserver Constants
@@ -131,6 +187,9 @@ const (
 	OsdMigrateStartPath  = OsdMigratePath + "/start"
 	OsdMigrateCancelPath = OsdMigratePath + "/cancel"
 	OsdMigrateStatusPath = OsdMigratePath + "/status"
+	OsdJobsPath          = "osd-jobs"
+	OsdCostsPath         = "costs"
+	OsdGrafanaPath       = "osd-grafana"
 	TimeLayout           = "Jan 2 15:04:05 UTC 2006"
 )
 
@@ -182,6 +241,76 @@ type Node struct {
 	NodeData map[string]interface{}
 	// User defined labels for node. Key Value pairs
 	NodeLabels map[string]string
+	// MaxVolumeAttachments is the maximum number of volumes attached to the
+	// node, unlimited if zero
+	MaxVolumeAttachments int64
+	// VolumeAttachments is the number of volumes attached to the node
+	VolumeAttachments int64
+}
+
+// WipeCertificate describes a completed wipe of the backing storage of a
+// volume.
+//
+// swagger:model
+type WipeCertificate struct {
+	// VolumeId of the wiped volume
+	VolumeId string
+	// Method used for the wipe, one of the SecureDelete* values
+	Method string
+	// BytesWiped is the number of bytes overwritten or discarded
+	BytesWiped uint64
+	// StartTime is when the wipe started
+	StartTime time.Time
+	// EndTime is when the wipe completed
+	EndTime time.Time
+}
+
+// DriverStatusState is the state of a component of a volume driver.
+type DriverStatusState string
+
+const (
+	// DriverStatusUp is a component working as expected
+	DriverStatusUp DriverStatusState = "up"
+	// DriverStatusDegraded is a component working with reduced capabilities
+	DriverStatusDegraded DriverStatusState = "degraded"
+	// DriverStatusDown is a component not working
+	DriverStatusDown DriverStatusState = "down"
+	// DriverStatusUnknown is a component whose state the driver does not
+	// report, such as those of the key-value pairs of Status
+	DriverStatusUnknown DriverStatusState = "unknown"
+)
+
+// DriverStatus is the status of a component of a volume driver.
+//
+// swagger:model
+type DriverStatus struct {
+	// Component is the name of the component, such as a pool or a check
+	Component string
+	// State of the component
+	State DriverStatusState
+	// Details describes the state of the component
+	Details string
+	// Since is when the component entered its state, zero if unknown
+	Since time.Time
+}
+
+// PoolExpandRequest adds a device to a storage pool.
+//
+// swagger:model
+type PoolExpandRequest struct {
+	// Device is the path of the block device to add to the pool
+	Device string
+	// Rebalance moves existing data onto the new device, if the driver
+	// supports it
+	Rebalance bool
+}
+
+// Validate checks that the request names a device.
+func (r *PoolExpandRequest) Validate() error {
+	if len(r.Device) == 0 || !path.IsAbs(r.Device) {
+		return fmt.Errorf("Device must be an absolute path, got %q", r.Device)
+	}
+	return nil
 }
 
 // FluentDConfig describes ip and port of a fluentdhost.
@@ -272,6 +401,9 @@ type CloudBackupCreateRequest struct {
 	// Labels are list of key value pairs to tag the cloud backup. These labels
 	// are stored in the metadata associated with the backup.
 	Labels map[string]string
+	// SourceNodeID is the optional node whose replica the backup reads from.
+	// If not specified it is set to the replica nearest the data mover.
+	SourceNodeID string
 }
 
 type CloudBackupCreateResponse struct {
@@ -306,6 +438,9 @@ type CloudBackupRestoreRequest struct {
 	// Name is optional unique id to be used for this restore op
 	// restore creates this by default
 	Name string
+	// Passphrase is the passphrase of the volume backed up, for the drivers
+	// which do not store it with the backups
+	Passphrase string
 }
 
 type CloudBackupRestoreResponse struct {
@@ -427,6 +562,8 @@ type CloudBackupStatus struct {
 	Info []string
 	// CredentialUUID used for this backup/restore op
 	CredentialUUID string
+	// SourceNodeID is the node whose replica a backup reads from
+	SourceNodeID string
 }
 
 type CloudBackupStatusResponse struct {
@@ -759,6 +896,18 @@ func (v Volume) DisplayId() string {
 	}
 }
 
+// AttachSlots returns the number of volumes that can still be attached to
+// the node, -1 if unlimited.
+func (s *Node) AttachSlots() int64 {
+	if s.MaxVolumeAttachments == 0 {
+		return -1
+	}
+	if s.VolumeAttachments >= s.MaxVolumeAttachments {
+		return 0
+	}
+	return s.MaxVolumeAttachments - s.VolumeAttachments
+}
+
 // ToStorageNode converts a Node structure to an exported gRPC StorageNode struct
 func (s *Node) ToStorageNode() *StorageNode {
 	node := &StorageNode{
@@ -577,6 +577,36 @@ func (OperationFlags) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_api_7d6ac3825e3482d9, []int{15}
 }
 
+// SdkAlertsWatchAction is the change of an alert delivered by Watch.
+type SdkAlertsWatchAction int32
+
+const (
+	// Alert raised for the first time
+	SdkAlertsWatchAction_SdkAlertsWatchActionRaised SdkAlertsWatchAction = 0
+	// Alert raised again
+	SdkAlertsWatchAction_SdkAlertsWatchActionUpdated SdkAlertsWatchAction = 1
+	// Alert deleted or expired
+	SdkAlertsWatchAction_SdkAlertsWatchActionDeleted SdkAlertsWatchAction = 2
+)
+
+var SdkAlertsWatchAction_name = map[int32]string{
+	0: "SdkAlertsWatchActionRaised",
+	1: "SdkAlertsWatchActionUpdated",
+	2: "SdkAlertsWatchActionDeleted",
+}
+var SdkAlertsWatchAction_value = map[string]int32{
+	"SdkAlertsWatchActionRaised":  0,
+	"SdkAlertsWatchActionUpdated": 1,
+	"SdkAlertsWatchActionDeleted": 2,
+}
+
+func (x SdkAlertsWatchAction) String() string {
+	return proto.EnumName(SdkAlertsWatchAction_name, int32(x))
+}
+func (SdkAlertsWatchAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{16}
+}
+
 // Defines times of day
 type SdkTimeWeekday int32
 
@@ -620,7 +650,7 @@ func (x SdkTimeWeekday) String() string {
 	return proto.EnumName(SdkTimeWeekday_name, int32(x))
 }
 func (SdkTimeWeekday) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{16}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{17}
 }
 
 // CloudBackup operations types
@@ -650,7 +680,7 @@ func (x SdkCloudBackupOpType) String() string {
 	return proto.EnumName(SdkCloudBackupOpType_name, int32(x))
 }
 func (SdkCloudBackupOpType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{17}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{18}
 }
 
 // CloudBackup status types
@@ -700,7 +730,7 @@ func (x SdkCloudBackupStatusType) String() string {
 	return proto.EnumName(SdkCloudBackupStatusType_name, int32(x))
 }
 func (SdkCloudBackupStatusType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{18}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{19}
 }
 
 // SdkCloudBackupRequestedState defines states to set a specified backup or restore
@@ -735,7 +765,7 @@ func (x SdkCloudBackupRequestedState) String() string {
 	return proto.EnumName(SdkCloudBackupRequestedState_name, int32(x))
 }
 func (SdkCloudBackupRequestedState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{19}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{20}
 }
 
 type SdkServiceCapability_OpenStorageService_Type int32
@@ -792,7 +822,7 @@ func (x SdkServiceCapability_OpenStorageService_Type) String() string {
 	return proto.EnumName(SdkServiceCapability_OpenStorageService_Type_name, int32(x))
 }
 func (SdkServiceCapability_OpenStorageService_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{161, 0, 0}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{165, 0, 0}
 }
 
 // These values are constants that can be used by the
@@ -827,7 +857,7 @@ func (x SdkVersion_Version) String() string {
 	return proto.EnumName(SdkVersion_Version_name, int32(x))
 }
 func (SdkVersion_Version) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{162, 0}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{166, 0}
 }
 
 type CloudMigrate_OperationType int32
@@ -859,7 +889,7 @@ func (x CloudMigrate_OperationType) String() string {
 	return proto.EnumName(CloudMigrate_OperationType_name, int32(x))
 }
 func (CloudMigrate_OperationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{164, 0}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{168, 0}
 }
 
 type CloudMigrate_Stage int32
@@ -891,7 +921,7 @@ func (x CloudMigrate_Stage) String() string {
 	return proto.EnumName(CloudMigrate_Stage_name, int32(x))
 }
 func (CloudMigrate_Stage) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{164, 1}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{168, 1}
 }
 
 type CloudMigrate_Status int32
@@ -929,7 +959,7 @@ func (x CloudMigrate_Status) String() string {
 	return proto.EnumName(CloudMigrate_Status_name, int32(x))
 }
 func (CloudMigrate_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{164, 2}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{168, 2}
 }
 
 // Defines the types of enforcement on the given rules
@@ -955,7 +985,7 @@ func (x VolumePlacementRule_EnforcementType) String() string {
 	return proto.EnumName(VolumePlacementRule_EnforcementType_name, int32(x))
 }
 func (VolumePlacementRule_EnforcementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{205, 0}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{209, 0}
 }
 
 // This specifies the type an affinity rule can take
@@ -981,7 +1011,7 @@ func (x VolumePlacementRule_AffinityRuleType) String() string {
 	return proto.EnumName(VolumePlacementRule_AffinityRuleType_name, int32(x))
 }
 func (VolumePlacementRule_AffinityRuleType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{205, 1}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{209, 1}
 }
 
 // This defines operator types used in a label matching rule
@@ -1023,7 +1053,7 @@ func (x LabelSelectorRequirement_Operator) String() string {
 	return proto.EnumName(LabelSelectorRequirement_Operator_name, int32(x))
 }
 func (LabelSelectorRequirement_Operator) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{206, 0}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{210, 0}
 }
 
 // StorageResource groups properties of a storage device.
@@ -2767,7 +2797,10 @@ type Volume struct {
 	// VolumeConsumers are entities that consume this volume
 	VolumeConsumers []*VolumeConsumer `protobuf:"bytes,22,rep,name=volume_consumers,json=volumeConsumers" json:"volume_consumers,omitempty"`
 	// FsResizeRequired if an FS resize is required on the volume.
-	FsResizeRequired     bool     `protobuf:"varint,23,opt,name=fs_resize_required,json=fsResizeRequired" json:"fs_resize_required,omitempty"`
+	FsResizeRequired bool `protobuf:"varint,23,opt,name=fs_resize_required,json=fsResizeRequired" json:"fs_resize_required,omitempty"`
+	// FsUuid is the UUID of the filesystem created when the volume was formatted,
+	// verified before the volume is mounted.
+	FsUuid               string   `protobuf:"bytes,24,opt,name=fs_uuid,json=fsUuid" json:"fs_uuid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2958,6 +2991,13 @@ func (m *Volume) GetFsResizeRequired() bool {
 	return false
 }
 
+func (m *Volume) GetFsUuid() string {
+	if m != nil {
+		return m.FsUuid
+	}
+	return ""
+}
+
 // Stats is a structure that represents last collected stats for a volume
 type Stats struct {
 	// Reads completed successfully
@@ -3166,10 +3206,21 @@ type Alert struct {
 	// Count of such alerts raised so far.
 	Count int64 `protobuf:"varint,11,opt,name=count" json:"count,omitempty"`
 	// Timestamp when such alert was raised the very first time.
-	FirstSeen            *timestamp.Timestamp `protobuf:"bytes,12,opt,name=first_seen,json=firstSeen" json:"first_seen,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	FirstSeen *timestamp.Timestamp `protobuf:"bytes,12,opt,name=first_seen,json=firstSeen" json:"first_seen,omitempty"`
+	// Acknowledged is set once an operator has seen the alert
+	Acknowledged bool `protobuf:"varint,13,opt,name=acknowledged" json:"acknowledged,omitempty"`
+	// ReasonCode is the stable, machine readable code of the alert type,
+	// such as KVDB_QUORUM_LOST, while the message may change
+	ReasonCode string `protobuf:"bytes,14,opt,name=reason_code,json=reasonCode" json:"reason_code,omitempty"`
+	// Payload carries the machine readable context of the alert, such as
+	// the device path or the error counters, bounded in size
+	Payload map[string]string `protobuf:"bytes,15,rep,name=payload" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Annotations are the key/values attached by the operators to the
+	// alert, such as a ticket number or a triage owner
+	Annotations          map[string]string `protobuf:"bytes,16,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Alert) Reset()         { *m = Alert{} }
@@ -3280,11 +3331,39 @@ func (m *Alert) GetFirstSeen() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Alert) GetAcknowledged() bool {
+	if m != nil {
+		return m.Acknowledged
+	}
+	return false
+}
+
+func (m *Alert) GetReasonCode() string {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return ""
+}
+
+func (m *Alert) GetPayload() map[string]string {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *Alert) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
 // SdkAlertsTimeSpan to store time window information.
 type SdkAlertsTimeSpan struct {
-	// Start timestamp when Alert occured
+	// Start timestamp when Alert occured, unbounded if unset
 	StartTime *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
-	// End timestamp when Alert occured
+	// End timestamp when Alert occured, unbounded if unset
 	EndTime              *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime" json:"end_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
@@ -3923,10 +4002,20 @@ type SdkAlertsEnumerateRequest struct {
 	// and returned as output of an RPC call.
 	// In that sense alerts are fetched if they match any of the
 	// queries.
-	Queries              []*SdkAlertsQuery `protobuf:"bytes,1,rep,name=queries" json:"queries,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Queries []*SdkAlertsQuery `protobuf:"bytes,1,rep,name=queries" json:"queries,omitempty"`
+	// Search, if set, narrows the alerts matching the queries down to those
+	// whose message or payload values have a word starting with every word
+	// of search, case insensitively. All the alerts are searched if there
+	// are no queries.
+	Search string `protobuf:"bytes,2,opt,name=search" json:"search,omitempty"`
+	// Expression, if set, narrows the alerts matching the queries down to
+	// those for which it is true, such as
+	// "alert.Count > 5 && alert.Resource == 'VOLUME'". All the alerts are
+	// matched if there are no queries.
+	Expression           string   `protobuf:"bytes,3,opt,name=expression" json:"expression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SdkAlertsEnumerateRequest) Reset()         { *m = SdkAlertsEnumerateRequest{} }
@@ -3960,6 +4049,20 @@ func (m *SdkAlertsEnumerateRequest) GetQueries() []*SdkAlertsQuery {
 	return nil
 }
 
+func (m *SdkAlertsEnumerateRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *SdkAlertsEnumerateRequest) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
 // SdkAlertsEnumerateResponse is a list of alerts.
 type SdkAlertsEnumerateResponse struct {
 	// Response contains a list of alerts.
@@ -4072,6 +4175,169 @@ func (m *SdkAlertsDeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SdkAlertsDeleteResponse proto.InternalMessageInfo
 
+// SdkAlertsRaiseRequest is a request message to raise an alert.
+type SdkAlertsRaiseRequest struct {
+	// Alert to raise, identified by its resource type, alert type and
+	// resource id. The alert is deduplicated with the stored alert if any.
+	Alert                *Alert   `protobuf:"bytes,1,opt,name=alert" json:"alert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkAlertsRaiseRequest) Reset()         { *m = SdkAlertsRaiseRequest{} }
+func (m *SdkAlertsRaiseRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkAlertsRaiseRequest) ProtoMessage()    {}
+func (*SdkAlertsRaiseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{25}
+}
+func (m *SdkAlertsRaiseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkAlertsRaiseRequest.Unmarshal(m, b)
+}
+func (m *SdkAlertsRaiseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkAlertsRaiseRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkAlertsRaiseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkAlertsRaiseRequest.Merge(dst, src)
+}
+func (m *SdkAlertsRaiseRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkAlertsRaiseRequest.Size(m)
+}
+func (m *SdkAlertsRaiseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkAlertsRaiseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkAlertsRaiseRequest proto.InternalMessageInfo
+
+func (m *SdkAlertsRaiseRequest) GetAlert() *Alert {
+	if m != nil {
+		return m.Alert
+	}
+	return nil
+}
+
+// SdkAlertsRaiseResponse is empty.
+type SdkAlertsRaiseResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkAlertsRaiseResponse) Reset()         { *m = SdkAlertsRaiseResponse{} }
+func (m *SdkAlertsRaiseResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkAlertsRaiseResponse) ProtoMessage()    {}
+func (*SdkAlertsRaiseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{26}
+}
+func (m *SdkAlertsRaiseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkAlertsRaiseResponse.Unmarshal(m, b)
+}
+func (m *SdkAlertsRaiseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkAlertsRaiseResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkAlertsRaiseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkAlertsRaiseResponse.Merge(dst, src)
+}
+func (m *SdkAlertsRaiseResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkAlertsRaiseResponse.Size(m)
+}
+func (m *SdkAlertsRaiseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkAlertsRaiseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkAlertsRaiseResponse proto.InternalMessageInfo
+
+// SdkAlertsWatchRequest is a request message to watch alerts.
+type SdkAlertsWatchRequest struct {
+	// It takes a list of queries to find matching alerts, the changes of
+	// the alerts matching any of the queries are delivered. The changes of
+	// all the alerts are delivered if there are no queries.
+	Queries              []*SdkAlertsQuery `protobuf:"bytes,1,rep,name=queries" json:"queries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SdkAlertsWatchRequest) Reset()         { *m = SdkAlertsWatchRequest{} }
+func (m *SdkAlertsWatchRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkAlertsWatchRequest) ProtoMessage()    {}
+func (*SdkAlertsWatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{27}
+}
+func (m *SdkAlertsWatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkAlertsWatchRequest.Unmarshal(m, b)
+}
+func (m *SdkAlertsWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkAlertsWatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkAlertsWatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkAlertsWatchRequest.Merge(dst, src)
+}
+func (m *SdkAlertsWatchRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkAlertsWatchRequest.Size(m)
+}
+func (m *SdkAlertsWatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkAlertsWatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkAlertsWatchRequest proto.InternalMessageInfo
+
+func (m *SdkAlertsWatchRequest) GetQueries() []*SdkAlertsQuery {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+// SdkAlertsWatchResponse is a change of an alert.
+type SdkAlertsWatchResponse struct {
+	// Change of the alert
+	Action SdkAlertsWatchAction `protobuf:"varint,1,opt,name=action,enum=openstorage.api.SdkAlertsWatchAction" json:"action,omitempty"`
+	// Alert after the change, or before it was deleted
+	Alert                *Alert   `protobuf:"bytes,2,opt,name=alert" json:"alert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkAlertsWatchResponse) Reset()         { *m = SdkAlertsWatchResponse{} }
+func (m *SdkAlertsWatchResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkAlertsWatchResponse) ProtoMessage()    {}
+func (*SdkAlertsWatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{28}
+}
+func (m *SdkAlertsWatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkAlertsWatchResponse.Unmarshal(m, b)
+}
+func (m *SdkAlertsWatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkAlertsWatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkAlertsWatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkAlertsWatchResponse.Merge(dst, src)
+}
+func (m *SdkAlertsWatchResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkAlertsWatchResponse.Size(m)
+}
+func (m *SdkAlertsWatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkAlertsWatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkAlertsWatchResponse proto.InternalMessageInfo
+
+func (m *SdkAlertsWatchResponse) GetAction() SdkAlertsWatchAction {
+	if m != nil {
+		return m.Action
+	}
+	return SdkAlertsWatchAction_SdkAlertsWatchActionRaised
+}
+
+func (m *SdkAlertsWatchResponse) GetAlert() *Alert {
+	if m != nil {
+		return m.Alert
+	}
+	return nil
+}
+
 // Define a schedule policy request
 type SdkSchedulePolicyCreateRequest struct {
 	// Schedule Policy
@@ -4085,7 +4351,7 @@ func (m *SdkSchedulePolicyCreateRequest) Reset()         { *m = SdkSchedulePolic
 func (m *SdkSchedulePolicyCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyCreateRequest) ProtoMessage()    {}
 func (*SdkSchedulePolicyCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{25}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{29}
 }
 func (m *SdkSchedulePolicyCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyCreateRequest.Unmarshal(m, b)
@@ -4125,7 +4391,7 @@ func (m *Alerts) Reset()         { *m = Alerts{} }
 func (m *Alerts) String() string { return proto.CompactTextString(m) }
 func (*Alerts) ProtoMessage()    {}
 func (*Alerts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{26}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{30}
 }
 func (m *Alerts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Alerts.Unmarshal(m, b)
@@ -4186,7 +4452,7 @@ func (m *ObjectstoreInfo) Reset()         { *m = ObjectstoreInfo{} }
 func (m *ObjectstoreInfo) String() string { return proto.CompactTextString(m) }
 func (*ObjectstoreInfo) ProtoMessage()    {}
 func (*ObjectstoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{27}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{31}
 }
 func (m *ObjectstoreInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectstoreInfo.Unmarshal(m, b)
@@ -4302,7 +4568,7 @@ func (m *VolumeCreateRequest) Reset()         { *m = VolumeCreateRequest{} }
 func (m *VolumeCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*VolumeCreateRequest) ProtoMessage()    {}
 func (*VolumeCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{28}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{32}
 }
 func (m *VolumeCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeCreateRequest.Unmarshal(m, b)
@@ -4360,7 +4626,7 @@ func (m *VolumeResponse) Reset()         { *m = VolumeResponse{} }
 func (m *VolumeResponse) String() string { return proto.CompactTextString(m) }
 func (*VolumeResponse) ProtoMessage()    {}
 func (*VolumeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{29}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{33}
 }
 func (m *VolumeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeResponse.Unmarshal(m, b)
@@ -4409,7 +4675,7 @@ func (m *VolumeCreateResponse) Reset()         { *m = VolumeCreateResponse{} }
 func (m *VolumeCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*VolumeCreateResponse) ProtoMessage()    {}
 func (*VolumeCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{30}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{34}
 }
 func (m *VolumeCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeCreateResponse.Unmarshal(m, b)
@@ -4463,7 +4729,7 @@ func (m *VolumeStateAction) Reset()         { *m = VolumeStateAction{} }
 func (m *VolumeStateAction) String() string { return proto.CompactTextString(m) }
 func (*VolumeStateAction) ProtoMessage()    {}
 func (*VolumeStateAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{31}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{35}
 }
 func (m *VolumeStateAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeStateAction.Unmarshal(m, b)
@@ -4532,7 +4798,7 @@ func (m *VolumeSetRequest) Reset()         { *m = VolumeSetRequest{} }
 func (m *VolumeSetRequest) String() string { return proto.CompactTextString(m) }
 func (*VolumeSetRequest) ProtoMessage()    {}
 func (*VolumeSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{32}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{36}
 }
 func (m *VolumeSetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeSetRequest.Unmarshal(m, b)
@@ -4602,7 +4868,7 @@ func (m *VolumeSetResponse) Reset()         { *m = VolumeSetResponse{} }
 func (m *VolumeSetResponse) String() string { return proto.CompactTextString(m) }
 func (*VolumeSetResponse) ProtoMessage()    {}
 func (*VolumeSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{33}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{37}
 }
 func (m *VolumeSetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeSetResponse.Unmarshal(m, b)
@@ -4654,7 +4920,7 @@ func (m *SnapCreateRequest) Reset()         { *m = SnapCreateRequest{} }
 func (m *SnapCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SnapCreateRequest) ProtoMessage()    {}
 func (*SnapCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{34}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{38}
 }
 func (m *SnapCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SnapCreateRequest.Unmarshal(m, b)
@@ -4719,7 +4985,7 @@ func (m *SnapCreateResponse) Reset()         { *m = SnapCreateResponse{} }
 func (m *SnapCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SnapCreateResponse) ProtoMessage()    {}
 func (*SnapCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{35}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{39}
 }
 func (m *SnapCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SnapCreateResponse.Unmarshal(m, b)
@@ -4761,7 +5027,7 @@ func (m *VolumeInfo) Reset()         { *m = VolumeInfo{} }
 func (m *VolumeInfo) String() string { return proto.CompactTextString(m) }
 func (*VolumeInfo) ProtoMessage()    {}
 func (*VolumeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{36}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{40}
 }
 func (m *VolumeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeInfo.Unmarshal(m, b)
@@ -4833,7 +5099,7 @@ func (m *VolumeConsumer) Reset()         { *m = VolumeConsumer{} }
 func (m *VolumeConsumer) String() string { return proto.CompactTextString(m) }
 func (*VolumeConsumer) ProtoMessage()    {}
 func (*VolumeConsumer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{37}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{41}
 }
 func (m *VolumeConsumer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeConsumer.Unmarshal(m, b)
@@ -4912,7 +5178,7 @@ func (m *GraphDriverChanges) Reset()         { *m = GraphDriverChanges{} }
 func (m *GraphDriverChanges) String() string { return proto.CompactTextString(m) }
 func (*GraphDriverChanges) ProtoMessage()    {}
 func (*GraphDriverChanges) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{38}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{42}
 }
 func (m *GraphDriverChanges) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphDriverChanges.Unmarshal(m, b)
@@ -4962,7 +5228,7 @@ func (m *ClusterResponse) Reset()         { *m = ClusterResponse{} }
 func (m *ClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*ClusterResponse) ProtoMessage()    {}
 func (*ClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{39}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{43}
 }
 func (m *ClusterResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterResponse.Unmarshal(m, b)
@@ -5002,7 +5268,7 @@ func (m *ActiveRequest) Reset()         { *m = ActiveRequest{} }
 func (m *ActiveRequest) String() string { return proto.CompactTextString(m) }
 func (*ActiveRequest) ProtoMessage()    {}
 func (*ActiveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{40}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{44}
 }
 func (m *ActiveRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ActiveRequest.Unmarshal(m, b)
@@ -5043,7 +5309,7 @@ func (m *ActiveRequests) Reset()         { *m = ActiveRequests{} }
 func (m *ActiveRequests) String() string { return proto.CompactTextString(m) }
 func (*ActiveRequests) ProtoMessage()    {}
 func (*ActiveRequests) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{41}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{45}
 }
 func (m *ActiveRequests) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ActiveRequests.Unmarshal(m, b)
@@ -5091,7 +5357,7 @@ func (m *GroupSnapCreateRequest) Reset()         { *m = GroupSnapCreateRequest{}
 func (m *GroupSnapCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*GroupSnapCreateRequest) ProtoMessage()    {}
 func (*GroupSnapCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{42}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{46}
 }
 func (m *GroupSnapCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupSnapCreateRequest.Unmarshal(m, b)
@@ -5147,7 +5413,7 @@ func (m *GroupSnapCreateResponse) Reset()         { *m = GroupSnapCreateResponse
 func (m *GroupSnapCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*GroupSnapCreateResponse) ProtoMessage()    {}
 func (*GroupSnapCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{43}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{47}
 }
 func (m *GroupSnapCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupSnapCreateResponse.Unmarshal(m, b)
@@ -5221,7 +5487,7 @@ func (m *StorageNode) Reset()         { *m = StorageNode{} }
 func (m *StorageNode) String() string { return proto.CompactTextString(m) }
 func (*StorageNode) ProtoMessage()    {}
 func (*StorageNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{44}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{48}
 }
 func (m *StorageNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageNode.Unmarshal(m, b)
@@ -5356,7 +5622,7 @@ func (m *StorageCluster) Reset()         { *m = StorageCluster{} }
 func (m *StorageCluster) String() string { return proto.CompactTextString(m) }
 func (*StorageCluster) ProtoMessage()    {}
 func (*StorageCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{45}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{49}
 }
 func (m *StorageCluster) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageCluster.Unmarshal(m, b)
@@ -5408,7 +5674,7 @@ func (m *SdkSchedulePolicyCreateResponse) Reset()         { *m = SdkSchedulePoli
 func (m *SdkSchedulePolicyCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyCreateResponse) ProtoMessage()    {}
 func (*SdkSchedulePolicyCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{46}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{50}
 }
 func (m *SdkSchedulePolicyCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyCreateResponse.Unmarshal(m, b)
@@ -5441,7 +5707,7 @@ func (m *SdkSchedulePolicyUpdateRequest) Reset()         { *m = SdkSchedulePolic
 func (m *SdkSchedulePolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyUpdateRequest) ProtoMessage()    {}
 func (*SdkSchedulePolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{47}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{51}
 }
 func (m *SdkSchedulePolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyUpdateRequest.Unmarshal(m, b)
@@ -5479,7 +5745,7 @@ func (m *SdkSchedulePolicyUpdateResponse) Reset()         { *m = SdkSchedulePoli
 func (m *SdkSchedulePolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyUpdateResponse) ProtoMessage()    {}
 func (*SdkSchedulePolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{48}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{52}
 }
 func (m *SdkSchedulePolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyUpdateResponse.Unmarshal(m, b)
@@ -5510,7 +5776,7 @@ func (m *SdkSchedulePolicyEnumerateRequest) Reset()         { *m = SdkSchedulePo
 func (m *SdkSchedulePolicyEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyEnumerateRequest) ProtoMessage()    {}
 func (*SdkSchedulePolicyEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{49}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{53}
 }
 func (m *SdkSchedulePolicyEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyEnumerateRequest.Unmarshal(m, b)
@@ -5543,7 +5809,7 @@ func (m *SdkSchedulePolicyEnumerateResponse) Reset()         { *m = SdkScheduleP
 func (m *SdkSchedulePolicyEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyEnumerateResponse) ProtoMessage()    {}
 func (*SdkSchedulePolicyEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{50}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{54}
 }
 func (m *SdkSchedulePolicyEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyEnumerateResponse.Unmarshal(m, b)
@@ -5583,7 +5849,7 @@ func (m *SdkSchedulePolicyInspectRequest) Reset()         { *m = SdkSchedulePoli
 func (m *SdkSchedulePolicyInspectRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyInspectRequest) ProtoMessage()    {}
 func (*SdkSchedulePolicyInspectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{51}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{55}
 }
 func (m *SdkSchedulePolicyInspectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyInspectRequest.Unmarshal(m, b)
@@ -5623,7 +5889,7 @@ func (m *SdkSchedulePolicyInspectResponse) Reset()         { *m = SdkSchedulePol
 func (m *SdkSchedulePolicyInspectResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyInspectResponse) ProtoMessage()    {}
 func (*SdkSchedulePolicyInspectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{52}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{56}
 }
 func (m *SdkSchedulePolicyInspectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyInspectResponse.Unmarshal(m, b)
@@ -5663,7 +5929,7 @@ func (m *SdkSchedulePolicyDeleteRequest) Reset()         { *m = SdkSchedulePolic
 func (m *SdkSchedulePolicyDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyDeleteRequest) ProtoMessage()    {}
 func (*SdkSchedulePolicyDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{53}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{57}
 }
 func (m *SdkSchedulePolicyDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyDeleteRequest.Unmarshal(m, b)
@@ -5701,7 +5967,7 @@ func (m *SdkSchedulePolicyDeleteResponse) Reset()         { *m = SdkSchedulePoli
 func (m *SdkSchedulePolicyDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyDeleteResponse) ProtoMessage()    {}
 func (*SdkSchedulePolicyDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{54}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{58}
 }
 func (m *SdkSchedulePolicyDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyDeleteResponse.Unmarshal(m, b)
@@ -5736,7 +6002,7 @@ func (m *SdkSchedulePolicyIntervalDaily) Reset()         { *m = SdkSchedulePolic
 func (m *SdkSchedulePolicyIntervalDaily) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyIntervalDaily) ProtoMessage()    {}
 func (*SdkSchedulePolicyIntervalDaily) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{55}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{59}
 }
 func (m *SdkSchedulePolicyIntervalDaily) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyIntervalDaily.Unmarshal(m, b)
@@ -5786,7 +6052,7 @@ func (m *SdkSchedulePolicyIntervalWeekly) Reset()         { *m = SdkSchedulePoli
 func (m *SdkSchedulePolicyIntervalWeekly) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyIntervalWeekly) ProtoMessage()    {}
 func (*SdkSchedulePolicyIntervalWeekly) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{56}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{60}
 }
 func (m *SdkSchedulePolicyIntervalWeekly) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyIntervalWeekly.Unmarshal(m, b)
@@ -5844,7 +6110,7 @@ func (m *SdkSchedulePolicyIntervalMonthly) Reset()         { *m = SdkSchedulePol
 func (m *SdkSchedulePolicyIntervalMonthly) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyIntervalMonthly) ProtoMessage()    {}
 func (*SdkSchedulePolicyIntervalMonthly) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{57}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{61}
 }
 func (m *SdkSchedulePolicyIntervalMonthly) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyIntervalMonthly.Unmarshal(m, b)
@@ -5898,7 +6164,7 @@ func (m *SdkSchedulePolicyIntervalPeriodic) Reset()         { *m = SdkSchedulePo
 func (m *SdkSchedulePolicyIntervalPeriodic) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyIntervalPeriodic) ProtoMessage()    {}
 func (*SdkSchedulePolicyIntervalPeriodic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{58}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{62}
 }
 func (m *SdkSchedulePolicyIntervalPeriodic) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyIntervalPeriodic.Unmarshal(m, b)
@@ -5946,7 +6212,7 @@ func (m *SdkSchedulePolicyInterval) Reset()         { *m = SdkSchedulePolicyInte
 func (m *SdkSchedulePolicyInterval) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyInterval) ProtoMessage()    {}
 func (*SdkSchedulePolicyInterval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{59}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{63}
 }
 func (m *SdkSchedulePolicyInterval) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyInterval.Unmarshal(m, b)
@@ -6157,7 +6423,7 @@ func (m *SdkSchedulePolicy) Reset()         { *m = SdkSchedulePolicy{} }
 func (m *SdkSchedulePolicy) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicy) ProtoMessage()    {}
 func (*SdkSchedulePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{60}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{64}
 }
 func (m *SdkSchedulePolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicy.Unmarshal(m, b)
@@ -6215,7 +6481,7 @@ func (m *SdkCredentialCreateRequest) Reset()         { *m = SdkCredentialCreateR
 func (m *SdkCredentialCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialCreateRequest) ProtoMessage()    {}
 func (*SdkCredentialCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{61}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{65}
 }
 func (m *SdkCredentialCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialCreateRequest.Unmarshal(m, b)
@@ -6408,7 +6674,7 @@ func (m *SdkCredentialCreateResponse) Reset()         { *m = SdkCredentialCreate
 func (m *SdkCredentialCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialCreateResponse) ProtoMessage()    {}
 func (*SdkCredentialCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{62}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{66}
 }
 func (m *SdkCredentialCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialCreateResponse.Unmarshal(m, b)
@@ -6456,7 +6722,7 @@ func (m *SdkAwsCredentialRequest) Reset()         { *m = SdkAwsCredentialRequest
 func (m *SdkAwsCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkAwsCredentialRequest) ProtoMessage()    {}
 func (*SdkAwsCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{63}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{67}
 }
 func (m *SdkAwsCredentialRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkAwsCredentialRequest.Unmarshal(m, b)
@@ -6526,7 +6792,7 @@ func (m *SdkAzureCredentialRequest) Reset()         { *m = SdkAzureCredentialReq
 func (m *SdkAzureCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkAzureCredentialRequest) ProtoMessage()    {}
 func (*SdkAzureCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{64}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{68}
 }
 func (m *SdkAzureCredentialRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkAzureCredentialRequest.Unmarshal(m, b)
@@ -6575,7 +6841,7 @@ func (m *SdkGoogleCredentialRequest) Reset()         { *m = SdkGoogleCredentialR
 func (m *SdkGoogleCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkGoogleCredentialRequest) ProtoMessage()    {}
 func (*SdkGoogleCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{65}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{69}
 }
 func (m *SdkGoogleCredentialRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkGoogleCredentialRequest.Unmarshal(m, b)
@@ -6628,7 +6894,7 @@ func (m *SdkAwsCredentialResponse) Reset()         { *m = SdkAwsCredentialRespon
 func (m *SdkAwsCredentialResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkAwsCredentialResponse) ProtoMessage()    {}
 func (*SdkAwsCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{66}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{70}
 }
 func (m *SdkAwsCredentialResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkAwsCredentialResponse.Unmarshal(m, b)
@@ -6689,7 +6955,7 @@ func (m *SdkAzureCredentialResponse) Reset()         { *m = SdkAzureCredentialRe
 func (m *SdkAzureCredentialResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkAzureCredentialResponse) ProtoMessage()    {}
 func (*SdkAzureCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{67}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{71}
 }
 func (m *SdkAzureCredentialResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkAzureCredentialResponse.Unmarshal(m, b)
@@ -6729,7 +6995,7 @@ func (m *SdkGoogleCredentialResponse) Reset()         { *m = SdkGoogleCredential
 func (m *SdkGoogleCredentialResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkGoogleCredentialResponse) ProtoMessage()    {}
 func (*SdkGoogleCredentialResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{68}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{72}
 }
 func (m *SdkGoogleCredentialResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkGoogleCredentialResponse.Unmarshal(m, b)
@@ -6767,7 +7033,7 @@ func (m *SdkCredentialEnumerateRequest) Reset()         { *m = SdkCredentialEnum
 func (m *SdkCredentialEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialEnumerateRequest) ProtoMessage()    {}
 func (*SdkCredentialEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{69}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{73}
 }
 func (m *SdkCredentialEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialEnumerateRequest.Unmarshal(m, b)
@@ -6800,7 +7066,7 @@ func (m *SdkCredentialEnumerateResponse) Reset()         { *m = SdkCredentialEnu
 func (m *SdkCredentialEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialEnumerateResponse) ProtoMessage()    {}
 func (*SdkCredentialEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{70}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{74}
 }
 func (m *SdkCredentialEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialEnumerateResponse.Unmarshal(m, b)
@@ -6840,7 +7106,7 @@ func (m *SdkCredentialInspectRequest) Reset()         { *m = SdkCredentialInspec
 func (m *SdkCredentialInspectRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialInspectRequest) ProtoMessage()    {}
 func (*SdkCredentialInspectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{71}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{75}
 }
 func (m *SdkCredentialInspectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialInspectRequest.Unmarshal(m, b)
@@ -6893,7 +7159,7 @@ func (m *SdkCredentialInspectResponse) Reset()         { *m = SdkCredentialInspe
 func (m *SdkCredentialInspectResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialInspectResponse) ProtoMessage()    {}
 func (*SdkCredentialInspectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{72}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{76}
 }
 func (m *SdkCredentialInspectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialInspectResponse.Unmarshal(m, b)
@@ -7087,7 +7353,7 @@ func (m *SdkCredentialDeleteRequest) Reset()         { *m = SdkCredentialDeleteR
 func (m *SdkCredentialDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialDeleteRequest) ProtoMessage()    {}
 func (*SdkCredentialDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{73}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{77}
 }
 func (m *SdkCredentialDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialDeleteRequest.Unmarshal(m, b)
@@ -7125,7 +7391,7 @@ func (m *SdkCredentialDeleteResponse) Reset()         { *m = SdkCredentialDelete
 func (m *SdkCredentialDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialDeleteResponse) ProtoMessage()    {}
 func (*SdkCredentialDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{74}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{78}
 }
 func (m *SdkCredentialDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialDeleteResponse.Unmarshal(m, b)
@@ -7158,7 +7424,7 @@ func (m *SdkCredentialValidateRequest) Reset()         { *m = SdkCredentialValid
 func (m *SdkCredentialValidateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialValidateRequest) ProtoMessage()    {}
 func (*SdkCredentialValidateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{75}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{79}
 }
 func (m *SdkCredentialValidateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialValidateRequest.Unmarshal(m, b)
@@ -7196,7 +7462,7 @@ func (m *SdkCredentialValidateResponse) Reset()         { *m = SdkCredentialVali
 func (m *SdkCredentialValidateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialValidateResponse) ProtoMessage()    {}
 func (*SdkCredentialValidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{76}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{80}
 }
 func (m *SdkCredentialValidateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialValidateResponse.Unmarshal(m, b)
@@ -7231,7 +7497,7 @@ func (m *SdkVolumeMountRequest) Reset()         { *m = SdkVolumeMountRequest{} }
 func (m *SdkVolumeMountRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeMountRequest) ProtoMessage()    {}
 func (*SdkVolumeMountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{77}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{81}
 }
 func (m *SdkVolumeMountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeMountRequest.Unmarshal(m, b)
@@ -7276,7 +7542,7 @@ func (m *SdkVolumeMountResponse) Reset()         { *m = SdkVolumeMountResponse{}
 func (m *SdkVolumeMountResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeMountResponse) ProtoMessage()    {}
 func (*SdkVolumeMountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{78}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{82}
 }
 func (m *SdkVolumeMountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeMountResponse.Unmarshal(m, b)
@@ -7313,7 +7579,7 @@ func (m *SdkVolumeUnmountRequest) Reset()         { *m = SdkVolumeUnmountRequest
 func (m *SdkVolumeUnmountRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeUnmountRequest) ProtoMessage()    {}
 func (*SdkVolumeUnmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{79}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{83}
 }
 func (m *SdkVolumeUnmountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeUnmountRequest.Unmarshal(m, b)
@@ -7373,7 +7639,7 @@ func (m *SdkVolumeUnmountRequest_Options) Reset()         { *m = SdkVolumeUnmoun
 func (m *SdkVolumeUnmountRequest_Options) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeUnmountRequest_Options) ProtoMessage()    {}
 func (*SdkVolumeUnmountRequest_Options) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{79, 0}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{83, 0}
 }
 func (m *SdkVolumeUnmountRequest_Options) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeUnmountRequest_Options.Unmarshal(m, b)
@@ -7418,7 +7684,7 @@ func (m *SdkVolumeUnmountResponse) Reset()         { *m = SdkVolumeUnmountRespon
 func (m *SdkVolumeUnmountResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeUnmountResponse) ProtoMessage()    {}
 func (*SdkVolumeUnmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{80}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{84}
 }
 func (m *SdkVolumeUnmountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeUnmountResponse.Unmarshal(m, b)
@@ -7453,7 +7719,7 @@ func (m *SdkVolumeAttachRequest) Reset()         { *m = SdkVolumeAttachRequest{}
 func (m *SdkVolumeAttachRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeAttachRequest) ProtoMessage()    {}
 func (*SdkVolumeAttachRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{81}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{85}
 }
 func (m *SdkVolumeAttachRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeAttachRequest.Unmarshal(m, b)
@@ -7507,7 +7773,7 @@ func (m *SdkVolumeAttachRequest_Options) Reset()         { *m = SdkVolumeAttachR
 func (m *SdkVolumeAttachRequest_Options) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeAttachRequest_Options) ProtoMessage()    {}
 func (*SdkVolumeAttachRequest_Options) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{81, 0}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{85, 0}
 }
 func (m *SdkVolumeAttachRequest_Options) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeAttachRequest_Options.Unmarshal(m, b)
@@ -7561,7 +7827,7 @@ func (m *SdkVolumeAttachResponse) Reset()         { *m = SdkVolumeAttachResponse
 func (m *SdkVolumeAttachResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeAttachResponse) ProtoMessage()    {}
 func (*SdkVolumeAttachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{82}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{86}
 }
 func (m *SdkVolumeAttachResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeAttachResponse.Unmarshal(m, b)
@@ -7602,7 +7868,7 @@ func (m *SdkVolumeDetachRequest) Reset()         { *m = SdkVolumeDetachRequest{}
 func (m *SdkVolumeDetachRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeDetachRequest) ProtoMessage()    {}
 func (*SdkVolumeDetachRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{83}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{87}
 }
 func (m *SdkVolumeDetachRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeDetachRequest.Unmarshal(m, b)
@@ -7651,7 +7917,7 @@ func (m *SdkVolumeDetachRequest_Options) Reset()         { *m = SdkVolumeDetachR
 func (m *SdkVolumeDetachRequest_Options) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeDetachRequest_Options) ProtoMessage()    {}
 func (*SdkVolumeDetachRequest_Options) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{83, 0}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{87, 0}
 }
 func (m *SdkVolumeDetachRequest_Options) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeDetachRequest_Options.Unmarshal(m, b)
@@ -7696,7 +7962,7 @@ func (m *SdkVolumeDetachResponse) Reset()         { *m = SdkVolumeDetachResponse
 func (m *SdkVolumeDetachResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeDetachResponse) ProtoMessage()    {}
 func (*SdkVolumeDetachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{84}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{88}
 }
 func (m *SdkVolumeDetachResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeDetachResponse.Unmarshal(m, b)
@@ -7732,7 +7998,7 @@ func (m *SdkVolumeCreateRequest) Reset()         { *m = SdkVolumeCreateRequest{}
 func (m *SdkVolumeCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeCreateRequest) ProtoMessage()    {}
 func (*SdkVolumeCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{85}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{89}
 }
 func (m *SdkVolumeCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeCreateRequest.Unmarshal(m, b)
@@ -7779,7 +8045,7 @@ func (m *SdkVolumeCreateResponse) Reset()         { *m = SdkVolumeCreateResponse
 func (m *SdkVolumeCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeCreateResponse) ProtoMessage()    {}
 func (*SdkVolumeCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{86}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{90}
 }
 func (m *SdkVolumeCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeCreateResponse.Unmarshal(m, b)
@@ -7811,7 +8077,14 @@ type SdkVolumeCloneRequest struct {
 	// Unique name of the volume. This will be used for idempotency.
 	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	// Parent volume id or snapshot id will create a new volume as a clone of the parent.
-	ParentId             string   `protobuf:"bytes,2,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"`
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"`
+	// Labels to apply to the clone, overriding the labels inherited from the parent
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Spec overrides values of the spec inherited from the parent
+	Spec *VolumeSpecUpdate `protobuf:"bytes,4,opt,name=spec" json:"spec,omitempty"`
+	// Restore policy transforming the labels and the spec of the clone,
+	// the default policy of the cluster if empty
+	TransformPolicy      string   `protobuf:"bytes,5,opt,name=transform_policy,json=transformPolicy" json:"transform_policy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -7821,7 +8094,7 @@ func (m *SdkVolumeCloneRequest) Reset()         { *m = SdkVolumeCloneRequest{} }
 func (m *SdkVolumeCloneRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeCloneRequest) ProtoMessage()    {}
 func (*SdkVolumeCloneRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{87}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{91}
 }
 func (m *SdkVolumeCloneRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeCloneRequest.Unmarshal(m, b)
@@ -7855,6 +8128,27 @@ func (m *SdkVolumeCloneRequest) GetParentId() string {
 	return ""
 }
 
+func (m *SdkVolumeCloneRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *SdkVolumeCloneRequest) GetSpec() *VolumeSpecUpdate {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *SdkVolumeCloneRequest) GetTransformPolicy() string {
+	if m != nil {
+		return m.TransformPolicy
+	}
+	return ""
+}
+
 // Defines the response when creating a clone from a volume or a snapshot
 type SdkVolumeCloneResponse struct {
 	// Id of new volume
@@ -7868,7 +8162,7 @@ func (m *SdkVolumeCloneResponse) Reset()         { *m = SdkVolumeCloneResponse{}
 func (m *SdkVolumeCloneResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeCloneResponse) ProtoMessage()    {}
 func (*SdkVolumeCloneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{88}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{92}
 }
 func (m *SdkVolumeCloneResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeCloneResponse.Unmarshal(m, b)
@@ -7908,7 +8202,7 @@ func (m *SdkVolumeDeleteRequest) Reset()         { *m = SdkVolumeDeleteRequest{}
 func (m *SdkVolumeDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeDeleteRequest) ProtoMessage()    {}
 func (*SdkVolumeDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{89}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{93}
 }
 func (m *SdkVolumeDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeDeleteRequest.Unmarshal(m, b)
@@ -7946,7 +8240,7 @@ func (m *SdkVolumeDeleteResponse) Reset()         { *m = SdkVolumeDeleteResponse
 func (m *SdkVolumeDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeDeleteResponse) ProtoMessage()    {}
 func (*SdkVolumeDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{90}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{94}
 }
 func (m *SdkVolumeDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeDeleteResponse.Unmarshal(m, b)
@@ -7979,7 +8273,7 @@ func (m *SdkVolumeInspectRequest) Reset()         { *m = SdkVolumeInspectRequest
 func (m *SdkVolumeInspectRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeInspectRequest) ProtoMessage()    {}
 func (*SdkVolumeInspectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{91}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{95}
 }
 func (m *SdkVolumeInspectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeInspectRequest.Unmarshal(m, b)
@@ -8019,7 +8313,7 @@ func (m *SdkVolumeInspectResponse) Reset()         { *m = SdkVolumeInspectRespon
 func (m *SdkVolumeInspectResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeInspectResponse) ProtoMessage()    {}
 func (*SdkVolumeInspectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{92}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{96}
 }
 func (m *SdkVolumeInspectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeInspectResponse.Unmarshal(m, b)
@@ -8073,7 +8367,7 @@ func (m *SdkVolumeUpdateRequest) Reset()         { *m = SdkVolumeUpdateRequest{}
 func (m *SdkVolumeUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeUpdateRequest) ProtoMessage()    {}
 func (*SdkVolumeUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{93}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{97}
 }
 func (m *SdkVolumeUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeUpdateRequest.Unmarshal(m, b)
@@ -8125,7 +8419,7 @@ func (m *SdkVolumeUpdateResponse) Reset()         { *m = SdkVolumeUpdateResponse
 func (m *SdkVolumeUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeUpdateResponse) ProtoMessage()    {}
 func (*SdkVolumeUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{94}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{98}
 }
 func (m *SdkVolumeUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeUpdateResponse.Unmarshal(m, b)
@@ -8161,7 +8455,7 @@ func (m *SdkVolumeStatsRequest) Reset()         { *m = SdkVolumeStatsRequest{} }
 func (m *SdkVolumeStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeStatsRequest) ProtoMessage()    {}
 func (*SdkVolumeStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{95}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{99}
 }
 func (m *SdkVolumeStatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeStatsRequest.Unmarshal(m, b)
@@ -8208,7 +8502,7 @@ func (m *SdkVolumeStatsResponse) Reset()         { *m = SdkVolumeStatsResponse{}
 func (m *SdkVolumeStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeStatsResponse) ProtoMessage()    {}
 func (*SdkVolumeStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{96}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{100}
 }
 func (m *SdkVolumeStatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeStatsResponse.Unmarshal(m, b)
@@ -8248,7 +8542,7 @@ func (m *SdkVolumeCapacityUsageRequest) Reset()         { *m = SdkVolumeCapacity
 func (m *SdkVolumeCapacityUsageRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeCapacityUsageRequest) ProtoMessage()    {}
 func (*SdkVolumeCapacityUsageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{97}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{101}
 }
 func (m *SdkVolumeCapacityUsageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeCapacityUsageRequest.Unmarshal(m, b)
@@ -8288,7 +8582,7 @@ func (m *SdkVolumeCapacityUsageResponse) Reset()         { *m = SdkVolumeCapacit
 func (m *SdkVolumeCapacityUsageResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeCapacityUsageResponse) ProtoMessage()    {}
 func (*SdkVolumeCapacityUsageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{98}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{102}
 }
 func (m *SdkVolumeCapacityUsageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeCapacityUsageResponse.Unmarshal(m, b)
@@ -8326,7 +8620,7 @@ func (m *SdkVolumeEnumerateRequest) Reset()         { *m = SdkVolumeEnumerateReq
 func (m *SdkVolumeEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeEnumerateRequest) ProtoMessage()    {}
 func (*SdkVolumeEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{99}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{103}
 }
 func (m *SdkVolumeEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeEnumerateRequest.Unmarshal(m, b)
@@ -8359,7 +8653,7 @@ func (m *SdkVolumeEnumerateResponse) Reset()         { *m = SdkVolumeEnumerateRe
 func (m *SdkVolumeEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeEnumerateResponse) ProtoMessage()    {}
 func (*SdkVolumeEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{100}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{104}
 }
 func (m *SdkVolumeEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeEnumerateResponse.Unmarshal(m, b)
@@ -8400,7 +8694,7 @@ func (m *SdkVolumeEnumerateWithFiltersRequest) Reset()         { *m = SdkVolumeE
 func (m *SdkVolumeEnumerateWithFiltersRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeEnumerateWithFiltersRequest) ProtoMessage()    {}
 func (*SdkVolumeEnumerateWithFiltersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{101}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{105}
 }
 func (m *SdkVolumeEnumerateWithFiltersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeEnumerateWithFiltersRequest.Unmarshal(m, b)
@@ -8440,7 +8734,7 @@ func (m *SdkVolumeEnumerateWithFiltersResponse) Reset()         { *m = SdkVolume
 func (m *SdkVolumeEnumerateWithFiltersResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeEnumerateWithFiltersResponse) ProtoMessage()    {}
 func (*SdkVolumeEnumerateWithFiltersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{102}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{106}
 }
 func (m *SdkVolumeEnumerateWithFiltersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeEnumerateWithFiltersResponse.Unmarshal(m, b)
@@ -8484,7 +8778,7 @@ func (m *SdkVolumeSnapshotCreateRequest) Reset()         { *m = SdkVolumeSnapsho
 func (m *SdkVolumeSnapshotCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotCreateRequest) ProtoMessage()    {}
 func (*SdkVolumeSnapshotCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{103}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{107}
 }
 func (m *SdkVolumeSnapshotCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotCreateRequest.Unmarshal(m, b)
@@ -8538,7 +8832,7 @@ func (m *SdkVolumeSnapshotCreateResponse) Reset()         { *m = SdkVolumeSnapsh
 func (m *SdkVolumeSnapshotCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotCreateResponse) ProtoMessage()    {}
 func (*SdkVolumeSnapshotCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{104}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{108}
 }
 func (m *SdkVolumeSnapshotCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotCreateResponse.Unmarshal(m, b)
@@ -8580,7 +8874,7 @@ func (m *SdkVolumeSnapshotRestoreRequest) Reset()         { *m = SdkVolumeSnapsh
 func (m *SdkVolumeSnapshotRestoreRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotRestoreRequest) ProtoMessage()    {}
 func (*SdkVolumeSnapshotRestoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{105}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{109}
 }
 func (m *SdkVolumeSnapshotRestoreRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotRestoreRequest.Unmarshal(m, b)
@@ -8625,7 +8919,7 @@ func (m *SdkVolumeSnapshotRestoreResponse) Reset()         { *m = SdkVolumeSnaps
 func (m *SdkVolumeSnapshotRestoreResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotRestoreResponse) ProtoMessage()    {}
 func (*SdkVolumeSnapshotRestoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{106}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{110}
 }
 func (m *SdkVolumeSnapshotRestoreResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotRestoreResponse.Unmarshal(m, b)
@@ -8658,7 +8952,7 @@ func (m *SdkVolumeSnapshotEnumerateRequest) Reset()         { *m = SdkVolumeSnap
 func (m *SdkVolumeSnapshotEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotEnumerateRequest) ProtoMessage()    {}
 func (*SdkVolumeSnapshotEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{107}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{111}
 }
 func (m *SdkVolumeSnapshotEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.Unmarshal(m, b)
@@ -8698,7 +8992,7 @@ func (m *SdkVolumeSnapshotEnumerateResponse) Reset()         { *m = SdkVolumeSna
 func (m *SdkVolumeSnapshotEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotEnumerateResponse) ProtoMessage()    {}
 func (*SdkVolumeSnapshotEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{108}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{112}
 }
 func (m *SdkVolumeSnapshotEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.Unmarshal(m, b)
@@ -8744,7 +9038,7 @@ func (m *SdkVolumeSnapshotEnumerateWithFiltersRequest) String() string {
 }
 func (*SdkVolumeSnapshotEnumerateWithFiltersRequest) ProtoMessage() {}
 func (*SdkVolumeSnapshotEnumerateWithFiltersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{109}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{113}
 }
 func (m *SdkVolumeSnapshotEnumerateWithFiltersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotEnumerateWithFiltersRequest.Unmarshal(m, b)
@@ -8795,7 +9089,7 @@ func (m *SdkVolumeSnapshotEnumerateWithFiltersResponse) String() string {
 }
 func (*SdkVolumeSnapshotEnumerateWithFiltersResponse) ProtoMessage() {}
 func (*SdkVolumeSnapshotEnumerateWithFiltersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{110}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{114}
 }
 func (m *SdkVolumeSnapshotEnumerateWithFiltersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotEnumerateWithFiltersResponse.Unmarshal(m, b)
@@ -8839,7 +9133,7 @@ func (m *SdkVolumeSnapshotScheduleUpdateRequest) Reset() {
 func (m *SdkVolumeSnapshotScheduleUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotScheduleUpdateRequest) ProtoMessage()    {}
 func (*SdkVolumeSnapshotScheduleUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{111}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{115}
 }
 func (m *SdkVolumeSnapshotScheduleUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotScheduleUpdateRequest.Unmarshal(m, b)
@@ -8886,7 +9180,7 @@ func (m *SdkVolumeSnapshotScheduleUpdateResponse) Reset() {
 func (m *SdkVolumeSnapshotScheduleUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotScheduleUpdateResponse) ProtoMessage()    {}
 func (*SdkVolumeSnapshotScheduleUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{112}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{116}
 }
 func (m *SdkVolumeSnapshotScheduleUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotScheduleUpdateResponse.Unmarshal(m, b)
@@ -8917,7 +9211,7 @@ func (m *SdkClusterInspectCurrentRequest) Reset()         { *m = SdkClusterInspe
 func (m *SdkClusterInspectCurrentRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterInspectCurrentRequest) ProtoMessage()    {}
 func (*SdkClusterInspectCurrentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{113}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{117}
 }
 func (m *SdkClusterInspectCurrentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterInspectCurrentRequest.Unmarshal(m, b)
@@ -8950,7 +9244,7 @@ func (m *SdkClusterInspectCurrentResponse) Reset()         { *m = SdkClusterInsp
 func (m *SdkClusterInspectCurrentResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterInspectCurrentResponse) ProtoMessage()    {}
 func (*SdkClusterInspectCurrentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{114}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{118}
 }
 func (m *SdkClusterInspectCurrentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterInspectCurrentResponse.Unmarshal(m, b)
@@ -8990,7 +9284,7 @@ func (m *SdkNodeInspectRequest) Reset()         { *m = SdkNodeInspectRequest{} }
 func (m *SdkNodeInspectRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkNodeInspectRequest) ProtoMessage()    {}
 func (*SdkNodeInspectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{115}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{119}
 }
 func (m *SdkNodeInspectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkNodeInspectRequest.Unmarshal(m, b)
@@ -9030,7 +9324,7 @@ func (m *SdkNodeInspectResponse) Reset()         { *m = SdkNodeInspectResponse{}
 func (m *SdkNodeInspectResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkNodeInspectResponse) ProtoMessage()    {}
 func (*SdkNodeInspectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{116}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{120}
 }
 func (m *SdkNodeInspectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkNodeInspectResponse.Unmarshal(m, b)
@@ -9068,7 +9362,7 @@ func (m *SdkNodeInspectCurrentRequest) Reset()         { *m = SdkNodeInspectCurr
 func (m *SdkNodeInspectCurrentRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkNodeInspectCurrentRequest) ProtoMessage()    {}
 func (*SdkNodeInspectCurrentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{117}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{121}
 }
 func (m *SdkNodeInspectCurrentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkNodeInspectCurrentRequest.Unmarshal(m, b)
@@ -9101,7 +9395,7 @@ func (m *SdkNodeInspectCurrentResponse) Reset()         { *m = SdkNodeInspectCur
 func (m *SdkNodeInspectCurrentResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkNodeInspectCurrentResponse) ProtoMessage()    {}
 func (*SdkNodeInspectCurrentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{118}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{122}
 }
 func (m *SdkNodeInspectCurrentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkNodeInspectCurrentResponse.Unmarshal(m, b)
@@ -9139,7 +9433,7 @@ func (m *SdkNodeEnumerateRequest) Reset()         { *m = SdkNodeEnumerateRequest
 func (m *SdkNodeEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkNodeEnumerateRequest) ProtoMessage()    {}
 func (*SdkNodeEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{119}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{123}
 }
 func (m *SdkNodeEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkNodeEnumerateRequest.Unmarshal(m, b)
@@ -9172,7 +9466,7 @@ func (m *SdkNodeEnumerateResponse) Reset()         { *m = SdkNodeEnumerateRespon
 func (m *SdkNodeEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkNodeEnumerateResponse) ProtoMessage()    {}
 func (*SdkNodeEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{120}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{124}
 }
 func (m *SdkNodeEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkNodeEnumerateResponse.Unmarshal(m, b)
@@ -9212,7 +9506,7 @@ func (m *SdkObjectstoreInspectRequest) Reset()         { *m = SdkObjectstoreInsp
 func (m *SdkObjectstoreInspectRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreInspectRequest) ProtoMessage()    {}
 func (*SdkObjectstoreInspectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{121}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{125}
 }
 func (m *SdkObjectstoreInspectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreInspectRequest.Unmarshal(m, b)
@@ -9252,7 +9546,7 @@ func (m *SdkObjectstoreInspectResponse) Reset()         { *m = SdkObjectstoreIns
 func (m *SdkObjectstoreInspectResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreInspectResponse) ProtoMessage()    {}
 func (*SdkObjectstoreInspectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{122}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{126}
 }
 func (m *SdkObjectstoreInspectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreInspectResponse.Unmarshal(m, b)
@@ -9292,7 +9586,7 @@ func (m *SdkObjectstoreCreateRequest) Reset()         { *m = SdkObjectstoreCreat
 func (m *SdkObjectstoreCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreCreateRequest) ProtoMessage()    {}
 func (*SdkObjectstoreCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{123}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{127}
 }
 func (m *SdkObjectstoreCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreCreateRequest.Unmarshal(m, b)
@@ -9333,7 +9627,7 @@ func (m *SdkObjectstoreCreateResponse) Reset()         { *m = SdkObjectstoreCrea
 func (m *SdkObjectstoreCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreCreateResponse) ProtoMessage()    {}
 func (*SdkObjectstoreCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{124}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{128}
 }
 func (m *SdkObjectstoreCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreCreateResponse.Unmarshal(m, b)
@@ -9373,7 +9667,7 @@ func (m *SdkObjectstoreDeleteRequest) Reset()         { *m = SdkObjectstoreDelet
 func (m *SdkObjectstoreDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreDeleteRequest) ProtoMessage()    {}
 func (*SdkObjectstoreDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{125}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{129}
 }
 func (m *SdkObjectstoreDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreDeleteRequest.Unmarshal(m, b)
@@ -9411,7 +9705,7 @@ func (m *SdkObjectstoreDeleteResponse) Reset()         { *m = SdkObjectstoreDele
 func (m *SdkObjectstoreDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreDeleteResponse) ProtoMessage()    {}
 func (*SdkObjectstoreDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{126}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{130}
 }
 func (m *SdkObjectstoreDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreDeleteResponse.Unmarshal(m, b)
@@ -9446,7 +9740,7 @@ func (m *SdkObjectstoreUpdateRequest) Reset()         { *m = SdkObjectstoreUpdat
 func (m *SdkObjectstoreUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreUpdateRequest) ProtoMessage()    {}
 func (*SdkObjectstoreUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{127}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{131}
 }
 func (m *SdkObjectstoreUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreUpdateRequest.Unmarshal(m, b)
@@ -9491,7 +9785,7 @@ func (m *SdkObjectstoreUpdateResponse) Reset()         { *m = SdkObjectstoreUpda
 func (m *SdkObjectstoreUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreUpdateResponse) ProtoMessage()    {}
 func (*SdkObjectstoreUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{128}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{132}
 }
 func (m *SdkObjectstoreUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreUpdateResponse.Unmarshal(m, b)
@@ -9534,7 +9828,7 @@ func (m *SdkCloudBackupCreateRequest) Reset()         { *m = SdkCloudBackupCreat
 func (m *SdkCloudBackupCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupCreateRequest) ProtoMessage()    {}
 func (*SdkCloudBackupCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{129}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{133}
 }
 func (m *SdkCloudBackupCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupCreateRequest.Unmarshal(m, b)
@@ -9602,7 +9896,7 @@ func (m *SdkCloudBackupCreateResponse) Reset()         { *m = SdkCloudBackupCrea
 func (m *SdkCloudBackupCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupCreateResponse) ProtoMessage()    {}
 func (*SdkCloudBackupCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{130}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{134}
 }
 func (m *SdkCloudBackupCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupCreateResponse.Unmarshal(m, b)
@@ -9643,7 +9937,10 @@ type SdkCloudBackupRestoreRequest struct {
 	// volume (ResoreVolumeName should not be specified)
 	NodeId string `protobuf:"bytes,4,opt,name=node_id,json=nodeId" json:"node_id,omitempty"`
 	// TaskId of the task performing this restore
-	TaskId               string   `protobuf:"bytes,5,opt,name=task_id,json=taskId" json:"task_id,omitempty"`
+	TaskId string `protobuf:"bytes,5,opt,name=task_id,json=taskId" json:"task_id,omitempty"`
+	// Restore policy transforming the labels and the spec of the restored
+	// volume, the default policy of the cluster if empty
+	TransformPolicy      string   `protobuf:"bytes,6,opt,name=transform_policy,json=transformPolicy" json:"transform_policy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -9653,7 +9950,7 @@ func (m *SdkCloudBackupRestoreRequest) Reset()         { *m = SdkCloudBackupRest
 func (m *SdkCloudBackupRestoreRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupRestoreRequest) ProtoMessage()    {}
 func (*SdkCloudBackupRestoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{131}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{135}
 }
 func (m *SdkCloudBackupRestoreRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupRestoreRequest.Unmarshal(m, b)
@@ -9708,6 +10005,13 @@ func (m *SdkCloudBackupRestoreRequest) GetTaskId() string {
 	return ""
 }
 
+func (m *SdkCloudBackupRestoreRequest) GetTransformPolicy() string {
+	if m != nil {
+		return m.TransformPolicy
+	}
+	return ""
+}
+
 // Defines a response when restoring a volume from a backup stored by
 // a cloud provider
 type SdkCloudBackupRestoreResponse struct {
@@ -9724,7 +10028,7 @@ func (m *SdkCloudBackupRestoreResponse) Reset()         { *m = SdkCloudBackupRes
 func (m *SdkCloudBackupRestoreResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupRestoreResponse) ProtoMessage()    {}
 func (*SdkCloudBackupRestoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{132}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{136}
 }
 func (m *SdkCloudBackupRestoreResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupRestoreResponse.Unmarshal(m, b)
@@ -9777,7 +10081,7 @@ func (m *SdkCloudBackupDeleteRequest) Reset()         { *m = SdkCloudBackupDelet
 func (m *SdkCloudBackupDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupDeleteRequest) ProtoMessage()    {}
 func (*SdkCloudBackupDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{133}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{137}
 }
 func (m *SdkCloudBackupDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupDeleteRequest.Unmarshal(m, b)
@@ -9829,7 +10133,7 @@ func (m *SdkCloudBackupDeleteResponse) Reset()         { *m = SdkCloudBackupDele
 func (m *SdkCloudBackupDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupDeleteResponse) ProtoMessage()    {}
 func (*SdkCloudBackupDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{134}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{138}
 }
 func (m *SdkCloudBackupDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupDeleteResponse.Unmarshal(m, b)
@@ -9865,7 +10169,7 @@ func (m *SdkCloudBackupDeleteAllRequest) Reset()         { *m = SdkCloudBackupDe
 func (m *SdkCloudBackupDeleteAllRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupDeleteAllRequest) ProtoMessage()    {}
 func (*SdkCloudBackupDeleteAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{135}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{139}
 }
 func (m *SdkCloudBackupDeleteAllRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupDeleteAllRequest.Unmarshal(m, b)
@@ -9910,7 +10214,7 @@ func (m *SdkCloudBackupDeleteAllResponse) Reset()         { *m = SdkCloudBackupD
 func (m *SdkCloudBackupDeleteAllResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupDeleteAllResponse) ProtoMessage()    {}
 func (*SdkCloudBackupDeleteAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{136}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{140}
 }
 func (m *SdkCloudBackupDeleteAllResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupDeleteAllResponse.Unmarshal(m, b)
@@ -9963,7 +10267,7 @@ func (m *SdkCloudBackupEnumerateWithFiltersRequest) Reset() {
 func (m *SdkCloudBackupEnumerateWithFiltersRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupEnumerateWithFiltersRequest) ProtoMessage()    {}
 func (*SdkCloudBackupEnumerateWithFiltersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{137}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{141}
 }
 func (m *SdkCloudBackupEnumerateWithFiltersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupEnumerateWithFiltersRequest.Unmarshal(m, b)
@@ -10035,7 +10339,7 @@ func (m *SdkCloudBackupInfo) Reset()         { *m = SdkCloudBackupInfo{} }
 func (m *SdkCloudBackupInfo) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupInfo) ProtoMessage()    {}
 func (*SdkCloudBackupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{138}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{142}
 }
 func (m *SdkCloudBackupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupInfo.Unmarshal(m, b)
@@ -10113,7 +10417,7 @@ func (m *SdkCloudBackupEnumerateWithFiltersResponse) String() string {
 }
 func (*SdkCloudBackupEnumerateWithFiltersResponse) ProtoMessage() {}
 func (*SdkCloudBackupEnumerateWithFiltersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{139}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{143}
 }
 func (m *SdkCloudBackupEnumerateWithFiltersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupEnumerateWithFiltersResponse.Unmarshal(m, b)
@@ -10176,7 +10480,7 @@ func (m *SdkCloudBackupStatus) Reset()         { *m = SdkCloudBackupStatus{} }
 func (m *SdkCloudBackupStatus) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupStatus) ProtoMessage()    {}
 func (*SdkCloudBackupStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{140}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{144}
 }
 func (m *SdkCloudBackupStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupStatus.Unmarshal(m, b)
@@ -10302,7 +10606,7 @@ func (m *SdkCloudBackupStatusRequest) Reset()         { *m = SdkCloudBackupStatu
 func (m *SdkCloudBackupStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupStatusRequest) ProtoMessage()    {}
 func (*SdkCloudBackupStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{141}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{145}
 }
 func (m *SdkCloudBackupStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupStatusRequest.Unmarshal(m, b)
@@ -10357,7 +10661,7 @@ func (m *SdkCloudBackupStatusResponse) Reset()         { *m = SdkCloudBackupStat
 func (m *SdkCloudBackupStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupStatusResponse) ProtoMessage()    {}
 func (*SdkCloudBackupStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{142}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{146}
 }
 func (m *SdkCloudBackupStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupStatusResponse.Unmarshal(m, b)
@@ -10399,7 +10703,7 @@ func (m *SdkCloudBackupCatalogRequest) Reset()         { *m = SdkCloudBackupCata
 func (m *SdkCloudBackupCatalogRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupCatalogRequest) ProtoMessage()    {}
 func (*SdkCloudBackupCatalogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{143}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{147}
 }
 func (m *SdkCloudBackupCatalogRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupCatalogRequest.Unmarshal(m, b)
@@ -10446,7 +10750,7 @@ func (m *SdkCloudBackupCatalogResponse) Reset()         { *m = SdkCloudBackupCat
 func (m *SdkCloudBackupCatalogResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupCatalogResponse) ProtoMessage()    {}
 func (*SdkCloudBackupCatalogResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{144}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{148}
 }
 func (m *SdkCloudBackupCatalogResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupCatalogResponse.Unmarshal(m, b)
@@ -10491,7 +10795,7 @@ func (m *SdkCloudBackupHistoryItem) Reset()         { *m = SdkCloudBackupHistory
 func (m *SdkCloudBackupHistoryItem) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupHistoryItem) ProtoMessage()    {}
 func (*SdkCloudBackupHistoryItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{145}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{149}
 }
 func (m *SdkCloudBackupHistoryItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupHistoryItem.Unmarshal(m, b)
@@ -10547,7 +10851,7 @@ func (m *SdkCloudBackupHistoryRequest) Reset()         { *m = SdkCloudBackupHist
 func (m *SdkCloudBackupHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupHistoryRequest) ProtoMessage()    {}
 func (*SdkCloudBackupHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{146}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{150}
 }
 func (m *SdkCloudBackupHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupHistoryRequest.Unmarshal(m, b)
@@ -10587,7 +10891,7 @@ func (m *SdkCloudBackupHistoryResponse) Reset()         { *m = SdkCloudBackupHis
 func (m *SdkCloudBackupHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupHistoryResponse) ProtoMessage()    {}
 func (*SdkCloudBackupHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{147}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{151}
 }
 func (m *SdkCloudBackupHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupHistoryResponse.Unmarshal(m, b)
@@ -10631,7 +10935,7 @@ func (m *SdkCloudBackupStateChangeRequest) Reset()         { *m = SdkCloudBackup
 func (m *SdkCloudBackupStateChangeRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupStateChangeRequest) ProtoMessage()    {}
 func (*SdkCloudBackupStateChangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{148}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{152}
 }
 func (m *SdkCloudBackupStateChangeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupStateChangeRequest.Unmarshal(m, b)
@@ -10676,7 +10980,7 @@ func (m *SdkCloudBackupStateChangeResponse) Reset()         { *m = SdkCloudBacku
 func (m *SdkCloudBackupStateChangeResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupStateChangeResponse) ProtoMessage()    {}
 func (*SdkCloudBackupStateChangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{149}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{153}
 }
 func (m *SdkCloudBackupStateChangeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupStateChangeResponse.Unmarshal(m, b)
@@ -10719,7 +11023,7 @@ func (m *SdkCloudBackupScheduleInfo) Reset()         { *m = SdkCloudBackupSchedu
 func (m *SdkCloudBackupScheduleInfo) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupScheduleInfo) ProtoMessage()    {}
 func (*SdkCloudBackupScheduleInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{150}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{154}
 }
 func (m *SdkCloudBackupScheduleInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupScheduleInfo.Unmarshal(m, b)
@@ -10788,7 +11092,7 @@ func (m *SdkCloudBackupSchedCreateRequest) Reset()         { *m = SdkCloudBackup
 func (m *SdkCloudBackupSchedCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupSchedCreateRequest) ProtoMessage()    {}
 func (*SdkCloudBackupSchedCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{151}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{155}
 }
 func (m *SdkCloudBackupSchedCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupSchedCreateRequest.Unmarshal(m, b)
@@ -10829,7 +11133,7 @@ func (m *SdkCloudBackupSchedCreateResponse) Reset()         { *m = SdkCloudBacku
 func (m *SdkCloudBackupSchedCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupSchedCreateResponse) ProtoMessage()    {}
 func (*SdkCloudBackupSchedCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{152}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{156}
 }
 func (m *SdkCloudBackupSchedCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupSchedCreateResponse.Unmarshal(m, b)
@@ -10869,7 +11173,7 @@ func (m *SdkCloudBackupSchedDeleteRequest) Reset()         { *m = SdkCloudBackup
 func (m *SdkCloudBackupSchedDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupSchedDeleteRequest) ProtoMessage()    {}
 func (*SdkCloudBackupSchedDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{153}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{157}
 }
 func (m *SdkCloudBackupSchedDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupSchedDeleteRequest.Unmarshal(m, b)
@@ -10907,7 +11211,7 @@ func (m *SdkCloudBackupSchedDeleteResponse) Reset()         { *m = SdkCloudBacku
 func (m *SdkCloudBackupSchedDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupSchedDeleteResponse) ProtoMessage()    {}
 func (*SdkCloudBackupSchedDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{154}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{158}
 }
 func (m *SdkCloudBackupSchedDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupSchedDeleteResponse.Unmarshal(m, b)
@@ -10938,7 +11242,7 @@ func (m *SdkCloudBackupSchedEnumerateRequest) Reset()         { *m = SdkCloudBac
 func (m *SdkCloudBackupSchedEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupSchedEnumerateRequest) ProtoMessage()    {}
 func (*SdkCloudBackupSchedEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{155}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{159}
 }
 func (m *SdkCloudBackupSchedEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupSchedEnumerateRequest.Unmarshal(m, b)
@@ -10972,7 +11276,7 @@ func (m *SdkCloudBackupSchedEnumerateResponse) Reset()         { *m = SdkCloudBa
 func (m *SdkCloudBackupSchedEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupSchedEnumerateResponse) ProtoMessage()    {}
 func (*SdkCloudBackupSchedEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{156}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{160}
 }
 func (m *SdkCloudBackupSchedEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupSchedEnumerateResponse.Unmarshal(m, b)
@@ -11010,7 +11314,7 @@ func (m *SdkIdentityCapabilitiesRequest) Reset()         { *m = SdkIdentityCapab
 func (m *SdkIdentityCapabilitiesRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkIdentityCapabilitiesRequest) ProtoMessage()    {}
 func (*SdkIdentityCapabilitiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{157}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{161}
 }
 func (m *SdkIdentityCapabilitiesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkIdentityCapabilitiesRequest.Unmarshal(m, b)
@@ -11043,7 +11347,7 @@ func (m *SdkIdentityCapabilitiesResponse) Reset()         { *m = SdkIdentityCapa
 func (m *SdkIdentityCapabilitiesResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkIdentityCapabilitiesResponse) ProtoMessage()    {}
 func (*SdkIdentityCapabilitiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{158}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{162}
 }
 func (m *SdkIdentityCapabilitiesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkIdentityCapabilitiesResponse.Unmarshal(m, b)
@@ -11081,7 +11385,7 @@ func (m *SdkIdentityVersionRequest) Reset()         { *m = SdkIdentityVersionReq
 func (m *SdkIdentityVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkIdentityVersionRequest) ProtoMessage()    {}
 func (*SdkIdentityVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{159}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{163}
 }
 func (m *SdkIdentityVersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkIdentityVersionRequest.Unmarshal(m, b)
@@ -11116,7 +11420,7 @@ func (m *SdkIdentityVersionResponse) Reset()         { *m = SdkIdentityVersionRe
 func (m *SdkIdentityVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkIdentityVersionResponse) ProtoMessage()    {}
 func (*SdkIdentityVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{160}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{164}
 }
 func (m *SdkIdentityVersionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkIdentityVersionResponse.Unmarshal(m, b)
@@ -11167,7 +11471,7 @@ func (m *SdkServiceCapability) Reset()         { *m = SdkServiceCapability{} }
 func (m *SdkServiceCapability) String() string { return proto.CompactTextString(m) }
 func (*SdkServiceCapability) ProtoMessage()    {}
 func (*SdkServiceCapability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{161}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{165}
 }
 func (m *SdkServiceCapability) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkServiceCapability.Unmarshal(m, b)
@@ -11280,7 +11584,7 @@ func (m *SdkServiceCapability_OpenStorageService) Reset() {
 func (m *SdkServiceCapability_OpenStorageService) String() string { return proto.CompactTextString(m) }
 func (*SdkServiceCapability_OpenStorageService) ProtoMessage()    {}
 func (*SdkServiceCapability_OpenStorageService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{161, 0}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{165, 0}
 }
 func (m *SdkServiceCapability_OpenStorageService) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkServiceCapability_OpenStorageService.Unmarshal(m, b)
@@ -11329,7 +11633,7 @@ func (m *SdkVersion) Reset()         { *m = SdkVersion{} }
 func (m *SdkVersion) String() string { return proto.CompactTextString(m) }
 func (*SdkVersion) ProtoMessage()    {}
 func (*SdkVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{162}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{166}
 }
 func (m *SdkVersion) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVersion.Unmarshal(m, b)
@@ -11394,7 +11698,7 @@ func (m *StorageVersion) Reset()         { *m = StorageVersion{} }
 func (m *StorageVersion) String() string { return proto.CompactTextString(m) }
 func (*StorageVersion) ProtoMessage()    {}
 func (*StorageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{163}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{167}
 }
 func (m *StorageVersion) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageVersion.Unmarshal(m, b)
@@ -11445,7 +11749,7 @@ func (m *CloudMigrate) Reset()         { *m = CloudMigrate{} }
 func (m *CloudMigrate) String() string { return proto.CompactTextString(m) }
 func (*CloudMigrate) ProtoMessage()    {}
 func (*CloudMigrate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{164}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{168}
 }
 func (m *CloudMigrate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloudMigrate.Unmarshal(m, b)
@@ -11485,7 +11789,7 @@ func (m *CloudMigrateStartRequest) Reset()         { *m = CloudMigrateStartReque
 func (m *CloudMigrateStartRequest) String() string { return proto.CompactTextString(m) }
 func (*CloudMigrateStartRequest) ProtoMessage()    {}
 func (*CloudMigrateStartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{165}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{169}
 }
 func (m *CloudMigrateStartRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloudMigrateStartRequest.Unmarshal(m, b)
@@ -11554,7 +11858,7 @@ func (m *SdkCloudMigrateStartRequest) Reset()         { *m = SdkCloudMigrateStar
 func (m *SdkCloudMigrateStartRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudMigrateStartRequest) ProtoMessage()    {}
 func (*SdkCloudMigrateStartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{166}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{170}
 }
 func (m *SdkCloudMigrateStartRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudMigrateStartRequest.Unmarshal(m, b)
@@ -11741,7 +12045,7 @@ func (m *SdkCloudMigrateStartRequest_MigrateVolume) Reset() {
 func (m *SdkCloudMigrateStartRequest_MigrateVolume) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudMigrateStartRequest_MigrateVolume) ProtoMessage()    {}
 func (*SdkCloudMigrateStartRequest_MigrateVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{166, 0}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{170, 0}
 }
 func (m *SdkCloudMigrateStartRequest_MigrateVolume) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudMigrateStartRequest_MigrateVolume.Unmarshal(m, b)
@@ -11784,7 +12088,7 @@ func (m *SdkCloudMigrateStartRequest_MigrateVolumeGroup) String() string {
 }
 func (*SdkCloudMigrateStartRequest_MigrateVolumeGroup) ProtoMessage() {}
 func (*SdkCloudMigrateStartRequest_MigrateVolumeGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{166, 1}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{170, 1}
 }
 func (m *SdkCloudMigrateStartRequest_MigrateVolumeGroup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudMigrateStartRequest_MigrateVolumeGroup.Unmarshal(m, b)
@@ -11826,7 +12130,7 @@ func (m *SdkCloudMigrateStartRequest_MigrateAllVolumes) String() string {
 }
 func (*SdkCloudMigrateStartRequest_MigrateAllVolumes) ProtoMessage() {}
 func (*SdkCloudMigrateStartRequest_MigrateAllVolumes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{166, 2}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{170, 2}
 }
 func (m *SdkCloudMigrateStartRequest_MigrateAllVolumes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudMigrateStartRequest_MigrateAllVolumes.Unmarshal(m, b)
@@ -11859,7 +12163,7 @@ func (m *CloudMigrateStartResponse) Reset()         { *m = CloudMigrateStartResp
 func (m *CloudMigrateStartResponse) String() string { return proto.CompactTextString(m) }
 func (*CloudMigrateStartResponse) ProtoMessage()    {}
 func (*CloudMigrateStartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{167}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{171}
 }
 func (m *CloudMigrateStartResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloudMigrateStartResponse.Unmarshal(m, b)
@@ -11899,7 +12203,7 @@ func (m *SdkCloudMigrateStartResponse) Reset()         { *m = SdkCloudMigrateSta
 func (m *SdkCloudMigrateStartResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudMigrateStartResponse) ProtoMessage()    {}
 func (*SdkCloudMigrateStartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{168}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{172}
 }
 func (m *SdkCloudMigrateStartResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudMigrateStartResponse.Unmarshal(m, b)
@@ -11939,7 +12243,7 @@ func (m *CloudMigrateCancelRequest) Reset()         { *m = CloudMigrateCancelReq
 func (m *CloudMigrateCancelRequest) String() string { return proto.CompactTextString(m) }
 func (*CloudMigrateCancelRequest) ProtoMessage()    {}
 func (*CloudMigrateCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{169}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{173}
 }
 func (m *CloudMigrateCancelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloudMigrateCancelRequest.Unmarshal(m, b)
@@ -11979,7 +12283,7 @@ func (m *SdkCloudMigrateCancelRequest) Reset()         { *m = SdkCloudMigrateCan
 func (m *SdkCloudMigrateCancelRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudMigrateCancelRequest) ProtoMessage()    {}
 func (*SdkCloudMigrateCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{170}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{174}
 }
 func (m *SdkCloudMigrateCancelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudMigrateCancelRequest.Unmarshal(m, b)
@@ -12017,7 +12321,7 @@ func (m *SdkCloudMigrateCancelResponse) Reset()         { *m = SdkCloudMigrateCa
 func (m *SdkCloudMigrateCancelResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudMigrateCancelResponse) ProtoMessage()    {}
 func (*SdkCloudMigrateCancelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{171}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{175}
 }
 func (m *SdkCloudMigrateCancelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudMigrateCancelResponse.Unmarshal(m, b)
@@ -12077,7 +12381,7 @@ func (m *CloudMigrateInfo) Reset()         { *m = CloudMigrateInfo{} }
 func (m *CloudMigrateInfo) String() string { return proto.CompactTextString(m) }
 func (*CloudMigrateInfo) ProtoMessage()    {}
 func (*CloudMigrateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{172}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{176}
 }
 func (m *CloudMigrateInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloudMigrateInfo.Unmarshal(m, b)
@@ -12213,7 +12517,7 @@ func (m *CloudMigrateInfoList) Reset()         { *m = CloudMigrateInfoList{} }
 func (m *CloudMigrateInfoList) String() string { return proto.CompactTextString(m) }
 func (*CloudMigrateInfoList) ProtoMessage()    {}
 func (*CloudMigrateInfoList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{173}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{177}
 }
 func (m *CloudMigrateInfoList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloudMigrateInfoList.Unmarshal(m, b)
@@ -12251,7 +12555,7 @@ func (m *SdkCloudMigrateStatusRequest) Reset()         { *m = SdkCloudMigrateSta
 func (m *SdkCloudMigrateStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudMigrateStatusRequest) ProtoMessage()    {}
 func (*SdkCloudMigrateStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{174}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{178}
 }
 func (m *SdkCloudMigrateStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudMigrateStatusRequest.Unmarshal(m, b)
@@ -12284,7 +12588,7 @@ func (m *CloudMigrateStatusResponse) Reset()         { *m = CloudMigrateStatusRe
 func (m *CloudMigrateStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*CloudMigrateStatusResponse) ProtoMessage()    {}
 func (*CloudMigrateStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{175}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{179}
 }
 func (m *CloudMigrateStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloudMigrateStatusResponse.Unmarshal(m, b)
@@ -12324,7 +12628,7 @@ func (m *SdkCloudMigrateStatusResponse) Reset()         { *m = SdkCloudMigrateSt
 func (m *SdkCloudMigrateStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudMigrateStatusResponse) ProtoMessage()    {}
 func (*SdkCloudMigrateStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{176}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{180}
 }
 func (m *SdkCloudMigrateStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudMigrateStatusResponse.Unmarshal(m, b)
@@ -12370,7 +12674,7 @@ func (m *ClusterPairCreateRequest) Reset()         { *m = ClusterPairCreateReque
 func (m *ClusterPairCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairCreateRequest) ProtoMessage()    {}
 func (*ClusterPairCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{177}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{181}
 }
 func (m *ClusterPairCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairCreateRequest.Unmarshal(m, b)
@@ -12433,7 +12737,7 @@ func (m *ClusterPairCreateResponse) Reset()         { *m = ClusterPairCreateResp
 func (m *ClusterPairCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairCreateResponse) ProtoMessage()    {}
 func (*ClusterPairCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{178}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{182}
 }
 func (m *ClusterPairCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairCreateResponse.Unmarshal(m, b)
@@ -12479,7 +12783,7 @@ func (m *SdkClusterPairCreateRequest) Reset()         { *m = SdkClusterPairCreat
 func (m *SdkClusterPairCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairCreateRequest) ProtoMessage()    {}
 func (*SdkClusterPairCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{179}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{183}
 }
 func (m *SdkClusterPairCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairCreateRequest.Unmarshal(m, b)
@@ -12519,7 +12823,7 @@ func (m *SdkClusterPairCreateResponse) Reset()         { *m = SdkClusterPairCrea
 func (m *SdkClusterPairCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairCreateResponse) ProtoMessage()    {}
 func (*SdkClusterPairCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{180}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{184}
 }
 func (m *SdkClusterPairCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairCreateResponse.Unmarshal(m, b)
@@ -12561,7 +12865,7 @@ func (m *ClusterPairProcessRequest) Reset()         { *m = ClusterPairProcessReq
 func (m *ClusterPairProcessRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairProcessRequest) ProtoMessage()    {}
 func (*ClusterPairProcessRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{181}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{185}
 }
 func (m *ClusterPairProcessRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairProcessRequest.Unmarshal(m, b)
@@ -12615,7 +12919,7 @@ func (m *ClusterPairProcessResponse) Reset()         { *m = ClusterPairProcessRe
 func (m *ClusterPairProcessResponse) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairProcessResponse) ProtoMessage()    {}
 func (*ClusterPairProcessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{182}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{186}
 }
 func (m *ClusterPairProcessResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairProcessResponse.Unmarshal(m, b)
@@ -12676,7 +12980,7 @@ func (m *ClusterPairDeleteRequest) Reset()         { *m = ClusterPairDeleteReque
 func (m *ClusterPairDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairDeleteRequest) ProtoMessage()    {}
 func (*ClusterPairDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{183}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{187}
 }
 func (m *ClusterPairDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairDeleteRequest.Unmarshal(m, b)
@@ -12716,7 +13020,7 @@ func (m *SdkClusterPairDeleteRequest) Reset()         { *m = SdkClusterPairDelet
 func (m *SdkClusterPairDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairDeleteRequest) ProtoMessage()    {}
 func (*SdkClusterPairDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{184}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{188}
 }
 func (m *SdkClusterPairDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairDeleteRequest.Unmarshal(m, b)
@@ -12754,7 +13058,7 @@ func (m *SdkClusterPairDeleteResponse) Reset()         { *m = SdkClusterPairDele
 func (m *SdkClusterPairDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairDeleteResponse) ProtoMessage()    {}
 func (*SdkClusterPairDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{185}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{189}
 }
 func (m *SdkClusterPairDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairDeleteResponse.Unmarshal(m, b)
@@ -12787,7 +13091,7 @@ func (m *ClusterPairTokenGetRequest) Reset()         { *m = ClusterPairTokenGetR
 func (m *ClusterPairTokenGetRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairTokenGetRequest) ProtoMessage()    {}
 func (*ClusterPairTokenGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{186}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{190}
 }
 func (m *ClusterPairTokenGetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairTokenGetRequest.Unmarshal(m, b)
@@ -12827,7 +13131,7 @@ func (m *ClusterPairTokenGetResponse) Reset()         { *m = ClusterPairTokenGet
 func (m *ClusterPairTokenGetResponse) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairTokenGetResponse) ProtoMessage()    {}
 func (*ClusterPairTokenGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{187}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{191}
 }
 func (m *ClusterPairTokenGetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairTokenGetResponse.Unmarshal(m, b)
@@ -12865,7 +13169,7 @@ func (m *SdkClusterPairGetTokenRequest) Reset()         { *m = SdkClusterPairGet
 func (m *SdkClusterPairGetTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairGetTokenRequest) ProtoMessage()    {}
 func (*SdkClusterPairGetTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{188}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{192}
 }
 func (m *SdkClusterPairGetTokenRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairGetTokenRequest.Unmarshal(m, b)
@@ -12898,7 +13202,7 @@ func (m *SdkClusterPairGetTokenResponse) Reset()         { *m = SdkClusterPairGe
 func (m *SdkClusterPairGetTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairGetTokenResponse) ProtoMessage()    {}
 func (*SdkClusterPairGetTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{189}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{193}
 }
 func (m *SdkClusterPairGetTokenResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairGetTokenResponse.Unmarshal(m, b)
@@ -12936,7 +13240,7 @@ func (m *SdkClusterPairResetTokenRequest) Reset()         { *m = SdkClusterPairR
 func (m *SdkClusterPairResetTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairResetTokenRequest) ProtoMessage()    {}
 func (*SdkClusterPairResetTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{190}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{194}
 }
 func (m *SdkClusterPairResetTokenRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairResetTokenRequest.Unmarshal(m, b)
@@ -12969,7 +13273,7 @@ func (m *SdkClusterPairResetTokenResponse) Reset()         { *m = SdkClusterPair
 func (m *SdkClusterPairResetTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairResetTokenResponse) ProtoMessage()    {}
 func (*SdkClusterPairResetTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{191}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{195}
 }
 func (m *SdkClusterPairResetTokenResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairResetTokenResponse.Unmarshal(m, b)
@@ -13022,7 +13326,7 @@ func (m *ClusterPairInfo) Reset()         { *m = ClusterPairInfo{} }
 func (m *ClusterPairInfo) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairInfo) ProtoMessage()    {}
 func (*ClusterPairInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{192}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{196}
 }
 func (m *ClusterPairInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairInfo.Unmarshal(m, b)
@@ -13104,7 +13408,7 @@ func (m *ClusterPairGetRequest) Reset()         { *m = ClusterPairGetRequest{} }
 func (m *ClusterPairGetRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairGetRequest) ProtoMessage()    {}
 func (*ClusterPairGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{193}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{197}
 }
 func (m *ClusterPairGetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairGetRequest.Unmarshal(m, b)
@@ -13144,7 +13448,7 @@ func (m *SdkClusterPairInspectRequest) Reset()         { *m = SdkClusterPairInsp
 func (m *SdkClusterPairInspectRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairInspectRequest) ProtoMessage()    {}
 func (*SdkClusterPairInspectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{194}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{198}
 }
 func (m *SdkClusterPairInspectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairInspectRequest.Unmarshal(m, b)
@@ -13184,7 +13488,7 @@ func (m *ClusterPairGetResponse) Reset()         { *m = ClusterPairGetResponse{}
 func (m *ClusterPairGetResponse) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairGetResponse) ProtoMessage()    {}
 func (*ClusterPairGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{195}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{199}
 }
 func (m *ClusterPairGetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairGetResponse.Unmarshal(m, b)
@@ -13224,7 +13528,7 @@ func (m *SdkClusterPairInspectResponse) Reset()         { *m = SdkClusterPairIns
 func (m *SdkClusterPairInspectResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairInspectResponse) ProtoMessage()    {}
 func (*SdkClusterPairInspectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{196}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{200}
 }
 func (m *SdkClusterPairInspectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairInspectResponse.Unmarshal(m, b)
@@ -13262,7 +13566,7 @@ func (m *SdkClusterPairEnumerateRequest) Reset()         { *m = SdkClusterPairEn
 func (m *SdkClusterPairEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairEnumerateRequest) ProtoMessage()    {}
 func (*SdkClusterPairEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{197}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{201}
 }
 func (m *SdkClusterPairEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairEnumerateRequest.Unmarshal(m, b)
@@ -13297,7 +13601,7 @@ func (m *ClusterPairsEnumerateResponse) Reset()         { *m = ClusterPairsEnume
 func (m *ClusterPairsEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*ClusterPairsEnumerateResponse) ProtoMessage()    {}
 func (*ClusterPairsEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{198}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{202}
 }
 func (m *ClusterPairsEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterPairsEnumerateResponse.Unmarshal(m, b)
@@ -13344,7 +13648,7 @@ func (m *SdkClusterPairEnumerateResponse) Reset()         { *m = SdkClusterPairE
 func (m *SdkClusterPairEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterPairEnumerateResponse) ProtoMessage()    {}
 func (*SdkClusterPairEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{199}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{203}
 }
 func (m *SdkClusterPairEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterPairEnumerateResponse.Unmarshal(m, b)
@@ -13393,7 +13697,7 @@ func (m *Catalog) Reset()         { *m = Catalog{} }
 func (m *Catalog) String() string { return proto.CompactTextString(m) }
 func (*Catalog) ProtoMessage()    {}
 func (*Catalog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{200}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{204}
 }
 func (m *Catalog) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Catalog.Unmarshal(m, b)
@@ -13469,7 +13773,7 @@ func (m *Report) Reset()         { *m = Report{} }
 func (m *Report) String() string { return proto.CompactTextString(m) }
 func (*Report) ProtoMessage()    {}
 func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{201}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{205}
 }
 func (m *Report) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Report.Unmarshal(m, b)
@@ -13517,7 +13821,7 @@ func (m *CatalogResponse) Reset()         { *m = CatalogResponse{} }
 func (m *CatalogResponse) String() string { return proto.CompactTextString(m) }
 func (*CatalogResponse) ProtoMessage()    {}
 func (*CatalogResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{202}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{206}
 }
 func (m *CatalogResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CatalogResponse.Unmarshal(m, b)
@@ -13569,7 +13873,7 @@ func (m *LocateResponse) Reset()         { *m = LocateResponse{} }
 func (m *LocateResponse) String() string { return proto.CompactTextString(m) }
 func (*LocateResponse) ProtoMessage()    {}
 func (*LocateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{203}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{207}
 }
 func (m *LocateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocateResponse.Unmarshal(m, b)
@@ -13619,7 +13923,7 @@ func (m *VolumePlacementStrategy) Reset()         { *m = VolumePlacementStrategy
 func (m *VolumePlacementStrategy) String() string { return proto.CompactTextString(m) }
 func (*VolumePlacementStrategy) ProtoMessage()    {}
 func (*VolumePlacementStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{204}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{208}
 }
 func (m *VolumePlacementStrategy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumePlacementStrategy.Unmarshal(m, b)
@@ -13672,7 +13976,7 @@ func (m *VolumePlacementRule) Reset()         { *m = VolumePlacementRule{} }
 func (m *VolumePlacementRule) String() string { return proto.CompactTextString(m) }
 func (*VolumePlacementRule) ProtoMessage()    {}
 func (*VolumePlacementRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{205}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{209}
 }
 func (m *VolumePlacementRule) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumePlacementRule.Unmarshal(m, b)
@@ -13749,7 +14053,7 @@ func (m *LabelSelectorRequirement) Reset()         { *m = LabelSelectorRequireme
 func (m *LabelSelectorRequirement) String() string { return proto.CompactTextString(m) }
 func (*LabelSelectorRequirement) ProtoMessage()    {}
 func (*LabelSelectorRequirement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_7d6ac3825e3482d9, []int{206}
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{210}
 }
 func (m *LabelSelectorRequirement) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LabelSelectorRequirement.Unmarshal(m, b)
@@ -13790,6 +14094,205 @@ func (m *LabelSelectorRequirement) GetValues() []string {
 	return nil
 }
 
+// SdkErrorInfo is attached to the status of the errors of the SDK, for the
+// clients to tell the errors apart without parsing their messages.
+type SdkErrorInfo struct {
+	// Reason of the error, the name of its gRPC code such as NOT_FOUND
+	Reason string `protobuf:"bytes,1,opt,name=reason" json:"reason,omitempty"`
+	// Domain of the reason, openstorage.org
+	Domain string `protobuf:"bytes,2,opt,name=domain" json:"domain,omitempty"`
+	// Method is the full name of the gRPC method which failed
+	Method               string   `protobuf:"bytes,3,opt,name=method" json:"method,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkErrorInfo) Reset()         { *m = SdkErrorInfo{} }
+func (m *SdkErrorInfo) String() string { return proto.CompactTextString(m) }
+func (*SdkErrorInfo) ProtoMessage()    {}
+func (*SdkErrorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{211}
+}
+func (m *SdkErrorInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkErrorInfo.Unmarshal(m, b)
+}
+func (m *SdkErrorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkErrorInfo.Marshal(b, m, deterministic)
+}
+func (dst *SdkErrorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkErrorInfo.Merge(dst, src)
+}
+func (m *SdkErrorInfo) XXX_Size() int {
+	return xxx_messageInfo_SdkErrorInfo.Size(m)
+}
+func (m *SdkErrorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkErrorInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkErrorInfo proto.InternalMessageInfo
+
+func (m *SdkErrorInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SdkErrorInfo) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *SdkErrorInfo) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+// SdkFieldViolation describes an invalid field of a request.
+type SdkFieldViolation struct {
+	// Field is the name of the field of the request, such as volume_id
+	Field string `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"`
+	// Description of why the field is invalid
+	Description          string   `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkFieldViolation) Reset()         { *m = SdkFieldViolation{} }
+func (m *SdkFieldViolation) String() string { return proto.CompactTextString(m) }
+func (*SdkFieldViolation) ProtoMessage()    {}
+func (*SdkFieldViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{212}
+}
+func (m *SdkFieldViolation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkFieldViolation.Unmarshal(m, b)
+}
+func (m *SdkFieldViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkFieldViolation.Marshal(b, m, deterministic)
+}
+func (dst *SdkFieldViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkFieldViolation.Merge(dst, src)
+}
+func (m *SdkFieldViolation) XXX_Size() int {
+	return xxx_messageInfo_SdkFieldViolation.Size(m)
+}
+func (m *SdkFieldViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkFieldViolation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkFieldViolation proto.InternalMessageInfo
+
+func (m *SdkFieldViolation) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *SdkFieldViolation) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// SdkBadRequest is attached to the status of the errors with the
+// InvalidArgument code caused by invalid fields of the request.
+type SdkBadRequest struct {
+	// FieldViolations are the invalid fields of the request
+	FieldViolations      []*SdkFieldViolation `protobuf:"bytes,1,rep,name=field_violations,json=fieldViolations" json:"field_violations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SdkBadRequest) Reset()         { *m = SdkBadRequest{} }
+func (m *SdkBadRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkBadRequest) ProtoMessage()    {}
+func (*SdkBadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{213}
+}
+func (m *SdkBadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkBadRequest.Unmarshal(m, b)
+}
+func (m *SdkBadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkBadRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkBadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkBadRequest.Merge(dst, src)
+}
+func (m *SdkBadRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkBadRequest.Size(m)
+}
+func (m *SdkBadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkBadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkBadRequest proto.InternalMessageInfo
+
+func (m *SdkBadRequest) GetFieldViolations() []*SdkFieldViolation {
+	if m != nil {
+		return m.FieldViolations
+	}
+	return nil
+}
+
+// SdkResourceInfo is attached to the status of the errors with the NotFound
+// code, describing the resource which does not exist.
+type SdkResourceInfo struct {
+	// ResourceType is the type of the resource, such as volume or node
+	ResourceType string `protobuf:"bytes,1,opt,name=resource_type,json=resourceType" json:"resource_type,omitempty"`
+	// ResourceId is the id of the resource
+	ResourceId           string   `protobuf:"bytes,2,opt,name=resource_id,json=resourceId" json:"resource_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkResourceInfo) Reset()         { *m = SdkResourceInfo{} }
+func (m *SdkResourceInfo) String() string { return proto.CompactTextString(m) }
+func (*SdkResourceInfo) ProtoMessage()    {}
+func (*SdkResourceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_7d6ac3825e3482d9, []int{214}
+}
+func (m *SdkResourceInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkResourceInfo.Unmarshal(m, b)
+}
+func (m *SdkResourceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkResourceInfo.Marshal(b, m, deterministic)
+}
+func (dst *SdkResourceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkResourceInfo.Merge(dst, src)
+}
+func (m *SdkResourceInfo) XXX_Size() int {
+	return xxx_messageInfo_SdkResourceInfo.Size(m)
+}
+func (m *SdkResourceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkResourceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkResourceInfo proto.InternalMessageInfo
+
+func (m *SdkResourceInfo) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ""
+}
+
+func (m *SdkResourceInfo) GetResourceId() string {
+	if m != nil {
+		return m.ResourceId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StorageResource)(nil), "openstorage.api.StorageResource")
 	proto.RegisterType((*StoragePool)(nil), "openstorage.api.StoragePool")
@@ -13811,6 +14314,8 @@ func init() {
 	proto.RegisterType((*Stats)(nil), "openstorage.api.Stats")
 	proto.RegisterType((*CapacityUsageInfo)(nil), "openstorage.api.CapacityUsageInfo")
 	proto.RegisterType((*Alert)(nil), "openstorage.api.Alert")
+	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.Alert.PayloadEntry")
+	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.Alert.AnnotationsEntry")
 	proto.RegisterType((*SdkAlertsTimeSpan)(nil), "openstorage.api.SdkAlertsTimeSpan")
 	proto.RegisterType((*SdkAlertsCountSpan)(nil), "openstorage.api.SdkAlertsCountSpan")
 	proto.RegisterType((*SdkAlertsOption)(nil), "openstorage.api.SdkAlertsOption")
@@ -13822,6 +14327,10 @@ func init() {
 	proto.RegisterType((*SdkAlertsEnumerateResponse)(nil), "openstorage.api.SdkAlertsEnumerateResponse")
 	proto.RegisterType((*SdkAlertsDeleteRequest)(nil), "openstorage.api.SdkAlertsDeleteRequest")
 	proto.RegisterType((*SdkAlertsDeleteResponse)(nil), "openstorage.api.SdkAlertsDeleteResponse")
+	proto.RegisterType((*SdkAlertsRaiseRequest)(nil), "openstorage.api.SdkAlertsRaiseRequest")
+	proto.RegisterType((*SdkAlertsRaiseResponse)(nil), "openstorage.api.SdkAlertsRaiseResponse")
+	proto.RegisterType((*SdkAlertsWatchRequest)(nil), "openstorage.api.SdkAlertsWatchRequest")
+	proto.RegisterType((*SdkAlertsWatchResponse)(nil), "openstorage.api.SdkAlertsWatchResponse")
 	proto.RegisterType((*SdkSchedulePolicyCreateRequest)(nil), "openstorage.api.SdkSchedulePolicyCreateRequest")
 	proto.RegisterType((*Alerts)(nil), "openstorage.api.Alerts")
 	proto.RegisterType((*ObjectstoreInfo)(nil), "openstorage.api.ObjectstoreInfo")
@@ -13894,6 +14403,7 @@ func init() {
 	proto.RegisterType((*SdkVolumeCreateRequest)(nil), "openstorage.api.SdkVolumeCreateRequest")
 	proto.RegisterType((*SdkVolumeCreateResponse)(nil), "openstorage.api.SdkVolumeCreateResponse")
 	proto.RegisterType((*SdkVolumeCloneRequest)(nil), "openstorage.api.SdkVolumeCloneRequest")
+	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.SdkVolumeCloneRequest.LabelsEntry")
 	proto.RegisterType((*SdkVolumeCloneResponse)(nil), "openstorage.api.SdkVolumeCloneResponse")
 	proto.RegisterType((*SdkVolumeDeleteRequest)(nil), "openstorage.api.SdkVolumeDeleteRequest")
 	proto.RegisterType((*SdkVolumeDeleteResponse)(nil), "openstorage.api.SdkVolumeDeleteResponse")
@@ -14030,6 +14540,10 @@ func init() {
 	proto.RegisterType((*VolumePlacementStrategy)(nil), "openstorage.api.VolumePlacementStrategy")
 	proto.RegisterType((*VolumePlacementRule)(nil), "openstorage.api.VolumePlacementRule")
 	proto.RegisterType((*LabelSelectorRequirement)(nil), "openstorage.api.LabelSelectorRequirement")
+	proto.RegisterType((*SdkErrorInfo)(nil), "openstorage.api.SdkErrorInfo")
+	proto.RegisterType((*SdkFieldViolation)(nil), "openstorage.api.SdkFieldViolation")
+	proto.RegisterType((*SdkBadRequest)(nil), "openstorage.api.SdkBadRequest")
+	proto.RegisterType((*SdkResourceInfo)(nil), "openstorage.api.SdkResourceInfo")
 	proto.RegisterEnum("openstorage.api.Status", Status_name, Status_value)
 	proto.RegisterEnum("openstorage.api.DriverType", DriverType_name, DriverType_value)
 	proto.RegisterEnum("openstorage.api.FSType", FSType_name, FSType_value)
@@ -14046,6 +14560,7 @@ func init() {
 	proto.RegisterEnum("openstorage.api.ClusterNotify", ClusterNotify_name, ClusterNotify_value)
 	proto.RegisterEnum("openstorage.api.AttachState", AttachState_name, AttachState_value)
 	proto.RegisterEnum("openstorage.api.OperationFlags", OperationFlags_name, OperationFlags_value)
+	proto.RegisterEnum("openstorage.api.SdkAlertsWatchAction", SdkAlertsWatchAction_name, SdkAlertsWatchAction_value)
 	proto.RegisterEnum("openstorage.api.SdkTimeWeekday", SdkTimeWeekday_name, SdkTimeWeekday_value)
 	proto.RegisterEnum("openstorage.api.SdkCloudBackupOpType", SdkCloudBackupOpType_name, SdkCloudBackupOpType_value)
 	proto.RegisterEnum("openstorage.api.SdkCloudBackupStatusType", SdkCloudBackupStatusType_name, SdkCloudBackupStatusType_value)
@@ -14114,6 +14629,20 @@ type OpenStorageAlertsClient interface {
 	// SdkAlertsEnumerateRequest takes a list of such queries and all alerts
 	// that match at least one of the queries are deleted.
 	Delete(ctx context.Context, in *SdkAlertsDeleteRequest, opts ...grpc.CallOption) (*SdkAlertsDeleteResponse, error)
+	// Raise raises an alert.
+	//
+	// #### Raise
+	// The alert is stored, deduplicated with the stored alert of the same
+	// resource type, alert type and resource id if any, and delivered to
+	// the notifiers.
+	Raise(ctx context.Context, in *SdkAlertsRaiseRequest, opts ...grpc.CallOption) (*SdkAlertsRaiseResponse, error)
+	// Watch streams the changes of the alerts.
+	//
+	// #### Watch
+	// Watch takes the same queries as Enumerate and delivers the alerts
+	// matching any of them as they are raised, raised again or deleted,
+	// until the call is canceled.
+	Watch(ctx context.Context, in *SdkAlertsWatchRequest, opts ...grpc.CallOption) (OpenStorageAlerts_WatchClient, error)
 }
 
 type openStorageAlertsClient struct {
@@ -14165,6 +14694,47 @@ func (c *openStorageAlertsClient) Delete(ctx context.Context, in *SdkAlertsDelet
 	return out, nil
 }
 
+func (c *openStorageAlertsClient) Raise(ctx context.Context, in *SdkAlertsRaiseRequest, opts ...grpc.CallOption) (*SdkAlertsRaiseResponse, error) {
+	out := new(SdkAlertsRaiseResponse)
+	err := grpc.Invoke(ctx, "/openstorage.api.OpenStorageAlerts/Raise", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openStorageAlertsClient) Watch(ctx context.Context, in *SdkAlertsWatchRequest, opts ...grpc.CallOption) (OpenStorageAlerts_WatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_OpenStorageAlerts_serviceDesc.Streams[1], c.cc, "/openstorage.api.OpenStorageAlerts/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &openStorageAlertsWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OpenStorageAlerts_WatchClient interface {
+	Recv() (*SdkAlertsWatchResponse, error)
+	grpc.ClientStream
+}
+
+type openStorageAlertsWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *openStorageAlertsWatchClient) Recv() (*SdkAlertsWatchResponse, error) {
+	m := new(SdkAlertsWatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for OpenStorageAlerts service
 
 type OpenStorageAlertsServer interface {
@@ -14211,6 +14781,20 @@ type OpenStorageAlertsServer interface {
 	// SdkAlertsEnumerateRequest takes a list of such queries and all alerts
 	// that match at least one of the queries are deleted.
 	Delete(context.Context, *SdkAlertsDeleteRequest) (*SdkAlertsDeleteResponse, error)
+	// Raise raises an alert.
+	//
+	// #### Raise
+	// The alert is stored, deduplicated with the stored alert of the same
+	// resource type, alert type and resource id if any, and delivered to
+	// the notifiers.
+	Raise(context.Context, *SdkAlertsRaiseRequest) (*SdkAlertsRaiseResponse, error)
+	// Watch streams the changes of the alerts.
+	//
+	// #### Watch
+	// Watch takes the same queries as Enumerate and delivers the alerts
+	// matching any of them as they are raised, raised again or deleted,
+	// until the call is canceled.
+	Watch(*SdkAlertsWatchRequest, OpenStorageAlerts_WatchServer) error
 }
 
 func RegisterOpenStorageAlertsServer(s *grpc.Server, srv OpenStorageAlertsServer) {
@@ -14256,6 +14840,45 @@ func _OpenStorageAlerts_Delete_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenStorageAlerts_Raise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkAlertsRaiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageAlertsServer).Raise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageAlerts/Raise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageAlertsServer).Raise(ctx, req.(*SdkAlertsRaiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageAlerts_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SdkAlertsWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OpenStorageAlertsServer).Watch(m, &openStorageAlertsWatchServer{stream})
+}
+
+type OpenStorageAlerts_WatchServer interface {
+	Send(*SdkAlertsWatchResponse) error
+	grpc.ServerStream
+}
+
+type openStorageAlertsWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *openStorageAlertsWatchServer) Send(m *SdkAlertsWatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _OpenStorageAlerts_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openstorage.api.OpenStorageAlerts",
 	HandlerType: (*OpenStorageAlertsServer)(nil),
@@ -14264,6 +14887,10 @@ var _OpenStorageAlerts_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _OpenStorageAlerts_Delete_Handler,
 		},
+		{
+			MethodName: "Raise",
+			Handler:    _OpenStorageAlerts_Raise_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -14271,6 +14898,11 @@ var _OpenStorageAlerts_serviceDesc = grpc.ServiceDesc{
 			Handler:       _OpenStorageAlerts_Enumerate_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Watch",
+			Handler:       _OpenStorageAlerts_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/api.proto",
 }
@@ -16840,708 +17472,731 @@ var _OpenStorageCloudBackup_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api/api.proto", fileDescriptor_api_7d6ac3825e3482d9) }
 
 var fileDescriptor_api_7d6ac3825e3482d9 = []byte{
-	// 11245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0xbd, 0x6f, 0x6c, 0x24, 0xc9,
-	0x75, 0x18, 0xce, 0x9e, 0x21, 0x39, 0x33, 0x8f, 0xff, 0x9a, 0xb5, 0x7b, 0xdc, 0xd9, 0xd9, 0xff,
-	0xbd, 0xda, 0xdb, 0x5d, 0xde, 0x2e, 0xb9, 0x47, 0xdd, 0x9e, 0xee, 0xf6, 0xee, 0x24, 0x0d, 0xc9,
-	0xe1, 0x72, 0xb4, 0xfc, 0x77, 0x3d, 0xe4, 0xee, 0x9d, 0x64, 0x79, 0xd4, 0x3b, 0x53, 0x4b, 0xf6,
-	0xed, 0x70, 0x7a, 0xae, 0xbb, 0x87, 0x77, 0x3c, 0xe9, 0x24, 0xfd, 0x04, 0x18, 0xfe, 0xc5, 0x96,
-	0x2d, 0xdb, 0xb2, 0x1c, 0xc8, 0xca, 0x1f, 0x07, 0x81, 0x9d, 0x04, 0x8e, 0x80, 0x28, 0x01, 0x02,
-	0x24, 0x71, 0x62, 0x40, 0x1f, 0xe2, 0xc8, 0x09, 0x9c, 0x0f, 0x46, 0x3e, 0x05, 0x09, 0x60, 0x40,
-	0x08, 0x62, 0x04, 0x70, 0x00, 0x7f, 0x0b, 0x10, 0x20, 0x41, 0xbd, 0xaa, 0xee, 0xae, 0xea, 0xee,
-	0xf9, 0xc3, 0xbd, 0xbd, 0x7c, 0x21, 0xa7, 0xaa, 0x5e, 0xbd, 0x7a, 0xf5, 0xea, 0xd5, 0xab, 0xaa,
-	0x57, 0xaf, 0x5e, 0xc3, 0x94, 0xd5, 0xb1, 0x17, 0xad, 0x8e, 0xbd, 0xd0, 0x71, 0x1d, 0xdf, 0x21,
-	0x33, 0x4e, 0x87, 0xb6, 0x3d, 0xdf, 0x71, 0xad, 0x7d, 0xba, 0x60, 0x75, 0xec, 0xd2, 0xa5, 0x7d,
-	0xc7, 0xd9, 0x6f, 0xd1, 0x45, 0x2c, 0x7e, 0xdc, 0x7d, 0xb2, 0xe8, 0xdb, 0x87, 0xd4, 0xf3, 0xad,
-	0xc3, 0x0e, 0xaf, 0x51, 0x3a, 0x2f, 0x00, 0x10, 0x4f, 0xbb, 0xed, 0xf8, 0x96, 0x6f, 0x3b, 0x6d,
-	0x8f, 0x97, 0x1a, 0x7f, 0x3f, 0x0b, 0x33, 0x35, 0x8e, 0xce, 0xa4, 0x9e, 0xd3, 0x75, 0x1b, 0x94,
-	0x4c, 0x43, 0xc6, 0x6e, 0x16, 0xb5, 0xcb, 0xda, 0x8d, 0x82, 0x99, 0xb1, 0x9b, 0x84, 0xc0, 0x68,
-	0xc7, 0xf2, 0x0f, 0x8a, 0x19, 0xcc, 0xc1, 0xdf, 0xe4, 0x55, 0x18, 0x3f, 0xa4, 0x4d, 0xbb, 0x7b,
-	0x58, 0xcc, 0x5e, 0xd6, 0x6e, 0x4c, 0x2f, 0x5d, 0x5c, 0x88, 0x11, 0xb6, 0x20, 0xb0, 0x6e, 0x22,
-	0x94, 0x29, 0xa0, 0xc9, 0x1c, 0x8c, 0x3b, 0xed, 0x96, 0xdd, 0xa6, 0xc5, 0xd1, 0xcb, 0xda, 0x8d,
-	0xbc, 0x29, 0x52, 0xac, 0x0d, 0xdb, 0xe9, 0x78, 0xc5, 0xb1, 0xcb, 0xda, 0x8d, 0x51, 0x13, 0x7f,
-	0x93, 0x73, 0x50, 0xf0, 0xe8, 0xfb, 0xf5, 0x0f, 0x5c, 0xdb, 0xa7, 0xc5, 0xf1, 0xcb, 0xda, 0x0d,
-	0xcd, 0xcc, 0x7b, 0xf4, 0xfd, 0x47, 0x2c, 0x4d, 0xce, 0x02, 0xfb, 0x5d, 0x77, 0xa9, 0xd5, 0x2c,
-	0xe6, 0xb0, 0x2c, 0xe7, 0xd1, 0xf7, 0x4d, 0x6a, 0x35, 0x59, 0x1b, 0xae, 0xd5, 0x6e, 0x9a, 0x8f,
-	0x8a, 0x79, 0x2c, 0x10, 0x29, 0xd6, 0x86, 0x67, 0x7f, 0x44, 0x8b, 0x05, 0xde, 0x06, 0xfb, 0xcd,
-	0xf2, 0xba, 0x1e, 0x6d, 0x16, 0x81, 0xe7, 0xb1, 0xdf, 0xe4, 0x1a, 0x4c, 0xbb, 0x82, 0x4d, 0x75,
-	0xaf, 0x43, 0x69, 0xb3, 0x38, 0x81, 0x3d, 0x9f, 0x0a, 0x72, 0x6b, 0x2c, 0x93, 0x7c, 0x0e, 0x0a,
-	0x2d, 0xcb, 0xf3, 0xeb, 0x5e, 0xc3, 0x6a, 0x17, 0x27, 0x2f, 0x6b, 0x37, 0x26, 0x96, 0x4a, 0x0b,
-	0x9c, 0xd9, 0x0b, 0xc1, 0x68, 0x2c, 0xec, 0x06, 0xa3, 0x61, 0xe6, 0x19, 0x70, 0xad, 0x61, 0xb5,
-	0x49, 0x09, 0xf2, 0x87, 0xd4, 0xb7, 0x9a, 0x96, 0x6f, 0x15, 0xa7, 0x90, 0x0b, 0x61, 0x9a, 0x9c,
-	0x86, 0xb1, 0x86, 0xd5, 0x38, 0xa0, 0xc5, 0x69, 0x2c, 0xe0, 0x09, 0xe3, 0xcf, 0x32, 0x30, 0x21,
-	0xf8, 0xb9, 0xe3, 0x38, 0x2d, 0x36, 0x42, 0xd5, 0x55, 0x1c, 0xa1, 0x31, 0x33, 0x53, 0x5d, 0x25,
-	0xf3, 0x90, 0x5d, 0x71, 0x3c, 0x1c, 0xa0, 0xe9, 0xa5, 0x62, 0x62, 0x28, 0x56, 0x1c, 0x6f, 0xf7,
-	0xb8, 0x43, 0x4d, 0x06, 0xc4, 0x46, 0x6e, 0xf3, 0x44, 0x23, 0xc7, 0xff, 0x93, 0xf3, 0x50, 0x30,
-	0x2d, 0xbb, 0xb9, 0x41, 0x8f, 0x68, 0x0b, 0x07, 0xaf, 0x60, 0x46, 0x19, 0xac, 0x74, 0xd7, 0xf1,
-	0xad, 0x56, 0x8d, 0x31, 0x38, 0x87, 0xcc, 0x8c, 0x32, 0x18, 0x97, 0xf7, 0x18, 0x97, 0xf3, 0x9c,
-	0xcb, 0xec, 0x37, 0xf9, 0x22, 0x8c, 0xb7, 0xac, 0xc7, 0xb4, 0xe5, 0x15, 0x0b, 0x97, 0xb3, 0x37,
-	0x26, 0x96, 0x6e, 0xf4, 0xa2, 0x83, 0xf5, 0x78, 0x61, 0x03, 0x41, 0x2b, 0x6d, 0xdf, 0x3d, 0x36,
-	0x45, 0xbd, 0xd2, 0xeb, 0x30, 0x21, 0x65, 0x13, 0x1d, 0xb2, 0x4f, 0xe9, 0xb1, 0x90, 0x5b, 0xf6,
-	0x93, 0x31, 0xf3, 0xc8, 0x6a, 0x75, 0xa9, 0x90, 0x5c, 0x9e, 0xb8, 0x97, 0x79, 0x4d, 0x33, 0xfe,
-	0x95, 0x06, 0x53, 0x0f, 0x9d, 0x56, 0xf7, 0x90, 0x6e, 0x38, 0x0d, 0xcb, 0x77, 0x5c, 0x46, 0x62,
-	0xdb, 0x3a, 0xa4, 0xa2, 0x3a, 0xfe, 0x26, 0x7b, 0x30, 0x75, 0x84, 0x40, 0x75, 0x41, 0x69, 0x06,
-	0x29, 0xbd, 0x93, 0xa0, 0x54, 0x41, 0x15, 0xa4, 0x24, 0x8a, 0x27, 0x8f, 0xa4, 0xac, 0xd2, 0x17,
-	0x60, 0x36, 0x01, 0x72, 0x22, 0xea, 0x5f, 0x81, 0xf1, 0x1a, 0x9f, 0xaa, 0x73, 0x30, 0xde, 0xb1,
-	0x5c, 0xda, 0xf6, 0x45, 0x45, 0x91, 0x42, 0x51, 0x67, 0x82, 0x2b, 0xa6, 0x2c, 0xfb, 0x6d, 0x9c,
-	0x81, 0xb1, 0xfb, 0xae, 0xd3, 0xed, 0xc4, 0xe7, 0xb7, 0xb1, 0x0a, 0x50, 0x75, 0x6a, 0xbe, 0x6b,
-	0xf9, 0x74, 0xff, 0x98, 0x4d, 0x2c, 0xcb, 0x3b, 0x6e, 0x37, 0xea, 0xb6, 0x83, 0x30, 0x79, 0x33,
-	0x87, 0xe9, 0xaa, 0xc3, 0x26, 0x24, 0xb5, 0xdc, 0xd6, 0x71, 0xdd, 0x6a, 0x3c, 0x45, 0xd4, 0x79,
-	0x33, 0x8f, 0x19, 0xe5, 0xc6, 0x53, 0xe3, 0xbf, 0x16, 0x00, 0x78, 0xb7, 0x6a, 0x1d, 0xda, 0x60,
-	0x02, 0x41, 0x3b, 0x07, 0xf4, 0x90, 0xba, 0x56, 0x4b, 0xe0, 0x89, 0x32, 0xc2, 0xa9, 0x98, 0x91,
-	0xa6, 0xe2, 0x22, 0x8c, 0x3f, 0x71, 0xdc, 0x43, 0xcb, 0x17, 0x82, 0x79, 0x26, 0xc1, 0xe6, 0xb5,
-	0x1a, 0x8a, 0xb1, 0x00, 0x23, 0x17, 0x00, 0x1e, 0xb7, 0x9c, 0xc6, 0xd3, 0x3a, 0xa2, 0x62, 0x22,
-	0x99, 0x35, 0x0b, 0x98, 0x83, 0x42, 0x77, 0x16, 0xf2, 0x07, 0x56, 0xbd, 0x85, 0xf2, 0x3a, 0x86,
-	0x85, 0xb9, 0x03, 0x8b, 0x4b, 0xeb, 0x3c, 0x64, 0x1b, 0x8e, 0x87, 0x3a, 0xa5, 0xef, 0x7c, 0x69,
-	0x38, 0x1e, 0x79, 0x1d, 0xc0, 0x76, 0xea, 0x1d, 0xd7, 0x79, 0x62, 0xb7, 0xb8, 0x68, 0x4f, 0x2f,
-	0x95, 0x12, 0x55, 0xaa, 0xce, 0x0e, 0x87, 0x30, 0x0b, 0x76, 0xf0, 0x93, 0x8d, 0x4e, 0x93, 0x36,
-	0xbb, 0x1d, 0x8a, 0x82, 0x9f, 0x37, 0x45, 0x8a, 0xbc, 0x04, 0xb3, 0x5e, 0xdb, 0xea, 0x78, 0x07,
-	0x8e, 0x5f, 0xb7, 0xdb, 0x3e, 0x75, 0x8f, 0xac, 0x16, 0x6a, 0xa5, 0x29, 0x53, 0x0f, 0x0a, 0xaa,
-	0x22, 0x9f, 0x98, 0x71, 0x21, 0x04, 0x14, 0xc2, 0xdb, 0x3d, 0x84, 0x90, 0x31, 0x7f, 0x90, 0x04,
-	0x32, 0xc2, 0xbc, 0x03, 0xcb, 0x15, 0x9a, 0x2d, 0x6f, 0x8a, 0x14, 0x79, 0x13, 0x26, 0x5c, 0xda,
-	0x69, 0xd9, 0x0d, 0xab, 0xee, 0x51, 0x5f, 0x28, 0xb5, 0x73, 0x89, 0x96, 0x4c, 0x0e, 0x53, 0xa3,
-	0xbe, 0x09, 0x6e, 0xf8, 0x9b, 0x75, 0xcb, 0xda, 0xdf, 0x77, 0xe9, 0x3e, 0x57, 0x9d, 0x9c, 0xf3,
-	0x53, 0xbc, 0x5b, 0x52, 0x41, 0xa8, 0x30, 0x68, 0xbb, 0xe1, 0x1e, 0x77, 0x7c, 0xda, 0x14, 0xca,
-	0x2e, 0xca, 0x20, 0x17, 0x01, 0x3a, 0x96, 0xe7, 0x75, 0x0e, 0x5c, 0xcb, 0xa3, 0xc5, 0x19, 0x14,
-	0x55, 0x29, 0x47, 0xe1, 0xa0, 0xd7, 0x38, 0xa0, 0xcd, 0x6e, 0x8b, 0x16, 0x75, 0x04, 0x0b, 0x39,
-	0x58, 0x13, 0xf9, 0x6c, 0x22, 0x79, 0x0d, 0xab, 0x45, 0x8b, 0xb3, 0x48, 0x0b, 0x4f, 0x20, 0x0f,
-	0x7c, 0xbb, 0xf1, 0xf4, 0xb8, 0x48, 0x04, 0x0f, 0x30, 0x45, 0x6e, 0xc1, 0xd8, 0x3e, 0x9b, 0x26,
-	0xc5, 0x17, 0xb0, 0xf7, 0x73, 0x89, 0xde, 0xe3, 0x24, 0x32, 0x39, 0x10, 0x5b, 0x2b, 0xf0, 0x47,
-	0x9d, 0xb6, 0x9f, 0x38, 0x6e, 0x83, 0x36, 0x8b, 0x73, 0x88, 0x6d, 0x0a, 0x73, 0x2b, 0x22, 0x93,
-	0xf5, 0xa7, 0xe1, 0x1c, 0x76, 0x5c, 0xea, 0x31, 0x35, 0x78, 0x06, 0x41, 0xa4, 0x1c, 0xb6, 0x24,
-	0x34, 0x2c, 0xaf, 0x61, 0x35, 0x69, 0xb3, 0x58, 0xe4, 0x13, 0x2b, 0x48, 0x93, 0x22, 0xe4, 0xde,
-	0x73, 0xba, 0x6e, 0xdb, 0x6a, 0x15, 0xcf, 0xf2, 0xf9, 0x28, 0x92, 0xac, 0x16, 0x1f, 0xb8, 0xa3,
-	0x57, 0x8a, 0x25, 0x5e, 0x2b, 0x48, 0x93, 0x4b, 0x30, 0xf1, 0x7e, 0x97, 0x76, 0x69, 0xbd, 0x49,
-	0x3b, 0xfe, 0x41, 0xf1, 0x1c, 0x76, 0x1d, 0x30, 0x6b, 0x95, 0xe5, 0x90, 0xd7, 0xe1, 0x2c, 0x12,
-	0x57, 0xef, 0xb6, 0xbd, 0x6e, 0xa7, 0xe3, 0xb8, 0x3e, 0x6d, 0xd6, 0x9f, 0x78, 0x75, 0xff, 0xb8,
-	0x43, 0x8b, 0xe7, 0x11, 0xdb, 0x1c, 0x02, 0xec, 0x45, 0xe5, 0x6b, 0x38, 0x2f, 0xd8, 0xd8, 0xb5,
-	0x9d, 0xa6, 0xed, 0x35, 0x2c, 0xb7, 0x59, 0xbc, 0xc0, 0xc7, 0x2e, 0xcc, 0x60, 0x42, 0x64, 0x3b,
-	0x75, 0x4f, 0xe8, 0x93, 0xe2, 0xc5, 0x1e, 0x42, 0x14, 0xa9, 0x1c, 0x13, 0xec, 0x48, 0xfd, 0x3c,
-	0x02, 0xd2, 0x69, 0x59, 0x0d, 0x7a, 0x48, 0xdb, 0x7e, 0x84, 0xe4, 0x12, 0x22, 0xb9, 0xd1, 0x43,
-	0xe6, 0x77, 0x82, 0x0a, 0x21, 0xc6, 0xd9, 0x4e, 0x3c, 0xeb, 0x93, 0x6b, 0xdd, 0xff, 0x99, 0x03,
-	0x3d, 0x9a, 0x63, 0x7b, 0x9d, 0xa6, 0xe5, 0x33, 0xd9, 0x92, 0x14, 0xd9, 0xfa, 0x88, 0x50, 0x65,
-	0xe7, 0xe2, 0xaa, 0x67, 0x5d, 0x8b, 0x94, 0xcf, 0xad, 0xa1, 0x94, 0xcf, 0x7a, 0x86, 0xab, 0x9f,
-	0x37, 0x4e, 0xa6, 0x7e, 0xd6, 0xb3, 0xb2, 0x02, 0x2a, 0xaa, 0x0a, 0x68, 0x7d, 0x34, 0x54, 0x41,
-	0xb7, 0x7b, 0xaa, 0xa0, 0xf5, 0xb1, 0x14, 0x25, 0xf4, 0x4e, 0xba, 0x12, 0xfa, 0x6c, 0x1f, 0x25,
-	0xc4, 0x19, 0x34, 0x50, 0x15, 0x15, 0x55, 0x55, 0xb4, 0x3e, 0xfe, 0x9c, 0x94, 0xd1, 0xe5, 0xa4,
-	0x06, 0x59, 0xcf, 0x29, 0x3a, 0xe4, 0x76, 0x4f, 0x1d, 0xb2, 0x9e, 0x4f, 0xd1, 0x22, 0x73, 0x8a,
-	0x16, 0x59, 0x2f, 0x04, 0x7a, 0xa4, 0xa8, 0xea, 0x91, 0x75, 0x08, 0x35, 0xc9, 0x42, 0xa0, 0x49,
-	0x4e, 0xf5, 0xd3, 0x24, 0xeb, 0x13, 0x81, 0x2e, 0x29, 0x45, 0x13, 0x1d, 0x35, 0xc4, 0xfa, 0x64,
-	0x34, 0xd5, 0xcf, 0x4b, 0x53, 0x1d, 0x15, 0xc4, 0xfa, 0x94, 0x34, 0xd9, 0xaf, 0xa8, 0x93, 0xfd,
-	0x2c, 0x52, 0x38, 0x2d, 0x4f, 0xf7, 0x4f, 0x2c, 0xfe, 0xcb, 0x00, 0x79, 0x26, 0xdb, 0x75, 0xa7,
-	0xe3, 0x2f, 0x4f, 0xc3, 0x64, 0x20, 0xdf, 0x98, 0x2e, 0x40, 0xae, 0xe1, 0x78, 0xf8, 0x53, 0x87,
-	0xe9, 0x48, 0x5e, 0x31, 0x67, 0x12, 0x80, 0x0b, 0x1d, 0xa6, 0xce, 0xc0, 0x0b, 0x09, 0xc1, 0x0b,
-	0xc0, 0x78, 0x7f, 0x02, 0x34, 0xd1, 0x50, 0x25, 0x2a, 0x06, 0xc3, 0x85, 0x05, 0x13, 0x50, 0xc0,
-	0x91, 0x08, 0xb1, 0x20, 0xf7, 0x83, 0x22, 0xae, 0x9d, 0x59, 0x62, 0x0a, 0x26, 0x04, 0x37, 0x83,
-	0x3e, 0x04, 0xfc, 0xc3, 0xf4, 0x2c, 0xcc, 0x48, 0x3c, 0x64, 0x59, 0x86, 0x01, 0x10, 0x49, 0x17,
-	0x63, 0x4d, 0xdb, 0x69, 0x52, 0xaf, 0xa8, 0x5d, 0xce, 0x32, 0xd6, 0x60, 0xc2, 0xf8, 0x43, 0x0d,
-	0x66, 0xcc, 0x6e, 0x9b, 0x9d, 0xba, 0x6a, 0xbe, 0xe5, 0xd3, 0x4d, 0xab, 0x43, 0x1e, 0xc1, 0x94,
-	0xcb, 0xb3, 0xea, 0x1e, 0xcb, 0xc3, 0x1a, 0x13, 0x4b, 0x4b, 0x49, 0xd9, 0x55, 0x2b, 0x2a, 0x69,
-	0x31, 0x59, 0x5c, 0x29, 0x8b, 0x0d, 0x62, 0x02, 0xe4, 0x44, 0x3a, 0xec, 0x37, 0x0b, 0x30, 0xce,
-	0xc5, 0x20, 0x71, 0xca, 0x5b, 0x84, 0x71, 0x7e, 0xfe, 0xc3, 0x5a, 0x13, 0x29, 0xdb, 0x2f, 0xbe,
-	0xe7, 0x34, 0x05, 0x58, 0xb4, 0x50, 0x66, 0x87, 0x59, 0x28, 0x4b, 0x90, 0x67, 0x67, 0x35, 0xa7,
-	0xdd, 0x3a, 0x16, 0x47, 0xbf, 0x30, 0x4d, 0x5e, 0x83, 0x5c, 0x8b, 0xef, 0x9d, 0x51, 0x5b, 0x4e,
-	0xa4, 0x9c, 0x49, 0x94, 0x1d, 0xb6, 0x19, 0x80, 0x93, 0x3b, 0x30, 0xd6, 0x60, 0xec, 0x40, 0x6d,
-	0xda, 0xff, 0xfc, 0xc5, 0x01, 0xc9, 0x22, 0x8c, 0x7a, 0x1d, 0xda, 0x40, 0x4d, 0x9a, 0xa6, 0x4e,
-	0x22, 0x05, 0x66, 0x22, 0x20, 0x63, 0x66, 0xd7, 0xb3, 0xf6, 0xa9, 0x38, 0xbc, 0xf0, 0x84, 0x7a,
-	0xf8, 0x2b, 0x9c, 0xe0, 0xf0, 0x17, 0xed, 0x72, 0x61, 0xb8, 0x5d, 0xee, 0x5d, 0xa6, 0x5f, 0x2c,
-	0xbf, 0xeb, 0xa1, 0x82, 0x9c, 0x5e, 0xba, 0xd0, 0x8b, 0x64, 0x04, 0x32, 0x05, 0x30, 0x59, 0x82,
-	0x31, 0x2e, 0x7b, 0x93, 0x58, 0xeb, 0x7c, 0x9f, 0x5a, 0xd4, 0xe4, 0xa0, 0x6c, 0xcf, 0x60, 0xf9,
-	0x3e, 0x3b, 0x71, 0x36, 0xeb, 0x4e, 0x1b, 0xb7, 0x6e, 0x05, 0x13, 0x82, 0xac, 0xed, 0x36, 0x59,
-	0x81, 0xe9, 0x10, 0x80, 0x63, 0x9f, 0xee, 0x81, 0xbd, 0x8c, 0x60, 0x1c, 0xfb, 0x54, 0x50, 0xa7,
-	0x16, 0xb4, 0xd2, 0xa4, 0x47, 0x76, 0x83, 0xd6, 0xd1, 0xaa, 0x20, 0x36, 0x77, 0x3c, 0x6b, 0xc7,
-	0xf2, 0x0f, 0xc8, 0x2d, 0x20, 0x1e, 0x6d, 0x74, 0x5d, 0x36, 0x15, 0x23, 0xb8, 0x60, 0x77, 0x87,
-	0x25, 0xab, 0x11, 0x74, 0x48, 0x34, 0x07, 0x9b, 0xc5, 0xc9, 0x29, 0x88, 0x46, 0x80, 0xf5, 0x10,
-	0xc0, 0x6e, 0x3f, 0x71, 0x8a, 0x04, 0xe7, 0xe2, 0xf5, 0x1e, 0xfc, 0x10, 0x84, 0x57, 0xdb, 0x4f,
-	0x1c, 0x3e, 0x01, 0x05, 0x26, 0x96, 0x41, 0x3e, 0x0f, 0x93, 0xd2, 0x8a, 0xe4, 0x15, 0x4f, 0x21,
-	0xaa, 0xbe, 0x4b, 0xd2, 0x44, 0xb4, 0x24, 0x79, 0xa4, 0x12, 0xd7, 0x0b, 0xa7, 0x11, 0xc1, 0xe5,
-	0x41, 0x7a, 0x41, 0xd5, 0x02, 0x4c, 0x22, 0xa9, 0xeb, 0x3a, 0x2e, 0xee, 0x50, 0x0b, 0x26, 0x4f,
-	0x90, 0x2f, 0x81, 0x2e, 0x96, 0xe8, 0x86, 0xd3, 0xf6, 0xba, 0x87, 0xd4, 0xf5, 0x8a, 0x73, 0x88,
-	0xff, 0x52, 0x8f, 0xbe, 0xae, 0x08, 0x38, 0x73, 0xe6, 0x48, 0x49, 0x7b, 0x6c, 0x04, 0x9e, 0x78,
-	0x75, 0x97, 0xa2, 0xc2, 0x77, 0xe9, 0xfb, 0x5d, 0xdb, 0x0d, 0xb7, 0xad, 0xfa, 0x13, 0xcf, 0xc4,
-	0x02, 0x53, 0xe4, 0x97, 0xde, 0x82, 0x99, 0x18, 0xd7, 0x4e, 0xa4, 0x93, 0xfe, 0x6e, 0x06, 0xc6,
-	0x58, 0xc7, 0x3c, 0x06, 0xc3, 0x74, 0x82, 0x87, 0xf5, 0x46, 0x4d, 0x9e, 0x20, 0x67, 0x20, 0xc7,
-	0x7e, 0xd4, 0x0f, 0x3d, 0x71, 0x5c, 0x1c, 0x67, 0xc9, 0x4d, 0x8f, 0x9d, 0xff, 0xb0, 0xe0, 0xf1,
-	0xb1, 0x4f, 0x3d, 0xd4, 0x42, 0xa3, 0x66, 0x81, 0xe5, 0x2c, 0xb3, 0x0c, 0xb6, 0xc1, 0x47, 0xd3,
-	0x91, 0x87, 0xfa, 0x66, 0xd4, 0x14, 0x29, 0x76, 0x2e, 0xc4, 0x5f, 0x0c, 0x21, 0x37, 0x37, 0xe5,
-	0x30, 0xbd, 0xe9, 0x31, 0x59, 0xe2, 0x45, 0x1c, 0xe5, 0x38, 0x96, 0x02, 0x66, 0x71, 0x9c, 0x97,
-	0x70, 0x6f, 0xdb, 0x71, 0x9d, 0x7d, 0xb6, 0x71, 0x17, 0x86, 0x0e, 0xc0, 0x0d, 0x17, 0xe6, 0x90,
-	0x53, 0x30, 0x66, 0x3b, 0x0c, 0x73, 0x3e, 0x30, 0x64, 0x71, 0x42, 0x11, 0x61, 0x1d, 0x4d, 0x4d,
-	0xdc, 0xfc, 0x54, 0xc0, 0x1c, 0xb4, 0x84, 0x30, 0xa4, 0xc1, 0x4a, 0x78, 0xe8, 0x09, 0x53, 0x14,
-	0x04, 0x59, 0x9b, 0x9e, 0xf1, 0x6d, 0x0d, 0x66, 0x57, 0xac, 0x8e, 0xd5, 0xb0, 0xfd, 0xe3, 0x3d,
-	0xa6, 0x7e, 0x50, 0x1a, 0xaf, 0xc3, 0x0c, 0xfd, 0xb0, 0xd1, 0xea, 0x7a, 0xf6, 0x51, 0x40, 0xb0,
-	0x86, 0xc7, 0xdc, 0xe9, 0x30, 0x9b, 0x13, 0x7d, 0x25, 0x58, 0xe9, 0x04, 0x54, 0x06, 0xa1, 0x26,
-	0x78, 0x5e, 0xd8, 0x2f, 0xdf, 0xf1, 0xad, 0x96, 0xc4, 0xcb, 0xac, 0x09, 0x98, 0x85, 0x00, 0xc6,
-	0xbf, 0xce, 0xc2, 0x58, 0xb9, 0x45, 0x5d, 0x5f, 0x5a, 0x37, 0xb2, 0xb8, 0x6e, 0xbc, 0x0e, 0x79,
-	0x8f, 0x1e, 0x51, 0xd7, 0xf6, 0x8f, 0x85, 0x01, 0x2a, 0xa9, 0xa1, 0x6a, 0x02, 0x00, 0x15, 0x5b,
-	0x08, 0xce, 0xf8, 0x62, 0x31, 0x9c, 0xfc, 0xcc, 0xc1, 0x1b, 0x2d, 0x60, 0x0e, 0x1e, 0x33, 0x8a,
-	0x90, 0x3b, 0xa4, 0x1e, 0xea, 0x5e, 0x6e, 0x6f, 0x0a, 0x92, 0xe4, 0x35, 0x28, 0x84, 0x66, 0x4e,
-	0xb1, 0x64, 0xf4, 0xd3, 0xbe, 0x11, 0x30, 0xeb, 0xa8, 0x2b, 0xec, 0x9c, 0x75, 0xbb, 0x89, 0x23,
-	0x5c, 0x60, 0xfb, 0x46, 0x9e, 0x55, 0xc5, 0xee, 0x04, 0x29, 0xb1, 0xdb, 0xbe, 0x90, 0x32, 0xbf,
-	0x39, 0x00, 0xef, 0x4e, 0x00, 0xce, 0xe8, 0x6d, 0xb4, 0x28, 0xee, 0x65, 0xf9, 0x79, 0x3f, 0x48,
-	0xb2, 0xe9, 0xe0, 0xfb, 0x2d, 0x31, 0xf2, 0xec, 0x27, 0xeb, 0x7a, 0xb7, 0x6d, 0xbf, 0xdf, 0xa5,
-	0x75, 0xdf, 0xda, 0xc7, 0x21, 0x2f, 0x98, 0x05, 0x9e, 0xb3, 0x6b, 0xed, 0xa3, 0x19, 0xd0, 0xe9,
-	0xb6, 0x7d, 0xd4, 0xf9, 0x59, 0x93, 0x27, 0xc8, 0xeb, 0x00, 0x4f, 0x6c, 0x97, 0xad, 0x3a, 0x94,
-	0x0e, 0x63, 0x72, 0x2c, 0x20, 0x74, 0x8d, 0xd2, 0xb6, 0xf1, 0x4b, 0x1a, 0xcc, 0xd6, 0x9a, 0x4f,
-	0x71, 0x08, 0x3d, 0x06, 0x51, 0xeb, 0x58, 0x6d, 0x86, 0xd0, 0xf3, 0x2d, 0x36, 0x00, 0xb6, 0x30,
-	0x7d, 0x0d, 0x40, 0x88, 0xd0, 0x2c, 0x4d, 0xee, 0x42, 0x9e, 0xb6, 0x9b, 0xbc, 0x62, 0x66, 0x60,
-	0xc5, 0x1c, 0x6d, 0x37, 0x59, 0xca, 0xd8, 0x02, 0x12, 0x92, 0xb1, 0xc2, 0x3a, 0x85, 0x74, 0x9c,
-	0x83, 0xc2, 0xa1, 0xdd, 0xae, 0xf3, 0x2e, 0x73, 0xd1, 0xca, 0x1f, 0xda, 0x6d, 0x04, 0xc0, 0x42,
-	0xeb, 0x43, 0x51, 0x98, 0x11, 0x85, 0xd6, 0x87, 0x58, 0x68, 0x7c, 0x2f, 0x03, 0x33, 0x21, 0xc2,
-	0xed, 0x8e, 0x6f, 0x3b, 0x6d, 0xf2, 0x00, 0x66, 0x19, 0xb6, 0x40, 0xcc, 0xb8, 0x74, 0x69, 0x43,
-	0x88, 0xe6, 0xfa, 0x88, 0x39, 0x73, 0x68, 0xb7, 0xe5, 0x2c, 0x72, 0x09, 0xc0, 0xf6, 0xea, 0xc1,
-	0xb8, 0xa2, 0xd1, 0x6b, 0x7d, 0xc4, 0x2c, 0xd8, 0xde, 0x8a, 0x18, 0xdb, 0x32, 0x97, 0xc5, 0xba,
-	0xd7, 0xb1, 0xda, 0x62, 0x2b, 0x64, 0x24, 0x5b, 0x89, 0xb3, 0x7e, 0x7d, 0xc4, 0xcc, 0xfb, 0xc1,
-	0x30, 0xac, 0x02, 0x60, 0xef, 0x38, 0x8e, 0x51, 0xc4, 0x71, 0xb5, 0x37, 0x8e, 0x90, 0x6f, 0x8c,
-	0x90, 0x46, 0x90, 0x58, 0x1e, 0x83, 0x2c, 0xdb, 0xb4, 0x7e, 0x0d, 0x4a, 0x21, 0xa4, 0x2c, 0xa8,
-	0x6f, 0x77, 0xa9, 0x7b, 0x4c, 0x96, 0x61, 0x2a, 0x94, 0xff, 0xbe, 0x7c, 0x51, 0x64, 0x7c, 0xd2,
-	0x95, 0x52, 0xc6, 0x37, 0xe0, 0x4c, 0xd8, 0x42, 0x39, 0x98, 0xad, 0xcf, 0x0d, 0x7d, 0x4c, 0x2b,
-	0x64, 0x62, 0x5a, 0xc1, 0xf8, 0x3b, 0x1a, 0x14, 0x13, 0x1d, 0xac, 0x36, 0xff, 0x5f, 0xb5, 0x1f,
-	0xd7, 0x20, 0xd9, 0xb8, 0x06, 0x31, 0xfe, 0x4b, 0x06, 0xa6, 0x43, 0x02, 0x39, 0x59, 0x5f, 0x85,
-	0x53, 0x0a, 0x59, 0xf5, 0xf7, 0x59, 0xb6, 0x98, 0x70, 0x2f, 0xf5, 0x1e, 0xe9, 0xc4, 0xf8, 0xad,
-	0x8f, 0x98, 0xb3, 0x6e, 0x62, 0x50, 0x77, 0x41, 0x8f, 0x28, 0x16, 0xb8, 0x33, 0x3d, 0x2c, 0x26,
-	0x3d, 0x46, 0x6e, 0x7d, 0xc4, 0x9c, 0xb6, 0xd4, 0xb1, 0x7c, 0x04, 0xb3, 0x52, 0x47, 0x05, 0x5a,
-	0x2e, 0xe0, 0x37, 0x07, 0x93, 0x2c, 0x46, 0x84, 0x4d, 0x29, 0x37, 0x36, 0x48, 0xaf, 0xc0, 0xa8,
-	0xd3, 0xf1, 0xd9, 0xb2, 0x9c, 0xbe, 0xfb, 0x89, 0xcd, 0x67, 0x13, 0xa1, 0x97, 0x73, 0x30, 0x86,
-	0x24, 0x18, 0x0f, 0xe1, 0x6c, 0x08, 0x51, 0x69, 0xb3, 0x0d, 0x0b, 0xdb, 0x64, 0xd2, 0xf7, 0xbb,
-	0xd4, 0x63, 0x2a, 0x32, 0xc7, 0xa0, 0x6c, 0x71, 0x4c, 0x4b, 0xdb, 0xfc, 0xa8, 0x63, 0x63, 0x06,
-	0xf0, 0xc6, 0x86, 0x34, 0x71, 0x24, 0xbc, 0x5e, 0xc7, 0x69, 0x7b, 0x94, 0x2c, 0xc0, 0x38, 0xf2,
-	0x27, 0xc0, 0x9b, 0x3c, 0xee, 0x60, 0x4d, 0x53, 0x40, 0x19, 0x35, 0x98, 0x0b, 0xb1, 0xad, 0xd2,
-	0x16, 0x7d, 0x2e, 0x24, 0x9e, 0x95, 0x66, 0x5e, 0x80, 0x94, 0xd3, 0x67, 0x1c, 0xc2, 0xc5, 0x5a,
-	0xf3, 0x69, 0x60, 0xad, 0xd8, 0x71, 0x5a, 0x76, 0xe3, 0x78, 0xc5, 0xa5, 0x12, 0x6b, 0x1e, 0xc0,
-	0x4c, 0x78, 0x6e, 0xee, 0x60, 0xb9, 0x10, 0xc0, 0x54, 0x75, 0xa5, 0x62, 0x32, 0xa7, 0x3d, 0x25,
-	0x6d, 0xbc, 0x0a, 0xe3, 0x9c, 0x0c, 0x76, 0x0c, 0xc4, 0x2e, 0x0f, 0xe0, 0x0b, 0x07, 0x32, 0xfe,
-	0x43, 0x06, 0x66, 0xb6, 0x1f, 0xbf, 0x47, 0x1b, 0x3e, 0x03, 0xe1, 0x1b, 0x19, 0x02, 0xa3, 0xdd,
-	0x6e, 0x78, 0x16, 0xc5, 0xdf, 0x4c, 0xe9, 0x8b, 0xdd, 0xac, 0x1d, 0xdc, 0x62, 0xe4, 0x79, 0x46,
-	0x15, 0x2d, 0xa2, 0xb4, 0x6d, 0x3d, 0x6e, 0x51, 0x3e, 0xfd, 0xf2, 0x66, 0x90, 0xe4, 0x46, 0x5d,
-	0x3c, 0x2c, 0xf1, 0x1d, 0x43, 0x70, 0x1a, 0x9a, 0x83, 0x71, 0xab, 0xc1, 0x84, 0x49, 0xdc, 0x04,
-	0x88, 0x14, 0xce, 0xf5, 0x46, 0x83, 0x7a, 0x5e, 0x9d, 0x6d, 0x57, 0xf9, 0x6e, 0xa0, 0xc0, 0x73,
-	0x1e, 0x50, 0xdc, 0xa0, 0x78, 0xb4, 0xe1, 0x52, 0x1f, 0x8b, 0x73, 0xbc, 0x98, 0xe7, 0xb0, 0x62,
-	0xb4, 0x61, 0x37, 0x3b, 0x8e, 0xdd, 0xf6, 0xd9, 0x86, 0x8f, 0x1d, 0x3c, 0xa2, 0x0c, 0x72, 0x13,
-	0xf4, 0x46, 0xd7, 0x75, 0x69, 0xdb, 0xaf, 0x07, 0x99, 0xb8, 0x03, 0x28, 0x98, 0x33, 0x22, 0xbf,
-	0x22, 0xb2, 0xf1, 0x0c, 0xc3, 0xc9, 0xe8, 0x38, 0x2e, 0x3f, 0x19, 0x66, 0x4d, 0x41, 0xd9, 0x8e,
-	0xe3, 0xfa, 0x78, 0xa5, 0x49, 0xf7, 0x19, 0xfd, 0xfc, 0x2a, 0x52, 0xa4, 0x8c, 0x9f, 0x68, 0x70,
-	0x4a, 0x6c, 0xe6, 0x95, 0xb1, 0x96, 0x4e, 0xd4, 0xda, 0xc9, 0x4e, 0xd4, 0x27, 0x36, 0x03, 0x04,
-	0x07, 0xea, 0xec, 0x90, 0x07, 0x6a, 0xe3, 0x45, 0x98, 0xe6, 0x79, 0xe1, 0xdc, 0x0a, 0x0f, 0x34,
-	0x9a, 0x74, 0xa0, 0x31, 0x3a, 0x70, 0x5a, 0xed, 0x9a, 0x80, 0x8e, 0x1b, 0x2e, 0xd6, 0x41, 0x9c,
-	0x5f, 0xd8, 0x81, 0x05, 0x41, 0x04, 0xe9, 0xbd, 0xce, 0x3d, 0x01, 0x26, 0x73, 0xfa, 0x48, 0x49,
-	0x1b, 0x3f, 0xd3, 0x02, 0x23, 0x19, 0x1e, 0xb4, 0xca, 0x5c, 0x46, 0xee, 0xc1, 0x38, 0x3f, 0x03,
-	0x8a, 0xc5, 0xc4, 0xe8, 0x81, 0x96, 0x83, 0xef, 0x58, 0xae, 0x75, 0x68, 0x8a, 0x1a, 0xe4, 0x35,
-	0x18, 0x3b, 0x0c, 0xf7, 0x2d, 0xc3, 0x55, 0xe5, 0x15, 0x98, 0xe8, 0xe1, 0x0f, 0x7e, 0xaa, 0xe5,
-	0xab, 0x4c, 0x01, 0x73, 0x82, 0x53, 0xaf, 0x7c, 0x38, 0x1e, 0x8d, 0x1f, 0xa2, 0x8d, 0x3f, 0xce,
-	0x84, 0xd6, 0x6a, 0xea, 0x3f, 0x0f, 0xb1, 0xe0, 0xa3, 0x9c, 0x19, 0xd6, 0x6c, 0x72, 0x2f, 0x9c,
-	0x71, 0xbd, 0xf6, 0x44, 0x09, 0x4e, 0x87, 0xb3, 0x72, 0x1d, 0x72, 0x0e, 0xaa, 0xfe, 0x60, 0x8d,
-	0x58, 0xe8, 0x55, 0x39, 0xec, 0xda, 0x02, 0x5f, 0x2b, 0x84, 0x89, 0x39, 0xa8, 0x5e, 0xba, 0x07,
-	0x93, 0x72, 0xc1, 0x89, 0xce, 0xa5, 0xbf, 0x1e, 0x49, 0x03, 0x6b, 0x46, 0x48, 0xdf, 0x22, 0x8c,
-	0x73, 0xa9, 0x11, 0x1c, 0x3c, 0xd3, 0x4b, 0xc8, 0x04, 0xd8, 0x73, 0x14, 0xcf, 0xdf, 0x66, 0x7b,
-	0xf8, 0xb6, 0xd5, 0x51, 0xa7, 0x7a, 0x7c, 0x3a, 0x48, 0x63, 0x9c, 0x39, 0xd9, 0x18, 0xcb, 0x26,
-	0xba, 0x6c, 0xcc, 0x44, 0x77, 0x16, 0xf2, 0x6d, 0xa7, 0xee, 0x52, 0xdf, 0x0d, 0xcc, 0x77, 0xb9,
-	0xb6, 0x63, 0xb2, 0xa4, 0xf1, 0x3e, 0x10, 0x99, 0x2a, 0xc1, 0xa7, 0xaf, 0xc0, 0x5c, 0x60, 0x8e,
-	0xc0, 0x82, 0xa8, 0xf7, 0x9c, 0x6f, 0xd7, 0x7a, 0x19, 0x25, 0x14, 0x34, 0xe6, 0xe9, 0xa3, 0x94,
-	0x5c, 0xc3, 0x0f, 0xae, 0x9a, 0x71, 0xfd, 0x50, 0xd6, 0x0a, 0x2d, 0xb6, 0x56, 0xa4, 0x39, 0xaf,
-	0xdc, 0x85, 0x9c, 0x68, 0x78, 0x18, 0xad, 0x15, 0xc0, 0x1a, 0x3f, 0xd6, 0x02, 0xcd, 0x15, 0x58,
-	0x4a, 0x52, 0xbd, 0x06, 0xce, 0x43, 0x81, 0xfd, 0xf7, 0x3a, 0x56, 0x23, 0x90, 0xaa, 0x28, 0x83,
-	0xd5, 0x08, 0x4f, 0xbb, 0x05, 0x13, 0x7f, 0x93, 0x33, 0x90, 0x6b, 0x3b, 0x4d, 0x24, 0x5f, 0x2c,
-	0x5b, 0x2c, 0x59, 0x6d, 0x32, 0x25, 0xe0, 0x7c, 0xd0, 0xa6, 0x6e, 0x1d, 0x1b, 0x19, 0xe3, 0xb8,
-	0x30, 0x67, 0x8b, 0xb5, 0x14, 0x16, 0x23, 0xc6, 0x71, 0xa9, 0x18, 0x77, 0xca, 0x4d, 0x20, 0xf7,
-	0x5d, 0xab, 0x73, 0xb0, 0xea, 0xda, 0x47, 0xd4, 0x5d, 0x39, 0xb0, 0xda, 0xfb, 0xd4, 0x0b, 0x19,
-	0xa2, 0x49, 0x0c, 0xb9, 0x07, 0xa3, 0x4f, 0xed, 0x76, 0x53, 0x68, 0xa9, 0x17, 0x53, 0x2c, 0xb9,
-	0x31, 0x34, 0xb8, 0x6d, 0xc6, 0x3a, 0xc6, 0x75, 0x98, 0x59, 0x69, 0x75, 0x3d, 0x9f, 0xba, 0x03,
-	0xf4, 0xf9, 0xef, 0x68, 0x30, 0xc5, 0x26, 0xfa, 0x51, 0x28, 0xba, 0xeb, 0x90, 0x37, 0xe9, 0xfb,
-	0xd4, 0xf3, 0x1f, 0x3c, 0x14, 0xbb, 0x87, 0x5b, 0xc9, 0xdd, 0x83, 0x5c, 0x63, 0x21, 0x00, 0xe7,
-	0xd3, 0x3c, 0xac, 0x5d, 0x7a, 0x03, 0xa6, 0x94, 0x22, 0x79, 0xa2, 0x67, 0x07, 0x4d, 0xf4, 0x8f,
-	0x60, 0x5a, 0x69, 0xc5, 0x23, 0x06, 0x4c, 0x8a, 0xdf, 0x2b, 0xd2, 0x91, 0x54, 0xc9, 0x23, 0xab,
-	0xb1, 0xde, 0x08, 0xe7, 0x90, 0x8b, 0xfd, 0x7b, 0x60, 0xaa, 0x95, 0x8c, 0x7f, 0xa2, 0xc1, 0x1c,
-	0xda, 0xc9, 0x07, 0x4f, 0xec, 0x07, 0x30, 0xbe, 0x21, 0xbb, 0xa1, 0x7c, 0x36, 0xdd, 0xe0, 0x9e,
-	0x40, 0xa4, 0xfa, 0xce, 0x6c, 0x7c, 0x62, 0xdf, 0x99, 0xbf, 0xd4, 0xe0, 0x4c, 0xa2, 0x25, 0x31,
-	0xf2, 0x7b, 0x50, 0x08, 0x2e, 0x69, 0x82, 0xdd, 0xed, 0xe7, 0x06, 0x93, 0xc9, 0x2b, 0x2f, 0xd4,
-	0x82, 0x9a, 0x9c, 0xd4, 0x08, 0x53, 0x24, 0x50, 0x19, 0x49, 0xa0, 0x4a, 0x16, 0x4c, 0xab, 0x55,
-	0x52, 0xba, 0xf1, 0xba, 0xdc, 0x8d, 0xd4, 0x53, 0x75, 0x82, 0x0e, 0xb9, 0xaf, 0xff, 0x68, 0x2c,
-	0x74, 0xbc, 0xda, 0x72, 0x9a, 0xc9, 0xbd, 0x87, 0x0e, 0xd9, 0x46, 0xa7, 0x8b, 0xc8, 0x35, 0x93,
-	0xfd, 0x44, 0x6b, 0x05, 0x3d, 0xac, 0xa3, 0xe9, 0x4c, 0xd8, 0x24, 0xf3, 0x87, 0xf4, 0x10, 0x7d,
-	0xa1, 0x98, 0x16, 0x65, 0x85, 0x68, 0x06, 0xe4, 0x46, 0xc9, 0xdc, 0x21, 0x3d, 0x44, 0x23, 0xa0,
-	0x28, 0x7a, 0xe2, 0x52, 0x1a, 0x58, 0x25, 0x0f, 0xe9, 0xe1, 0x9a, 0x4b, 0xd1, 0x91, 0xc5, 0x3a,
-	0xda, 0xaf, 0xb7, 0x1c, 0x8b, 0x1b, 0xac, 0xb2, 0x66, 0xce, 0x3a, 0xda, 0xdf, 0x70, 0x2c, 0x7e,
-	0x69, 0xc3, 0xf7, 0xbb, 0xb9, 0x1e, 0xb7, 0x09, 0xb1, 0x6b, 0x81, 0xb7, 0x60, 0xac, 0x69, 0x7b,
-	0x4f, 0x03, 0xa7, 0xab, 0xeb, 0xbd, 0x9c, 0xae, 0x58, 0x6f, 0x17, 0x56, 0x19, 0x24, 0x1f, 0x0c,
-	0x5e, 0x8b, 0x2c, 0xc1, 0x58, 0xc7, 0x71, 0xc2, 0xfb, 0xdf, 0xf3, 0xfd, 0x7c, 0xb6, 0x4c, 0x0e,
-	0xca, 0xb4, 0xdb, 0xe1, 0xfe, 0xa1, 0x5f, 0xb7, 0x3b, 0xc1, 0xe6, 0x95, 0x25, 0xab, 0x1d, 0x56,
-	0xd0, 0xb4, 0x7c, 0x8b, 0x15, 0x4c, 0xf2, 0x02, 0x96, 0xac, 0xe2, 0x5d, 0xd1, 0x81, 0xe3, 0xf9,
-	0xa8, 0xf4, 0xf8, 0xf5, 0x40, 0x98, 0x26, 0x9b, 0x30, 0x81, 0xba, 0x52, 0xdc, 0x43, 0xeb, 0x3d,
-	0xd4, 0x86, 0xdc, 0x0d, 0xf6, 0x47, 0x9e, 0x03, 0xd0, 0x0e, 0x33, 0xc8, 0x02, 0x9c, 0x0a, 0x4e,
-	0x36, 0x6e, 0x1d, 0x11, 0x63, 0xab, 0xb3, 0xd8, 0xea, 0x6c, 0x58, 0xc4, 0x50, 0x30, 0x95, 0x5b,
-	0xfa, 0x32, 0x40, 0xc4, 0x95, 0x14, 0x79, 0x7b, 0x55, 0x95, 0xb7, 0xcb, 0xbd, 0x08, 0x0b, 0x8e,
-	0xc9, 0x92, 0xb0, 0x95, 0xde, 0x82, 0x99, 0x18, 0xa9, 0x27, 0x9a, 0x97, 0x14, 0xa6, 0x05, 0x72,
-	0xa1, 0x8f, 0x25, 0xe9, 0xd0, 0x86, 0x93, 0x0e, 0x2e, 0xde, 0x19, 0xd9, 0xf3, 0x13, 0xd9, 0x91,
-	0x8d, 0x96, 0x37, 0xe3, 0x0a, 0x5c, 0xea, 0x79, 0xd0, 0xec, 0x73, 0x16, 0xe5, 0xee, 0x00, 0x9f,
-	0xca, 0x59, 0x34, 0x8d, 0xa2, 0xa0, 0x39, 0x41, 0xd1, 0x55, 0xb8, 0x92, 0x00, 0x89, 0xdb, 0x0e,
-	0x8c, 0x26, 0x18, 0xfd, 0x80, 0x84, 0x8a, 0xfb, 0x3c, 0xe4, 0x91, 0xe2, 0xe8, 0xfc, 0x3e, 0x0c,
-	0xcd, 0x61, 0x1d, 0xe3, 0x6e, 0x0a, 0xb5, 0xd5, 0x36, 0xdb, 0x33, 0x87, 0xdb, 0xf4, 0x94, 0x5d,
-	0x85, 0xf1, 0x8b, 0x70, 0xb9, 0x77, 0x35, 0x41, 0xda, 0x3d, 0x18, 0x3f, 0x31, 0x33, 0x45, 0x0d,
-	0xe3, 0x95, 0x94, 0x31, 0x53, 0xed, 0x16, 0x69, 0x54, 0xa5, 0xb1, 0x3e, 0x66, 0x98, 0xd8, 0x48,
-	0x41, 0x1c, 0xf8, 0x95, 0xac, 0x5a, 0x76, 0xeb, 0x98, 0x21, 0x3e, 0x70, 0xba, 0xae, 0xf0, 0x67,
-	0xc5, 0xdf, 0xec, 0xc0, 0x7b, 0x68, 0xb7, 0xbb, 0x3e, 0x97, 0xf3, 0x31, 0x53, 0xa4, 0x8c, 0x6f,
-	0x6b, 0xa9, 0xec, 0xe3, 0xe8, 0x1e, 0x51, 0xfa, 0xb4, 0x75, 0x4c, 0x5e, 0x86, 0x6c, 0xd3, 0x3a,
-	0x16, 0x32, 0x9f, 0x6a, 0x5c, 0xd9, 0xb5, 0x0f, 0x29, 0x03, 0x6e, 0x5a, 0xc7, 0x26, 0x83, 0x0d,
-	0x49, 0xc8, 0xa4, 0x92, 0x90, 0x55, 0x48, 0xf8, 0x5a, 0xea, 0x48, 0x88, 0xcb, 0x1a, 0xa7, 0xed,
-	0x1f, 0xb4, 0x70, 0xde, 0x06, 0x24, 0x8c, 0x9d, 0xbc, 0x85, 0xb7, 0x52, 0xa4, 0x35, 0x68, 0x61,
-	0x87, 0xba, 0xb6, 0xd3, 0xb4, 0x1b, 0xa4, 0x08, 0x39, 0x8f, 0x36, 0x9c, 0x76, 0x33, 0xb8, 0xf6,
-	0x09, 0x92, 0xc6, 0xff, 0xce, 0xa0, 0x85, 0x2c, 0xbd, 0x3e, 0x37, 0x25, 0xf8, 0x96, 0xdd, 0x16,
-	0xd5, 0x44, 0x8a, 0xac, 0xc3, 0x58, 0x93, 0x0d, 0x47, 0xf1, 0xdf, 0x71, 0xe1, 0x59, 0x1c, 0x2c,
-	0x3c, 0xca, 0x30, 0xae, 0x8f, 0x98, 0x1c, 0x01, 0xdb, 0xa8, 0x7c, 0x80, 0x23, 0x51, 0xfc, 0x19,
-	0x47, 0x75, 0x67, 0x78, 0x54, 0x7c, 0x08, 0xd7, 0x47, 0x4c, 0x81, 0x82, 0x6c, 0x41, 0xee, 0x90,
-	0x33, 0xb5, 0xf8, 0xa7, 0x1c, 0xdb, 0xcb, 0xc3, 0x63, 0x13, 0xc3, 0xb1, 0x3e, 0x62, 0x06, 0x48,
-	0xc8, 0xdb, 0x90, 0xef, 0x08, 0x16, 0x16, 0xff, 0x3d, 0x47, 0xb8, 0x34, 0x3c, 0xc2, 0x80, 0xfb,
-	0xeb, 0x23, 0x66, 0x88, 0x66, 0x79, 0x0a, 0x26, 0xf8, 0x6f, 0xdc, 0x87, 0x1b, 0xef, 0xe3, 0x4d,
-	0x8b, 0x5a, 0x3f, 0xf5, 0xa0, 0xb0, 0x0e, 0x85, 0x40, 0x93, 0x05, 0x7b, 0xba, 0xf9, 0xe1, 0x49,
-	0x31, 0xa3, 0xca, 0xc6, 0xaf, 0x65, 0xd1, 0x76, 0xb9, 0xe2, 0xd2, 0x26, 0x6d, 0xfb, 0xb6, 0xd5,
-	0x52, 0x77, 0x92, 0x69, 0x8d, 0xcf, 0xc1, 0xf8, 0xe3, 0x6e, 0xe3, 0x29, 0xf5, 0x85, 0xba, 0x17,
-	0x29, 0x72, 0x0d, 0xa6, 0x85, 0x1b, 0xa6, 0xed, 0xb4, 0xd1, 0xec, 0xc5, 0x95, 0xff, 0x54, 0x94,
-	0xfb, 0x80, 0x1e, 0x13, 0x13, 0xa6, 0xad, 0x0f, 0x3c, 0x76, 0xb6, 0x13, 0x2d, 0x06, 0x62, 0x93,
-	0x6e, 0x71, 0xfe, 0xc0, 0x8b, 0x68, 0x13, 0x54, 0xad, 0x8f, 0x98, 0x53, 0x96, 0x9c, 0x4f, 0xde,
-	0x01, 0xdd, 0xfa, 0xa8, 0xeb, 0x52, 0x19, 0xab, 0x90, 0xa0, 0x54, 0xbe, 0x94, 0x19, 0x70, 0x1a,
-	0xde, 0x19, 0x4b, 0x2d, 0x21, 0x5f, 0x81, 0x59, 0x7e, 0x37, 0x25, 0xa3, 0xfe, 0xd3, 0x3e, 0xe6,
-	0xf7, 0xfb, 0x08, 0x9d, 0x86, 0x5b, 0xdf, 0x8f, 0x15, 0x2d, 0xcf, 0xc2, 0x4c, 0x84, 0x95, 0x8b,
-	0xc0, 0x32, 0x9c, 0x4b, 0x1d, 0x0e, 0xa1, 0xa7, 0xaf, 0xc2, 0x94, 0x54, 0x23, 0xdc, 0x50, 0x4e,
-	0x46, 0x99, 0xd5, 0xa6, 0xf1, 0x8f, 0x35, 0x6e, 0xec, 0x4d, 0x61, 0x5d, 0xcc, 0x6c, 0xa9, 0xf5,
-	0x37, 0x5b, 0x66, 0xe2, 0x66, 0xcb, 0x12, 0x5e, 0xdd, 0x71, 0x83, 0x24, 0x1f, 0xdc, 0x30, 0x2d,
-	0x19, 0x1a, 0x47, 0x65, 0x43, 0x23, 0xda, 0x9b, 0x6c, 0xcf, 0x7a, 0xdc, 0xa2, 0x75, 0xcf, 0xe3,
-	0x4e, 0x8d, 0x79, 0x13, 0x44, 0x56, 0xcd, 0x6b, 0x19, 0x75, 0x6e, 0x95, 0x4f, 0x1d, 0x12, 0x72,
-	0x05, 0x26, 0xad, 0x06, 0xbf, 0xe2, 0x92, 0x04, 0x71, 0x42, 0xe4, 0xe1, 0x59, 0x96, 0x9b, 0x40,
-	0x11, 0x24, 0x22, 0x1a, 0x44, 0xd6, 0x03, 0x7a, 0x6c, 0x3c, 0x44, 0x11, 0xef, 0x31, 0x30, 0xac,
-	0xcb, 0x1d, 0xd7, 0x79, 0x8f, 0x36, 0xfc, 0x88, 0x9f, 0x05, 0x91, 0x53, 0xc5, 0xdd, 0xf5, 0x7b,
-	0x9e, 0x90, 0x67, 0x8e, 0x3a, 0xc7, 0xd2, 0x0c, 0xef, 0xaf, 0x8b, 0xfb, 0x24, 0x95, 0xcf, 0x62,
-	0xa4, 0x54, 0x46, 0x67, 0xe2, 0x8c, 0xfe, 0x54, 0x38, 0xf9, 0x05, 0x7e, 0x0f, 0x11, 0xe7, 0xa4,
-	0xa0, 0x28, 0xce, 0xca, 0x4c, 0x82, 0x95, 0xc6, 0x9b, 0x28, 0x7d, 0x49, 0x4e, 0x45, 0x7d, 0x92,
-	0x58, 0x95, 0x89, 0xb1, 0xca, 0xb8, 0x04, 0x17, 0x14, 0xd9, 0x4d, 0x6c, 0x93, 0xee, 0xe3, 0x82,
-	0x9e, 0x0a, 0x20, 0x5a, 0xb8, 0x06, 0xd3, 0x8a, 0x7c, 0x07, 0x2e, 0x73, 0x53, 0xb2, 0x80, 0x7b,
-	0x89, 0x59, 0x12, 0xdb, 0x05, 0x0d, 0x35, 0x4b, 0x7e, 0x23, 0x0b, 0xe7, 0xd3, 0x91, 0x9c, 0x60,
-	0xae, 0x85, 0x0a, 0x32, 0x93, 0xaa, 0x20, 0xb3, 0x8a, 0x82, 0xac, 0xf5, 0xd2, 0x7c, 0x37, 0x87,
-	0xd0, 0x7c, 0x9c, 0xa8, 0xa4, 0xea, 0x7b, 0xb7, 0xb7, 0xea, 0x7b, 0x69, 0x28, 0xd5, 0x17, 0x22,
-	0x4e, 0xe8, 0xbe, 0x5f, 0xe8, 0xa3, 0xfb, 0x6e, 0x0d, 0xa7, 0xfb, 0x42, 0xe4, 0x43, 0x29, 0xbf,
-	0x72, 0x6c, 0x2d, 0x52, 0x77, 0x91, 0x43, 0x8d, 0xea, 0x85, 0x98, 0x64, 0xc4, 0xb6, 0x94, 0x2b,
-	0xb1, 0x31, 0x7f, 0x68, 0xb5, 0x6c, 0xf9, 0x74, 0x31, 0x54, 0x1b, 0x71, 0x39, 0x8f, 0x90, 0x88,
-	0x56, 0x6a, 0xf0, 0x42, 0xad, 0xf9, 0x94, 0x1b, 0xfc, 0x36, 0xd9, 0xe4, 0x0a, 0xd0, 0xf7, 0xb5,
-	0x37, 0xaa, 0x76, 0xfb, 0x4c, 0xcc, 0x6e, 0x6f, 0x14, 0xf1, 0x5a, 0x50, 0x41, 0x2a, 0x9a, 0xfb,
-	0x83, 0x0c, 0xea, 0x7b, 0x5e, 0xb4, 0xd7, 0x3e, 0x7c, 0x4e, 0x2d, 0x92, 0x2f, 0x45, 0xc6, 0xf4,
-	0x6c, 0xef, 0xdd, 0x58, 0x5a, 0xb3, 0x81, 0x4d, 0x3d, 0x32, 0xa7, 0x7f, 0x47, 0x83, 0x9c, 0xc8,
-	0x24, 0xf3, 0x30, 0xdb, 0xc4, 0x61, 0xa9, 0x4b, 0xad, 0xf3, 0x87, 0x3e, 0x33, 0xbc, 0x60, 0x33,
-	0xa4, 0xe1, 0x01, 0x5c, 0x6d, 0x3b, 0xf5, 0x26, 0x6d, 0x59, 0xc7, 0xf5, 0xc7, 0xf4, 0x89, 0x83,
-	0xae, 0x7d, 0x2d, 0xea, 0xdb, 0xed, 0xfd, 0x7a, 0x8c, 0xf6, 0xbc, 0x79, 0xb1, 0xed, 0xac, 0x32,
-	0xc8, 0x65, 0x04, 0x5c, 0x15, 0x70, 0x21, 0x32, 0xa3, 0x84, 0xfa, 0x3a, 0x46, 0xb0, 0x60, 0xe2,
-	0x5f, 0x6b, 0x12, 0x7f, 0xb9, 0x53, 0xda, 0x50, 0x3c, 0xac, 0x46, 0x4c, 0xca, 0xf4, 0xde, 0xfd,
-	0xa6, 0xa0, 0x4d, 0xf2, 0xa8, 0x13, 0xb1, 0xe8, 0x12, 0x4c, 0x88, 0x75, 0x58, 0x5a, 0xf5, 0xc4,
-	0xd2, 0x1c, 0x18, 0x70, 0xfb, 0x2d, 0xd4, 0xd7, 0x60, 0x5a, 0x14, 0x37, 0x9c, 0xb6, 0x4f, 0x3f,
-	0x0c, 0x54, 0xd1, 0x14, 0xcf, 0x5d, 0xe1, 0x99, 0xc6, 0x3d, 0x49, 0x70, 0x02, 0xe2, 0x84, 0xf6,
-	0x8b, 0x5d, 0x13, 0x69, 0x89, 0x6b, 0xa2, 0xff, 0x24, 0x33, 0x6c, 0x95, 0x7e, 0x2a, 0x0c, 0x53,
-	0xd0, 0x26, 0x19, 0x56, 0x8b, 0x18, 0x76, 0x1a, 0xc6, 0xf0, 0xc9, 0x89, 0x90, 0x23, 0x9e, 0x20,
-	0x4b, 0xf0, 0x42, 0x97, 0x8f, 0x73, 0x24, 0x3c, 0x78, 0x1f, 0xc7, 0xe5, 0xe5, 0x94, 0x28, 0x0c,
-	0xe4, 0x85, 0x15, 0x89, 0x9b, 0x72, 0xb5, 0x7d, 0x21, 0x23, 0x5f, 0x95, 0x7a, 0x3c, 0x78, 0x9f,
-	0x7c, 0xd2, 0x8b, 0x2f, 0xe3, 0x55, 0xa9, 0xe5, 0xd8, 0xbe, 0xaf, 0x1f, 0x47, 0x8d, 0x75, 0x49,
-	0xdd, 0xac, 0xb4, 0x9c, 0x76, 0x5f, 0xaa, 0xce, 0x41, 0x81, 0xbf, 0xf4, 0x93, 0xae, 0xc7, 0x79,
-	0x46, 0xb5, 0x69, 0xdc, 0x95, 0x3b, 0xc8, 0x31, 0x0d, 0x43, 0xc0, 0x5d, 0x45, 0x12, 0x64, 0x9d,
-	0xdd, 0xb7, 0x9a, 0xca, 0x69, 0x45, 0x4f, 0xcb, 0xac, 0x88, 0x2d, 0xee, 0x7d, 0x51, 0x3e, 0x90,
-	0x66, 0x78, 0x7c, 0x3d, 0x3f, 0xe9, 0xfd, 0x9b, 0xf1, 0x07, 0xb2, 0x84, 0xab, 0x56, 0xa8, 0xbe,
-	0x12, 0xfe, 0xec, 0xf7, 0x68, 0x77, 0x85, 0xc8, 0x70, 0x47, 0xae, 0x2b, 0x03, 0xdf, 0xc8, 0x08,
-	0xc1, 0x91, 0x19, 0x19, 0x33, 0x5f, 0x7d, 0x45, 0x92, 0x0d, 0x74, 0x95, 0x1d, 0xaa, 0x07, 0xd7,
-	0x60, 0xba, 0xed, 0xf8, 0xf5, 0x46, 0xf7, 0xb0, 0xdb, 0xb2, 0x7c, 0xfb, 0x88, 0x8a, 0x09, 0x33,
-	0xd5, 0x76, 0xfc, 0x95, 0x30, 0xd3, 0x58, 0x93, 0xf8, 0x23, 0x90, 0x0b, 0x5e, 0xdf, 0xe2, 0x3e,
-	0xe4, 0x9e, 0x60, 0xf5, 0x5c, 0xaa, 0xf9, 0xd0, 0xe3, 0xde, 0xe3, 0x9e, 0xf1, 0x26, 0x2e, 0xa8,
-	0x42, 0xec, 0x64, 0x6f, 0xd5, 0xa1, 0xc6, 0xdc, 0xc7, 0x5d, 0x65, 0x6a, 0x6d, 0x41, 0x8d, 0x09,
-	0xa7, 0x1a, 0xa2, 0xa0, 0x8e, 0x4e, 0xf8, 0xdc, 0x9f, 0xbb, 0x97, 0xa9, 0x2b, 0xe1, 0x30, 0x6b,
-	0xce, 0x36, 0xe2, 0x59, 0xc6, 0x39, 0x3c, 0xb5, 0xf0, 0x56, 0x13, 0x1b, 0xdd, 0x37, 0x70, 0x23,
-	0x93, 0x28, 0x8c, 0xb6, 0xd1, 0x61, 0x6f, 0x82, 0x0d, 0x6e, 0x21, 0xe8, 0x8e, 0x67, 0x7c, 0x0d,
-	0x3e, 0x93, 0xac, 0xfc, 0xc8, 0xf6, 0x0f, 0xd6, 0xec, 0x96, 0x4f, 0x5d, 0xef, 0x13, 0x5f, 0xc9,
-	0x1b, 0x6b, 0x70, 0x6d, 0x40, 0x0b, 0xc3, 0x51, 0xfa, 0x17, 0x9a, 0xc4, 0xfa, 0xe0, 0x42, 0x45,
-	0x55, 0x8c, 0x83, 0x6e, 0x58, 0x13, 0x9b, 0xe7, 0x5a, 0xf8, 0xb8, 0x3b, 0x8b, 0x76, 0x8d, 0x37,
-	0x7a, 0xaf, 0x0e, 0xa9, 0x2d, 0x3e, 0xef, 0xf7, 0xde, 0xcb, 0x68, 0x35, 0x4c, 0x6f, 0x30, 0x5a,
-	0x2a, 0xa3, 0x87, 0x49, 0xcd, 0x70, 0xb1, 0x0e, 0x5e, 0xc2, 0x35, 0x8d, 0x7a, 0x0a, 0x0e, 0x93,
-	0xa2, 0x1b, 0xd3, 0x50, 0x7c, 0x8a, 0x35, 0x90, 0x49, 0x34, 0x60, 0xa0, 0x61, 0xb1, 0x47, 0x03,
-	0x42, 0x13, 0x7c, 0x11, 0x4d, 0x83, 0x2a, 0x4c, 0xc2, 0x09, 0xae, 0xef, 0x44, 0xdb, 0x45, 0x2b,
-	0x77, 0x4f, 0x0c, 0xa1, 0xbb, 0xdb, 0x29, 0x81, 0x42, 0xa2, 0x39, 0x10, 0x9e, 0xd9, 0x23, 0xa5,
-	0x36, 0x13, 0xa2, 0xbf, 0xd4, 0xe0, 0x56, 0x6f, 0xb4, 0x29, 0x72, 0xdf, 0x97, 0x55, 0x56, 0x28,
-	0x3e, 0xdc, 0x2c, 0x56, 0x1d, 0x2c, 0x3e, 0x7d, 0xda, 0x7a, 0xde, 0xc2, 0x54, 0x87, 0xdb, 0x43,
-	0x36, 0xff, 0x8c, 0xcc, 0xfc, 0x18, 0x5e, 0x4c, 0x34, 0x10, 0x18, 0x01, 0x4f, 0xb0, 0x82, 0xbd,
-	0x0a, 0x67, 0x92, 0x2f, 0xe6, 0xd0, 0x13, 0x01, 0xd9, 0x5a, 0x30, 0x5f, 0x88, 0x3f, 0x72, 0x64,
-	0x9b, 0x52, 0xcf, 0xb8, 0x09, 0xd7, 0x07, 0x36, 0x2f, 0xc4, 0x91, 0xdb, 0xff, 0xc5, 0x7d, 0x93,
-	0x58, 0xaa, 0x57, 0xb8, 0x73, 0x5b, 0xa0, 0x45, 0xbf, 0x8a, 0x52, 0xdd, 0x03, 0x44, 0x30, 0xe8,
-	0x75, 0xc8, 0x35, 0x38, 0x80, 0x50, 0x82, 0x97, 0x7a, 0xdd, 0x9b, 0x05, 0xae, 0x06, 0x01, 0xbc,
-	0x71, 0x07, 0x97, 0xc6, 0x2d, 0xa7, 0x19, 0xdf, 0x61, 0x48, 0x4e, 0x15, 0x9a, 0xec, 0x54, 0x61,
-	0x7c, 0x09, 0xd7, 0x3b, 0xa5, 0x86, 0x20, 0xe3, 0x0e, 0x8c, 0x32, 0x18, 0x41, 0xc3, 0xf9, 0x7e,
-	0x97, 0x8a, 0x26, 0x42, 0x1a, 0x17, 0xf1, 0x24, 0x2a, 0xe1, 0x8a, 0x75, 0xfe, 0x6d, 0x5c, 0x13,
-	0xd3, 0xca, 0x9f, 0xb9, 0x49, 0xbe, 0x4d, 0x60, 0x19, 0x89, 0x05, 0xeb, 0x2e, 0xee, 0x9b, 0x62,
-	0x45, 0xa2, 0x21, 0x74, 0xe0, 0x69, 0xca, 0x4b, 0x40, 0x8e, 0xf3, 0xc3, 0x33, 0x2a, 0xd8, 0x09,
-	0xc5, 0x2b, 0x53, 0xe1, 0xe4, 0x35, 0x98, 0x76, 0xa2, 0xc2, 0x88, 0xa1, 0x53, 0x52, 0x6e, 0xb5,
-	0x69, 0x74, 0xb0, 0xaf, 0x69, 0x68, 0x04, 0x09, 0xdb, 0x40, 0x64, 0x3c, 0xd2, 0xd5, 0x64, 0xda,
-	0x45, 0x69, 0xcc, 0x4b, 0xd4, 0x9c, 0x95, 0xea, 0xf2, 0x6b, 0x4b, 0xe3, 0x1e, 0x9a, 0x09, 0x24,
-	0xc0, 0xe1, 0x57, 0x2d, 0xc3, 0x89, 0x77, 0x3a, 0xb6, 0x1c, 0x3c, 0x77, 0x62, 0x57, 0xe3, 0xc4,
-	0xaa, 0x7b, 0xec, 0x21, 0x99, 0x7c, 0x31, 0x4e, 0x76, 0x6c, 0xcb, 0xfd, 0x0b, 0xf1, 0x56, 0x54,
-	0x7d, 0x31, 0x5c, 0x2b, 0x64, 0x0e, 0xc6, 0xb9, 0x47, 0xad, 0xd8, 0x31, 0x8a, 0x54, 0xb2, 0xf5,
-	0x98, 0x3a, 0xf8, 0x61, 0x86, 0x1b, 0x6e, 0x5a, 0x4e, 0xb7, 0xb9, 0x6c, 0x35, 0x9e, 0x76, 0x3b,
-	0x27, 0xd8, 0x47, 0x24, 0xac, 0x36, 0x99, 0x74, 0x4b, 0xdd, 0x93, 0x6e, 0xab, 0x25, 0xfc, 0xd3,
-	0xf0, 0x37, 0x9b, 0xe9, 0xbe, 0xe5, 0x3d, 0x95, 0xdc, 0xa7, 0x58, 0xb2, 0xda, 0x24, 0x3b, 0xe1,
-	0x32, 0x32, 0x86, 0xcb, 0xc8, 0x6b, 0x69, 0xcb, 0x48, 0x2f, 0x62, 0x9f, 0xf7, 0xaa, 0xf1, 0x39,
-	0x6e, 0xb4, 0x4a, 0xb6, 0x26, 0x04, 0x4e, 0xea, 0x85, 0x26, 0xf7, 0xc2, 0xf8, 0xa9, 0x16, 0xaf,
-	0x99, 0xdc, 0x75, 0x3c, 0xc6, 0x7c, 0x89, 0xab, 0x3c, 0xa3, 0xda, 0x64, 0x6b, 0x8f, 0xcb, 0xc1,
-	0xeb, 0x82, 0xf5, 0xd2, 0x66, 0x6d, 0x56, 0x14, 0x71, 0x5d, 0x8f, 0x26, 0x89, 0xc4, 0x28, 0x64,
-	0x53, 0x46, 0xa1, 0xa7, 0xc3, 0x9a, 0xd4, 0x89, 0x31, 0xa5, 0x13, 0x4d, 0x6e, 0x6d, 0x4b, 0xe9,
-	0x83, 0xe8, 0xfe, 0x3c, 0xcc, 0xc6, 0xe8, 0x0c, 0x3b, 0x33, 0xa3, 0x50, 0xa9, 0xb6, 0x92, 0x51,
-	0x5a, 0xe9, 0xc6, 0xc5, 0x2f, 0x71, 0x8e, 0xed, 0xcd, 0xa8, 0xa1, 0xc4, 0x2f, 0x34, 0x50, 0x64,
-	0x25, 0x03, 0x85, 0x98, 0x16, 0x29, 0xcd, 0x8a, 0x69, 0x61, 0x73, 0x8b, 0x79, 0xbc, 0xbc, 0xdc,
-	0x0a, 0xaf, 0x2f, 0x0c, 0x98, 0xf2, 0xdc, 0x46, 0xa2, 0xe7, 0x13, 0x9e, 0xdb, 0x78, 0x78, 0x92,
-	0xf9, 0x11, 0x2e, 0xc8, 0x69, 0x4d, 0x09, 0x6a, 0x7e, 0x5f, 0x83, 0x9b, 0x2a, 0x4c, 0xbf, 0x7d,
-	0xda, 0x30, 0x94, 0x5d, 0x00, 0x10, 0xcb, 0xb1, 0x74, 0xa3, 0x20, 0x72, 0xd2, 0x08, 0x4f, 0x13,
-	0x29, 0x1d, 0xb2, 0x56, 0xab, 0x25, 0x7c, 0x4b, 0xd9, 0x4f, 0xe3, 0x7f, 0x65, 0xf0, 0xad, 0x98,
-	0x44, 0x27, 0x7a, 0x7b, 0xc6, 0x5d, 0xb0, 0x12, 0x04, 0x66, 0x92, 0x04, 0xbe, 0x08, 0x33, 0x12,
-	0x8c, 0xe4, 0xd2, 0x32, 0x15, 0x42, 0xa1, 0xf0, 0x2b, 0xef, 0x0a, 0x47, 0x4f, 0xf2, 0xae, 0x70,
-	0x53, 0x8a, 0xe9, 0xc5, 0x95, 0xcd, 0xcb, 0x03, 0x94, 0x0d, 0xeb, 0xcc, 0xc2, 0xa6, 0xa8, 0x23,
-	0xfc, 0x19, 0xc3, 0x30, 0x60, 0xe5, 0xd0, 0x73, 0x87, 0x87, 0x09, 0xb9, 0x39, 0x00, 0x19, 0x5f,
-	0x63, 0xf8, 0xbb, 0x71, 0x5e, 0xb1, 0xf4, 0x06, 0x4c, 0x29, 0xd8, 0x4f, 0xa4, 0xac, 0x9e, 0xc2,
-	0xfc, 0x30, 0x22, 0x22, 0xe6, 0xee, 0x5b, 0x90, 0xe3, 0xd3, 0x28, 0xf0, 0x88, 0xb9, 0x3a, 0x44,
-	0xdf, 0xcd, 0xa0, 0x8e, 0xf1, 0x2b, 0xa3, 0x70, 0x3a, 0xad, 0x3b, 0xfd, 0xe7, 0xeb, 0x5b, 0x30,
-	0xee, 0x74, 0xc2, 0x27, 0x5a, 0xd3, 0x29, 0x0e, 0xc5, 0x2a, 0xce, 0xed, 0x0e, 0x67, 0x0f, 0xaf,
-	0x24, 0x71, 0x38, 0xfb, 0x8c, 0x1c, 0x8e, 0x9e, 0xf5, 0x36, 0x1d, 0x11, 0xcf, 0x2e, 0x78, 0xd6,
-	0xbb, 0xea, 0xb4, 0x69, 0xec, 0x71, 0xe5, 0xd8, 0x49, 0x1e, 0x57, 0x96, 0x61, 0xba, 0xe1, 0x1c,
-	0x76, 0xd8, 0xcc, 0x15, 0x4f, 0x2c, 0x07, 0xc7, 0x37, 0x98, 0x0a, 0x6b, 0x20, 0x0a, 0x49, 0x45,
-	0xe7, 0x14, 0x15, 0x9d, 0x98, 0x2f, 0xf9, 0xe4, 0x7c, 0x21, 0x30, 0x8a, 0xb6, 0x95, 0x02, 0x6e,
-	0x14, 0xf1, 0x77, 0x72, 0x16, 0x43, 0xca, 0x2c, 0xbe, 0x04, 0x13, 0x9c, 0x25, 0xdc, 0xff, 0x71,
-	0x82, 0x3f, 0x65, 0xc6, 0x2c, 0xee, 0x01, 0x79, 0x09, 0x26, 0xa8, 0x6f, 0xd5, 0x03, 0xcf, 0x95,
-	0x49, 0xfe, 0xd2, 0x85, 0xfa, 0x56, 0x4d, 0x38, 0xaf, 0xd8, 0x71, 0x15, 0x2e, 0x5c, 0xd4, 0x86,
-	0xd9, 0x41, 0x9c, 0x86, 0xb1, 0x96, 0xd3, 0xb0, 0x5a, 0x62, 0xd7, 0xc2, 0x13, 0xf2, 0x6a, 0x91,
-	0x55, 0x56, 0x8b, 0xff, 0x9c, 0x58, 0x58, 0x83, 0xb6, 0x84, 0x5c, 0x3f, 0x82, 0x3c, 0x1f, 0xea,
-	0xd0, 0xd5, 0xeb, 0x8d, 0xa1, 0xa4, 0x24, 0xf2, 0x68, 0x15, 0xb5, 0xc5, 0xf4, 0x0e, 0x90, 0x95,
-	0x1e, 0xc3, 0x94, 0x52, 0x94, 0x32, 0x37, 0xdf, 0x50, 0x1d, 0x09, 0xaf, 0x0d, 0xd7, 0xb0, 0x34,
-	0x85, 0xbf, 0x96, 0xd8, 0x6f, 0x58, 0xbe, 0xd5, 0x72, 0xf6, 0x9f, 0xdb, 0x62, 0x68, 0xbc, 0x11,
-	0x5f, 0xd3, 0xc3, 0x16, 0x04, 0xff, 0x4a, 0x90, 0xc7, 0x8b, 0x8b, 0xb6, 0x1f, 0x9c, 0x39, 0xc2,
-	0xb4, 0xf1, 0x47, 0x1a, 0x9a, 0xde, 0xa4, 0xda, 0xeb, 0x36, 0xeb, 0xde, 0x71, 0xd5, 0xa7, 0x87,
-	0x43, 0xad, 0x3a, 0x8a, 0xb2, 0xce, 0x9c, 0x44, 0x59, 0x7f, 0xf2, 0xb9, 0x6f, 0x2c, 0xc7, 0xb9,
-	0x2b, 0xa8, 0x3f, 0xc1, 0xb2, 0x69, 0xb4, 0xe3, 0xfc, 0x0b, 0x71, 0x08, 0xfe, 0x6d, 0xc2, 0xe4,
-	0x01, 0xcf, 0xaa, 0xb7, 0x6c, 0x2f, 0x78, 0x61, 0x37, 0x3f, 0x80, 0x5a, 0x89, 0x8f, 0xe6, 0x84,
-	0xa8, 0xbf, 0x61, 0x7b, 0xbe, 0xf1, 0x7d, 0x4d, 0x1c, 0xc5, 0x95, 0x8e, 0x51, 0xee, 0xd1, 0x2f,
-	0x1d, 0x9b, 0x53, 0xb7, 0xa1, 0xe4, 0x21, 0xcc, 0xb8, 0x1c, 0x26, 0x0c, 0xfe, 0xc1, 0x15, 0xef,
-	0xed, 0x01, 0xf4, 0x98, 0x41, 0x2d, 0x1e, 0x0d, 0x64, 0xda, 0x55, 0xd2, 0xc2, 0x35, 0xb3, 0x17,
-	0x51, 0x62, 0xcf, 0xf2, 0x73, 0x8d, 0x5f, 0x2a, 0x4b, 0x50, 0xc2, 0x20, 0x81, 0x7b, 0x82, 0xe7,
-	0xb5, 0x7d, 0x52, 0x5d, 0xb2, 0xb2, 0x9f, 0xc0, 0x25, 0x8b, 0x29, 0xba, 0x43, 0xeb, 0xc3, 0x7a,
-	0xb0, 0x2e, 0xf2, 0xd5, 0x01, 0x0e, 0xad, 0x0f, 0x39, 0xf9, 0x5e, 0x78, 0x92, 0x19, 0x8b, 0x4e,
-	0x32, 0xc6, 0x71, 0x62, 0x80, 0x18, 0x3e, 0xf5, 0x0c, 0xb5, 0x07, 0x7a, 0x83, 0x01, 0x70, 0x93,
-	0x8e, 0x6c, 0x03, 0x7f, 0x69, 0x90, 0x18, 0x4b, 0x2c, 0x33, 0xa7, 0x11, 0x09, 0x66, 0xa1, 0x25,
-	0xfc, 0xed, 0xc4, 0x30, 0xc8, 0x4d, 0x87, 0x17, 0x02, 0x44, 0xe8, 0x8c, 0xd0, 0x9e, 0x14, 0x32,
-	0x5b, 0x7f, 0xac, 0x36, 0xd2, 0x34, 0x76, 0x52, 0x7b, 0xa3, 0x6e, 0xc9, 0x4f, 0x86, 0xf1, 0x6a,
-	0x2a, 0x91, 0xb1, 0xdd, 0xf6, 0x35, 0xb8, 0x9a, 0x02, 0x94, 0x30, 0x96, 0xfc, 0x6a, 0x06, 0x2d,
-	0xf4, 0x7d, 0xe0, 0x44, 0xa7, 0x3d, 0x95, 0xe1, 0xd2, 0x4c, 0xac, 0x0e, 0xc3, 0xf0, 0x04, 0xc2,
-	0x85, 0x95, 0x90, 0xf3, 0x6c, 0x5a, 0xf2, 0xb5, 0x41, 0x1a, 0x0e, 0x96, 0x59, 0x6a, 0xc3, 0xa9,
-	0x14, 0xb0, 0x94, 0x75, 0xa2, 0xac, 0xae, 0x13, 0x27, 0x92, 0x01, 0x69, 0xb5, 0xb8, 0x8c, 0x47,
-	0x94, 0x2a, 0xce, 0x04, 0xff, 0x78, 0xc5, 0xea, 0x58, 0x8f, 0xed, 0x96, 0xed, 0xdb, 0x34, 0x58,
-	0x79, 0x8d, 0x16, 0x9e, 0x2c, 0xd2, 0x21, 0x04, 0xa7, 0xaa, 0x30, 0xd9, 0x90, 0xf2, 0x05, 0x97,
-	0x52, 0x97, 0xae, 0x1a, 0x75, 0x8f, 0xec, 0x06, 0x0d, 0xd1, 0x1c, 0x9b, 0x4a, 0x55, 0x71, 0x31,
-	0x13, 0xb4, 0xf6, 0x90, 0xba, 0x9e, 0xed, 0xb4, 0x03, 0x52, 0x7e, 0xc0, 0xb5, 0x41, 0xa2, 0x54,
-	0x90, 0xf1, 0x26, 0x4c, 0x78, 0xcd, 0xa7, 0xf5, 0x23, 0x9e, 0x2d, 0x26, 0xc7, 0xb9, 0x54, 0x13,
-	0xb2, 0xa8, 0x09, 0x5e, 0xf8, 0x9b, 0xbc, 0x0e, 0xb9, 0xa0, 0x66, 0xa6, 0xbf, 0x2d, 0x32, 0xa8,
-	0x1d, 0xc0, 0x1b, 0xff, 0x5f, 0x16, 0x37, 0xb2, 0x89, 0xbe, 0x91, 0x5d, 0xc8, 0x79, 0x3c, 0x53,
-	0x50, 0xf3, 0xda, 0x50, 0x3c, 0x59, 0xd8, 0xee, 0xd0, 0xb6, 0x68, 0x4c, 0x14, 0xae, 0x8f, 0x98,
-	0x01, 0xaa, 0xd2, 0xaf, 0x64, 0x80, 0x24, 0x21, 0xc8, 0xdb, 0xe2, 0x85, 0x19, 0xf7, 0x7f, 0x7e,
-	0xeb, 0x59, 0x5b, 0x5a, 0xe0, 0x6f, 0xbc, 0xd0, 0xa5, 0xe7, 0xf7, 0x34, 0x18, 0xc5, 0xe0, 0x07,
-	0x13, 0x90, 0xdb, 0xdb, 0x7a, 0xb0, 0xb5, 0xfd, 0x68, 0x4b, 0x1f, 0x61, 0x89, 0x95, 0x8d, 0xbd,
-	0xda, 0x6e, 0xc5, 0xd4, 0x35, 0xa2, 0xc3, 0xe4, 0xca, 0xc6, 0xf6, 0xde, 0x6a, 0x7d, 0xb9, 0xbc,
-	0xf2, 0x60, 0x6f, 0x47, 0xcf, 0x90, 0x19, 0x98, 0x58, 0x31, 0x2b, 0xab, 0x95, 0xad, 0xdd, 0x6a,
-	0x79, 0xa3, 0xa6, 0x67, 0x49, 0x1e, 0x46, 0xb7, 0xb6, 0x57, 0x2b, 0xfa, 0x28, 0x21, 0x30, 0xbd,
-	0xbd, 0xfc, 0xa5, 0xca, 0xca, 0x6e, 0xbd, 0xb6, 0xbb, 0x6d, 0x96, 0xef, 0x57, 0xf4, 0x31, 0x72,
-	0x0a, 0x66, 0x6a, 0x2b, 0xeb, 0x95, 0xd5, 0xbd, 0x8d, 0x4a, 0x7d, 0x67, 0x7b, 0xa3, 0xba, 0xf2,
-	0xae, 0x3e, 0x4e, 0x00, 0xc6, 0x1f, 0x6e, 0x6f, 0xec, 0x6d, 0x56, 0xf4, 0x1c, 0xfb, 0x5d, 0xde,
-	0xa8, 0x98, 0xbb, 0x35, 0x3d, 0xcf, 0x5a, 0xdb, 0xdc, 0xde, 0xdb, 0xda, 0xad, 0x97, 0x77, 0x77,
-	0xcb, 0x2b, 0xeb, 0x7a, 0x61, 0x79, 0x9c, 0xf7, 0xda, 0xf8, 0xa7, 0x1a, 0x40, 0x34, 0xb2, 0x6c,
-	0x4b, 0x78, 0x68, 0xbd, 0xe7, 0x04, 0xce, 0xe5, 0x3c, 0x81, 0xb9, 0x76, 0xdb, 0x09, 0xbc, 0xb1,
-	0x79, 0x82, 0xe5, 0x76, 0x2c, 0xbf, 0x71, 0x20, 0xbc, 0xb1, 0x79, 0x82, 0x14, 0x23, 0x79, 0x10,
-	0x51, 0x68, 0x82, 0xe1, 0x5e, 0x87, 0x5c, 0xd0, 0x4c, 0x11, 0x4e, 0x6f, 0xee, 0xd5, 0x76, 0xeb,
-	0xeb, 0xe5, 0x87, 0x95, 0xfa, 0x97, 0x2b, 0xe6, 0x76, 0xfd, 0x61, 0x79, 0x63, 0xaf, 0xa2, 0x8f,
-	0x90, 0x02, 0x8c, 0x6d, 0xb2, 0x36, 0xc5, 0x4f, 0xd6, 0x90, 0x7e, 0x91, 0xfd, 0xdc, 0x61, 0xd8,
-	0xf5, 0x91, 0x52, 0x46, 0xd7, 0x8c, 0x7f, 0xab, 0x85, 0x6f, 0x37, 0x02, 0x8c, 0x73, 0x30, 0xde,
-	0xc4, 0x07, 0x77, 0xc1, 0x32, 0xcc, 0x53, 0x32, 0x39, 0x19, 0x85, 0x1c, 0xb2, 0x06, 0xb9, 0x26,
-	0xf5, 0x2d, 0x3b, 0xbc, 0x74, 0xbb, 0x35, 0x40, 0x70, 0x17, 0x56, 0x39, 0xb8, 0x78, 0x33, 0x2b,
-	0x2a, 0x97, 0xee, 0xc1, 0xa4, 0x5c, 0x70, 0xa2, 0x73, 0xe3, 0x4f, 0x32, 0x30, 0x89, 0xda, 0x66,
-	0xd3, 0xde, 0x67, 0x3a, 0xcf, 0xa8, 0xc3, 0xd4, 0x76, 0x87, 0xa9, 0x3f, 0xdb, 0x69, 0xa3, 0x04,
-	0xcd, 0xc0, 0x44, 0xb5, 0x7d, 0x64, 0xb5, 0xec, 0x26, 0x4b, 0xea, 0x23, 0x4c, 0x16, 0x04, 0xb0,
-	0xb0, 0xed, 0xeb, 0x1a, 0x99, 0x85, 0x29, 0x91, 0xc7, 0x97, 0x6f, 0x3d, 0x43, 0xe6, 0x80, 0x28,
-	0x59, 0xf8, 0x88, 0x4c, 0xcf, 0x1a, 0x5b, 0x18, 0x3c, 0x6a, 0x9f, 0x32, 0x91, 0x10, 0x88, 0x31,
-	0xad, 0x8f, 0x30, 0x81, 0xe1, 0x4a, 0x4f, 0xd7, 0x98, 0xac, 0x0a, 0x8b, 0x93, 0x9e, 0x61, 0xa0,
-	0xf2, 0x35, 0x3b, 0x17, 0x4d, 0x76, 0x66, 0xd3, 0x47, 0x8d, 0x0e, 0x8c, 0x8b, 0xd3, 0xe7, 0x2c,
-	0x4c, 0x45, 0x08, 0xfd, 0xae, 0xc7, 0x31, 0xbe, 0xdd, 0xa5, 0x5d, 0xda, 0xd4, 0x35, 0xde, 0x11,
-	0x9b, 0xed, 0x1b, 0xec, 0x8f, 0x68, 0x53, 0xcf, 0x90, 0x69, 0x80, 0x6a, 0x3b, 0x08, 0x04, 0xa5,
-	0x67, 0x19, 0xf0, 0x9a, 0x65, 0xb7, 0x68, 0x53, 0x1f, 0x25, 0x93, 0x90, 0x5f, 0x11, 0xc7, 0x33,
-	0x7d, 0x0c, 0x53, 0x56, 0xbb, 0x41, 0x59, 0xd9, 0xb8, 0xf1, 0x2f, 0x35, 0x28, 0xca, 0x3c, 0xab,
-	0xb1, 0x63, 0x60, 0xb0, 0x3e, 0x56, 0xa1, 0xe0, 0x04, 0xfc, 0x13, 0x33, 0x3a, 0xa9, 0xe2, 0xe5,
-	0xda, 0x0b, 0x0a, 0xbb, 0xcd, 0xa8, 0xf6, 0x20, 0x2b, 0xcd, 0x39, 0x28, 0xf8, 0x96, 0xbb, 0x4f,
-	0xfd, 0xe8, 0xa0, 0x94, 0xe7, 0x19, 0xaa, 0xc5, 0x4d, 0x31, 0xb1, 0x1a, 0x7f, 0x9d, 0x8d, 0xce,
-	0x6b, 0x69, 0xf4, 0xab, 0x8d, 0x6a, 0xf1, 0x46, 0x7b, 0x59, 0xf2, 0xc8, 0x5e, 0xe8, 0xe6, 0x21,
-	0xbc, 0x2b, 0xef, 0xf5, 0x5c, 0xd8, 0x52, 0x9a, 0x5d, 0x50, 0x44, 0x65, 0x7d, 0x24, 0x7c, 0x8c,
-	0x4d, 0x41, 0x44, 0x1f, 0xad, 0xf3, 0xd0, 0x85, 0xc2, 0xc7, 0xf2, 0x0b, 0xcf, 0x8e, 0x9c, 0xc7,
-	0xf0, 0x1c, 0x31, 0x27, 0x8e, 0xa2, 0x24, 0x79, 0x0c, 0x13, 0x56, 0xab, 0x25, 0xf6, 0xa3, 0x5e,
-	0xe0, 0x6d, 0xf9, 0xf9, 0x67, 0x69, 0xa5, 0xdc, 0x6a, 0xf1, 0x86, 0xbc, 0xf5, 0x11, 0x13, 0xac,
-	0x30, 0x55, 0xba, 0x15, 0x9b, 0x23, 0x7d, 0x8f, 0xc6, 0xa5, 0xc5, 0xb4, 0xe9, 0x43, 0xce, 0x42,
-	0x9e, 0xc7, 0xc7, 0x0c, 0x6b, 0xe4, 0x30, 0x5d, 0x6d, 0x96, 0x4e, 0xc1, 0x6c, 0x82, 0x82, 0x20,
-	0xc4, 0xd0, 0x2b, 0x70, 0x36, 0x85, 0xec, 0x41, 0x76, 0xec, 0xc7, 0xd1, 0x91, 0x29, 0xb5, 0xe2,
-	0x32, 0x8c, 0xbb, 0xd4, 0xeb, 0xb6, 0xfc, 0x62, 0x2f, 0x9f, 0xff, 0x9e, 0x75, 0x4d, 0x51, 0x33,
-	0x4e, 0x19, 0x9f, 0x65, 0x83, 0x8e, 0x36, 0x46, 0x33, 0x41, 0x99, 0x5a, 0x71, 0x15, 0x72, 0xe2,
-	0xd0, 0x32, 0x14, 0x69, 0x4a, 0x65, 0x33, 0xa8, 0x1a, 0x38, 0x9c, 0xa6, 0x00, 0x8a, 0x8d, 0xeb,
-	0x3f, 0x1f, 0x03, 0x5d, 0x2e, 0xc6, 0xa3, 0x4d, 0xcf, 0xf3, 0xd8, 0x80, 0xe9, 0xfc, 0x22, 0xcc,
-	0xa0, 0xf9, 0x43, 0x3a, 0x14, 0x09, 0x93, 0x27, 0x66, 0x87, 0xc7, 0xa2, 0x79, 0x98, 0x55, 0xe0,
-	0xd0, 0x38, 0xca, 0xe7, 0xf8, 0x8c, 0x04, 0x89, 0xe6, 0xd1, 0x1b, 0xa0, 0xbb, 0xf4, 0xd0, 0xf1,
-	0x65, 0x13, 0x3d, 0x37, 0xf3, 0x4f, 0xf3, 0xfc, 0x87, 0x92, 0xeb, 0x11, 0x6e, 0x68, 0x23, 0x0b,
-	0x03, 0x7f, 0x9d, 0x3e, 0x25, 0xe5, 0xe2, 0x71, 0x6b, 0x2a, 0x08, 0x91, 0xe2, 0x31, 0xa5, 0x2d,
-	0x5e, 0xb1, 0x5e, 0xed, 0xaf, 0xe1, 0x50, 0xbf, 0x9b, 0x93, 0xa2, 0x26, 0xd7, 0xfe, 0x6f, 0x86,
-	0x47, 0xfa, 0x3c, 0xa2, 0xf8, 0xcc, 0x40, 0x14, 0xf2, 0xbb, 0xc7, 0x37, 0x60, 0x02, 0xa3, 0x79,
-	0x76, 0x71, 0x3d, 0x18, 0x22, 0x9e, 0x27, 0x30, 0x70, 0x11, 0x02, 0xfa, 0x0a, 0x4c, 0xe2, 0x7b,
-	0xe4, 0xba, 0x4b, 0x2d, 0xcf, 0x69, 0x0b, 0xbb, 0xd8, 0x04, 0xe6, 0x99, 0x98, 0x15, 0x33, 0x05,
-	0x4e, 0x7c, 0x32, 0x53, 0xe0, 0xe4, 0x49, 0x4d, 0x81, 0x31, 0xa3, 0xdc, 0x54, 0xc2, 0x28, 0xa7,
-	0x1a, 0x32, 0xa7, 0xe3, 0x86, 0xcc, 0x98, 0xcd, 0x6e, 0x26, 0x61, 0xb3, 0xdb, 0x84, 0xd3, 0x71,
-	0xb9, 0x65, 0x47, 0x16, 0x72, 0x17, 0x46, 0xa5, 0xd3, 0xd2, 0x95, 0xbe, 0x43, 0x82, 0x07, 0x12,
-	0x04, 0x97, 0xaf, 0x53, 0xa2, 0xc9, 0x1e, 0xd9, 0x00, 0x8d, 0x3f, 0xd1, 0xa0, 0x94, 0x56, 0x1a,
-	0x9e, 0x42, 0x46, 0xc5, 0xa1, 0x98, 0xb5, 0x7a, 0x77, 0x90, 0x16, 0x91, 0xed, 0x75, 0x51, 0xd8,
-	0x4f, 0x44, 0x51, 0xfa, 0x45, 0x28, 0xf4, 0x8b, 0x69, 0x39, 0xd0, 0x46, 0x97, 0xc6, 0x15, 0x79,
-	0xbb, 0xd4, 0x4c, 0xa8, 0x84, 0x58, 0x5f, 0x56, 0x62, 0x3a, 0xf1, 0xa5, 0x13, 0xf4, 0x26, 0x54,
-	0x8a, 0x3f, 0xc3, 0x0d, 0x06, 0x2a, 0x86, 0x1d, 0xcb, 0x76, 0x55, 0x73, 0x02, 0xde, 0xbb, 0xe1,
-	0x9c, 0x0e, 0xb5, 0x49, 0x27, 0xba, 0x77, 0x63, 0x05, 0x81, 0xeb, 0x46, 0x87, 0xdf, 0x25, 0x2a,
-	0xb0, 0x18, 0xae, 0x28, 0x83, 0xb1, 0xe5, 0x67, 0x15, 0x68, 0x8c, 0x5a, 0x74, 0x07, 0x4e, 0xc7,
-	0xe0, 0x7d, 0xe7, 0x29, 0x6d, 0x0b, 0x45, 0x44, 0x94, 0x0a, 0xbb, 0xac, 0x84, 0x7b, 0x4c, 0xfb,
-	0xf5, 0x26, 0x7d, 0x62, 0xb1, 0x4e, 0xf3, 0xdb, 0x20, 0xf0, 0xa8, 0xbf, 0xca, 0x73, 0x8c, 0x0f,
-	0x98, 0x82, 0x4f, 0x74, 0x45, 0xbe, 0x43, 0x54, 0xfb, 0xd2, 0x4c, 0xef, 0x4b, 0x33, 0xa5, 0x2f,
-	0xea, 0xbd, 0xa8, 0x04, 0x8d, 0x8f, 0x6a, 0x1e, 0x8b, 0x7d, 0x4e, 0x0f, 0x36, 0xae, 0xc4, 0x97,
-	0x88, 0x9b, 0x29, 0x23, 0x95, 0x5e, 0x37, 0x5a, 0x21, 0x82, 0x15, 0xb2, 0x57, 0xff, 0x86, 0x59,
-	0x21, 0x7b, 0xd4, 0x0d, 0x85, 0xe1, 0x58, 0x61, 0xe0, 0x8e, 0xeb, 0x34, 0xa8, 0xe7, 0x49, 0xc2,
-	0x20, 0x02, 0xbe, 0x25, 0x19, 0xc8, 0x0b, 0x22, 0x06, 0xf6, 0x1a, 0xdc, 0x4c, 0xaf, 0xc1, 0x35,
-	0x7e, 0x9a, 0x61, 0xf3, 0x36, 0xd9, 0xf6, 0xa7, 0x3f, 0x7a, 0xe4, 0x35, 0x28, 0xc6, 0xe0, 0xa3,
-	0xc0, 0x5d, 0x59, 0xb4, 0x4c, 0xcf, 0x29, 0x95, 0x2a, 0x61, 0x14, 0x2f, 0x33, 0x1e, 0x8b, 0xe8,
-	0xb5, 0x7e, 0x4c, 0x8f, 0xf5, 0xe9, 0x53, 0x88, 0x4a, 0xf4, 0xba, 0x32, 0x97, 0x55, 0x63, 0x5a,
-	0xff, 0xcd, 0xb6, 0x78, 0x17, 0xf6, 0xac, 0xb5, 0x2f, 0xc6, 0x85, 0x33, 0x66, 0x76, 0x7b, 0x4b,
-	0x19, 0x5c, 0x1c, 0xf1, 0xfb, 0x51, 0xe4, 0x29, 0x1e, 0x35, 0x91, 0xfa, 0x42, 0x48, 0xb8, 0x7f,
-	0x3f, 0x60, 0x16, 0x17, 0x8e, 0xcf, 0xc2, 0xb9, 0xd4, 0xea, 0x51, 0x48, 0x99, 0xa8, 0x66, 0xc1,
-	0xe4, 0x89, 0x70, 0x4b, 0x15, 0xd6, 0xbb, 0x2f, 0xd0, 0x05, 0x4b, 0xc5, 0x13, 0x71, 0xf3, 0x9e,
-	0x02, 0x20, 0x10, 0xaf, 0xc6, 0xe6, 0xd4, 0xad, 0x7e, 0xc3, 0x1b, 0x27, 0x2b, 0x9c, 0x55, 0x8a,
-	0x1f, 0x1c, 0x83, 0x34, 0xc3, 0x9e, 0x05, 0xa4, 0x1c, 0xc8, 0x7e, 0x70, 0x71, 0x90, 0xe7, 0x4a,
-	0xcc, 0x4f, 0x32, 0x61, 0x48, 0x1e, 0x06, 0x97, 0x7a, 0x6b, 0x9e, 0xe6, 0xb4, 0xdb, 0xef, 0x35,
-	0xe3, 0x4b, 0x30, 0x1b, 0x0f, 0x66, 0xc7, 0x27, 0x44, 0xc1, 0xd4, 0x63, 0xd1, 0xec, 0xf8, 0xe7,
-	0x65, 0x30, 0x4c, 0xb7, 0x30, 0x6e, 0x8b, 0x54, 0x34, 0x88, 0xe3, 0xd2, 0x20, 0x92, 0xfb, 0xd1,
-	0x0c, 0xcb, 0xf5, 0xf8, 0xb4, 0x4d, 0xac, 0x37, 0x9f, 0xc2, 0xb4, 0xba, 0x0e, 0x2f, 0xa8, 0x52,
-	0xd2, 0x23, 0x0a, 0x8f, 0xb1, 0x10, 0x9f, 0x06, 0x31, 0x67, 0xb9, 0x38, 0xfc, 0x23, 0x98, 0x8b,
-	0x23, 0x0e, 0x6f, 0xcd, 0x0b, 0x1d, 0xcb, 0x76, 0x65, 0x0b, 0xfe, 0xe5, 0x41, 0x3d, 0x37, 0xf3,
-	0x1d, 0xf1, 0xcb, 0xf8, 0x5a, 0x5c, 0xf6, 0xe3, 0xee, 0x76, 0x5f, 0x88, 0x09, 0xd3, 0xf5, 0x7e,
-	0xc8, 0xd3, 0xe4, 0xe8, 0x72, 0x7c, 0xf2, 0x24, 0x6c, 0xe8, 0xff, 0x43, 0x83, 0x0b, 0x52, 0xb9,
-	0x97, 0xea, 0x25, 0x2f, 0x16, 0x73, 0x49, 0xa9, 0x88, 0x9c, 0x6a, 0x93, 0x6c, 0xc3, 0x18, 0xeb,
-	0x50, 0xe0, 0xe7, 0xfb, 0x7a, 0x3f, 0x12, 0x93, 0xd8, 0x17, 0x44, 0x36, 0x06, 0xa8, 0x41, 0x3c,
-	0xa5, 0x2f, 0x03, 0x44, 0x99, 0xcf, 0x12, 0x9f, 0x25, 0xce, 0x70, 0x49, 0x46, 0xec, 0xf8, 0x24,
-	0x4f, 0x76, 0x77, 0x2d, 0xc6, 0xf3, 0x85, 0x93, 0x75, 0x28, 0x64, 0xfd, 0x7f, 0xd4, 0x20, 0x27,
-	0x6e, 0x53, 0x53, 0xdf, 0xff, 0xa4, 0x45, 0x35, 0x4b, 0x8b, 0x2c, 0x16, 0x7c, 0x67, 0x6b, 0x54,
-	0xfa, 0xce, 0xd6, 0xe7, 0x61, 0x72, 0xc3, 0xf2, 0xfc, 0x4d, 0xa7, 0x69, 0x3f, 0xb1, 0x69, 0x73,
-	0x08, 0xcf, 0x04, 0x05, 0x9e, 0xbc, 0x02, 0xf9, 0xc6, 0x81, 0xdd, 0x6a, 0xba, 0x38, 0x91, 0xd9,
-	0xb0, 0xa5, 0x7c, 0xc4, 0x46, 0xdc, 0x04, 0x87, 0x90, 0xc6, 0x17, 0x61, 0xdc, 0xa4, 0x6c, 0xbb,
-	0x48, 0x2e, 0xc3, 0x44, 0xd3, 0x76, 0x69, 0xc3, 0x77, 0x30, 0x06, 0xaa, 0x88, 0x46, 0x2e, 0x65,
-	0xa1, 0x97, 0x95, 0xdd, 0x0a, 0xe3, 0x90, 0xf3, 0x84, 0xd1, 0x81, 0x99, 0xf8, 0x05, 0xf3, 0x2d,
-	0x18, 0x75, 0x1d, 0x27, 0x60, 0x76, 0x6f, 0x32, 0x10, 0x8a, 0x2c, 0xb2, 0xc1, 0x09, 0x77, 0xac,
-	0x69, 0x4f, 0x87, 0x38, 0x85, 0xa6, 0x00, 0x33, 0xfe, 0x56, 0x06, 0xa6, 0xf1, 0xd9, 0x05, 0x95,
-	0x37, 0xe4, 0xf8, 0xd8, 0x2c, 0xb8, 0xdc, 0x48, 0x6e, 0xc8, 0xd5, 0x0a, 0x0b, 0xf8, 0x6e, 0x31,
-	0xf0, 0x22, 0xe4, 0x55, 0xc9, 0x06, 0x14, 0x9a, 0x4e, 0xe3, 0x29, 0x75, 0xed, 0x66, 0x20, 0xf9,
-	0x0b, 0x83, 0xf0, 0xac, 0x06, 0x15, 0x44, 0x70, 0xac, 0x10, 0x41, 0xe9, 0x75, 0x98, 0x90, 0x1a,
-	0x39, 0x89, 0xd6, 0x2b, 0xbd, 0x09, 0xd3, 0x2a, 0xde, 0x13, 0xe9, 0xcc, 0x3d, 0x38, 0xd3, 0xe3,
-	0xfb, 0x4b, 0xe4, 0x1e, 0x8c, 0xb9, 0x78, 0x87, 0xca, 0xb9, 0xf4, 0x99, 0x41, 0x1f, 0x6e, 0x32,
-	0xbb, 0x2d, 0x6a, 0xf2, 0x2a, 0xc6, 0xbf, 0xc8, 0x06, 0x31, 0x4d, 0x95, 0x62, 0xfc, 0xbc, 0xd8,
-	0x93, 0x27, 0xb4, 0xc1, 0x0e, 0xc2, 0xe2, 0xab, 0x0a, 0x9e, 0x30, 0xeb, 0xeb, 0x41, 0x81, 0xf8,
-	0xf2, 0x02, 0x0f, 0xfe, 0x4f, 0xed, 0xfd, 0x83, 0x20, 0x62, 0xb8, 0x48, 0x91, 0x87, 0x30, 0x21,
-	0xbe, 0xd4, 0xc5, 0xf0, 0x8a, 0xfb, 0xff, 0x57, 0x86, 0x21, 0x6f, 0xa1, 0x12, 0xd5, 0x43, 0xd3,
-	0xaa, 0x8c, 0x88, 0x1d, 0x3a, 0x71, 0xf2, 0x8d, 0x22, 0xc2, 0xbb, 0x43, 0x21, 0x2c, 0x3f, 0x79,
-	0x62, 0xb7, 0x6d, 0xff, 0x98, 0x25, 0xa2, 0xcb, 0x16, 0xf2, 0x10, 0x66, 0x0f, 0x2d, 0xbf, 0x71,
-	0x50, 0xa7, 0x1f, 0xe2, 0xe7, 0xc1, 0x70, 0x65, 0xe4, 0x3e, 0x65, 0xc9, 0x43, 0x05, 0x7a, 0xa3,
-	0xd6, 0x68, 0x0b, 0xe7, 0x8e, 0xf8, 0x22, 0x03, 0x36, 0xa0, 0x23, 0x8e, 0x4a, 0x84, 0xc2, 0x58,
-	0x80, 0x99, 0x58, 0x17, 0xc8, 0x24, 0x06, 0xe0, 0xc3, 0xaf, 0x38, 0xe8, 0x23, 0x64, 0x0a, 0x0a,
-	0x3b, 0x2e, 0x7d, 0x42, 0x5d, 0x96, 0xd4, 0x8c, 0x25, 0xd0, 0xe3, 0x14, 0xb2, 0x0a, 0x41, 0x9e,
-	0x3e, 0x42, 0x74, 0x98, 0x2c, 0xb7, 0x7d, 0x3b, 0xcc, 0xd1, 0x8c, 0xbf, 0xd0, 0xa0, 0xd8, 0x8b,
-	0xa4, 0x14, 0xd9, 0xda, 0x82, 0x3c, 0xb7, 0x4f, 0x8b, 0xab, 0x98, 0xe9, 0x94, 0x58, 0x2c, 0xbd,
-	0xd0, 0x09, 0x43, 0xb7, 0xe3, 0x9a, 0x21, 0x0e, 0x36, 0xea, 0x28, 0x9e, 0xc1, 0xa6, 0x5e, 0xa4,
-	0x8c, 0x07, 0x90, 0x0f, 0xa0, 0xc9, 0x38, 0x64, 0xaa, 0x6d, 0x7e, 0x1b, 0xb3, 0xe5, 0xf8, 0xd5,
-	0xb6, 0xae, 0x11, 0x80, 0xf1, 0xca, 0x87, 0xb6, 0xe7, 0x7b, 0xfc, 0x6e, 0x60, 0xd5, 0xa1, 0xde,
-	0x96, 0xe3, 0x63, 0x96, 0x9e, 0x65, 0x15, 0xee, 0xfb, 0xfa, 0x28, 0xfb, 0xbf, 0xe1, 0xeb, 0x63,
-	0xf3, 0x7f, 0x95, 0x09, 0xaf, 0x08, 0x66, 0x60, 0xa2, 0xb6, 0x5b, 0xde, 0xdd, 0xab, 0xd5, 0xb7,
-	0xb6, 0xb7, 0x2a, 0xfa, 0x88, 0x94, 0x51, 0xdd, 0xaa, 0xee, 0xea, 0x1a, 0xe3, 0xa9, 0xc8, 0xd8,
-	0x7e, 0xa0, 0x67, 0x08, 0x81, 0xe9, 0x20, 0xb9, 0xb6, 0xb6, 0x51, 0xdd, 0xaa, 0xe8, 0x59, 0xd6,
-	0xa2, 0xc8, 0xab, 0x98, 0xe6, 0xb6, 0xa9, 0x8f, 0x92, 0x22, 0x9c, 0x0e, 0xd1, 0xee, 0xd6, 0xab,
-	0x5b, 0xf5, 0xb7, 0xf7, 0xb6, 0xcd, 0xbd, 0x4d, 0x7d, 0x8c, 0x9c, 0x81, 0x53, 0xa2, 0x64, 0xb5,
-	0xb2, 0xb2, 0xbd, 0xb9, 0x59, 0xad, 0xd5, 0xaa, 0xdb, 0x5b, 0xfa, 0x38, 0x99, 0x03, 0x22, 0x0a,
-	0x36, 0xcb, 0xd5, 0xad, 0xdd, 0xca, 0x56, 0x79, 0x6b, 0xa5, 0xa2, 0xe7, 0xa4, 0x0a, 0xe2, 0xa6,
-	0xad, 0xbe, 0xba, 0xfd, 0x68, 0x4b, 0xcf, 0x93, 0x73, 0x70, 0x26, 0x5e, 0x50, 0xb9, 0x6f, 0x96,
-	0x57, 0x2b, 0xab, 0x7a, 0x41, 0xaa, 0xb5, 0x55, 0xa9, 0xac, 0xd6, 0xea, 0x66, 0x65, 0x79, 0x7b,
-	0x7b, 0x57, 0x07, 0x72, 0x1e, 0x8a, 0xb1, 0x5a, 0x66, 0x65, 0xb9, 0xbc, 0x81, 0x8d, 0x4d, 0x90,
-	0xcb, 0x70, 0x3e, 0x8e, 0xd3, 0xac, 0x3e, 0x64, 0x30, 0x3b, 0x1b, 0xe5, 0x95, 0x8a, 0x3e, 0x49,
-	0xae, 0xc2, 0xa5, 0xb4, 0x9e, 0xd5, 0xb7, 0xb6, 0xc3, 0x9b, 0xc0, 0x29, 0x32, 0x0d, 0x10, 0xf6,
-	0xe5, 0x1d, 0x7d, 0x7a, 0xfe, 0x87, 0x1a, 0x00, 0x0f, 0x3e, 0x89, 0x32, 0x78, 0x1a, 0x74, 0x44,
-	0x6b, 0xd6, 0x77, 0xdf, 0xdd, 0xa9, 0x04, 0x9c, 0x8f, 0xe5, 0xae, 0x55, 0x37, 0x2a, 0xba, 0x46,
-	0x5e, 0x80, 0x59, 0x39, 0x77, 0x79, 0x63, 0x7b, 0xe5, 0x01, 0xbf, 0x4c, 0x92, 0xb3, 0xf9, 0x5d,
-	0xa4, 0x9e, 0x25, 0x67, 0xe1, 0x05, 0x39, 0x5f, 0xdc, 0x6e, 0x56, 0x56, 0xf5, 0xd1, 0x38, 0xa6,
-	0xfb, 0x66, 0x79, 0x67, 0x5d, 0x1f, 0x9b, 0xff, 0x87, 0x1a, 0x8c, 0xf3, 0x0f, 0xf6, 0xb0, 0x71,
-	0x5c, 0xab, 0x29, 0x34, 0xcd, 0xc2, 0x54, 0x90, 0xb3, 0xbc, 0x6b, 0xae, 0xd5, 0xf8, 0x35, 0x69,
-	0x90, 0x55, 0x79, 0x67, 0xf7, 0x15, 0x2e, 0x70, 0x41, 0xce, 0xda, 0x5e, 0x8d, 0x09, 0xc4, 0x0c,
-	0x4c, 0x84, 0x88, 0xd6, 0x6a, 0xfa, 0xa8, 0x9c, 0xf1, 0x70, 0xad, 0xa6, 0x8f, 0xc9, 0x19, 0xef,
-	0xac, 0xd5, 0xf4, 0x71, 0x39, 0xe3, 0xcb, 0x6b, 0x35, 0x3d, 0x27, 0x37, 0xfd, 0xce, 0x5a, 0xed,
-	0x68, 0x49, 0xcf, 0xcf, 0xff, 0xa1, 0x06, 0x2f, 0xa4, 0x06, 0xf2, 0x24, 0x57, 0xe0, 0x02, 0xf6,
-	0xa7, 0x2e, 0x7a, 0xb8, 0xb2, 0x5e, 0xde, 0xba, 0x5f, 0x51, 0xba, 0x72, 0x0d, 0xae, 0xf4, 0x04,
-	0xd9, 0xdc, 0x5e, 0xad, 0xae, 0x55, 0x2b, 0xab, 0xba, 0x46, 0x0c, 0xb8, 0xd8, 0x13, 0xac, 0xbc,
-	0xca, 0x84, 0x2b, 0x43, 0x3e, 0x03, 0x97, 0x7b, 0xc2, 0xac, 0x56, 0x36, 0x2a, 0xbb, 0x95, 0x55,
-	0x3d, 0x3b, 0xef, 0xc3, 0xa4, 0xf2, 0x1d, 0x06, 0x26, 0xe0, 0x95, 0x87, 0x15, 0xb3, 0xba, 0xfb,
-	0xae, 0x42, 0x18, 0x13, 0x55, 0x25, 0xbf, 0xbc, 0x51, 0x36, 0x37, 0x75, 0x8d, 0x8d, 0xa5, 0x5a,
-	0xf0, 0xa8, 0x6c, 0x6e, 0x55, 0xb7, 0xee, 0xeb, 0x19, 0x9c, 0x5f, 0x31, 0x5c, 0xbb, 0xd5, 0xb5,
-	0x77, 0xf5, 0xec, 0xfc, 0x77, 0x35, 0x98, 0x94, 0x83, 0xee, 0xb3, 0x66, 0xcd, 0x4a, 0x6d, 0x7b,
-	0xcf, 0x5c, 0x51, 0xf9, 0x51, 0x84, 0xd3, 0x6a, 0xbe, 0xb8, 0xa6, 0xd6, 0xd2, 0x6a, 0xac, 0x56,
-	0xf4, 0x0c, 0xa3, 0x47, 0xcd, 0x0f, 0xee, 0xce, 0xb3, 0xac, 0x0f, 0x6a, 0x11, 0x72, 0x46, 0x1f,
-	0x9d, 0xff, 0x65, 0x0d, 0x66, 0x30, 0x7c, 0x3a, 0x0f, 0x90, 0x8c, 0x14, 0x95, 0x60, 0x0e, 0xaf,
-	0xc1, 0xeb, 0xe5, 0x95, 0xdd, 0xea, 0xf6, 0x96, 0x42, 0xd5, 0x79, 0x28, 0x26, 0xcb, 0x38, 0x4f,
-	0x75, 0x2d, 0xbd, 0x74, 0xc5, 0xac, 0x94, 0x77, 0x19, 0x7d, 0xa9, 0xa5, 0x7b, 0x3b, 0xab, 0xac,
-	0x34, 0x3b, 0xff, 0x5e, 0x10, 0x0b, 0x59, 0x0a, 0x55, 0xcd, 0xaa, 0xf0, 0x6e, 0x07, 0x75, 0x76,
-	0xca, 0x66, 0x79, 0x33, 0x20, 0xe6, 0x1c, 0x9c, 0x49, 0x2b, 0xdd, 0x5e, 0x5b, 0xd3, 0x35, 0xd6,
-	0x8b, 0xd4, 0xc2, 0x2d, 0x3d, 0x33, 0xbf, 0x04, 0x39, 0xf1, 0x11, 0x44, 0xee, 0x32, 0x80, 0xd8,
-	0x72, 0x90, 0xdd, 0xd8, 0x7e, 0xc4, 0x95, 0xf5, 0x66, 0x65, 0xb5, 0xba, 0xb7, 0xa9, 0x67, 0x58,
-	0xf1, 0x7a, 0xf5, 0xfe, 0xba, 0x9e, 0x9d, 0xff, 0x26, 0x14, 0xc2, 0x6f, 0x20, 0x32, 0x56, 0x57,
-	0xb7, 0xeb, 0x3b, 0xe6, 0x36, 0xd3, 0x02, 0xf5, 0x5a, 0xe5, 0xed, 0x3d, 0xee, 0x84, 0xa0, 0x8f,
-	0xb0, 0x69, 0x2c, 0x15, 0x99, 0xe5, 0xad, 0xd5, 0xed, 0x4d, 0x7e, 0xe1, 0x2c, 0x65, 0xaf, 0x2e,
-	0x73, 0x21, 0x51, 0xb2, 0xea, 0x66, 0x65, 0x73, 0x9b, 0xf1, 0x82, 0x29, 0x71, 0xa9, 0x64, 0x65,
-	0xb3, 0xa6, 0x8f, 0xce, 0xff, 0x30, 0x03, 0x13, 0x52, 0x40, 0x6b, 0xd6, 0x8e, 0xe8, 0x1f, 0x53,
-	0x65, 0xb2, 0xd8, 0x28, 0xd9, 0x3b, 0x95, 0xad, 0x55, 0x26, 0x93, 0x32, 0x43, 0x78, 0x49, 0xf9,
-	0x61, 0xb9, 0xba, 0x51, 0x5e, 0xde, 0x10, 0xa2, 0xa3, 0x96, 0xa1, 0xd3, 0x03, 0x9b, 0x26, 0x89,
-	0xa2, 0xd5, 0x8a, 0x28, 0x1a, 0x95, 0xf8, 0x1f, 0x15, 0xed, 0xae, 0xac, 0xb3, 0xe6, 0xc6, 0x98,
-	0x94, 0x2a, 0x85, 0x7c, 0xe9, 0x19, 0x4f, 0x10, 0x18, 0x4c, 0xc8, 0x1c, 0xb9, 0x08, 0x25, 0xa5,
-	0x64, 0xd7, 0x7c, 0x57, 0xb4, 0xc6, 0x30, 0xe6, 0x13, 0x35, 0xcd, 0x0a, 0xd3, 0xe8, 0x15, 0xbd,
-	0x30, 0xff, 0x3d, 0x2d, 0xb8, 0x6f, 0xaf, 0x05, 0xd1, 0xf8, 0xe5, 0xc6, 0xa3, 0xd5, 0xf3, 0x02,
-	0x9c, 0x8d, 0xe7, 0xef, 0xd6, 0x77, 0xcc, 0x4a, 0xad, 0xb2, 0xc5, 0xd6, 0xd2, 0xd3, 0xa0, 0xab,
-	0xc5, 0xe8, 0x66, 0x92, 0x40, 0x86, 0x0b, 0x5c, 0x36, 0xc6, 0x50, 0x5c, 0x31, 0xc5, 0xfa, 0x36,
-	0x3a, 0xff, 0x55, 0x98, 0x52, 0x3e, 0x72, 0xcd, 0x57, 0x43, 0xbe, 0x64, 0x71, 0xe1, 0xaa, 0x6f,
-	0x96, 0xef, 0x6f, 0x55, 0x76, 0xab, 0x2b, 0xfa, 0x08, 0x5f, 0x5b, 0x95, 0xc2, 0x5a, 0x8d, 0x29,
-	0x3b, 0x5c, 0x25, 0x95, 0xfc, 0xad, 0x87, 0x9b, 0x15, 0x3d, 0x33, 0x7f, 0x03, 0xa6, 0x02, 0xe3,
-	0xa3, 0xe3, 0xdb, 0x4f, 0x8e, 0x19, 0xa4, 0x98, 0xed, 0x42, 0xd5, 0x70, 0x22, 0x47, 0xe6, 0x29,
-	0x4c, 0x48, 0x5f, 0x4a, 0x63, 0xa3, 0xc9, 0xc7, 0x36, 0x18, 0x95, 0x77, 0x76, 0x2b, 0xe6, 0x16,
-	0x0a, 0x6e, 0xbc, 0x88, 0x2d, 0xf2, 0x58, 0xa4, 0xb1, 0x65, 0x37, 0xb5, 0xa8, 0x5e, 0x7b, 0x54,
-	0xdd, 0x5d, 0x59, 0xd7, 0x33, 0xf3, 0xbb, 0x30, 0x1d, 0xba, 0x05, 0xac, 0xb5, 0xac, 0x7d, 0x76,
-	0xc4, 0xd2, 0xb7, 0x77, 0xea, 0x6b, 0x1b, 0xe5, 0xfb, 0xb5, 0x7a, 0xe4, 0xd1, 0x33, 0x0b, 0x53,
-	0x61, 0x2e, 0x8e, 0x09, 0xaa, 0xd1, 0x30, 0x8b, 0x0f, 0x77, 0x7d, 0x6d, 0xdb, 0x5c, 0x61, 0xdd,
-	0xfc, 0x33, 0x0d, 0x3f, 0x74, 0x22, 0x05, 0x53, 0x44, 0xcd, 0xaa, 0xe4, 0xd4, 0xba, 0xed, 0xa6,
-	0x75, 0xcc, 0x25, 0x5f, 0x2d, 0xd9, 0x74, 0xb0, 0x84, 0x2b, 0x6a, 0xa5, 0x64, 0xb7, 0x4b, 0x3d,
-	0x56, 0x94, 0xc1, 0x61, 0x51, 0x8a, 0x1e, 0xd1, 0x66, 0x9b, 0x17, 0xe2, 0x00, 0xc7, 0xea, 0x1d,
-	0x74, 0x5d, 0x2c, 0x1b, 0x4d, 0xb6, 0xb6, 0xe6, 0xda, 0xac, 0x64, 0x2c, 0x59, 0xab, 0x66, 0xf9,
-	0x5d, 0x97, 0x95, 0x8d, 0xcf, 0x7f, 0x23, 0xfe, 0x0e, 0x81, 0xbf, 0x19, 0x20, 0x97, 0xe2, 0x3e,
-	0xe9, 0x3c, 0x7f, 0xaf, 0xfd, 0xb4, 0xed, 0x7c, 0xc0, 0xf6, 0x92, 0x97, 0xe3, 0xde, 0xc0, 0x1c,
-	0x20, 0xf8, 0xad, 0x6b, 0x6c, 0x89, 0x4d, 0x7d, 0x8e, 0xc0, 0x7d, 0x52, 0xb6, 0x3b, 0x7a, 0x66,
-	0xfe, 0x8f, 0x33, 0xf8, 0x7c, 0x33, 0xd5, 0xef, 0x18, 0x37, 0x4e, 0x3d, 0xca, 0x22, 0x32, 0x5e,
-	0xc4, 0xa7, 0xdd, 0xa9, 0x40, 0x5b, 0x8e, 0x8f, 0x37, 0xe6, 0xe8, 0xba, 0x72, 0x39, 0xdd, 0xef,
-	0x9d, 0xc1, 0xa1, 0x17, 0x4c, 0xa6, 0x5f, 0x73, 0xe5, 0xc7, 0xf8, 0x29, 0x60, 0x3d, 0xcb, 0x16,
-	0xfb, 0x5e, 0x40, 0x3b, 0x56, 0xd7, 0x43, 0xc7, 0x97, 0x3e, 0x88, 0x6a, 0xbe, 0xd3, 0xe9, 0xd0,
-	0xa6, 0x3e, 0xd6, 0x0f, 0x11, 0x8f, 0x96, 0xad, 0x8f, 0xf7, 0x83, 0x11, 0x5e, 0x36, 0xb9, 0xf9,
-	0x3f, 0x4a, 0x79, 0x29, 0x27, 0xfb, 0x1a, 0x93, 0xeb, 0x71, 0xd7, 0x50, 0xb5, 0x3c, 0xe2, 0xe4,
-	0xb5, 0xb8, 0xa3, 0xa9, 0x0a, 0x88, 0xdd, 0xd3, 0xb5, 0x24, 0xc3, 0x63, 0xbe, 0xce, 0xd4, 0xe3,
-	0xce, 0x4b, 0x9f, 0x89, 0x7b, 0xc2, 0xaa, 0x70, 0x8c, 0x13, 0x7a, 0x76, 0xe9, 0xdb, 0x19, 0x98,
-	0x95, 0xfc, 0xf0, 0xc4, 0xf7, 0x55, 0x3e, 0x82, 0x42, 0x68, 0x27, 0x22, 0xf3, 0xbd, 0x3f, 0x15,
-	0x13, 0x37, 0xce, 0x95, 0x5e, 0x1a, 0x0a, 0x56, 0x58, 0xef, 0xc9, 0x77, 0xfe, 0xfc, 0xe7, 0xdf,
-	0xcf, 0x4c, 0x12, 0x58, 0x3c, 0x7a, 0x79, 0x91, 0x7f, 0xc2, 0xe6, 0x8e, 0x46, 0x1c, 0x18, 0xe7,
-	0x56, 0x7e, 0x72, 0xbd, 0x37, 0x32, 0xe5, 0x16, 0xa1, 0x74, 0x63, 0x30, 0xa0, 0xda, 0xa4, 0x21,
-	0x35, 0xb9, 0xf4, 0xcf, 0x32, 0x70, 0x4a, 0x62, 0x41, 0xe0, 0xe1, 0x49, 0x7e, 0x47, 0x83, 0x49,
-	0xd9, 0xe5, 0x94, 0xa4, 0x86, 0x1b, 0xea, 0xe3, 0xbe, 0x5a, 0xba, 0x33, 0x7c, 0x85, 0x20, 0x66,
-	0x30, 0xd2, 0x77, 0x81, 0x9c, 0x63, 0xf4, 0xd9, 0x1c, 0xd2, 0xa6, 0xde, 0xa2, 0xec, 0xa7, 0x4a,
-	0xbe, 0xa3, 0x45, 0x4e, 0x80, 0xf3, 0xfd, 0x9a, 0x50, 0x5d, 0x58, 0xd3, 0x07, 0xa7, 0x87, 0x43,
-	0xab, 0x71, 0x11, 0x29, 0x29, 0x92, 0xb9, 0x18, 0x25, 0xc2, 0xf3, 0x6f, 0xe9, 0x27, 0x9a, 0xe2,
-	0x08, 0x1a, 0x84, 0x7f, 0xfe, 0x5d, 0x0d, 0xa6, 0xd5, 0x67, 0xe7, 0xe4, 0x4e, 0xba, 0x0b, 0x52,
-	0xef, 0xe7, 0xfb, 0xa5, 0x97, 0x4f, 0x50, 0x23, 0x8d, 0x71, 0xe2, 0x02, 0xc9, 0x5b, 0xb4, 0x39,
-	0xb0, 0xb8, 0x2c, 0x58, 0xfa, 0xab, 0x71, 0x98, 0x4b, 0xd2, 0xbc, 0x63, 0xd9, 0x2e, 0xe3, 0xe9,
-	0x38, 0xbf, 0xbd, 0x24, 0xb7, 0xfa, 0xb4, 0x9e, 0xb8, 0x48, 0x2d, 0xdd, 0x1e, 0x12, 0x5a, 0xd0,
-	0x79, 0x0e, 0xe9, 0x7c, 0xc1, 0xd0, 0x25, 0x3a, 0xd1, 0x8a, 0x7c, 0x4f, 0x9b, 0x27, 0xdf, 0xd3,
-	0x20, 0x27, 0xfa, 0x47, 0x06, 0xe1, 0x55, 0xaf, 0x00, 0x4a, 0x0b, 0xc3, 0x82, 0x07, 0x0e, 0xeb,
-	0x48, 0xc7, 0x25, 0x72, 0x21, 0x4e, 0x47, 0xc0, 0xb3, 0xc5, 0xaf, 0xdb, 0xcd, 0x8f, 0xc9, 0xff,
-	0xaf, 0xc9, 0x9a, 0x60, 0x71, 0x40, 0x23, 0x09, 0x75, 0x70, 0x67, 0xf8, 0x0a, 0x82, 0xae, 0x22,
-	0xd2, 0x45, 0x48, 0x82, 0x3f, 0xe4, 0x57, 0x35, 0xc8, 0x07, 0x37, 0x69, 0x64, 0x50, 0x77, 0x63,
-	0x77, 0x72, 0xa5, 0xc5, 0xa1, 0xe1, 0xd3, 0xc4, 0x5f, 0xe1, 0x0f, 0xbf, 0x40, 0xfa, 0x6d, 0x0d,
-	0x20, 0xba, 0x4c, 0x23, 0x83, 0x3a, 0x9a, 0xb8, 0x9a, 0xeb, 0x2b, 0xe3, 0xe9, 0x37, 0x75, 0xc6,
-	0x15, 0xa4, 0xe9, 0x9c, 0xd1, 0x83, 0x26, 0x26, 0x41, 0xdf, 0xd5, 0x42, 0xed, 0x39, 0x48, 0x8c,
-	0x55, 0x15, 0x7a, 0x7b, 0x48, 0x68, 0x55, 0x7c, 0xe6, 0x93, 0xe2, 0xf3, 0xf5, 0xe8, 0x3e, 0xf7,
-	0xe3, 0xa5, 0x1f, 0x65, 0x61, 0x46, 0x9a, 0x70, 0xf8, 0x39, 0x83, 0x6f, 0x45, 0x32, 0xfe, 0x62,
-	0x5a, 0xa3, 0xc9, 0xb0, 0x1a, 0xa5, 0xeb, 0x03, 0xe1, 0xd2, 0xb4, 0x00, 0x7e, 0x2b, 0x3b, 0x12,
-	0x67, 0xf1, 0x32, 0xf1, 0x63, 0xf2, 0x9b, 0x49, 0x15, 0x75, 0x7b, 0x40, 0x03, 0x31, 0xfd, 0xb4,
-	0x30, 0x2c, 0xb8, 0x20, 0xeb, 0x32, 0x92, 0x55, 0x22, 0xc5, 0x04, 0x59, 0x42, 0x33, 0x11, 0x4f,
-	0x9e, 0x66, 0x37, 0x7a, 0xa1, 0x4f, 0xcc, 0xaf, 0x9b, 0x43, 0x40, 0x0a, 0x1a, 0x66, 0x91, 0x86,
-	0x09, 0x52, 0x08, 0x69, 0x58, 0xfa, 0x13, 0x5d, 0x59, 0xfb, 0x85, 0x4b, 0xa7, 0x17, 0x2a, 0xc2,
-	0xeb, 0xbd, 0x23, 0xe0, 0xa8, 0x3a, 0xf0, 0xc6, 0x60, 0x40, 0x41, 0xc5, 0x1c, 0x52, 0xa1, 0x1b,
-	0x13, 0x8c, 0x0a, 0xe1, 0xaa, 0xca, 0xe4, 0xf6, 0x08, 0xc6, 0x30, 0x6a, 0x5c, 0xba, 0x48, 0x24,
-	0x03, 0xd4, 0x95, 0xae, 0x0f, 0x84, 0x13, 0x2d, 0x9e, 0xc7, 0x16, 0xe7, 0x8c, 0x59, 0xa9, 0xc5,
-	0xc5, 0x06, 0x03, 0x61, 0xed, 0x7e, 0xa3, 0xff, 0x66, 0x23, 0x25, 0x30, 0x5d, 0xbf, 0xce, 0xc6,
-	0x26, 0xc9, 0x25, 0x6c, 0xfa, 0xec, 0xfc, 0x19, 0xb9, 0xe9, 0xaf, 0x87, 0x6e, 0x8c, 0x1f, 0x93,
-	0x5f, 0x96, 0xf4, 0x7d, 0x1f, 0xb4, 0xb1, 0xd9, 0x70, 0x73, 0x08, 0x48, 0x41, 0xc1, 0x75, 0xa4,
-	0xe0, 0x0a, 0xb9, 0x24, 0x53, 0x10, 0xce, 0x08, 0x89, 0x92, 0x6f, 0xc1, 0xb8, 0xf0, 0x2c, 0xec,
-	0xc3, 0x07, 0x25, 0xac, 0x47, 0x3f, 0x3e, 0xc4, 0x22, 0x74, 0x18, 0x48, 0xc5, 0xf9, 0x52, 0x2f,
-	0x3e, 0xb0, 0x81, 0xf8, 0x56, 0xf0, 0x3d, 0xe6, 0x3e, 0x02, 0x20, 0x47, 0xa1, 0xeb, 0x27, 0x00,
-	0x4a, 0x40, 0x39, 0x75, 0xa5, 0x0b, 0x5a, 0xc7, 0xe8, 0x71, 0x0a, 0x07, 0xfe, 0xa6, 0x06, 0x53,
-	0x4a, 0xf8, 0xb6, 0xf4, 0x35, 0xa6, 0x77, 0xa8, 0xb9, 0xd2, 0xe2, 0xd0, 0xf0, 0xfd, 0x28, 0xc3,
-	0x28, 0x73, 0x0a, 0x65, 0xc7, 0x03, 0x37, 0xe3, 0xe9, 0xb1, 0xe4, 0xd2, 0xf7, 0x7b, 0x3d, 0x42,
-	0xcb, 0x19, 0xa7, 0x90, 0x98, 0x29, 0x22, 0xcf, 0x4c, 0xf2, 0xfb, 0x1a, 0x9c, 0x4e, 0x7b, 0x85,
-	0x4f, 0xee, 0x0e, 0x81, 0x3a, 0x19, 0xd8, 0xa1, 0xf4, 0xea, 0x49, 0xab, 0xa9, 0xab, 0xb1, 0x71,
-	0x4a, 0xe6, 0xd4, 0x13, 0x0e, 0xc4, 0xa4, 0xe7, 0x07, 0x5a, 0xf4, 0x59, 0x1e, 0xa1, 0xbc, 0x16,
-	0x4f, 0x18, 0xfd, 0xad, 0x74, 0x67, 0xf8, 0x0a, 0xaa, 0x5a, 0x37, 0x5e, 0x50, 0x24, 0x2b, 0xf8,
-	0x3a, 0x10, 0xa3, 0xeb, 0xf7, 0x34, 0x98, 0x89, 0x45, 0x55, 0x23, 0x43, 0xb4, 0xa3, 0xc6, 0x5a,
-	0x49, 0xdf, 0x2a, 0xf4, 0x0f, 0xd9, 0x76, 0x03, 0x49, 0x33, 0x8c, 0x0b, 0xa9, 0xa4, 0x2d, 0x8a,
-	0xe0, 0x26, 0x8c, 0xc4, 0x7f, 0x20, 0x3e, 0xf0, 0xa6, 0x04, 0x14, 0x23, 0x4b, 0x27, 0x08, 0x7e,
-	0x16, 0x90, 0xf9, 0xd9, 0x13, 0xd5, 0x11, 0x84, 0xde, 0x44, 0x42, 0xaf, 0x92, 0x2b, 0xe9, 0x84,
-	0xca, 0xf3, 0xe0, 0xcf, 0xd9, 0x49, 0xbb, 0x4f, 0xe8, 0x33, 0xf2, 0xd6, 0x27, 0x8a, 0xd8, 0x56,
-	0xfa, 0xfc, 0xb3, 0x56, 0x17, 0x5d, 0x79, 0x05, 0xbb, 0xb2, 0x60, 0xdc, 0x1c, 0xd8, 0x15, 0x59,
-	0x74, 0x7f, 0xaa, 0xc1, 0x5c, 0x7a, 0xc0, 0x33, 0xf2, 0xb9, 0xc1, 0x04, 0xa5, 0x46, 0x68, 0x2b,
-	0xbd, 0x76, 0xf2, 0x8a, 0xa2, 0x0f, 0x77, 0xb1, 0x0f, 0x8b, 0xc6, 0x7c, 0x5a, 0x1f, 0x16, 0xc3,
-	0x97, 0xc6, 0x31, 0xed, 0xbd, 0xf4, 0xc3, 0x51, 0xe5, 0x60, 0x85, 0xae, 0x01, 0xdc, 0xc6, 0x48,
-	0xbe, 0x09, 0xe3, 0xe2, 0xd7, 0xf5, 0x21, 0xc3, 0x1b, 0xf7, 0x5b, 0x59, 0xd4, 0x50, 0xc3, 0xea,
-	0x8e, 0x18, 0x1d, 0x1d, 0xf8, 0x37, 0x30, 0x17, 0xf9, 0x3f, 0xc6, 0xdf, 0x6f, 0xb2, 0x15, 0x7e,
-	0x50, 0xfb, 0x4a, 0xb4, 0xe0, 0xfe, 0x2b, 0xfc, 0x70, 0xed, 0xf3, 0x60, 0xc1, 0xac, 0xfd, 0x8f,
-	0x60, 0x0c, 0xd9, 0xd1, 0x6f, 0x61, 0x93, 0x23, 0x7d, 0xf7, 0x5b, 0xd8, 0xd4, 0xe0, 0xdd, 0x8a,
-	0xfa, 0x91, 0x1b, 0xc7, 0xdf, 0xac, 0xed, 0xef, 0x68, 0x90, 0x13, 0xd1, 0xaa, 0xfb, 0xed, 0x2f,
-	0xd4, 0x08, 0xdc, 0xfd, 0xf6, 0x17, 0xf1, 0xd0, 0xd7, 0x62, 0x65, 0x37, 0xce, 0xc4, 0x49, 0x10,
-	0xe1, 0x91, 0x99, 0x6c, 0xfc, 0x38, 0xab, 0x18, 0x0a, 0x84, 0xdb, 0x38, 0xa3, 0x6d, 0x0c, 0xad,
-	0x83, 0xbd, 0x0e, 0x2a, 0xe9, 0x4f, 0x94, 0x4a, 0xb7, 0x87, 0x84, 0xee, 0xbd, 0xfd, 0x3b, 0xe4,
-	0x70, 0xc1, 0x71, 0x89, 0x3f, 0x88, 0x21, 0x03, 0xf1, 0x2a, 0x2f, 0x6c, 0x7a, 0x9d, 0xb7, 0x7b,
-	0xbe, 0xb3, 0x11, 0x27, 0x13, 0xa3, 0x98, 0xa0, 0x63, 0xb1, 0x81, 0x90, 0x62, 0xbc, 0x82, 0x5b,
-	0xfe, 0x61, 0xba, 0x19, 0x3d, 0x4f, 0x18, 0x4c, 0x8e, 0xea, 0xa6, 0x6f, 0x9c, 0x45, 0x72, 0x4e,
-	0x91, 0x24, 0x5b, 0x96, 0x7e, 0xae, 0xce, 0x65, 0x29, 0xf0, 0x1a, 0xf9, 0xd1, 0x20, 0xfb, 0x44,
-	0xcf, 0x78, 0x7e, 0xe9, 0x04, 0xf6, 0x8e, 0xdb, 0x67, 0xbc, 0x8c, 0x04, 0xbe, 0x44, 0x50, 0x99,
-	0x4a, 0x81, 0xe2, 0xa4, 0xed, 0xab, 0x1a, 0x54, 0xee, 0xe3, 0x81, 0x26, 0x9c, 0x5e, 0x31, 0xfb,
-	0x4a, 0xb7, 0x87, 0x84, 0x4e, 0x33, 0xe1, 0xc8, 0xa4, 0xb1, 0x21, 0xfc, 0x8d, 0x01, 0x07, 0xf0,
-	0x5e, 0xb1, 0xf8, 0x06, 0x12, 0x11, 0x3b, 0x5b, 0x88, 0x75, 0x73, 0xfe, 0x4a, 0x82, 0x3f, 0x09,
-	0xbe, 0x7c, 0x5f, 0x0b, 0x37, 0xf7, 0x83, 0x48, 0x52, 0x97, 0x91, 0xdb, 0x43, 0x42, 0x0b, 0x92,
-	0x6e, 0x21, 0x49, 0x2f, 0x96, 0x06, 0x93, 0xc4, 0xd4, 0xc2, 0x7f, 0x1f, 0x53, 0x6d, 0x71, 0x61,
-	0x44, 0x0c, 0x8f, 0x1d, 0x46, 0xc4, 0x38, 0xa6, 0x47, 0x16, 0x48, 0xff, 0xe2, 0x50, 0xe9, 0xd6,
-	0x70, 0xc0, 0x82, 0xda, 0x12, 0x52, 0x7b, 0xda, 0x98, 0x41, 0x0b, 0x46, 0xd4, 0x3a, 0x1b, 0xc4,
-	0x5f, 0x52, 0xac, 0x5e, 0x0b, 0xfd, 0xf1, 0x26, 0xf6, 0x41, 0x8b, 0x43, 0xc3, 0x0b, 0x52, 0xce,
-	0x20, 0x29, 0xb3, 0x24, 0x4e, 0x0a, 0x3b, 0x93, 0x84, 0xf3, 0x6d, 0x40, 0xef, 0x62, 0xd3, 0xed,
-	0xf6, 0x90, 0xd0, 0x82, 0x82, 0x45, 0xa4, 0xe0, 0x26, 0xb9, 0x1e, 0xa3, 0x20, 0x9a, 0x6c, 0x4a,
-	0x18, 0x93, 0x8f, 0x65, 0x3b, 0xd3, 0x80, 0x31, 0x52, 0xa5, 0xfc, 0xd6, 0x70, 0xc0, 0xea, 0xf1,
-	0x75, 0xfe, 0x52, 0x9c, 0xac, 0x38, 0x39, 0x3f, 0xd2, 0x20, 0x1f, 0x7c, 0x4b, 0x83, 0x0c, 0xe8,
-	0x7b, 0xec, 0xc3, 0x1d, 0xa5, 0x85, 0x61, 0xc1, 0x05, 0x51, 0x77, 0x90, 0xa8, 0x79, 0x72, 0x23,
-	0x4e, 0xd4, 0x91, 0x80, 0x8c, 0x53, 0xb7, 0xf4, 0x7f, 0xc6, 0xe0, 0xac, 0x1c, 0xeb, 0x40, 0xfd,
-	0x4a, 0xd7, 0x77, 0x23, 0xb5, 0x35, 0xc4, 0xe7, 0xcf, 0x86, 0x38, 0xb3, 0xf4, 0xfd, 0x4c, 0xa2,
-	0xb0, 0x49, 0x18, 0xa7, 0x19, 0xf5, 0xc1, 0x7e, 0x2e, 0xf8, 0x4e, 0x60, 0xb0, 0x24, 0x0a, 0x6d,
-	0x31, 0x04, 0x39, 0xaa, 0xc2, 0xb8, 0x33, 0x7c, 0x05, 0x95, 0x9c, 0x52, 0x4f, 0x72, 0x7e, 0x4b,
-	0x99, 0x8a, 0x43, 0x7c, 0x35, 0x6d, 0xb8, 0x63, 0xc9, 0x80, 0x0f, 0x2e, 0x06, 0xdb, 0x06, 0x92,
-	0x4a, 0x97, 0xb2, 0x0e, 0x0e, 0xf5, 0x9d, 0x39, 0x65, 0x6e, 0xbe, 0x7c, 0x82, 0x1a, 0x82, 0x9c,
-	0x97, 0x90, 0x9c, 0x6b, 0xe4, 0x6a, 0x1a, 0x39, 0x92, 0x89, 0xd3, 0x3a, 0xa4, 0x1f, 0xcb, 0x4b,
-	0xd0, 0x10, 0x23, 0xa8, 0xce, 0xcf, 0x3b, 0xc3, 0x57, 0x50, 0x37, 0x36, 0xf3, 0xe7, 0x52, 0x49,
-	0xe3, 0x24, 0x2d, 0xfd, 0xb7, 0xe9, 0xd8, 0xc5, 0x4b, 0x78, 0x29, 0x39, 0xc4, 0xc5, 0x4b, 0x7a,
-	0xa8, 0xd4, 0xd2, 0xed, 0x21, 0xa1, 0xd3, 0x2f, 0x5e, 0xc2, 0x17, 0xc1, 0x28, 0x65, 0xbf, 0xa6,
-	0x85, 0xa1, 0x1a, 0xc8, 0xe0, 0xd0, 0x52, 0xca, 0xe1, 0x7c, 0x61, 0x58, 0xf0, 0xb4, 0x8d, 0xa0,
-	0x4c, 0x87, 0x7c, 0x28, 0xff, 0xad, 0x81, 0x66, 0xfc, 0xf4, 0x68, 0xa3, 0x03, 0x99, 0x12, 0x1b,
-	0x3c, 0x21, 0x57, 0xf3, 0x57, 0x13, 0xc4, 0xf0, 0xff, 0x8b, 0x5f, 0x0f, 0x1f, 0x53, 0x7f, 0x4c,
-	0x7e, 0xa0, 0x41, 0x21, 0x8c, 0xec, 0xd9, 0xeb, 0x2e, 0xa8, 0x67, 0xb8, 0xd1, 0x5e, 0x77, 0x41,
-	0x7d, 0x82, 0x86, 0x0a, 0xfb, 0x98, 0x51, 0x4a, 0x50, 0xc7, 0xbf, 0xf5, 0x63, 0xb5, 0x70, 0xd7,
-	0xfc, 0x6f, 0x7a, 0x19, 0xa9, 0xee, 0x0d, 0x68, 0xb1, 0x9f, 0x31, 0xe0, 0x8d, 0x67, 0xaa, 0x2b,
-	0x08, 0xbf, 0x8d, 0x84, 0x5f, 0x37, 0x8c, 0x04, 0xe1, 0x34, 0xa8, 0x26, 0x9b, 0x00, 0xfe, 0x46,
-	0xb4, 0xed, 0xbf, 0x35, 0x64, 0xac, 0xbf, 0xe1, 0x46, 0x3b, 0xb6, 0xe9, 0x57, 0x4e, 0x6b, 0x0a,
-	0x59, 0xfc, 0x49, 0x7a, 0x30, 0x13, 0x82, 0x47, 0x20, 0x03, 0x67, 0x98, 0x12, 0xdc, 0x6f, 0xe0,
-	0x4c, 0x88, 0x3d, 0xa4, 0xe8, 0x33, 0x13, 0x1a, 0x1c, 0x92, 0xd1, 0xf3, 0xbb, 0x1a, 0xe4, 0x44,
-	0x70, 0xb9, 0x81, 0xf4, 0xa8, 0xe1, 0xf0, 0x06, 0xd2, 0x13, 0x8b, 0x7c, 0x17, 0x5b, 0xd8, 0x65,
-	0x7a, 0x44, 0x40, 0xbb, 0xc5, 0xaf, 0x2b, 0x01, 0xdf, 0x3e, 0x26, 0x7f, 0x5b, 0x83, 0x09, 0x29,
-	0x72, 0x1c, 0x79, 0x79, 0x88, 0xf1, 0x50, 0x43, 0xdf, 0x95, 0x96, 0x4e, 0x52, 0x45, 0xdd, 0x16,
-	0x19, 0xe7, 0x53, 0xc7, 0x91, 0x36, 0x10, 0x9a, 0x31, 0xef, 0x47, 0x8c, 0xbe, 0x28, 0xa4, 0xda,
-	0x60, 0xfa, 0x12, 0x91, 0xdf, 0x06, 0xd3, 0x97, 0x8c, 0xd8, 0xd6, 0x67, 0xde, 0x86, 0x06, 0x24,
-	0x46, 0xdd, 0x8f, 0x03, 0xea, 0x84, 0xa6, 0x1b, 0x8a, 0x3a, 0x55, 0xdd, 0x2d, 0x9d, 0xa4, 0x8a,
-	0xa0, 0xee, 0x73, 0x48, 0xdd, 0xcb, 0xf3, 0x8b, 0xbd, 0xa9, 0x0b, 0xd5, 0x9e, 0x14, 0x1f, 0xee,
-	0x63, 0xf2, 0xf7, 0x34, 0x98, 0x56, 0xa3, 0xab, 0x91, 0x57, 0x4e, 0x18, 0x8c, 0x8d, 0x53, 0x7d,
-	0xf7, 0x99, 0x42, 0xb8, 0x05, 0xd3, 0x97, 0xf4, 0x61, 0xeb, 0xf2, 0x79, 0x38, 0xd5, 0x70, 0x0e,
-	0xe3, 0xf8, 0x77, 0xb4, 0x2f, 0x67, 0xad, 0x8e, 0xfd, 0x78, 0x1c, 0xdf, 0x59, 0x7d, 0xf6, 0xff,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x71, 0xff, 0x84, 0xdc, 0xe0, 0xa5, 0x00, 0x00,
+	// 11616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x6d, 0x6c, 0x1c, 0x49,
+	0x76, 0x98, 0x7a, 0x86, 0x33, 0xe4, 0x3c, 0x7e, 0x35, 0x5b, 0x5a, 0x6a, 0x34, 0xfa, 0xa2, 0x5a,
+	0xab, 0x95, 0xc4, 0x95, 0x48, 0x2d, 0x6f, 0xb5, 0xb7, 0xab, 0xfd, 0x38, 0x8f, 0x38, 0x43, 0x71,
+	0x4e, 0xfc, 0xda, 0x1e, 0x52, 0xda, 0x3d, 0xfb, 0x6e, 0xae, 0x35, 0x5d, 0x24, 0xfb, 0x34, 0x9c,
+	0x9e, 0xed, 0xee, 0xe1, 0x2e, 0xf7, 0x6e, 0xef, 0x12, 0x03, 0x07, 0x3b, 0xf6, 0xf9, 0xce, 0xf1,
+	0xf9, 0x03, 0xe7, 0xcb, 0x87, 0x83, 0xc0, 0xce, 0x87, 0x73, 0x40, 0x2e, 0x01, 0x02, 0x24, 0x31,
+	0x62, 0xc0, 0x3f, 0xe2, 0x9c, 0x13, 0x38, 0x3f, 0x8c, 0xfc, 0x0a, 0x12, 0x20, 0xc0, 0x21, 0x88,
+	0x11, 0xd8, 0x01, 0xfc, 0x2f, 0x40, 0x80, 0x04, 0xf5, 0xd5, 0x5d, 0xd5, 0x1f, 0x33, 0x3d, 0x5a,
+	0x6d, 0xfe, 0x90, 0x53, 0xaf, 0x5e, 0xbd, 0x7a, 0xf5, 0xea, 0xd5, 0xab, 0xaa, 0x57, 0x55, 0xaf,
+	0x61, 0xda, 0xec, 0xd9, 0xcb, 0x66, 0xcf, 0x5e, 0xea, 0xb9, 0x8e, 0xef, 0x68, 0xb3, 0x4e, 0x0f,
+	0x75, 0x3d, 0xdf, 0x71, 0xcd, 0x03, 0xb4, 0x64, 0xf6, 0xec, 0xca, 0xe5, 0x03, 0xc7, 0x39, 0xe8,
+	0xa0, 0x65, 0x92, 0xfd, 0xa4, 0xbf, 0xbf, 0xec, 0xdb, 0x47, 0xc8, 0xf3, 0xcd, 0xa3, 0x1e, 0x2d,
+	0x51, 0xb9, 0xc0, 0x10, 0x08, 0x9d, 0x6e, 0xd7, 0xf1, 0x4d, 0xdf, 0x76, 0xba, 0x1e, 0xcd, 0xd5,
+	0xff, 0x7e, 0x1e, 0x66, 0x9b, 0x94, 0x9c, 0x81, 0x3c, 0xa7, 0xef, 0xb6, 0x91, 0x36, 0x03, 0x39,
+	0xdb, 0x2a, 0x2b, 0x0b, 0xca, 0x8d, 0x92, 0x91, 0xb3, 0x2d, 0x4d, 0x83, 0xb1, 0x9e, 0xe9, 0x1f,
+	0x96, 0x73, 0x04, 0x42, 0x7e, 0x6b, 0xaf, 0x41, 0xf1, 0x08, 0x59, 0x76, 0xff, 0xa8, 0x9c, 0x5f,
+	0x50, 0x6e, 0xcc, 0xac, 0x5c, 0x5a, 0x8a, 0x30, 0xb6, 0xc4, 0xa8, 0x6e, 0x12, 0x2c, 0x83, 0x61,
+	0x6b, 0xf3, 0x50, 0x74, 0xba, 0x1d, 0xbb, 0x8b, 0xca, 0x63, 0x0b, 0xca, 0x8d, 0x09, 0x83, 0xa5,
+	0x70, 0x1d, 0xb6, 0xd3, 0xf3, 0xca, 0x85, 0x05, 0xe5, 0xc6, 0x98, 0x41, 0x7e, 0x6b, 0xe7, 0xa1,
+	0xe4, 0xa1, 0x0f, 0x5a, 0x1f, 0xba, 0xb6, 0x8f, 0xca, 0xc5, 0x05, 0xe5, 0x86, 0x62, 0x4c, 0x78,
+	0xe8, 0x83, 0xc7, 0x38, 0xad, 0x9d, 0x03, 0xfc, 0xbb, 0xe5, 0x22, 0xd3, 0x2a, 0x8f, 0x93, 0xbc,
+	0x71, 0x0f, 0x7d, 0x60, 0x20, 0xd3, 0xc2, 0x75, 0xb8, 0x66, 0xd7, 0x32, 0x1e, 0x97, 0x27, 0x48,
+	0x06, 0x4b, 0xe1, 0x3a, 0x3c, 0xfb, 0x63, 0x54, 0x2e, 0xd1, 0x3a, 0xf0, 0x6f, 0x0c, 0xeb, 0x7b,
+	0xc8, 0x2a, 0x03, 0x85, 0xe1, 0xdf, 0xda, 0x35, 0x98, 0x71, 0x99, 0x98, 0x5a, 0x5e, 0x0f, 0x21,
+	0xab, 0x3c, 0x49, 0x5a, 0x3e, 0xcd, 0xa1, 0x4d, 0x0c, 0xd4, 0x3e, 0x0f, 0xa5, 0x8e, 0xe9, 0xf9,
+	0x2d, 0xaf, 0x6d, 0x76, 0xcb, 0x53, 0x0b, 0xca, 0x8d, 0xc9, 0x95, 0xca, 0x12, 0x15, 0xf6, 0x12,
+	0xef, 0x8d, 0xa5, 0x5d, 0xde, 0x1b, 0xc6, 0x04, 0x46, 0x6e, 0xb6, 0xcd, 0xae, 0x56, 0x81, 0x89,
+	0x23, 0xe4, 0x9b, 0x96, 0xe9, 0x9b, 0xe5, 0x69, 0x22, 0x85, 0x20, 0xad, 0x9d, 0x81, 0x42, 0xdb,
+	0x6c, 0x1f, 0xa2, 0xf2, 0x0c, 0xc9, 0xa0, 0x09, 0xfd, 0x4f, 0x73, 0x30, 0xc9, 0xe4, 0xb9, 0xe3,
+	0x38, 0x1d, 0xdc, 0x43, 0x8d, 0x1a, 0xe9, 0xa1, 0x82, 0x91, 0x6b, 0xd4, 0xb4, 0x45, 0xc8, 0xaf,
+	0x3a, 0x1e, 0xe9, 0xa0, 0x99, 0x95, 0x72, 0xac, 0x2b, 0x56, 0x1d, 0x6f, 0xf7, 0xa4, 0x87, 0x0c,
+	0x8c, 0x84, 0x7b, 0x6e, 0x73, 0xa4, 0x9e, 0xa3, 0xff, 0xb5, 0x0b, 0x50, 0x32, 0x4c, 0xdb, 0xda,
+	0x40, 0xc7, 0xa8, 0x43, 0x3a, 0xaf, 0x64, 0x84, 0x00, 0x9c, 0xbb, 0xeb, 0xf8, 0x66, 0xa7, 0x89,
+	0x05, 0x3c, 0x4e, 0x84, 0x19, 0x02, 0xb0, 0x94, 0xf7, 0xb0, 0x94, 0x27, 0xa8, 0x94, 0xf1, 0x6f,
+	0xed, 0x67, 0xa0, 0xd8, 0x31, 0x9f, 0xa0, 0x8e, 0x57, 0x2e, 0x2d, 0xe4, 0x6f, 0x4c, 0xae, 0xdc,
+	0x48, 0xe3, 0x03, 0xb7, 0x78, 0x69, 0x83, 0xa0, 0xd6, 0xbb, 0xbe, 0x7b, 0x62, 0xb0, 0x72, 0x95,
+	0x37, 0x60, 0x52, 0x00, 0x6b, 0x2a, 0xe4, 0x9f, 0xa2, 0x13, 0xa6, 0xb7, 0xf8, 0x27, 0x16, 0xe6,
+	0xb1, 0xd9, 0xe9, 0x23, 0xa6, 0xb9, 0x34, 0x71, 0x2f, 0xf7, 0xba, 0xa2, 0xff, 0x6b, 0x05, 0xa6,
+	0x1f, 0x39, 0x9d, 0xfe, 0x11, 0xda, 0x70, 0xda, 0xa6, 0xef, 0xb8, 0x98, 0xc5, 0xae, 0x79, 0x84,
+	0x58, 0x71, 0xf2, 0x5b, 0xdb, 0x83, 0xe9, 0x63, 0x82, 0xd4, 0x62, 0x9c, 0xe6, 0x08, 0xa7, 0x77,
+	0x62, 0x9c, 0x4a, 0xa4, 0x78, 0x4a, 0xe0, 0x78, 0xea, 0x58, 0x00, 0x55, 0xbe, 0x00, 0x73, 0x31,
+	0x94, 0x91, 0xb8, 0x7f, 0x15, 0x8a, 0x4d, 0x3a, 0x54, 0xe7, 0xa1, 0xd8, 0x33, 0x5d, 0xd4, 0xf5,
+	0x59, 0x41, 0x96, 0x22, 0xaa, 0x8e, 0x15, 0x97, 0x0d, 0x59, 0xfc, 0x5b, 0x3f, 0x0b, 0x85, 0x07,
+	0xae, 0xd3, 0xef, 0x45, 0xc7, 0xb7, 0x5e, 0x03, 0x68, 0x38, 0x4d, 0xdf, 0x35, 0x7d, 0x74, 0x70,
+	0x82, 0x07, 0x96, 0xe9, 0x9d, 0x74, 0xdb, 0x2d, 0xdb, 0x21, 0x38, 0x13, 0xc6, 0x38, 0x49, 0x37,
+	0x1c, 0x3c, 0x20, 0x91, 0xe9, 0x76, 0x4e, 0x5a, 0x66, 0xfb, 0x29, 0x21, 0x3d, 0x61, 0x4c, 0x10,
+	0x40, 0xb5, 0xfd, 0x54, 0xff, 0xaf, 0x25, 0x00, 0xda, 0xac, 0x66, 0x0f, 0xb5, 0xb1, 0x42, 0xa0,
+	0xde, 0x21, 0x3a, 0x42, 0xae, 0xd9, 0x61, 0x74, 0x42, 0x40, 0x30, 0x14, 0x73, 0xc2, 0x50, 0x5c,
+	0x86, 0xe2, 0xbe, 0xe3, 0x1e, 0x99, 0x3e, 0x53, 0xcc, 0xb3, 0x31, 0x31, 0xaf, 0x35, 0x89, 0x1a,
+	0x33, 0x34, 0xed, 0x22, 0xc0, 0x93, 0x8e, 0xd3, 0x7e, 0xda, 0x22, 0xa4, 0xb0, 0x4a, 0xe6, 0x8d,
+	0x12, 0x81, 0x10, 0xa5, 0x3b, 0x07, 0x13, 0x87, 0x66, 0xab, 0x43, 0xf4, 0xb5, 0x40, 0x32, 0xc7,
+	0x0f, 0x4d, 0xaa, 0xad, 0x8b, 0x90, 0x6f, 0x3b, 0x5e, 0xb9, 0x38, 0x6c, 0xbc, 0xb4, 0x1d, 0x4f,
+	0x7b, 0x03, 0xc0, 0x76, 0x5a, 0x3d, 0xd7, 0xd9, 0xb7, 0x3b, 0x54, 0xb5, 0x67, 0x56, 0x2a, 0xb1,
+	0x22, 0x0d, 0x67, 0x87, 0x62, 0x18, 0x25, 0x9b, 0xff, 0xc4, 0xbd, 0x63, 0x21, 0xab, 0xdf, 0x43,
+	0x44, 0xf1, 0x27, 0x0c, 0x96, 0xd2, 0x5e, 0x86, 0x39, 0xaf, 0x6b, 0xf6, 0xbc, 0x43, 0xc7, 0x6f,
+	0xd9, 0x5d, 0x1f, 0xb9, 0xc7, 0x66, 0x87, 0x58, 0xa5, 0x69, 0x43, 0xe5, 0x19, 0x0d, 0x06, 0xd7,
+	0x8c, 0xa8, 0x12, 0x02, 0x51, 0xc2, 0xdb, 0x29, 0x4a, 0x88, 0x85, 0x3f, 0x4c, 0x03, 0x31, 0x63,
+	0xde, 0xa1, 0xe9, 0x32, 0xcb, 0x36, 0x61, 0xb0, 0x94, 0xf6, 0x16, 0x4c, 0xba, 0xa8, 0xd7, 0xb1,
+	0xdb, 0x66, 0xcb, 0x43, 0x3e, 0x33, 0x6a, 0xe7, 0x63, 0x35, 0x19, 0x14, 0xa7, 0x89, 0x7c, 0x03,
+	0xdc, 0xe0, 0x37, 0x6e, 0x96, 0x79, 0x70, 0xe0, 0xa2, 0x03, 0x6a, 0x3a, 0xa9, 0xe4, 0xa7, 0x69,
+	0xb3, 0x84, 0x8c, 0xc0, 0x60, 0xa0, 0x6e, 0xdb, 0x3d, 0xe9, 0xf9, 0xc8, 0x62, 0xc6, 0x2e, 0x04,
+	0x68, 0x97, 0x00, 0x7a, 0xa6, 0xe7, 0xf5, 0x0e, 0x5d, 0xd3, 0x43, 0xe5, 0x59, 0xa2, 0xaa, 0x02,
+	0x44, 0x92, 0xa0, 0xd7, 0x3e, 0x44, 0x56, 0xbf, 0x83, 0xca, 0x2a, 0x41, 0x0b, 0x24, 0xd8, 0x64,
+	0x70, 0x3c, 0x90, 0xbc, 0xb6, 0xd9, 0x41, 0xe5, 0x39, 0xc2, 0x0b, 0x4d, 0x10, 0x19, 0xf8, 0x76,
+	0xfb, 0xe9, 0x49, 0x59, 0x63, 0x32, 0x20, 0x29, 0xed, 0x16, 0x14, 0x0e, 0xf0, 0x30, 0x29, 0xbf,
+	0x40, 0x5a, 0x3f, 0x1f, 0x6b, 0x3d, 0x19, 0x44, 0x06, 0x45, 0xc2, 0x73, 0x05, 0xf9, 0xd1, 0x42,
+	0xdd, 0x7d, 0xc7, 0x6d, 0x23, 0xab, 0x3c, 0x4f, 0xa8, 0x4d, 0x13, 0x68, 0x9d, 0x01, 0x71, 0x7b,
+	0xda, 0xce, 0x51, 0xcf, 0x45, 0x1e, 0x36, 0x83, 0x67, 0x09, 0x8a, 0x00, 0xc1, 0x53, 0x42, 0xdb,
+	0xf4, 0xda, 0xa6, 0x85, 0xac, 0x72, 0x99, 0x0e, 0x2c, 0x9e, 0xd6, 0xca, 0x30, 0xfe, 0x35, 0xa7,
+	0xef, 0x76, 0xcd, 0x4e, 0xf9, 0x1c, 0x1d, 0x8f, 0x2c, 0x89, 0x4b, 0xd1, 0x8e, 0x3b, 0x7e, 0xb5,
+	0x5c, 0xa1, 0xa5, 0x78, 0x5a, 0xbb, 0x0c, 0x93, 0x1f, 0xf4, 0x51, 0x1f, 0xb5, 0x2c, 0xd4, 0xf3,
+	0x0f, 0xcb, 0xe7, 0x49, 0xd3, 0x81, 0x80, 0x6a, 0x18, 0xa2, 0xbd, 0x01, 0xe7, 0x08, 0x73, 0xad,
+	0x7e, 0xd7, 0xeb, 0xf7, 0x7a, 0x8e, 0xeb, 0x23, 0xab, 0xb5, 0xef, 0xb5, 0xfc, 0x93, 0x1e, 0x2a,
+	0x5f, 0x20, 0xd4, 0xe6, 0x09, 0xc2, 0x5e, 0x98, 0xbf, 0x46, 0xc6, 0x05, 0xee, 0xbb, 0xae, 0x63,
+	0xd9, 0x5e, 0xdb, 0x74, 0xad, 0xf2, 0x45, 0xda, 0x77, 0x01, 0x00, 0x2b, 0x91, 0xed, 0xb4, 0x3c,
+	0x66, 0x4f, 0xca, 0x97, 0x52, 0x94, 0x28, 0x34, 0x39, 0x06, 0xd8, 0xc1, 0x6f, 0xed, 0x31, 0x68,
+	0xbd, 0x8e, 0xd9, 0x46, 0x47, 0xa8, 0xeb, 0x87, 0x44, 0x2e, 0x2f, 0x28, 0x89, 0x53, 0x04, 0x55,
+	0xf4, 0x1d, 0x5e, 0x20, 0xa0, 0x38, 0xd7, 0x8b, 0x82, 0x3e, 0xbd, 0xd5, 0xfd, 0x5f, 0xe3, 0xa0,
+	0x86, 0x63, 0x6c, 0xaf, 0x67, 0x99, 0x3e, 0xd6, 0x2d, 0xc1, 0x90, 0xad, 0x9f, 0x62, 0xa6, 0xec,
+	0x7c, 0xd4, 0xf4, 0xac, 0x2b, 0xa1, 0xf1, 0xb9, 0x95, 0xc9, 0xf8, 0xac, 0xe7, 0xa8, 0xf9, 0x79,
+	0x73, 0x34, 0xf3, 0xb3, 0x9e, 0x17, 0x0d, 0x50, 0x59, 0x36, 0x40, 0xeb, 0x63, 0x81, 0x09, 0xba,
+	0x9d, 0x6a, 0x82, 0xd6, 0x0b, 0x09, 0x46, 0xe8, 0xbd, 0x64, 0x23, 0xf4, 0xb9, 0x01, 0x46, 0x88,
+	0x0a, 0x68, 0xa8, 0x29, 0x2a, 0xcb, 0xa6, 0x68, 0xbd, 0xf8, 0x9c, 0x8c, 0xd1, 0x42, 0xdc, 0x82,
+	0xac, 0x8f, 0x4b, 0x36, 0xe4, 0x76, 0xaa, 0x0d, 0x59, 0x9f, 0x48, 0xb0, 0x22, 0xf3, 0x92, 0x15,
+	0x59, 0x2f, 0x71, 0x3b, 0x52, 0x96, 0xed, 0xc8, 0x3a, 0x04, 0x96, 0x64, 0x89, 0x5b, 0x92, 0xd3,
+	0x83, 0x2c, 0xc9, 0xfa, 0x24, 0xb7, 0x25, 0x95, 0x70, 0xa0, 0x13, 0x0b, 0xb1, 0x3e, 0x15, 0x0e,
+	0xf5, 0x0b, 0xc2, 0x50, 0x27, 0x06, 0x62, 0x7d, 0x5a, 0x18, 0xec, 0x57, 0xe4, 0xc1, 0x7e, 0x8e,
+	0x70, 0x38, 0x23, 0x0e, 0xf7, 0x4f, 0xad, 0xfe, 0xf7, 0x01, 0x26, 0xb0, 0x6e, 0xb7, 0x9c, 0x9e,
+	0x7f, 0x7f, 0x06, 0xa6, 0xb8, 0x7e, 0x93, 0x74, 0x09, 0xc6, 0xdb, 0x8e, 0x47, 0x7e, 0xaa, 0x30,
+	0x13, 0xea, 0x2b, 0x81, 0x4c, 0x01, 0x50, 0xa5, 0x23, 0xa9, 0xb3, 0xf0, 0x42, 0x4c, 0xf1, 0x38,
+	0x1a, 0x6d, 0x0f, 0x27, 0x13, 0x76, 0x55, 0xac, 0x20, 0xef, 0x2e, 0x92, 0x31, 0x09, 0x25, 0xd2,
+	0x13, 0x01, 0x15, 0x22, 0x7d, 0x9e, 0x45, 0xad, 0x33, 0x4e, 0x4c, 0xc3, 0x24, 0x93, 0x26, 0x6f,
+	0x03, 0x97, 0x1f, 0x49, 0xcf, 0xc1, 0xac, 0x20, 0x43, 0x0c, 0xd2, 0x75, 0x80, 0x50, 0xbb, 0xb0,
+	0x68, 0xba, 0x8e, 0x85, 0xbc, 0xb2, 0xb2, 0x90, 0xc7, 0xa2, 0x21, 0x09, 0xfd, 0xf7, 0x15, 0x98,
+	0x35, 0xfa, 0x5d, 0xbc, 0xeb, 0x6a, 0xfa, 0xa6, 0x8f, 0x36, 0xcd, 0x9e, 0xf6, 0x18, 0xa6, 0x5d,
+	0x0a, 0x6a, 0x79, 0x18, 0x46, 0x4a, 0x4c, 0xae, 0xac, 0xc4, 0x75, 0x57, 0x2e, 0x28, 0xa5, 0xd9,
+	0x60, 0x71, 0x05, 0x10, 0xee, 0xc4, 0x18, 0xca, 0x48, 0x36, 0xec, 0x1f, 0x97, 0xa0, 0x48, 0xd5,
+	0x20, 0xb6, 0xcb, 0x5b, 0x86, 0x22, 0xdd, 0xff, 0x91, 0x52, 0x93, 0x09, 0xcb, 0x2f, 0xba, 0xe6,
+	0x34, 0x18, 0x5a, 0x38, 0x51, 0xe6, 0xb3, 0x4c, 0x94, 0x15, 0x98, 0xc0, 0x7b, 0x35, 0xa7, 0xdb,
+	0x39, 0x61, 0x5b, 0xbf, 0x20, 0xad, 0xbd, 0x0e, 0xe3, 0x1d, 0xba, 0x76, 0x26, 0xd6, 0x72, 0x32,
+	0x61, 0x4f, 0x22, 0xad, 0xb0, 0x0d, 0x8e, 0xae, 0xdd, 0x81, 0x42, 0x1b, 0x8b, 0xa3, 0x5c, 0x1c,
+	0xba, 0xff, 0xa2, 0x88, 0xda, 0x32, 0x8c, 0x79, 0x3d, 0xd4, 0x2e, 0x8f, 0xa7, 0x98, 0x93, 0xd0,
+	0x80, 0x19, 0x04, 0x11, 0x0b, 0xb3, 0xef, 0x99, 0x07, 0x88, 0x6d, 0x5e, 0x68, 0x42, 0xde, 0xfc,
+	0x95, 0x46, 0xd8, 0xfc, 0x85, 0xab, 0x5c, 0xc8, 0xb6, 0xca, 0xbd, 0x8b, 0xed, 0x8b, 0xe9, 0xf7,
+	0x3d, 0x62, 0x20, 0x67, 0x56, 0x2e, 0xa6, 0xb1, 0x4c, 0x90, 0x0c, 0x86, 0xac, 0xad, 0x40, 0x81,
+	0xea, 0xde, 0x14, 0x29, 0x75, 0x61, 0x40, 0x29, 0x64, 0x50, 0x54, 0xbc, 0x66, 0x30, 0x7d, 0x1f,
+	0xef, 0x38, 0xad, 0x96, 0xd3, 0x25, 0x4b, 0xb7, 0x92, 0x01, 0x1c, 0xb4, 0xdd, 0xd5, 0x56, 0x61,
+	0x26, 0x40, 0xa0, 0xd4, 0x67, 0x52, 0xa8, 0x57, 0x09, 0x1a, 0xa5, 0x3e, 0xcd, 0xcb, 0x34, 0x79,
+	0x2d, 0x16, 0x3a, 0xb6, 0xdb, 0xa8, 0x45, 0xbc, 0x0a, 0x6c, 0x71, 0x47, 0x41, 0x3b, 0xd8, 0xb7,
+	0x70, 0x0b, 0x34, 0x0f, 0xb5, 0xfb, 0x2e, 0x6a, 0x51, 0x20, 0xc5, 0xe3, 0xab, 0x3b, 0x92, 0x53,
+	0x0b, 0xb1, 0x03, 0xa6, 0x29, 0xda, 0xdc, 0x42, 0x3e, 0x64, 0x9a, 0x20, 0xac, 0x07, 0x08, 0x76,
+	0x77, 0xdf, 0x29, 0x6b, 0x64, 0x2c, 0x5e, 0x4f, 0x91, 0x07, 0x63, 0xbc, 0xd1, 0xdd, 0x77, 0xe8,
+	0x00, 0x04, 0x33, 0x00, 0x68, 0xef, 0xc0, 0x94, 0x30, 0x23, 0x79, 0xe5, 0xd3, 0x0b, 0xf9, 0x44,
+	0x1d, 0x12, 0xa6, 0xa4, 0xc9, 0x70, 0x4a, 0xf2, 0xb4, 0x7a, 0xd4, 0x2e, 0x9c, 0x21, 0x04, 0x16,
+	0x86, 0xd9, 0x05, 0xd9, 0x0a, 0x60, 0x8d, 0x44, 0xae, 0xeb, 0xb8, 0x64, 0x85, 0x5a, 0x32, 0x68,
+	0x42, 0xfb, 0x22, 0xa8, 0x6c, 0x8a, 0x6e, 0x3b, 0x5d, 0xaf, 0x7f, 0x84, 0x5c, 0xaf, 0x3c, 0x4f,
+	0xe8, 0x5f, 0x4e, 0x69, 0xeb, 0x2a, 0xc3, 0x33, 0x66, 0x8f, 0xa5, 0xb4, 0x87, 0x7b, 0x60, 0xdf,
+	0x6b, 0xb9, 0x88, 0x18, 0x7c, 0x17, 0x7d, 0xd0, 0xb7, 0xdd, 0x60, 0xd9, 0xaa, 0xee, 0x7b, 0x06,
+	0xc9, 0x30, 0x18, 0x5c, 0x3b, 0x0b, 0xe3, 0xfb, 0x5e, 0xab, 0xdf, 0xb7, 0xe9, 0xda, 0xb5, 0x64,
+	0x14, 0xf7, 0xbd, 0xbd, 0xbe, 0x6d, 0x55, 0xde, 0x86, 0xd9, 0x88, 0x38, 0x47, 0x32, 0x56, 0x7f,
+	0x37, 0x07, 0x05, 0xdc, 0x62, 0x0f, 0xe3, 0x60, 0x63, 0xe1, 0x91, 0x72, 0x63, 0x06, 0x4d, 0xe0,
+	0x7a, 0xf1, 0x8f, 0xd6, 0x91, 0xc7, 0xf6, 0x91, 0x45, 0x9c, 0xdc, 0xf4, 0xf0, 0xc6, 0x90, 0x64,
+	0x3c, 0x39, 0xf1, 0x91, 0x47, 0xcc, 0xd3, 0x98, 0x51, 0xc2, 0x90, 0xfb, 0x18, 0x80, 0x57, 0xfe,
+	0xc4, 0xa7, 0xe4, 0x11, 0x43, 0x34, 0x66, 0xb0, 0x14, 0xde, 0x30, 0x92, 0x5f, 0x98, 0x20, 0xf5,
+	0x43, 0x8d, 0x93, 0xf4, 0xa6, 0x87, 0x95, 0x8c, 0x66, 0x51, 0x92, 0x45, 0x92, 0x0b, 0x04, 0x44,
+	0x69, 0x5e, 0x26, 0x8b, 0xde, 0x9e, 0xeb, 0x1c, 0xe0, 0x15, 0x3d, 0xf3, 0x80, 0x00, 0x59, 0x89,
+	0x11, 0x88, 0x76, 0x1a, 0x0a, 0xb6, 0x83, 0x29, 0x4f, 0x70, 0x0f, 0x17, 0x65, 0x94, 0x10, 0x6c,
+	0x11, 0x1f, 0x14, 0xf5, 0x4b, 0x95, 0x08, 0x84, 0xb8, 0x48, 0x30, 0x51, 0x3e, 0x45, 0x1e, 0x79,
+	0xcc, 0x47, 0x05, 0x1c, 0xb4, 0xe9, 0xe9, 0x7f, 0x4d, 0x81, 0xb9, 0x55, 0xb3, 0x67, 0xb6, 0x6d,
+	0xff, 0x64, 0x0f, 0xdb, 0x25, 0xa2, 0xa6, 0xd7, 0x61, 0x16, 0x7d, 0xd4, 0xee, 0xf4, 0x3d, 0xfb,
+	0x98, 0x33, 0xac, 0x90, 0xfd, 0xef, 0x4c, 0x00, 0xa6, 0x4c, 0x5f, 0xe1, 0x53, 0x20, 0xc3, 0xca,
+	0x11, 0xac, 0x49, 0x0a, 0x0b, 0xda, 0xe5, 0x3b, 0xbe, 0xd9, 0x11, 0x64, 0x99, 0x37, 0x80, 0x80,
+	0x08, 0x82, 0xfe, 0x8b, 0x45, 0x28, 0x54, 0x3b, 0xc8, 0xf5, 0x85, 0x09, 0x25, 0x4f, 0x26, 0x94,
+	0x37, 0xb0, 0x87, 0xee, 0x18, 0xb9, 0xb6, 0x7f, 0x52, 0xce, 0xa5, 0x98, 0xae, 0x26, 0x43, 0x20,
+	0x16, 0x2f, 0x40, 0xc7, 0x72, 0x31, 0x31, 0x4d, 0xba, 0x19, 0xa1, 0x95, 0x96, 0x08, 0x04, 0x23,
+	0xe2, 0x1d, 0xd1, 0x11, 0xf2, 0x88, 0x51, 0xa6, 0x8e, 0x28, 0x9e, 0xd4, 0x5e, 0x87, 0x52, 0xe0,
+	0xff, 0x2c, 0x17, 0x86, 0x9a, 0xe5, 0x10, 0x19, 0x37, 0xd4, 0x65, 0x0e, 0xd0, 0x96, 0x6d, 0x91,
+	0x1e, 0x2e, 0x19, 0xc0, 0x41, 0x0d, 0xd2, 0x1c, 0x9e, 0x2a, 0x8f, 0xa7, 0x34, 0x87, 0xbb, 0x50,
+	0x69, 0x73, 0x38, 0x3a, 0xe6, 0xb7, 0xdd, 0x41, 0x64, 0x91, 0x4b, 0x1d, 0x01, 0x3c, 0x89, 0x87,
+	0x83, 0xef, 0x77, 0x58, 0xcf, 0xe3, 0x9f, 0xb8, 0xe9, 0xfd, 0xae, 0xfd, 0x41, 0x1f, 0xb5, 0x7c,
+	0xf3, 0x80, 0x74, 0x79, 0xc9, 0x28, 0x51, 0xc8, 0xae, 0x79, 0x40, 0xfc, 0x83, 0x4e, 0xbf, 0xeb,
+	0x93, 0xc9, 0x20, 0x6f, 0xd0, 0x04, 0xf6, 0x51, 0xec, 0xdb, 0x2e, 0x9e, 0x8e, 0x10, 0xca, 0xe2,
+	0x8b, 0x2c, 0x11, 0xec, 0x26, 0x42, 0x5d, 0x4d, 0x87, 0x29, 0xb3, 0xfd, 0xb4, 0xeb, 0x7c, 0xd8,
+	0x41, 0xd6, 0x01, 0xb2, 0x98, 0x43, 0x52, 0x82, 0x51, 0xd9, 0x98, 0x9e, 0xd3, 0x6d, 0xb5, 0x1d,
+	0x8b, 0xda, 0x7c, 0x22, 0x1b, 0x0c, 0x5a, 0x75, 0x2c, 0xa4, 0xbd, 0x0d, 0xe3, 0x3d, 0xf3, 0xa4,
+	0xe3, 0x98, 0x56, 0x79, 0x96, 0x98, 0x9c, 0xab, 0xf1, 0x09, 0x01, 0xf7, 0xde, 0xd2, 0x0e, 0xc5,
+	0xa2, 0xa6, 0x95, 0x97, 0xd1, 0x1a, 0x30, 0x29, 0x78, 0xa6, 0xcb, 0x6a, 0x8a, 0x85, 0xa6, 0x24,
+	0xaa, 0x21, 0x26, 0x25, 0x23, 0x96, 0xad, 0xdc, 0x83, 0x29, 0xb1, 0x8e, 0x51, 0xec, 0x4d, 0xe5,
+	0x1d, 0x50, 0xa3, 0xc4, 0x47, 0xb2, 0x57, 0xdf, 0x56, 0x60, 0xae, 0x69, 0x3d, 0x25, 0x6c, 0x7a,
+	0x58, 0xd8, 0xcd, 0x9e, 0xd9, 0xc5, 0x7d, 0xe3, 0xf9, 0x26, 0xd6, 0x65, 0x9b, 0xb9, 0x17, 0x87,
+	0xf4, 0x0d, 0xc1, 0xc6, 0x69, 0xed, 0x2e, 0x4c, 0xa0, 0xae, 0x45, 0x0b, 0xe6, 0x86, 0x16, 0x1c,
+	0x47, 0x5d, 0x0b, 0xa7, 0xf4, 0x2d, 0xd0, 0x02, 0x36, 0x56, 0xb1, 0x7e, 0x10, 0x3e, 0xce, 0x43,
+	0xe9, 0xc8, 0xee, 0xb6, 0xa8, 0xf6, 0xd0, 0x51, 0x3a, 0x71, 0x64, 0x77, 0x09, 0x02, 0xc9, 0x34,
+	0x3f, 0x62, 0x99, 0x39, 0x96, 0x69, 0x7e, 0x44, 0x32, 0xf5, 0xef, 0xe5, 0x60, 0x36, 0x20, 0xb8,
+	0xdd, 0xc3, 0xd2, 0xd1, 0x1e, 0xc2, 0x1c, 0xa6, 0xc6, 0x47, 0x2c, 0x1d, 0xa8, 0x4a, 0x86, 0x51,
+	0xbe, 0x7e, 0xca, 0x98, 0x3d, 0xb2, 0xbb, 0x22, 0x48, 0xbb, 0x0c, 0x60, 0x7b, 0x2d, 0x3e, 0x44,
+	0x88, 0x63, 0x71, 0xfd, 0x94, 0x51, 0xb2, 0xbd, 0x55, 0x36, 0x4c, 0xaa, 0x74, 0x58, 0xb7, 0xbc,
+	0x9e, 0xd9, 0x65, 0xcb, 0x4d, 0x3d, 0x5e, 0x4b, 0x54, 0xf4, 0xeb, 0xa7, 0x8c, 0x09, 0x9f, 0x77,
+	0x43, 0x0d, 0x7b, 0x60, 0xfa, 0x5d, 0x9f, 0xd2, 0x18, 0x5b, 0x50, 0x12, 0xb5, 0x34, 0x2e, 0x37,
+	0xcc, 0x48, 0x9b, 0x27, 0xee, 0x17, 0x20, 0x8f, 0x37, 0x06, 0x5f, 0x85, 0x4a, 0x80, 0x29, 0x8e,
+	0xf9, 0x77, 0xfb, 0xc8, 0x3d, 0xd1, 0xee, 0xc3, 0x74, 0x60, 0x4a, 0x06, 0xca, 0x45, 0x2c, 0x6a,
+	0x4c, 0xb9, 0x42, 0x4a, 0xff, 0x06, 0x9c, 0x0d, 0x6a, 0xa8, 0x72, 0xc3, 0xf7, 0xdc, 0xc8, 0x47,
+	0x0c, 0x6c, 0x2e, 0x62, 0x60, 0xf5, 0xbf, 0xa3, 0x40, 0x39, 0xd6, 0xc0, 0x86, 0xf5, 0xff, 0xab,
+	0xfe, 0xa8, 0x31, 0xce, 0x47, 0x8d, 0xb1, 0xfe, 0x5f, 0x72, 0x30, 0x13, 0x30, 0x48, 0xd9, 0xfa,
+	0x32, 0x9c, 0x96, 0xd8, 0x6a, 0x7d, 0x80, 0xc1, 0x6c, 0xc0, 0xbd, 0x9c, 0xde, 0xd3, 0xb1, 0xfe,
+	0x5b, 0x3f, 0x65, 0xcc, 0xb9, 0xb1, 0x4e, 0xdd, 0x05, 0x35, 0xe4, 0x98, 0xd1, 0xce, 0xa5, 0x78,
+	0xa5, 0x52, 0x7a, 0x6e, 0xfd, 0x94, 0x31, 0x63, 0xca, 0x7d, 0xf9, 0x18, 0xe6, 0x84, 0x86, 0x32,
+	0xb2, 0x54, 0xc1, 0x6f, 0x0e, 0x67, 0x99, 0xf5, 0x08, 0x1e, 0x52, 0x6e, 0xa4, 0x93, 0x5e, 0x85,
+	0x31, 0xa7, 0xe7, 0xe3, 0x15, 0x4e, 0xf2, 0x0a, 0x33, 0x32, 0x9e, 0x0d, 0x82, 0x7d, 0x7f, 0x1c,
+	0x0a, 0x84, 0x05, 0xfd, 0xbb, 0x0a, 0x9c, 0x0b, 0x50, 0xea, 0x5d, 0xbc, 0x2a, 0x34, 0x7d, 0xb2,
+	0xe2, 0x43, 0x1e, 0x9e, 0x6e, 0xc6, 0x31, 0x9a, 0xcd, 0xf6, 0xc2, 0x49, 0x2b, 0x4c, 0xb9, 0x73,
+	0x0c, 0x8e, 0x4f, 0xbc, 0xae, 0xc8, 0x74, 0xdb, 0xfc, 0x34, 0x91, 0xa5, 0xb0, 0x83, 0x14, 0x7d,
+	0x44, 0xbc, 0xa1, 0xb6, 0xd3, 0xe5, 0x1d, 0x1e, 0x42, 0xf4, 0x0d, 0xa8, 0x24, 0xf1, 0xe3, 0xf5,
+	0x9c, 0xae, 0x87, 0xb4, 0x25, 0x28, 0x12, 0xc1, 0x72, 0x7e, 0xe6, 0x93, 0xe7, 0x0e, 0x83, 0x61,
+	0xe9, 0x4d, 0x98, 0x0f, 0xa8, 0xd5, 0x50, 0x07, 0x3d, 0x8f, 0xa6, 0xe9, 0xe7, 0xe0, 0x6c, 0x8c,
+	0x28, 0xe5, 0x4f, 0xaf, 0xc3, 0x0b, 0x61, 0xe7, 0x99, 0xb6, 0x17, 0x54, 0x77, 0x0b, 0x0a, 0x84,
+	0x25, 0xa6, 0xa6, 0x69, 0x7c, 0x53, 0x24, 0xbd, 0x0c, 0xf3, 0x51, 0x32, 0xac, 0x02, 0x43, 0xa8,
+	0xe0, 0xb1, 0xe9, 0xb7, 0x0f, 0x9f, 0x43, 0x7b, 0xbe, 0xad, 0xc0, 0x7c, 0x94, 0x28, 0x93, 0xf7,
+	0xdb, 0x50, 0x34, 0xdb, 0x58, 0x6f, 0xd8, 0xd8, 0xbf, 0x96, 0x4e, 0x94, 0x14, 0xac, 0x12, 0x64,
+	0x83, 0x15, 0x0a, 0x5b, 0x9d, 0xcb, 0xd2, 0xea, 0x23, 0xb8, 0xd4, 0xb4, 0x9e, 0x72, 0x3f, 0xdc,
+	0x8e, 0xd3, 0xb1, 0xdb, 0x27, 0xab, 0x2e, 0x12, 0xf4, 0xf1, 0x21, 0xcc, 0x06, 0x1e, 0xa1, 0x1e,
+	0xc9, 0x2f, 0x2b, 0xe9, 0x93, 0x84, 0x4c, 0xc9, 0x98, 0xf1, 0xa4, 0xb4, 0xfe, 0x1a, 0x14, 0x29,
+	0xe7, 0x62, 0xe7, 0xe4, 0x87, 0xb3, 0xf9, 0x1f, 0x72, 0x30, 0xbb, 0xfd, 0xe4, 0x6b, 0xa8, 0xed,
+	0x63, 0x14, 0xba, 0x12, 0xc7, 0xa7, 0xcb, 0xfd, 0xc0, 0xcb, 0x42, 0x7e, 0xe3, 0xa9, 0x96, 0xed,
+	0xd3, 0x6c, 0x7e, 0x3e, 0x37, 0x41, 0x01, 0x0d, 0xe2, 0xeb, 0x47, 0x5d, 0xf3, 0x49, 0x07, 0x51,
+	0xa3, 0x37, 0x61, 0xf0, 0x24, 0x3d, 0xae, 0x20, 0x6e, 0x80, 0x31, 0x36, 0x70, 0x48, 0x0a, 0xc3,
+	0x59, 0x57, 0xd0, 0x33, 0x2e, 0x2e, 0x63, 0x6c, 0x61, 0xdb, 0x6d, 0xe4, 0x79, 0x2d, 0xbc, 0x7e,
+	0xa1, 0xcb, 0xd9, 0x12, 0x85, 0x3c, 0x44, 0x64, 0x85, 0xed, 0xa1, 0xb6, 0x8b, 0x7c, 0x92, 0x3d,
+	0x4e, 0xb3, 0x29, 0x04, 0x67, 0x93, 0xd3, 0x19, 0xab, 0xe7, 0xd8, 0x5d, 0x1f, 0xef, 0x58, 0xf0,
+	0x96, 0x3a, 0x04, 0x68, 0x37, 0x41, 0x6d, 0xf7, 0x5d, 0x17, 0x75, 0xfd, 0x16, 0x07, 0x92, 0x25,
+	0x6c, 0xc9, 0x98, 0x65, 0xf0, 0x3a, 0x03, 0x93, 0xdd, 0x39, 0x65, 0xa3, 0xe7, 0xb8, 0xd4, 0xe7,
+	0x91, 0x37, 0x18, 0x67, 0x3b, 0x8e, 0xeb, 0x63, 0xfe, 0x5d, 0x74, 0x80, 0xf9, 0xa7, 0x87, 0xec,
+	0x2c, 0xa5, 0xff, 0x58, 0x81, 0xd3, 0x6c, 0x9b, 0x2a, 0xf5, 0xb5, 0xe0, 0x2b, 0x52, 0x46, 0xf3,
+	0x15, 0x8d, 0xec, 0xe0, 0xe2, 0xae, 0xa2, 0x7c, 0x46, 0x57, 0x91, 0xfe, 0x12, 0xcc, 0x50, 0x58,
+	0x30, 0x50, 0x82, 0xad, 0xba, 0x22, 0x6c, 0xd5, 0xf5, 0x1e, 0x9c, 0x91, 0x9b, 0xc6, 0xb0, 0xa3,
+	0x2e, 0xb9, 0x75, 0x60, 0x3b, 0xf3, 0x96, 0xcb, 0x50, 0x18, 0xeb, 0x69, 0x3b, 0x7a, 0x4e, 0xc9,
+	0x98, 0x39, 0x96, 0xd2, 0xfa, 0x4f, 0x14, 0xee, 0xfe, 0x25, 0x2e, 0x04, 0x3a, 0x1e, 0xb5, 0x7b,
+	0x50, 0xa4, 0xde, 0x0d, 0x36, 0x8c, 0xf5, 0x14, 0xb2, 0x14, 0x7d, 0xc7, 0x74, 0xcd, 0x23, 0x83,
+	0x95, 0xd0, 0x5e, 0x87, 0xc2, 0x51, 0xb0, 0x5a, 0xcc, 0x56, 0x94, 0x16, 0xc0, 0xaa, 0x47, 0x7e,
+	0x50, 0x7f, 0x0d, 0x35, 0xf5, 0x25, 0x02, 0xe1, 0xfe, 0x1c, 0xd1, 0xed, 0x33, 0x16, 0x75, 0x0f,
+	0xe9, 0x7f, 0x98, 0x0b, 0xce, 0x61, 0x90, 0xff, 0x3c, 0xd4, 0x82, 0xf6, 0x72, 0x2e, 0xab, 0x43,
+	0xf0, 0x5e, 0x30, 0xe2, 0xd2, 0x56, 0xa2, 0x31, 0x49, 0x07, 0xa3, 0x72, 0x1d, 0xc6, 0x9d, 0x1e,
+	0xdd, 0xe5, 0xd0, 0x99, 0x79, 0x29, 0xad, 0x70, 0xd0, 0xb4, 0xa5, 0xed, 0x5e, 0xb8, 0x1f, 0x31,
+	0x78, 0x71, 0xbc, 0xd1, 0xd9, 0xee, 0x3d, 0xe3, 0x46, 0xe5, 0xbb, 0xa1, 0x36, 0x20, 0x9f, 0xeb,
+	0x08, 0x1e, 0x1f, 0x54, 0x6b, 0xca, 0x4a, 0xca, 0xf8, 0x60, 0x4a, 0xc6, 0xd0, 0x9e, 0xa3, 0x7a,
+	0xfe, 0x3a, 0xde, 0x39, 0x75, 0xcd, 0x9e, 0x3c, 0xd4, 0xa3, 0xc3, 0x41, 0xe8, 0xe3, 0xdc, 0x68,
+	0x7d, 0x2c, 0x3a, 0x9f, 0xf3, 0x11, 0xe7, 0xf3, 0x39, 0x98, 0xe8, 0x3a, 0x2d, 0x17, 0xf9, 0x2e,
+	0x77, 0x4c, 0x8f, 0x77, 0x1d, 0x03, 0x27, 0xf5, 0x0f, 0x40, 0x13, 0xb9, 0x62, 0x72, 0xfa, 0x59,
+	0x98, 0xe7, 0x8e, 0x36, 0x92, 0x11, 0xb6, 0x9e, 0xca, 0xed, 0x5a, 0x9a, 0xbb, 0x4d, 0x22, 0x63,
+	0x9c, 0x39, 0x4e, 0x80, 0xea, 0x3e, 0xbf, 0x44, 0x41, 0xe6, 0x0f, 0x69, 0xae, 0x50, 0x22, 0x73,
+	0x45, 0xd2, 0xb5, 0xac, 0xbb, 0x30, 0xce, 0x2a, 0xce, 0x62, 0xb5, 0x38, 0xae, 0xfe, 0x23, 0x85,
+	0x5b, 0x2e, 0xee, 0x03, 0x4c, 0xbc, 0x0f, 0x83, 0xcf, 0x7d, 0xcd, 0x23, 0xe4, 0xf5, 0xcc, 0x36,
+	0xd7, 0xaa, 0x10, 0x80, 0x4b, 0x04, 0xee, 0x9a, 0x92, 0x41, 0x7e, 0x63, 0x17, 0x5d, 0xd7, 0xb1,
+	0x08, 0xfb, 0x6c, 0xda, 0xc2, 0xc9, 0x86, 0x85, 0x8d, 0x80, 0xf3, 0x61, 0x17, 0xb9, 0x2d, 0x52,
+	0x49, 0x81, 0xd2, 0x22, 0x90, 0x2d, 0x5c, 0x53, 0x90, 0x4d, 0x28, 0x16, 0x85, 0x6c, 0xb2, 0x3f,
+	0xb1, 0x40, 0x7b, 0xe0, 0x9a, 0xbd, 0xc3, 0x9a, 0x6b, 0x1f, 0x23, 0x77, 0xf5, 0xd0, 0xec, 0x1e,
+	0x20, 0x2f, 0x10, 0x88, 0x22, 0x08, 0xe4, 0x1e, 0x8c, 0x3d, 0xb5, 0xbb, 0x16, 0xb3, 0x52, 0x2f,
+	0x25, 0x9c, 0x51, 0x44, 0xc8, 0x60, 0xfa, 0x06, 0x29, 0xa3, 0x5f, 0x87, 0xd9, 0xd5, 0x4e, 0xdf,
+	0xf3, 0x91, 0x3b, 0xc4, 0x9e, 0xff, 0xa6, 0x02, 0xd3, 0x78, 0xa0, 0x1f, 0x07, 0xaa, 0xbb, 0x0e,
+	0x13, 0x06, 0xfa, 0x00, 0x79, 0xfe, 0xc3, 0x47, 0x6c, 0xf5, 0x70, 0x2b, 0xbe, 0x7a, 0x10, 0x4b,
+	0x2c, 0x71, 0x74, 0x3a, 0xcc, 0x83, 0xd2, 0x95, 0x37, 0x61, 0x5a, 0xca, 0x12, 0x07, 0x7a, 0x7e,
+	0xd8, 0x40, 0xff, 0x18, 0x66, 0xa4, 0x5a, 0x3c, 0xec, 0xee, 0x61, 0xbf, 0x57, 0x05, 0x47, 0x80,
+	0x04, 0xd3, 0x6a, 0x91, 0xd6, 0xb0, 0x6b, 0x4f, 0x97, 0x06, 0xb7, 0xc0, 0x90, 0x0b, 0xe9, 0xff,
+	0x54, 0x81, 0x79, 0x72, 0x02, 0x34, 0x7c, 0x60, 0x3f, 0x84, 0xe2, 0x86, 0x78, 0xc1, 0xea, 0x73,
+	0xc9, 0x47, 0x49, 0x31, 0x42, 0xf2, 0xad, 0xb0, 0x8d, 0x4f, 0x7d, 0x2b, 0xec, 0xcf, 0x15, 0x38,
+	0x1b, 0xab, 0x89, 0xf5, 0xfc, 0x1e, 0x94, 0xf8, 0xf1, 0x23, 0x5f, 0x4a, 0x7f, 0x7e, 0x38, 0x9b,
+	0xb4, 0xf0, 0x52, 0x93, 0x97, 0xa4, 0xac, 0x86, 0x94, 0x42, 0x85, 0xca, 0x09, 0x0a, 0x55, 0x31,
+	0x61, 0x46, 0x2e, 0x92, 0xd0, 0x8c, 0x37, 0xc4, 0x66, 0x24, 0xfa, 0x32, 0x62, 0x7c, 0x88, 0x6d,
+	0xfd, 0x47, 0x85, 0xe0, 0x4a, 0xe1, 0x96, 0x63, 0xc5, 0xd7, 0x1e, 0x2a, 0xe4, 0xdb, 0xbd, 0x3e,
+	0x21, 0xae, 0x18, 0xf8, 0x27, 0xf1, 0x11, 0xa1, 0xa3, 0x16, 0xf1, 0xfd, 0x32, 0xa7, 0xfa, 0xc4,
+	0x11, 0x3a, 0x22, 0xb7, 0xfc, 0xb0, 0x15, 0xc5, 0x99, 0xc4, 0x8f, 0x4d, 0xbd, 0xea, 0xe3, 0x47,
+	0xe8, 0x88, 0x78, 0xb1, 0x59, 0xd6, 0xbe, 0x8b, 0x10, 0x77, 0xab, 0x1f, 0xa1, 0xa3, 0x35, 0x17,
+	0x91, 0x2b, 0x5a, 0xe6, 0xf1, 0x41, 0x8b, 0x38, 0x0e, 0x8b, 0xf4, 0x8a, 0x96, 0x79, 0x7c, 0xb0,
+	0x81, 0x7d, 0x82, 0xcb, 0xc1, 0x7a, 0x77, 0x3c, 0xe5, 0x9c, 0x2c, 0x72, 0xe0, 0xf5, 0x36, 0x14,
+	0x2c, 0xdb, 0x7b, 0xca, 0xaf, 0x13, 0x5e, 0x4f, 0xbb, 0x4e, 0x88, 0x5b, 0xbb, 0x54, 0xc3, 0x98,
+	0xb4, 0x33, 0x68, 0x29, 0x7c, 0x5e, 0xd6, 0x73, 0x9c, 0xe0, 0x66, 0xc3, 0x85, 0x41, 0xb7, 0x11,
+	0x0d, 0x8a, 0x8a, 0xad, 0xdb, 0xd1, 0xc1, 0x91, 0xdf, 0xb2, 0x7b, 0x7c, 0xf1, 0x8a, 0x93, 0x8d,
+	0x1e, 0xce, 0xb0, 0x4c, 0xdf, 0xc4, 0x19, 0x53, 0x34, 0x03, 0x27, 0x1b, 0xe4, 0x14, 0xf4, 0xd0,
+	0xf1, 0x7c, 0x62, 0xf4, 0xe8, 0xc1, 0x57, 0x90, 0xd6, 0x36, 0x61, 0x92, 0xd8, 0x4a, 0x76, 0xc3,
+	0x42, 0x4d, 0x31, 0x1b, 0x62, 0x33, 0xf0, 0x1f, 0x71, 0x0c, 0x40, 0x37, 0x00, 0x68, 0x4b, 0x70,
+	0x9a, 0xef, 0x6c, 0xdc, 0x16, 0x21, 0x4c, 0x6a, 0x9d, 0x23, 0xb5, 0xce, 0x05, 0x59, 0x98, 0x04,
+	0x36, 0xb9, 0x95, 0x2f, 0x01, 0x84, 0x52, 0x49, 0xd0, 0xb7, 0xd7, 0x64, 0x7d, 0x5b, 0x48, 0x63,
+	0x8c, 0x3b, 0x27, 0x44, 0xcf, 0xea, 0xdb, 0x30, 0x1b, 0x61, 0x75, 0xa4, 0x71, 0x89, 0x60, 0x86,
+	0x11, 0x67, 0xf6, 0x58, 0xd0, 0x0e, 0x25, 0x9b, 0x76, 0x50, 0xf5, 0xce, 0x89, 0x77, 0x9a, 0x89,
+	0x38, 0xf2, 0xe1, 0xf4, 0xa6, 0x5f, 0x81, 0xcb, 0xa9, 0x1b, 0x4d, 0x36, 0x3d, 0x27, 0xed, 0x45,
+	0xe9, 0x45, 0x97, 0xcf, 0x64, 0x2f, 0x9a, 0xc4, 0x11, 0xaf, 0x8e, 0x71, 0x74, 0x15, 0xae, 0xc4,
+	0x50, 0xa2, 0x0e, 0x1b, 0xdd, 0x02, 0x7d, 0x10, 0x12, 0x33, 0x71, 0xef, 0xc0, 0x04, 0xe1, 0x38,
+	0x74, 0x16, 0x64, 0xe1, 0x39, 0x28, 0xa3, 0xdf, 0x4d, 0xe0, 0xb6, 0xd1, 0xc5, 0x6b, 0xe6, 0x60,
+	0x99, 0x9e, 0xb0, 0xaa, 0xd0, 0xbf, 0x02, 0x0b, 0xe9, 0xc5, 0x18, 0x6b, 0xf7, 0xa0, 0x38, 0xb2,
+	0x30, 0x59, 0x09, 0xfd, 0xd5, 0x84, 0x3e, 0x93, 0x9d, 0x3e, 0x49, 0x5c, 0x25, 0x89, 0x3e, 0xe2,
+	0xd5, 0xd9, 0x48, 0x20, 0xcc, 0x6f, 0x4c, 0xd5, 0x4c, 0xbb, 0x73, 0x82, 0x09, 0x1f, 0x3a, 0x7d,
+	0x97, 0xdd, 0xd4, 0x26, 0xbf, 0xf1, 0x86, 0xf7, 0xc8, 0xee, 0xf6, 0x7d, 0xaa, 0xe7, 0x05, 0x83,
+	0xa5, 0xf0, 0x59, 0xde, 0xe5, 0x54, 0x72, 0x8f, 0x11, 0x7a, 0xda, 0x39, 0xd1, 0x5e, 0x81, 0xbc,
+	0x65, 0x9e, 0x30, 0x9d, 0x4f, 0xf4, 0xe4, 0x60, 0xdf, 0x37, 0x46, 0xb6, 0xcc, 0x13, 0x03, 0xe3,
+	0x06, 0x2c, 0xe4, 0x12, 0x59, 0xc8, 0x4b, 0x2c, 0x7c, 0x15, 0x16, 0x52, 0x39, 0xd8, 0x74, 0xba,
+	0xfe, 0x61, 0x87, 0x8c, 0x5b, 0xce, 0x42, 0x61, 0xf4, 0x1a, 0xde, 0x86, 0x2b, 0xa9, 0x35, 0xec,
+	0x20, 0xd7, 0x76, 0x2c, 0xbb, 0x8d, 0x9d, 0x20, 0x1e, 0x6a, 0x3b, 0x5d, 0x8b, 0x9f, 0x5b, 0xf2,
+	0xa4, 0xfe, 0x7f, 0x72, 0x70, 0x2e, 0xb5, 0x3c, 0x75, 0x25, 0xf8, 0xa6, 0xdd, 0x65, 0xc5, 0x58,
+	0x4a, 0x5b, 0x87, 0x82, 0x85, 0xbb, 0xa3, 0xfc, 0xef, 0xa8, 0xf2, 0x2c, 0x0f, 0x57, 0x1e, 0xa9,
+	0x1b, 0xd7, 0x4f, 0x19, 0x94, 0x00, 0x5e, 0xa8, 0x7c, 0x48, 0x7a, 0xa2, 0xfc, 0x13, 0x4a, 0xea,
+	0x4e, 0x76, 0x52, 0xb4, 0x0b, 0xd7, 0x4f, 0x19, 0x8c, 0x84, 0xb6, 0x05, 0xe3, 0x47, 0x54, 0xa8,
+	0xe5, 0x3f, 0xa1, 0xd4, 0x5e, 0xc9, 0x4e, 0x8d, 0x75, 0xc7, 0xfa, 0x29, 0x83, 0x13, 0xd1, 0xde,
+	0x85, 0x89, 0x1e, 0x13, 0x61, 0xf9, 0xdf, 0x53, 0x82, 0x2b, 0xd9, 0x09, 0x72, 0xe9, 0xe3, 0x43,
+	0x13, 0x4e, 0x06, 0x5f, 0x99, 0xa2, 0xbf, 0xc9, 0x3a, 0x5c, 0xff, 0x00, 0xe6, 0x62, 0xe5, 0x13,
+	0x37, 0x0a, 0xeb, 0xf8, 0x4a, 0x16, 0xc5, 0xe2, 0x6b, 0xba, 0xc5, 0xec, 0xac, 0x18, 0x61, 0x61,
+	0xfd, 0x57, 0xf2, 0xc4, 0xf1, 0xbb, 0xea, 0x22, 0x0b, 0x75, 0x7d, 0xdb, 0xec, 0xc8, 0x2b, 0xc9,
+	0xa4, 0xca, 0xe7, 0xa1, 0xf8, 0xa4, 0xdf, 0x7e, 0x8a, 0x7c, 0xee, 0x62, 0xa6, 0x29, 0x7c, 0x55,
+	0x97, 0x5d, 0x30, 0xc6, 0xb7, 0x93, 0xf1, 0xe4, 0x43, 0x8d, 0xff, 0x74, 0x08, 0xc5, 0xae, 0x2f,
+	0x03, 0x66, 0xcc, 0x0f, 0xbd, 0x56, 0x3b, 0xa8, 0x91, 0xab, 0x4d, 0xb2, 0x9f, 0xff, 0x43, 0x2f,
+	0xe4, 0x8d, 0x71, 0xb5, 0x7e, 0xca, 0x98, 0x36, 0x45, 0xb8, 0xf6, 0x1e, 0xa8, 0xe6, 0xc7, 0x7d,
+	0x17, 0x89, 0x54, 0x99, 0x06, 0x25, 0xca, 0xa5, 0x8a, 0x91, 0x93, 0xe8, 0xce, 0x9a, 0x72, 0x8e,
+	0xf6, 0xb3, 0x30, 0x47, 0x4f, 0x04, 0x45, 0xd2, 0x7f, 0x32, 0xe0, 0xd0, 0xe3, 0x01, 0xc1, 0x4e,
+	0xa2, 0xad, 0x1e, 0x44, 0xb2, 0xf0, 0x95, 0xb8, 0x90, 0x2a, 0x55, 0x81, 0xfb, 0x70, 0x3e, 0xb1,
+	0x3b, 0x98, 0x9d, 0xbe, 0x0a, 0xd3, 0x42, 0x89, 0x60, 0x41, 0x39, 0x15, 0x02, 0x1b, 0x96, 0xfe,
+	0x4f, 0x14, 0xea, 0x29, 0x4f, 0x10, 0x5d, 0xc4, 0x6d, 0xa9, 0x0c, 0x76, 0x5b, 0xe6, 0xa2, 0x6e,
+	0xcb, 0x0a, 0x39, 0x30, 0xa5, 0x0e, 0x49, 0xda, 0xb9, 0x41, 0x5a, 0x70, 0x34, 0x8e, 0x89, 0x8e,
+	0x46, 0xe2, 0x6f, 0xb2, 0x3d, 0xec, 0x64, 0x6d, 0x79, 0x1e, 0xbd, 0xae, 0x3b, 0x61, 0x00, 0x03,
+	0x35, 0xbd, 0x8e, 0xde, 0xa2, 0x47, 0x21, 0x89, 0x5d, 0x82, 0xaf, 0x50, 0x98, 0x6d, 0x7a, 0xb0,
+	0x28, 0x28, 0xe2, 0x24, 0x83, 0x91, 0xbd, 0xec, 0x65, 0xe0, 0x49, 0x81, 0x69, 0x60, 0xa0, 0x87,
+	0xe8, 0x44, 0x7f, 0x04, 0x95, 0xf4, 0x8e, 0xc1, 0x4d, 0xee, 0xb9, 0x0e, 0xf6, 0x2b, 0x87, 0xf2,
+	0x2c, 0x31, 0x48, 0x83, 0xac, 0xae, 0xbf, 0xe6, 0x39, 0x5d, 0x81, 0xf4, 0x38, 0x4e, 0x63, 0xba,
+	0xdf, 0x65, 0xa7, 0x78, 0xb2, 0x9c, 0x59, 0x4f, 0xc9, 0x82, 0xce, 0x45, 0x05, 0xfd, 0x99, 0x48,
+	0xf2, 0x0b, 0x50, 0x49, 0x92, 0x24, 0xe3, 0x28, 0x2a, 0xca, 0x5c, 0x4c, 0x94, 0xfa, 0x5b, 0x70,
+	0x3e, 0x51, 0x52, 0x61, 0x9b, 0x04, 0x51, 0xe5, 0x22, 0xa2, 0xd2, 0x2f, 0xc3, 0x45, 0x49, 0x77,
+	0x63, 0xcb, 0xa4, 0x07, 0x70, 0x29, 0x0d, 0x81, 0xd5, 0x70, 0x0d, 0x66, 0x24, 0xfd, 0xe6, 0x97,
+	0x41, 0xa7, 0x45, 0x05, 0xf7, 0x62, 0xa3, 0x24, 0xb2, 0x0a, 0xca, 0x34, 0x4a, 0x7e, 0x35, 0x0f,
+	0x17, 0x92, 0x89, 0x8c, 0x30, 0xd6, 0x02, 0x03, 0x99, 0x4b, 0x34, 0x90, 0x79, 0xc9, 0x40, 0x36,
+	0xd3, 0x2c, 0xdf, 0xcd, 0x0c, 0x96, 0x8f, 0x32, 0x15, 0x37, 0x7d, 0xef, 0xa7, 0x9b, 0xbe, 0x97,
+	0x33, 0x99, 0xbe, 0x80, 0x70, 0xcc, 0xf6, 0xfd, 0xdc, 0x00, 0xdb, 0x77, 0x2b, 0x9b, 0xed, 0x0b,
+	0x88, 0x67, 0x32, 0x7e, 0xd5, 0xc8, 0x5c, 0x24, 0xaf, 0x22, 0x33, 0xf5, 0xea, 0x45, 0x38, 0x9f,
+	0x48, 0x82, 0x2d, 0x29, 0x57, 0x23, 0x7d, 0xfe, 0xc8, 0xec, 0xd8, 0x96, 0x39, 0x62, 0x1d, 0x51,
+	0x3d, 0x0f, 0x89, 0xb0, 0x5a, 0x9a, 0xe4, 0xb4, 0x90, 0x3a, 0xfc, 0x36, 0xf1, 0xe0, 0xe2, 0xe4,
+	0x07, 0xfa, 0x1b, 0x65, 0xbf, 0x7d, 0x2e, 0xe2, 0xb7, 0x67, 0x87, 0x93, 0x12, 0x51, 0x56, 0xdd,
+	0xef, 0xe5, 0xe0, 0x6c, 0x90, 0xb5, 0xd7, 0x3d, 0x7a, 0x4e, 0x35, 0x6a, 0x5f, 0x0c, 0x9d, 0xe9,
+	0xf9, 0xf4, 0xd5, 0x58, 0x52, 0xb5, 0xdc, 0xa7, 0x1e, 0xba, 0xd3, 0x7f, 0x5e, 0x81, 0x71, 0x06,
+	0xd4, 0x16, 0x61, 0xce, 0x22, 0xdd, 0xd2, 0x12, 0x6a, 0xa7, 0x4f, 0xd8, 0x66, 0x69, 0xc6, 0x66,
+	0xc0, 0xc3, 0x43, 0xb8, 0xda, 0x75, 0x5a, 0x16, 0xea, 0x98, 0x27, 0xad, 0x27, 0x68, 0xdf, 0x21,
+	0x97, 0x56, 0x3b, 0xc8, 0xb7, 0xbb, 0x07, 0xad, 0x08, 0xef, 0x13, 0xc6, 0xa5, 0xae, 0x53, 0xc3,
+	0x98, 0xf7, 0x09, 0x62, 0x8d, 0xe1, 0x05, 0xc4, 0xf4, 0x0a, 0x94, 0xe3, 0x0c, 0x33, 0x21, 0xfe,
+	0x95, 0x22, 0xc8, 0x97, 0xde, 0xaa, 0xcc, 0x24, 0xc3, 0x46, 0x28, 0xa4, 0x5c, 0xfa, 0xea, 0x37,
+	0x81, 0x6c, 0x5c, 0x46, 0xbd, 0x50, 0x44, 0x97, 0x61, 0x92, 0xcd, 0xc3, 0xc2, 0xac, 0xc7, 0xa6,
+	0x66, 0xee, 0xc0, 0x1d, 0x34, 0x51, 0x5f, 0x83, 0x19, 0x96, 0xdd, 0x76, 0xba, 0x3e, 0xfa, 0x88,
+	0x9b, 0xa2, 0x69, 0x0a, 0x5d, 0xa5, 0x40, 0xfd, 0x1e, 0x9c, 0x8d, 0x31, 0xc7, 0xac, 0x5f, 0xe4,
+	0x98, 0x48, 0x89, 0x1d, 0x13, 0xfd, 0x27, 0x51, 0x60, 0x35, 0xf4, 0x99, 0x08, 0xac, 0x86, 0x06,
+	0x0a, 0xac, 0x19, 0x0a, 0xec, 0x0c, 0x14, 0xc8, 0x63, 0x2a, 0xa6, 0x47, 0x34, 0xa1, 0xad, 0xc0,
+	0x0b, 0x7d, 0xda, 0xcf, 0xa1, 0xf2, 0x60, 0x8a, 0x4c, 0x5f, 0x4e, 0xb3, 0x4c, 0xae, 0x2f, 0x38,
+	0x8b, 0x5d, 0x33, 0x90, 0xeb, 0x67, 0x3a, 0xf2, 0x65, 0xa1, 0xc5, 0xc3, 0xd7, 0xc9, 0xa3, 0x1e,
+	0x7c, 0xe9, 0xaf, 0xc1, 0xd9, 0x18, 0x79, 0xd6, 0x1b, 0x83, 0x24, 0xaa, 0xff, 0x38, 0x27, 0xd8,
+	0x9b, 0xd5, 0x8e, 0xd3, 0x1d, 0xc8, 0xd6, 0x79, 0x28, 0xd1, 0x47, 0xac, 0xc2, 0xf9, 0x38, 0x05,
+	0x34, 0x2c, 0xed, 0x8b, 0xc1, 0xa3, 0xe1, 0x7c, 0xca, 0x93, 0x8a, 0xc4, 0x8a, 0x92, 0x9e, 0x0f,
+	0x6b, 0x77, 0x59, 0xfb, 0xe9, 0x5d, 0xb0, 0x2b, 0x43, 0x9f, 0x32, 0xb1, 0xe3, 0xbf, 0x9b, 0xa0,
+	0xfa, 0xae, 0xd9, 0xf5, 0xf0, 0xf5, 0x7c, 0xee, 0xe1, 0xa1, 0xe7, 0x17, 0xb3, 0x01, 0x9c, 0xee,
+	0x67, 0x3e, 0x8d, 0x2b, 0xfa, 0x2e, 0xcc, 0x47, 0x5b, 0x92, 0x45, 0xd4, 0x77, 0x25, 0x9d, 0x17,
+	0x67, 0xa7, 0x81, 0xc5, 0x64, 0x9d, 0x92, 0x66, 0x24, 0xb1, 0xd3, 0x23, 0xcb, 0x98, 0x81, 0x24,
+	0x1f, 0x42, 0x39, 0x5e, 0xee, 0x19, 0x4f, 0x1a, 0xf5, 0xdf, 0x13, 0xc7, 0xb2, 0xec, 0x6f, 0x1b,
+	0x38, 0x96, 0x9f, 0xfd, 0xc4, 0xf0, 0xd9, 0x94, 0x43, 0x12, 0x64, 0xc4, 0x51, 0xf7, 0xb3, 0xc2,
+	0x20, 0x20, 0xb7, 0xda, 0x33, 0xb5, 0xe0, 0x1a, 0xcc, 0x74, 0x1d, 0xbf, 0xd5, 0xee, 0x1f, 0xf5,
+	0x3b, 0x26, 0x3e, 0x5e, 0x61, 0xa6, 0x61, 0xba, 0xeb, 0xf8, 0xab, 0x01, 0x50, 0x5f, 0x83, 0xf9,
+	0x28, 0x71, 0x26, 0xeb, 0x5b, 0xf4, 0x1d, 0x88, 0x97, 0x7a, 0xc3, 0x88, 0xa2, 0x53, 0x24, 0xfd,
+	0x2d, 0xb8, 0x18, 0xd0, 0x91, 0x2e, 0x96, 0x67, 0xea, 0x73, 0x1f, 0x2e, 0xa5, 0x95, 0x66, 0xdc,
+	0x18, 0x70, 0xba, 0xcd, 0x32, 0x5a, 0xe4, 0x21, 0x0d, 0x7d, 0x93, 0x91, 0xe6, 0xd4, 0x8b, 0xdd,
+	0x6d, 0x37, 0xe6, 0xda, 0x51, 0x90, 0x7e, 0x1e, 0xce, 0x05, 0xb5, 0xc6, 0x96, 0xf4, 0x6f, 0x42,
+	0x25, 0x29, 0x33, 0xdc, 0x30, 0x04, 0xad, 0xe1, 0x4b, 0xf9, 0x12, 0x6f, 0x8e, 0xa7, 0x7f, 0x15,
+	0x5e, 0x8c, 0x17, 0x7e, 0x6c, 0xfb, 0x87, 0x6b, 0x76, 0xc7, 0x47, 0xae, 0xf7, 0xa9, 0x2f, 0x1f,
+	0xe8, 0x6b, 0x70, 0x6d, 0x48, 0x0d, 0xd9, 0x38, 0xfd, 0x6f, 0x8a, 0x20, 0x7a, 0x7e, 0x74, 0x24,
+	0x4f, 0x01, 0xc3, 0xce, 0x92, 0x63, 0xdb, 0x84, 0x66, 0xc4, 0xd6, 0xbe, 0x99, 0x6e, 0x6b, 0x13,
+	0x6b, 0x7c, 0xde, 0x31, 0x1b, 0xee, 0xc3, 0xe5, 0xd4, 0x0a, 0xc3, 0x45, 0x41, 0xf8, 0xb8, 0xd0,
+	0x0a, 0x96, 0x25, 0x0c, 0xd4, 0xb0, 0xf4, 0x56, 0x02, 0x0d, 0x03, 0xe1, 0x36, 0x65, 0x93, 0x53,
+	0xa4, 0x82, 0x5c, 0xac, 0x02, 0x1d, 0x16, 0xd2, 0x2b, 0x60, 0x96, 0xe0, 0x67, 0xe0, 0x4a, 0x0c,
+	0x27, 0x76, 0xc7, 0x72, 0xe0, 0x40, 0xdb, 0x05, 0x7d, 0x10, 0x85, 0xe0, 0x56, 0xe4, 0x69, 0x46,
+	0x42, 0xe0, 0x99, 0x2b, 0xcf, 0xdc, 0xb1, 0x54, 0x1a, 0x2b, 0xd1, 0x9f, 0x2b, 0x70, 0x2b, 0x9d,
+	0x6c, 0x82, 0xde, 0x0f, 0x14, 0x95, 0x19, 0xa8, 0x0f, 0x75, 0x00, 0x36, 0x86, 0xab, 0xcf, 0x80,
+	0xba, 0x9e, 0xb7, 0x32, 0xb5, 0xe0, 0x76, 0xc6, 0xea, 0x9f, 0x51, 0x98, 0x9f, 0xc0, 0x4b, 0xb1,
+	0x0a, 0xb8, 0xbb, 0x73, 0x84, 0x19, 0xec, 0x35, 0x38, 0x1b, 0x7f, 0xf5, 0x4a, 0xee, 0x5c, 0x10,
+	0xb1, 0x96, 0x8c, 0x17, 0xa2, 0x0f, 0x95, 0xf1, 0xf2, 0xdb, 0xd3, 0x6f, 0xc2, 0xf5, 0xa1, 0xd5,
+	0x33, 0x75, 0xa4, 0x27, 0x1d, 0xec, 0x64, 0x8d, 0x4d, 0xd5, 0xab, 0xf4, 0x1a, 0x1f, 0xb7, 0xa2,
+	0x5f, 0x86, 0x85, 0x74, 0x14, 0x26, 0xa0, 0x37, 0xf0, 0x23, 0x17, 0x82, 0xc0, 0x8c, 0xe0, 0xe5,
+	0xb4, 0x13, 0x42, 0x46, 0xc7, 0xe0, 0xf8, 0xfa, 0x1d, 0x32, 0x35, 0xe2, 0x13, 0xc2, 0xc8, 0x0a,
+	0x43, 0xb8, 0x3e, 0xa2, 0x88, 0xd7, 0x47, 0xf4, 0x2f, 0xc2, 0x7c, 0xb4, 0x04, 0x63, 0xe3, 0x0e,
+	0x8c, 0x61, 0x1c, 0xc6, 0xc3, 0x85, 0x41, 0xc7, 0xa7, 0x06, 0xc1, 0xd4, 0x2f, 0x91, 0x3d, 0xb7,
+	0x40, 0x2b, 0xd2, 0xf8, 0x77, 0xe1, 0x62, 0x4a, 0xfe, 0x33, 0x57, 0x49, 0x97, 0x09, 0x18, 0x10,
+	0x9b, 0xb0, 0xee, 0x42, 0x39, 0x9e, 0xc5, 0x2a, 0x22, 0x57, 0x95, 0x2c, 0x71, 0x0a, 0x18, 0xa7,
+	0xf2, 0xf0, 0xf4, 0x3a, 0x69, 0x84, 0x74, 0xff, 0x54, 0x92, 0xe4, 0x35, 0x98, 0x71, 0xc2, 0xcc,
+	0x50, 0xa0, 0xd3, 0x02, 0xb4, 0x61, 0xe9, 0x3d, 0xb8, 0x98, 0x42, 0x86, 0xb1, 0xb0, 0x0d, 0x9a,
+	0x48, 0x47, 0x38, 0x84, 0x4d, 0x3a, 0x12, 0x8e, 0xdc, 0x87, 0x35, 0xe6, 0x84, 0xb2, 0xf4, 0x80,
+	0x56, 0xbf, 0x47, 0x1c, 0x22, 0x02, 0x62, 0xf6, 0x59, 0x4b, 0x77, 0xe0, 0x42, 0x72, 0xd9, 0xcf,
+	0x8a, 0xd9, 0x5a, 0x94, 0x59, 0x79, 0x8d, 0x9d, 0x51, 0xc8, 0x97, 0xe0, 0x42, 0x32, 0x15, 0x36,
+	0x20, 0x7f, 0x2e, 0x5a, 0x8b, 0x6c, 0x2f, 0xb2, 0xd5, 0x82, 0xbd, 0x7c, 0xf4, 0xee, 0x30, 0x5b,
+	0x31, 0xb2, 0x54, 0xbc, 0xf6, 0x88, 0x39, 0xf8, 0x41, 0x8e, 0xba, 0xa8, 0x3a, 0x4e, 0xdf, 0xba,
+	0x6f, 0xb6, 0x9f, 0xf6, 0x7b, 0x23, 0xac, 0x23, 0x62, 0xfe, 0xa9, 0x5c, 0xb2, 0x4f, 0x72, 0xbf,
+	0xdf, 0xe9, 0xb0, 0x9b, 0x78, 0xe4, 0x37, 0x1e, 0xe9, 0xbe, 0xe9, 0x3d, 0x15, 0x2e, 0x8a, 0xe1,
+	0x64, 0xc3, 0xd2, 0x76, 0x82, 0x69, 0xa4, 0x40, 0xa6, 0x91, 0xd7, 0x93, 0xa6, 0x91, 0x34, 0x66,
+	0x9f, 0xf7, 0xac, 0xf1, 0x79, 0xb8, 0x90, 0x5c, 0x1b, 0x53, 0x38, 0xa1, 0x15, 0x8a, 0xd8, 0x0a,
+	0xfd, 0x2f, 0x94, 0x68, 0xc9, 0xf8, 0xaa, 0xe3, 0x09, 0x81, 0x0b, 0x52, 0xa5, 0x80, 0x86, 0x85,
+	0xe7, 0x1e, 0x97, 0xa2, 0xb7, 0x98, 0xe8, 0x85, 0xc5, 0xda, 0x1c, 0xcb, 0xa2, 0xb6, 0x9e, 0x38,
+	0x5f, 0x62, 0xbd, 0x90, 0x4f, 0xe8, 0x85, 0xd4, 0xab, 0x79, 0x42, 0x23, 0x0a, 0x52, 0x57, 0x24,
+	0xed, 0x7c, 0x8b, 0x89, 0x3b, 0x5f, 0xdd, 0x82, 0x8b, 0x29, 0xcd, 0x65, 0x92, 0x5a, 0x84, 0xb9,
+	0x48, 0x93, 0x82, 0x76, 0xcf, 0x4a, 0x0d, 0x92, 0x19, 0xca, 0x49, 0x52, 0xed, 0x47, 0x35, 0x35,
+	0xb6, 0xe5, 0x4d, 0x97, 0x69, 0x26, 0x4d, 0x0d, 0xbc, 0x36, 0x79, 0xc1, 0x6b, 0xc3, 0x46, 0x50,
+	0x42, 0xb5, 0x6c, 0x04, 0xd9, 0x70, 0x29, 0x29, 0xbf, 0xda, 0x09, 0xce, 0x74, 0x74, 0x98, 0xf6,
+	0xdc, 0x76, 0xac, 0xe5, 0x93, 0x9e, 0xdb, 0x7e, 0x34, 0xca, 0x50, 0x0a, 0xe6, 0xee, 0xa4, 0xaa,
+	0x18, 0x37, 0xbf, 0xab, 0xc0, 0x4d, 0x19, 0x67, 0xd0, 0x92, 0x2e, 0x0b, 0x67, 0x17, 0x01, 0xd8,
+	0xcc, 0x2d, 0x1c, 0xb3, 0x30, 0x48, 0x12, 0xe3, 0x49, 0xda, 0xa7, 0x42, 0xde, 0xec, 0x74, 0xd8,
+	0x85, 0x5b, 0xfc, 0x53, 0xff, 0xdf, 0x39, 0xd0, 0x64, 0x3e, 0xc9, 0x15, 0xd8, 0xe8, 0xbd, 0xb4,
+	0x18, 0x83, 0xb9, 0x38, 0x83, 0x2f, 0xc1, 0xac, 0x80, 0x23, 0xdc, 0xf3, 0x99, 0x0e, 0xb0, 0xc8,
+	0x38, 0x91, 0x5e, 0x0b, 0x8f, 0x8d, 0xf2, 0x5a, 0x78, 0x53, 0x08, 0xe1, 0x47, 0xed, 0xd2, 0x2b,
+	0x43, 0xec, 0x12, 0x6e, 0xcc, 0xd2, 0x26, 0x2b, 0xc3, 0x2e, 0x79, 0x72, 0x12, 0x5a, 0x35, 0xb8,
+	0xce, 0x44, 0xa3, 0x02, 0xdd, 0x1c, 0x42, 0x8c, 0x4e, 0x47, 0x34, 0x4c, 0x04, 0x2d, 0x88, 0xef,
+	0x89, 0x4a, 0xd4, 0x47, 0xb2, 0x6b, 0x4f, 0x61, 0x31, 0x8b, 0x8a, 0x04, 0xaf, 0x7f, 0xc6, 0xe9,
+	0x30, 0xe2, 0xd7, 0x84, 0xae, 0x66, 0x68, 0xbb, 0xc1, 0xcb, 0xe8, 0xbf, 0x34, 0x06, 0x67, 0x92,
+	0x9a, 0x33, 0x78, 0xbc, 0xbe, 0x0d, 0x45, 0xa7, 0x17, 0xbc, 0x16, 0x4c, 0x79, 0x72, 0x24, 0xd0,
+	0xdc, 0xee, 0x51, 0xf1, 0xd0, 0x42, 0x82, 0x84, 0xf3, 0xcf, 0x28, 0xe1, 0xf0, 0xb1, 0xbe, 0xe5,
+	0xb0, 0xf0, 0x95, 0xfc, 0xb1, 0x7e, 0xcd, 0xe9, 0xa2, 0xc8, 0x3b, 0xdf, 0xc2, 0x28, 0xef, 0x7c,
+	0xab, 0x30, 0x83, 0x63, 0x81, 0x75, 0x90, 0x8f, 0xd8, 0x6b, 0xdf, 0xe1, 0xe1, 0x4c, 0xa6, 0x83,
+	0x12, 0x84, 0x84, 0x60, 0xcd, 0xc7, 0x25, 0x6b, 0x1e, 0x1b, 0x2f, 0x13, 0xf1, 0xf1, 0x82, 0x83,
+	0x6f, 0x62, 0x37, 0x4c, 0x89, 0xac, 0x29, 0xc9, 0xef, 0xf8, 0x28, 0x86, 0x84, 0x51, 0x7c, 0x19,
+	0x26, 0xa9, 0x48, 0xe8, 0xa5, 0xd0, 0x49, 0x22, 0x13, 0x2a, 0x25, 0x7a, 0x2d, 0xf4, 0x32, 0x4c,
+	0x22, 0xdf, 0x6c, 0xf1, 0xeb, 0x3c, 0x53, 0xf4, 0xf9, 0x0f, 0xf2, 0xcd, 0x26, 0x85, 0xe8, 0x36,
+	0x9c, 0x4f, 0x12, 0x7c, 0xa6, 0xc5, 0xc6, 0x19, 0x28, 0x60, 0x3f, 0x4a, 0x87, 0x2d, 0x70, 0x68,
+	0x42, 0x9c, 0x2d, 0xf2, 0xd2, 0x6c, 0xf1, 0x9f, 0x63, 0x73, 0x30, 0xaf, 0x8b, 0xe9, 0xf5, 0x63,
+	0x98, 0xa0, 0x5d, 0x1d, 0xdc, 0x7f, 0x7b, 0x33, 0x93, 0x96, 0x84, 0xd7, 0x7c, 0x59, 0x69, 0x36,
+	0xbc, 0x39, 0xb1, 0xca, 0x13, 0x98, 0x96, 0xb2, 0x12, 0xc6, 0xe6, 0x9b, 0xf2, 0xed, 0xca, 0x6b,
+	0xd9, 0x2a, 0x16, 0x86, 0xf0, 0x57, 0x63, 0x4b, 0x13, 0xd3, 0x37, 0x3b, 0xce, 0xc1, 0x73, 0x9b,
+	0x0c, 0xf5, 0x37, 0xe1, 0x62, 0x4a, 0x0d, 0x4c, 0x7e, 0x38, 0x88, 0x9d, 0xd3, 0xf5, 0x51, 0xd7,
+	0xe7, 0xdb, 0x93, 0x20, 0xad, 0xff, 0x01, 0x7d, 0x50, 0x2a, 0x94, 0x5e, 0xb7, 0x71, 0xf3, 0x4e,
+	0x1a, 0x3e, 0x3a, 0xca, 0x34, 0xeb, 0x48, 0xc6, 0x3a, 0x37, 0x8a, 0xb1, 0xfe, 0xf4, 0x63, 0x5f,
+	0xbf, 0x0f, 0x17, 0x12, 0xb9, 0x1f, 0x61, 0xda, 0xd4, 0xbb, 0x70, 0x31, 0x85, 0x06, 0x93, 0xdf,
+	0x26, 0x4c, 0x1d, 0x52, 0x50, 0xab, 0x63, 0x7b, 0xfc, 0xd9, 0xe1, 0xe2, 0x10, 0x6e, 0x05, 0x39,
+	0x1a, 0x93, 0xac, 0xfc, 0x86, 0xed, 0xf9, 0xfa, 0xf7, 0x15, 0x58, 0x90, 0x51, 0x71, 0xc3, 0x10,
+	0x7d, 0xe6, 0x20, 0xec, 0xb0, 0x13, 0x57, 0xac, 0xda, 0x23, 0x98, 0x75, 0x29, 0x4e, 0x10, 0xeb,
+	0x87, 0x1a, 0xde, 0xdb, 0x43, 0xf8, 0x31, 0x78, 0x29, 0x52, 0x9b, 0x31, 0xe3, 0x4a, 0x69, 0x76,
+	0x5f, 0x35, 0x8d, 0x29, 0xb6, 0x66, 0xf9, 0xa9, 0x02, 0x95, 0x08, 0x16, 0xf3, 0x5d, 0x90, 0x35,
+	0xc1, 0xf3, 0x5a, 0x3e, 0xc9, 0xf7, 0xd4, 0xf2, 0x9f, 0xe2, 0x9e, 0x1a, 0x36, 0x74, 0x38, 0x80,
+	0x02, 0x9f, 0x17, 0xe9, 0xec, 0x00, 0x47, 0xe6, 0x47, 0x94, 0x7d, 0x2f, 0xd8, 0xf4, 0x14, 0xc2,
+	0x4d, 0x8f, 0x7e, 0x12, 0xeb, 0x20, 0x4c, 0x4f, 0xde, 0x6e, 0xed, 0x81, 0xda, 0xc6, 0x08, 0xd4,
+	0xfb, 0x23, 0xba, 0xcb, 0x5f, 0x1e, 0xa6, 0xc6, 0x82, 0xc8, 0x8c, 0x19, 0x42, 0x84, 0x80, 0x70,
+	0x5a, 0x7f, 0x37, 0xd6, 0x0d, 0x62, 0xd5, 0xc1, 0xd9, 0x81, 0xc6, 0x6c, 0x46, 0xe0, 0x7a, 0x0a,
+	0x84, 0xad, 0x3e, 0x91, 0x2b, 0xb1, 0xf4, 0x9d, 0xc4, 0xd6, 0xc8, 0x4b, 0xf2, 0xd1, 0x28, 0x5e,
+	0x85, 0x2b, 0x03, 0x28, 0x32, 0x5d, 0xb9, 0x06, 0x57, 0x13, 0x90, 0x62, 0x7e, 0x95, 0x5f, 0xce,
+	0xc1, 0x8b, 0x83, 0xf1, 0x58, 0xa3, 0x3d, 0x59, 0xe0, 0xc2, 0x48, 0x6c, 0x64, 0x11, 0x78, 0x8c,
+	0xe0, 0xd2, 0x6a, 0x20, 0x79, 0x3c, 0x2c, 0xe9, 0xdc, 0x30, 0xd3, 0x96, 0x80, 0x95, 0x2e, 0x9c,
+	0x4e, 0x40, 0x4b, 0x98, 0x27, 0xaa, 0xf2, 0x3c, 0x31, 0x92, 0x0e, 0x08, 0xb3, 0xc5, 0x02, 0xd9,
+	0xa2, 0x34, 0xc8, 0x48, 0xf0, 0x4f, 0xf0, 0x31, 0xcb, 0x13, 0xbb, 0x63, 0xfb, 0x36, 0xe2, 0x33,
+	0xaf, 0xde, 0x81, 0xcb, 0xa9, 0x18, 0x4c, 0x52, 0x0d, 0x98, 0x6a, 0x0b, 0x70, 0x26, 0xa5, 0xc4,
+	0xa9, 0xab, 0x89, 0x5c, 0x7c, 0x30, 0x1f, 0x90, 0x39, 0x31, 0xa4, 0xa2, 0xec, 0x0c, 0x87, 0xd7,
+	0xf6, 0x08, 0xb9, 0x9e, 0xed, 0x74, 0x39, 0x2b, 0xbf, 0x41, 0xad, 0x41, 0x2c, 0x97, 0xb1, 0xf1,
+	0x16, 0x4c, 0x7a, 0xd6, 0xd3, 0xd6, 0x31, 0x05, 0x97, 0x95, 0x94, 0xe3, 0x6c, 0xec, 0x0e, 0x65,
+	0x25, 0xc1, 0x0b, 0x7e, 0x63, 0xb7, 0x25, 0x2f, 0x99, 0x1b, 0xec, 0xb6, 0xe4, 0xa5, 0x39, 0xbe,
+	0xfe, 0xd7, 0xf3, 0x70, 0x26, 0xa9, 0x6d, 0xda, 0x2e, 0xbe, 0xc0, 0x4c, 0x80, 0x8c, 0x9b, 0xd7,
+	0x33, 0xc9, 0x64, 0x69, 0xbb, 0x87, 0xba, 0xac, 0x32, 0x96, 0x89, 0xef, 0xf7, 0x32, 0x52, 0x95,
+	0x5f, 0xca, 0x81, 0x16, 0xc7, 0xd0, 0xde, 0x65, 0xcf, 0xee, 0xe8, 0xa5, 0xf0, 0xb7, 0x9f, 0xb5,
+	0xa6, 0x25, 0xfa, 0xf0, 0x0d, 0x93, 0xd2, 0x7f, 0x47, 0x81, 0x31, 0x9c, 0xd4, 0x26, 0x61, 0x7c,
+	0x6f, 0xeb, 0xe1, 0xd6, 0xf6, 0xe3, 0x2d, 0xf5, 0x14, 0x4e, 0xac, 0x6e, 0xec, 0x35, 0x77, 0xeb,
+	0x86, 0xaa, 0x68, 0x2a, 0x4c, 0xad, 0x6e, 0x6c, 0xef, 0xd5, 0x5a, 0xf7, 0xab, 0xab, 0x0f, 0xf7,
+	0x76, 0xd4, 0x9c, 0x36, 0x0b, 0x93, 0xab, 0x46, 0xbd, 0x56, 0xdf, 0xda, 0x6d, 0x54, 0x37, 0x9a,
+	0x6a, 0x5e, 0x9b, 0x80, 0xb1, 0xad, 0xed, 0x5a, 0x5d, 0x1d, 0xd3, 0x34, 0x98, 0xd9, 0xbe, 0xff,
+	0xc5, 0xfa, 0xea, 0x6e, 0xab, 0xb9, 0xbb, 0x6d, 0x54, 0x1f, 0xd4, 0xd5, 0x82, 0x76, 0x1a, 0x66,
+	0x9b, 0xab, 0xeb, 0xf5, 0xda, 0xde, 0x46, 0xbd, 0xb5, 0xb3, 0xbd, 0xd1, 0x58, 0x7d, 0x5f, 0x2d,
+	0x6a, 0x00, 0xc5, 0x47, 0xdb, 0x1b, 0x7b, 0x9b, 0x75, 0x75, 0x1c, 0xff, 0xae, 0x6e, 0xd4, 0x8d,
+	0xdd, 0xa6, 0x3a, 0x81, 0x6b, 0xdb, 0xdc, 0xde, 0xdb, 0xda, 0x6d, 0x55, 0x77, 0x77, 0xab, 0xab,
+	0xeb, 0x6a, 0xe9, 0x7e, 0x91, 0xb6, 0x5a, 0xff, 0x67, 0x0a, 0x40, 0xd8, 0xb3, 0x78, 0x49, 0x78,
+	0x64, 0x7e, 0xcd, 0xe1, 0x37, 0xee, 0x69, 0x82, 0x40, 0xed, 0xae, 0xc3, 0xaf, 0xa8, 0xd3, 0x04,
+	0x86, 0xf6, 0x70, 0x70, 0x02, 0x76, 0x45, 0x9d, 0x26, 0xf0, 0xe5, 0x73, 0xae, 0x0f, 0x2c, 0xb6,
+	0x14, 0xef, 0xee, 0x75, 0x18, 0xe7, 0xd5, 0x94, 0xe1, 0xcc, 0xe6, 0x5e, 0x73, 0xb7, 0xb5, 0x5e,
+	0x7d, 0x54, 0x6f, 0x7d, 0xa9, 0x6e, 0x6c, 0xb7, 0x1e, 0x55, 0x37, 0xf6, 0xea, 0xea, 0x29, 0xad,
+	0x04, 0x85, 0x4d, 0x5c, 0x27, 0xfb, 0x89, 0x2b, 0x52, 0x2f, 0xe1, 0x9f, 0x3b, 0x98, 0xba, 0x7a,
+	0xaa, 0x92, 0x53, 0x15, 0xfd, 0xdf, 0x2a, 0xc1, 0x83, 0x16, 0x4e, 0x11, 0x87, 0x8a, 0x26, 0xaf,
+	0x10, 0xf9, 0x34, 0x4c, 0x53, 0x22, 0x3b, 0x39, 0x89, 0x1d, 0x6d, 0x0d, 0xc6, 0x2d, 0xe4, 0x9b,
+	0x76, 0x70, 0x3e, 0x77, 0x6b, 0x88, 0xe2, 0x2e, 0xd5, 0x28, 0x3a, 0x7b, 0x48, 0xcc, 0x0a, 0xe3,
+	0x87, 0xc4, 0x62, 0xc6, 0x48, 0xfb, 0xc6, 0x1f, 0xe7, 0x60, 0x8a, 0x58, 0x9b, 0x4d, 0xfb, 0x00,
+	0xdb, 0x3c, 0xbd, 0x05, 0xd3, 0xdb, 0x3d, 0x6c, 0xfe, 0x6c, 0xa7, 0x4b, 0x34, 0x68, 0x16, 0x26,
+	0x1b, 0xdd, 0x63, 0x7c, 0x01, 0x0d, 0x27, 0xd5, 0x53, 0x58, 0x17, 0x18, 0x32, 0x3b, 0x06, 0x50,
+	0x15, 0x6d, 0x0e, 0xa6, 0x19, 0x8c, 0x4e, 0xdf, 0x6a, 0x4e, 0x9b, 0x07, 0x4d, 0x02, 0x91, 0x97,
+	0x75, 0x6a, 0x5e, 0xdf, 0x22, 0x21, 0xe1, 0x0e, 0x10, 0x56, 0x09, 0x46, 0x98, 0xa4, 0xd5, 0x53,
+	0x58, 0x61, 0xa8, 0xd1, 0x53, 0x15, 0xac, 0xab, 0xcc, 0xe3, 0xa4, 0xe6, 0x30, 0xaa, 0x78, 0x22,
+	0x4f, 0x55, 0x13, 0xef, 0xd9, 0xd4, 0x31, 0xbd, 0x07, 0x45, 0xb6, 0xfb, 0x9c, 0x83, 0xe9, 0x90,
+	0xa0, 0xdf, 0xf7, 0x28, 0xc5, 0x77, 0xfb, 0xa8, 0x8f, 0x2c, 0x55, 0xa1, 0x0d, 0xb1, 0xf1, 0xba,
+	0xc1, 0xfe, 0x18, 0x59, 0x6a, 0x4e, 0x9b, 0x01, 0x68, 0x74, 0x79, 0x78, 0x37, 0x35, 0x8f, 0x91,
+	0xd7, 0x4c, 0xbb, 0x83, 0x2c, 0x75, 0x4c, 0x9b, 0x82, 0x89, 0x55, 0xb6, 0x3d, 0x53, 0x0b, 0x24,
+	0x65, 0x76, 0xdb, 0x08, 0xe7, 0x15, 0xf5, 0x7f, 0xa5, 0x40, 0x59, 0x94, 0x59, 0x13, 0x6f, 0x03,
+	0xf9, 0xfc, 0xd8, 0x80, 0x92, 0xc3, 0xe5, 0xc7, 0x46, 0x74, 0xdc, 0xc4, 0x8b, 0xa5, 0x97, 0x24,
+	0x71, 0x1b, 0x61, 0xe9, 0x61, 0x5e, 0x9a, 0xf3, 0x50, 0xf2, 0x4d, 0xf7, 0x00, 0xf9, 0xe1, 0x46,
+	0x69, 0x82, 0x02, 0x64, 0x8f, 0x9b, 0xe4, 0x8d, 0xd5, 0xff, 0x2a, 0x1f, 0xee, 0xd7, 0x92, 0xf8,
+	0x97, 0x2b, 0x55, 0xa2, 0x95, 0xa6, 0x79, 0xf2, 0xb4, 0xbd, 0xe0, 0x46, 0x08, 0xbb, 0x72, 0x7a,
+	0x2f, 0x75, 0x62, 0x4b, 0xa8, 0x76, 0x49, 0x52, 0x15, 0xfc, 0xc4, 0x82, 0x12, 0xd3, 0x10, 0xb0,
+	0x60, 0xc3, 0x2d, 0x1a, 0xa9, 0x94, 0x5d, 0x3c, 0xfd, 0xc2, 0xb3, 0x13, 0xa7, 0x21, 0x7b, 0x4f,
+	0x19, 0x93, 0xc7, 0x61, 0x52, 0x7b, 0x02, 0x93, 0x66, 0xa7, 0xc3, 0xd6, 0xa3, 0x1e, 0xbf, 0x82,
+	0xfa, 0xce, 0xb3, 0xd4, 0x52, 0xed, 0x74, 0x68, 0x45, 0xde, 0xfa, 0x29, 0x03, 0xcc, 0x20, 0x55,
+	0xb9, 0x15, 0x19, 0x23, 0x03, 0xb7, 0xc6, 0x95, 0xe5, 0xa4, 0xe1, 0x83, 0xcf, 0x8f, 0x88, 0x1c,
+	0xc2, 0x12, 0xe3, 0x24, 0xdd, 0xb0, 0x2a, 0xa7, 0x61, 0x2e, 0xc6, 0x01, 0x8f, 0x76, 0xf5, 0x2a,
+	0x9c, 0x4b, 0x60, 0x7b, 0x98, 0xcb, 0xfb, 0x49, 0xb8, 0x65, 0x4a, 0x2c, 0x78, 0x1f, 0xdf, 0x22,
+	0xf7, 0xfa, 0x1d, 0x1e, 0xfb, 0x66, 0x71, 0xa0, 0x9e, 0x4b, 0x65, 0x0d, 0x56, 0x32, 0xca, 0x19,
+	0x1d, 0x65, 0xc3, 0xb6, 0x36, 0xba, 0x15, 0xe3, 0x4c, 0x2e, 0x58, 0xc3, 0x71, 0x25, 0xc9, 0xcf,
+	0x4c, 0xac, 0x49, 0x85, 0x0d, 0x5e, 0x94, 0xdf, 0xc2, 0x4d, 0x40, 0x64, 0x0b, 0xd7, 0x7f, 0x51,
+	0x00, 0x55, 0xcc, 0x26, 0x5b, 0x9b, 0xd4, 0xfd, 0xd8, 0x90, 0xe1, 0xfc, 0x12, 0xcc, 0x12, 0xf7,
+	0x87, 0xb0, 0x29, 0x62, 0x2e, 0x4f, 0x02, 0x0e, 0xb6, 0x45, 0x8b, 0x30, 0x27, 0xe1, 0x11, 0xe7,
+	0x28, 0x1d, 0xe3, 0xb3, 0x02, 0x26, 0x71, 0x8f, 0xde, 0x00, 0xd5, 0x45, 0x47, 0x8e, 0x2f, 0xba,
+	0xe8, 0xe9, 0x89, 0xc0, 0x0c, 0x85, 0x3f, 0x12, 0x6e, 0x29, 0x91, 0x05, 0x6d, 0xe8, 0x61, 0xa0,
+	0xe7, 0x02, 0xd3, 0x02, 0x94, 0x6c, 0xb7, 0xa6, 0x79, 0xdc, 0x18, 0x0f, 0x1b, 0x6d, 0xf6, 0xb4,
+	0xf7, 0xea, 0x60, 0x0b, 0x47, 0xec, 0xbb, 0x31, 0xc5, 0x4a, 0x92, 0x94, 0xf6, 0x56, 0xb0, 0xa5,
+	0x9f, 0x20, 0x24, 0x5e, 0x1c, 0x4a, 0x42, 0x7c, 0x0c, 0xfa, 0x26, 0x4c, 0x92, 0xe0, 0xbd, 0x7d,
+	0x32, 0x1f, 0x64, 0x08, 0xdf, 0x0b, 0x18, 0x9d, 0x45, 0x7c, 0xbf, 0x02, 0x53, 0xe4, 0x91, 0x76,
+	0x8b, 0xc6, 0x3f, 0x64, 0x7e, 0xb1, 0x49, 0x02, 0x33, 0x08, 0x28, 0xe2, 0x0a, 0x9c, 0xfc, 0x74,
+	0xae, 0xc0, 0xa9, 0x51, 0x5d, 0x81, 0x11, 0xa7, 0xdc, 0x74, 0xcc, 0x29, 0x27, 0x3b, 0x32, 0x67,
+	0xa2, 0x8e, 0xcc, 0x88, 0xcf, 0x6e, 0x36, 0xe6, 0xb3, 0xdb, 0x84, 0x33, 0x51, 0xbd, 0xc5, 0x5b,
+	0x16, 0x7c, 0x67, 0x4e, 0xd8, 0x2d, 0x5d, 0x19, 0xd8, 0x25, 0xb8, 0x90, 0x41, 0xd0, 0xc5, 0xe3,
+	0x94, 0x70, 0xb0, 0x87, 0x3e, 0x40, 0xfd, 0x8f, 0x15, 0xa8, 0x24, 0xe5, 0x06, 0xbb, 0x90, 0x31,
+	0xb6, 0x29, 0xc6, 0xb5, 0xde, 0x1d, 0x66, 0x45, 0x84, 0xa2, 0x4b, 0x61, 0x94, 0x5f, 0x42, 0xa2,
+	0xf2, 0x15, 0x28, 0x0d, 0x8a, 0x54, 0x3b, 0xd4, 0x47, 0x97, 0x24, 0x15, 0x71, 0xb9, 0x64, 0xc5,
+	0x4c, 0x42, 0xa4, 0x2d, 0xab, 0x11, 0x9b, 0xf8, 0xf2, 0x08, 0xad, 0x09, 0x8c, 0xe2, 0x4f, 0xc8,
+	0x02, 0x83, 0x18, 0x86, 0x1d, 0xd3, 0x76, 0x65, 0x77, 0x02, 0x39, 0x77, 0x23, 0x63, 0x3a, 0xb0,
+	0x26, 0xbd, 0xf0, 0xdc, 0x0d, 0x67, 0xb0, 0xa2, 0x8d, 0x1e, 0x3d, 0x76, 0x94, 0x70, 0x49, 0x0c,
+	0xa7, 0x1c, 0xf9, 0x94, 0xc4, 0x9c, 0x84, 0x4d, 0x42, 0x39, 0xdd, 0x81, 0x33, 0x11, 0x7c, 0xdf,
+	0x79, 0x8a, 0x78, 0x34, 0x37, 0x4d, 0x2a, 0xb0, 0x8b, 0x73, 0xe8, 0x35, 0x72, 0xbf, 0x65, 0xa1,
+	0x7d, 0x13, 0x37, 0x9a, 0x9e, 0x06, 0x81, 0x87, 0xfc, 0x1a, 0x85, 0xe8, 0x1f, 0xc2, 0x39, 0x56,
+	0x40, 0x6c, 0x8a, 0x78, 0x86, 0x28, 0xb7, 0xc5, 0x4a, 0x6e, 0x8b, 0x95, 0xd0, 0x16, 0xf9, 0x08,
+	0x55, 0xc0, 0x26, 0x2f, 0x8d, 0x9e, 0xb0, 0x75, 0x4e, 0x8a, 0x18, 0x57, 0xa3, 0x53, 0xc4, 0xcd,
+	0x84, 0x9e, 0x4a, 0x2e, 0x1b, 0xce, 0x10, 0x7c, 0x86, 0x4c, 0x6b, 0x5f, 0x96, 0x19, 0x32, 0xa5,
+	0x6c, 0xa0, 0x0c, 0x27, 0x92, 0x00, 0x77, 0x5c, 0xa7, 0x8d, 0x3c, 0x4f, 0x50, 0x06, 0x16, 0x7b,
+	0x30, 0x2e, 0x40, 0x9a, 0x11, 0x0a, 0x30, 0xad, 0x73, 0x73, 0x69, 0x9d, 0xab, 0xff, 0x51, 0x0e,
+	0x2a, 0x0c, 0x20, 0xd5, 0xfd, 0xd9, 0xf7, 0x9e, 0xf6, 0x3a, 0x94, 0x23, 0xf8, 0x61, 0x34, 0xb3,
+	0x3c, 0xf1, 0x4c, 0xcf, 0x4b, 0x85, 0x78, 0xb8, 0x32, 0x4f, 0x33, 0xa2, 0x01, 0x9a, 0x5e, 0x1f,
+	0x24, 0xf4, 0x48, 0x9b, 0x3e, 0x83, 0x50, 0x4d, 0x6f, 0x48, 0x63, 0x59, 0x76, 0xa6, 0x0d, 0x5e,
+	0x6c, 0xb3, 0xc7, 0x72, 0xcf, 0x5a, 0xfa, 0x12, 0x5c, 0x48, 0x2e, 0xcd, 0x56, 0x2f, 0x6f, 0x4b,
+	0x9d, 0x4b, 0x7a, 0xfc, 0x41, 0x18, 0x8e, 0x8b, 0x06, 0xf0, 0x44, 0x3e, 0x53, 0x12, 0xfa, 0xe8,
+	0x01, 0x08, 0x88, 0x2a, 0xc7, 0xe7, 0xe0, 0x7c, 0x62, 0xf1, 0x30, 0xce, 0x4e, 0x58, 0xb2, 0x64,
+	0xd0, 0x44, 0xb0, 0xa4, 0x0a, 0xca, 0x3d, 0x60, 0xe4, 0xf8, 0x54, 0xb1, 0x0f, 0x97, 0xd2, 0x10,
+	0x18, 0xe1, 0x5a, 0x64, 0x4c, 0xdd, 0x1a, 0xd4, 0xbd, 0x51, 0xb6, 0x82, 0x51, 0x25, 0x5d, 0x99,
+	0xc3, 0x98, 0x06, 0xf2, 0x22, 0xac, 0x1c, 0xc2, 0x42, 0x3a, 0xca, 0x73, 0x65, 0xe6, 0xc7, 0x39,
+	0x98, 0x15, 0xf0, 0x12, 0x4f, 0xcd, 0x93, 0xee, 0xf7, 0x0e, 0x7a, 0xe2, 0xf9, 0x32, 0xcc, 0x45,
+	0x23, 0xfc, 0xd1, 0x01, 0x51, 0x32, 0xd4, 0x48, 0x88, 0x3f, 0x16, 0xd3, 0xb3, 0xdd, 0x77, 0x11,
+	0x73, 0x6e, 0xb3, 0x54, 0xd8, 0x89, 0x45, 0xa1, 0x13, 0xb5, 0x07, 0xe1, 0x08, 0x1b, 0x4f, 0xf9,
+	0x92, 0x55, 0xa4, 0x35, 0x9f, 0xc1, 0xb0, 0xba, 0x0e, 0x2f, 0xc8, 0x5a, 0x92, 0x12, 0x9a, 0x48,
+	0x5f, 0x8a, 0x0e, 0x83, 0xc8, 0xbd, 0xba, 0x28, 0xfe, 0x63, 0x98, 0x8f, 0x12, 0x0e, 0x4e, 0xcd,
+	0x4b, 0x3d, 0xd3, 0x76, 0x45, 0x0f, 0xfe, 0xc2, 0xb0, 0x96, 0xe3, 0x97, 0x2f, 0xf4, 0x97, 0xfe,
+	0x55, 0xb8, 0x98, 0xc2, 0x08, 0xa3, 0xff, 0x85, 0x88, 0x32, 0x5d, 0x1f, 0x44, 0x3c, 0x49, 0x8f,
+	0x16, 0xa2, 0x83, 0x27, 0xe6, 0x43, 0xff, 0x9f, 0x0a, 0x5c, 0x14, 0xf2, 0xbd, 0xc4, 0x0b, 0xf5,
+	0x6c, 0x32, 0x17, 0x8c, 0x0a, 0x83, 0x34, 0x2c, 0x6d, 0x1b, 0xbb, 0xdc, 0x6c, 0x97, 0x5f, 0x09,
+	0x7e, 0x63, 0x10, 0x8b, 0x71, 0xea, 0x4b, 0x0c, 0x4c, 0xa2, 0xf6, 0x10, 0x3a, 0x38, 0x68, 0x4d,
+	0x08, 0x7c, 0x96, 0xa0, 0x35, 0x51, 0x81, 0x0b, 0x3a, 0x62, 0x47, 0x07, 0x79, 0xbc, 0xb9, 0x6b,
+	0x11, 0x99, 0x2f, 0x8d, 0xd6, 0xa0, 0x40, 0xf4, 0xff, 0x51, 0x81, 0x71, 0x76, 0x9a, 0x9a, 0xf8,
+	0x26, 0x2a, 0x29, 0xd4, 0x5b, 0x52, 0xb8, 0x35, 0xfe, 0x59, 0xbd, 0x31, 0xe1, 0xb3, 0x7a, 0xef,
+	0xc0, 0xd4, 0x86, 0xe9, 0xf9, 0x9b, 0x8e, 0x65, 0xef, 0xdb, 0xc8, 0xca, 0x70, 0x33, 0x41, 0xc2,
+	0xd7, 0x5e, 0x85, 0x89, 0xf6, 0xa1, 0xdd, 0xb1, 0x5c, 0x32, 0x90, 0x71, 0xb7, 0x25, 0x7c, 0xb3,
+	0x8a, 0xf2, 0x6e, 0x04, 0x98, 0xfa, 0xcf, 0x40, 0xd1, 0x40, 0x78, 0xb9, 0xa8, 0x2d, 0xe0, 0x57,
+	0xe1, 0x2e, 0x6a, 0xfb, 0x0e, 0x89, 0x42, 0xcb, 0xbe, 0x31, 0x20, 0x80, 0xc8, 0x2d, 0x2b, 0xbb,
+	0x13, 0x7c, 0x5d, 0x80, 0x26, 0xf4, 0x1e, 0xcc, 0x46, 0x0f, 0x98, 0x6f, 0xc1, 0x98, 0xeb, 0x38,
+	0x5c, 0xd8, 0xe9, 0x6c, 0x10, 0x2c, 0xfc, 0xca, 0xc8, 0x45, 0xc1, 0x8a, 0x35, 0xe9, 0x95, 0x11,
+	0xe5, 0xd0, 0x60, 0x68, 0xfa, 0xdf, 0xca, 0xc1, 0x0c, 0x79, 0xa1, 0x81, 0xc4, 0x05, 0x39, 0x79,
+	0x81, 0xc7, 0x0f, 0x37, 0xe2, 0x0b, 0x72, 0xb9, 0xc0, 0x12, 0x79, 0xcc, 0xc9, 0x2f, 0x1c, 0xd2,
+	0xa2, 0xda, 0x06, 0x94, 0x2c, 0xa7, 0xfd, 0x14, 0xb9, 0xb6, 0xc5, 0x35, 0x7f, 0x69, 0x18, 0x9d,
+	0x1a, 0x2f, 0x40, 0x49, 0x85, 0x04, 0xf0, 0xf5, 0x45, 0xa1, 0x92, 0x91, 0x02, 0xdc, 0xbf, 0x05,
+	0x33, 0x32, 0xdd, 0x91, 0x6c, 0xe6, 0x1e, 0x9c, 0x4d, 0xf9, 0xdc, 0x9a, 0x76, 0x0f, 0x0a, 0x2e,
+	0x39, 0x43, 0xa5, 0x52, 0x7a, 0x71, 0xd8, 0x77, 0xda, 0x8c, 0x7e, 0x07, 0x19, 0xb4, 0x88, 0xfe,
+	0x2f, 0xf3, 0x70, 0x3a, 0x21, 0x9b, 0x7c, 0x4d, 0x70, 0x7f, 0x1f, 0xb5, 0xf1, 0x46, 0x98, 0x7d,
+	0x44, 0xc5, 0x63, 0x6e, 0x7d, 0x95, 0x67, 0xb0, 0x0f, 0xad, 0xd0, 0x4f, 0x7a, 0x20, 0xfb, 0xe0,
+	0x90, 0x07, 0xaf, 0x67, 0x29, 0xed, 0x11, 0x4c, 0xb2, 0x0f, 0xf3, 0x61, 0xba, 0xec, 0xfc, 0xff,
+	0xd5, 0x2c, 0xec, 0x2d, 0xd5, 0xc3, 0x72, 0xc4, 0xb5, 0x2a, 0x12, 0xc2, 0x9b, 0x4e, 0x32, 0xf8,
+	0xc6, 0x08, 0xc1, 0xbb, 0x99, 0x08, 0x56, 0xf7, 0xf7, 0xed, 0x2e, 0x3e, 0xfa, 0xea, 0x77, 0x50,
+	0x78, 0xd8, 0xa2, 0x3d, 0x82, 0xb9, 0x23, 0x7c, 0x36, 0xd0, 0x0a, 0xa3, 0x5d, 0xf3, 0xbb, 0xae,
+	0xf1, 0x4d, 0x05, 0xb9, 0xb8, 0xda, 0x44, 0x1d, 0x32, 0x76, 0xd8, 0x07, 0x58, 0x48, 0x05, 0x2a,
+	0xa1, 0x51, 0x0f, 0x49, 0xe8, 0x4b, 0x30, 0x1b, 0x69, 0x02, 0x76, 0x44, 0xb3, 0x32, 0x96, 0x7a,
+	0x4a, 0x9b, 0x86, 0xd2, 0x8e, 0x8b, 0xf6, 0x91, 0x8b, 0x93, 0x8a, 0xbe, 0x02, 0x6a, 0x94, 0x43,
+	0x5c, 0x80, 0xc3, 0xd4, 0x53, 0xd8, 0x8f, 0x5e, 0xed, 0xfa, 0x76, 0x00, 0x51, 0xf0, 0xb3, 0xa3,
+	0x72, 0x1a, 0x4b, 0x09, 0xba, 0xb5, 0x05, 0x13, 0xd4, 0x3f, 0xcd, 0x8e, 0x62, 0x66, 0x12, 0xde,
+	0x6f, 0xa6, 0x91, 0x63, 0x8e, 0x6e, 0xc7, 0x35, 0x02, 0x1a, 0xb8, 0xd7, 0x89, 0x7a, 0xf2, 0x45,
+	0x3d, 0x4b, 0xe9, 0x0f, 0x61, 0x82, 0x63, 0x6b, 0x45, 0xc8, 0x35, 0xba, 0xf4, 0x34, 0x66, 0xcb,
+	0xf1, 0x1b, 0x5d, 0x55, 0xc1, 0x9e, 0xfa, 0xfa, 0x47, 0xb6, 0xe7, 0x7b, 0xf4, 0x6c, 0xa0, 0xe6,
+	0x20, 0x6f, 0xcb, 0xf1, 0x09, 0x48, 0xcd, 0xe3, 0x02, 0x0f, 0x7c, 0x75, 0x0c, 0xff, 0xdf, 0xf0,
+	0xd5, 0x82, 0xfe, 0x08, 0xa6, 0x9a, 0xd6, 0xd3, 0x3a, 0x76, 0xef, 0x90, 0xa5, 0x15, 0x89, 0x7e,
+	0x41, 0x3c, 0x3f, 0x0a, 0x8f, 0x7e, 0x81, 0x53, 0x18, 0x6e, 0x39, 0x47, 0x38, 0xfa, 0x10, 0x73,
+	0x6d, 0xd3, 0x14, 0x86, 0x1f, 0x21, 0xff, 0xd0, 0x09, 0xae, 0x23, 0xd1, 0x94, 0xfe, 0x90, 0x04,
+	0xd3, 0x59, 0xb3, 0x51, 0xc7, 0x7a, 0x64, 0x3b, 0x1d, 0xea, 0xb4, 0x27, 0xa6, 0x10, 0x75, 0xf8,
+	0xd4, 0x49, 0x13, 0xc4, 0x84, 0x22, 0xaf, 0xed, 0xda, 0x64, 0xb9, 0xc3, 0xe8, 0x8b, 0x20, 0xfd,
+	0x2b, 0x30, 0xdd, 0xb4, 0x9e, 0xde, 0x37, 0x2d, 0xbe, 0x2e, 0xd9, 0x04, 0x95, 0x94, 0x6d, 0x1d,
+	0x73, 0xda, 0x03, 0x63, 0x7a, 0xc9, 0x6c, 0x18, 0xb3, 0xfb, 0x52, 0xda, 0xd3, 0x1f, 0x93, 0x2f,
+	0x40, 0x04, 0x71, 0xe7, 0xd9, 0x05, 0xb1, 0xf8, 0x67, 0x00, 0x4a, 0x91, 0x38, 0xff, 0x91, 0x40,
+	0xfe, 0xb9, 0x68, 0x20, 0xff, 0xc5, 0xbf, 0xcc, 0x05, 0x07, 0x30, 0xb3, 0x30, 0xd9, 0xdc, 0xad,
+	0xee, 0xee, 0x35, 0x5b, 0x5b, 0xdb, 0x5b, 0xf8, 0x2c, 0x2d, 0x04, 0x34, 0xb6, 0x1a, 0xbb, 0xaa,
+	0x82, 0x35, 0x96, 0x01, 0xb6, 0x1f, 0xaa, 0x39, 0x7c, 0x94, 0xc4, 0x93, 0x6b, 0x6b, 0x1b, 0x8d,
+	0xad, 0xba, 0x9a, 0xc7, 0xfd, 0xc9, 0x60, 0x75, 0xc3, 0xd8, 0x36, 0xd4, 0x31, 0x7c, 0x56, 0x17,
+	0x90, 0xdd, 0x6d, 0x35, 0xb6, 0x5a, 0xef, 0xee, 0x6d, 0x1b, 0x7b, 0x9b, 0x6a, 0x41, 0x3b, 0x0b,
+	0xa7, 0x59, 0x4e, 0xad, 0xbe, 0xba, 0xbd, 0xb9, 0xd9, 0x68, 0x36, 0x1b, 0xdb, 0x5b, 0x6a, 0x11,
+	0x1f, 0x3e, 0xb1, 0x8c, 0xcd, 0x6a, 0x63, 0x6b, 0xb7, 0xbe, 0x55, 0xdd, 0x5a, 0xc5, 0x47, 0x92,
+	0x61, 0x01, 0x76, 0x8e, 0xd9, 0xaa, 0xe1, 0xa3, 0xd1, 0x09, 0xed, 0x3c, 0x9c, 0x8d, 0x66, 0xd4,
+	0x1f, 0x18, 0xd5, 0x5a, 0xbd, 0xa6, 0x96, 0x84, 0x52, 0x5b, 0xf5, 0x7a, 0xad, 0xd9, 0x32, 0xea,
+	0xf7, 0xb7, 0xb7, 0x77, 0x55, 0xd0, 0x2e, 0x40, 0x39, 0x52, 0xca, 0xa8, 0xdf, 0xaf, 0x6e, 0x90,
+	0xca, 0x26, 0xb5, 0x05, 0xb8, 0x10, 0xa5, 0x69, 0x34, 0x1e, 0x61, 0x9c, 0x9d, 0x8d, 0xea, 0x6a,
+	0x5d, 0x9d, 0xd2, 0xae, 0xc2, 0xe5, 0xa4, 0x96, 0xb5, 0xb6, 0xb6, 0x83, 0x73, 0xd6, 0x69, 0x7c,
+	0x4c, 0x15, 0xb4, 0xe5, 0x3d, 0x75, 0x66, 0xf1, 0x07, 0x0a, 0x00, 0x8d, 0x77, 0x4a, 0x3a, 0xe8,
+	0x0c, 0xa8, 0x84, 0xac, 0xd1, 0xda, 0x7d, 0x7f, 0xa7, 0xce, 0x25, 0x1f, 0x81, 0xae, 0x35, 0x36,
+	0xea, 0xaa, 0xa2, 0xbd, 0x00, 0x73, 0x22, 0xf4, 0xfe, 0xc6, 0xf6, 0xea, 0x43, 0x7a, 0x54, 0x27,
+	0x82, 0xe9, 0x49, 0xaf, 0x9a, 0xd7, 0xce, 0xc1, 0x0b, 0x22, 0x9c, 0x9d, 0x1d, 0xd7, 0x6b, 0xea,
+	0x58, 0x94, 0xd2, 0x03, 0xa3, 0xba, 0xb3, 0xae, 0x16, 0x16, 0xff, 0xa1, 0x02, 0x45, 0xfa, 0xf5,
+	0x33, 0xdc, 0x8f, 0x6b, 0x4d, 0x89, 0xa7, 0x39, 0x98, 0xe6, 0x90, 0xfb, 0xbb, 0xc6, 0x5a, 0x93,
+	0x1e, 0x42, 0x73, 0x50, 0xfd, 0xbd, 0xdd, 0x57, 0xd5, 0x9c, 0x08, 0x59, 0xdb, 0x6b, 0x62, 0x85,
+	0x98, 0x85, 0xc9, 0x80, 0xd0, 0x5a, 0x53, 0x1d, 0x13, 0x01, 0x8f, 0xd6, 0x9a, 0x6a, 0x41, 0x04,
+	0xbc, 0xb7, 0xd6, 0x54, 0x8b, 0x22, 0xe0, 0x4b, 0x6b, 0x4d, 0x75, 0x5c, 0xac, 0xfa, 0xbd, 0xb5,
+	0xe6, 0xf1, 0x8a, 0x3a, 0xb1, 0xf8, 0xfb, 0x0a, 0xbc, 0x90, 0x18, 0x3b, 0x56, 0xbb, 0x02, 0x17,
+	0x49, 0x7b, 0x5a, 0xac, 0x85, 0xab, 0xeb, 0xd5, 0xad, 0x07, 0x75, 0xa9, 0x29, 0xd7, 0xe0, 0x4a,
+	0x2a, 0xca, 0xe6, 0x76, 0xad, 0xb1, 0xd6, 0xa8, 0xd7, 0x54, 0x45, 0xd3, 0xe1, 0x52, 0x2a, 0x5a,
+	0xb5, 0x86, 0x95, 0x2b, 0xa7, 0xbd, 0x08, 0x0b, 0xa9, 0x38, 0xb5, 0xfa, 0x46, 0x7d, 0xb7, 0x5e,
+	0x53, 0xf3, 0x8b, 0x3e, 0x4c, 0x49, 0x1f, 0x5c, 0xc1, 0x0a, 0x5e, 0x7f, 0x54, 0x37, 0x1a, 0xbb,
+	0xef, 0x4b, 0x8c, 0x61, 0x55, 0x95, 0xe0, 0xd5, 0x8d, 0xaa, 0xb1, 0xa9, 0x2a, 0xb8, 0x2f, 0xe5,
+	0x8c, 0xc7, 0x55, 0x63, 0xab, 0xb1, 0xf5, 0x40, 0xcd, 0x91, 0xf1, 0x15, 0xa1, 0xb5, 0xdb, 0x58,
+	0x7b, 0x5f, 0xcd, 0x2f, 0x7e, 0x47, 0xc1, 0xc1, 0x66, 0x05, 0x6b, 0x30, 0x0f, 0x9a, 0x51, 0x6f,
+	0x6e, 0xef, 0x19, 0xab, 0xb2, 0x3c, 0xca, 0x70, 0x46, 0x86, 0xb3, 0x4b, 0x00, 0x4a, 0x52, 0x89,
+	0x5a, 0x5d, 0xcd, 0x61, 0x7e, 0x64, 0x38, 0xbf, 0x99, 0x90, 0xc7, 0x6d, 0x90, 0xb3, 0x88, 0x64,
+	0xd4, 0xb1, 0xc5, 0x5f, 0x50, 0x60, 0x96, 0x44, 0xec, 0xa7, 0x31, 0xb9, 0x09, 0x47, 0x15, 0x98,
+	0x27, 0x97, 0x0c, 0x5a, 0xd5, 0xd5, 0xdd, 0xc6, 0xf6, 0x96, 0xc4, 0xd5, 0x05, 0x28, 0xc7, 0xf3,
+	0xa8, 0x4c, 0x55, 0x25, 0x39, 0x77, 0xd5, 0xa8, 0x57, 0x77, 0x31, 0x7f, 0x89, 0xb9, 0x7b, 0x3b,
+	0x35, 0x9c, 0x9b, 0x5f, 0xfc, 0x1a, 0x0f, 0xbf, 0x2d, 0x44, 0x47, 0xc7, 0x45, 0x68, 0xb3, 0x79,
+	0x99, 0x9d, 0xaa, 0x51, 0xdd, 0xe4, 0xcc, 0x9c, 0x87, 0xb3, 0x49, 0xb9, 0xdb, 0x6b, 0x6b, 0xaa,
+	0x82, 0x5b, 0x91, 0x98, 0xb9, 0xa5, 0xe6, 0x16, 0x57, 0x60, 0x9c, 0x7d, 0x51, 0x96, 0x5e, 0xc8,
+	0x20, 0xd4, 0xc6, 0x21, 0xbf, 0xb1, 0xfd, 0x98, 0x4e, 0x85, 0x9b, 0xf5, 0x5a, 0x63, 0x6f, 0x53,
+	0xcd, 0xe1, 0xec, 0xf5, 0xc6, 0x83, 0x75, 0x35, 0xbf, 0xf8, 0x4d, 0x28, 0x05, 0x1f, 0x94, 0xc5,
+	0xa2, 0x6e, 0x6c, 0xb7, 0x76, 0x8c, 0x6d, 0x6c, 0x05, 0x5a, 0xcd, 0xfa, 0xbb, 0x7b, 0xf4, 0x8a,
+	0x87, 0x7a, 0x0a, 0x0f, 0x63, 0x21, 0xcb, 0xa8, 0x6e, 0xd5, 0xb6, 0x37, 0xe9, 0x71, 0xbe, 0x00,
+	0xae, 0xdd, 0xa7, 0x4a, 0x22, 0x81, 0x5a, 0x46, 0x7d, 0x73, 0x1b, 0xcb, 0x02, 0x1b, 0x71, 0x21,
+	0x67, 0x75, 0xb3, 0xa9, 0x8e, 0x2d, 0xfe, 0x20, 0x07, 0x93, 0x42, 0x0c, 0x75, 0x5c, 0x0f, 0x6b,
+	0x1f, 0x36, 0x65, 0xa2, 0xda, 0x48, 0xe0, 0x9d, 0xfa, 0x56, 0x0d, 0xeb, 0xa4, 0x28, 0x10, 0x9a,
+	0x53, 0x7d, 0x54, 0x6d, 0x6c, 0x54, 0xef, 0x6f, 0x30, 0xd5, 0x91, 0xf3, 0xc8, 0x95, 0x12, 0x3c,
+	0x4c, 0x62, 0x59, 0xb5, 0x3a, 0xcb, 0x1a, 0x13, 0xe4, 0x1f, 0x66, 0xed, 0xae, 0xae, 0xe3, 0xea,
+	0x0a, 0x58, 0x4b, 0xa5, 0x4c, 0x3a, 0xf5, 0x14, 0x63, 0x0c, 0xf2, 0x01, 0x39, 0xae, 0x5d, 0x82,
+	0x8a, 0x94, 0xb3, 0x6b, 0xbc, 0xcf, 0x6a, 0xc3, 0x14, 0x27, 0x62, 0x25, 0x8d, 0x3a, 0xb6, 0xe8,
+	0x75, 0xb5, 0xb4, 0xf8, 0x3d, 0x05, 0xa6, 0x42, 0xd9, 0xf4, 0xbd, 0x48, 0xe5, 0xe1, 0xec, 0x79,
+	0x11, 0xce, 0x45, 0xe1, 0xbb, 0xad, 0x1d, 0xa3, 0xde, 0xac, 0x6f, 0xe1, 0xb9, 0xf4, 0x0c, 0xa8,
+	0x72, 0x36, 0xb9, 0xc4, 0x13, 0x23, 0x46, 0x26, 0xb8, 0x7c, 0x44, 0xa0, 0x7b, 0xcd, 0x70, 0x7e,
+	0x1b, 0x5b, 0xfc, 0x32, 0xbe, 0xdd, 0x4c, 0x56, 0x13, 0x9b, 0xc8, 0xb2, 0xfb, 0x47, 0x74, 0x36,
+	0xa4, 0x53, 0x16, 0x55, 0xae, 0xd6, 0x66, 0xf5, 0xc1, 0x56, 0x7d, 0xb7, 0xb1, 0xaa, 0x9e, 0xa2,
+	0x73, 0xab, 0x94, 0xd9, 0x6c, 0x62, 0x63, 0x47, 0x66, 0x49, 0x09, 0xbe, 0xf5, 0x68, 0xb3, 0xae,
+	0xe6, 0x16, 0x6f, 0xc0, 0x34, 0x77, 0xed, 0x3a, 0xbe, 0xbd, 0x7f, 0x82, 0x31, 0xd9, 0x68, 0x67,
+	0xa6, 0x86, 0x32, 0x79, 0x6a, 0x11, 0xc1, 0xa4, 0xf0, 0xd9, 0x49, 0xdc, 0x9b, 0xb4, 0x6f, 0x79,
+	0xaf, 0xbc, 0xb7, 0x5b, 0x37, 0xb6, 0x88, 0xe2, 0x46, 0xb3, 0x1a, 0x5b, 0x2c, 0x4b, 0xc1, 0xd3,
+	0x6e, 0x62, 0x56, 0xab, 0xf9, 0xb8, 0xb1, 0xbb, 0xba, 0xae, 0xe6, 0x16, 0x77, 0x61, 0x26, 0xb8,
+	0x74, 0xb1, 0xd6, 0x31, 0x0f, 0xf0, 0x06, 0x56, 0xdd, 0xde, 0x69, 0xad, 0x6d, 0x54, 0x1f, 0x34,
+	0x5b, 0xe1, 0x7d, 0xa9, 0x39, 0x98, 0x0e, 0xa0, 0xa4, 0x4f, 0x88, 0x19, 0x0d, 0x40, 0xb4, 0xbb,
+	0x5b, 0x6b, 0xdb, 0xc6, 0x2a, 0x6e, 0xe6, 0x47, 0xe4, 0x2e, 0x59, 0xec, 0x9b, 0x29, 0x58, 0x53,
+	0x92, 0xe0, 0xe4, 0xf3, 0x2f, 0x78, 0x15, 0x7f, 0x19, 0xce, 0x27, 0xe5, 0xd3, 0xc3, 0x4a, 0x7c,
+	0x71, 0x25, 0x05, 0x81, 0xba, 0x73, 0x2d, 0x35, 0xb7, 0xf8, 0xa7, 0x0a, 0xf9, 0x96, 0x92, 0x10,
+	0x39, 0x94, 0xd8, 0x74, 0x09, 0xd2, 0xec, 0x77, 0x2d, 0xf3, 0x44, 0x3d, 0x15, 0xcf, 0xd9, 0x74,
+	0x48, 0x0e, 0x9d, 0x22, 0xa4, 0x9c, 0xdd, 0x3e, 0xf2, 0x70, 0x56, 0x8e, 0x28, 0x84, 0x94, 0xf5,
+	0x18, 0x59, 0x5d, 0x9a, 0x49, 0x54, 0x2b, 0x52, 0xee, 0xb0, 0xef, 0x92, 0xbc, 0xb1, 0x78, 0x6d,
+	0x6b, 0xae, 0x8d, 0x73, 0x0a, 0xf1, 0x52, 0x4d, 0xd3, 0xef, 0xbb, 0x38, 0xaf, 0xb8, 0xf8, 0x0d,
+	0x38, 0x93, 0xf4, 0x16, 0x84, 0x49, 0x22, 0x06, 0xdf, 0xeb, 0xe2, 0x4f, 0xdd, 0xe1, 0x3d, 0xc2,
+	0x02, 0x5c, 0x48, 0x42, 0xe0, 0xbf, 0x55, 0x05, 0x4f, 0xee, 0x49, 0x18, 0xec, 0xae, 0xd1, 0x76,
+	0x4f, 0xcd, 0x2d, 0xfe, 0x61, 0x0e, 0xca, 0x32, 0x4e, 0x78, 0x9f, 0x9c, 0x2c, 0xd9, 0x52, 0xf2,
+	0x42, 0x36, 0x5e, 0x02, 0x3d, 0x0d, 0x69, 0xcb, 0xf1, 0xc9, 0x4d, 0x08, 0xd2, 0xb3, 0x0b, 0x70,
+	0x21, 0x0d, 0x8f, 0xdc, 0x6e, 0xca, 0x0d, 0xaa, 0xae, 0xfa, 0x84, 0x7c, 0xd1, 0x5d, 0xcd, 0xe3,
+	0x65, 0x46, 0x1a, 0xd2, 0x8e, 0xd9, 0xf7, 0xc8, 0x85, 0xa6, 0x01, 0x84, 0x9a, 0xbe, 0xd3, 0xeb,
+	0x21, 0x4b, 0x2d, 0x0c, 0x22, 0x44, 0x43, 0xc3, 0xab, 0xc5, 0x41, 0x38, 0xec, 0xf6, 0xd4, 0xf8,
+	0xe2, 0x1f, 0x24, 0x3c, 0x96, 0x14, 0xef, 0x90, 0x6b, 0xd7, 0xe1, 0xea, 0xa0, 0xfc, 0x50, 0x92,
+	0xd7, 0xe0, 0xca, 0x20, 0x44, 0xd2, 0x3c, 0x55, 0x89, 0x0b, 0x5c, 0x46, 0x33, 0x90, 0x47, 0x2f,
+	0xa5, 0xbd, 0x08, 0x0b, 0x83, 0xf0, 0xb0, 0x24, 0xd4, 0xfc, 0xca, 0x5f, 0xe4, 0x61, 0x4e, 0xb8,
+	0x5f, 0xc9, 0x3e, 0x26, 0xf4, 0x31, 0x94, 0x02, 0xff, 0x9f, 0xb6, 0x98, 0xfe, 0xbd, 0xa4, 0xa8,
+	0xd3, 0xb5, 0xf2, 0x72, 0x26, 0x5c, 0x76, 0x2a, 0xa3, 0xfd, 0xfc, 0x9f, 0xfd, 0xf4, 0xfb, 0xb9,
+	0x29, 0x0d, 0x96, 0x8f, 0x5f, 0x59, 0xa6, 0x1f, 0xbb, 0xba, 0xa3, 0x68, 0x0e, 0x14, 0xe9, 0x70,
+	0xd7, 0xae, 0xa7, 0x13, 0x93, 0x4e, 0x87, 0x2a, 0x37, 0x86, 0x23, 0xca, 0x55, 0xea, 0x42, 0x95,
+	0x5a, 0x1f, 0x0a, 0xc4, 0x40, 0x69, 0x2f, 0xa5, 0x93, 0x11, 0xbf, 0x83, 0x55, 0xb9, 0x3e, 0x14,
+	0x8f, 0xd5, 0x76, 0x9e, 0xd4, 0xf6, 0xc2, 0x3d, 0x65, 0x51, 0x57, 0xc3, 0x0a, 0x97, 0x5d, 0x52,
+	0x9b, 0x0f, 0x05, 0x62, 0xe1, 0x06, 0x55, 0x2b, 0x7e, 0x1d, 0xab, 0x72, 0x7d, 0x28, 0x1e, 0xab,
+	0xb6, 0x4c, 0xaa, 0xd5, 0x34, 0xb1, 0xce, 0x0f, 0x31, 0xc6, 0x1d, 0x65, 0xe5, 0x9f, 0xe7, 0xe0,
+	0xb4, 0xd0, 0xdf, 0xfc, 0x9a, 0xb2, 0xf6, 0x9b, 0x0a, 0x4c, 0x89, 0xf7, 0xa6, 0xb5, 0xc4, 0x40,
+	0x62, 0x03, 0xee, 0x60, 0x57, 0xee, 0x64, 0x2f, 0xc0, 0xa3, 0x81, 0x13, 0x3e, 0x2f, 0x6a, 0xe7,
+	0x31, 0x9f, 0x36, 0xc5, 0xb4, 0x91, 0xb7, 0x2c, 0x5e, 0xb6, 0xd6, 0x70, 0xac, 0x3b, 0x7e, 0xef,
+	0x74, 0x71, 0x50, 0x15, 0xf2, 0x3d, 0xec, 0xca, 0xcb, 0x99, 0x70, 0x19, 0x27, 0x97, 0x08, 0x27,
+	0x65, 0x6d, 0x3e, 0xc2, 0x09, 0xbb, 0xbe, 0xba, 0xf2, 0x63, 0x45, 0xba, 0xcd, 0xcc, 0x03, 0xbb,
+	0xff, 0xb6, 0x02, 0x33, 0x72, 0x98, 0x05, 0xed, 0x4e, 0xf2, 0x3d, 0xba, 0xf4, 0x70, 0x15, 0x95,
+	0x57, 0x46, 0x28, 0x91, 0x24, 0x38, 0x76, 0x0a, 0xea, 0x2d, 0xdb, 0x14, 0x99, 0x9d, 0x78, 0xad,
+	0xfc, 0x65, 0x11, 0xe6, 0xe3, 0x3c, 0x63, 0xdf, 0x3e, 0x96, 0x69, 0x91, 0x1e, 0xc1, 0x6b, 0xb7,
+	0x06, 0xd4, 0x1e, 0xbb, 0x0d, 0x50, 0xb9, 0x9d, 0x11, 0x5b, 0xd6, 0x7f, 0x5d, 0x15, 0xf8, 0x24,
+	0x47, 0x21, 0xf7, 0x94, 0x45, 0xed, 0x7b, 0x0a, 0x8c, 0xb3, 0xf6, 0x69, 0xc3, 0xe8, 0xca, 0xe7,
+	0x58, 0x95, 0xa5, 0xac, 0xe8, 0xfc, 0xd5, 0x05, 0xe1, 0xe3, 0xb2, 0x76, 0x31, 0xca, 0x07, 0x97,
+	0xd9, 0xf2, 0xd7, 0x6d, 0xeb, 0x13, 0xed, 0x17, 0x15, 0xd1, 0xec, 0x2d, 0x0f, 0xa9, 0x24, 0x66,
+	0xfb, 0xee, 0x64, 0x2f, 0x90, 0x34, 0x50, 0x45, 0xbe, 0xb4, 0x5f, 0x56, 0x60, 0x82, 0x1f, 0x07,
+	0x6b, 0xc3, 0x9a, 0x1b, 0x39, 0x58, 0xae, 0x2c, 0x67, 0xc6, 0x4f, 0x52, 0x7f, 0x49, 0x3e, 0xf4,
+	0x14, 0xf4, 0xd7, 0x15, 0x80, 0xf0, 0x44, 0x58, 0x1b, 0xd6, 0xd0, 0xd8, 0xf9, 0x72, 0xe5, 0x95,
+	0x11, 0x4a, 0xf0, 0x40, 0x2f, 0x84, 0xa7, 0xf3, 0x7a, 0x0a, 0x4f, 0x58, 0x83, 0xbe, 0xa3, 0x04,
+	0x53, 0xc5, 0x30, 0x35, 0x96, 0xe7, 0x8b, 0xdb, 0x19, 0xb1, 0x65, 0xf5, 0x59, 0x8c, 0xab, 0xcf,
+	0xd7, 0xc3, 0x4b, 0x09, 0x9f, 0xac, 0xfc, 0x30, 0x0f, 0xb3, 0xc2, 0x80, 0x23, 0x1f, 0x2a, 0xf9,
+	0x56, 0xa8, 0xe3, 0x89, 0x66, 0x3e, 0x1e, 0x46, 0xa6, 0x72, 0x7d, 0x28, 0x5e, 0x92, 0x15, 0xe8,
+	0x3a, 0x16, 0x12, 0xd4, 0x99, 0x3d, 0xaf, 0xfd, 0x44, 0xfb, 0x9b, 0x71, 0x13, 0x75, 0x7b, 0x48,
+	0x05, 0x11, 0xfb, 0xb4, 0x94, 0x15, 0x9d, 0xb1, 0xb5, 0x40, 0xd8, 0xaa, 0x68, 0xe5, 0x18, 0x5b,
+	0xcc, 0x32, 0x69, 0x9e, 0x38, 0xcc, 0x6e, 0xa4, 0x91, 0x8f, 0x8d, 0xaf, 0x9b, 0x19, 0x30, 0x19,
+	0x0f, 0x73, 0x84, 0x87, 0x49, 0xad, 0x14, 0xf0, 0xb0, 0xf2, 0xc7, 0xaa, 0xb4, 0xd0, 0x61, 0xf7,
+	0x92, 0xbd, 0xc0, 0x10, 0x5e, 0x1f, 0x10, 0x9c, 0x51, 0xb2, 0x81, 0x37, 0x86, 0x23, 0x32, 0x2e,
+	0xe6, 0x09, 0x17, 0xaa, 0x3e, 0x89, 0xb9, 0x60, 0xf7, 0xad, 0xb1, 0xde, 0x1e, 0x43, 0x81, 0x44,
+	0x49, 0xd4, 0x5e, 0x1a, 0x40, 0x4a, 0x08, 0x08, 0x59, 0xb9, 0x3e, 0x14, 0x8f, 0xd5, 0x78, 0x81,
+	0xd4, 0x38, 0xaf, 0xcf, 0x09, 0x35, 0x2e, 0xb7, 0x31, 0x0a, 0xae, 0xf7, 0x1b, 0x83, 0x57, 0x56,
+	0x09, 0x81, 0x18, 0x2b, 0x37, 0x86, 0x23, 0xb2, 0xaa, 0x2f, 0x93, 0xaa, 0xcf, 0x2d, 0x9e, 0x15,
+	0xab, 0xfe, 0x7a, 0x70, 0x17, 0xf7, 0x13, 0xed, 0x17, 0x04, 0x7b, 0x3f, 0x80, 0x6c, 0x64, 0x34,
+	0xdc, 0xcc, 0x80, 0xc9, 0x38, 0xb8, 0x4e, 0x38, 0xb8, 0xa2, 0x5d, 0x16, 0x39, 0x08, 0x46, 0x84,
+	0xc0, 0xc9, 0xb7, 0xa0, 0xc8, 0xae, 0xc7, 0x0e, 0x90, 0x83, 0x14, 0xc6, 0xa6, 0x72, 0x63, 0x38,
+	0x22, 0xe3, 0x42, 0x27, 0x5c, 0x5c, 0xa8, 0xa4, 0xc9, 0x01, 0x77, 0xc4, 0xb7, 0xc8, 0xbb, 0x10,
+	0xdf, 0x1b, 0xa4, 0x00, 0x62, 0xd4, 0xc5, 0xca, 0xf5, 0xa1, 0x78, 0x49, 0x33, 0x1d, 0xaf, 0x9d,
+	0x44, 0x4b, 0x94, 0x24, 0xf0, 0x5b, 0x0a, 0x4c, 0x4b, 0xe1, 0x0a, 0xb5, 0xa5, 0xf4, 0x1a, 0x92,
+	0x42, 0x2b, 0x56, 0x96, 0x33, 0xe3, 0x0f, 0xe2, 0x8c, 0x44, 0x55, 0x94, 0x38, 0x3b, 0x19, 0xba,
+	0xf3, 0x48, 0x8e, 0x9d, 0x58, 0x79, 0x39, 0x13, 0x2e, 0x63, 0xe6, 0x34, 0x61, 0x66, 0x5a, 0x13,
+	0x47, 0xa6, 0xf6, 0xbb, 0x0a, 0x9c, 0x49, 0x0a, 0x25, 0xa1, 0xdd, 0xcd, 0x40, 0x3a, 0x1e, 0x9d,
+	0xa4, 0xf2, 0xda, 0xa8, 0xc5, 0xe4, 0xd9, 0x58, 0x3f, 0x2d, 0x4a, 0x6a, 0x9f, 0x22, 0x61, 0xed,
+	0xf9, 0x0d, 0x25, 0xfc, 0xe0, 0x16, 0x33, 0x5e, 0xcb, 0x23, 0x46, 0x3b, 0xac, 0xdc, 0xc9, 0x5e,
+	0x40, 0x36, 0xeb, 0xfa, 0x0b, 0x92, 0x66, 0x31, 0x5c, 0xc2, 0xd7, 0xef, 0x28, 0x30, 0x1b, 0x89,
+	0x22, 0xa8, 0x65, 0xa8, 0x47, 0x8e, 0x2d, 0x54, 0x79, 0x65, 0x84, 0x12, 0x8c, 0xb5, 0x1b, 0x84,
+	0x35, 0x5d, 0xbf, 0x98, 0xc8, 0xda, 0x32, 0x8b, 0xd0, 0x83, 0x59, 0xfc, 0x07, 0xec, 0xd3, 0x8d,
+	0x52, 0x00, 0x3d, 0x6d, 0x65, 0x84, 0x60, 0x7f, 0x9c, 0xcd, 0xcf, 0x8d, 0x54, 0x86, 0x31, 0x7a,
+	0x93, 0x30, 0x7a, 0x55, 0xbb, 0x92, 0xcc, 0xa8, 0x38, 0x0e, 0xfe, 0x0c, 0xbb, 0x15, 0x06, 0x84,
+	0xfa, 0xd3, 0xde, 0xfe, 0x54, 0x11, 0x0a, 0x2b, 0xef, 0x3c, 0x6b, 0x71, 0xd6, 0x94, 0x57, 0x49,
+	0x53, 0x96, 0xf4, 0x9b, 0x43, 0x9b, 0x22, 0xaa, 0xee, 0x1f, 0xe1, 0xd0, 0xb8, 0x89, 0x01, 0xfe,
+	0xb4, 0xcf, 0x0f, 0x67, 0x28, 0x31, 0x22, 0x61, 0xe5, 0xf5, 0xd1, 0x0b, 0xb2, 0x36, 0xdc, 0x25,
+	0x6d, 0x58, 0xd6, 0x17, 0x93, 0xda, 0xb0, 0x1c, 0x3c, 0x97, 0x8f, 0x58, 0xef, 0x95, 0x1f, 0x8c,
+	0x49, 0x1b, 0x2b, 0x72, 0xbf, 0x85, 0xba, 0x72, 0xb5, 0x6f, 0x42, 0x91, 0xfd, 0xba, 0x9e, 0x31,
+	0x70, 0x79, 0xe5, 0xc6, 0x70, 0xc4, 0xa4, 0x15, 0x31, 0xb9, 0xad, 0x43, 0xbf, 0x6e, 0xbb, 0x4c,
+	0xff, 0x61, 0xf9, 0x7e, 0x13, 0xcf, 0xf0, 0xc3, 0xea, 0xaf, 0xa1, 0x8c, 0xf5, 0xd7, 0x50, 0xb6,
+	0xfa, 0x2d, 0xc4, 0xeb, 0xff, 0x18, 0x0a, 0x44, 0x1c, 0x83, 0x26, 0x36, 0x31, 0x86, 0x7f, 0xe5,
+	0xfa, 0x50, 0xbc, 0x24, 0xf3, 0x23, 0x56, 0x4e, 0x7e, 0xe3, 0xba, 0xb1, 0xa3, 0x80, 0xc5, 0xa1,
+	0x1f, 0xb4, 0xbe, 0x90, 0x63, 0xeb, 0x57, 0x6e, 0x66, 0xc0, 0x94, 0x67, 0x76, 0xfd, 0x6c, 0x94,
+	0x05, 0x16, 0xf8, 0x1c, 0xeb, 0xc6, 0x8f, 0xf2, 0x92, 0xa3, 0x80, 0xbd, 0x7d, 0xc0, 0xbc, 0x15,
+	0x88, 0x2b, 0x34, 0x6d, 0xa3, 0x92, 0xfc, 0xce, 0xae, 0x72, 0x3b, 0x23, 0x76, 0xfa, 0xf2, 0xef,
+	0x88, 0xe2, 0xf1, 0xed, 0x12, 0x7d, 0xd5, 0xa5, 0x0d, 0xa5, 0x2b, 0x3d, 0x13, 0xab, 0x2c, 0x65,
+	0x45, 0x97, 0x77, 0x26, 0x7a, 0x39, 0xc6, 0xc7, 0x72, 0x9b, 0x60, 0xb2, 0xfe, 0xe2, 0x97, 0x29,
+	0xb2, 0x34, 0x33, 0x7c, 0x63, 0x53, 0x59, 0xca, 0x8a, 0xce, 0xd8, 0x39, 0x47, 0xd8, 0x39, 0xad,
+	0xc5, 0xc5, 0xb2, 0xf2, 0x53, 0x79, 0x2c, 0x0b, 0x81, 0x06, 0xb5, 0x1f, 0x0e, 0xf3, 0x4f, 0xa4,
+	0xc6, 0xaf, 0xac, 0x2c, 0x65, 0x45, 0x67, 0x0c, 0xbe, 0x42, 0x18, 0x7c, 0x59, 0x23, 0xc6, 0x54,
+	0x08, 0x8c, 0x28, 0x2c, 0x5f, 0xe5, 0x20, 0x8a, 0x9f, 0x0c, 0x75, 0xe1, 0xa4, 0xc5, 0xa8, 0xac,
+	0xdc, 0xce, 0x88, 0x9d, 0xe4, 0xc2, 0x11, 0x59, 0xc3, 0x5d, 0xf8, 0xab, 0x43, 0x36, 0xe0, 0x69,
+	0xb1, 0x27, 0x2b, 0xb7, 0x33, 0x62, 0xcb, 0xf3, 0xe6, 0xe2, 0x95, 0x98, 0x7c, 0x62, 0x72, 0xf9,
+	0xbe, 0x12, 0x2c, 0xee, 0x87, 0xb1, 0x24, 0x4f, 0x23, 0xb7, 0x33, 0x62, 0x33, 0x96, 0x6e, 0x11,
+	0x96, 0x5e, 0xaa, 0x0c, 0x67, 0x09, 0x9b, 0x85, 0xff, 0x51, 0x90, 0x7d, 0x71, 0x41, 0x58, 0x17,
+	0x0f, 0x6f, 0x46, 0x58, 0x3f, 0x26, 0x87, 0xc7, 0x48, 0xfe, 0x96, 0x58, 0xe5, 0x56, 0x36, 0x64,
+	0xc6, 0x6d, 0x85, 0x70, 0x7b, 0x46, 0x9f, 0x25, 0x1e, 0x8c, 0xb0, 0x76, 0xdc, 0x89, 0xdf, 0x96,
+	0xbc, 0x5e, 0x4b, 0x83, 0xe9, 0xc6, 0xd6, 0x41, 0xcb, 0x99, 0xf1, 0x19, 0x2b, 0x67, 0x09, 0x2b,
+	0x73, 0x5a, 0x94, 0x15, 0xed, 0xb7, 0x84, 0xf1, 0x36, 0xa4, 0x75, 0x91, 0xe1, 0x76, 0x3b, 0x23,
+	0x36, 0xe3, 0x60, 0x99, 0x70, 0x70, 0x53, 0xbb, 0x1e, 0xe1, 0x20, 0x1c, 0x6c, 0x52, 0x2c, 0x9e,
+	0x4f, 0x44, 0x3f, 0xd3, 0x90, 0x3e, 0x92, 0xb5, 0xfc, 0x56, 0x36, 0x64, 0x79, 0xfb, 0xba, 0x78,
+	0x39, 0xca, 0x56, 0x94, 0x9d, 0x1f, 0x2a, 0x30, 0xc1, 0xbf, 0x92, 0xa3, 0x0d, 0x69, 0x7b, 0xe4,
+	0x93, 0x3c, 0x95, 0xa5, 0xac, 0xe8, 0x8c, 0xa9, 0x3b, 0x84, 0xa9, 0x45, 0xed, 0x46, 0x94, 0xa9,
+	0x63, 0x86, 0x19, 0xe5, 0x6e, 0xe5, 0xff, 0x16, 0xe0, 0x9c, 0x18, 0xb0, 0x43, 0xfe, 0xfe, 0xde,
+	0x77, 0x42, 0xb3, 0x95, 0xe1, 0xc3, 0x86, 0x19, 0xf6, 0x2c, 0x03, 0x3f, 0x80, 0xca, 0x7c, 0x12,
+	0xfa, 0x19, 0xcc, 0x3d, 0x5f, 0xcf, 0xf1, 0x2f, 0x80, 0xf2, 0x29, 0x91, 0x59, 0x8b, 0x0c, 0xec,
+	0xc8, 0x06, 0xe3, 0x4e, 0xf6, 0x02, 0x32, 0x3b, 0x95, 0x54, 0x76, 0x7e, 0x4d, 0x1a, 0x8a, 0x19,
+	0xbe, 0x87, 0x98, 0x6d, 0x5b, 0x32, 0xe4, 0x53, 0xaa, 0x7c, 0xd9, 0xa0, 0x25, 0xf2, 0x25, 0xcd,
+	0x83, 0x99, 0xbe, 0x20, 0x29, 0x8d, 0xcd, 0x57, 0x46, 0x28, 0xc1, 0xd8, 0x79, 0x99, 0xb0, 0x73,
+	0x4d, 0xbb, 0x9a, 0xc4, 0x8e, 0xe0, 0xe2, 0x34, 0x8f, 0xd0, 0x27, 0xe2, 0x14, 0x94, 0xa1, 0x07,
+	0xe5, 0xf1, 0x79, 0x27, 0x7b, 0x01, 0x79, 0x61, 0xb3, 0x78, 0x3e, 0x91, 0x35, 0xca, 0xd2, 0xca,
+	0x7f, 0x9f, 0x89, 0x1c, 0xbc, 0x04, 0x27, 0xb0, 0x19, 0x0e, 0x5e, 0x92, 0x43, 0x03, 0x57, 0x6e,
+	0x67, 0xc4, 0x4e, 0x3e, 0x78, 0x09, 0x9e, 0xb5, 0x13, 0x2d, 0xfb, 0x15, 0x25, 0x88, 0x37, 0xa2,
+	0x0d, 0xa3, 0x1b, 0xd9, 0x9c, 0x2f, 0x65, 0x45, 0x4f, 0x5a, 0x08, 0x8a, 0x7c, 0x88, 0x9b, 0xf2,
+	0x5f, 0x1b, 0xea, 0xc6, 0x4f, 0x0e, 0x99, 0x5b, 0xb9, 0x9d, 0x11, 0x5b, 0xd6, 0xab, 0xc5, 0xab,
+	0x31, 0x66, 0xe8, 0xff, 0xe5, 0xaf, 0x07, 0x11, 0x01, 0x3e, 0xc1, 0x4e, 0x96, 0x52, 0x10, 0x9e,
+	0x56, 0x5b, 0xce, 0x54, 0x53, 0x18, 0x33, 0xb7, 0x72, 0x27, 0x7b, 0x01, 0xd9, 0x3f, 0xa6, 0x57,
+	0x62, 0xdc, 0xd1, 0xaf, 0x78, 0x99, 0x1d, 0xb2, 0x6a, 0xfe, 0x37, 0x69, 0x4e, 0xaa, 0x7b, 0x43,
+	0x6a, 0x1c, 0xe4, 0x0c, 0x78, 0xf3, 0x99, 0xca, 0x32, 0xc6, 0x6f, 0x13, 0xc6, 0xaf, 0xeb, 0x7a,
+	0x8c, 0x71, 0xc4, 0x8b, 0x89, 0x2e, 0x80, 0xbf, 0x11, 0x2e, 0xfb, 0x6f, 0x65, 0x0c, 0x58, 0x99,
+	0xad, 0xb7, 0x23, 0x8b, 0x7e, 0x69, 0xb7, 0x26, 0xb1, 0x45, 0xe3, 0x2a, 0xf0, 0x91, 0xc0, 0x5f,
+	0x32, 0x0d, 0x1d, 0x61, 0x52, 0x84, 0xca, 0xca, 0x52, 0x56, 0xf4, 0xa1, 0x23, 0xa1, 0x4d, 0x31,
+	0x31, 0x3f, 0xbf, 0xad, 0xc0, 0x38, 0x8b, 0x90, 0x38, 0x94, 0x1f, 0x39, 0xa6, 0x63, 0x65, 0x29,
+	0x2b, 0x7a, 0xe2, 0xc4, 0x2e, 0xf2, 0xc3, 0xa2, 0x32, 0x2e, 0x7f, 0x5d, 0x8a, 0x5a, 0xf8, 0x89,
+	0xf6, 0xb7, 0x15, 0xfc, 0x0d, 0xfe, 0x20, 0xfc, 0xa1, 0xf6, 0x4a, 0x86, 0xfe, 0x90, 0xe3, 0x37,
+	0x56, 0x56, 0x46, 0x29, 0x22, 0x2f, 0x8b, 0xf4, 0x0b, 0x89, 0xfd, 0x88, 0xda, 0x04, 0x1b, 0x0b,
+	0xef, 0x87, 0x98, 0xbf, 0x30, 0x2e, 0xe0, 0x70, 0xfe, 0x62, 0xe1, 0x0b, 0x2b, 0x2b, 0xa3, 0x14,
+	0x19, 0x3a, 0x6e, 0x03, 0x07, 0x12, 0xe6, 0xee, 0x47, 0x9c, 0x3b, 0x66, 0xe9, 0x32, 0x71, 0x27,
+	0x9b, 0xbb, 0x95, 0x51, 0x8a, 0x30, 0xee, 0x3e, 0x4f, 0xb8, 0x7b, 0x65, 0x71, 0x39, 0x9d, 0xbb,
+	0xc0, 0xec, 0x09, 0x41, 0x0e, 0x3f, 0xd1, 0xfe, 0x1e, 0x76, 0x32, 0x4b, 0x21, 0x02, 0xb5, 0x57,
+	0x47, 0x8c, 0x28, 0x48, 0xb9, 0xbe, 0xfb, 0x4c, 0x71, 0x08, 0xf9, 0xf0, 0xd5, 0x06, 0x88, 0xf5,
+	0xfe, 0x05, 0x38, 0xdd, 0x76, 0x8e, 0xa2, 0xf4, 0x77, 0x94, 0x2f, 0xe5, 0xcd, 0x9e, 0xfd, 0xa4,
+	0x48, 0x1e, 0x0b, 0x7e, 0xee, 0xff, 0x0d, 0x00, 0x5c, 0xc4, 0x69, 0x33, 0x94, 0xac, 0x00, 0x00,
 }
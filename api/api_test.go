@@ -17,9 +17,17 @@ limitations under the License.
 package api
 
 import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCloudBackupStatusTypeToSdkCloudBackupStatusType(t *testing.T) {
@@ -115,3 +123,56 @@ func TestStringToSdkCloudBackupStatusType(t *testing.T) {
 			StringToSdkCloudBackupStatusType(test.internalType))
 	}
 }
+
+// TestFileDescriptor checks that the descriptor embedded in api.pb.go, which
+// gRPC reflection serves, describes the fields of the generated messages.
+func TestFileDescriptor(t *testing.T) {
+	r, err := gzip.NewReader(bytes.NewReader(proto.FileDescriptor("api/api.proto")))
+	require.NoError(t, err)
+	raw, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	fd := &descriptor.FileDescriptorProto{}
+	require.NoError(t, proto.Unmarshal(raw, fd))
+
+	var check func(prefix string, m *descriptor.DescriptorProto)
+	check = func(prefix string, m *descriptor.DescriptorProto) {
+		name := prefix + m.GetName()
+		if m.GetOptions().GetMapEntry() {
+			assert.NotNil(t, proto.MessageType(name), "map %s is not registered", name)
+			return
+		}
+		typ := proto.MessageType(name)
+		if !assert.NotNil(t, typ, "message %s is not registered", name) {
+			return
+		}
+		fields := make(map[int32]string)
+		for _, f := range m.GetField() {
+			fields[f.GetNumber()] = f.GetName()
+		}
+		s := typ.Elem()
+		for i := 0; i < s.NumField(); i++ {
+			tag := s.Field(i).Tag.Get("protobuf")
+			if len(tag) == 0 {
+				continue
+			}
+			parts := strings.Split(tag, ",")
+			number, err := strconv.Atoi(parts[1])
+			require.NoError(t, err)
+			fieldName, ok := fields[int32(number)]
+			assert.True(t, ok, "field %s.%s is not in the descriptor", name, s.Field(i).Name)
+			assert.Contains(t, parts, "name="+fieldName, "field %s.%s", name, s.Field(i).Name)
+			delete(fields, int32(number))
+		}
+		for _, f := range m.GetField() {
+			if _, ok := fields[f.GetNumber()]; ok && f.OneofIndex == nil {
+				t.Errorf("field %s.%s is not in the generated message", name, f.GetName())
+			}
+		}
+		for _, n := range m.GetNestedType() {
+			check(name+".", n)
+		}
+	}
+	for _, m := range fd.GetMessageType() {
+		check(fd.GetPackage()+".", m)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"net/url"
 	"sync"
 	"time"
+
+	"github.com/libopenstorage/openstorage/pkg/crypto"
 )
 
 var (
@@ -25,9 +27,9 @@ func NewClient(host, version, userAgent string) (*Client, error) {
 		baseURL.Path = "/"
 	}
 	unix2HTTP(baseURL)
-	hClient := getHTTPClient(host)
-	if hClient == nil {
-		return nil, fmt.Errorf("Unable to parse provided url: %v", host)
+	hClient, err := getHTTPClient(host)
+	if err != nil {
+		return nil, err
 	}
 	c := &Client{
 		base:        baseURL,
@@ -50,9 +52,9 @@ func NewAuthClient(host, version, authstring, accesstoken, userAgent string) (*C
 		baseURL.Path = "/"
 	}
 	unix2HTTP(baseURL)
-	hClient := getHTTPClient(host)
-	if hClient == nil {
-		return nil, fmt.Errorf("Unable to parse provided url: %v", host)
+	hClient, err := getHTTPClient(host)
+	if err != nil {
+		return nil, err
 	}
 	c := &Client{
 		base:        baseURL,
@@ -85,12 +87,20 @@ type Client struct {
 	authstring  string
 	accesstoken string
 	userAgent   string
+	hooks       *Hooks
 }
 
-func (c *Client) SetTLS(tlsConfig *tls.Config) {
+// SetTLS makes the client connect with tlsConfig, restricted by the crypto
+// policy.
+func (c *Client) SetTLS(tlsConfig *tls.Config) error {
+	config, err := crypto.GetPolicy().TLSConfig(tlsConfig)
+	if err != nil {
+		return err
+	}
 	c.httpClient = &http.Client{
-		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		Transport: &http.Transport{TLSClientConfig: config},
 	}
+	return nil
 }
 
 // Versions send a request at the /versions REST endpoint.
@@ -102,22 +112,27 @@ func (c *Client) Versions(endpoint string) ([]string, error) {
 
 // Get returns a Request object setup for GET call.
 func (c *Client) Get() *Request {
-	return NewRequest(c.httpClient, c.base, "GET", c.version, c.authstring, c.userAgent)
+	return c.request("GET")
 }
 
 // Post returns a Request object setup for POST call.
 func (c *Client) Post() *Request {
-	return NewRequest(c.httpClient, c.base, "POST", c.version, c.authstring, c.userAgent)
+	return c.request("POST")
 }
 
 // Put returns a Request object setup for PUT call.
 func (c *Client) Put() *Request {
-	return NewRequest(c.httpClient, c.base, "PUT", c.version, c.authstring, c.userAgent)
+	return c.request("PUT")
 }
 
 // Delete returns a Request object setup for DELETE call.
 func (c *Client) Delete() *Request {
-	return NewRequest(c.httpClient, c.base, "DELETE", c.version, c.authstring, c.userAgent)
+	return c.request("DELETE")
+}
+
+func (c *Client) request(verb string) *Request {
+	return NewRequest(c.httpClient, c.base, verb, c.version, c.authstring, c.userAgent).
+		Hooks(c.hooks)
 }
 
 func unix2HTTP(u *url.URL) {
@@ -133,10 +148,13 @@ func newHTTPClient(
 	u *url.URL,
 	tlsConfig *tls.Config,
 	timeout time.Duration,
-	responseTimeout time.Duration,
-) *http.Client {
+) (*http.Client, error) {
+	config, err := crypto.GetPolicy().TLSConfig(tlsConfig)
+	if err != nil {
+		return nil, err
+	}
 	httpTransport := &http.Transport{
-		TLSClientConfig: tlsConfig,
+		TLSClientConfig: config,
 	}
 
 	switch u.Scheme {
@@ -154,24 +172,28 @@ func newHTTPClient(
 		}
 	}
 
-	return &http.Client{Transport: httpTransport, Timeout: responseTimeout}
+	// Requests are bounded by their own deadline, see Request.Deadline
+	return &http.Client{Transport: httpTransport}, nil
 }
 
-func getHTTPClient(host string) *http.Client {
+func getHTTPClient(host string) (*http.Client, error) {
 	cacheLock.Lock()
 	defer cacheLock.Unlock()
 	c, ok := httpCache[host]
 	if !ok {
 		u, err := url.Parse(host)
 		if err != nil {
-			return nil
+			return nil, fmt.Errorf("Unable to parse provided url: %v", host)
 		}
 		if u.Path == "" {
 			u.Path = "/"
 		}
-		c = newHTTPClient(u, nil, 10*time.Second, 5*time.Minute)
+		c, err = newHTTPClient(u, nil, 10*time.Second)
+		if err != nil {
+			return nil, err
+		}
 		httpCache[host] = c
 	}
 
-	return c
+	return c, nil
 }
@@ -0,0 +1,48 @@
+package cluster
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/api/client"
+)
+
+const (
+	alertsPath = "/alerts"
+	// alertsTimeout bounds an enumeration of the alerts of a node
+	alertsTimeout = 10 * time.Second
+)
+
+// alertsSource enumerates the alerts of a node through its cluster API.
+type alertsSource struct {
+	c *client.Client
+}
+
+// NewAlertsSource provides the source of the alerts of the node serving the
+// cluster API of c, for the cluster wide enumerations of alerts.NewNodesOption.
+func NewAlertsSource(c *client.Client) alerts.NodeSource {
+	return &alertsSource{c: c}
+}
+
+func (s *alertsSource) Enumerate(q alerts.Query) ([]*api.Alert, error) {
+	request := s.c.Get().Resource(alertsPath).Deadline(alertsTimeout)
+	if len(q.Namespace) != 0 {
+		request.QueryOption("namespace", q.Namespace)
+	}
+	if q.Level >= alerts.QueryResourceType {
+		request.QueryOption("resource", strconv.FormatInt(int64(q.ResourceType), 10))
+	}
+	if q.Level >= alerts.QueryAlertType {
+		request.QueryOption("alerttype", strconv.FormatInt(q.AlertType, 10))
+	}
+	if q.Level >= alerts.QueryResource {
+		request.QueryOption("resourceid", q.ResourceID)
+	}
+	out := new(api.Alerts)
+	if err := request.Do().Unmarshal(out); err != nil {
+		return nil, err
+	}
+	return out.GetAlert(), nil
+}
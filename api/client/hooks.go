@@ -0,0 +1,70 @@
+package client
+
+import (
+	"net/http"
+	"time"
+)
+
+// Hooks are called around the requests sent by a client, so that
+// applications can record metrics or trace the requests. The hooks left nil
+// are not called. They are called from the goroutine sending the request
+// and must not keep it waiting.
+type Hooks struct {
+	// OnRequest is called before req is sent. It may set headers of req,
+	// to propagate a trace for instance.
+	OnRequest func(req *http.Request)
+	// OnResponse is called once the response of req is read, whatever its
+	// status code, with the time the request took including its retries.
+	OnResponse func(req *http.Request, resp *Response, elapsed time.Duration)
+	// OnError is called when req fails, either without response or with an
+	// error status code, with the time the request took.
+	OnError func(req *http.Request, err error, elapsed time.Duration)
+	// Metrics records the requests
+	Metrics Metrics
+}
+
+// Metrics records the requests sent by a client.
+type Metrics interface {
+	// ObserveRequest records a request of verb on resource, such as GET on
+	// osd-volumes, which ended with statusCode, 0 if no response was read,
+	// and err after elapsed.
+	ObserveRequest(verb, resource string, statusCode int, err error, elapsed time.Duration)
+}
+
+// WithHooks returns a copy of the client whose requests call hooks.
+func (c *Client) WithHooks(hooks *Hooks) *Client {
+	copy := *c
+	copy.hooks = hooks
+	return &copy
+}
+
+// Hooks makes the request call hooks.
+func (r *Request) Hooks(hooks *Hooks) *Request {
+	r.hooks = hooks
+	return r
+}
+
+func (r *Request) onRequest(req *http.Request) {
+	if r.hooks != nil && r.hooks.OnRequest != nil {
+		r.hooks.OnRequest(req)
+	}
+}
+
+// done calls the hooks of the request req once it ended with resp, and
+// returns resp.
+func (r *Request) done(req *http.Request, start time.Time, resp *Response) *Response {
+	if r.hooks == nil {
+		return resp
+	}
+	elapsed := time.Since(start)
+	if r.hooks.OnResponse != nil && resp.statusCode != 0 {
+		r.hooks.OnResponse(req, resp, elapsed)
+	}
+	if r.hooks.OnError != nil && resp.err != nil {
+		r.hooks.OnError(req, resp.err, elapsed)
+	}
+	if r.hooks.Metrics != nil {
+		r.hooks.Metrics.ObserveRequest(r.verb, r.resource, resp.statusCode, resp.err, elapsed)
+	}
+	return resp
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,16 +14,25 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	ost_errors "github.com/libopenstorage/openstorage/api/errors"
+	"github.com/libopenstorage/openstorage/pkg/correlation"
 )
 
 const (
 	maxRetryDuration = 5 * time.Minute
+	// DefaultDeadline bounds the requests made without a deadline
+	DefaultDeadline = 5 * time.Minute
+	// activeHeader is set by standby API servers to the endpoint of the
+	// active server, see leader.EndpointHeader
+	activeHeader = "X-Openstorage-Active"
 )
 
 // Request is contructed iteratively by the client and finally dispatched.
 // A REST endpoint is accessed with the following convention:
 // base_url/<version>/<resource>/[<instance>]
 type Request struct {
+	ctx         context.Context
 	client      *http.Client
 	version     string
 	verb        string
@@ -37,8 +47,10 @@ type Request struct {
 	req         *http.Request
 	resp        *http.Response
 	timeout     time.Duration
+	deadline    time.Duration
 	authstring  string
 	accesstoken string
+	hooks       *Hooks
 }
 
 // Response is a representation of HTTP response received from the server.
@@ -64,6 +76,7 @@ func NewRequest(client *http.Client, base *url.URL, verb string, version string,
 		path:       base.Path,
 		version:    version,
 		authstring: authstring,
+		deadline:   DefaultDeadline,
 	}
 	r.SetHeader("User-Agent", userAgent)
 	return r
@@ -159,6 +172,23 @@ func (r *Request) Timeout(d time.Duration) *Request {
 	return r
 }
 
+// Context makes the request with ctx. The request ends once ctx is done.
+func (r *Request) Context(ctx context.Context) *Request {
+	r.ctx = ctx
+	return r
+}
+
+// Deadline bounds the time the request may take, including its retries, unless
+// the context of the request already has a deadline. Unlike Timeout, it is
+// enforced by the client.
+func (r *Request) Deadline(d time.Duration) *Request {
+	if r.err != nil {
+		return r
+	}
+	r.deadline = d
+	return r
+}
+
 // Body sets the request Body.
 func (r *Request) Body(v interface{}) *Request {
 	var err error
@@ -229,8 +259,9 @@ func parseHTTPStatus(resp *http.Response, body []byte) error {
 	return fmt.Errorf("HTTP error %d", resp.StatusCode)
 }
 
-// Do executes the request and returns a Response.
-// Do executes the request and returns a Response.
+// Do executes the request and returns a Response, calling the hooks of the
+// request, see Hooks.
+// Errors ErrDeadlineExceeded may be returned.
 func (r *Request) Do() *Response {
 	var (
 		err  error
@@ -264,10 +295,34 @@ func (r *Request) Do() *Response {
 		req.Header.Set("Access-Token", r.accesstoken)
 	}
 
+	if id := correlation.FromContext(r.ctx); len(id) != 0 {
+		req.Header.Set(correlation.Header, id)
+	}
+
+	ctx := r.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	timeout := time.Duration(0)
+	if _, ok := ctx.Deadline(); !ok && r.deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.deadline)
+		defer cancel()
+		timeout = r.deadline
+	}
+	req = req.WithContext(ctx)
+	deadlineExceeded := func(err error) *Response {
+		if ctx.Err() == context.DeadlineExceeded {
+			err = &ost_errors.ErrDeadlineExceeded{Request: r.verb + " " + url, Timeout: timeout}
+		}
+		return &Response{err: err}
+	}
+
+	r.onRequest(req)
 	start := time.Now()
 	for {
 		if resp, err = r.client.Do(req); err != nil {
-			return &Response{err: err}
+			return r.done(req, start, deadlineExceeded(err))
 		}
 
 		if time.Since(start) >= maxRetryDuration ||
@@ -275,25 +330,52 @@ func (r *Request) Do() *Response {
 			// Server needs to set this header along with returning a 503
 			break
 		}
-		handleServiceUnavailable(resp)
+		resp.Body.Close()
+		if active := resp.Header.Get(activeHeader); len(active) != 0 {
+			// A standby server returns the endpoint of the active one
+			if err := failover(req, active); err != nil {
+				return r.done(req, start, &Response{err: err})
+			}
+		}
+		if err := handleServiceUnavailable(ctx, resp); err != nil {
+			return r.done(req, start, deadlineExceeded(err))
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(r.body))
 	}
 
 	if resp.Body != nil {
 		defer resp.Body.Close()
 		if body, err = ioutil.ReadAll(resp.Body); err != nil {
-			return &Response{err: err}
+			return r.done(req, start, deadlineExceeded(err))
 		}
 	}
 
-	return &Response{
+	return r.done(req, start, &Response{
 		status:     resp.Status,
 		statusCode: resp.StatusCode,
 		body:       body,
 		err:        parseHTTPStatus(resp, body),
+	})
+}
+
+// failover sends req and the following retries to the active server at
+// endpoint, keeping the path of the request. Requests on a unix socket stay
+// on the local server.
+func failover(req *http.Request, endpoint string) error {
+	if req.URL.Host == "unix.sock" {
+		return nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
 	}
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+	req.Host = u.Host
+	return nil
 }
 
-func handleServiceUnavailable(resp *http.Response) {
+func handleServiceUnavailable(ctx context.Context, resp *http.Response) error {
 	var duration = time.Duration(1 * time.Second)
 	if len(resp.Header["Retry-After"]) > 0 {
 		if retryafter, err := strconv.Atoi(resp.Header["Retry-After"][0]); err == nil {
@@ -301,7 +383,12 @@ func handleServiceUnavailable(resp *http.Response) {
 		}
 	}
 
-	time.Sleep(duration)
+	select {
+	case <-time.After(duration):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // Body return http body, valid only if there is no error
@@ -1,9 +1,16 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
+
+	ost_errors "github.com/libopenstorage/openstorage/api/errors"
 )
 
 func TestQueryOptionLabel(t *testing.T) {
@@ -43,3 +50,138 @@ func TestBasic(t *testing.T) {
 
 func init() {
 }
+
+func TestFailover(t *testing.T) {
+	active := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	defer active.Close()
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(activeHeader, active.URL)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer standby.Close()
+
+	u, _ := url.Parse(standby.URL)
+	var echo string
+	err := NewRequest(standby.Client(), u, "POST", "v1", "", "").
+		Resource("resource").Body("ping").Do().Unmarshal(&echo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if echo != "ping" {
+		t.Fatalf("Expected the active server to echo %#v, got %#v", "ping", echo)
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+
+	err := NewRequest(ts.Client(), u, "GET", "v1", "", "").
+		Resource("resource").Deadline(10 * time.Millisecond).Do().Error()
+	if e, ok := err.(*ost_errors.ErrDeadlineExceeded); !ok || e.Timeout != 10*time.Millisecond {
+		t.Fatalf("Expected a deadline exceeded error, got %#v", err)
+	}
+
+	// The deadline of the context replaces the one of the request
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = NewRequest(ts.Client(), u, "GET", "v1", "", "").Context(ctx).
+		Resource("resource").Deadline(time.Minute).Do().Error()
+	if e, ok := err.(*ost_errors.ErrDeadlineExceeded); !ok || e.Timeout != 0 {
+		t.Fatalf("Expected a deadline exceeded error, got %#v", err)
+	}
+
+	// Retries end with the deadline
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+	c, _ := NewClient(unavailable.URL, "v1", "")
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = c.Get().Context(ctx).Resource("resource").Do().Error()
+	if _, ok := err.(*ost_errors.ErrDeadlineExceeded); !ok || time.Since(start) > time.Second {
+		t.Fatalf("Expected a deadline exceeded error, got %#v", err)
+	}
+}
+
+type fakeMetrics struct {
+	verb, resource string
+	statusCode     int
+	err            error
+}
+
+func (m *fakeMetrics) ObserveRequest(verb, resource string, statusCode int, err error, elapsed time.Duration) {
+	m.verb, m.resource, m.statusCode, m.err = verb, resource, statusCode, err
+}
+
+func TestHooks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Trace") != "trace1" {
+			t.Errorf("Expected the trace header set by OnRequest, got %#v", r.Header.Get("X-Trace"))
+		}
+		if r.URL.Path == "/v1/missing" {
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	var (
+		responses []int
+		errs      []error
+		metrics   fakeMetrics
+	)
+	c, _ := NewClient(ts.URL, "v1", "")
+	c = c.WithHooks(&Hooks{
+		OnRequest: func(req *http.Request) {
+			req.Header.Set("X-Trace", "trace1")
+		},
+		OnResponse: func(req *http.Request, resp *Response, elapsed time.Duration) {
+			responses = append(responses, resp.StatusCode())
+		},
+		OnError: func(req *http.Request, err error, elapsed time.Duration) {
+			errs = append(errs, err)
+		},
+		Metrics: &metrics,
+	})
+
+	if err := c.Get().Resource("resource").Do().Error(); err != nil {
+		t.Fatal(err)
+	}
+	if len(responses) != 1 || responses[0] != http.StatusOK || len(errs) != 0 {
+		t.Fatalf("Expected one OK response, got %v and errors %v", responses, errs)
+	}
+	if metrics.verb != "GET" || metrics.resource != "resource" || metrics.statusCode != http.StatusOK {
+		t.Fatalf("Expected the request to be observed, got %#v", metrics)
+	}
+
+	err := c.Delete().Resource("missing").Do().Error()
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if len(responses) != 2 || responses[1] != http.StatusNotFound || len(errs) != 1 || errs[0] != err {
+		t.Fatalf("Expected a not found response and error, got %v and errors %v", responses, errs)
+	}
+	if metrics.verb != "DELETE" || metrics.statusCode != http.StatusNotFound || metrics.err != err {
+		t.Fatalf("Expected the failed request to be observed, got %#v", metrics)
+	}
+
+	// Requests without response are errors only
+	ts.Close()
+	err = c.Get().Resource("resource").Do().Error()
+	if err == nil || len(responses) != 2 || len(errs) != 2 || metrics.statusCode != 0 {
+		t.Fatalf("Expected an error without response, got %v and errors %v", responses, errs)
+	}
+}
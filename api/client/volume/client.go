@@ -2,16 +2,19 @@ package volume
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/api/client"
 	ost_errors "github.com/libopenstorage/openstorage/api/errors"
+	"github.com/libopenstorage/openstorage/jobs"
 	"github.com/libopenstorage/openstorage/volume"
 )
 
@@ -19,15 +22,31 @@ const (
 	graphPath  = "/graph"
 	volumePath = "/osd-volumes"
 	snapPath   = "/osd-snapshot"
+	jobsPath   = "/osd-jobs"
+	statusPath = "/status"
+)
+
+const (
+	// CreateDeadline bounds Create unless its context has a deadline
+	CreateDeadline = 60 * time.Second
+	// InspectDeadline bounds Inspect unless its context has a deadline
+	InspectDeadline = 2 * time.Second
+)
+
+var (
+	// jobPollInterval is how often the status of a job is checked while
+	// waiting for it to complete
+	jobPollInterval = 500 * time.Millisecond
 )
 
 type volumeClient struct {
 	volume.IODriver
+	volume.WipeDriver
 	c *client.Client
 }
 
-func newVolumeClient(c *client.Client) volume.VolumeDriver {
-	return &volumeClient{volume.IONotSupported, c}
+func newVolumeClient(c *client.Client) *volumeClient {
+	return &volumeClient{volume.IONotSupported, volume.WipeNotSupported, c}
 }
 
 // String description of this driver.
@@ -45,8 +64,13 @@ func (v *volumeClient) Version() (*api.StorageVersion, error) {
 }
 
 func (v *volumeClient) GraphDriverCreate(id string, parent string) error {
+	return v.GraphDriverCreateWithContext(context.Background(), id, parent)
+}
+
+// GraphDriverCreateWithContext is GraphDriverCreate made with ctx.
+func (v *volumeClient) GraphDriverCreateWithContext(ctx context.Context, id string, parent string) error {
 	response := ""
-	if err := v.c.Put().Resource(graphPath + "/create").Instance(id).Do().Unmarshal(&response); err != nil {
+	if err := v.c.Put().Context(ctx).Resource(graphPath + "/create").Instance(id).Do().Unmarshal(&response); err != nil {
 		return err
 	}
 	if response != id {
@@ -56,8 +80,13 @@ func (v *volumeClient) GraphDriverCreate(id string, parent string) error {
 }
 
 func (v *volumeClient) GraphDriverRemove(id string) error {
+	return v.GraphDriverRemoveWithContext(context.Background(), id)
+}
+
+// GraphDriverRemoveWithContext is GraphDriverRemove made with ctx.
+func (v *volumeClient) GraphDriverRemoveWithContext(ctx context.Context, id string) error {
 	response := ""
-	if err := v.c.Put().Resource(graphPath + "/remove").Instance(id).Do().Unmarshal(&response); err != nil {
+	if err := v.c.Put().Context(ctx).Resource(graphPath + "/remove").Instance(id).Do().Unmarshal(&response); err != nil {
 		return err
 	}
 	if response != id {
@@ -67,16 +96,26 @@ func (v *volumeClient) GraphDriverRemove(id string) error {
 }
 
 func (v *volumeClient) GraphDriverGet(id string, mountLabel string) (string, error) {
+	return v.GraphDriverGetWithContext(context.Background(), id, mountLabel)
+}
+
+// GraphDriverGetWithContext is GraphDriverGet made with ctx.
+func (v *volumeClient) GraphDriverGetWithContext(ctx context.Context, id string, mountLabel string) (string, error) {
 	response := ""
-	if err := v.c.Get().Resource(graphPath + "/inspect").Instance(id).Do().Unmarshal(&response); err != nil {
+	if err := v.c.Get().Context(ctx).Resource(graphPath + "/inspect").Instance(id).Do().Unmarshal(&response); err != nil {
 		return "", err
 	}
 	return response, nil
 }
 
 func (v *volumeClient) GraphDriverRelease(id string) error {
+	return v.GraphDriverReleaseWithContext(context.Background(), id)
+}
+
+// GraphDriverReleaseWithContext is GraphDriverRelease made with ctx.
+func (v *volumeClient) GraphDriverReleaseWithContext(ctx context.Context, id string) error {
 	response := ""
-	if err := v.c.Put().Resource(graphPath + "/release").Instance(id).Do().Unmarshal(&response); err != nil {
+	if err := v.c.Put().Context(ctx).Resource(graphPath + "/release").Instance(id).Do().Unmarshal(&response); err != nil {
 		return err
 	}
 	if response != id {
@@ -97,32 +136,55 @@ func (v *volumeClient) GraphDriverDiff(id string, parent string) io.Writer {
 }
 
 func (v *volumeClient) GraphDriverChanges(id string, parent string) ([]api.GraphDriverChanges, error) {
+	return v.GraphDriverChangesWithContext(context.Background(), id, parent)
+}
+
+// GraphDriverChangesWithContext is GraphDriverChanges made with ctx.
+func (v *volumeClient) GraphDriverChangesWithContext(ctx context.Context, id string, parent string) ([]api.GraphDriverChanges, error) {
 	var changes []api.GraphDriverChanges
-	err := v.c.Get().Resource(graphPath + "/changes").Instance(id).Do().Unmarshal(&changes)
+	err := v.c.Get().Context(ctx).Resource(graphPath + "/changes").Instance(id).Do().Unmarshal(&changes)
 	return changes, err
 }
 
 func (v *volumeClient) GraphDriverApplyDiff(id string, parent string, diff io.Reader) (int, error) {
+	return v.GraphDriverApplyDiffWithContext(context.Background(), id, parent, diff)
+}
+
+// GraphDriverApplyDiffWithContext is GraphDriverApplyDiff made with ctx.
+func (v *volumeClient) GraphDriverApplyDiffWithContext(ctx context.Context, id string, parent string, diff io.Reader) (int, error) {
 	b, err := ioutil.ReadAll(diff)
 	if err != nil {
 		return 0, err
 	}
 	response := 0
-	if err = v.c.Put().Resource(graphPath + "/diff?id=" + id + "&parent=" + parent).Instance(id).Body(b).Do().Unmarshal(&response); err != nil {
+	if err = v.c.Put().Context(ctx).Resource(graphPath + "/diff?id=" + id + "&parent=" + parent).Instance(id).Body(b).Do().Unmarshal(&response); err != nil {
 		return 0, err
 	}
 	return response, nil
 }
 
 func (v *volumeClient) GraphDriverDiffSize(id string, parent string) (int, error) {
+	return v.GraphDriverDiffSizeWithContext(context.Background(), id, parent)
+}
+
+// GraphDriverDiffSizeWithContext is GraphDriverDiffSize made with ctx.
+func (v *volumeClient) GraphDriverDiffSizeWithContext(ctx context.Context, id string, parent string) (int, error) {
 	size := 0
-	err := v.c.Get().Resource(graphPath + "/diffsize").Instance(id).Do().Unmarshal(&size)
+	err := v.c.Get().Context(ctx).Resource(graphPath + "/diffsize").Instance(id).Do().Unmarshal(&size)
 	return size, err
 }
 
 // Create a new Vol for the specific volume spev.c.
 // It returns a system generated VolumeID that uniquely identifies the volume
+// Errors ErrDeadlineExceeded may be returned.
 func (v *volumeClient) Create(locator *api.VolumeLocator, source *api.Source,
+	spec *api.VolumeSpec) (string, error) {
+	return v.CreateWithContext(context.Background(), locator, source, spec)
+}
+
+// CreateWithContext is Create made with ctx. It is bounded by CreateDeadline
+// unless ctx has a deadline.
+func (v *volumeClient) CreateWithContext(ctx context.Context, locator *api.VolumeLocator, source *api.Source,
 	spec *api.VolumeSpec) (string, error) {
 	response := &api.VolumeCreateResponse{}
 	request := &api.VolumeCreateRequest{
@@ -130,7 +192,8 @@ func (v *volumeClient) Create(locator *api.VolumeLocator, source *api.Source,
 		Source:  source,
 		Spec:    spec,
 	}
-	if err := v.c.Post().Resource(volumePath).Body(request).Do().Unmarshal(response); err != nil {
+	if err := v.c.Post().Context(ctx).Resource(volumePath).Body(request).Deadline(CreateDeadline).
+		Do().Unmarshal(response); err != nil {
 		return "", err
 	}
 	if response.VolumeResponse != nil && response.VolumeResponse.Error != "" {
@@ -141,17 +204,38 @@ func (v *volumeClient) Create(locator *api.VolumeLocator, source *api.Source,
 
 // Status diagnostic information
 func (v *volumeClient) Status() [][2]string {
-	return [][2]string{}
+	return volume.StatusLines(v.DriverStatus())
+}
+
+// DriverStatus returns the status of the components of the driver, none if
+// it cannot be retrieved.
+func (v *volumeClient) DriverStatus() []*api.DriverStatus {
+	return v.DriverStatusWithContext(context.Background())
+}
+
+// DriverStatusWithContext is DriverStatus made with ctx.
+func (v *volumeClient) DriverStatusWithContext(ctx context.Context) []*api.DriverStatus {
+	var status []*api.DriverStatus
+	if err := v.c.Get().Context(ctx).Resource(statusPath).Do().Unmarshal(&status); err != nil {
+		return nil
+	}
+	return status
 }
 
 // Inspect specified volumes.
-// Errors ErrEnoEnt may be returned.
+// Errors ErrEnoEnt, ErrDeadlineExceeded may be returned.
 func (v *volumeClient) Inspect(ids []string) ([]*api.Volume, error) {
+	return v.InspectWithContext(context.Background(), ids)
+}
+
+// InspectWithContext is Inspect made with ctx. It is bounded by
+// InspectDeadline unless ctx has a deadline.
+func (v *volumeClient) InspectWithContext(ctx context.Context, ids []string) ([]*api.Volume, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
 	var volumes []*api.Volume
-	request := v.c.Get().Resource(volumePath)
+	request := v.c.Get().Context(ctx).Resource(volumePath).Deadline(InspectDeadline)
 	for _, id := range ids {
 		request.QueryOption(api.OptVolumeID, id)
 	}
@@ -164,8 +248,13 @@ func (v *volumeClient) Inspect(ids []string) ([]*api.Volume, error) {
 // Delete volume.
 // Errors ErrEnoEnt, ErrVolHasSnaps may be returned.
 func (v *volumeClient) Delete(volumeID string) error {
+	return v.DeleteWithContext(context.Background(), volumeID)
+}
+
+// DeleteWithContext is Delete made with ctx.
+func (v *volumeClient) DeleteWithContext(ctx context.Context, volumeID string) error {
 	response := &api.VolumeResponse{}
-	if err := v.c.Delete().Resource(volumePath).Instance(volumeID).Do().Unmarshal(response); err != nil {
+	if err := v.c.Delete().Context(ctx).Resource(volumePath).Instance(volumeID).Do().Unmarshal(response); err != nil {
 		return err
 	}
 	if response.Error != "" {
@@ -181,6 +270,15 @@ func (v *volumeClient) Snapshot(volumeID string,
 	readonly bool,
 	locator *api.VolumeLocator,
 	noRetry bool,
+) (string, error) {
+	return v.SnapshotWithContext(context.Background(), volumeID, readonly, locator, noRetry)
+}
+
+// SnapshotWithContext is Snapshot made with ctx.
+func (v *volumeClient) SnapshotWithContext(ctx context.Context, volumeID string,
+	readonly bool,
+	locator *api.VolumeLocator,
+	noRetry bool,
 ) (string, error) {
 	response := &api.SnapCreateResponse{}
 	request := &api.SnapCreateRequest{
@@ -189,7 +287,7 @@ func (v *volumeClient) Snapshot(volumeID string,
 		Locator:  locator,
 		NoRetry:  noRetry,
 	}
-	if err := v.c.Post().Resource(snapPath).Body(request).Do().Unmarshal(response); err != nil {
+	if err := v.c.Post().Context(ctx).Resource(snapPath).Body(request).Do().Unmarshal(response); err != nil {
 		return "", err
 	}
 	// TODO(pedge): this probably should not be embedded in this way
@@ -207,8 +305,13 @@ func (v *volumeClient) Snapshot(volumeID string,
 
 // Restore specified volume to given snapshot state
 func (v *volumeClient) Restore(volumeID string, snapID string) error {
+	return v.RestoreWithContext(context.Background(), volumeID, snapID)
+}
+
+// RestoreWithContext is Restore made with ctx.
+func (v *volumeClient) RestoreWithContext(ctx context.Context, volumeID string, snapID string) error {
 	response := &api.VolumeResponse{}
-	req := v.c.Post().Resource(snapPath + "/restore").Instance(volumeID)
+	req := v.c.Post().Context(ctx).Resource(snapPath + "/restore").Instance(volumeID)
 	req.QueryOption(api.OptSnapID, snapID)
 
 	if err := req.Do().Unmarshal(response); err != nil {
@@ -225,9 +328,18 @@ func (v *volumeClient) Restore(volumeID string, snapID string) error {
 func (v *volumeClient) Stats(
 	volumeID string,
 	cumulative bool,
+) (*api.Stats, error) {
+	return v.StatsWithContext(context.Background(), volumeID, cumulative)
+}
+
+// StatsWithContext is Stats made with ctx.
+func (v *volumeClient) StatsWithContext(
+	ctx context.Context,
+	volumeID string,
+	cumulative bool,
 ) (*api.Stats, error) {
 	stats := &api.Stats{}
-	req := v.c.Get().Resource(volumePath + "/stats").Instance(volumeID)
+	req := v.c.Get().Context(ctx).Resource(volumePath + "/stats").Instance(volumeID)
 	req.QueryOption(api.OptCumulative, strconv.FormatBool(cumulative))
 
 	err := req.Do().Unmarshal(stats)
@@ -239,18 +351,31 @@ func (v *volumeClient) Stats(
 // Errors ErrEnoEnt may be returned
 func (v *volumeClient) UsedSize(
 	volumeID string,
+) (uint64, error) {
+	return v.UsedSizeWithContext(context.Background(), volumeID)
+}
+
+// UsedSizeWithContext is UsedSize made with ctx.
+func (v *volumeClient) UsedSizeWithContext(
+	ctx context.Context,
+	volumeID string,
 ) (uint64, error) {
 	var usedSize uint64
-	req := v.c.Get().Resource(volumePath + "/usedsize").Instance(volumeID)
+	req := v.c.Get().Context(ctx).Resource(volumePath + "/usedsize").Instance(volumeID)
 	err := req.Do().Unmarshal(&usedSize)
 	return usedSize, err
 }
 
 // Active Requests on all volume.
 func (v *volumeClient) GetActiveRequests() (*api.ActiveRequests, error) {
+	return v.GetActiveRequestsWithContext(context.Background())
+}
+
+// GetActiveRequestsWithContext is GetActiveRequests made with ctx.
+func (v *volumeClient) GetActiveRequestsWithContext(ctx context.Context) (*api.ActiveRequests, error) {
 
 	requests := &api.ActiveRequests{}
-	resp := v.c.Get().Resource(volumePath + "/requests").Instance("vol_id").Do()
+	resp := v.c.Get().Context(ctx).Resource(volumePath + "/requests").Instance("vol_id").Do()
 
 	if resp.Error() != nil {
 		return nil, resp.FormatError()
@@ -267,9 +392,17 @@ func (v *volumeClient) GetActiveRequests() (*api.ActiveRequests, error) {
 // usage of a snapshot/volume
 func (v *volumeClient) CapacityUsage(
 	ID string,
+) (*api.CapacityUsageResponse, error) {
+	return v.CapacityUsageWithContext(context.Background(), ID)
+}
+
+// CapacityUsageWithContext is CapacityUsage made with ctx.
+func (v *volumeClient) CapacityUsageWithContext(
+	ctx context.Context,
+	ID string,
 ) (*api.CapacityUsageResponse, error) {
 	requests := &api.CapacityUsageResponse{}
-	resp := v.c.Get().Resource(volumePath + "/usage").Instance(ID).Do()
+	resp := v.c.Get().Context(ctx).Resource(volumePath + "/usage").Instance(ID).Do()
 
 	if resp.Error() != nil {
 		return nil, resp.FormatError()
@@ -288,9 +421,15 @@ func (v *volumeClient) Shutdown() {}
 // Enumerate volumes that map to the volumeLocator. Locator fields may be regexp.
 // If locator fields are left blank, this will return all volumes.
 func (v *volumeClient) Enumerate(locator *api.VolumeLocator,
+	labels map[string]string) ([]*api.Volume, error) {
+	return v.EnumerateWithContext(context.Background(), locator, labels)
+}
+
+// EnumerateWithContext is Enumerate made with ctx.
+func (v *volumeClient) EnumerateWithContext(ctx context.Context, locator *api.VolumeLocator,
 	labels map[string]string) ([]*api.Volume, error) {
 	var volumes []*api.Volume
-	req := v.c.Get().Resource(volumePath)
+	req := v.c.Get().Context(ctx).Resource(volumePath)
 	if locator.Name != "" {
 		req.QueryOption(api.OptName, locator.Name)
 	}
@@ -314,9 +453,15 @@ func (v *volumeClient) Enumerate(locator *api.VolumeLocator,
 // Enumerate snaps for specified volume
 // Count indicates the number of snaps populated.
 func (v *volumeClient) SnapEnumerate(ids []string,
+	snapLabels map[string]string) ([]*api.Volume, error) {
+	return v.SnapEnumerateWithContext(context.Background(), ids, snapLabels)
+}
+
+// SnapEnumerateWithContext is SnapEnumerate made with ctx.
+func (v *volumeClient) SnapEnumerateWithContext(ctx context.Context, ids []string,
 	snapLabels map[string]string) ([]*api.Volume, error) {
 	var volumes []*api.Volume
-	request := v.c.Get().Resource(snapPath)
+	request := v.c.Get().Context(ctx).Resource(snapPath)
 	for _, id := range ids {
 		request.QueryOption(api.OptVolumeID, id)
 	}
@@ -333,7 +478,12 @@ func (v *volumeClient) SnapEnumerate(ids []string,
 // On success the devicePath specifies location where the device is exported
 // Errors ErrEnoEnt, ErrVolAttached may be returned.
 func (v *volumeClient) Attach(volumeID string, attachOptions map[string]string) (string, error) {
-	response, err := v.doVolumeSetGetResponse(
+	return v.AttachWithContext(context.Background(), volumeID, attachOptions)
+}
+
+// AttachWithContext is Attach made with ctx.
+func (v *volumeClient) AttachWithContext(ctx context.Context, volumeID string, attachOptions map[string]string) (string, error) {
+	response, err := v.doVolumeSetGetResponse(ctx,
 		volumeID,
 		&api.VolumeSetRequest{
 			Action: &api.VolumeStateAction{
@@ -358,7 +508,12 @@ func (v *volumeClient) Attach(volumeID string, attachOptions map[string]string)
 // Detach device from the host.
 // Errors ErrEnoEnt, ErrVolDetached may be returned.
 func (v *volumeClient) Detach(volumeID string, options map[string]string) error {
-	return v.doVolumeSet(
+	return v.DetachWithContext(context.Background(), volumeID, options)
+}
+
+// DetachWithContext is Detach made with ctx.
+func (v *volumeClient) DetachWithContext(ctx context.Context, volumeID string, options map[string]string) error {
+	return v.doVolumeSet(ctx,
 		volumeID,
 		&api.VolumeSetRequest{
 			Action: &api.VolumeStateAction{
@@ -376,7 +531,12 @@ func (v *volumeClient) MountedAt(mountPath string) string {
 // Mount volume at specified path
 // Errors ErrEnoEnt, ErrVolDetached may be returned.
 func (v *volumeClient) Mount(volumeID string, mountPath string, options map[string]string) error {
-	return v.doVolumeSet(
+	return v.MountWithContext(context.Background(), volumeID, mountPath, options)
+}
+
+// MountWithContext is Mount made with ctx.
+func (v *volumeClient) MountWithContext(ctx context.Context, volumeID string, mountPath string, options map[string]string) error {
+	return v.doVolumeSet(ctx,
 		volumeID,
 		&api.VolumeSetRequest{
 			Action: &api.VolumeStateAction{
@@ -391,7 +551,12 @@ func (v *volumeClient) Mount(volumeID string, mountPath string, options map[stri
 // Unmount volume at specified path
 // Errors ErrEnoEnt, ErrVolDetached may be returned.
 func (v *volumeClient) Unmount(volumeID string, mountPath string, options map[string]string) error {
-	return v.doVolumeSet(
+	return v.UnmountWithContext(context.Background(), volumeID, mountPath, options)
+}
+
+// UnmountWithContext is Unmount made with ctx.
+func (v *volumeClient) UnmountWithContext(ctx context.Context, volumeID string, mountPath string, options map[string]string) error {
+	return v.doVolumeSet(ctx,
 		volumeID,
 		&api.VolumeSetRequest{
 			Action: &api.VolumeStateAction{
@@ -406,7 +571,13 @@ func (v *volumeClient) Unmount(volumeID string, mountPath string, options map[st
 // Update volume
 func (v *volumeClient) Set(volumeID string, locator *api.VolumeLocator,
 	spec *api.VolumeSpec) error {
-	return v.doVolumeSet(
+	return v.SetWithContext(context.Background(), volumeID, locator, spec)
+}
+
+// SetWithContext is Set made with ctx.
+func (v *volumeClient) SetWithContext(ctx context.Context, volumeID string, locator *api.VolumeLocator,
+	spec *api.VolumeSpec) error {
+	return v.doVolumeSet(ctx,
 		volumeID,
 		&api.VolumeSetRequest{
 			Locator: locator,
@@ -415,16 +586,16 @@ func (v *volumeClient) Set(volumeID string, locator *api.VolumeLocator,
 	)
 }
 
-func (v *volumeClient) doVolumeSet(volumeID string,
+func (v *volumeClient) doVolumeSet(ctx context.Context, volumeID string,
 	request *api.VolumeSetRequest) error {
-	_, err := v.doVolumeSetGetResponse(volumeID, request)
+	_, err := v.doVolumeSetGetResponse(ctx, volumeID, request)
 	return err
 }
 
-func (v *volumeClient) doVolumeSetGetResponse(volumeID string,
+func (v *volumeClient) doVolumeSetGetResponse(ctx context.Context, volumeID string,
 	request *api.VolumeSetRequest) (*api.VolumeSetResponse, error) {
 	response := &api.VolumeSetResponse{}
-	if err := v.c.Put().Resource(volumePath).Instance(volumeID).Body(request).Do().Unmarshal(response); err != nil {
+	if err := v.c.Put().Context(ctx).Resource(volumePath).Instance(volumeID).Body(request).Do().Unmarshal(response); err != nil {
 		return nil, err
 	}
 	if response.VolumeResponse != nil && response.VolumeResponse.Error != "" {
@@ -438,9 +609,19 @@ func (v *volumeClient) Quiesce(
 	volumeID string,
 	timeoutSec uint64,
 	quiesceID string,
+) error {
+	return v.QuiesceWithContext(context.Background(), volumeID, timeoutSec, quiesceID)
+}
+
+// QuiesceWithContext is Quiesce made with ctx.
+func (v *volumeClient) QuiesceWithContext(
+	ctx context.Context,
+	volumeID string,
+	timeoutSec uint64,
+	quiesceID string,
 ) error {
 	response := &api.VolumeResponse{}
-	req := v.c.Post().Resource(volumePath + "/quiesce").Instance(volumeID)
+	req := v.c.Post().Context(ctx).Resource(volumePath + "/quiesce").Instance(volumeID)
 	req.QueryOption(api.OptTimeoutSec, strconv.FormatUint(timeoutSec, 10))
 	req.QueryOption(api.OptQuiesceID, quiesceID)
 	if err := req.Do().Unmarshal(response); err != nil {
@@ -452,10 +633,53 @@ func (v *volumeClient) Quiesce(
 	return nil
 }
 
+// Resize grows the volume and its filesystem to newSize bytes
+func (v *volumeClient) Resize(volumeID string, newSize uint64) error {
+	return v.ResizeWithContext(context.Background(), volumeID, newSize)
+}
+
+// ResizeWithContext is Resize made with ctx.
+func (v *volumeClient) ResizeWithContext(ctx context.Context, volumeID string, newSize uint64) error {
+	response := &api.VolumeResponse{}
+	req := v.c.Post().Context(ctx).Resource(volumePath + "/resize").Instance(volumeID)
+	req.QueryOption(api.OptNewSize, strconv.FormatUint(newSize, 10))
+	if err := req.Do().Unmarshal(response); err != nil {
+		return err
+	}
+	if response.Error != "" {
+		return errors.New(response.Error)
+	}
+	return nil
+}
+
+// Clone creates a writable volume independent of volumeID, with a copy of its
+// data, and returns its ID.
+func (v *volumeClient) Clone(volumeID string, locator *api.VolumeLocator) (string, error) {
+	return v.CloneWithContext(context.Background(), volumeID, locator)
+}
+
+// CloneWithContext is Clone made with ctx.
+func (v *volumeClient) CloneWithContext(ctx context.Context, volumeID string, locator *api.VolumeLocator) (string, error) {
+	response := &api.VolumeCreateResponse{}
+	req := v.c.Post().Context(ctx).Resource(volumePath + "/clone").Instance(volumeID).Body(locator)
+	if err := req.Do().Unmarshal(response); err != nil {
+		return "", err
+	}
+	if response.VolumeResponse != nil && response.VolumeResponse.Error != "" {
+		return "", errors.New(response.VolumeResponse.Error)
+	}
+	return response.Id, nil
+}
+
 // Unquiesce un-quiesces volume i/o
 func (v *volumeClient) Unquiesce(volumeID string) error {
+	return v.UnquiesceWithContext(context.Background(), volumeID)
+}
+
+// UnquiesceWithContext is Unquiesce made with ctx.
+func (v *volumeClient) UnquiesceWithContext(ctx context.Context, volumeID string) error {
 	response := &api.VolumeResponse{}
-	req := v.c.Post().Resource(volumePath + "/unquiesce").Instance(volumeID)
+	req := v.c.Post().Context(ctx).Resource(volumePath + "/unquiesce").Instance(volumeID)
 	if err := req.Do().Unmarshal(response); err != nil {
 		return err
 	}
@@ -467,18 +691,28 @@ func (v *volumeClient) Unquiesce(volumeID string) error {
 
 // CredsEnumerate enumerates configured credentials in the cluster
 func (v *volumeClient) CredsEnumerate() (map[string]interface{}, error) {
+	return v.CredsEnumerateWithContext(context.Background())
+}
+
+// CredsEnumerateWithContext is CredsEnumerate made with ctx.
+func (v *volumeClient) CredsEnumerateWithContext(ctx context.Context) (map[string]interface{}, error) {
 	creds := make(map[string]interface{}, 0)
-	err := v.c.Get().Resource(api.OsdCredsPath).Do().Unmarshal(&creds)
+	err := v.c.Get().Context(ctx).Resource(api.OsdCredsPath).Do().Unmarshal(&creds)
 	return creds, err
 }
 
 // CredsCreate creates credentials for a given cloud provider
 func (v *volumeClient) CredsCreate(params map[string]string) (string, error) {
+	return v.CredsCreateWithContext(context.Background(), params)
+}
+
+// CredsCreateWithContext is CredsCreate made with ctx.
+func (v *volumeClient) CredsCreateWithContext(ctx context.Context, params map[string]string) (string, error) {
 	createResponse := api.CredCreateResponse{}
 	request := &api.CredCreateRequest{
 		InputParams: params,
 	}
-	req := v.c.Post().Resource(api.OsdCredsPath).Body(request)
+	req := v.c.Post().Context(ctx).Resource(api.OsdCredsPath).Body(request)
 	response := req.Do()
 	if response.Error() != nil {
 		return "", response.FormatError()
@@ -491,7 +725,12 @@ func (v *volumeClient) CredsCreate(params map[string]string) (string, error) {
 
 // CredsDelete deletes the credential with given UUID
 func (v *volumeClient) CredsDelete(uuid string) error {
-	req := v.c.Delete().Resource(api.OsdCredsPath).Instance(uuid)
+	return v.CredsDeleteWithContext(context.Background(), uuid)
+}
+
+// CredsDeleteWithContext is CredsDelete made with ctx.
+func (v *volumeClient) CredsDeleteWithContext(ctx context.Context, uuid string) error {
+	req := v.c.Delete().Context(ctx).Resource(api.OsdCredsPath).Instance(uuid)
 	response := req.Do()
 	if response.Error() != nil {
 		return response.FormatError()
@@ -502,7 +741,12 @@ func (v *volumeClient) CredsDelete(uuid string) error {
 // CredsValidate validates the credential by accessuing the cloud
 // provider with the given credential
 func (v *volumeClient) CredsValidate(uuid string) error {
-	req := v.c.Put().Resource(api.OsdCredsPath + "/validate").Instance(uuid)
+	return v.CredsValidateWithContext(context.Background(), uuid)
+}
+
+// CredsValidateWithContext is CredsValidate made with ctx.
+func (v *volumeClient) CredsValidateWithContext(ctx context.Context, uuid string) error {
+	req := v.c.Put().Context(ctx).Resource(api.OsdCredsPath + "/validate").Instance(uuid)
 	response := req.Do()
 	if response.Error() != nil {
 		return response.FormatError()
@@ -513,9 +757,17 @@ func (v *volumeClient) CredsValidate(uuid string) error {
 // CloudBackupCreate uploads snapshot of a volume to cloud
 func (v *volumeClient) CloudBackupCreate(
 	input *api.CloudBackupCreateRequest,
+) (*api.CloudBackupCreateResponse, error) {
+	return v.CloudBackupCreateWithContext(context.Background(), input)
+}
+
+// CloudBackupCreateWithContext is CloudBackupCreate made with ctx.
+func (v *volumeClient) CloudBackupCreateWithContext(
+	ctx context.Context,
+	input *api.CloudBackupCreateRequest,
 ) (*api.CloudBackupCreateResponse, error) {
 	createResp := &api.CloudBackupCreateResponse{}
-	req := v.c.Post().Resource(api.OsdBackupPath).Body(input)
+	req := v.c.Post().Context(ctx).Resource(api.OsdBackupPath).Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		if response.StatusCode() == http.StatusConflict {
@@ -536,7 +788,15 @@ func (v *volumeClient) CloudBackupCreate(
 func (v *volumeClient) CloudBackupGroupCreate(
 	input *api.CloudBackupGroupCreateRequest,
 ) error {
-	req := v.c.Post().Resource(api.OsdBackupPath + "/group").Body(input)
+	return v.CloudBackupGroupCreateWithContext(context.Background(), input)
+}
+
+// CloudBackupGroupCreateWithContext is CloudBackupGroupCreate made with ctx.
+func (v *volumeClient) CloudBackupGroupCreateWithContext(
+	ctx context.Context,
+	input *api.CloudBackupGroupCreateRequest,
+) error {
+	req := v.c.Post().Context(ctx).Resource(api.OsdBackupPath + "/group").Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return response.FormatError()
@@ -548,9 +808,17 @@ func (v *volumeClient) CloudBackupGroupCreate(
 // CloudBackupRestore downloads a cloud backup to a newly created volume
 func (v *volumeClient) CloudBackupRestore(
 	input *api.CloudBackupRestoreRequest,
+) (*api.CloudBackupRestoreResponse, error) {
+	return v.CloudBackupRestoreWithContext(context.Background(), input)
+}
+
+// CloudBackupRestoreWithContext is CloudBackupRestore made with ctx.
+func (v *volumeClient) CloudBackupRestoreWithContext(
+	ctx context.Context,
+	input *api.CloudBackupRestoreRequest,
 ) (*api.CloudBackupRestoreResponse, error) {
 	restoreResponse := &api.CloudBackupRestoreResponse{}
-	req := v.c.Post().Resource(api.OsdBackupPath + "/restore").Body(input)
+	req := v.c.Post().Context(ctx).Resource(api.OsdBackupPath + "/restore").Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		if response.StatusCode() == http.StatusConflict {
@@ -571,9 +839,17 @@ func (v *volumeClient) CloudBackupRestore(
 // CloudBackupEnumerate lists the backups for a given cluster/credential/volumeID
 func (v *volumeClient) CloudBackupEnumerate(
 	input *api.CloudBackupEnumerateRequest,
+) (*api.CloudBackupEnumerateResponse, error) {
+	return v.CloudBackupEnumerateWithContext(context.Background(), input)
+}
+
+// CloudBackupEnumerateWithContext is CloudBackupEnumerate made with ctx.
+func (v *volumeClient) CloudBackupEnumerateWithContext(
+	ctx context.Context,
+	input *api.CloudBackupEnumerateRequest,
 ) (*api.CloudBackupEnumerateResponse, error) {
 	enumerateResponse := &api.CloudBackupEnumerateResponse{}
-	req := v.c.Get().Resource(api.OsdBackupPath).Body(input)
+	req := v.c.Get().Context(ctx).Resource(api.OsdBackupPath).Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return nil, response.FormatError()
@@ -589,7 +865,15 @@ func (v *volumeClient) CloudBackupEnumerate(
 func (v *volumeClient) CloudBackupDelete(
 	input *api.CloudBackupDeleteRequest,
 ) error {
-	req := v.c.Delete().Resource(api.OsdBackupPath).Body(input)
+	return v.CloudBackupDeleteWithContext(context.Background(), input)
+}
+
+// CloudBackupDeleteWithContext is CloudBackupDelete made with ctx.
+func (v *volumeClient) CloudBackupDeleteWithContext(
+	ctx context.Context,
+	input *api.CloudBackupDeleteRequest,
+) error {
+	req := v.c.Delete().Context(ctx).Resource(api.OsdBackupPath).Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return response.FormatError()
@@ -601,7 +885,15 @@ func (v *volumeClient) CloudBackupDelete(
 func (v *volumeClient) CloudBackupDeleteAll(
 	input *api.CloudBackupDeleteAllRequest,
 ) error {
-	req := v.c.Delete().Resource(api.OsdBackupPath + "/all").Body(input)
+	return v.CloudBackupDeleteAllWithContext(context.Background(), input)
+}
+
+// CloudBackupDeleteAllWithContext is CloudBackupDeleteAll made with ctx.
+func (v *volumeClient) CloudBackupDeleteAllWithContext(
+	ctx context.Context,
+	input *api.CloudBackupDeleteAllRequest,
+) error {
+	req := v.c.Delete().Context(ctx).Resource(api.OsdBackupPath + "/all").Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return response.FormatError()
@@ -612,9 +904,17 @@ func (v *volumeClient) CloudBackupDeleteAll(
 // CloudBackupStatus gets the most recent status of backup/restores
 func (v *volumeClient) CloudBackupStatus(
 	input *api.CloudBackupStatusRequest,
+) (*api.CloudBackupStatusResponse, error) {
+	return v.CloudBackupStatusWithContext(context.Background(), input)
+}
+
+// CloudBackupStatusWithContext is CloudBackupStatus made with ctx.
+func (v *volumeClient) CloudBackupStatusWithContext(
+	ctx context.Context,
+	input *api.CloudBackupStatusRequest,
 ) (*api.CloudBackupStatusResponse, error) {
 	statusResponse := &api.CloudBackupStatusResponse{}
-	req := v.c.Get().Resource(api.OsdBackupPath + "/status").Body(input)
+	req := v.c.Get().Context(ctx).Resource(api.OsdBackupPath + "/status").Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return nil, response.FormatError()
@@ -629,9 +929,17 @@ func (v *volumeClient) CloudBackupStatus(
 // CloudBackupCatalog displays listing of backup content
 func (v *volumeClient) CloudBackupCatalog(
 	input *api.CloudBackupCatalogRequest,
+) (*api.CloudBackupCatalogResponse, error) {
+	return v.CloudBackupCatalogWithContext(context.Background(), input)
+}
+
+// CloudBackupCatalogWithContext is CloudBackupCatalog made with ctx.
+func (v *volumeClient) CloudBackupCatalogWithContext(
+	ctx context.Context,
+	input *api.CloudBackupCatalogRequest,
 ) (*api.CloudBackupCatalogResponse, error) {
 	catalogResponse := &api.CloudBackupCatalogResponse{}
-	req := v.c.Get().Resource(api.OsdBackupPath + "/catalog").Body(input)
+	req := v.c.Get().Context(ctx).Resource(api.OsdBackupPath + "/catalog").Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return nil, response.FormatError()
@@ -646,9 +954,17 @@ func (v *volumeClient) CloudBackupCatalog(
 // CloudBackupHistory displays past backup/restore operations in the cluster
 func (v *volumeClient) CloudBackupHistory(
 	input *api.CloudBackupHistoryRequest,
+) (*api.CloudBackupHistoryResponse, error) {
+	return v.CloudBackupHistoryWithContext(context.Background(), input)
+}
+
+// CloudBackupHistoryWithContext is CloudBackupHistory made with ctx.
+func (v *volumeClient) CloudBackupHistoryWithContext(
+	ctx context.Context,
+	input *api.CloudBackupHistoryRequest,
 ) (*api.CloudBackupHistoryResponse, error) {
 	historyResponse := &api.CloudBackupHistoryResponse{}
-	req := v.c.Get().Resource(api.OsdBackupPath + "/history").Body(input)
+	req := v.c.Get().Context(ctx).Resource(api.OsdBackupPath + "/history").Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return nil, response.FormatError()
@@ -665,7 +981,15 @@ func (v *volumeClient) CloudBackupHistory(
 func (v *volumeClient) CloudBackupStateChange(
 	input *api.CloudBackupStateChangeRequest,
 ) error {
-	req := v.c.Put().Resource(api.OsdBackupPath + "/statechange").Body(input)
+	return v.CloudBackupStateChangeWithContext(context.Background(), input)
+}
+
+// CloudBackupStateChangeWithContext is CloudBackupStateChange made with ctx.
+func (v *volumeClient) CloudBackupStateChangeWithContext(
+	ctx context.Context,
+	input *api.CloudBackupStateChangeRequest,
+) error {
+	req := v.c.Put().Context(ctx).Resource(api.OsdBackupPath + "/statechange").Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return response.FormatError()
@@ -676,9 +1000,17 @@ func (v *volumeClient) CloudBackupStateChange(
 // CloudBackupSchedCreate for a volume creates a schedule to backup volume to cloud
 func (v *volumeClient) CloudBackupSchedCreate(
 	input *api.CloudBackupSchedCreateRequest,
+) (*api.CloudBackupSchedCreateResponse, error) {
+	return v.CloudBackupSchedCreateWithContext(context.Background(), input)
+}
+
+// CloudBackupSchedCreateWithContext is CloudBackupSchedCreate made with ctx.
+func (v *volumeClient) CloudBackupSchedCreateWithContext(
+	ctx context.Context,
+	input *api.CloudBackupSchedCreateRequest,
 ) (*api.CloudBackupSchedCreateResponse, error) {
 	createResponse := &api.CloudBackupSchedCreateResponse{}
-	req := v.c.Post().Resource(api.OsdBackupPath + "/sched").Body(input)
+	req := v.c.Post().Context(ctx).Resource(api.OsdBackupPath + "/sched").Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return nil, response.FormatError()
@@ -694,9 +1026,17 @@ func (v *volumeClient) CloudBackupSchedCreate(
 // volume group to the cloud
 func (v *volumeClient) CloudBackupGroupSchedCreate(
 	input *api.CloudBackupGroupSchedCreateRequest,
+) (*api.CloudBackupSchedCreateResponse, error) {
+	return v.CloudBackupGroupSchedCreateWithContext(context.Background(), input)
+}
+
+// CloudBackupGroupSchedCreateWithContext is CloudBackupGroupSchedCreate made with ctx.
+func (v *volumeClient) CloudBackupGroupSchedCreateWithContext(
+	ctx context.Context,
+	input *api.CloudBackupGroupSchedCreateRequest,
 ) (*api.CloudBackupSchedCreateResponse, error) {
 	createResponse := &api.CloudBackupSchedCreateResponse{}
-	req := v.c.Post().Resource(api.OsdBackupPath + "/schedgroup").Body(input)
+	req := v.c.Post().Context(ctx).Resource(api.OsdBackupPath + "/schedgroup").Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return nil, response.FormatError()
@@ -712,7 +1052,15 @@ func (v *volumeClient) CloudBackupGroupSchedCreate(
 func (v *volumeClient) CloudBackupSchedDelete(
 	input *api.CloudBackupSchedDeleteRequest,
 ) error {
-	req := v.c.Delete().Resource(api.OsdBackupPath + "/sched").Body(input)
+	return v.CloudBackupSchedDeleteWithContext(context.Background(), input)
+}
+
+// CloudBackupSchedDeleteWithContext is CloudBackupSchedDelete made with ctx.
+func (v *volumeClient) CloudBackupSchedDeleteWithContext(
+	ctx context.Context,
+	input *api.CloudBackupSchedDeleteRequest,
+) error {
+	req := v.c.Delete().Context(ctx).Resource(api.OsdBackupPath + "/sched").Body(input)
 	response := req.Do()
 	if response.Error() != nil {
 		return response.FormatError()
@@ -722,8 +1070,13 @@ func (v *volumeClient) CloudBackupSchedDelete(
 
 // CloudBackupSchedEnumerate enumerates the configured backup-schedules in the cluster
 func (v *volumeClient) CloudBackupSchedEnumerate() (*api.CloudBackupSchedEnumerateResponse, error) {
+	return v.CloudBackupSchedEnumerateWithContext(context.Background())
+}
+
+// CloudBackupSchedEnumerateWithContext is CloudBackupSchedEnumerate made with ctx.
+func (v *volumeClient) CloudBackupSchedEnumerateWithContext(ctx context.Context) (*api.CloudBackupSchedEnumerateResponse, error) {
 	enumerateResponse := &api.CloudBackupSchedEnumerateResponse{}
-	req := v.c.Get().Resource(api.OsdBackupPath + "/sched")
+	req := v.c.Get().Context(ctx).Resource(api.OsdBackupPath + "/sched")
 	response := req.Do()
 	if response.Error() != nil {
 		return nil, response.FormatError()
@@ -735,6 +1088,11 @@ func (v *volumeClient) CloudBackupSchedEnumerate() (*api.CloudBackupSchedEnumera
 }
 
 func (v *volumeClient) SnapshotGroup(groupID string, labels map[string]string) (*api.GroupSnapCreateResponse, error) {
+	return v.SnapshotGroupWithContext(context.Background(), groupID, labels)
+}
+
+// SnapshotGroupWithContext is SnapshotGroup made with ctx.
+func (v *volumeClient) SnapshotGroupWithContext(ctx context.Context, groupID string, labels map[string]string) (*api.GroupSnapCreateResponse, error) {
 
 	response := &api.GroupSnapCreateResponse{}
 	request := &api.GroupSnapCreateRequest{
@@ -742,7 +1100,7 @@ func (v *volumeClient) SnapshotGroup(groupID string, labels map[string]string) (
 		Labels: labels,
 	}
 
-	req := v.c.Post().Resource(snapPath + "/snapshotgroup").Body(request)
+	req := v.c.Post().Context(ctx).Resource(snapPath + "/snapshotgroup").Body(request)
 	res := req.Do()
 	if res.Error() != nil {
 		return nil, res.FormatError()
@@ -755,8 +1113,13 @@ func (v *volumeClient) SnapshotGroup(groupID string, labels map[string]string) (
 }
 
 func (v *volumeClient) CloudMigrateStart(request *api.CloudMigrateStartRequest) (*api.CloudMigrateStartResponse, error) {
+	return v.CloudMigrateStartWithContext(context.Background(), request)
+}
+
+// CloudMigrateStartWithContext is CloudMigrateStart made with ctx.
+func (v *volumeClient) CloudMigrateStartWithContext(ctx context.Context, request *api.CloudMigrateStartRequest) (*api.CloudMigrateStartResponse, error) {
 	startResponse := &api.CloudMigrateStartResponse{}
-	req := v.c.Post().Resource(api.OsdMigrateStartPath).Body(request)
+	req := v.c.Post().Context(ctx).Resource(api.OsdMigrateStartPath).Body(request)
 	response := req.Do()
 	if response.Error() != nil {
 		if response.StatusCode() == http.StatusConflict {
@@ -774,7 +1137,12 @@ func (v *volumeClient) CloudMigrateStart(request *api.CloudMigrateStartRequest)
 }
 
 func (v *volumeClient) CloudMigrateCancel(request *api.CloudMigrateCancelRequest) error {
-	req := v.c.Post().Resource(api.OsdMigrateCancelPath).Body(request)
+	return v.CloudMigrateCancelWithContext(context.Background(), request)
+}
+
+// CloudMigrateCancelWithContext is CloudMigrateCancel made with ctx.
+func (v *volumeClient) CloudMigrateCancelWithContext(ctx context.Context, request *api.CloudMigrateCancelRequest) error {
+	req := v.c.Post().Context(ctx).Resource(api.OsdMigrateCancelPath).Body(request)
 	response := req.Do()
 	if response.Error() != nil {
 		return response.FormatError()
@@ -783,8 +1151,13 @@ func (v *volumeClient) CloudMigrateCancel(request *api.CloudMigrateCancelRequest
 }
 
 func (v *volumeClient) CloudMigrateStatus() (*api.CloudMigrateStatusResponse, error) {
+	return v.CloudMigrateStatusWithContext(context.Background())
+}
+
+// CloudMigrateStatusWithContext is CloudMigrateStatus made with ctx.
+func (v *volumeClient) CloudMigrateStatusWithContext(ctx context.Context) (*api.CloudMigrateStatusResponse, error) {
 	statusResponse := &api.CloudMigrateStatusResponse{}
-	req := v.c.Get().Resource(api.OsdMigrateStatusPath)
+	req := v.c.Get().Context(ctx).Resource(api.OsdMigrateStatusPath)
 	response := req.Do()
 	if response.Error() != nil {
 		return nil, response.FormatError()
@@ -795,11 +1168,73 @@ func (v *volumeClient) CloudMigrateStatus() (*api.CloudMigrateStatusResponse, er
 	return statusResponse, nil
 }
 
+// PoolExpand adds a device to the storage pool with the given id and
+// returns the pool with its new capacity.
+func (v *volumeClient) PoolExpand(poolID int32, request *api.PoolExpandRequest) (*api.StoragePool, error) {
+	return v.PoolExpandWithContext(context.Background(), poolID, request)
+}
+
+// PoolExpandWithContext is PoolExpand made with ctx.
+func (v *volumeClient) PoolExpandWithContext(ctx context.Context, poolID int32, request *api.PoolExpandRequest) (*api.StoragePool, error) {
+	pool := &api.StoragePool{}
+	response := v.c.Post().Context(ctx).Resource(volumePath + "/poolexpand").
+		Instance(strconv.Itoa(int(poolID))).Body(request).Do()
+	if response.Error() != nil {
+		return nil, response.FormatError()
+	}
+	if err := response.Unmarshal(pool); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// RotateKey rotates the key encryption key of the specified volume and
+// waits for the rotation job to complete.
+func (v *volumeClient) RotateKey(volumeID string) error {
+	return v.RotateKeyWithContext(context.Background(), volumeID)
+}
+
+// RotateKeyWithContext is RotateKey made with ctx.
+func (v *volumeClient) RotateKeyWithContext(ctx context.Context, volumeID string) error {
+	job := &jobs.Job{}
+	req := v.c.Post().Context(ctx).Resource(volumePath + "/rotatekey").Instance(volumeID)
+	response := req.Do()
+	if response.Error() != nil {
+		return response.FormatError()
+	}
+	if err := response.Unmarshal(job); err != nil {
+		return err
+	}
+
+	for !job.State.Done() {
+		select {
+		case <-time.After(jobPollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err := v.c.Get().Context(ctx).Resource(jobsPath).Instance(job.Id).Do().Unmarshal(job); err != nil {
+			return err
+		}
+	}
+	switch job.State {
+	case jobs.StateFailed:
+		return errors.New(job.Error)
+	case jobs.StateCanceled:
+		return errors.New("Key rotation was canceled")
+	}
+	return nil
+}
+
 // Du specified volume id and specifically path (if provided)
 func (v *volumeClient) Catalog(id, subfolder, maxDepth string) (api.CatalogResponse, error) {
+	return v.CatalogWithContext(context.Background(), id, subfolder, maxDepth)
+}
+
+// CatalogWithContext is Catalog made with ctx.
+func (v *volumeClient) CatalogWithContext(ctx context.Context, id, subfolder, maxDepth string) (api.CatalogResponse, error) {
 	var catalog api.CatalogResponse
 
-	req := v.c.Get().Resource(volumePath + "/catalog").Instance(id)
+	req := v.c.Get().Context(ctx).Resource(volumePath + "/catalog").Instance(id)
 	if err := req.QueryOption(api.OptCatalogSubFolder, subfolder).QueryOption(api.OptCatalogMaxDepth, maxDepth).Do().Unmarshal(&catalog); err != nil {
 		return catalog, err
 	}
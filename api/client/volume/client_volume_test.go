@@ -1,13 +1,16 @@
 package volume
 
 import (
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/libopenstorage/openstorage/api"
+	ost_errors "github.com/libopenstorage/openstorage/api/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,9 +26,40 @@ func TestClientTLS(t *testing.T) {
 	clnt, err := NewDriverClient(ts.URL, "pxd", "", "")
 	require.NoError(t, err)
 
-	clnt.SetTLS(&tls.Config{InsecureSkipVerify: true})
+	require.NoError(t, clnt.SetTLS(&tls.Config{InsecureSkipVerify: true}))
 
 	_, err = VolumeDriver(clnt).Inspect([]string{"12345"})
 
 	require.NoError(t, err)
 }
+
+func TestClientContext(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	clnt, err := NewDriverClient(ts.URL, "pxd", "", "")
+	require.NoError(t, err)
+	driver := ContextVolumeDriver(clnt)
+
+	// Inspect is bounded by its default deadline
+	_, err = driver.InspectWithContext(context.Background(), []string{"12345"})
+	require.IsType(t, &ost_errors.ErrDeadlineExceeded{}, err)
+	require.Equal(t, InspectDeadline, err.(*ost_errors.ErrDeadlineExceeded).Timeout)
+
+	// The deadline of the context replaces the default one
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = driver.CreateWithContext(ctx, &api.VolumeLocator{}, nil, &api.VolumeSpec{})
+	require.IsType(t, &ost_errors.ErrDeadlineExceeded{}, err)
+	require.True(t, time.Since(start) < time.Second)
+
+	// Cancelling the context ends the call
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, driver.DeleteWithContext(ctx, "12345"))
+}
@@ -0,0 +1,17 @@
+package volume
+
+import (
+	"github.com/libopenstorage/openstorage/api/client"
+	"github.com/libopenstorage/openstorage/speclint"
+)
+
+// Lint returns the findings of the volume of req against the tenant policies
+// of the cluster and the capabilities of the driver of c, without creating
+// it.
+func Lint(c *client.Client, req *speclint.Request) (*speclint.Result, error) {
+	result := &speclint.Result{}
+	if err := c.Post().Resource(volumePath + "/validate").Body(req).Do().Unmarshal(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -1,18 +1,87 @@
 package volume
 
 import (
+	"context"
 	"fmt"
+	"io"
 
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/api/client"
 	"github.com/libopenstorage/openstorage/volume"
 )
 
+// ContextDriver is a VolumeDriver whose calls can be made with a context.
+// Cancelling the context or reaching its deadline ends the call, and the
+// deadline of the context replaces the default one of the call. The requests
+// carry the correlation id of the context, see correlation.NewContext.
+type ContextDriver interface {
+	volume.VolumeDriver
+	GraphDriverCreateWithContext(ctx context.Context, id string, parent string) error
+	GraphDriverRemoveWithContext(ctx context.Context, id string) error
+	GraphDriverGetWithContext(ctx context.Context, id string, mountLabel string) (string, error)
+	GraphDriverReleaseWithContext(ctx context.Context, id string) error
+	GraphDriverChangesWithContext(ctx context.Context, id string, parent string) ([]api.GraphDriverChanges, error)
+	GraphDriverApplyDiffWithContext(ctx context.Context, id string, parent string, diff io.Reader) (int, error)
+	GraphDriverDiffSizeWithContext(ctx context.Context, id string, parent string) (int, error)
+	CreateWithContext(ctx context.Context, locator *api.VolumeLocator, source *api.Source, spec *api.VolumeSpec) (string, error)
+	DriverStatusWithContext(ctx context.Context) []*api.DriverStatus
+	InspectWithContext(ctx context.Context, ids []string) ([]*api.Volume, error)
+	DeleteWithContext(ctx context.Context, volumeID string) error
+	SnapshotWithContext(ctx context.Context, volumeID string, readonly bool, locator *api.VolumeLocator, noRetry bool) (string, error)
+	RestoreWithContext(ctx context.Context, volumeID string, snapID string) error
+	StatsWithContext(ctx context.Context, volumeID string, cumulative bool) (*api.Stats, error)
+	UsedSizeWithContext(ctx context.Context, volumeID string) (uint64, error)
+	GetActiveRequestsWithContext(ctx context.Context) (*api.ActiveRequests, error)
+	CapacityUsageWithContext(ctx context.Context, ID string) (*api.CapacityUsageResponse, error)
+	EnumerateWithContext(ctx context.Context, locator *api.VolumeLocator, labels map[string]string) ([]*api.Volume, error)
+	SnapEnumerateWithContext(ctx context.Context, ids []string, snapLabels map[string]string) ([]*api.Volume, error)
+	AttachWithContext(ctx context.Context, volumeID string, attachOptions map[string]string) (string, error)
+	DetachWithContext(ctx context.Context, volumeID string, options map[string]string) error
+	MountWithContext(ctx context.Context, volumeID string, mountPath string, options map[string]string) error
+	UnmountWithContext(ctx context.Context, volumeID string, mountPath string, options map[string]string) error
+	SetWithContext(ctx context.Context, volumeID string, locator *api.VolumeLocator, spec *api.VolumeSpec) error
+	QuiesceWithContext(ctx context.Context, volumeID string, timeoutSec uint64, quiesceID string) error
+	ResizeWithContext(ctx context.Context, volumeID string, newSize uint64) error
+	CloneWithContext(ctx context.Context, volumeID string, locator *api.VolumeLocator) (string, error)
+	UnquiesceWithContext(ctx context.Context, volumeID string) error
+	CredsEnumerateWithContext(ctx context.Context) (map[string]interface{}, error)
+	CredsCreateWithContext(ctx context.Context, params map[string]string) (string, error)
+	CredsDeleteWithContext(ctx context.Context, uuid string) error
+	CredsValidateWithContext(ctx context.Context, uuid string) error
+	CloudBackupCreateWithContext(ctx context.Context, input *api.CloudBackupCreateRequest) (*api.CloudBackupCreateResponse, error)
+	CloudBackupGroupCreateWithContext(ctx context.Context, input *api.CloudBackupGroupCreateRequest) error
+	CloudBackupRestoreWithContext(ctx context.Context, input *api.CloudBackupRestoreRequest) (*api.CloudBackupRestoreResponse, error)
+	CloudBackupEnumerateWithContext(ctx context.Context, input *api.CloudBackupEnumerateRequest) (*api.CloudBackupEnumerateResponse, error)
+	CloudBackupDeleteWithContext(ctx context.Context, input *api.CloudBackupDeleteRequest) error
+	CloudBackupDeleteAllWithContext(ctx context.Context, input *api.CloudBackupDeleteAllRequest) error
+	CloudBackupStatusWithContext(ctx context.Context, input *api.CloudBackupStatusRequest) (*api.CloudBackupStatusResponse, error)
+	CloudBackupCatalogWithContext(ctx context.Context, input *api.CloudBackupCatalogRequest) (*api.CloudBackupCatalogResponse, error)
+	CloudBackupHistoryWithContext(ctx context.Context, input *api.CloudBackupHistoryRequest) (*api.CloudBackupHistoryResponse, error)
+	CloudBackupStateChangeWithContext(ctx context.Context, input *api.CloudBackupStateChangeRequest) error
+	CloudBackupSchedCreateWithContext(ctx context.Context, input *api.CloudBackupSchedCreateRequest) (*api.CloudBackupSchedCreateResponse, error)
+	CloudBackupGroupSchedCreateWithContext(ctx context.Context, input *api.CloudBackupGroupSchedCreateRequest) (*api.CloudBackupSchedCreateResponse, error)
+	CloudBackupSchedDeleteWithContext(ctx context.Context, input *api.CloudBackupSchedDeleteRequest) error
+	CloudBackupSchedEnumerateWithContext(ctx context.Context) (*api.CloudBackupSchedEnumerateResponse, error)
+	SnapshotGroupWithContext(ctx context.Context, groupID string, labels map[string]string) (*api.GroupSnapCreateResponse, error)
+	CloudMigrateStartWithContext(ctx context.Context, request *api.CloudMigrateStartRequest) (*api.CloudMigrateStartResponse, error)
+	CloudMigrateCancelWithContext(ctx context.Context, request *api.CloudMigrateCancelRequest) error
+	CloudMigrateStatusWithContext(ctx context.Context) (*api.CloudMigrateStatusResponse, error)
+	PoolExpandWithContext(ctx context.Context, poolID int32, request *api.PoolExpandRequest) (*api.StoragePool, error)
+	RotateKeyWithContext(ctx context.Context, volumeID string) error
+	CatalogWithContext(ctx context.Context, id, subfolder, maxDepth string) (api.CatalogResponse, error)
+}
+
 // VolumeDriver returns a REST wrapper for the VolumeDriver interface.
 func VolumeDriver(c *client.Client) volume.VolumeDriver {
 	return newVolumeClient(c)
 }
 
+// ContextVolumeDriver returns a REST wrapper for the VolumeDriver interface
+// whose calls can be made with a context.
+func ContextVolumeDriver(c *client.Client) ContextDriver {
+	return newVolumeClient(c)
+}
+
 // NewAuthDriverClient returns a new REST client of the supplied version for specified driver.
 // host: REST endpoint [http://<ip>:<port> OR unix://<path-to-unix-socket>]. default: [unix:///var/lib/osd/<driverName>.sock]
 // version: Volume API version
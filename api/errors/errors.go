@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ErrNotFound error type for objects not found
 type ErrNotFound struct {
@@ -32,3 +35,19 @@ type ErrNotSupported struct{}
 func (e *ErrNotSupported) Error() string {
 	return fmt.Sprintf("Not Supported")
 }
+
+// ErrDeadlineExceeded error type for requests that did not complete before
+// their deadline
+type ErrDeadlineExceeded struct {
+	// Request is the method and URL of the request
+	Request string
+	// Timeout of the request, zero if the deadline was set by the caller
+	Timeout time.Duration
+}
+
+func (e *ErrDeadlineExceeded) Error() string {
+	if e.Timeout == 0 {
+		return fmt.Sprintf("%v did not complete before its deadline", e.Request)
+	}
+	return fmt.Sprintf("%v did not complete within %v", e.Request, e.Timeout)
+}
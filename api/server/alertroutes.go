@@ -0,0 +1,81 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// alertRoutesPath is the cluster route of the alert routes, set in the
+// alerts section of the cluster configuration
+const alertRoutesPath = "/alertroutes"
+
+// swagger:operation GET /cluster/alertroutes cluster enumerateAlertRoutes
+//
+// Enumerate the routes of the alerts to the notifiers, in the order they
+// are evaluated. The first route matching an alert wins.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: alert routes
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Route"
+func (c *clusterApi) enumerateAlertRoutes(w http.ResponseWriter, r *http.Request) {
+	method := "enumerateAlertRoutes"
+	m, err := alerts.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(m.Routes())
+}
+
+// swagger:operation POST /cluster/alertroutes/preview cluster previewAlertRoute
+//
+// Preview the route an example alert would take and the notifiers it
+// would be delivered to, without raising it.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: alert
+//   in: body
+//   description: example alert
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Alert"
+// responses:
+//   '200':
+//     description: route of the alert
+//     schema:
+//       "$ref": "#/definitions/RoutePreview"
+func (c *clusterApi) previewAlertRoute(w http.ResponseWriter, r *http.Request) {
+	method := "previewAlertRoute"
+
+	var alert api.Alert
+	if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	m, err := alerts.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	preview, err := m.PreviewRoute(&alert)
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(preview)
+}
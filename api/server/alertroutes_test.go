@@ -0,0 +1,54 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	clusterclient "github.com/libopenstorage/openstorage/api/client/cluster"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertRoutes(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m, err := alerts.NewManager(kv)
+	require.NoError(t, err)
+	require.NoError(t, m.SetRoutes(&alerts.Route{
+		Name:          "volumes",
+		ResourceTypes: []string{"volume"},
+	}))
+	oldInst := alerts.Inst
+	alerts.Inst = func() (alerts.Manager, error) {
+		return m, nil
+	}
+	defer func() {
+		alerts.Inst = oldInst
+	}()
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+
+	var routes []*alerts.Route
+	err = c.Get().Resource("cluster" + alertRoutesPath).Do().Unmarshal(&routes)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	assert.Equal(t, "volumes", routes[0].Name)
+
+	var preview alerts.RoutePreview
+	err = c.Post().Resource("cluster" + alertRoutesPath + "/preview").Body(&api.Alert{
+		AlertType:  1,
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol",
+	}).Do().Unmarshal(&preview)
+	require.NoError(t, err)
+	assert.Equal(t, "volumes", preview.Route)
+	assert.Empty(t, preview.Notifiers)
+}
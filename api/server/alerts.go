@@ -0,0 +1,367 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	// alertsPath is the route of the alerts, filtered by the query parameters
+	alertsPath = "alerts"
+	// alertsWatchKeepAlive is the time between the comments sent on the
+	// alerts watches, so that idle connections are not closed by proxies
+	alertsWatchKeepAlive = 30 * time.Second
+)
+
+// swagger:operation GET /alerts alerts enumerateAlertsWithFilters
+//
+// Enumerate the alerts matching the query parameters. An alert type
+// requires a resource type. All the alerts are returned if there are no
+// parameters.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: resource
+//   in: query
+//   description: |
+//    Resource type of the alerts, such as volume, node, cluster or drive,
+//    or its number.
+//   type: string
+// - name: alerttype
+//   in: query
+//   description: alert type of the alerts
+//   type: integer
+// - name: resourceid
+//   in: query
+//   description: id of the resource of the alerts
+//   type: string
+// - name: namespace
+//   in: query
+//   description: |
+//    Namespace of the alerts, such as a tenant, the default namespace if
+//    unset and there is a resource type.
+//   type: string
+// - name: timestart
+//   in: query
+//   description: alerts raised at this time or later
+//   type: string
+// - name: timeend
+//   in: query
+//   description: alerts raised at this time or earlier
+//   type: string
+// - name: severity
+//   in: query
+//   description: |
+//    Minimum severity of the alerts, such as alarm, warning or notify,
+//    or its number.
+//   type: string
+// - name: cluster
+//   in: query
+//   description: |
+//    Also list the alerts of the other nodes of the cluster, keeping the
+//    alert raised last of each resource.
+//   type: boolean
+// - name: tag
+//   in: query
+//   description: |
+//    Annotation of the alerts, as key or key=value, repeated for the alerts
+//    having all the annotations.
+//   type: array
+//   items:
+//     type: string
+//   collectionFormat: multi
+// responses:
+//   '200':
+//      description: Alerts object
+//      schema:
+//       $ref: '#/definitions/Alerts'
+func (c *clusterApi) enumerateAlertsWithFilters(w http.ResponseWriter, r *http.Request) {
+	method := "enumerateAlertsWithFilters"
+
+	filters, err := alertsFilters(r.URL.Query())
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	m, err := alerts.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var options []alerts.Option
+	if v := r.URL.Query().Get("cluster"); len(v) != 0 {
+		clusterWide, err := strconv.ParseBool(v)
+		if err != nil {
+			c.sendError(c.name, method, w, "Invalid cluster param", http.StatusBadRequest)
+			return
+		}
+		if clusterWide {
+			options = append(options, alerts.NewClusterWideOption())
+		}
+	}
+	out, _, err := m.EnumerateWithOptions(options, filters...)
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(&api.Alerts{Alert: out})
+}
+
+// AlertAnnotations are the annotations to attach to an alert.
+// swagger:model
+type AlertAnnotations struct {
+	// ID identifies the alert, see alerts.ID
+	ID string `json:"id"`
+	// Annotations replace those of the same keys, an empty value removing
+	// the annotation
+	Annotations map[string]string `json:"annotations"`
+}
+
+// swagger:operation PUT /alerts/annotations alerts annotateAlert
+//
+// Attach annotations to an alert, such as a ticket number or a triage
+// owner, replacing those of the same keys. An empty value removes the
+// annotation. The alerts are selected by their annotations with the tag
+// parameter of enumerateAlertsWithFilters.
+//
+// ---
+// consumes:
+// - application/json
+// parameters:
+// - name: annotations
+//   in: body
+//   description: alert and its annotations
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/AlertAnnotations"
+// responses:
+//   '200':
+//     description: annotations attached
+//   '400':
+//     description: invalid alert id or annotations
+//   '404':
+//     description: alert not found
+func (c *clusterApi) annotateAlert(w http.ResponseWriter, r *http.Request) {
+	method := "annotateAlert"
+
+	var req AlertAnnotations
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	m, err := alerts.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := m.Annotate(req.ID, req.Annotations); err != nil {
+		code := http.StatusInternalServerError
+		switch {
+		case err == alerts.ErrNotFound:
+			code = http.StatusNotFound
+		case err == alerts.ErrInvalidID,
+			strings.HasSuffix(err.Error(), alerts.ErrInvalidAnnotations.Error()):
+			code = http.StatusBadRequest
+		}
+		c.sendError(c.name, method, w, err.Error(), code)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// watchEventNames are the names of the Server-Sent Events of the alerts
+// watches.
+var watchEventNames = map[alerts.WatchAction]string{
+	alerts.AlertRaised:  "raised",
+	alerts.AlertUpdated: "updated",
+	alerts.AlertDeleted: "deleted",
+}
+
+// swagger:operation GET /alerts/watch alerts watchAlerts
+//
+// Stream the changes of the alerts matching the query parameters, those of
+// enumerateAlertsWithFilters, as Server-Sent Events until the client
+// disconnects. Every event is named raised, updated or deleted, and its
+// data is the alert as JSON.
+//
+// ---
+// produces:
+// - text/event-stream
+// responses:
+//   '200':
+//      description: stream of alerts
+func (c *clusterApi) watchAlerts(w http.ResponseWriter, r *http.Request) {
+	method := "watchAlerts"
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		c.sendError(c.name, method, w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	filters, err := alertsFilters(r.URL.Query())
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	m, err := alerts.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	events, stop, err := m.Watch(filters...)
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer stop()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(alertsWatchKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(event.Alert)
+			if err != nil {
+				c.logRequest(method, "").Warnln("Failed to encode alert:", err)
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", watchEventNames[event.Action], data)
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keepalive\n\n")
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// alertsFilters translates the query parameters of enumerateAlertsWithFilters
+// into alerts filters. The efficient filter of the resource type, alert type
+// and resource id given is used when there is a resource type, the other
+// parameters being its options, or the namespace filter when there is only a
+// namespace.
+func alertsFilters(params url.Values) ([]alerts.Filter, error) {
+	var (
+		options []alerts.Option
+		// conds are the filters matching the options, when there is no
+		// efficient filter to apply them to
+		conds []alerts.Filter
+	)
+
+	var timeStart, timeEnd time.Time
+	for name, t := range map[string]*time.Time{"timestart": &timeStart, "timeend": &timeEnd} {
+		if v := params.Get(name); len(v) != 0 {
+			var err error
+			if *t, err = time.Parse(api.TimeLayout, v); err != nil {
+				return nil, fmt.Errorf("Invalid %s param", name)
+			}
+		}
+	}
+	if !timeStart.IsZero() || !timeEnd.IsZero() {
+		options = append(options, alerts.NewTimeSpanOption(timeStart, timeEnd))
+		conds = append(conds, alerts.NewTimeSpanFilter(timeStart, timeEnd))
+	}
+
+	for _, tag := range params["tag"] {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts[0]) == 0 {
+			return nil, fmt.Errorf("Invalid tag param")
+		}
+		options = append(options, alerts.NewTagOption(parts[0], parts[1:]...))
+		conds = append(conds, alerts.NewTagFilter(parts[0], parts[1:]...))
+	}
+
+	if v := params.Get("severity"); len(v) != 0 {
+		severity, err := handleSeverityType(v)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid severity param")
+		}
+		options = append(options, alerts.NewMinSeverityOption(severity))
+		conds = append(conds, alerts.NewMinSeverityFilter(severity))
+	}
+
+	var alertType int64
+	v := params.Get("alerttype")
+	hasAlertType := len(v) != 0
+	if hasAlertType {
+		var err error
+		if alertType, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("Invalid alerttype param")
+		}
+	}
+	resourceID := params.Get("resourceid")
+	namespace := params.Get("namespace")
+
+	v = params.Get("resource")
+	if len(v) == 0 {
+		if hasAlertType {
+			return nil, fmt.Errorf("Missing resource param for alerttype")
+		}
+		if len(namespace) != 0 {
+			if len(resourceID) != 0 {
+				options = append(options, alerts.NewResourceIdOption(resourceID))
+			}
+			return []alerts.Filter{alerts.NewNamespaceFilter(namespace, options...)}, nil
+		}
+		if len(resourceID) != 0 {
+			conds = append(conds, alerts.NewMatchResourceIDFilter(resourceID))
+		}
+		if len(conds) == 0 {
+			return nil, nil
+		}
+		return []alerts.Filter{alerts.NewAndFilter(conds...)}, nil
+	}
+	resourceType, err := handleResourceType(v)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid resource param")
+	}
+	if len(namespace) != 0 {
+		options = append(options, alerts.NewNamespaceOption(namespace))
+	}
+
+	switch {
+	case hasAlertType && len(resourceID) != 0:
+		return []alerts.Filter{alerts.NewResourceIDFilter(resourceID, alertType, resourceType, options...)}, nil
+	case hasAlertType:
+		return []alerts.Filter{alerts.NewAlertTypeFilter(alertType, resourceType, options...)}, nil
+	case len(resourceID) != 0:
+		options = append(options, alerts.NewResourceIdOption(resourceID))
+	}
+	return []alerts.Filter{alerts.NewResourceTypeFilter(resourceType, options...)}, nil
+}
+
+// handleSeverityType parses a severity, by name with or without its
+// SEVERITY_TYPE_ prefix, in any case, or by number.
+func handleSeverityType(severity string) (api.SeverityType, error) {
+	name := strings.ToUpper(severity)
+	if !strings.HasPrefix(name, "SEVERITY_TYPE_") {
+		name = "SEVERITY_TYPE_" + name
+	}
+	if v, ok := api.SeverityType_value[name]; ok {
+		return api.SeverityType(v), nil
+	}
+	if v, err := strconv.ParseInt(severity, 10, 32); err == nil {
+		if _, ok := api.SeverityType_name[int32(v)]; ok {
+			return api.SeverityType(v), nil
+		}
+	}
+	return api.SeverityType_SEVERITY_TYPE_NONE, fmt.Errorf("Invalid severity type")
+}
@@ -0,0 +1,255 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/api/client"
+	clusterclient "github.com/libopenstorage/openstorage/api/client/cluster"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumerateAlertsWithFilters(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m, err := alerts.NewManager(kv)
+	require.NoError(t, err)
+	oldInst := alerts.Inst
+	alerts.Inst = func() (alerts.Manager, error) {
+		return m, nil
+	}
+	defer func() {
+		alerts.Inst = oldInst
+	}()
+
+	for _, alert := range []*api.Alert{
+		{Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, AlertType: 1, ResourceId: "vol1",
+			Severity: api.SeverityType_SEVERITY_TYPE_ALARM},
+		{Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, AlertType: 2, ResourceId: "vol1",
+			Severity: api.SeverityType_SEVERITY_TYPE_NOTIFY},
+		{Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, AlertType: 1, ResourceId: "vol2",
+			Severity: api.SeverityType_SEVERITY_TYPE_WARNING},
+		{Resource: api.ResourceType_RESOURCE_TYPE_NODE, AlertType: 1, ResourceId: "node1",
+			Severity: api.SeverityType_SEVERITY_TYPE_ALARM},
+	} {
+		require.NoError(t, m.Raise(alert))
+	}
+	require.NoError(t, m.Raise(&api.Alert{Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, AlertType: 1,
+		ResourceId: "vol3", Severity: api.SeverityType_SEVERITY_TYPE_NOTIFY},
+		alerts.NewNamespaceOption("tenant-a")))
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+
+	now := time.Now()
+	for _, tt := range []struct {
+		params   map[string]string
+		expected int
+	}{
+		{nil, 5},
+		{map[string]string{"resource": "volume"}, 3},
+		{map[string]string{"resource": "volume", "alerttype": "1"}, 2},
+		{map[string]string{"resource": "volume", "alerttype": "1", "resourceid": "vol2"}, 1},
+		{map[string]string{"resource": "volume", "resourceid": "vol1"}, 2},
+		{map[string]string{"resourceid": "vol1"}, 2},
+		{map[string]string{"severity": "warning"}, 3},
+		{map[string]string{"resource": "volume", "severity": "alarm"}, 1},
+		{map[string]string{"timestart": now.Add(-time.Hour).Format(api.TimeLayout)}, 5},
+		{map[string]string{"namespace": "tenant-a"}, 1},
+		{map[string]string{"namespace": "tenant-a", "resource": "volume", "alerttype": "1"}, 1},
+		{map[string]string{"namespace": "tenant-a", "severity": "warning"}, 0},
+		{map[string]string{"namespace": "tenant-b"}, 0},
+		{map[string]string{"resource": "node", "timeend": now.Add(-time.Hour).Format(api.TimeLayout)}, 0},
+	} {
+		req := c.Get().Resource(alertsPath)
+		for k, v := range tt.params {
+			req = req.QueryOption(k, v)
+		}
+		var out api.Alerts
+		require.NoError(t, req.Do().Unmarshal(&out), "%v", tt.params)
+		assert.Len(t, out.Alert, tt.expected, "%v", tt.params)
+	}
+
+	for _, params := range []map[string]string{
+		{"alerttype": "1"},
+		{"resource": "bogus"},
+		{"severity": "bogus"},
+		{"timestart": "bogus"},
+	} {
+		req := c.Get().Resource(alertsPath)
+		for k, v := range params {
+			req = req.QueryOption(k, v)
+		}
+		assert.Error(t, req.Do().Error(), "%v", params)
+	}
+}
+
+func TestAnnotateAlert(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m, err := alerts.NewManager(kv)
+	require.NoError(t, err)
+	oldInst := alerts.Inst
+	alerts.Inst = func() (alerts.Manager, error) {
+		return m, nil
+	}
+	defer func() {
+		alerts.Inst = oldInst
+	}()
+
+	alert := &api.Alert{Resource: api.ResourceType_RESOURCE_TYPE_DRIVE, AlertType: 1, ResourceId: "sdb"}
+	require.NoError(t, m.Raise(alert))
+	require.NoError(t, m.Raise(&api.Alert{Resource: api.ResourceType_RESOURCE_TYPE_DRIVE, AlertType: 1,
+		ResourceId: "sdc"}))
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+
+	err = c.Put().Resource(alertsPath + "/annotations").Body(&AlertAnnotations{
+		ID:          alerts.ID(alert),
+		Annotations: map[string]string{"ticket": "OPS-1234", "owner": "storage"},
+	}).Do().Error()
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		tags     []string
+		expected int
+	}{
+		{[]string{"ticket"}, 1},
+		{[]string{"ticket", "owner=storage"}, 1},
+		{[]string{"owner=network"}, 0},
+	} {
+		req := c.Get().Resource(alertsPath)
+		for _, tag := range tt.tags {
+			req = req.QueryOption("tag", tag)
+		}
+		var out api.Alerts
+		require.NoError(t, req.Do().Unmarshal(&out), "%v", tt.tags)
+		require.Len(t, out.Alert, tt.expected, "%v", tt.tags)
+		if tt.expected != 0 {
+			assert.Equal(t, "OPS-1234", out.Alert[0].Annotations["ticket"])
+		}
+	}
+
+	for _, req := range []*AlertAnnotations{
+		{ID: "bogus", Annotations: map[string]string{"owner": "storage"}},
+		{ID: alerts.ID(alert), Annotations: map[string]string{"bad key": "x"}},
+		{ID: "RESOURCE_TYPE_DRIVE/1/sdd", Annotations: map[string]string{"owner": "storage"}},
+	} {
+		assert.Error(t, c.Put().Resource(alertsPath+"/annotations").Body(req).Do().Error(), "%v", req)
+	}
+}
+
+func TestWatchAlerts(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m, err := alerts.NewManager(kv)
+	require.NoError(t, err)
+	oldInst := alerts.Inst
+	alerts.Inst = func() (alerts.Manager, error) {
+		return m, nil
+	}
+	defer func() {
+		alerts.Inst = oldInst
+	}()
+
+	resp, err := http.Get(ts.URL + "/v1/" + alertsPath + "/watch?resource=volume")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// the node alert is not streamed
+	require.NoError(t, m.Raise(&api.Alert{Resource: api.ResourceType_RESOURCE_TYPE_NODE, AlertType: 1,
+		ResourceId: "node1", Severity: api.SeverityType_SEVERITY_TYPE_ALARM}))
+	require.NoError(t, m.Raise(&api.Alert{Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, AlertType: 1,
+		ResourceId: "vol1", Severity: api.SeverityType_SEVERITY_TYPE_ALARM}))
+
+	events := bufio.NewReader(resp.Body)
+	line, err := events.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "event: raised\n", line)
+	line, err = events.ReadString('\n')
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(line, "data: "), line)
+	var alert api.Alert
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &alert))
+	assert.Equal(t, "vol1", alert.ResourceId)
+
+	// invalid parameters fail the watch
+	resp, err = http.Get(ts.URL + "/v1/" + alertsPath + "/watch?resource=bogus")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestEnumerateAlertsClusterWide(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	// the other node is this server, its alerts are merged with themselves
+	// and its enumerations do not fan out again
+	node, err := client.NewClient(ts.URL, "v1", "")
+	require.NoError(t, err)
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m, err := alerts.NewManager(kv, alerts.NewNodesOption(func() (map[string]alerts.NodeSource, error) {
+		return map[string]alerts.NodeSource{"node2": clusterclient.NewAlertsSource(node)}, nil
+	}))
+	require.NoError(t, err)
+	oldInst := alerts.Inst
+	alerts.Inst = func() (alerts.Manager, error) {
+		return m, nil
+	}
+	defer func() {
+		alerts.Inst = oldInst
+	}()
+
+	for _, id := range []string{"vol1", "vol2"} {
+		require.NoError(t, m.Raise(&api.Alert{Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, AlertType: 1,
+			ResourceId: id, Severity: api.SeverityType_SEVERITY_TYPE_ALARM}))
+	}
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+	for _, params := range []map[string]string{
+		{"cluster": "true"},
+		{"cluster": "true", "resource": "volume", "alerttype": "1", "resourceid": "vol1"},
+	} {
+		req := c.Get().Resource(alertsPath)
+		for k, v := range params {
+			req = req.QueryOption(k, v)
+		}
+		var out api.Alerts
+		require.NoError(t, req.Do().Unmarshal(&out), "%v", params)
+		expected := 2
+		if len(params["resourceid"]) != 0 {
+			expected = 1
+		}
+		assert.Len(t, out.Alert, expected, "%v", params)
+	}
+
+	assert.Error(t, c.Get().Resource(alertsPath).QueryOption("cluster", "bogus").Do().Error())
+}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/libopenstorage/openstorage/api"
+	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
+	"github.com/libopenstorage/openstorage/pkg/topology"
 	"github.com/libopenstorage/openstorage/volume"
 )
 
@@ -23,6 +25,15 @@ func (vd *volAPI) cloudBackupCreate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Read from the replica nearest this node to avoid cross-zone egress.
+	if len(backupReq.SourceNodeID) == 0 {
+		if cm, err := clustermanager.Inst(); err == nil {
+			if source, _, err := topology.BackupSource(cm, d, backupReq.VolumeID); err == nil {
+				backupReq.SourceNodeID = source
+			}
+		}
+	}
+
 	createResp, err := d.CloudBackupCreate(backupReq)
 	if err != nil {
 		if err == volume.ErrInvalidName {
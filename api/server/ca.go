@@ -0,0 +1,191 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/libopenstorage/openstorage/pkg/ca"
+)
+
+const (
+	// caPath is the cluster route of the cluster CA
+	caPath = "/ca"
+	// caEnrollPath is the route joining nodes enroll with. It is the only
+	// route of the cluster API not requiring a client certificate.
+	caEnrollPath = caPath + "/enroll"
+)
+
+// CATokenResponse holds a new enrollment token.
+// swagger:model
+type CATokenResponse struct {
+	// Token to pass to the joining node
+	Token string
+}
+
+// swagger:operation POST /cluster/ca/tokens cluster caCreateToken
+//
+// Create a one time token for a node to enroll with the cluster CA.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: ttl
+//   in: query
+//   description: lifetime of the token, e.g. 30m. Defaults to 1h.
+//   required: false
+//   type: string
+// responses:
+//   '200':
+//     description: enrollment token
+//     schema:
+//       "$ref": "#/definitions/CATokenResponse"
+func (c *clusterApi) caCreateToken(w http.ResponseWriter, r *http.Request) {
+	method := "caCreateToken"
+
+	var ttl time.Duration
+	if v := r.URL.Query().Get("ttl"); len(v) != 0 {
+		var err error
+		if ttl, err = time.ParseDuration(v); err != nil || ttl < 0 {
+			c.sendError(c.name, method, w, "Invalid ttl "+v, http.StatusBadRequest)
+			return
+		}
+	}
+	clusterCA, err := ca.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	token, err := clusterCA.CreateToken(ttl)
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(&CATokenResponse{Token: token})
+}
+
+// swagger:operation POST /cluster/ca/enroll cluster caEnroll
+//
+// Issue the certificate of a joining node in exchange for an enrollment
+// token.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: request
+//   in: body
+//   description: token and certificate signing request of the node
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/EnrollRequest"
+// responses:
+//   '200':
+//     description: node and CA certificates
+//     schema:
+//       "$ref": "#/definitions/EnrollResponse"
+func (c *clusterApi) caEnroll(w http.ResponseWriter, r *http.Request) {
+	method := "caEnroll"
+
+	var req ca.EnrollRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	clusterCA, err := ca.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	cert, err := clusterCA.Enroll(req.Token, []byte(req.Csr))
+	if err == ca.ErrInvalidToken {
+		c.sendError(c.name, method, w, err.Error(), http.StatusForbidden)
+		return
+	} else if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	json.NewEncoder(w).Encode(&ca.EnrollResponse{
+		Cert: string(cert),
+		CA:   string(clusterCA.CertPEM()),
+	})
+}
+
+// swagger:operation GET /cluster/ca/certs cluster caEnumerateCerts
+//
+// Enumerate the certificates issued by the cluster CA.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: an array of certificate records
+//     schema:
+//       type: array
+//       items:
+//         $ref: '#/definitions/CertRecord'
+func (c *clusterApi) caEnumerateCerts(w http.ResponseWriter, r *http.Request) {
+	method := "caEnumerateCerts"
+
+	clusterCA, err := ca.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	records, err := clusterCA.Enumerate()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(records)
+}
+
+// swagger:operation DELETE /cluster/ca/certs/{serial} cluster caRevokeCert
+//
+// Revoke the certificate with the given serial number.
+//
+// ---
+// parameters:
+// - name: serial
+//   in: path
+//   description: hex encoded serial number of the certificate
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: success
+func (c *clusterApi) caRevokeCert(w http.ResponseWriter, r *http.Request) {
+	method := "caRevokeCert"
+
+	clusterCA, err := ca.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	err = clusterCA.Revoke(mux.Vars(r)["serial"])
+	if err == ca.ErrNotFound {
+		c.sendError(c.name, method, w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// requireClientCert rejects the requests without a verified client
+// certificate, except for enrollment.
+func requireClientCert(enrollPath string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != enrollPath && (r.TLS == nil || len(r.TLS.VerifiedChains) == 0) {
+			http.Error(w, "Client certificate required", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
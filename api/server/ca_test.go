@@ -0,0 +1,95 @@
+package server
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	clusterclient "github.com/libopenstorage/openstorage/api/client/cluster"
+	"github.com/libopenstorage/openstorage/pkg/ca"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCA(t *testing.T) func() {
+	kv, err := kvdb.New(mem.Name, "test", []string{}, nil, nil)
+	require.NoError(t, err)
+	clusterCA, err := ca.New(kv, "cluster1")
+	require.NoError(t, err)
+
+	oldInst := ca.Inst
+	ca.Inst = func() (*ca.CA, error) {
+		return clusterCA, nil
+	}
+	return func() {
+		ca.Inst = oldInst
+	}
+}
+
+func TestCAEnroll(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+	defer newTestCA(t)()
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+
+	var token CATokenResponse
+	err = c.Post().Resource("cluster"+caPath+"/tokens").QueryOption("ttl", "10m").Do().Unmarshal(&token)
+	require.NoError(t, err)
+	require.NotEmpty(t, token.Token)
+
+	_, csr, err := ca.NewCSR("node2", nil)
+	require.NoError(t, err)
+	var enrolled ca.EnrollResponse
+	err = c.Post().Resource("cluster" + caEnrollPath).Body(&ca.EnrollRequest{
+		Token: token.Token,
+		Csr:   string(csr),
+	}).Do().Unmarshal(&enrolled)
+	require.NoError(t, err)
+	assert.Contains(t, enrolled.Cert, "BEGIN CERTIFICATE")
+	assert.Contains(t, enrolled.CA, "BEGIN CERTIFICATE")
+
+	resp := c.Post().Resource("cluster" + caEnrollPath).Body(&ca.EnrollRequest{
+		Token: token.Token,
+		Csr:   string(csr),
+	}).Do()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode())
+
+	var records []*ca.CertRecord
+	err = c.Get().Resource("cluster" + caPath + "/certs").Do().Unmarshal(&records)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "node2", records[0].NodeId)
+
+	err = c.Delete().Resource("cluster" + caPath + "/certs").Instance(records[0].Serial).Do().Error()
+	require.NoError(t, err)
+	resp = c.Delete().Resource("cluster" + caPath + "/certs").Instance("ff").Do()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+}
+
+func TestRequireClientCert(t *testing.T) {
+	handler := requireClientCert("/enroll", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, test := range []struct {
+		path     string
+		state    *tls.ConnectionState
+		expected int
+	}{
+		{"/enroll", nil, http.StatusOK},
+		{"/certs", nil, http.StatusUnauthorized},
+		{"/certs", &tls.ConnectionState{}, http.StatusUnauthorized},
+	} {
+		r := httptest.NewRequest("GET", test.path, nil)
+		r.TLS = test.state
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, test.expected, w.Code, test.path)
+	}
+}
@@ -12,6 +12,9 @@ import (
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/cluster"
 	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
+	"github.com/libopenstorage/openstorage/kvdbhealth"
+	"github.com/libopenstorage/openstorage/preflight"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 const (
@@ -633,6 +636,73 @@ func (c *clusterApi) getAlertParams(w http.ResponseWriter, r *http.Request, meth
 	return resourceType, alertId, nil
 }
 
+// swagger:operation GET /cluster/kvdbhealth cluster kvdbHealth
+//
+// Get the health of the kvdb backend and its endpoints.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: kvdb health
+//     schema:
+//       "$ref": "#/definitions/Status"
+func (c *clusterApi) kvdbHealth(w http.ResponseWriter, r *http.Request) {
+	method := "kvdbHealth"
+	monitor, err := kvdbhealth.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(monitor.Status())
+}
+
+// swagger:operation GET /cluster/preflight cluster preflight
+//
+// Get the results of the preflight checks of the kernel prerequisites of
+// the volume drivers of the node.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: preflight checks passed
+//     schema:
+//       "$ref": "#/definitions/Status"
+//   '503':
+//     description: a preflight check failed
+//     schema:
+//       "$ref": "#/definitions/Status"
+func (c *clusterApi) preflight(w http.ResponseWriter, r *http.Request) {
+	method := "preflight"
+	checker, err := preflight.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	status := checker.Status()
+	if !status.Passed {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(status)
+}
+
+// swagger:operation GET /cluster/metrics cluster metrics
+//
+// Get the Prometheus metrics of the node, such as those of the alerts.
+//
+// ---
+// produces:
+// - text/plain
+// responses:
+//   '200':
+//     description: metrics in the Prometheus text format
+func (c *clusterApi) metrics(w http.ResponseWriter, r *http.Request) {
+	prometheus.Handler().ServeHTTP(w, r)
+}
+
 func (c *clusterApi) sendNotImplemented(w http.ResponseWriter, method string) {
 	c.sendError(c.name, method, w, "Not implemented.", http.StatusNotImplemented)
 }
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// gzipMinSize is the size of the smallest response compressed, smaller
+// ones would not get shorter
+const gzipMinSize = 1024
+
+// bufferedResponse holds the response of a handler until it is complete.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+// cacheable tags the successful responses of GET requests, such as the
+// volume lists and inspects, with an ETag, answers 304 Not Modified to the
+// clients which already hold that response, and compresses the others with
+// gzip when the client accepts it.
+func cacheable(fn func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			fn(w, r)
+			return
+		}
+		buf := &bufferedResponse{header: w.Header(), status: http.StatusOK}
+		fn(buf, r)
+		body := buf.body.Bytes()
+
+		if buf.status == http.StatusOK {
+			sum := sha256.Sum256(body)
+			etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+			w.Header().Set("ETag", etag)
+			if etagMatch(r.Header.Get("If-None-Match"), etag) {
+				w.Header().Del("Content-Length")
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+
+		if len(body) < gzipMinSize {
+			w.WriteHeader(buf.status)
+			w.Write(body)
+			return
+		}
+		w.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(r) {
+			w.WriteHeader(buf.status)
+			w.Write(body)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Del("Content-Length")
+		w.WriteHeader(buf.status)
+		gz := gzip.NewWriter(w)
+		gz.Write(body)
+		gz.Close()
+	}
+}
+
+// etagMatch returns true if etag is one of the entity tags of an
+// If-None-Match header.
+func etagMatch(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsGzip returns true if the client accepts gzip encoded responses.
+func acceptsGzip(r *http.Request) bool {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		parts := strings.Split(strings.TrimSpace(enc), ";")
+		if strings.TrimSpace(parts[0]) != "gzip" {
+			continue
+		}
+		return len(parts) == 1 || strings.Replace(strings.TrimSpace(parts[1]), " ", "", -1) != "q=0"
+	}
+	return false
+}
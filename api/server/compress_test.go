@@ -0,0 +1,76 @@
+package server
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheable(t *testing.T) {
+	list := strings.Repeat(`{"id":"vol"},`, 200)
+	ts := httptest.NewServer(http.HandlerFunc(cacheable(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(list))
+	})))
+	defer ts.Close()
+
+	get := func(headers map[string]string) *http.Response {
+		req, err := http.NewRequest("GET", ts.URL+"/v1/osd-volumes", nil)
+		require.NoError(t, err)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		// the transport would decompress transparently otherwise
+		resp, err := (&http.Transport{DisableCompression: true}).RoundTrip(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := get(map[string]string{"Accept-Encoding": "gzip"})
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+	gz, err := gzip.NewReader(resp.Body)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(gz)
+	require.NoError(t, err)
+	assert.Equal(t, list, string(body))
+	etag := resp.Header.Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	// clients holding the response get it again only if it changed
+	resp = get(map[string]string{"If-None-Match": etag})
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+	body, _ = ioutil.ReadAll(resp.Body)
+	assert.Empty(t, body)
+
+	list = strings.Repeat(`{"id":"vol"},`, 201)
+	resp = get(map[string]string{"If-None-Match": etag})
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("Content-Encoding"))
+	assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+	body, _ = ioutil.ReadAll(resp.Body)
+	assert.Equal(t, list, string(body))
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	for header, expected := range map[string]bool{
+		"":                  false,
+		"gzip":              true,
+		"deflate, gzip":     true,
+		"gzip;q=0":          false,
+		"gzip; q=0.5, br":   true,
+		"identity, deflate": false,
+	} {
+		r := &http.Request{Header: http.Header{"Accept-Encoding": []string{header}}}
+		assert.Equal(t, expected, acceptsGzip(r), header)
+	}
+}
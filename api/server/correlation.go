@@ -0,0 +1,21 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+)
+
+// correlated sets the correlation id of the requests in their context: the
+// one of their correlation.Header, or a new one. The id is returned in the
+// same header of the response, and logged by sendError.
+func correlated(fn func(http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(correlation.Header)
+		if len(id) == 0 {
+			id = correlation.NewID()
+		}
+		w.Header().Set(correlation.Header, id)
+		fn(w, r.WithContext(correlation.NewContext(r.Context(), id)))
+	}
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorrelated(t *testing.T) {
+	var id string
+	handler := correlated(func(w http.ResponseWriter, r *http.Request) {
+		id = correlation.FromContext(r.Context())
+	})
+
+	r := httptest.NewRequest("GET", "/v1/osd-volumes", nil)
+	r.Header.Set(correlation.Header, "abc")
+	w := httptest.NewRecorder()
+	handler(w, r)
+	assert.Equal(t, "abc", id)
+	assert.Equal(t, "abc", w.Header().Get(correlation.Header))
+
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest("GET", "/v1/osd-volumes", nil))
+	assert.NotEmpty(t, id)
+	assert.Equal(t, id, w.Header().Get(correlation.Header))
+}
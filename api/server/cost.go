@@ -0,0 +1,149 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/cost"
+)
+
+// swagger:operation GET /costs volume costReport
+//
+// Report the monthly cost of volumes grouped by tenant or namespace.
+//
+// ---
+// produces:
+// - application/json
+// - text/csv
+// parameters:
+// - name: groupby
+//   in: query
+//   description: tenant or namespace, defaults to tenant
+//   required: false
+//   type: string
+// - name: format
+//   in: query
+//   description: csv to export the report as CSV
+//   required: false
+//   type: string
+// responses:
+//   '200':
+//     description: cost report
+//     schema:
+//       "$ref": "#/definitions/Report"
+func (vd *volAPI) costReport(w http.ResponseWriter, r *http.Request) {
+	method := "costReport"
+
+	d, err := vd.getVolDriver(r)
+	if err != nil {
+		notFound(w, r)
+		return
+	}
+
+	cm, err := cost.Inst()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	params := r.URL.Query()
+	groupBy := params.Get(api.OptCostGroupBy)
+	if len(groupBy) == 0 {
+		groupBy = cost.GroupByTenant
+	}
+	report, err := cm.Report(d, groupBy)
+	if err == cost.ErrInvalidGroupBy || err == cost.ErrNoPriceSheet {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if params.Get(api.OptCostFormat) == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", "attachment; filename=costs.csv")
+		if err := report.WriteCSV(w); err != nil {
+			vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	json.NewEncoder(w).Encode(report)
+}
+
+// swagger:operation GET /costs/pricesheet volume getPriceSheet
+//
+// Get the price sheet used for cost reports.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//
+//   '200':
+//     description: price sheet
+//     schema:
+//       "$ref": "#/definitions/PriceSheet"
+func (vd *volAPI) getPriceSheet(w http.ResponseWriter, r *http.Request) {
+	method := "getPriceSheet"
+
+	cm, err := cost.Inst()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sheet, err := cm.GetPriceSheet()
+	if err == cost.ErrNoPriceSheet {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(sheet)
+}
+
+// swagger:operation PUT /costs/pricesheet volume setPriceSheet
+//
+// Set the price sheet used for cost reports.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: pricesheet
+//   in: body
+//   description: price per GiB-month of each tier and of backup storage
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/PriceSheet"
+// responses:
+//   '200':
+//     description: price sheet
+//     schema:
+//       "$ref": "#/definitions/PriceSheet"
+func (vd *volAPI) setPriceSheet(w http.ResponseWriter, r *http.Request) {
+	method := "setPriceSheet"
+
+	var sheet cost.PriceSheet
+	if err := json.NewDecoder(r.Body).Decode(&sheet); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cm, err := cost.Inst()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := cm.SetPriceSheet(&sheet); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(&sheet)
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	client "github.com/libopenstorage/openstorage/api/client/volume"
+	"github.com/libopenstorage/openstorage/cost"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostReport(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+	tm := newTestManagers(t)
+	defer tm.Finish()
+
+	cl, err := client.NewDriverClient(ts.URL, mockDriverName, "", mockDriverName)
+	require.NoError(t, err)
+
+	// No price sheet yet
+	resp := cl.Get().Resource(api.OsdCostsPath).Do()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+
+	sheet := &cost.PriceSheet{
+		Currency:       "USD",
+		CapacityPrices: map[string]float64{"low": 0.10},
+	}
+	err = cl.Put().Resource(api.OsdCostsPath + "/pricesheet").Body(sheet).Do().Error()
+	require.NoError(t, err)
+
+	var got cost.PriceSheet
+	err = cl.Get().Resource(api.OsdCostsPath + "/pricesheet").Do().Unmarshal(&got)
+	require.NoError(t, err)
+	assert.Equal(t, *sheet, got)
+
+	vols := []*api.Volume{
+		{
+			Id: "vol1",
+			Locator: &api.VolumeLocator{
+				VolumeLabels: map[string]string{api.LabelNamespace: "prod"},
+			},
+			Spec: &api.VolumeSpec{Size: 10 << 30, Cos: api.CosType_LOW},
+		},
+	}
+	testVolDriver.MockDriver().EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(vols, nil).Times(2)
+	testVolDriver.MockDriver().EXPECT().CloudBackupStatus(&api.CloudBackupStatusRequest{}).
+		Return(&api.CloudBackupStatusResponse{}, nil).Times(2)
+
+	var report cost.Report
+	err = cl.Get().Resource(api.OsdCostsPath).
+		QueryOption(api.OptCostGroupBy, cost.GroupByNamespace).
+		Do().Unmarshal(&report)
+	require.NoError(t, err)
+	require.Len(t, report.Items, 1)
+	assert.Equal(t, "prod", report.Items[0].Group)
+	assert.InDelta(t, 1.0, report.TotalCost, 0.001)
+
+	body, err := cl.Get().Resource(api.OsdCostsPath).
+		QueryOption(api.OptCostGroupBy, cost.GroupByNamespace).
+		QueryOption(api.OptCostFormat, "csv").
+		Do().Body()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(body), "namespace,volumes,"))
+	assert.Contains(t, string(body), "prod,1,10737418240,1.00,0,0.00,1.00,USD\n")
+}
+
+func TestCostReportInvalidGroupBy(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+	tm := newTestManagers(t)
+	defer tm.Finish()
+
+	cl, err := client.NewDriverClient(ts.URL, mockDriverName, "", mockDriverName)
+	require.NoError(t, err)
+
+	resp := cl.Get().Resource(api.OsdCostsPath).QueryOption(api.OptCostGroupBy, "node").Do()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
 )
 
 func (vd *volAPI) credsEnumerate(w http.ResponseWriter, r *http.Request) {
@@ -34,6 +35,17 @@ func (vd *volAPI) credsCreate(w http.ResponseWriter, r *http.Request) {
 		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if len(input.InputParams[api.OptCredEncrKey]) != 0 {
+		cipher, ok := input.InputParams[api.OptCredEncrCipher]
+		if !ok {
+			cipher = crypto.DefaultBackupCipher
+		}
+		if err := crypto.GetPolicy().CheckCipher(cipher); err != nil {
+			vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
 	d, err := vd.getVolDriver(r)
 	if err != nil {
 		notFound(w, r)
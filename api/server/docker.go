@@ -7,11 +7,15 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"regexp"
 
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/api/spec"
+	"github.com/libopenstorage/openstorage/audit"
 	"github.com/libopenstorage/openstorage/config"
+	"github.com/libopenstorage/openstorage/eventbus"
 	"github.com/libopenstorage/openstorage/pkg/options"
+	"github.com/libopenstorage/openstorage/pkg/subpath"
 	"github.com/libopenstorage/openstorage/pkg/util"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers"
@@ -22,6 +26,12 @@ const (
 	VolumeDriver = "VolumeDriver"
 )
 
+var (
+	// subpathRegex matches the subpath of an inline volume name, such as
+	// name=vol,subpath=logs
+	subpathRegex = regexp.MustCompile(subpath.Label + "=([0-9A-Za-z_.-]+),?")
+)
+
 // Implementation of the Docker volumes plugin specification.
 type driver struct {
 	restBase
@@ -169,6 +179,16 @@ func (d *driver) mountpath(name string) string {
 	return path.Join(volume.MountBase, name)
 }
 
+// subpathMount returns the subpath of the volume requested, if any, and its
+// mount path, one per subpath of the volume name.
+func (d *driver) subpathMount(request, name string) (string, string) {
+	m := subpathRegex.FindStringSubmatch(request)
+	if len(m) != 2 {
+		return "", d.mountpath(name)
+	}
+	return m[1], d.mountpath(name + "@" + m[1])
+}
+
 func (d *driver) create(w http.ResponseWriter, r *http.Request) {
 	method := "create"
 	request, err := d.decode(method, w, r)
@@ -418,7 +438,7 @@ func (d *driver) mount(w http.ResponseWriter, r *http.Request) {
 
 	// If a scaled volume is already mounted, check if it can be unmounted and
 	// detached. If not return an error.
-	mountpoint := d.mountpath(name)
+	sub, mountpoint := d.subpathMount(request.Name, name)
 	if vol.Spec.Scale > 1 {
 		id := v.MountedAt(mountpoint)
 		if len(id) != 0 {
@@ -468,7 +488,11 @@ func (d *driver) mount(w http.ResponseWriter, r *http.Request) {
 	// result of scale up.
 	response.Mountpoint = mountpoint
 	os.MkdirAll(mountpoint, 0755)
-	err = v.Mount(vol.Id, response.Mountpoint, nil)
+	var mountOpts map[string]string
+	if len(sub) != 0 {
+		mountOpts = map[string]string{options.OptionsSubpath: sub}
+	}
+	err = v.Mount(vol.Id, response.Mountpoint, mountOpts)
 	if err != nil {
 		d.logRequest(method, request.Name).Warnf(
 			"Cannot mount volume %v, %v",
@@ -476,6 +500,8 @@ func (d *driver) mount(w http.ResponseWriter, r *http.Request) {
 		d.errorResponse(method, w, err)
 		return
 	}
+	audit.LogAccess(r.Context(), eventbus.EventVolumeMount, vol.Id,
+		map[string]string{"mount_path": response.Mountpoint})
 	d.logRequest(method, request.Name).Infof("response %v", response.Mountpoint)
 	json.NewEncoder(w).Encode(&response)
 }
@@ -498,6 +524,12 @@ func (d *driver) path(w http.ResponseWriter, r *http.Request) {
 	}
 
 	d.logRequest(method, name).Debugf("")
+	if sub, mountpoint := d.subpathMount(request.Name, name); len(sub) != 0 {
+		response.Mountpoint = mountpoint
+		d.logRequest(method, request.Name).Debugf("response %v", response.Mountpoint)
+		json.NewEncoder(w).Encode(&response)
+		return
+	}
 	if len(vol.AttachPath) == 0 || len(vol.AttachPath) == 0 {
 		e := d.volNotMounted(method, name)
 		d.errorResponse(method, w, e)
@@ -589,7 +621,7 @@ func (d *driver) unmount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	mountpoint := d.mountpath(name)
+	_, mountpoint := d.subpathMount(request.Name, name)
 	id := vol.Id
 	if vol.Spec.Scale > 1 {
 		id = v.MountedAt(mountpoint)
@@ -616,6 +648,9 @@ func (d *driver) unmount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	audit.LogAccess(r.Context(), eventbus.EventVolumeUnmount, id,
+		map[string]string{"mount_path": mountpoint})
+
 	if v.Type() == api.DriverType_DRIVER_TYPE_BLOCK {
 		_ = v.Detach(id, nil)
 	}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/volume"
+)
+
+// swagger:operation GET /status volume driverStatus
+//
+// Status of the components of the volume driver. The drivers reporting
+// their status as key-value pairs have components of unknown state.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: an array of driver component statuses
+//     schema:
+//       type: array
+//       items:
+//         $ref: '#/definitions/DriverStatus'
+func (vd *volAPI) driverStatus(w http.ResponseWriter, r *http.Request) {
+	d, err := vd.getVolDriver(r)
+	if err != nil {
+		notFound(w, r)
+		return
+	}
+	json.NewEncoder(w).Encode(volume.StatusOf(d))
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	client "github.com/libopenstorage/openstorage/api/client/volume"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriverStatus(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	cl, err := client.NewDriverClient(ts.URL, mockDriverName, "", mockDriverName)
+	require.NoError(t, err)
+
+	testVolDriver.MockDriver().EXPECT().
+		Status().
+		Return([][2]string{{"Pool 0", "online"}}).
+		Times(2)
+	d := client.VolumeDriver(cl)
+	reporter, ok := d.(volume.StatusReporter)
+	require.True(t, ok)
+	status := reporter.DriverStatus()
+	require.Len(t, status, 1)
+	assert.Equal(t, "Pool 0", status[0].Component)
+	assert.Equal(t, api.DriverStatusUnknown, status[0].State)
+	assert.Equal(t, "online", status[0].Details)
+
+	// the key-value pairs are unchanged through the conversion
+	assert.Equal(t, [][2]string{{"Pool 0", "online"}}, d.Status())
+}
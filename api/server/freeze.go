@@ -0,0 +1,136 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/libopenstorage/openstorage/freeze"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+)
+
+// freezePath is the cluster route of the cluster operations freeze
+const freezePath = "/freeze"
+
+// FreezeRequest is the body of a cluster freeze.
+// swagger:model
+type FreezeRequest struct {
+	// Reason the cluster is frozen, returned with the rejected operations
+	Reason string
+}
+
+// swagger:operation GET /cluster/freeze cluster freezeStatus
+//
+// Get the freeze state of the cluster.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: freeze state
+//     schema:
+//       "$ref": "#/definitions/State"
+func (c *clusterApi) freezeStatus(w http.ResponseWriter, r *http.Request) {
+	method := "freezeStatus"
+	m, err := freeze.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s, err := m.State()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(s)
+}
+
+// swagger:operation POST /cluster/freeze cluster freeze
+//
+// Freeze the operations of the cluster. Every node rejects the requests
+// which modify state, with the reason, until the cluster is thawed. Reads
+// are still served.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: request
+//   in: body
+//   description: reason of the freeze
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/FreezeRequest"
+// responses:
+//   '200':
+//     description: freeze state
+//     schema:
+//       "$ref": "#/definitions/State"
+func (c *clusterApi) freeze(w http.ResponseWriter, r *http.Request) {
+	method := "freeze"
+
+	var req FreezeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	m, err := freeze.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s, err := m.Freeze(req.Reason, auth.FromContext(r.Context()))
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(s)
+}
+
+// swagger:operation POST /cluster/freeze/thaw cluster thaw
+//
+// Thaw the cluster, so that every node serves the requests which modify
+// state again.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: freeze state
+//     schema:
+//       "$ref": "#/definitions/State"
+func (c *clusterApi) thaw(w http.ResponseWriter, r *http.Request) {
+	method := "thaw"
+	m, err := freeze.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s, err := m.Thaw(auth.FromContext(r.Context()))
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(s)
+}
+
+// rejectWhenFrozen rejects requests which modify state while the cluster is
+// frozen, except the ones freezing and thawing it.
+func rejectWhenFrozen(fn func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead &&
+			!readOnlyPluginPaths[r.URL.Path] &&
+			!strings.HasPrefix(r.URL.Path, clusterPath(freezePath, cluster.APIVersion)) {
+			if err := freeze.Check(); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				return
+			}
+		}
+		fn(w, r)
+	}
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	clusterclient "github.com/libopenstorage/openstorage/api/client/cluster"
+	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/libopenstorage/openstorage/freeze"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFreeze(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m := freeze.NewManager(kv)
+	oldInst := freeze.Inst
+	freeze.Inst = func() (*freeze.Manager, error) {
+		return m, nil
+	}
+	defer func() {
+		freeze.Inst = oldInst
+	}()
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+
+	var s freeze.State
+	err = c.Post().Resource("cluster" + freezePath).Body(&FreezeRequest{Reason: "kvdb migration"}).Do().Unmarshal(&s)
+	require.NoError(t, err)
+	assert.True(t, s.Frozen)
+	assert.Equal(t, "kvdb migration", s.Reason)
+	assert.Equal(t, auth.Anonymous, s.Principal)
+
+	err = c.Get().Resource("cluster" + freezePath).Do().Unmarshal(&s)
+	require.NoError(t, err)
+	assert.True(t, s.Frozen)
+
+	handler := rejectWhenFrozen(func(w http.ResponseWriter, r *http.Request) {})
+	for _, test := range []struct {
+		method string
+		path   string
+		code   int
+	}{
+		{method: "POST", path: "/v1/osd-volumes", code: http.StatusServiceUnavailable},
+		{method: "DELETE", path: "/v1/osd-volumes/vol1", code: http.StatusServiceUnavailable},
+		{method: "GET", path: "/v1/osd-volumes", code: http.StatusOK},
+		{method: "POST", path: "/VolumeDriver.Get", code: http.StatusOK},
+		{method: "POST", path: clusterPath(freezePath+"/thaw", cluster.APIVersion), code: http.StatusOK},
+	} {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(test.method, test.path, nil))
+		assert.Equal(t, test.code, w.Code, "%s %s", test.method, test.path)
+		if test.code != http.StatusOK {
+			assert.Contains(t, w.Body.String(), "kvdb migration")
+		}
+	}
+
+	err = c.Post().Resource("cluster" + freezePath + "/thaw").Do().Unmarshal(&s)
+	require.NoError(t, err)
+	assert.False(t, s.Frozen)
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest("POST", "/v1/osd-volumes", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
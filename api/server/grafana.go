@@ -0,0 +1,157 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/libopenstorage/openstorage/statshistory"
+)
+
+// grafanaSearchRequest is the body of a Grafana JSON datasource search.
+type grafanaSearchRequest struct {
+	Target string `json:"target"`
+}
+
+// grafanaQueryRequest is the body of a Grafana JSON datasource query.
+type grafanaQueryRequest struct {
+	Range struct {
+		From time.Time `json:"from"`
+		To   time.Time `json:"to"`
+	} `json:"range"`
+	IntervalMs    int64 `json:"intervalMs"`
+	MaxDataPoints int64 `json:"maxDataPoints"`
+	Targets       []struct {
+		Target string `json:"target"`
+	} `json:"targets"`
+}
+
+// grafanaTimeSeries is a time series in a Grafana JSON datasource query
+// response. Each datapoint is a [value, unix time in ms] pair.
+type grafanaTimeSeries struct {
+	Target     string       `json:"target"`
+	Datapoints [][2]float64 `json:"datapoints"`
+}
+
+// swagger:operation GET /osd-grafana volume grafanaTest
+//
+// Test the Grafana JSON datasource connection.
+//
+// ---
+// responses:
+//   '200':
+//     description: datasource is available
+func (vd *volAPI) grafanaTest(w http.ResponseWriter, r *http.Request) {
+	method := "grafanaTest"
+
+	if _, err := statshistory.Inst(); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// swagger:operation POST /osd-grafana/search volume grafanaSearch
+//
+// List the time series available to a Grafana JSON datasource. Time series
+// are named <volume id>.<metric>.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: time series names
+//     schema:
+//       type: array
+//       items:
+//         type: string
+func (vd *volAPI) grafanaSearch(w http.ResponseWriter, r *http.Request) {
+	method := "grafanaSearch"
+
+	var req grafanaSearchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	store, err := statshistory.Inst()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	targets := make([]string, 0)
+	for _, id := range store.Volumes() {
+		for _, metric := range statshistory.Metrics {
+			target := id + "." + metric
+			if strings.Contains(target, req.Target) {
+				targets = append(targets, target)
+			}
+		}
+	}
+	json.NewEncoder(w).Encode(targets)
+}
+
+// swagger:operation POST /osd-grafana/query volume grafanaQuery
+//
+// Query downsampled volume time series for a Grafana JSON datasource.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: time series with [value, timestamp in ms] datapoints
+func (vd *volAPI) grafanaQuery(w http.ResponseWriter, r *http.Request) {
+	method := "grafanaQuery"
+
+	var req grafanaQueryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	store, err := statshistory.Inst()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	from, to := req.Range.From, req.Range.To
+	step := time.Duration(req.IntervalMs) * time.Millisecond
+	if req.MaxDataPoints > 0 {
+		if minStep := to.Sub(from) / time.Duration(req.MaxDataPoints); minStep > step {
+			step = minStep
+		}
+	}
+
+	resp := make([]grafanaTimeSeries, 0, len(req.Targets))
+	for _, t := range req.Targets {
+		i := strings.LastIndex(t.Target, ".")
+		if i < 0 {
+			vd.sendError(vd.name, method, w, "Invalid target "+t.Target, http.StatusBadRequest)
+			return
+		}
+		points, err := store.Query(t.Target[:i], t.Target[i+1:], from, to, step)
+		if err == statshistory.ErrUnknownMetric {
+			vd.sendError(vd.name, method, w, err.Error()+" "+t.Target[i+1:], http.StatusBadRequest)
+			return
+		} else if err != nil {
+			vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		series := grafanaTimeSeries{Target: t.Target, Datapoints: make([][2]float64, 0, len(points))}
+		for _, p := range points {
+			series.Datapoints = append(series.Datapoints,
+				[2]float64{p.Value, float64(p.Time.UnixNano() / int64(time.Millisecond))})
+		}
+		resp = append(resp, series)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	client "github.com/libopenstorage/openstorage/api/client/volume"
+	"github.com/libopenstorage/openstorage/statshistory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrafanaDatasource(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+	tm := newTestManagers(t)
+	defer tm.Finish()
+	store := tm.stats
+
+	start := time.Unix(1500000000, 0).UTC()
+	for i := 0; i < 10; i++ {
+		store.Add("vol1", start.Add(time.Duration(i)*time.Second), &api.Stats{
+			WriteBytes: uint64(i * 1000),
+		})
+	}
+
+	cl, err := client.NewDriverClient(ts.URL, mockDriverName, "", mockDriverName)
+	require.NoError(t, err)
+
+	resp := cl.Get().Resource(api.OsdGrafanaPath).Do()
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+
+	var targets []string
+	err = cl.Post().Resource(api.OsdGrafanaPath + "/search").
+		Body(&grafanaSearchRequest{Target: "bps"}).
+		Do().Unmarshal(&targets)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vol1." + statshistory.MetricReadBPS, "vol1." + statshistory.MetricWriteBPS}, targets)
+
+	req := &grafanaQueryRequest{IntervalMs: 1000, MaxDataPoints: 3}
+	req.Range.From = start
+	req.Range.To = start.Add(9 * time.Second)
+	req.Targets = append(req.Targets, struct {
+		Target string `json:"target"`
+	}{Target: "vol1." + statshistory.MetricWriteBPS})
+
+	var series []grafanaTimeSeries
+	err = cl.Post().Resource(api.OsdGrafanaPath + "/query").Body(req).Do().Unmarshal(&series)
+	require.NoError(t, err)
+	require.Len(t, series, 1)
+	assert.Equal(t, "vol1."+statshistory.MetricWriteBPS, series[0].Target)
+	// 9 rate points downsampled into buckets of 3 seconds
+	require.Len(t, series[0].Datapoints, 4)
+	assert.Equal(t, [2]float64{1000, float64(start.Unix() * 1000)}, series[0].Datapoints[0])
+}
+
+func TestGrafanaQueryUnknownMetric(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+	tm := newTestManagers(t)
+	defer tm.Finish()
+
+	cl, err := client.NewDriverClient(ts.URL, mockDriverName, "", mockDriverName)
+	require.NoError(t, err)
+
+	req := &grafanaQueryRequest{}
+	req.Targets = append(req.Targets, struct {
+		Target string `json:"target"`
+	}{Target: "vol1.latency"})
+	resp := cl.Post().Resource(api.OsdGrafanaPath + "/query").Body(req).Do()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+}
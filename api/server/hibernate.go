@@ -0,0 +1,91 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/hibernate"
+)
+
+// hibernatePath is the cluster route of the cluster suspend and resume
+const hibernatePath = "/hibernate"
+
+// swagger:operation GET /cluster/hibernate cluster hibernateStatus
+//
+// Get the state requested for the cluster and the volumes released by a
+// suspend and not yet restored.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: hibernation status
+//     schema:
+//       "$ref": "#/definitions/Status"
+func (c *clusterApi) hibernateStatus(w http.ResponseWriter, r *http.Request) {
+	method := "hibernateStatus"
+	m, err := hibernate.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	status, err := m.Status()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(status)
+}
+
+// swagger:operation POST /cluster/hibernate/suspend cluster hibernateSuspend
+//
+// Suspend the cluster. Every node unmounts and detaches its volumes and
+// keeps them released until the cluster is resumed, even across reboots.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: requested state
+//     schema:
+//       "$ref": "#/definitions/Intent"
+func (c *clusterApi) hibernateSuspend(w http.ResponseWriter, r *http.Request) {
+	c.setHibernateIntent("hibernateSuspend", w, (*hibernate.Manager).Suspend)
+}
+
+// swagger:operation POST /cluster/hibernate/resume cluster hibernateResume
+//
+// Resume the cluster. Every node attaches and mounts the volumes it
+// released in dependency order.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: requested state
+//     schema:
+//       "$ref": "#/definitions/Intent"
+func (c *clusterApi) hibernateResume(w http.ResponseWriter, r *http.Request) {
+	c.setHibernateIntent("hibernateResume", w, (*hibernate.Manager).Resume)
+}
+
+func (c *clusterApi) setHibernateIntent(method string, w http.ResponseWriter, set func(*hibernate.Manager) error) {
+	m, err := hibernate.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := set(m); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	intent, err := m.Intent()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(intent)
+}
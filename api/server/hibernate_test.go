@@ -0,0 +1,47 @@
+package server
+
+import (
+	"testing"
+
+	clusterclient "github.com/libopenstorage/openstorage/api/client/cluster"
+	"github.com/libopenstorage/openstorage/hibernate"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHibernate(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m := hibernate.NewManager(kv, "node1", nil)
+	oldInst := hibernate.Inst
+	hibernate.Inst = func() (*hibernate.Manager, error) {
+		return m, nil
+	}
+	defer func() {
+		hibernate.Inst = oldInst
+	}()
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+
+	var intent hibernate.Intent
+	err = c.Post().Resource("cluster" + hibernatePath + "/suspend").Do().Unmarshal(&intent)
+	require.NoError(t, err)
+	assert.Equal(t, hibernate.StateSuspended, intent.State)
+
+	var status hibernate.Status
+	err = c.Get().Resource("cluster" + hibernatePath).Do().Unmarshal(&status)
+	require.NoError(t, err)
+	assert.Equal(t, hibernate.StateSuspended, status.Intent.State)
+	assert.Empty(t, status.Records)
+
+	err = c.Post().Resource("cluster" + hibernatePath + "/resume").Do().Unmarshal(&intent)
+	require.NoError(t, err)
+	assert.Equal(t, hibernate.StateRunning, intent.State)
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/idempotency"
+)
+
+// responseRecorder keeps a copy of the response written by a handler.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(p []byte) (int, error) {
+	r.body.Write(p)
+	return r.ResponseWriter.Write(p)
+}
+
+// idempotent returns the stored response of a mutating request retried with
+// the same Idempotency-Key header, instead of running fn again. Responses
+// with a server error are not stored, so that a retry runs fn again.
+func idempotent(fn func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotency.Header)
+		store, err := idempotency.Inst()
+		if len(key) == 0 || err != nil || r.Method == http.MethodGet || r.Method == http.MethodHead {
+			fn(w, r)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		scope := r.Method + " " + r.URL.Path
+		o, err := store.Begin(r.Context(), scope, key, idempotency.Fingerprint([]byte(scope), []byte(r.URL.RawQuery), body))
+		switch err {
+		case nil:
+		case idempotency.ErrInProgress:
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		case idempotency.ErrMismatch:
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if o != nil {
+			if len(o.Type) != 0 {
+				w.Header().Set("Content-Type", o.Type)
+			}
+			w.WriteHeader(o.Code)
+			w.Write(o.Body)
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		fn(rec, r)
+		if rec.status >= http.StatusInternalServerError {
+			store.Abandon(r.Context(), scope, key)
+			return
+		}
+		store.Complete(r.Context(), scope, key, &idempotency.Outcome{
+			Code: rec.status,
+			Type: w.Header().Get("Content-Type"),
+			Body: rec.body.Bytes(),
+		})
+	}
+}
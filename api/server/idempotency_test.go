@@ -0,0 +1,60 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/idempotency"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, idempotency.Init(idempotency.New(kv, &idempotency.Config{})))
+
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(idempotent(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(body)
+	})))
+	defer ts.Close()
+
+	post := func(key, body string) (int, string) {
+		req, err := http.NewRequest("POST", ts.URL+"/v1/osd-volumes", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set(idempotency.Header, key)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		data, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(data)
+	}
+
+	// retries return the first response
+	for i := 0; i < 2; i++ {
+		code, body := post("key1", "vol1")
+		assert.Equal(t, http.StatusCreated, code)
+		assert.Equal(t, "vol1", body)
+	}
+	assert.Equal(t, 1, calls)
+
+	// the key cannot be reused for another request
+	code, _ := post("key1", "vol2")
+	assert.Equal(t, http.StatusUnprocessableEntity, code)
+
+	code, body := post("key2", "vol2")
+	assert.Equal(t, http.StatusCreated, code)
+	assert.Equal(t, "vol2", body)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,438 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/libopenstorage/openstorage/api"
+	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
+	"github.com/libopenstorage/openstorage/jobs"
+	"github.com/libopenstorage/openstorage/leader"
+	"github.com/libopenstorage/openstorage/volume"
+	volumedrivers "github.com/libopenstorage/openstorage/volume/drivers"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// rotateKeyJobType is the job type used for volume key rotations
+	rotateKeyJobType = "rotatekey"
+	// keyRotationCheckInterval is how often the key rotation policy is evaluated
+	keyRotationCheckInterval = time.Hour
+	// keyRotationKvdbKey is the kvdb prefix of the last rotation times of
+	// the volume keys and of the locks taken to rotate them
+	keyRotationKvdbKey = "keyrotation"
+)
+
+// errKvdbNotInitialized is returned when the key rotation times cannot be
+// kept for lack of kvdb.
+var errKvdbNotInitialized = errors.New("KVDB is not yet initialized")
+
+// swagger:operation POST /osd-volumes/rotatekey/{id} volume rotateKey
+//
+// Rotate the key encryption key of an encrypted volume.
+// The rotation runs asynchronously and is tracked as a job.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the volume
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: job tracking the key rotation
+//     schema:
+//       "$ref": "#/definitions/Job"
+func (vd *volAPI) rotateKey(w http.ResponseWriter, r *http.Request) {
+	var volumeID string
+	var err error
+	method := "rotateKey"
+
+	if volumeID, err = vd.parseID(r); err != nil {
+		e := fmt.Errorf("Failed to parse parse volumeID: %s", err.Error())
+		vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	d, err := vd.getVolDriver(r)
+	if err != nil {
+		notFound(w, r)
+		return
+	}
+
+	job, err := submitRotateKey(r.Context(), d, volumeID)
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(job)
+}
+
+// swagger:operation GET /osd-jobs/{id} volume inspectJob
+//
+// Inspect the job with specified id.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the job
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: job
+//     schema:
+//       "$ref": "#/definitions/Job"
+func (vd *volAPI) jobInspect(w http.ResponseWriter, r *http.Request) {
+	var jobID string
+	var err error
+	method := "jobInspect"
+
+	if jobID, err = vd.parseID(r); err != nil {
+		e := fmt.Errorf("Failed to parse parse jobID: %s", err.Error())
+		vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	jm, err := jobs.Inst()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	job, err := jm.Inspect(jobID)
+	if err == jobs.ErrNotFound {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(job)
+}
+
+// swagger:operation GET /osd-jobs volume enumerateJobs
+//
+// Enumerate all jobs.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: an array of jobs
+//     schema:
+//       type: array
+//       items:
+//         $ref: '#/definitions/Job'
+func (vd *volAPI) jobEnumerate(w http.ResponseWriter, r *http.Request) {
+	method := "jobEnumerate"
+
+	jm, err := jobs.Inst()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jobList, err := jm.Enumerate()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(jobList)
+}
+
+// swagger:operation POST /osd-jobs/{id}/cancel volume cancelJob
+//
+// Cancel a job. A pending job never runs, a running one is canceled once it
+// returns.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the job
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: job
+//     schema:
+//       "$ref": "#/definitions/Job"
+func (vd *volAPI) jobCancel(w http.ResponseWriter, r *http.Request) {
+	var jobID string
+	var err error
+	method := "jobCancel"
+
+	if jobID, err = vd.parseID(r); err != nil {
+		e := fmt.Errorf("Failed to parse parse jobID: %s", err.Error())
+		vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	jm, err := jobs.Inst()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := jm.Cancel(jobID); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), jobErrorStatus(err))
+		return
+	}
+	job, err := jm.Inspect(jobID)
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), jobErrorStatus(err))
+		return
+	}
+	json.NewEncoder(w).Encode(job)
+}
+
+// swagger:operation POST /osd-jobs/{id}/requeue volume requeueJob
+//
+// Run a completed job again, such as a failed or canceled one.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the job
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: job
+//     schema:
+//       "$ref": "#/definitions/Job"
+func (vd *volAPI) jobRequeue(w http.ResponseWriter, r *http.Request) {
+	var jobID string
+	var err error
+	method := "jobRequeue"
+
+	if jobID, err = vd.parseID(r); err != nil {
+		e := fmt.Errorf("Failed to parse parse jobID: %s", err.Error())
+		vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	jm, err := jobs.Inst()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	job, err := jm.Requeue(jobID)
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), jobErrorStatus(err))
+		return
+	}
+	json.NewEncoder(w).Encode(job)
+}
+
+// jobErrorStatus returns the HTTP status of an error of the jobs manager.
+func jobErrorStatus(err error) int {
+	switch err {
+	case jobs.ErrNotFound:
+		return http.StatusNotFound
+	case jobs.ErrDone, jobs.ErrNotDone, jobs.ErrNotLocal:
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+func submitRotateKey(ctx context.Context, d volume.VolumeDriver, volumeID string) (*jobs.Job, error) {
+	jm, err := jobs.Inst()
+	if err != nil {
+		return nil, err
+	}
+	return jm.SubmitWithContext(ctx, rotateKeyJobType, volumeID, func(context.Context) error {
+		if err := d.RotateKey(volumeID); err != nil {
+			return err
+		}
+		return setKeyRotated(volumeID, time.Now())
+	})
+}
+
+// keyRotatedKey returns the kvdb key of the time the key of volumeID was
+// last rotated.
+func keyRotatedKey(volumeID string) string {
+	return path.Join(keyRotationKvdbKey, "volumes", volumeID)
+}
+
+// setKeyRotated records that the key of volumeID was rotated at t. Unlike
+// the rotation jobs, the record is not pruned by the jobs retention.
+func setKeyRotated(volumeID string, t time.Time) error {
+	kv := kvdb.Instance()
+	if kv == nil {
+		return errKvdbNotInitialized
+	}
+	_, err := kv.Put(keyRotatedKey(volumeID), t, 0)
+	return err
+}
+
+// keyRotated returns the time the key of volumeID was last rotated, false
+// if it never was.
+func keyRotated(kv kvdb.Kvdb, volumeID string) (time.Time, bool, error) {
+	var t time.Time
+	_, err := kv.GetVal(keyRotatedKey(volumeID), &t)
+	if err == kvdb.ErrNotFound {
+		return t, false, nil
+	} else if err != nil {
+		return t, false, err
+	}
+	return t, true, nil
+}
+
+// StartKeyRotationPolicy starts a worker that periodically rotates the keys
+// of the encrypted volumes owned by the named driver, as configured by the
+// cluster wide key rotation policy.
+func StartKeyRotationPolicy(driverName string) error {
+	d, err := volumedrivers.Get(driverName)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for range time.Tick(keyRotationCheckInterval) {
+			if err := applyKeyRotationPolicy(d, time.Now()); err != nil {
+				logrus.Warnf("Key rotation policy for driver %s failed: %v",
+					driverName, err)
+			}
+		}
+	}()
+	return nil
+}
+
+// applyKeyRotationPolicy submits a rotation of the keys of the encrypted
+// volumes not rotated for the interval of the policy. The policy is applied
+// by the active API server only if the election is enabled, and under a
+// kvdb lock per volume so that nodes applying it concurrently do not rotate
+// a volume twice.
+func applyKeyRotationPolicy(d volume.VolumeDriver, now time.Time) error {
+	if e, err := leader.Inst(); err == nil && !e.IsLeader() {
+		return nil
+	}
+	kv := kvdb.Instance()
+	if kv == nil {
+		return errKvdbNotInitialized
+	}
+
+	cm, err := clustermanager.Inst()
+	if err != nil {
+		return err
+	}
+	conf, err := cm.GetClusterConf()
+	if err != nil {
+		return err
+	}
+	if conf.Secrets == nil || conf.Secrets.KeyRotation == nil ||
+		!conf.Secrets.KeyRotation.Enabled ||
+		conf.Secrets.KeyRotation.IntervalDays == 0 {
+		return nil
+	}
+	interval := time.Duration(conf.Secrets.KeyRotation.IntervalDays) * 24 * time.Hour
+
+	vols, err := d.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		return err
+	}
+	exists := make(map[string]bool)
+	for _, v := range vols {
+		exists[v.GetId()] = true
+		if !v.GetSpec().GetEncrypted() {
+			continue
+		}
+		if err := rotateKeyIfDue(kv, d, v, interval, now); err != nil {
+			return err
+		}
+	}
+	return pruneKeyRotated(kv, exists)
+}
+
+// rotateKeyIfDue submits a rotation of the key of v if it was not rotated,
+// or created, for interval and no rotation of it is pending or running.
+func rotateKeyIfDue(
+	kv kvdb.Kvdb,
+	d volume.VolumeDriver,
+	v *api.Volume,
+	interval time.Duration,
+	now time.Time,
+) error {
+	last, ok, err := keyRotated(kv, v.GetId())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if last, err = ptypes.Timestamp(v.GetCtime()); err != nil {
+			last = time.Time{}
+		}
+	}
+	if now.Sub(last) < interval {
+		return nil
+	}
+
+	lock, err := kv.Lock(path.Join(keyRotationKvdbKey, "locks", v.GetId()))
+	if err != nil {
+		return err
+	}
+	defer kv.Unlock(lock)
+
+	// Another node may have rotated the key, or submitted its rotation,
+	// since it was checked.
+	if last, ok, err = keyRotated(kv, v.GetId()); err != nil {
+		return err
+	} else if ok && now.Sub(last) < interval {
+		return nil
+	}
+	jm, err := jobs.Inst()
+	if err != nil {
+		return err
+	}
+	jobList, err := jm.Enumerate()
+	if err != nil {
+		return err
+	}
+	for _, job := range jobList {
+		if job.Type == rotateKeyJobType && job.ResourceId == v.GetId() &&
+			!job.State.Done() {
+			return nil
+		}
+	}
+	_, err = submitRotateKey(context.Background(), d, v.GetId())
+	return err
+}
+
+// pruneKeyRotated deletes the rotation times of the volumes which no longer
+// exist.
+func pruneKeyRotated(kv kvdb.Kvdb, exists map[string]bool) error {
+	kvps, err := kv.Enumerate(path.Join(keyRotationKvdbKey, "volumes"))
+	if err != nil {
+		return err
+	}
+	for _, kvp := range kvps {
+		if volumeID := path.Base(kvp.Key); !exists[volumeID] {
+			if _, err := kv.Delete(keyRotatedKey(volumeID)); err != nil &&
+				err != kvdb.ErrNotFound {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/libopenstorage/openstorage/api"
+	client "github.com/libopenstorage/openstorage/api/client/volume"
+	"github.com/libopenstorage/openstorage/jobs"
+	"github.com/libopenstorage/openstorage/osdconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKey(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+	tm := newTestManagers(t)
+	defer tm.Finish()
+	jm := tm.jobs
+	setupTestKvdb(t)
+
+	cl, err := client.NewDriverClient(ts.URL, mockDriverName, "", mockDriverName)
+	require.NoError(t, err)
+
+	testVolDriver.MockDriver().EXPECT().RotateKey("goodVolumeID").Return(nil).Times(1)
+	err = client.VolumeDriver(cl).RotateKey("goodVolumeID")
+	require.NoError(t, err)
+
+	jobList, err := jm.Enumerate()
+	require.NoError(t, err)
+	require.Len(t, jobList, 1)
+	require.Equal(t, rotateKeyJobType, jobList[0].Type)
+	require.Equal(t, "goodVolumeID", jobList[0].ResourceId)
+	require.Equal(t, jobs.StateDone, jobList[0].State)
+}
+
+func TestRotateKeyFailed(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+	tm := newTestManagers(t)
+	defer tm.Finish()
+	setupTestKvdb(t)
+
+	cl, err := client.NewDriverClient(ts.URL, mockDriverName, "", mockDriverName)
+	require.NoError(t, err)
+
+	testVolDriver.MockDriver().EXPECT().RotateKey("badVolumeID").Return(fmt.Errorf("Volume is not encrypted")).Times(1)
+	err = client.VolumeDriver(cl).RotateKey("badVolumeID")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Volume is not encrypted")
+}
+
+func TestKeyRotationPolicy(t *testing.T) {
+	tc := newTestCluster(t)
+	defer tc.Finish()
+	testVolDriver := newTestServer(t)
+	defer testVolDriver.Stop()
+	tm := newTestManagers(t)
+	defer tm.Finish()
+	jm := tm.jobs
+	kv := setupTestKvdb(t)
+
+	now := time.Now()
+	ctime, err := ptypes.TimestampProto(now.Add(-48 * time.Hour))
+	require.NoError(t, err)
+	vols := []*api.Volume{
+		{Id: "policyVol", Ctime: ctime, Spec: &api.VolumeSpec{Encrypted: true}},
+		{Id: "plainVol", Ctime: ctime, Spec: &api.VolumeSpec{}},
+	}
+	tc.MockCluster().EXPECT().GetClusterConf().Return(&osdconfig.ClusterConfig{
+		Secrets: &osdconfig.SecretsConfig{
+			KeyRotation: &osdconfig.KeyRotationConfig{Enabled: true, IntervalDays: 1},
+		},
+	}, nil).AnyTimes()
+	testVolDriver.MockDriver().EXPECT().Enumerate(gomock.Any(), gomock.Any()).
+		Return(vols, nil).Times(2)
+	testVolDriver.MockDriver().EXPECT().RotateKey("policyVol").Return(nil).Times(1)
+
+	require.NoError(t, applyKeyRotationPolicy(testVolDriver.MockDriver(), now))
+	jobList, err := jm.Enumerate()
+	require.NoError(t, err)
+	require.Len(t, jobList, 1)
+	job := jobList[0]
+	for !job.State.Done() {
+		time.Sleep(10 * time.Millisecond)
+		job, err = jm.Inspect(job.Id)
+		require.NoError(t, err)
+	}
+	require.Equal(t, jobs.StateDone, job.State)
+	rotated, ok, err := keyRotated(kv, "policyVol")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.False(t, rotated.Before(now))
+
+	// The rotation time outlives the rotation jobs.
+	fresh := newTestManagers(t)
+	defer fresh.Finish()
+	require.NoError(t, applyKeyRotationPolicy(testVolDriver.MockDriver(), now.Add(time.Hour)))
+
+	// The rotation times of deleted volumes are pruned.
+	vols = vols[1:]
+	testVolDriver.MockDriver().EXPECT().Enumerate(gomock.Any(), gomock.Any()).
+		Return(vols, nil).Times(1)
+	require.NoError(t, applyKeyRotationPolicy(testVolDriver.MockDriver(), now.Add(time.Hour)))
+	_, ok, err = keyRotated(kv, "policyVol")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestJobCancelRequeue(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+	tm := newTestManagers(t)
+	defer tm.Finish()
+	jm := tm.jobs
+
+	job, err := jm.Submit("test", "vol1", func() error { return fmt.Errorf("boom") })
+	require.NoError(t, err)
+	for !job.State.Done() {
+		time.Sleep(10 * time.Millisecond)
+		job, err = jm.Inspect(job.Id)
+		require.NoError(t, err)
+	}
+
+	post := func(path string) *http.Response {
+		resp, err := http.Post(ts.URL+"/v1/"+api.OsdJobsPath+path, "application/json", nil)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+	require.Equal(t, http.StatusOK, post("/"+job.Id+"/requeue").StatusCode)
+	for {
+		job, err = jm.Inspect(job.Id)
+		require.NoError(t, err)
+		if job.State.Done() {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, jobs.StateFailed, job.State)
+	require.Equal(t, http.StatusConflict, post("/"+job.Id+"/cancel").StatusCode)
+	require.Equal(t, http.StatusNotFound, post("/nope/cancel").StatusCode)
+	require.Equal(t, http.StatusNotFound, post("/nope/requeue").StatusCode)
+}
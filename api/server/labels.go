@@ -0,0 +1,102 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/pkg/keylock"
+	"github.com/libopenstorage/openstorage/volume"
+)
+
+// labelsLock serializes the label patches of a volume, read and applied
+// with separate driver calls
+var labelsLock = keylock.ByName("volume-labels")
+
+// swagger:operation PUT /osd-volumes/labels/{id} volume patchVolumeLabels
+//
+// Patch the labels of a volume on behalf of a manager. The labels set are
+// owned by the manager, the labels owned by other managers are only changed
+// if the patch is forced. With IfAbsent, only the missing labels are set.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the volume
+//   required: true
+//   type: string
+// - name: patch
+//   in: body
+//   description: labels to set and remove
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/LabelPatch"
+// responses:
+//   '200':
+//     description: new labels of the volume
+//     schema:
+//       type: object
+//       additionalProperties:
+//         type: string
+//   '409':
+//     description: a label is owned by another manager
+func (vd *volAPI) patchLabels(w http.ResponseWriter, r *http.Request) {
+	method := "patchLabels"
+
+	volumeID, err := vd.parseID(r)
+	if err != nil {
+		e := fmt.Errorf("Failed to parse parse volumeID: %s", err.Error())
+		vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var patch volume.LabelPatch
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := patch.Validate(); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	d, err := vd.getVolDriver(r)
+	if err != nil {
+		notFound(w, r)
+		return
+	}
+
+	h := labelsLock.Acquire(volumeID)
+	defer labelsLock.Release(&h)
+
+	vols, err := d.Inspect([]string{volumeID})
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	} else if len(vols) == 0 {
+		vd.sendError(vd.name, method, w, "Volume not found", http.StatusNotFound)
+		return
+	}
+
+	locator, labels, err := volume.PatchLabels(vols[0], &patch)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(*volume.ErrLabelConflict); ok {
+			status = http.StatusConflict
+		}
+		vd.sendError(vd.name, method, w, err.Error(), status)
+		return
+	}
+	if len(locator.VolumeLabels) != 0 {
+		if err := d.Set(volumeID, locator, nil); err != nil {
+			vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	json.NewEncoder(w).Encode(labels)
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	client "github.com/libopenstorage/openstorage/api/client/volume"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchLabels(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	cl, err := client.NewDriverClient(ts.URL, mockDriverName, "", mockDriverName)
+	require.NoError(t, err)
+
+	vol := &api.Volume{
+		Id: "myvol",
+		Locator: &api.VolumeLocator{VolumeLabels: map[string]string{
+			"app":             "db",
+			"tier":            "gold",
+			api.LabelManagers: `{"tier":"ctrl-a"}`,
+		}},
+	}
+	patch := func(p *volume.LabelPatch) (map[string]string, error) {
+		labels := make(map[string]string)
+		err := cl.Put().Resource("osd-volumes/labels").Instance("myvol").Body(p).Do().Unmarshal(&labels)
+		return labels, err
+	}
+
+	// Unmanaged labels are taken over, the labels of the manager are changed
+	testVolDriver.MockDriver().EXPECT().Inspect([]string{"myvol"}).Return([]*api.Volume{vol}, nil).Times(1)
+	testVolDriver.MockDriver().EXPECT().Set("myvol", &api.VolumeLocator{VolumeLabels: map[string]string{
+		"app":             "web",
+		"tier":            "",
+		api.LabelManagers: `{"app":"ctrl-a"}`,
+	}}, nil).Return(nil).Times(1)
+	labels, err := patch(&volume.LabelPatch{
+		Manager: "ctrl-a",
+		Set:     map[string]string{"app": "web"},
+		Remove:  []string{"tier"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "web", api.LabelManagers: `{"app":"ctrl-a"}`}, labels)
+
+	// The labels of another manager conflict
+	testVolDriver.MockDriver().EXPECT().Inspect([]string{"myvol"}).Return([]*api.Volume{vol}, nil).Times(1)
+	_, err = patch(&volume.LabelPatch{
+		Manager: "ctrl-b",
+		Set:     map[string]string{"tier": "silver"},
+	})
+	assert.Error(t, err)
+
+	// Unless absent labels only are set
+	testVolDriver.MockDriver().EXPECT().Inspect([]string{"myvol"}).Return([]*api.Volume{vol}, nil).Times(1)
+	testVolDriver.MockDriver().EXPECT().Set("myvol", &api.VolumeLocator{VolumeLabels: map[string]string{
+		"zone":            "east",
+		api.LabelManagers: `{"tier":"ctrl-a","zone":"ctrl-b"}`,
+	}}, nil).Return(nil).Times(1)
+	labels, err = patch(&volume.LabelPatch{
+		Manager:  "ctrl-b",
+		Set:      map[string]string{"tier": "silver", "zone": "east"},
+		IfAbsent: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "gold", labels["tier"])
+	assert.Equal(t, "east", labels["zone"])
+
+	// Or the patch is forced
+	testVolDriver.MockDriver().EXPECT().Inspect([]string{"myvol"}).Return([]*api.Volume{vol}, nil).Times(1)
+	testVolDriver.MockDriver().EXPECT().Set("myvol", &api.VolumeLocator{VolumeLabels: map[string]string{
+		"tier":            "silver",
+		api.LabelManagers: `{"tier":"ctrl-b"}`,
+	}}, nil).Return(nil).Times(1)
+	_, err = patch(&volume.LabelPatch{
+		Manager: "ctrl-b",
+		Set:     map[string]string{"tier": "silver"},
+		Force:   true,
+	})
+	require.NoError(t, err)
+
+	// Invalid patches do not reach the driver
+	_, err = patch(&volume.LabelPatch{Set: map[string]string{"app": "web"}})
+	assert.Error(t, err)
+	_, err = patch(&volume.LabelPatch{Manager: "ctrl-a", Set: map[string]string{api.LabelManagers: "{}"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
+	"github.com/libopenstorage/openstorage/nodelabels"
+)
+
+// nodeLabelsPath is the cluster route of the node labels
+const nodeLabelsPath = "/nodelabels"
+
+// swagger:operation GET /cluster/nodelabels cluster enumerateNodeLabels
+//
+// Enumerate the labels set on the nodes through the node labels API.
+//
+// ---
+// produces:
+// - application/json
+// responses:
+//   '200':
+//     description: labels by node id
+//     schema:
+//       type: object
+//       additionalProperties:
+//         type: object
+//         additionalProperties:
+//           type: string
+func (c *clusterApi) enumerateNodeLabels(w http.ResponseWriter, r *http.Request) {
+	method := "enumerateNodeLabels"
+	m, err := nodelabels.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	all, err := m.Enumerate()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(all)
+}
+
+// swagger:operation GET /cluster/nodelabels/{id} cluster getNodeLabels
+//
+// Get the labels set on a node through the node labels API.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the node
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: labels of the node
+//     schema:
+//       type: object
+//       additionalProperties:
+//         type: string
+func (c *clusterApi) getNodeLabels(w http.ResponseWriter, r *http.Request) {
+	method := "getNodeLabels"
+	m, err := nodelabels.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	labels, err := m.Get(mux.Vars(r)["id"])
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(labels)
+}
+
+// swagger:operation PUT /cluster/nodelabels/{id} cluster updateNodeLabels
+//
+// Set and remove labels of a node. The placement of the existing volumes
+// is revalidated when the labels change.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the node
+//   required: true
+//   type: string
+// - name: update
+//   in: body
+//   description: labels to set and remove
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Update"
+// responses:
+//   '200':
+//     description: new labels of the node
+//     schema:
+//       type: object
+//       additionalProperties:
+//         type: string
+func (c *clusterApi) updateNodeLabels(w http.ResponseWriter, r *http.Request) {
+	method := "updateNodeLabels"
+
+	var u nodelabels.Update
+	if err := json.NewDecoder(r.Body).Decode(&u); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := u.Validate(); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	m, err := nodelabels.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	labels, err := m.Update(mux.Vars(r)["id"], &u)
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(labels)
+}
+
+// swagger:operation POST /cluster/nodelabels/filter cluster filterNodes
+//
+// Filter the candidate nodes of a scheduler extender by label selector.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: request
+//   in: body
+//   description: candidate nodes and selector
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/FilterRequest"
+// responses:
+//   '200':
+//     description: candidate nodes satisfying the selector
+//     schema:
+//       "$ref": "#/definitions/FilterResponse"
+func (c *clusterApi) filterNodes(w http.ResponseWriter, r *http.Request) {
+	method := "filterNodes"
+
+	var req nodelabels.FilterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	inst, err := clustermanager.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	cluster, err := inst.Enumerate()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	m, err := nodelabels.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	nodes, err := m.Apply(cluster.Nodes)
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(nodelabels.Filter(nodes, &req))
+}
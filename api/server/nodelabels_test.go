@@ -0,0 +1,84 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	clusterclient "github.com/libopenstorage/openstorage/api/client/cluster"
+	"github.com/libopenstorage/openstorage/nodelabels"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestNodeLabels(t *testing.T) func() {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m := nodelabels.NewManager(kv)
+
+	oldInst := nodelabels.Inst
+	nodelabels.Inst = func() (nodelabels.Manager, error) {
+		return m, nil
+	}
+	return func() {
+		nodelabels.Inst = oldInst
+	}
+}
+
+func TestNodeLabels(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+	defer newTestNodeLabels(t)()
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+
+	var labels map[string]string
+	err = c.Put().Resource("cluster" + nodeLabelsPath).Instance("node1").Body(&nodelabels.Update{
+		Set: map[string]string{"rack": "r1", "diskclass": "ssd"},
+	}).Do().Unmarshal(&labels)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"rack": "r1", "diskclass": "ssd"}, labels)
+
+	labels = nil
+	err = c.Get().Resource("cluster" + nodeLabelsPath).Instance("node1").Do().Unmarshal(&labels)
+	require.NoError(t, err)
+	assert.Equal(t, "ssd", labels["diskclass"])
+
+	var all map[string]map[string]string
+	err = c.Get().Resource("cluster" + nodeLabelsPath).Do().Unmarshal(&all)
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+
+	resp := c.Put().Resource("cluster" + nodeLabelsPath).Instance("node1").Body(&nodelabels.Update{
+		Set: map[string]string{"bad key": "v"},
+	}).Do()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+
+	tc.MockCluster().
+		EXPECT().
+		Enumerate().
+		Return(api.Cluster{
+			Id: "cluster-dummy-id",
+			Nodes: []api.Node{
+				{Id: "node1", Hostname: "host1"},
+				{Id: "node2", Hostname: "host2"},
+			},
+		}, nil)
+
+	var filtered nodelabels.FilterResponse
+	err = c.Post().Resource("cluster" + nodeLabelsPath + "/filter").Body(&nodelabels.FilterRequest{
+		NodeNames: []string{"host1", "host2"},
+		Selector: []*api.LabelSelectorRequirement{{
+			Key:      "diskclass",
+			Operator: api.LabelSelectorRequirement_In,
+			Values:   []string{"ssd"},
+		}},
+	}).Do().Unmarshal(&filtered)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host1"}, filtered.NodeNames)
+	assert.Contains(t, filtered.FailedNodes, "host2")
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
+	"github.com/libopenstorage/openstorage/nodelabels"
+	"github.com/libopenstorage/openstorage/planner"
+)
+
+// planPath is the cluster route of the capacity planner
+const planPath = "/plan"
+
+// swagger:operation POST /cluster/plan cluster planCapacity
+//
+// Simulate the placement of hypothetical volume workloads on the cluster.
+// No volumes are created, the plan reports whether and where they would fit.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: request
+//   in: body
+//   description: workloads to place and nodes to add
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Request"
+// responses:
+//   '200':
+//     description: capacity plan
+//     schema:
+//       "$ref": "#/definitions/Plan"
+func (c *clusterApi) planCapacity(w http.ResponseWriter, r *http.Request) {
+	method := "planCapacity"
+
+	var req planner.Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	inst, err := clustermanager.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	cluster, err := inst.Enumerate()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	nodes := cluster.Nodes
+	if labels, err := nodelabels.Inst(); err == nil {
+		if nodes, err = labels.Apply(nodes); err != nil {
+			c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	plan, err := planner.Simulate(nodes, &req)
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	json.NewEncoder(w).Encode(plan)
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	clusterclient "github.com/libopenstorage/openstorage/api/client/cluster"
+	"github.com/libopenstorage/openstorage/planner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanCapacity(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+
+	tc.MockCluster().
+		EXPECT().
+		Enumerate().
+		Return(api.Cluster{
+			Id: "cluster-dummy-id",
+			Nodes: []api.Node{
+				{Id: "1", Status: api.Status_STATUS_OK, Pools: []api.StoragePool{{TotalSize: 1000}}},
+				{Id: "2", Status: api.Status_STATUS_OK, Pools: []api.StoragePool{{TotalSize: 1000}}},
+			},
+		}, nil)
+
+	var plan planner.Plan
+	err = c.Post().Resource("cluster" + planPath).Body(&planner.Request{
+		Workloads: []planner.Workload{{Name: "db", Count: 20, Size: 100, HaLevel: 2}},
+	}).Do().Unmarshal(&plan)
+	require.NoError(t, err)
+	assert.False(t, plan.Fits)
+	assert.Len(t, plan.Placements, 10)
+	require.Len(t, plan.Unplaced, 1)
+	assert.Equal(t, 10, plan.Unplaced[0].Count)
+}
+
+func TestPlanCapacityInvalid(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+
+	resp := c.Post().Resource("cluster" + planPath).Body(&planner.Request{
+		Workloads: []planner.Workload{{Name: "db", Size: 100}},
+	}).Do()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+}
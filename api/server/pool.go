@@ -0,0 +1,86 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /osd-volumes/poolexpand/{id} volume poolExpand
+//
+// Add a device to a storage pool of this node, optionally rebalancing the
+// existing data onto it. The drivers that support rebalancing may complete
+// it in the background.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the pool
+//   required: true
+//   type: integer
+// - name: request
+//   in: body
+//   description: device to add
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/PoolExpandRequest"
+// responses:
+//   '200':
+//     description: pool with its new capacity
+//     schema:
+//       "$ref": "#/definitions/StoragePool"
+func (vd *volAPI) poolExpand(w http.ResponseWriter, r *http.Request) {
+	method := "poolExpand"
+
+	id, err := vd.parseID(r)
+	if err != nil {
+		e := fmt.Errorf("Failed to parse poolID: %s", err.Error())
+		vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
+		return
+	}
+	poolID, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		vd.sendError(vd.name, method, w, "Pool id must be int", http.StatusBadRequest)
+		return
+	}
+
+	var req api.PoolExpandRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	d, err := vd.getVolDriver(r)
+	if err != nil {
+		notFound(w, r)
+		return
+	}
+
+	pool, err := d.PoolExpand(int32(poolID), &req)
+	if err == volume.ErrNotSupported {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusNotImplemented)
+		return
+	} else if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	logrus.Infof("Expanded pool %d with device %s, capacity is now %d bytes",
+		pool.ID, req.Device, pool.TotalSize)
+	eventbus.PublishContext(r.Context(), eventbus.EventPoolExpand, id, pool)
+	json.NewEncoder(w).Encode(pool)
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	client "github.com/libopenstorage/openstorage/api/client/volume"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolExpand(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	cl, err := client.NewDriverClient(ts.URL, mockDriverName, "", mockDriverName)
+	require.NoError(t, err)
+
+	req := &api.PoolExpandRequest{Device: "/dev/sdb", Rebalance: true}
+	testVolDriver.MockDriver().EXPECT().
+		PoolExpand(int32(1), req).
+		Return(&api.StoragePool{ID: 1, TotalSize: 2000}, nil).
+		Times(1)
+	pool, err := client.VolumeDriver(cl).PoolExpand(1, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), pool.ID)
+	assert.Equal(t, uint64(2000), pool.TotalSize)
+
+	testVolDriver.MockDriver().EXPECT().
+		PoolExpand(int32(2), req).
+		Return(nil, volume.ErrNotSupported).
+		Times(1)
+	_, err = client.VolumeDriver(cl).PoolExpand(2, req)
+	assert.Error(t, err)
+
+	// Invalid requests do not reach the driver
+	_, err = client.VolumeDriver(cl).PoolExpand(1, &api.PoolExpandRequest{Device: "sdb"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/pkg/auth"
+)
+
+// authenticated sets the principal of the requests received with TLS in
+// their context, the one of their client certificate. The principal of the
+// requests received on a unix socket is set by auth.ConnContext.
+// The requests forwarded by a standby are made with its node certificate on
+// behalf of its client, whose principal the standby forwards. The
+// certificates of the cluster CA are node certificates, so the principal
+// forwarded is trusted from the requests authenticated by one only.
+func authenticated(fn func(http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if principal := auth.FromTLS(r.TLS); len(principal) != 0 {
+			if forwarded := r.Header.Get(principalHeader); len(forwarded) != 0 &&
+				len(r.Header.Get(proxiedHeader)) != 0 {
+				principal = forwarded
+			}
+			r = r.WithContext(auth.NewContext(r.Context(), principal))
+		}
+		fn(w, r)
+	}
+}
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/pkg/redact"
+)
+
+// redacted removes the secrets, such as the passphrases of the volume
+// specs, from the JSON responses.
+func redacted(fn func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		buf := &bufferedResponse{header: w.Header(), status: http.StatusOK}
+		fn(buf, r)
+		body := buf.body.Bytes()
+		if redactedBody := redact.JSON(body); !bytes.Equal(redactedBody, body) {
+			w.Header().Del("Content-Length")
+			body = redactedBody
+		}
+		w.WriteHeader(buf.status)
+		w.Write(body)
+	}
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedacted(t *testing.T) {
+	vol := &api.Volume{Id: "vol1", Spec: &api.VolumeSpec{Passphrase: "hunter2"}}
+	ts := httptest.NewServer(http.HandlerFunc(redacted(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]*api.Volume{vol})
+	})))
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/v1/osd-volumes")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var vols []*api.Volume
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&vols))
+	require.Len(t, vols, 1)
+	assert.Equal(t, "vol1", vols[0].Id)
+	assert.Empty(t, vols[0].Spec.Passphrase)
+
+	// the volume of the driver keeps its passphrase
+	assert.Equal(t, "hunter2", vol.Spec.Passphrase)
+}
@@ -52,5 +52,32 @@ func (c *clusterApi) Routes() []*Route {
 		{verb: "PUT", path: clusterPath(client.PairPath+"/{id}", cluster.APIVersion), fn: c.refreshPair},
 		{verb: "DELETE", path: clusterPath(client.PairPath+"/{id}", cluster.APIVersion), fn: c.deletePair},
 		{verb: "GET", path: clusterPath(client.PairTokenPath, cluster.APIVersion), fn: c.getPairToken},
+		{verb: "POST", path: clusterPath(planPath, cluster.APIVersion), fn: c.planCapacity},
+		{verb: "GET", path: clusterPath("/kvdbhealth", cluster.APIVersion), fn: c.kvdbHealth},
+		{verb: "GET", path: clusterPath("/preflight", cluster.APIVersion), fn: c.preflight},
+		{verb: "GET", path: clusterPath("/metrics", cluster.APIVersion), fn: c.metrics},
+		{verb: "POST", path: clusterPath(caPath+"/tokens", cluster.APIVersion), fn: c.caCreateToken},
+		{verb: "POST", path: clusterPath(caEnrollPath, cluster.APIVersion), fn: c.caEnroll},
+		{verb: "GET", path: clusterPath(caPath+"/certs", cluster.APIVersion), fn: c.caEnumerateCerts},
+		{verb: "DELETE", path: clusterPath(caPath+"/certs/{serial}", cluster.APIVersion), fn: c.caRevokeCert},
+		{verb: "GET", path: clusterPath(nodeLabelsPath, cluster.APIVersion), fn: c.enumerateNodeLabels},
+		{verb: "POST", path: clusterPath(nodeLabelsPath+"/filter", cluster.APIVersion), fn: c.filterNodes},
+		{verb: "GET", path: clusterPath(nodeLabelsPath+"/{id}", cluster.APIVersion), fn: c.getNodeLabels},
+		{verb: "PUT", path: clusterPath(nodeLabelsPath+"/{id}", cluster.APIVersion), fn: c.updateNodeLabels},
+		{verb: "GET", path: clusterPath(alertRoutesPath, cluster.APIVersion), fn: c.enumerateAlertRoutes},
+		{verb: "POST", path: clusterPath(alertRoutesPath+"/preview", cluster.APIVersion), fn: c.previewAlertRoute},
+		{verb: "GET", path: clusterVersion(alertsPath, cluster.APIVersion), fn: c.enumerateAlertsWithFilters},
+		{verb: "GET", path: clusterVersion(alertsPath+"/watch", cluster.APIVersion), fn: c.watchAlerts, stream: true},
+		{verb: "PUT", path: clusterVersion(alertsPath+"/annotations", cluster.APIVersion), fn: c.annotateAlert},
+		{verb: "GET", path: clusterPath(hibernatePath, cluster.APIVersion), fn: c.hibernateStatus},
+		{verb: "POST", path: clusterPath(hibernatePath+"/suspend", cluster.APIVersion), fn: c.hibernateSuspend},
+		{verb: "POST", path: clusterPath(hibernatePath+"/resume", cluster.APIVersion), fn: c.hibernateResume},
+		{verb: "GET", path: clusterPath(freezePath, cluster.APIVersion), fn: c.freezeStatus},
+		{verb: "POST", path: clusterPath(freezePath, cluster.APIVersion), fn: c.freeze},
+		{verb: "POST", path: clusterPath(freezePath+"/thaw", cluster.APIVersion), fn: c.thaw},
+		{verb: "GET", path: clusterPath(snapSchedulesPath, cluster.APIVersion), fn: c.enumerateSnapSchedules},
+		{verb: "POST", path: clusterPath(snapSchedulesPath, cluster.APIVersion), fn: c.createSnapSchedule},
+		{verb: "GET", path: clusterPath(snapSchedulesPath+"/{id}", cluster.APIVersion), fn: c.inspectSnapSchedule},
+		{verb: "DELETE", path: clusterPath(snapSchedulesPath+"/{id}", cluster.APIVersion), fn: c.deleteSnapSchedule},
 	}
 }
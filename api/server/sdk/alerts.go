@@ -21,8 +21,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/libopenstorage/openstorage/alerts"
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/correlation"
 	"github.com/libopenstorage/openstorage/pkg/proto/time"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
@@ -66,8 +68,8 @@ func getOpts(opts []*api.SdkAlertsOption) []alerts.Option {
 		case *api.SdkAlertsOption_TimeSpan:
 			options = append(options,
 				alerts.NewTimeSpanOption(
-					prototime.TimestampToTime(opt.GetTimeSpan().GetStartTime()),
-					prototime.TimestampToTime(opt.GetTimeSpan().GetEndTime())))
+					spanTime(opt.GetTimeSpan().GetStartTime()),
+					spanTime(opt.GetTimeSpan().GetEndTime())))
 		case *api.SdkAlertsOption_CountSpan:
 			options = append(options,
 				alerts.NewCountSpanOption(
@@ -79,6 +81,15 @@ func getOpts(opts []*api.SdkAlertsOption) []alerts.Option {
 	return options
 }
 
+// spanTime returns the bound of a time span, the zero time leaving the span
+// unbounded if unset.
+func spanTime(ts *timestamp.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return prototime.TimestampToTime(ts)
+}
+
 func getFilters(queries []*api.SdkAlertsQuery) []alerts.Filter {
 	var filters []alerts.Filter
 
@@ -112,6 +123,25 @@ func getFilters(queries []*api.SdkAlertsQuery) []alerts.Filter {
 	return filters
 }
 
+// searchFilters narrows filters down to the alerts matching search, all the
+// alerts if there are no filters.
+func searchFilters(filters []alerts.Filter, search string) []alerts.Filter {
+	return narrowFilters(filters, alerts.NewSearchFilter(search))
+}
+
+// narrowFilters narrows filters down to the alerts matched by narrowing, all
+// the alerts if there are no filters.
+func narrowFilters(filters []alerts.Filter, narrowing alerts.Filter) []alerts.Filter {
+	if len(filters) == 0 {
+		return []alerts.Filter{narrowing}
+	}
+	narrowed := make([]alerts.Filter, 0, len(filters))
+	for _, filter := range filters {
+		narrowed = append(narrowed, alerts.NewAndFilter(filter, narrowing))
+	}
+	return narrowed
+}
+
 // Enumerate implements api.OpenStorageAlertsServer for alertsServer.
 // Input context should ideally have a deadline, in which case, a
 // graceful exit is ensured within that deadline.
@@ -123,8 +153,15 @@ func (g *alertsServer) Enumerate(request *api.SdkAlertsEnumerateRequest, stream
 	}
 
 	queries := request.GetQueries()
-	if queries == nil {
-		return status.Error(codes.InvalidArgument, "Must provide at least one query")
+	if queries == nil && len(request.GetSearch()) == 0 && len(request.GetExpression()) == 0 {
+		return status.Error(codes.InvalidArgument, "Must provide at least one query, a search or an expression")
+	}
+	var expression *alerts.Expression
+	if len(request.GetExpression()) != 0 {
+		var err error
+		if expression, err = alerts.CompileExpression(request.GetExpression()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid expression: %v", err)
+		}
 	}
 
 	// if input has deadline, ensure graceful exit within that deadline.
@@ -140,6 +177,12 @@ func (g *alertsServer) Enumerate(request *api.SdkAlertsEnumerateRequest, stream
 	errChan := make(chan error)
 
 	filters := getFilters(queries)
+	if search := request.GetSearch(); len(search) != 0 {
+		filters = searchFilters(filters, search)
+	}
+	if expression != nil {
+		filters = narrowFilters(filters, alerts.NewExpressionFilter(expression))
+	}
 
 	// spawn err-group process.
 	group.Go(func() error {
@@ -244,3 +287,70 @@ func (g *alertsServer) Delete(ctx context.Context,
 			"Deadline is reached, server side func exiting")
 	}
 }
+
+// raiser is implemented by the alerts handlers which can raise alerts, such as
+// alerts.Manager.
+type raiser interface {
+	Raise(alert *api.Alert, options ...alerts.Option) error
+}
+
+// Raise implements api.OpenStorageAlertsServer for alertsServer.
+func (g *alertsServer) Raise(ctx context.Context,
+	request *api.SdkAlertsRaiseRequest) (*api.SdkAlertsRaiseResponse, error) {
+	if g.alert() == nil {
+		return nil, status.Error(codes.Unavailable, "Resource has not been initialized")
+	}
+
+	r, ok := g.alert().(raiser)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "Alerts cannot be raised on this server")
+	}
+
+	if request.GetAlert() == nil {
+		return nil, status.Error(codes.InvalidArgument, "Must provide an alert")
+	}
+
+	if err := r.Raise(request.GetAlert(), alerts.NewCorrelationIDOption(correlation.FromContext(ctx))); err != nil {
+		return nil, status.Errorf(codes.Internal, "error raising alert: %v", err)
+	}
+	return &api.SdkAlertsRaiseResponse{}, nil
+}
+
+var watchActions = map[alerts.WatchAction]api.SdkAlertsWatchAction{
+	alerts.AlertRaised:  api.SdkAlertsWatchAction_SdkAlertsWatchActionRaised,
+	alerts.AlertUpdated: api.SdkAlertsWatchAction_SdkAlertsWatchActionUpdated,
+	alerts.AlertDeleted: api.SdkAlertsWatchAction_SdkAlertsWatchActionDeleted,
+}
+
+// Watch implements api.OpenStorageAlertsServer for alertsServer.
+// The changes are streamed until the call is canceled.
+func (g *alertsServer) Watch(request *api.SdkAlertsWatchRequest, stream api.OpenStorageAlerts_WatchServer) error {
+	ctx := stream.Context()
+
+	if g.alert() == nil {
+		return status.Error(codes.Unavailable, "Resource has not been initialized")
+	}
+
+	events, stop, err := g.alert().Watch(getFilters(request.GetQueries())...)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error watching alerts: %v", err)
+	}
+	defer stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&api.SdkAlertsWatchResponse{
+				Action: watchActions[event.Action],
+				Alert:  event.Alert,
+			}); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
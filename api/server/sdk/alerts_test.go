@@ -25,6 +25,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/alerts"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/pkg/proto/time"
 	"github.com/stretchr/testify/assert"
@@ -435,6 +437,198 @@ func TestAlertsServerEnumerateError(t *testing.T) {
 	}
 }
 
+// TestAlertsServerEnumerateSearch tests that the search narrows down every query.
+func TestAlertsServerEnumerateSearch(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Setup client
+	c := api.NewOpenStorageAlertsClient(s.Conn())
+
+	for _, req := range []*api.SdkAlertsEnumerateRequest{
+		{
+			Queries: []*api.SdkAlertsQuery{
+				{
+					Query: testNewResourceTypeQuery(api.ResourceType_RESOURCE_TYPE_DRIVE),
+				},
+			},
+			Search: "sdb failed",
+		},
+		{
+			Search: "sdb failed",
+		},
+	} {
+		var filters []interface{}
+		for _, filter := range searchFilters(getFilters(req.Queries), req.Search) {
+			filters = append(filters, filter)
+		}
+		if len(req.Queries) == 0 {
+			assert.Equal(t, []interface{}{alerts.NewSearchFilter("sdb failed")}, filters)
+		}
+
+		s.MockFilterDeleter().EXPECT().Enumerate(filters...).Return([]*api.Alert{new(api.Alert)}, nil).Times(1)
+		enumerateClient, err := c.Enumerate(context.Background(), req)
+		assert.NoError(t, err)
+		r, err := enumerateClient.Recv()
+		assert.NoError(t, err)
+		assert.Len(t, r.Alerts, 1)
+	}
+}
+
+// TestAlertsServerEnumerateExpression tests that the expression narrows down every query.
+func TestAlertsServerEnumerateExpression(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Setup client
+	c := api.NewOpenStorageAlertsClient(s.Conn())
+
+	matching := &api.Alert{Resource: api.ResourceType_RESOURCE_TYPE_DRIVE, Count: 10}
+	others := []*api.Alert{
+		{Resource: api.ResourceType_RESOURCE_TYPE_DRIVE, Count: 1},
+		{Resource: api.ResourceType_RESOURCE_TYPE_VOLUME, Count: 10},
+	}
+	for _, req := range []*api.SdkAlertsEnumerateRequest{
+		{
+			Queries: []*api.SdkAlertsQuery{
+				{
+					Query: testNewResourceTypeQuery(api.ResourceType_RESOURCE_TYPE_DRIVE),
+				},
+			},
+			Expression: "alert.Count > 5",
+		},
+		{
+			Expression: "alert.Count > 5 && alert.Resource == 'DRIVE'",
+		},
+	} {
+		s.MockFilterDeleter().EXPECT().Enumerate(gomock.Any()).Do(func(filter alerts.Filter) {
+			ok, err := filter.Match(matching)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			for _, alert := range others {
+				ok, err := filter.Match(alert)
+				assert.NoError(t, err)
+				assert.False(t, ok)
+			}
+		}).Return([]*api.Alert{matching}, nil).Times(1)
+		enumerateClient, err := c.Enumerate(context.Background(), req)
+		assert.NoError(t, err)
+		r, err := enumerateClient.Recv()
+		assert.NoError(t, err)
+		assert.Len(t, r.Alerts, 1)
+	}
+
+	enumerateClient, err := c.Enumerate(context.Background(), &api.SdkAlertsEnumerateRequest{
+		Expression: "alert.Count > 'five'",
+	})
+	assert.NoError(t, err)
+	_, err = enumerateClient.Recv()
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+}
+
+// TestAlertsServerOpenEndedTimeSpan tests that an unset bound of a time span leaves it unbounded.
+func TestAlertsServerOpenEndedTimeSpan(t *testing.T) {
+	since := time.Now().Add(-time.Hour)
+	opts := getOpts([]*api.SdkAlertsOption{
+		{
+			Opt: &api.SdkAlertsOption_TimeSpan{
+				TimeSpan: &api.SdkAlertsTimeSpan{
+					StartTime: prototime.TimeToTimestamp(since),
+				},
+			},
+		},
+	})
+	assert.Len(t, opts, 1)
+	assert.Equal(t, alerts.NewTimeSpanOption(prototime.TimestampToTime(prototime.TimeToTimestamp(since)),
+		time.Time{}), opts[0])
+}
+
+// TestAlertsServerRaise tests that raise stores the alert with the alerts manager.
+func TestAlertsServerRaise(t *testing.T) {
+	manager, err := alerts.NewManagerWithStore(alerts.NewMemStore())
+	assert.NoError(t, err)
+	server := NewAlertsServer(manager)
+
+	alert := &api.Alert{
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		AlertType:  10,
+		ResourceId: "myvol",
+		Severity:   api.SeverityType_SEVERITY_TYPE_WARNING,
+	}
+	_, err = server.Raise(context.Background(), &api.SdkAlertsRaiseRequest{Alert: alert})
+	assert.NoError(t, err)
+
+	out, err := manager.Enumerate(alerts.NewResourceIDFilter("myvol", 10, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	assert.NoError(t, err)
+	assert.Len(t, out, 1)
+
+	_, err = server.Raise(context.Background(), &api.SdkAlertsRaiseRequest{})
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.InvalidArgument)
+}
+
+// TestAlertsServerRaiseUnimplemented tests that raise fails when the alerts handler cannot raise.
+func TestAlertsServerRaiseUnimplemented(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Setup client
+	c := api.NewOpenStorageAlertsClient(s.Conn())
+
+	_, err := c.Raise(context.Background(), &api.SdkAlertsRaiseRequest{Alert: new(api.Alert)})
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Unimplemented)
+}
+
+// TestAlertsServerWatch tests watch functionality over gRPC using mock.
+func TestAlertsServerWatch(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Setup client
+	c := api.NewOpenStorageAlertsClient(s.Conn())
+
+	events := make(chan *alerts.WatchEvent, 2)
+	events <- &alerts.WatchEvent{Action: alerts.AlertRaised, Alert: &api.Alert{ResourceId: "myvol"}}
+	events <- &alerts.WatchEvent{Action: alerts.AlertDeleted, Alert: &api.Alert{ResourceId: "myvol"}}
+	close(events)
+	stopped := make(chan struct{})
+	s.MockFilterDeleter().EXPECT().Watch().Return((<-chan *alerts.WatchEvent)(events),
+		func() { close(stopped) }, nil).Times(1)
+
+	watchClient, err := c.Watch(context.Background(), &api.SdkAlertsWatchRequest{})
+	assert.NoError(t, err)
+
+	var actions []api.SdkAlertsWatchAction
+	for {
+		r, err := watchClient.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, "myvol", r.GetAlert().GetResourceId())
+		actions = append(actions, r.GetAction())
+	}
+	assert.Equal(t, []api.SdkAlertsWatchAction{
+		api.SdkAlertsWatchAction_SdkAlertsWatchActionRaised,
+		api.SdkAlertsWatchAction_SdkAlertsWatchActionDeleted,
+	}, actions)
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("watch not stopped")
+	}
+}
+
 // TestAlertsServerDelete tests delete functionality over gRPC using mock.
 func TestAlertsServerDelete(t *testing.T) {
 	// Create server and client connection
@@ -20,6 +20,9 @@ import (
 	"context"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/errdetails"
+	"github.com/libopenstorage/openstorage/pkg/topology"
+	"github.com/libopenstorage/openstorage/transform"
 	"github.com/libopenstorage/openstorage/volume"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -45,9 +48,15 @@ func (s *CloudBackupServer) Create(
 	}
 
 	if len(req.GetVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply a volume id")
+		return nil, errdetails.InvalidArgument("volume_id", "Must supply a volume id")
 	} else if len(req.GetCredentialId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply credential uuid")
+		return nil, errdetails.InvalidArgument("credential_id", "Must supply credential uuid")
+	}
+
+	// Read from the replica nearest this node to avoid cross-zone egress.
+	var source string
+	if s.server.cluster() != nil {
+		source, _, _ = topology.BackupSource(s.server.cluster(), s.driver(), req.GetVolumeId())
 	}
 
 	// Create the backup
@@ -57,6 +66,7 @@ func (s *CloudBackupServer) Create(
 		Full:           req.GetFull(),
 		Name:           req.GetTaskId(),
 		Labels:         req.GetLabels(),
+		SourceNodeID:   source,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to create backup: %v", err)
@@ -82,6 +92,11 @@ func (s *CloudBackupServer) Restore(
 		return nil, status.Error(codes.InvalidArgument, "Must provide credential uuid")
 	}
 
+	policy, err := transform.Select(req.GetTransformPolicy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid restore policy: %v", err)
+	}
+
 	r, err := s.driver().CloudBackupRestore(&api.CloudBackupRestoreRequest{
 		ID:                req.GetBackupId(),
 		RestoreVolumeName: req.GetRestoreVolumeName(),
@@ -93,6 +108,33 @@ func (s *CloudBackupServer) Restore(
 		return nil, status.Errorf(codes.Internal, "Failed to restore backup: %v", err)
 	}
 
+	// The restored volume carries the labels and the spec of the backup,
+	// transform them for this cluster
+	if policy != nil {
+		vols, err := s.driver().Inspect([]string{r.RestoreVolumeID})
+		if err == nil && len(vols) == 0 {
+			err = volume.ErrEnoEnt
+		}
+		if err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Failed to inspect restored volume %s: %v",
+				r.RestoreVolumeID,
+				err)
+		}
+		if err := s.driver().Set(
+			r.RestoreVolumeID,
+			policy.Locator(vols[0].GetLocator()),
+			policy.Spec(vols[0].GetSpec()),
+		); err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Failed to apply restore policy to volume %s: %v",
+				r.RestoreVolumeID,
+				err)
+		}
+	}
+
 	return &api.SdkCloudBackupRestoreResponse{
 		RestoreVolumeId: r.RestoreVolumeID,
 		TaskId:          r.Name,
@@ -302,9 +344,9 @@ func (s *CloudBackupServer) SchedCreate(
 	if req.GetCloudSchedInfo() == nil {
 		return nil, status.Error(codes.InvalidArgument, "BackupSchedule object cannot be nil")
 	} else if len(req.GetCloudSchedInfo().GetSrcVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply source volume id")
+		return nil, errdetails.InvalidArgument("cloud_sched_info.src_volume_id", "Must supply source volume id")
 	} else if len(req.GetCloudSchedInfo().GetCredentialId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply credential uuid")
+		return nil, errdetails.InvalidArgument("cloud_sched_info.credential_id", "Must supply credential uuid")
 	} else if req.GetCloudSchedInfo().GetSchedules() == nil ||
 		len(req.GetCloudSchedInfo().GetSchedules()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Must supply Schedule")
@@ -48,6 +48,31 @@ func TestSdkCloudBackupCreate(t *testing.T) {
 		Labels:       labels,
 	}
 
+	// The backup reads from the replica in the same zone as this node
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{{
+			Id:          id,
+			ReplicaSets: []*api.ReplicaSet{{Nodes: []string{"node2", "node3"}}},
+		}}, nil).
+		Times(1)
+	s.MockCluster().
+		EXPECT().
+		Enumerate().
+		Return(api.Cluster{
+			NodeId: "node1",
+			Nodes: []api.Node{
+				{Id: "node1", Status: api.Status_STATUS_OK,
+					NodeLabels: map[string]string{api.NodeLabelZone: "a"}},
+				{Id: "node2", Status: api.Status_STATUS_OK,
+					NodeLabels: map[string]string{api.NodeLabelZone: "b"}},
+				{Id: "node3", Status: api.Status_STATUS_OK,
+					NodeLabels: map[string]string{api.NodeLabelZone: "a"}},
+			},
+		}, nil).
+		Times(1)
+
 	// Create response
 	s.MockDriver().
 		EXPECT().
@@ -57,6 +82,7 @@ func TestSdkCloudBackupCreate(t *testing.T) {
 			Full:           false,
 			Name:           taskId,
 			Labels:         labels,
+			SourceNodeID:   "node3",
 		}).
 		Return(&api.CloudBackupCreateResponse{Name: "good-backup-name"}, nil).
 		Times(1)
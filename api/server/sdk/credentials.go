@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
 	"github.com/libopenstorage/openstorage/volume"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -46,7 +47,18 @@ func (s *CredentialServer) Create(
 
 	if len(req.GetName()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Must supply a name")
-	} else if aws := req.GetAwsCredential(); aws != nil {
+	}
+
+	if len(req.GetEncryptionKey()) != 0 {
+		if err := crypto.GetPolicy().CheckCipher(crypto.DefaultBackupCipher); err != nil {
+			return nil, status.Errorf(
+				codes.FailedPrecondition,
+				"Backup encryption not allowed: %v",
+				err.Error())
+		}
+	}
+
+	if aws := req.GetAwsCredential(); aws != nil {
 		return s.awsCreate(ctx, req, aws)
 	} else if azure := req.GetAzureCredential(); azure != nil {
 		return s.azureCreate(ctx, req, azure)
@@ -0,0 +1,74 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Services whose serving status depends on the handlers of the server.
+var (
+	driverServices = []string{
+		"openstorage.api.OpenStorageVolume",
+		"openstorage.api.OpenStorageMountAttach",
+		"openstorage.api.OpenStorageMigrate",
+		"openstorage.api.OpenStorageCredentials",
+		"openstorage.api.OpenStorageCloudBackup",
+	}
+	clusterServices = []string{
+		"openstorage.api.OpenStorageCluster",
+		"openstorage.api.OpenStorageNode",
+		"openstorage.api.OpenStorageClusterPair",
+		"openstorage.api.OpenStorageObjectstore",
+		"openstorage.api.OpenStorageSchedulePolicy",
+	}
+	alertsServices = []string{
+		"openstorage.api.OpenStorageAlerts",
+	}
+	identityServices = []string{
+		"openstorage.api.OpenStorageIdentity",
+	}
+)
+
+// newHealthServer returns the grpc.health.v1 Health service of the server.
+// The overall status, of the empty service name, is always SERVING.
+func newHealthServer() *health.Server {
+	h := health.NewServer()
+	for _, service := range identityServices {
+		h.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	return h
+}
+
+// updateHealth sets the serving status of the services from the handlers
+// they require, s.lock must be held.
+func (s *Server) updateHealth() {
+	setServingStatus(s.healthServer, driverServices, s.driverHandler != nil)
+	setServingStatus(s.healthServer, clusterServices, s.clusterHandler != nil)
+	setServingStatus(s.healthServer, alertsServices, s.alertHandler != nil)
+}
+
+func setServingStatus(h *health.Server, services []string, serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	for _, service := range services {
+		h.SetServingStatus(service, status)
+	}
+}
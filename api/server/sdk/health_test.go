@@ -0,0 +1,59 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestSdkHealthCheck(t *testing.T) {
+	s := newTestServer(t)
+	defer s.Stop()
+
+	c := healthpb.NewHealthClient(s.Conn())
+	for _, service := range []string{
+		"",
+		"openstorage.api.OpenStorageVolume",
+		"openstorage.api.OpenStorageCluster",
+		"openstorage.api.OpenStorageAlerts",
+	} {
+		r, err := c.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		assert.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, r.GetStatus(), service)
+	}
+
+	// Unknown services are not found
+	_, err := c.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, serverError.Code())
+
+	// The volume services are not serving without a driver
+	s.server.UseVolumeDriver(nil)
+	r, err := c.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "openstorage.api.OpenStorageVolume"})
+	assert.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, r.GetStatus())
+	r, err = c.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "openstorage.api.OpenStorageAlerts"})
+	assert.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, r.GetStatus())
+}
@@ -0,0 +1,131 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/idempotency"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// transientCodes are the codes of the failures which are not stored, so
+// that a retry runs the call again.
+var transientCodes = map[codes.Code]bool{
+	codes.Unavailable:      true,
+	codes.DeadlineExceeded: true,
+	codes.Canceled:         true,
+	codes.Aborted:          true,
+}
+
+// This interceptor returns the stored outcome of a mutating call retried
+// with the same idempotency key, instead of running it again
+func (s *Server) idempotencyIntercepter(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	key := idempotencyKey(ctx)
+	store, err := idempotency.Inst()
+	if len(key) == 0 || err != nil || isReadOnlyMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	o, err := store.Begin(ctx, info.FullMethod, key, idempotency.Fingerprint([]byte(info.FullMethod), data))
+	switch err {
+	case nil:
+	case idempotency.ErrInProgress:
+		return nil, status.Error(codes.Aborted, err.Error())
+	case idempotency.ErrMismatch:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if o != nil {
+		return replay(o)
+	}
+
+	resp, err := handler(ctx, req)
+	o = &idempotency.Outcome{Code: int(grpc.Code(err))}
+	if err != nil {
+		if transientCodes[grpc.Code(err)] {
+			store.Abandon(ctx, info.FullMethod, key)
+			return resp, err
+		}
+		st, _ := status.FromError(err)
+		o.Message = st.Message()
+		// the details of the status, such as its errdetails, are replayed
+		if data, merr := proto.Marshal(st.Proto()); merr == nil {
+			o.Status = data
+		}
+	} else if msg, ok := resp.(proto.Message); ok {
+		o.Type = proto.MessageName(msg)
+		if o.Body, err = proto.Marshal(msg); err != nil {
+			store.Abandon(ctx, info.FullMethod, key)
+			return resp, nil
+		}
+	}
+	store.Complete(ctx, info.FullMethod, key, o)
+	return resp, err
+}
+
+// idempotencyKey returns the idempotency key of the call, if any.
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md[idempotency.MetadataKey]; len(v) != 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// replay returns the stored outcome of a call.
+func replay(o *idempotency.Outcome) (interface{}, error) {
+	if codes.Code(o.Code) != codes.OK {
+		var st spb.Status
+		if len(o.Status) == 0 || proto.Unmarshal(o.Status, &st) != nil {
+			return nil, status.Error(codes.Code(o.Code), o.Message)
+		}
+		return nil, status.ErrorProto(&st)
+	}
+	t := proto.MessageType(o.Type)
+	if t == nil {
+		return nil, status.Errorf(codes.Internal, "Unknown response type %s", o.Type)
+	}
+	msg := reflect.New(t.Elem()).Interface().(proto.Message)
+	if err := proto.Unmarshal(o.Body, msg); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return msg, nil
+}
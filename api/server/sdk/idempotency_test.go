@@ -0,0 +1,105 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/idempotency"
+	"github.com/libopenstorage/openstorage/pkg/errdetails"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestSdkIdempotencyKey(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, idempotency.Init(idempotency.New(kv, &idempotency.Config{})))
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	name := "myvol"
+	req := &api.SdkVolumeCreateRequest{
+		Name: name,
+		Spec: &api.VolumeSpec{
+			Size: 1234,
+		},
+	}
+
+	// The volume is created once
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Create(&api.VolumeLocator{
+				Name: name,
+			}, &api.Source{}, gomock.Any()).
+			Return("myid", nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+	ctx := metadata.NewOutgoingContext(context.Background(),
+		metadata.Pairs(idempotency.MetadataKey, "create-myvol"))
+
+	for i := 0; i < 2; i++ {
+		r, err := c.Create(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "myid", r.GetVolumeId())
+	}
+
+	// The key cannot be reused for another request
+	_, err = c.Create(ctx, &api.SdkVolumeCreateRequest{Name: "othervol"})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+
+	// The details of a failure are replayed
+	ctx = metadata.NewOutgoingContext(context.Background(),
+		metadata.Pairs(idempotency.MetadataKey, "delete-novol"))
+	for i := 0; i < 2; i++ {
+		_, err = c.Delete(ctx, &api.SdkVolumeDeleteRequest{})
+		assert.Error(t, err)
+		violations := errdetails.FieldViolations(err)
+		require.Len(t, violations, 1)
+		assert.Equal(t, "volume_id", violations[0].GetField())
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"mime"
 	"net/http"
+	"path"
+	"strings"
 	"sync"
 
 	"github.com/gobuffalo/packr"
@@ -31,11 +33,22 @@ import (
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/api/spec"
 	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/libopenstorage/openstorage/freeze"
+	"github.com/libopenstorage/openstorage/kvdbhealth"
+	"github.com/libopenstorage/openstorage/pkg/apiqueue"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+	"github.com/libopenstorage/openstorage/pkg/errdetails"
 	"github.com/libopenstorage/openstorage/pkg/grpcserver"
+	"github.com/libopenstorage/openstorage/pkg/redact"
 	"github.com/libopenstorage/openstorage/volume"
 	volumedrivers "github.com/libopenstorage/openstorage/volume/drivers"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
 // ServerConfig provides the configuration to the SDK server
@@ -80,6 +93,7 @@ type Server struct {
 	credentialServer     *CredentialServer
 	identityServer       *IdentityServer
 	alertsServer         api.OpenStorageAlertsServer
+	healthServer         *health.Server
 }
 
 // Interface check
@@ -151,6 +165,8 @@ func New(config *ServerConfig) (*Server, error) {
 	s.clusterPairServer = &ClusterPairServer{
 		server: s,
 	}
+	s.healthServer = newHealthServer()
+	s.updateHealth()
 
 	return s, nil
 }
@@ -162,7 +178,15 @@ func (s *Server) Start() error {
 	opts := make([]grpc.ServerOption, 0)
 	opts = append(opts, grpc.UnaryInterceptor(
 		grpc_middleware.ChainUnaryServer(
+			correlation.UnaryServerInterceptor(),
+			errdetails.UnaryServerInterceptor(),
+			auth.UnaryServerInterceptor(),
+			apiqueue.UnaryServerInterceptor(),
 			s.rwlockIntercepter,
+			s.readOnlyIntercepter,
+			s.freezeIntercepter,
+			s.idempotencyIntercepter,
+			redact.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(),
 		)))
 
@@ -182,6 +206,7 @@ func (s *Server) Start() error {
 		api.RegisterOpenStorageMountAttachServer(grpcServer, s.volumeServer)
 		api.RegisterOpenStorageAlertsServer(grpcServer, s.alertsServer)
 		api.RegisterOpenStorageClusterPairServer(grpcServer, s.clusterPairServer)
+		healthpb.RegisterHealthServer(grpcServer, s.healthServer)
 		return grpcServer
 	})
 	if err != nil {
@@ -200,6 +225,7 @@ func (s *Server) UseCluster(c cluster.Cluster) {
 	defer s.lock.Unlock()
 
 	s.clusterHandler = c
+	s.updateHealth()
 }
 
 // UseVolumeDriver will setup a new driver object for the gRPC handlers
@@ -208,6 +234,7 @@ func (s *Server) UseVolumeDriver(d volume.VolumeDriver) {
 	defer s.lock.Unlock()
 
 	s.driverHandler = d
+	s.updateHealth()
 }
 
 // UseAlert will setup a new alert object for the gRPC handlers
@@ -216,6 +243,7 @@ func (s *Server) UseAlert(a alerts.FilterDeleter) {
 	defer s.lock.Unlock()
 
 	s.alertHandler = a
+	s.updateHealth()
 }
 
 // Accessors
@@ -408,3 +436,50 @@ func (s *Server) rwlockIntercepter(
 
 	return handler(ctx, req)
 }
+
+// readOnlyMethodPrefixes are the prefixes of the SDK methods which do not
+// modify state.
+var readOnlyMethodPrefixes = []string{
+	"Inspect", "Enumerate", "Status", "Stats", "Get", "Catalog", "History",
+	"Version", "CapacityUsage", "Validate", "Check",
+}
+
+// This interceptor rejects calls which modify state while kvdb is down
+func (s *Server) readOnlyIntercepter(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if kvdbhealth.ReadOnly() && !isReadOnlyMethod(info.FullMethod) {
+		return nil, status.Error(codes.Unavailable, kvdbhealth.ErrReadOnly.Error())
+	}
+	return handler(ctx, req)
+}
+
+// This interceptor rejects calls which modify state while the cluster is frozen
+func (s *Server) freezeIntercepter(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if isReadOnlyMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	if err := freeze.Check(); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return handler(ctx, req)
+}
+
+// isReadOnlyMethod returns true if the SDK method does not modify state.
+func isReadOnlyMethod(fullMethod string) bool {
+	method := path.Base(fullMethod)
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
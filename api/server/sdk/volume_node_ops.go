@@ -19,10 +19,16 @@ package sdk
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/attachlimit"
+	"github.com/libopenstorage/openstorage/audit"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/pkg/errdetails"
 	mountattachoptions "github.com/libopenstorage/openstorage/pkg/options"
 	"github.com/libopenstorage/openstorage/pkg/util"
+	"github.com/libopenstorage/openstorage/slo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -37,7 +43,7 @@ func (s *VolumeServer) Attach(
 	}
 
 	if len(req.GetVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+		return nil, errdetails.InvalidArgument("volume_id", "Must supply volume id")
 	}
 
 	// Check if already attached
@@ -66,13 +72,24 @@ func (s *VolumeServer) Attach(
 		}
 	}
 
+	start := time.Now()
 	devPath, err := s.driver().Attach(req.GetVolumeId(), options)
-	if err != nil {
+	slo.Observe(slo.OperationAttach, time.Since(start), err)
+	if _, ok := err.(*attachlimit.LimitError); ok {
+		return nil, status.Errorf(
+			codes.ResourceExhausted,
+			"failed to attach volume: %v",
+			err.Error())
+	} else if err != nil {
 		return nil, status.Errorf(
 			codes.Internal,
 			"failed  to attach volume: %v",
 			err.Error())
 	}
+	eventbus.PublishContext(ctx, eventbus.EventVolumeAttach, req.GetVolumeId(),
+		map[string]string{"device_path": devPath})
+	audit.LogAccess(ctx, eventbus.EventVolumeAttach, req.GetVolumeId(),
+		map[string]string{"device_path": devPath})
 
 	return &api.SdkVolumeAttachResponse{DevicePath: devPath}, nil
 }
@@ -87,7 +104,7 @@ func (s *VolumeServer) Detach(
 	}
 
 	if len(req.GetVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+		return nil, errdetails.InvalidArgument("volume_id", "Must supply volume id")
 	}
 
 	// Check if already attached
@@ -117,6 +134,8 @@ func (s *VolumeServer) Detach(
 			req.GetVolumeId(),
 			err)
 	}
+	eventbus.PublishContext(ctx, eventbus.EventVolumeDetach, req.GetVolumeId(), nil)
+	audit.LogAccess(ctx, eventbus.EventVolumeDetach, req.GetVolumeId(), nil)
 
 	return &api.SdkVolumeDetachResponse{}, nil
 }
@@ -131,13 +150,15 @@ func (s *VolumeServer) Mount(
 	}
 
 	if len(req.GetVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+		return nil, errdetails.InvalidArgument("volume_id", "Must supply volume id")
 	}
 	if len(req.GetMountPath()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Invalid Mount Path")
 	}
 
+	start := time.Now()
 	err := s.driver().Mount(req.GetVolumeId(), req.GetMountPath(), nil)
+	slo.Observe(slo.OperationMount, time.Since(start), err)
 	if err != nil {
 		return nil, status.Errorf(
 			codes.Internal,
@@ -145,6 +166,10 @@ func (s *VolumeServer) Mount(
 			req.GetVolumeId(),
 			err.Error())
 	}
+	eventbus.PublishContext(ctx, eventbus.EventVolumeMount, req.GetVolumeId(),
+		map[string]string{"mount_path": req.GetMountPath()})
+	audit.LogAccess(ctx, eventbus.EventVolumeMount, req.GetVolumeId(),
+		map[string]string{"mount_path": req.GetMountPath()})
 	return &api.SdkVolumeMountResponse{}, err
 }
 
@@ -158,7 +183,7 @@ func (s *VolumeServer) Unmount(
 	}
 
 	if len(req.GetVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+		return nil, errdetails.InvalidArgument("volume_id", "Must supply volume id")
 	}
 
 	if len(req.GetMountPath()) == 0 {
@@ -183,6 +208,10 @@ func (s *VolumeServer) Unmount(
 			req.GetVolumeId(),
 			err.Error())
 	}
+	eventbus.PublishContext(ctx, eventbus.EventVolumeUnmount, req.GetVolumeId(),
+		map[string]string{"mount_path": req.GetMountPath()})
+	audit.LogAccess(ctx, eventbus.EventVolumeUnmount, req.GetVolumeId(),
+		map[string]string{"mount_path": req.GetMountPath()})
 
 	return &api.SdkVolumeUnmountResponse{}, nil
 }
@@ -20,7 +20,11 @@ import (
 	"context"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/pkg/errdetails"
 	"github.com/libopenstorage/openstorage/pkg/util"
+	"github.com/libopenstorage/openstorage/pkg/wipe"
+	"github.com/libopenstorage/openstorage/transform"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/portworx/kvdb"
 	"google.golang.org/grpc/codes"
@@ -28,6 +32,7 @@ import (
 )
 
 func (s *VolumeServer) create(
+	ctx context.Context,
 	locator *api.VolumeLocator,
 	source *api.Source,
 	spec *api.VolumeSpec,
@@ -72,10 +77,8 @@ func (s *VolumeServer) create(
 				err.Error())
 		}
 
-		// Create a snapshot from the parent
-		id, err = s.driver().Snapshot(parent.GetId(), false, &api.VolumeLocator{
-			Name: volName,
-		}, false)
+		// Create a snapshot from the parent, inheriting its labels
+		id, err = s.driver().Snapshot(parent.GetId(), false, volume.InheritLocator(parent, locator), false)
 		if err != nil {
 			return "", status.Errorf(
 				codes.Internal,
@@ -92,6 +95,12 @@ func (s *VolumeServer) create(
 				err.Error())
 		}
 	}
+	eventbus.PublishContext(ctx, eventbus.EventVolumeCreate, id, &api.Volume{
+		Id:      id,
+		Locator: locator,
+		Source:  source,
+		Spec:    spec,
+	})
 
 	return id, nil
 }
@@ -121,7 +130,7 @@ func (s *VolumeServer) Create(
 	}
 	source := &api.Source{}
 
-	id, err := s.create(locator, source, spec)
+	id, err := s.create(ctx, locator, source, spec)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -150,8 +159,14 @@ func (s *VolumeServer) Clone(
 			"Must parent volume id")
 	}
 
+	policy, err := transform.Select(req.GetTransformPolicy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid restore policy: %v", err)
+	}
+
 	locator := &api.VolumeLocator{
-		Name: req.GetName(),
+		Name:         req.GetName(),
+		VolumeLabels: req.GetLabels(),
 	}
 	source := &api.Source{
 		Parent: req.GetParentId(),
@@ -166,11 +181,32 @@ func (s *VolumeServer) Clone(
 	}
 
 	// Create the clone
-	id, err := s.create(locator, source, parentVol.GetVolume().GetSpec())
+	id, err := s.create(ctx, locator, source, parentVol.GetVolume().GetSpec())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// The clone inherits the spec of its parent, apply the requested overrides
+	// and the restore policy
+	if req.GetSpec() != nil || policy != nil {
+		spec := parentVol.GetVolume().GetSpec()
+		if req.GetSpec() != nil {
+			spec = s.mergeVolumeSpecs(spec, req.GetSpec())
+		}
+		var cloneLocator *api.VolumeLocator
+		if policy != nil {
+			cloneLocator = policy.Locator(volume.InheritLocator(parentVol.GetVolume(), locator))
+			spec = policy.Spec(spec)
+		}
+		if err := s.driver().Set(id, cloneLocator, spec); err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Failed to update the spec of clone %s: %v",
+				id,
+				err.Error())
+		}
+	}
+
 	return &api.SdkVolumeCloneResponse{
 		VolumeId: id,
 	}, nil
@@ -186,7 +222,7 @@ func (s *VolumeServer) Delete(
 	}
 
 	if len(req.GetVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+		return nil, errdetails.InvalidArgument("volume_id", "Must supply volume id")
 	}
 
 	// If the volume is not found, return OK to be idempotent
@@ -202,6 +238,18 @@ func (s *VolumeServer) Delete(
 			err.Error())
 	}
 
+	// Volumes with secure delete enabled are wiped and deleted by a job
+	if method, ok := volumes[0].GetSpec().GetVolumeLabels()[api.SpecSecureDelete]; ok {
+		if _, err := wipe.SecureDelete(ctx, s.driver(), req.GetVolumeId(), method); err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Failed to start secure delete of volume %s: %v",
+				req.GetVolumeId(),
+				err.Error())
+		}
+		return &api.SdkVolumeDeleteResponse{}, nil
+	}
+
 	err = s.driver().Delete(req.GetVolumeId())
 	if err != nil {
 		return nil, status.Errorf(
@@ -210,6 +258,7 @@ func (s *VolumeServer) Delete(
 			req.GetVolumeId(),
 			err.Error())
 	}
+	eventbus.PublishContext(ctx, eventbus.EventVolumeDelete, req.GetVolumeId(), nil)
 
 	return &api.SdkVolumeDeleteResponse{}, nil
 }
@@ -224,7 +273,7 @@ func (s *VolumeServer) Inspect(
 	}
 
 	if len(req.GetVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+		return nil, errdetails.InvalidArgument("volume_id", "Must supply volume id")
 	}
 
 	vols, err := s.driver().Inspect([]string{req.GetVolumeId()})
@@ -305,7 +354,7 @@ func (s *VolumeServer) Update(
 	}
 
 	if len(req.GetVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+		return nil, errdetails.InvalidArgument("volume_id", "Must supply volume id")
 	}
 
 	// Get current state
@@ -335,7 +384,7 @@ func (s *VolumeServer) Stats(
 	}
 
 	if len(req.GetVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+		return nil, errdetails.InvalidArgument("volume_id", "Must supply volume id")
 	}
 
 	stats, err := s.driver().Stats(req.GetVolumeId(), !req.GetNotCumulative())
@@ -358,7 +407,7 @@ func (s *VolumeServer) CapacityUsage(
 ) (*api.SdkVolumeCapacityUsageResponse, error) {
 
 	if len(req.GetVolumeId()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+		return nil, errdetails.InvalidArgument("volume_id", "Must supply volume id")
 	}
 
 	dResp, err := s.driver().CapacityUsage(req.GetVolumeId())
@@ -23,6 +23,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/errdetails"
+	"github.com/libopenstorage/openstorage/transform"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/portworx/kvdb"
 	"github.com/stretchr/testify/assert"
@@ -173,7 +175,10 @@ func TestSdkVolumeClone(t *testing.T) {
 
 		s.MockDriver().
 			EXPECT().
-			Snapshot(parentid, false, &api.VolumeLocator{Name: name}, false).
+			Snapshot(parentid, false, &api.VolumeLocator{
+				Name:         name,
+				VolumeLabels: map[string]string{api.LabelLineage: parentid},
+			}, false).
 			Return(id, nil).
 			Times(1),
 	)
@@ -186,6 +191,215 @@ func TestSdkVolumeClone(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, r.GetVolumeId(), "myid")
 }
+
+func TestSdkVolumeCloneInheritance(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	name := "myclone"
+	parentid := "mysnap"
+	parentVol := &api.Volume{
+		Id: parentid,
+		Spec: &api.VolumeSpec{
+			Size:      1234,
+			Cos:       api.CosType_HIGH,
+			IoProfile: api.IoProfile_IO_PROFILE_DB,
+		},
+		Source: &api.Source{
+			Parent: "myvol",
+		},
+		Locator: &api.VolumeLocator{
+			Name: parentid,
+			VolumeLabels: map[string]string{
+				"app":            "db",
+				"env":            "prod",
+				api.LabelLineage: "myvol,mybase",
+			},
+		},
+	}
+	req := &api.SdkVolumeCloneRequest{
+		Name:     name,
+		ParentId: parentid,
+		Labels:   map[string]string{"env": "test"},
+		Spec: &api.VolumeSpecUpdate{
+			CosOpt: &api.VolumeSpecUpdate_Cos{Cos: api.CosType_LOW},
+		},
+	}
+
+	// Create response
+	id := "myid"
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{parentid}).
+			Return([]*api.Volume{parentVol}, nil).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{parentid}).
+			Return([]*api.Volume{parentVol}, nil).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Snapshot(parentid, false, &api.VolumeLocator{
+				Name: name,
+				VolumeLabels: map[string]string{
+					"app":            "db",
+					"env":            "test",
+					api.LabelLineage: "mysnap,myvol,mybase",
+				},
+			}, false).
+			Return(id, nil).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Set(id, nil, gomock.Any()).
+			Do(func(id string, locator *api.VolumeLocator, spec *api.VolumeSpec) {
+				assert.Equal(t, api.CosType_LOW, spec.GetCos())
+				assert.Equal(t, api.IoProfile_IO_PROFILE_DB, spec.GetIoProfile())
+				assert.Equal(t, uint64(1234), spec.GetSize())
+			}).
+			Return(nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Get info
+	r, err := c.Clone(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetVolumeId(), "myid")
+}
+
+func TestSdkVolumeCloneRestorePolicy(t *testing.T) {
+	policies, err := transform.New(&transform.Config{
+		Policies: []transform.Policy{
+			{
+				Name:        "staging",
+				StripLabels: []string{"env"},
+				SetLabels:   map[string]string{"stage": "test"},
+				Cos:         "low",
+				HaLevel:     1,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, transform.Init(policies))
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	name := "myclone"
+	parentid := "myvol"
+	parentVol := &api.Volume{
+		Id: parentid,
+		Spec: &api.VolumeSpec{
+			Size:    1234,
+			HaLevel: 3,
+			Cos:     api.CosType_HIGH,
+		},
+		Locator: &api.VolumeLocator{
+			Name: parentid,
+			VolumeLabels: map[string]string{
+				"app": "db",
+				"env": "prod",
+			},
+		},
+	}
+	req := &api.SdkVolumeCloneRequest{
+		Name:            name,
+		ParentId:        parentid,
+		TransformPolicy: "staging",
+	}
+
+	// Create response
+	id := "myid"
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{parentid}).
+			Return([]*api.Volume{parentVol}, nil).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{parentid}).
+			Return([]*api.Volume{parentVol}, nil).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Snapshot(parentid, false, gomock.Any(), false).
+			Return(id, nil).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Set(id, &api.VolumeLocator{
+				Name: name,
+				VolumeLabels: map[string]string{
+					"app":            "db",
+					"stage":          "test",
+					api.LabelLineage: "myvol",
+				},
+			}, gomock.Any()).
+			Do(func(id string, locator *api.VolumeLocator, spec *api.VolumeSpec) {
+				assert.Equal(t, api.CosType_LOW, spec.GetCos())
+				assert.Equal(t, int64(1), spec.GetHaLevel())
+				assert.Equal(t, uint64(1234), spec.GetSize())
+			}).
+			Return(nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Get info
+	r, err := c.Clone(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetVolumeId(), "myid")
+
+	// Unknown policies are refused
+	req.TransformPolicy = "missing"
+	_, err = c.Clone(context.Background(), req)
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.InvalidArgument)
+}
+
 func TestSdkVolumeDelete(t *testing.T) {
 
 	// Create server and client connection
@@ -222,6 +436,44 @@ func TestSdkVolumeDelete(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSdkVolumeErrorDetails(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	id := "myvol"
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{}, nil).
+		Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	_, err := c.Delete(context.Background(), &api.SdkVolumeDeleteRequest{})
+	assert.Error(t, err)
+	info := errdetails.ErrorInfo(err)
+	assert.NotNil(t, info)
+	assert.Equal(t, "INVALID_ARGUMENT", info.GetReason())
+	assert.Equal(t, "/openstorage.api.OpenStorageVolume/Delete", info.GetMethod())
+	violations := errdetails.FieldViolations(err)
+	assert.Len(t, violations, 1)
+	assert.Equal(t, "volume_id", violations[0].GetField())
+
+	_, err = c.Inspect(context.Background(), &api.SdkVolumeInspectRequest{VolumeId: id})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, serverError.Code())
+	assert.Equal(t, "NOT_FOUND", errdetails.ErrorInfo(err).GetReason())
+	resource := errdetails.ResourceInfo(err)
+	assert.NotNil(t, resource)
+	assert.Equal(t, "volume", resource.GetResourceType())
+	assert.Equal(t, id, resource.GetResourceId())
+}
+
 func TestSdkVolumeDeleteReturnOkWhenVolumeNotFound(t *testing.T) {
 
 	// Create server and client connection
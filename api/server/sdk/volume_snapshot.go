@@ -21,6 +21,7 @@ import (
 
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/pkg/sched"
+	"github.com/libopenstorage/openstorage/volume"
 	"github.com/portworx/kvdb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -41,11 +42,20 @@ func (s *VolumeServer) SnapshotCreate(
 		return nil, status.Error(codes.InvalidArgument, "Must supply a name")
 	}
 
-	readonly := true
-	snapshotID, err := s.driver().Snapshot(req.GetVolumeId(), readonly, &api.VolumeLocator{
+	// The snapshot inherits the labels of the volume and records its lineage
+	parent, err := s.Inspect(ctx, &api.SdkVolumeInspectRequest{
+		VolumeId: req.GetVolumeId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	locator := volume.InheritLocator(parent.GetVolume(), &api.VolumeLocator{
 		Name:         req.GetName(),
 		VolumeLabels: req.GetLabels(),
-	}, false)
+	})
+
+	readonly := true
+	snapshotID, err := s.driver().Snapshot(req.GetVolumeId(), readonly, locator, false)
 	if err != nil {
 		if err == kvdb.ErrNotFound {
 			return nil, status.Errorf(
@@ -20,6 +20,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
@@ -63,13 +64,28 @@ func TestSdkVolumeSnapshotCreate(t *testing.T) {
 	}
 
 	// Create response
-	s.MockDriver().
-		EXPECT().
-		Snapshot(req.GetVolumeId(), true, &api.VolumeLocator{
-			Name: snapName,
-		}, false).
-		Return(snapid, nil).
-		Times(1)
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{volid}).
+			Return([]*api.Volume{{
+				Id:      volid,
+				Locator: &api.VolumeLocator{VolumeLabels: map[string]string{"app": "db"}},
+			}}, nil).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Snapshot(req.GetVolumeId(), true, &api.VolumeLocator{
+				Name: snapName,
+				VolumeLabels: map[string]string{
+					"app":            "db",
+					api.LabelLineage: volid,
+				},
+			}, false).
+			Return(snapid, nil).
+			Times(1),
+	)
 
 	// Setup client
 	c := api.NewOpenStorageVolumeClient(s.Conn())
@@ -1,12 +1,22 @@
 package server
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"path"
+	"time"
 
+	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/libopenstorage/openstorage/kvdbhealth"
+	"github.com/libopenstorage/openstorage/pkg/apiqueue"
+	"github.com/libopenstorage/openstorage/pkg/ca"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+	"github.com/libopenstorage/openstorage/slo"
 	"github.com/sirupsen/logrus"
 
 	"github.com/gorilla/mux"
@@ -17,6 +27,9 @@ type Route struct {
 	verb string
 	path string
 	fn   func(http.ResponseWriter, *http.Request)
+	// stream is true if the route streams its response, such as the
+	// alerts watches
+	stream bool
 }
 
 func (r *Route) GetVerb() string {
@@ -112,49 +125,93 @@ func StartVolumePluginAPI(
 }
 
 // StartClusterAPI starts a REST server to receive driver configuration commands
-// from the CLI/UX to control the OSD cluster.
+// from the CLI/UX to control the OSD cluster. The requests are served by the
+// active node when the API server election is enabled.
 func StartClusterAPI(clusterApiBase string, clusterPort uint16) error {
 	clusterApi := newClusterAPI()
+	routes := newStandby(nil).routes(clusterApi.Routes())
 
 	// start server as before
-	if err := startServer("osd", clusterApiBase, clusterPort, clusterApi.Routes()); err != nil {
+	if err := startServer("osd", clusterApiBase, clusterPort, routes); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// StartClusterAPIWithTLS starts the cluster REST server like
+// StartClusterAPI, but serves the port with TLS as the node identity.
+// Clients of the port must present a certificate issued by the cluster CA,
+// except to enroll. The unix socket is unchanged.
+func StartClusterAPIWithTLS(clusterApiBase string, clusterPort uint16, identity *ca.Identity) error {
+	clusterApi := newClusterAPI()
+	tlsConfig, err := crypto.GetPolicy().TLSConfig(identity.ServerTLSConfig(tls.VerifyClientCertIfGiven))
+	if err != nil {
+		return err
+	}
+	router, err := listenUnix("osd", clusterApiBase, newStandby(identity).routes(clusterApi.Routes()))
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Addr:      fmt.Sprintf(":%d", clusterPort),
+		Handler:   requireClientCert(clusterPath(caEnrollPath, cluster.APIVersion), router),
+		TLSConfig: tlsConfig,
+	}
+	logrus.Printf("Starting REST service with TLS on port : %v", clusterPort)
+	go server.ListenAndServeTLS("", "")
+	return nil
+}
+
 func GetClusterAPIRoutes() []*Route {
 	clusterApi := newClusterAPI()
 	return clusterApi.Routes()
 }
 
 func startServer(name string, sockBase string, port uint16, routes []*Route) error {
-	var (
-		listener net.Listener
-		err      error
-	)
+	router, err := listenUnix(name, sockBase, routes)
+	if err != nil {
+		return err
+	}
+	if port != 0 {
+		logrus.Printf("Starting REST service on port : %v", port)
+		go http.ListenAndServe(fmt.Sprintf(":%d", port), router)
+	}
+	return nil
+}
+
+// listenUnix serves routes on the unix socket of name and returns the
+// router to serve on other listeners.
+func listenUnix(name string, sockBase string, routes []*Route) (*mux.Router, error) {
 	router := mux.NewRouter()
 	router.NotFoundHandler = http.HandlerFunc(notFound)
 	for _, v := range routes {
-		router.Methods(v.verb).Path(v.path).HandlerFunc(v.fn)
+		router.Methods(v.verb).Path(v.path).HandlerFunc(routeHandler(v))
 	}
 	socket := path.Join(sockBase, name+".sock")
 	os.Remove(socket)
 	os.MkdirAll(path.Dir(socket), 0755)
 
 	logrus.Printf("Starting REST service on socket : %+v", socket)
-	listener, err = net.Listen("unix", socket)
+	listener, err := net.Listen("unix", socket)
 	if err != nil {
 		logrus.Warnln("Cannot listen on UNIX socket: ", err)
-		return err
+		return nil, err
 	}
-	go http.Serve(listener, router)
-	if port != 0 {
-		logrus.Printf("Starting REST service on port : %v", port)
-		go http.ListenAndServe(fmt.Sprintf(":%d", port), router)
+	server := &http.Server{Handler: router, ConnContext: auth.ConnContext}
+	go server.Serve(listener)
+	return router, nil
+}
+
+// routeHandler returns the handler of route, correlating and authenticating
+// every request, and rejecting the writes while kvdb is down or the cluster
+// is frozen. The streams are neither queued, buffered to be cached or
+// redacted, nor observed, since they last as long as their clients.
+func routeHandler(route *Route) http.HandlerFunc {
+	if route.stream {
+		return correlated(authenticated(readOnlyWhenKvdbDown(route.fn)))
 	}
-	return nil
+	return correlated(authenticated(observeAvailability(queued(readOnlyWhenKvdbDown(rejectWhenFrozen(idempotent(cacheable(redacted(route.fn)))))))))
 }
 
 type restServer interface {
@@ -178,7 +235,11 @@ func (rest *restBase) logRequest(request string, id string) *logrus.Entry {
 	})
 }
 func (rest *restBase) sendError(request string, id string, w http.ResponseWriter, msg string, code int) {
-	rest.logRequest(request, id).Warnln(code, " ", msg)
+	entry := rest.logRequest(request, id)
+	if correlationID := w.Header().Get(correlation.Header); len(correlationID) != 0 {
+		entry = entry.WithField(correlation.Key, correlationID)
+	}
+	entry.Warnln(code, " ", msg)
 	http.Error(w, msg, code)
 }
 
@@ -186,3 +247,82 @@ func notFound(w http.ResponseWriter, r *http.Request) {
 	logrus.Warnf("Not found: %+v ", r.URL)
 	http.NotFound(w, r)
 }
+
+// statusRecorder captures the status code written by a handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// observeAvailability records every request against the API availability
+// SLOs. Server errors count against the error budget, client errors do not.
+func observeAvailability(fn func(http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		fn(rec, r)
+
+		var err error
+		if rec.status >= http.StatusInternalServerError {
+			err = fmt.Errorf("%s %s returned %d", r.Method, r.URL.Path, rec.status)
+		}
+		slo.Observe(slo.OperationAPI, time.Since(start), err)
+	}
+}
+
+// readOnlyPluginPaths are the docker plugin requests which are reads even
+// though they are posted.
+var readOnlyPluginPaths = map[string]bool{
+	"/Plugin.Activate":           true,
+	"/VolumeDriver.Capabilities": true,
+	"/VolumeDriver.Get":          true,
+	"/VolumeDriver.List":         true,
+	"/VolumeDriver.Path":         true,
+}
+
+// readOnlyWhenKvdbDown rejects requests which modify state while kvdb is
+// down, so that reads keep being served from the drivers and caches.
+func readOnlyWhenKvdbDown(fn func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead &&
+			!readOnlyPluginPaths[r.URL.Path] && kvdbhealth.ReadOnly() {
+			http.Error(w, kvdbhealth.ErrReadOnly.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		fn(w, r)
+	}
+}
+
+// criticalPluginPaths are the docker plugin requests a container waits for
+// to start or stop.
+var criticalPluginPaths = map[string]bool{
+	"/VolumeDriver.Mount":   true,
+	"/VolumeDriver.Unmount": true,
+}
+
+// queued serves requests through the API request queue, once initialized.
+func queued(fn func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := apiqueue.Inst()
+		if err != nil {
+			fn(w, r)
+			return
+		}
+		class := apiqueue.ParseClass(r.Header.Get(apiqueue.Header))
+		if criticalPluginPaths[r.URL.Path] {
+			class = apiqueue.Critical
+		}
+		release, err := q.Acquire(r.Context(), class)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		defer release()
+		fn(w, r)
+	}
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/libopenstorage/openstorage/snapsched"
+)
+
+// snapSchedulesPath is the cluster route of the snapshot schedules
+const snapSchedulesPath = "/snapschedules"
+
+// swagger:operation POST /cluster/snapschedules cluster createSnapSchedule
+//
+// Create a snapshot schedule of a volume, taking a snapshot every interval
+// or following a cron expression.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: schedule
+//   in: body
+//   description: volume id, interval or cron expression and retention
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Schedule"
+// responses:
+//   '200':
+//     description: schedule created
+//     schema:
+//       "$ref": "#/definitions/Schedule"
+func (c *clusterApi) createSnapSchedule(w http.ResponseWriter, r *http.Request) {
+	method := "createSnapSchedule"
+
+	var s snapsched.Schedule
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.Validate(); err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	m, err := snapsched.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	created, err := m.Create(&s)
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(created)
+}
+
+// swagger:operation GET /cluster/snapschedules cluster enumerateSnapSchedules
+//
+// Enumerate the snapshot schedules, of a volume or of all volumes, with the
+// outcome of their last run.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: volume
+//   in: query
+//   description: id of the volume, all volumes if not set
+//   required: false
+//   type: string
+// responses:
+//   '200':
+//     description: schedules, oldest first
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Schedule"
+func (c *clusterApi) enumerateSnapSchedules(w http.ResponseWriter, r *http.Request) {
+	method := "enumerateSnapSchedules"
+	m, err := snapsched.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	schedules, err := m.Enumerate(r.URL.Query().Get("volume"))
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(schedules)
+}
+
+// swagger:operation GET /cluster/snapschedules/{id} cluster inspectSnapSchedule
+//
+// Inspect a snapshot schedule.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the schedule
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: schedule
+//     schema:
+//       "$ref": "#/definitions/Schedule"
+//   '404':
+//     description: schedule not found
+func (c *clusterApi) inspectSnapSchedule(w http.ResponseWriter, r *http.Request) {
+	method := "inspectSnapSchedule"
+	m, err := snapsched.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s, err := m.Inspect(mux.Vars(r)["id"])
+	if err == snapsched.ErrNotFound {
+		c.sendError(c.name, method, w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(s)
+}
+
+// swagger:operation DELETE /cluster/snapschedules/{id} cluster deleteSnapSchedule
+//
+// Delete a snapshot schedule. The snapshots it took are kept.
+//
+// ---
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the schedule
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: schedule deleted
+//   '404':
+//     description: schedule not found
+func (c *clusterApi) deleteSnapSchedule(w http.ResponseWriter, r *http.Request) {
+	method := "deleteSnapSchedule"
+	m, err := snapsched.Inst()
+	if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	err = m.Delete(mux.Vars(r)["id"])
+	if err == snapsched.ErrNotFound {
+		c.sendError(c.name, method, w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		c.sendError(c.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	clusterclient "github.com/libopenstorage/openstorage/api/client/cluster"
+	"github.com/libopenstorage/openstorage/snapsched"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// snapschedDriver knows a single volume.
+type snapschedDriver struct {
+	snapsched.Driver
+}
+
+func (d *snapschedDriver) Inspect(volumeIDs []string) ([]*api.Volume, error) {
+	if len(volumeIDs) == 1 && volumeIDs[0] == "vol1" {
+		return []*api.Volume{{Id: "vol1"}}, nil
+	}
+	return nil, nil
+}
+
+func TestSnapSchedules(t *testing.T) {
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	alertsManager, err := alerts.NewManager(kv)
+	require.NoError(t, err)
+	m := snapsched.NewManager(kv, &snapschedDriver{}, alertsManager)
+	oldInst := snapsched.Inst
+	snapsched.Inst = func() (*snapsched.Manager, error) {
+		return m, nil
+	}
+	defer func() {
+		snapsched.Inst = oldInst
+	}()
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	require.NoError(t, err)
+
+	var s snapsched.Schedule
+	err = c.Post().Resource("cluster" + snapSchedulesPath).
+		Body(&snapsched.Schedule{VolumeId: "vol1", Interval: time.Hour, MaxSnapshots: 3}).
+		Do().Unmarshal(&s)
+	require.NoError(t, err)
+	assert.NotEmpty(t, s.Id)
+	assert.Equal(t, 3, s.MaxSnapshots)
+
+	err = c.Post().Resource("cluster" + snapSchedulesPath).
+		Body(&snapsched.Schedule{VolumeId: "vol1"}).Do().Error()
+	assert.Error(t, err)
+
+	var schedules []*snapsched.Schedule
+	err = c.Get().Resource("cluster"+snapSchedulesPath).
+		QueryOption("volume", "vol1").Do().Unmarshal(&schedules)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	assert.Equal(t, s.Id, schedules[0].Id)
+
+	var inspected snapsched.Schedule
+	err = c.Get().Resource("cluster" + snapSchedulesPath + "/" + s.Id).Do().Unmarshal(&inspected)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, inspected.Interval)
+
+	err = c.Delete().Resource("cluster" + snapSchedulesPath + "/" + s.Id).Do().Error()
+	require.NoError(t, err)
+	err = c.Get().Resource("cluster" + snapSchedulesPath + "/" + s.Id).Do().Error()
+	assert.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/speclint"
+	"github.com/libopenstorage/openstorage/tenantpolicy"
+)
+
+// swagger:operation POST /osd-volumes/validate volume validateVolume
+//
+// Lint the spec of a volume, or a template of spec options, against the
+// tenant policies of the cluster and the capabilities of the driver,
+// without creating the volume.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: request
+//   in: body
+//   description: locator, spec and options of the volume
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Request"
+// responses:
+//   '200':
+//     description: findings of the volume
+//     schema:
+//       "$ref": "#/definitions/Result"
+func (vd *volAPI) validate(w http.ResponseWriter, r *http.Request) {
+	method := "validate"
+
+	var req speclint.Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	d, err := vd.getVolDriver(r)
+	if err != nil {
+		notFound(w, r)
+		return
+	}
+
+	// volumes are linted against no policy if none is declared
+	policies, err := tenantpolicy.Inst()
+	if err != nil && err != tenantpolicy.ErrNotInitialized {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(speclint.Lint(d, policies, &req))
+}
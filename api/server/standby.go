@@ -0,0 +1,109 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+
+	"github.com/libopenstorage/openstorage/leader"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/pkg/ca"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+)
+
+const (
+	// proxiedHeader marks the requests forwarded by a standby, so that they
+	// are not forwarded again while the lease changes hands.
+	proxiedHeader = "X-Openstorage-Proxied"
+	// principalHeader carries the principal of the client of the requests
+	// forwarded by a standby, which connects with its node certificate.
+	principalHeader = "X-Openstorage-Principal"
+)
+
+// standby forwards or fails the requests received while another node holds
+// the API server lease.
+type standby struct {
+	identity *ca.Identity
+
+	lock       sync.Mutex
+	transports map[string]http.RoundTripper
+}
+
+func newStandby(identity *ca.Identity) *standby {
+	return &standby{
+		identity:   identity,
+		transports: make(map[string]http.RoundTripper),
+	}
+}
+
+// routes returns routes served by the active node only.
+func (s *standby) routes(routes []*Route) []*Route {
+	active := make([]*Route, 0, len(routes))
+	for _, r := range routes {
+		active = append(active, &Route{verb: r.verb, path: r.path, fn: s.activeOnly(r.fn), stream: r.stream})
+	}
+	return active
+}
+
+// activeOnly serves requests with fn if the API server election is disabled
+// or this node is active. Standbys forward the requests to the active node,
+// or fail them with its endpoint, so that clients retry there. Requests
+// received while no node is active are failed with a Retry-After header.
+func (s *standby) activeOnly(fn func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		e, err := leader.Inst()
+		if err != nil || e.IsLeader() {
+			fn(w, r)
+			return
+		}
+		lease := e.Leader()
+		if lease == nil || len(lease.Endpoint) == 0 || len(r.Header.Get(proxiedHeader)) != 0 {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "No active API server", http.StatusServiceUnavailable)
+			return
+		}
+		if !e.Config().Proxy {
+			w.Header().Set(leader.EndpointHeader, lease.Endpoint)
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "API server is a standby of node "+lease.NodeId, http.StatusServiceUnavailable)
+			return
+		}
+		target, err := url.Parse(lease.Endpoint)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		transport, err := s.transport(lease.NodeId, target)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		proxy := httputil.NewSingleHostReverseProxy(target)
+		proxy.Transport = transport
+		r.Header.Set(proxiedHeader, e.Config().Endpoint)
+		r.Header.Set(principalHeader, auth.FromContext(r.Context()))
+		proxy.ServeHTTP(w, r)
+	}
+}
+
+// transport returns the transport to the active node nodeID. Connections to
+// a TLS endpoint authenticate both nodes with the identity of this node, so
+// that the active node trusts the principal forwarded.
+func (s *standby) transport(nodeID string, target *url.URL) (http.RoundTripper, error) {
+	if target.Scheme != "https" || s.identity == nil {
+		return http.DefaultTransport, nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if t, ok := s.transports[nodeID]; ok {
+		return t, nil
+	}
+	config, err := crypto.GetPolicy().TLSConfig(s.identity.ClientTLSConfig(nodeID))
+	if err != nil {
+		return nil, err
+	}
+	t := &http.Transport{TLSClientConfig: config}
+	s.transports[nodeID] = t
+	return t, nil
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/leader"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStandbyProxy(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+
+	served := func(node string) func(http.ResponseWriter, *http.Request) {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(node))
+		}
+	}
+	active := httptest.NewServer(http.HandlerFunc(served("node1")))
+	defer active.Close()
+	a := leader.New(kv, "api", "node1", &leader.Config{Enabled: true, Endpoint: active.URL})
+	require.NoError(t, a.Campaign(time.Now()))
+
+	// this node is a standby of node1
+	e := leader.New(kv, "api", "node2", &leader.Config{Enabled: true, Proxy: true})
+	require.NoError(t, leader.Init(e))
+	require.NoError(t, e.Campaign(time.Now()))
+
+	routes := newStandby(nil).routes([]*Route{{verb: "GET", path: "/", fn: served("node2")}})
+	ts := httptest.NewServer(http.HandlerFunc(routes[0].fn))
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "node1", string(body))
+
+	// the principal of the client is forwarded
+	principal := make(chan string, 1)
+	forwarded := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal <- r.Header.Get(principalHeader)
+	}))
+	defer forwarded.Close()
+	require.NoError(t, a.Resign())
+	a = leader.New(kv, "api", "node1", &leader.Config{Enabled: true, Endpoint: forwarded.URL})
+	require.NoError(t, a.Campaign(time.Now()))
+	require.NoError(t, e.Campaign(time.Now()))
+	w := httptest.NewRecorder()
+	req, err := http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set(principalHeader, "cert:forged")
+	routes[0].fn(w, req.WithContext(auth.NewContext(req.Context(), "uid:1000")))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "uid:1000", <-principal)
+
+	// requests already forwarded are not forwarded again
+	req, err = http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set(proxiedHeader, "node3")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, "1", resp.Header.Get("Retry-After"))
+
+	// requests are served once this node is active
+	require.NoError(t, a.Resign())
+	require.NoError(t, e.Campaign(time.Now()))
+	resp, err = http.Get(ts.URL)
+	require.NoError(t, err)
+	body, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "node2", string(body))
+}
+
+func TestAuthenticatedForwarded(t *testing.T) {
+	var principal string
+	fn := authenticated(func(w http.ResponseWriter, r *http.Request) {
+		principal = auth.FromContext(r.Context())
+	})
+	node := &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "node2"}}}},
+	}
+
+	for _, test := range []struct {
+		name     string
+		tls      *tls.ConnectionState
+		headers  map[string]string
+		expected string
+	}{
+		{"node", node, nil, "cert:node2"},
+		{"forwarded", node, map[string]string{proxiedHeader: "node2", principalHeader: "uid:1000"}, "uid:1000"},
+		{"not proxied", node, map[string]string{principalHeader: "uid:1000"}, "cert:node2"},
+		{"no certificate", nil, map[string]string{proxiedHeader: "node2", principalHeader: "uid:1000"}, auth.Anonymous},
+	} {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.TLS = test.tls
+		for k, v := range test.headers {
+			req.Header.Set(k, v)
+		}
+		fn(httptest.NewRecorder(), req)
+		assert.Equal(t, test.expected, principal, test.name)
+	}
+}
@@ -4,16 +4,24 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/kubernetes-csi/csi-test/utils"
 
 	"github.com/golang/mock/gomock"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/libopenstorage/openstorage/audit"
 	"github.com/libopenstorage/openstorage/cluster"
 	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
 	mockcluster "github.com/libopenstorage/openstorage/cluster/mock"
+	"github.com/libopenstorage/openstorage/cost"
+	"github.com/libopenstorage/openstorage/jobs"
+	"github.com/libopenstorage/openstorage/statshistory"
 	"github.com/libopenstorage/openstorage/volume"
 	volumedrivers "github.com/libopenstorage/openstorage/volume/drivers"
 	mockdriver "github.com/libopenstorage/openstorage/volume/drivers/mock"
@@ -134,3 +142,68 @@ func (s *testServer) Stop() {
 	// Check mocks
 	s.mc.Finish()
 }
+
+// setupTestKvdb sets the kvdb instance, which cannot be reset, once for all
+// the tests.
+func setupTestKvdb(t *testing.T) kvdb.Kvdb {
+	if kv := kvdb.Instance(); kv != nil {
+		return kv
+	}
+	kv, err := kvdb.New(mem.Name, "server_test", []string{}, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, kvdb.SetInstance(kv))
+	return kv
+}
+
+// testManagers are the managers the handlers get from the Inst of their
+// packages, replaced for a test.
+type testManagers struct {
+	jobs  jobs.Manager
+	audit audit.Logger
+	cost  cost.Manager
+	stats statshistory.Store
+
+	oldJobs  func() (jobs.Manager, error)
+	oldAudit func() (audit.Logger, error)
+	oldCost  func() (cost.Manager, error)
+	oldStats func() (statshistory.Store, error)
+}
+
+// newTestManagers sets the Inst of the jobs, audit, cost and stats history
+// packages to return new managers. Finish must be called to set the
+// previous ones back.
+func newTestManagers(t *testing.T) *testManagers {
+	kv, err := kvdb.New(mem.Name, "managers_test", []string{}, nil, nil)
+	require.NoError(t, err)
+	m := &testManagers{
+		jobs:  jobs.NewManager(kv),
+		audit: audit.NewLogger(kv, "node1"),
+		cost:  cost.NewManager(kv),
+		stats: statshistory.NewStore(time.Hour),
+
+		oldJobs:  jobs.Inst,
+		oldAudit: audit.Inst,
+		oldCost:  cost.Inst,
+		oldStats: statshistory.Inst,
+	}
+	jobs.Inst = func() (jobs.Manager, error) {
+		return m.jobs, nil
+	}
+	audit.Inst = func() (audit.Logger, error) {
+		return m.audit, nil
+	}
+	cost.Inst = func() (cost.Manager, error) {
+		return m.cost, nil
+	}
+	statshistory.Inst = func() (statshistory.Store, error) {
+		return m.stats, nil
+	}
+	return m
+}
+
+func (m *testManagers) Finish() {
+	jobs.Inst = m.oldJobs
+	audit.Inst = m.oldAudit
+	cost.Inst = m.oldCost
+	statshistory.Inst = m.oldStats
+}
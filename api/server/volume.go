@@ -6,11 +6,17 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/api/errors"
+	"github.com/libopenstorage/openstorage/audit"
 	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/pkg/wipe"
+	"github.com/libopenstorage/openstorage/slo"
+	"github.com/libopenstorage/openstorage/snapusage"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers"
 )
@@ -168,6 +174,14 @@ func (vd *volAPI) create(w http.ResponseWriter, r *http.Request) {
 	id, err := d.Create(dcReq.Locator, dcReq.Source, dcReq.Spec)
 	dcRes.VolumeResponse = &api.VolumeResponse{Error: responseStatus(err)}
 	dcRes.Id = id
+	if err == nil {
+		eventbus.PublishContext(r.Context(), eventbus.EventVolumeCreate, id, &api.Volume{
+			Id:      id,
+			Locator: dcReq.Locator,
+			Source:  dcReq.Source,
+			Spec:    dcReq.Spec,
+		})
+	}
 
 	vd.logRequest(method, id).Infoln("")
 
@@ -271,9 +285,21 @@ func (vd *volAPI) volumeSet(w http.ResponseWriter, r *http.Request) {
 	for err == nil && req.Action != nil {
 		if req.Action.Attach != api.VolumeActionParam_VOLUME_ACTION_PARAM_NONE {
 			if req.Action.Attach == api.VolumeActionParam_VOLUME_ACTION_PARAM_ON {
-				_, err = d.Attach(volumeID, req.Options)
+				var devPath string
+				start := time.Now()
+				devPath, err = d.Attach(volumeID, req.Options)
+				slo.Observe(slo.OperationAttach, time.Since(start), err)
+				if err == nil {
+					eventbus.PublishContext(r.Context(), eventbus.EventVolumeAttach, volumeID,
+						map[string]string{"device_path": devPath})
+					audit.LogAccess(r.Context(), eventbus.EventVolumeAttach, volumeID,
+						map[string]string{"device_path": devPath})
+				}
 			} else {
-				err = d.Detach(volumeID, req.Options)
+				if err = d.Detach(volumeID, req.Options); err == nil {
+					eventbus.PublishContext(r.Context(), eventbus.EventVolumeDetach, volumeID, nil)
+					audit.LogAccess(r.Context(), eventbus.EventVolumeDetach, volumeID, nil)
+				}
 			}
 			if err != nil {
 				break
@@ -286,9 +312,22 @@ func (vd *volAPI) volumeSet(w http.ResponseWriter, r *http.Request) {
 					err = fmt.Errorf("Invalid mount path")
 					break
 				}
+				start := time.Now()
 				err = d.Mount(volumeID, req.Action.MountPath, req.Options)
+				slo.Observe(slo.OperationMount, time.Since(start), err)
+				if err == nil {
+					eventbus.PublishContext(r.Context(), eventbus.EventVolumeMount, volumeID,
+						map[string]string{"mount_path": req.Action.MountPath})
+					audit.LogAccess(r.Context(), eventbus.EventVolumeMount, volumeID,
+						map[string]string{"mount_path": req.Action.MountPath})
+				}
 			} else {
-				err = d.Unmount(volumeID, req.Action.MountPath, req.Options)
+				if err = d.Unmount(volumeID, req.Action.MountPath, req.Options); err == nil {
+					eventbus.PublishContext(r.Context(), eventbus.EventVolumeUnmount, volumeID,
+						map[string]string{"mount_path": req.Action.MountPath})
+					audit.LogAccess(r.Context(), eventbus.EventVolumeUnmount, volumeID,
+						map[string]string{"mount_path": req.Action.MountPath})
+				}
 			}
 			if err != nil {
 				break
@@ -402,8 +441,25 @@ func (vd *volAPI) delete(w http.ResponseWriter, r *http.Request) {
 
 	volumeResponse := &api.VolumeResponse{}
 
+	// Volumes with secure delete enabled are wiped and deleted by a job
+	vols, err := d.Inspect([]string{volumeID})
+	if err == nil && len(vols) == 1 {
+		if wipeMethod, ok := vols[0].GetSpec().GetVolumeLabels()[api.SpecSecureDelete]; ok {
+			job, err := wipe.SecureDelete(r.Context(), d, volumeID, wipeMethod)
+			if err != nil {
+				volumeResponse.Error = err.Error()
+			} else {
+				vd.logRequest(method, volumeID).Infof("Secure delete job %s submitted", job.Id)
+			}
+			json.NewEncoder(w).Encode(volumeResponse)
+			return
+		}
+	}
+
 	if err := d.Delete(volumeID); err != nil {
 		volumeResponse.Error = err.Error()
+	} else {
+		eventbus.PublishContext(r.Context(), eventbus.EventVolumeDelete, volumeID, nil)
 	}
 	json.NewEncoder(w).Encode(volumeResponse)
 }
@@ -858,6 +914,99 @@ func (vd *volAPI) volumeusage(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(capacityInfo)
 }
 
+// swagger:operation GET /osd-volumes/snapusage/{id} volume snapshotUsage
+//
+// Get the space used by the volume with specified id and by each of its
+// snapshots, exclusive or shared with the others. The snapshots reclaiming
+// the most space when deleted come first.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the volume
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: space used by the volume and its snapshots
+//     schema:
+//       "$ref": "#/definitions/Report"
+func (vd *volAPI) snapUsage(w http.ResponseWriter, r *http.Request) {
+	method := "snapUsage"
+	volumeID, err := vd.parseID(r)
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	d, err := vd.getVolDriver(r)
+	if err != nil {
+		notFound(w, r)
+		return
+	}
+
+	vols, err := d.Inspect([]string{volumeID})
+	if err != nil || len(vols) == 0 {
+		vd.sendError(vd.name, method, w, fmt.Sprintf("Volume %s not found", volumeID), http.StatusNotFound)
+		return
+	}
+	report, err := snapusage.Compute(d, vols[0])
+	if err == volume.ErrNotSupported {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusNotImplemented)
+		return
+	} else if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(report)
+}
+
+// swagger:operation GET /osd-volumes/accesses/{id} volume volumeAccesses
+//
+// Get the accesses to the volume with specified id, oldest first: who
+// attached, detached, mounted or unmounted it, from which node and when,
+// as recorded in the audit log.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the volume
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: audit records of the accesses
+//     schema:
+//       type: array
+//       items:
+//         $ref: '#/definitions/Record'
+func (vd *volAPI) accesses(w http.ResponseWriter, r *http.Request) {
+	method := "accesses"
+	volumeID, err := vd.parseID(r)
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	auditLog, err := audit.Inst()
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	records, err := auditLog.EnumerateResource(volumeID, audit.AccessActions...)
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(records)
+}
+
 // swagger:operation GET /osd-volumes/quiesce/{id} volume quiesceVolume
 //
 // Quiesce volume with specified id.
@@ -969,6 +1118,134 @@ func (vd *volAPI) unquiesce(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(volumeResponse)
 }
 
+// swagger:operation POST /osd-volumes/resize/{id} volume resizeVolume
+//
+// Grow volume with specified id, and its filesystem online if it is mounted.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the volume to resize
+//   required: true
+//   type: string
+// - name: NewSize
+//   in: query
+//   description: new size of the volume in bytes, not lower than its size
+//   required: true
+//   type: integer
+// responses:
+//   '200':
+//     description: volume resize response
+//     schema:
+//         "$ref": "#/definitions/VolumeResponse"
+//   default:
+//     description: unexpected error
+//     schema:
+//       "$ref": "#/definitions/VolumeResponse"
+func (vd *volAPI) resize(w http.ResponseWriter, r *http.Request) {
+	var volumeID string
+	var err error
+	method := "resize"
+
+	if volumeID, err = vd.parseID(r); err != nil {
+		e := fmt.Errorf("Failed to parse parse volumeID: %s", err.Error())
+		vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	newSize, err := strconv.ParseUint(r.URL.Query().Get(api.OptNewSize), 10, 64)
+	if err != nil || newSize == 0 {
+		vd.sendError(vd.name, method, w, api.OptNewSize+" must be a positive int",
+			http.StatusBadRequest)
+		return
+	}
+
+	d, err := vd.getVolDriver(r)
+	if err != nil {
+		notFound(w, r)
+		return
+	}
+
+	volumeResponse := &api.VolumeResponse{}
+	if err := d.Resize(volumeID, newSize); err != nil {
+		volumeResponse.Error = responseStatus(err)
+	}
+	json.NewEncoder(w).Encode(volumeResponse)
+}
+
+// swagger:operation POST /osd-volumes/clone/{id} volume cloneVolume
+//
+// Create a writable volume independent of the volume with specified id, with
+// a copy of its data.
+//
+// ---
+// consumes:
+// - application/json
+// produces:
+// - application/json
+// parameters:
+// - name: id
+//   in: path
+//   description: id of the volume to clone
+//   required: true
+//   type: string
+// - name: locator
+//   in: body
+//   description: locator of the clone
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/VolumeLocator"
+// responses:
+//   '200':
+//     description: volume create response
+//     schema:
+//         "$ref": "#/definitions/VolumeCreateResponse"
+//   default:
+//     description: unexpected error
+//     schema:
+//       "$ref": "#/definitions/VolumeCreateResponse"
+func (vd *volAPI) clone(w http.ResponseWriter, r *http.Request) {
+	var volumeID string
+	var err error
+	method := "clone"
+
+	if volumeID, err = vd.parseID(r); err != nil {
+		e := fmt.Errorf("Failed to parse parse volumeID: %s", err.Error())
+		vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
+		return
+	}
+
+	locator := &api.VolumeLocator{}
+	if err := json.NewDecoder(r.Body).Decode(locator); err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	d, err := vd.getVolDriver(r)
+	if err != nil {
+		notFound(w, r)
+		return
+	}
+
+	var dcRes api.VolumeCreateResponse
+	id, err := d.Clone(volumeID, locator)
+	dcRes.VolumeResponse = &api.VolumeResponse{Error: responseStatus(err)}
+	dcRes.Id = id
+	if err == nil {
+		eventbus.PublishContext(r.Context(), eventbus.EventVolumeCreate, id, &api.Volume{
+			Id:      id,
+			Locator: locator,
+		})
+	}
+
+	vd.logRequest(method, id).Infoln("")
+
+	json.NewEncoder(w).Encode(&dcRes)
+}
+
 // swagger:operation POST /osd-snapshots/groupsnap volumegroup snapVolumeGroup
 //
 // Take a snapshot of volumegroup
@@ -1134,9 +1411,22 @@ func migratePath(route, version string) string {
 	return volVersion(route, version)
 }
 
+func jobsPath(route, version string) string {
+	return volVersion(api.OsdJobsPath+route, version)
+}
+
+func costsPath(route, version string) string {
+	return volVersion(api.OsdCostsPath+route, version)
+}
+
+func grafanaPath(route, version string) string {
+	return volVersion(api.OsdGrafanaPath+route, version)
+}
+
 func (vd *volAPI) Routes() []*Route {
 	return []*Route{
 		{verb: "GET", path: "/" + api.OsdVolumePath + "/versions", fn: vd.versions},
+		{verb: "GET", path: volVersion("status", volume.APIVersion), fn: vd.driverStatus},
 		{verb: "POST", path: volPath("", volume.APIVersion), fn: vd.create},
 		{verb: "PUT", path: volPath("/{id}", volume.APIVersion), fn: vd.volumeSet},
 		{verb: "GET", path: volPath("", volume.APIVersion), fn: vd.enumerate},
@@ -1150,9 +1440,17 @@ func (vd *volAPI) Routes() []*Route {
 		{verb: "GET", path: volPath("/requests/{id}", volume.APIVersion), fn: vd.requests},
 		{verb: "GET", path: volPath("/usage", volume.APIVersion), fn: vd.volumeusage},
 		{verb: "GET", path: volPath("/usage/{id}", volume.APIVersion), fn: vd.volumeusage},
+		{verb: "GET", path: volPath("/snapusage/{id}", volume.APIVersion), fn: vd.snapUsage},
+		{verb: "GET", path: volPath("/accesses/{id}", volume.APIVersion), fn: vd.accesses},
 		{verb: "POST", path: volPath("/quiesce/{id}", volume.APIVersion), fn: vd.quiesce},
 		{verb: "POST", path: volPath("/unquiesce/{id}", volume.APIVersion), fn: vd.unquiesce},
+		{verb: "POST", path: volPath("/resize/{id}", volume.APIVersion), fn: vd.resize},
+		{verb: "POST", path: volPath("/clone/{id}", volume.APIVersion), fn: vd.clone},
+		{verb: "POST", path: volPath("/validate", volume.APIVersion), fn: vd.validate},
 		{verb: "GET", path: volPath("/catalog/{id}", volume.APIVersion), fn: vd.catalog},
+		{verb: "POST", path: volPath("/rotatekey/{id}", volume.APIVersion), fn: vd.rotateKey},
+		{verb: "POST", path: volPath("/poolexpand/{id}", volume.APIVersion), fn: vd.poolExpand},
+		{verb: "PUT", path: volPath("/labels/{id}", volume.APIVersion), fn: vd.patchLabels},
 		{verb: "POST", path: snapPath("", volume.APIVersion), fn: vd.snap},
 		{verb: "GET", path: snapPath("", volume.APIVersion), fn: vd.snapEnumerate},
 		{verb: "POST", path: snapPath("/restore/{id}", volume.APIVersion), fn: vd.restore},
@@ -1178,5 +1476,15 @@ func (vd *volAPI) Routes() []*Route {
 		{verb: "POST", path: migratePath(api.OsdMigrateStartPath, volume.APIVersion), fn: vd.cloudMigrateStart},
 		{verb: "POST", path: migratePath(api.OsdMigrateCancelPath, volume.APIVersion), fn: vd.cloudMigrateCancel},
 		{verb: "GET", path: migratePath(api.OsdMigrateStatusPath, volume.APIVersion), fn: vd.cloudMigrateStatus},
+		{verb: "GET", path: jobsPath("", volume.APIVersion), fn: vd.jobEnumerate},
+		{verb: "GET", path: jobsPath("/{id}", volume.APIVersion), fn: vd.jobInspect},
+		{verb: "POST", path: jobsPath("/{id}/cancel", volume.APIVersion), fn: vd.jobCancel},
+		{verb: "POST", path: jobsPath("/{id}/requeue", volume.APIVersion), fn: vd.jobRequeue},
+		{verb: "GET", path: costsPath("", volume.APIVersion), fn: vd.costReport},
+		{verb: "GET", path: costsPath("/pricesheet", volume.APIVersion), fn: vd.getPriceSheet},
+		{verb: "PUT", path: costsPath("/pricesheet", volume.APIVersion), fn: vd.setPriceSheet},
+		{verb: "GET", path: grafanaPath("", volume.APIVersion), fn: vd.grafanaTest},
+		{verb: "POST", path: grafanaPath("/search", volume.APIVersion), fn: vd.grafanaSearch},
+		{verb: "POST", path: grafanaPath("/query", volume.APIVersion), fn: vd.grafanaQuery},
 	}
 }
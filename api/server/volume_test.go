@@ -1,11 +1,21 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/libopenstorage/openstorage/api"
 	volumeclient "github.com/libopenstorage/openstorage/api/client/volume"
+	"github.com/libopenstorage/openstorage/audit"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/jobs"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/pkg/wipe"
+	"github.com/libopenstorage/openstorage/snapusage"
+	"github.com/libopenstorage/openstorage/speclint"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -162,6 +172,10 @@ func TestVolumeDeleteSuccess(t *testing.T) {
 	// Setup mock
 	id := "myid"
 
+	testVolDriver.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{&api.Volume{Id: id, Spec: &api.VolumeSpec{}}}, nil)
 	testVolDriver.MockDriver().
 		EXPECT().
 		Delete(id).
@@ -187,6 +201,10 @@ func TestVolumeDeleteFailed(t *testing.T) {
 	// Setup mock
 	id := "myid"
 
+	testVolDriver.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{&api.Volume{Id: id, Spec: &api.VolumeSpec{}}}, nil)
 	testVolDriver.MockDriver().
 		EXPECT().
 		Delete(id).
@@ -200,6 +218,74 @@ func TestVolumeDeleteFailed(t *testing.T) {
 	assert.Contains(t, err.Error(), "error in delete")
 }
 
+func TestVolumeSecureDelete(t *testing.T) {
+
+	var err error
+
+	ts, testVolDriver := testRestServer(t)
+
+	defer ts.Close()
+	defer testVolDriver.Stop()
+	tm := newTestManagers(t)
+	defer tm.Finish()
+	jm, auditLog := tm.jobs, tm.audit
+
+	client, err := volumeclient.NewDriverClient(ts.URL, mockDriverName, version, mockDriverName)
+
+	// Setup mock
+	id := "myid"
+	deleted := make(chan struct{})
+
+	testVolDriver.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{&api.Volume{
+			Id: id,
+			Spec: &api.VolumeSpec{
+				VolumeLabels: map[string]string{
+					api.SpecSecureDelete: api.SecureDeleteOverwrite,
+				},
+			},
+		}}, nil)
+	gomock.InOrder(
+		testVolDriver.MockDriver().
+			EXPECT().
+			Wipe(id, api.SecureDeleteOverwrite).
+			Return(&api.WipeCertificate{VolumeId: id, Method: api.SecureDeleteOverwrite}, nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Delete(id).
+			Do(func(string) { close(deleted) }).
+			Return(nil),
+	)
+
+	// create client
+	driverclient := volumeclient.VolumeDriver(client)
+
+	err = driverclient.Delete(id)
+	assert.Nil(t, err)
+	<-deleted
+
+	var jobList []*jobs.Job
+	for i := 0; i < 100; i++ {
+		jobList, err = jm.Enumerate()
+		require.NoError(t, err)
+		require.Len(t, jobList, 1)
+		if jobList[0].State.Done() {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, wipe.JobType, jobList[0].Type)
+	assert.Equal(t, jobs.StateDone, jobList[0].State)
+
+	records, err := auditLog.Enumerate()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, wipe.AuditAction, records[0].Action)
+	assert.Equal(t, id, records[0].ResourceId)
+}
+
 func TestVolumeSnapshotCreateSuccess(t *testing.T) {
 
 	var err error
@@ -685,6 +771,103 @@ func TestVolumeMountSuccess(t *testing.T) {
 	assert.Nil(t, res)
 }
 
+func TestVolumeAccesses(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+	tm := newTestManagers(t)
+	defer tm.Finish()
+
+	client, err := volumeclient.NewDriverClient(ts.URL, mockDriverName, version, mockDriverName)
+	require.NoError(t, err)
+
+	id := "accessed"
+	gomock.InOrder(
+		testVolDriver.MockDriver().
+			EXPECT().
+			Mount(id, "/mnt", gomock.Any()).
+			Return(nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{{Id: id}}, nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Unmount(id, "/mnt", gomock.Any()).
+			Return(nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{{Id: id}}, nil),
+	)
+	driverclient := volumeclient.VolumeDriver(client)
+	require.NoError(t, driverclient.Mount(id, "/mnt", nil))
+	require.NoError(t, driverclient.Unmount(id, "/mnt", nil))
+
+	resp, err := http.Get(ts.URL + volPath("/accesses/"+id, version))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var records []*audit.Record
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&records))
+	require.Len(t, records, 2)
+	assert.Equal(t, eventbus.EventVolumeMount, records[0].Action)
+	assert.Equal(t, eventbus.EventVolumeUnmount, records[1].Action)
+	for _, record := range records {
+		assert.Equal(t, auth.Anonymous, record.Principal)
+		assert.Equal(t, "node1", record.NodeId)
+		assert.Equal(t, "/mnt", record.Details["mount_path"])
+	}
+}
+
+func TestVolumeSnapUsage(t *testing.T) {
+	ts, testVolDriver := testRestServer(t)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	id := "snapped"
+	snaps := []*api.Volume{
+		{Id: "snap1", Source: &api.Source{Parent: id}, Readonly: true},
+		{Id: "snap2", Source: &api.Source{Parent: id}, Readonly: true},
+	}
+	usage := func(exclusive int64) *api.CapacityUsageResponse {
+		return &api.CapacityUsageResponse{CapacityUsageInfo: &api.CapacityUsageInfo{
+			ExclusiveBytes: exclusive,
+			TotalBytes:     exclusive,
+		}}
+	}
+	mock := testVolDriver.MockDriver()
+	mock.EXPECT().Inspect([]string{id}).Return([]*api.Volume{{Id: id}}, nil)
+	mock.EXPECT().CapacityUsage(id).Return(usage(4096), nil)
+	mock.EXPECT().SnapEnumerate([]string{id}, nil).Return(snaps, nil)
+	mock.EXPECT().CapacityUsage("snap1").Return(usage(1024), nil)
+	mock.EXPECT().CapacityUsage("snap2").Return(usage(2048), nil)
+	mock.EXPECT().Inspect([]string{"missing"}).Return(nil, nil)
+
+	get := func(id string) (*http.Response, error) {
+		req, err := http.NewRequest("GET", ts.URL+volPath("/snapusage/"+id, version), nil)
+		require.NoError(t, err)
+		req.Header.Set("User-Agent", mockDriverName)
+		return http.DefaultClient.Do(req)
+	}
+	resp, err := get(id)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var report snapusage.Report
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+	assert.Equal(t, int64(4096), report.Volume.ExclusiveBytes)
+	require.Len(t, report.Snapshots, 2)
+	assert.Equal(t, "snap2", report.Snapshots[0].Id)
+	assert.Equal(t, "snap1", report.Snapshots[1].Id)
+	assert.Equal(t, int64(3072), report.ReclaimableBytes)
+
+	resp, err = get("missing")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
 func TestVolumeMountFailedNoMountPath(t *testing.T) {
 
 	var err error
@@ -933,6 +1116,76 @@ func TestVolumeQuiesceFailed(t *testing.T) {
 	assert.Contains(t, res.Error(), "error in quiesce")
 }
 
+func TestVolumeResize(t *testing.T) {
+
+	var err error
+	ts, testVolDriver := testRestServer(t)
+
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	client, err := volumeclient.NewDriverClient(ts.URL, mockDriverName, version, mockDriverName)
+	assert.Nil(t, err)
+
+	id := "myid"
+	gomock.InOrder(
+		testVolDriver.MockDriver().
+			EXPECT().
+			Resize(id, uint64(2*1024*1024*1024)).
+			Return(nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Resize(id, uint64(1024)).
+			Return(fmt.Errorf("error in resize")),
+	)
+
+	driverclient := volumeclient.VolumeDriver(client)
+	res := driverclient.Resize(id, 2*1024*1024*1024)
+	assert.Nil(t, res)
+
+	res = driverclient.Resize(id, 1024)
+	assert.NotNil(t, res)
+	assert.Contains(t, res.Error(), "error in resize")
+
+	// a size is required
+	res = driverclient.Resize(id, 0)
+	assert.NotNil(t, res)
+}
+
+func TestVolumeClone(t *testing.T) {
+
+	var err error
+	ts, testVolDriver := testRestServer(t)
+
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	client, err := volumeclient.NewDriverClient(ts.URL, mockDriverName, version, mockDriverName)
+	assert.Nil(t, err)
+
+	id := "myid"
+	locator := &api.VolumeLocator{Name: "myclone"}
+	gomock.InOrder(
+		testVolDriver.MockDriver().
+			EXPECT().
+			Clone(id, locator).
+			Return("cloneid", nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Clone(id, locator).
+			Return("", fmt.Errorf("error in clone")),
+	)
+
+	driverclient := volumeclient.VolumeDriver(client)
+	cloneID, err := driverclient.Clone(id, locator)
+	assert.Nil(t, err)
+	assert.Equal(t, "cloneid", cloneID)
+
+	_, err = driverclient.Clone(id, locator)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error in clone")
+}
+
 /* TODO(ram-infrac) : Test case is failing, recheck
 func TestVolumeUnquiesceSuccess(t *testing.T) {
 
@@ -1627,3 +1880,42 @@ func TestVolumeCatalogFailed(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "error in volume catalog")
 }
+
+func TestVolumeValidate(t *testing.T) {
+
+	var err error
+	ts, testVolDriver := testRestServer(t)
+
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	client, err := volumeclient.NewDriverClient(ts.URL, mockDriverName, version, mockDriverName)
+	assert.Nil(t, err)
+
+	testVolDriver.MockDriver().
+		EXPECT().
+		Name().
+		Return(mockDriverName).
+		AnyTimes()
+	testVolDriver.MockDriver().
+		EXPECT().
+		Type().
+		Return(api.DriverType_DRIVER_TYPE_BLOCK).
+		AnyTimes()
+
+	result, err := volumeclient.Lint(client, &speclint.Request{
+		Locator: &api.VolumeLocator{Name: "myvol"},
+		Options: map[string]string{api.SpecSize: "10G", api.SpecHaLevel: "2"},
+	})
+	assert.Nil(t, err)
+	assert.True(t, result.Valid)
+	assert.Equal(t, uint64(10*1024*1024*1024), result.Spec.Size)
+	assert.Len(t, result.Findings, 1)
+	assert.Equal(t, "spec.ha_level", result.Findings[0].Field)
+
+	result, err = volumeclient.Lint(client, &speclint.Request{
+		Options: map[string]string{api.SpecSize: "ten"},
+	})
+	assert.Nil(t, err)
+	assert.False(t, result.Valid)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
 	"github.com/libopenstorage/openstorage/pkg/parser"
 	"github.com/libopenstorage/openstorage/pkg/units"
 )
@@ -93,6 +94,9 @@ var (
 	passphraseRegex             = regexp.MustCompile(api.SpecPassphrase + "=([0-9A-Za-z_@./#&+-]+),?")
 	stickyRegex                 = regexp.MustCompile(api.SpecSticky + "=([A-Za-z]+),?")
 	secureRegex                 = regexp.MustCompile(api.SpecSecure + "=([A-Za-z]+),?")
+	cipherRegex                 = regexp.MustCompile(api.SpecCipher + "=([0-9A-Za-z:-]+),?")
+	kdfRegex                    = regexp.MustCompile(api.SpecKDF + "=([0-9A-Za-z-]+),?")
+	secureDeleteRegex           = regexp.MustCompile(api.SpecSecureDelete + "=([A-Za-z-]+),?")
 	zonesRegex                  = regexp.MustCompile(api.SpecZones + "=([A-Za-z]+),?")
 	racksRegex                  = regexp.MustCompile(api.SpecRacks + "=([A-Za-z]+),?")
 	rackRegex                   = regexp.MustCompile(api.SpecRack + "=([A-Za-z]+),?")
@@ -278,6 +282,23 @@ func (d *specHandler) UpdateSpecFromOpts(opts map[string]string, spec *api.Volum
 		case api.SpecPassphrase:
 			spec.Encrypted = true
 			spec.Passphrase = v
+		case api.SpecCipher:
+			if err := crypto.GetPolicy().CheckCipher(v); err != nil {
+				return nil, nil, nil, err
+			}
+			spec.VolumeLabels[k] = v
+		case api.SpecKDF:
+			if err := crypto.GetPolicy().CheckKDF(v); err != nil {
+				return nil, nil, nil, err
+			}
+			spec.VolumeLabels[k] = v
+		case api.SpecSecureDelete:
+			switch v {
+			case api.SecureDeleteOverwrite, api.SecureDeleteDiscard, api.SecureDeleteCryptoErase:
+				spec.VolumeLabels[k] = v
+			default:
+				return nil, nil, nil, fmt.Errorf("Unknown secure delete method %q", v)
+			}
 		case api.SpecGroup:
 			spec.Group = &api.Group{Id: v}
 		case api.SpecGroupEnforce:
@@ -416,6 +437,15 @@ func (d *specHandler) SpecOptsFromString(
 	if ok, passphrase := d.getVal(passphraseRegex, str); ok {
 		opts[api.SpecPassphrase] = passphrase
 	}
+	if ok, cipher := d.getVal(cipherRegex, str); ok {
+		opts[api.SpecCipher] = cipher
+	}
+	if ok, kdf := d.getVal(kdfRegex, str); ok {
+		opts[api.SpecKDF] = kdf
+	}
+	if ok, secureDelete := d.getVal(secureDeleteRegex, str); ok {
+		opts[api.SpecSecureDelete] = secureDelete
+	}
 	if ok, zones := d.getVal(zonesRegex, str); ok {
 		opts[api.SpecZones] = zones
 	}
@@ -197,3 +197,26 @@ func TestForceUnsupportedFsType(t *testing.T) {
 	spec = testSpecFromString(t, api.SpecRack, "ignore")
 	require.False(t, spec.ForceUnsupportedFsType)
 }
+
+func TestCipher(t *testing.T) {
+	testSpecOptString(t, api.SpecCipher, "aes-xts-plain64")
+	testSpecOptString(t, api.SpecKDF, "pbkdf2")
+
+	spec := testSpecFromString(t, api.SpecCipher, "aes-cbc-essiv:sha256")
+	require.Equal(t, "aes-cbc-essiv:sha256", spec.GetVolumeLabels()[api.SpecCipher])
+
+	spec = testSpecFromString(t, api.SpecKDF, "argon2id")
+	require.Equal(t, "argon2id", spec.GetVolumeLabels()[api.SpecKDF])
+
+	testSpecFromStringErr(t, api.SpecCipher, "rot13")
+	testSpecFromStringErr(t, api.SpecKDF, "md5")
+}
+
+func TestSecureDelete(t *testing.T) {
+	testSpecOptString(t, api.SpecSecureDelete, api.SecureDeleteCryptoErase)
+
+	spec := testSpecFromString(t, api.SpecSecureDelete, api.SecureDeleteOverwrite)
+	require.Equal(t, api.SecureDeleteOverwrite, spec.GetVolumeLabels()[api.SpecSecureDelete])
+
+	testSpecFromStringErr(t, api.SpecSecureDelete, "shred")
+}
@@ -0,0 +1,183 @@
+// Package attachlimit enforces the maximum number of volumes attached to a
+// node, such as the EBS attachment limit of an instance, and reports the
+// remaining attachment slots to the node API and CSI.
+package attachlimit
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/common"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// DefaultLimits are the attachment limits of the cloud providers, once
+	// the root and network devices are accounted for
+	DefaultLimits = map[string]int64{
+		"aws": 39,
+		"gce": 127,
+	}
+
+	// ErrNotInitialized returned when the attachment limiter has not been initialized
+	ErrNotInitialized = errors.New("openstorage.attachlimit: not initialized")
+	// ErrInitialized returned when the attachment limiter is initialized twice
+	ErrInitialized = errors.New("openstorage.attachlimit: already initialized")
+
+	inst *Limiter
+)
+
+// Config configures the attachment limit of this node.
+type Config struct {
+	// MaxVolumes is the maximum number of volumes attached to this node
+	MaxVolumes int64 `yaml:"max_volumes"`
+	// Provider sets the limit to the one of a cloud provider from
+	// DefaultLimits when MaxVolumes is not set
+	Provider string `yaml:"provider"`
+}
+
+// Limit returns the configured attachment limit, zero if unlimited.
+func (c *Config) Limit() int64 {
+	if c.MaxVolumes != 0 {
+		return c.MaxVolumes
+	}
+	return DefaultLimits[c.Provider]
+}
+
+// Enabled returns true if an attachment limit is configured.
+func (c *Config) Enabled() bool {
+	return c.Limit() > 0
+}
+
+// LimitError is returned by Attach when the node has no attachment slot
+// left.
+type LimitError struct {
+	// NodeId of the node
+	NodeId string
+	// Limit of the node
+	Limit int64
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("Node %s has reached its limit of %d attached volumes, "+
+		"detach a volume or attach it to another node", e.NodeId, e.Limit)
+}
+
+// Limiter counts the volumes attached to this node by the drivers it
+// wraps and rejects the attachments beyond the limit.
+type Limiter struct {
+	nodeID string
+	limit  int64
+
+	lock     sync.Mutex
+	drivers  []volume.VolumeDriver
+	attached int64
+}
+
+// New returns a limiter of the attachments to nodeID.
+func New(nodeID string, limit int64) *Limiter {
+	return &Limiter{nodeID: nodeID, limit: limit}
+}
+
+// Init sets the attachment limiter singleton.
+func Init(l *Limiter) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = l
+	return nil
+}
+
+// Inst returns the attachment limiter singleton.
+func Inst() (*Limiter, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// Wrap returns d enforcing the limit of l at Attach. The attachments of
+// every wrapped driver count against the same limit.
+func (l *Limiter) Wrap(d volume.VolumeDriver) volume.VolumeDriver {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.drivers = append(l.drivers, d)
+	if err := l.count(); err != nil {
+		logrus.WithField("pkg", "openstorage/attachlimit").
+			Warnf("Failed to count the volumes attached to node %s: %v", l.nodeID, err)
+	}
+	return &limitedDriver{VolumeDriver: d, limiter: l}
+}
+
+// Slots returns the attachment limit of the node, zero if unlimited, and
+// the number of volumes attached to it at the last attach or detach.
+func (l *Limiter) Slots() (int64, int64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.limit, l.attached
+}
+
+// count refreshes the number of volumes attached to the node. It must be
+// called with the lock held.
+func (l *Limiter) count() error {
+	var attached int64
+	for _, d := range l.drivers {
+		vols, err := d.Enumerate(&api.VolumeLocator{}, nil)
+		if err != nil {
+			return err
+		}
+		for _, v := range vols {
+			if common.AttachedHere(v, l.nodeID) {
+				attached++
+			}
+		}
+	}
+	l.attached = attached
+	return nil
+}
+
+// limitedDriver is a volume driver whose attachments are limited.
+type limitedDriver struct {
+	volume.VolumeDriver
+	limiter *Limiter
+}
+
+// Attach attaches volumeID unless the node has reached its limit. Volumes
+// already attached to the node do not take another slot.
+func (d *limitedDriver) Attach(volumeID string, attachOptions map[string]string) (string, error) {
+	l := d.limiter
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	vols, err := d.Inspect([]string{volumeID})
+	if err != nil {
+		return "", err
+	}
+	if len(vols) != 1 || !common.AttachedHere(vols[0], l.nodeID) {
+		if err := l.count(); err != nil {
+			return "", err
+		}
+		if l.attached >= l.limit {
+			return "", &LimitError{NodeId: l.nodeID, Limit: l.limit}
+		}
+	}
+	devicePath, err := d.VolumeDriver.Attach(volumeID, attachOptions)
+	if err == nil {
+		l.count()
+	}
+	return devicePath, err
+}
+
+// Detach detaches volumeID and releases its slot.
+func (d *limitedDriver) Detach(volumeID string, options map[string]string) error {
+	err := d.VolumeDriver.Detach(volumeID, options)
+	if err == nil {
+		d.limiter.lock.Lock()
+		d.limiter.count()
+		d.limiter.lock.Unlock()
+	}
+	return err
+}
@@ -0,0 +1,112 @@
+package attachlimit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// volumes returns the volumes ids, attached to node1 if suffixed by "*".
+func volumes(ids ...string) []*api.Volume {
+	vols := make([]*api.Volume, 0, len(ids))
+	for _, id := range ids {
+		v := &api.Volume{Id: strings.TrimSuffix(id, "*")}
+		if strings.HasSuffix(id, "*") {
+			v.AttachedOn = "node1"
+		}
+		vols = append(vols, v)
+	}
+	return vols
+}
+
+func TestLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m1 := mock.NewMockVolumeDriver(ctrl)
+	m2 := mock.NewMockVolumeDriver(ctrl)
+	l := New("node1", 3)
+
+	// count expects the volumes of the drivers counted
+	count := func(vols1, vols2 []*api.Volume) {
+		m1.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(vols1, nil)
+		m2.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(vols2, nil)
+	}
+	m1.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(volumes("a", "b"), nil)
+	d1 := l.Wrap(m1)
+	count(volumes("a", "b"), volumes("c", "d"))
+	d2 := l.Wrap(m2)
+
+	m1.EXPECT().Inspect([]string{"a"}).Return(volumes("a"), nil)
+	count(volumes("a", "b"), volumes("c", "d"))
+	m1.EXPECT().Attach("a", nil).Return("/dev/a", nil)
+	count(volumes("a*", "b"), volumes("c", "d"))
+	_, err := d1.Attach("a", nil)
+	require.NoError(t, err)
+
+	m1.EXPECT().Inspect([]string{"b"}).Return(volumes("b"), nil)
+	count(volumes("a*", "b"), volumes("c", "d"))
+	m1.EXPECT().Attach("b", nil).Return("/dev/b", nil)
+	count(volumes("a*", "b*"), volumes("c", "d"))
+	_, err = d1.Attach("b", nil)
+	require.NoError(t, err)
+
+	m2.EXPECT().Inspect([]string{"c"}).Return(volumes("c"), nil)
+	count(volumes("a*", "b*"), volumes("c", "d"))
+	m2.EXPECT().Attach("c", nil).Return("/dev/c", nil)
+	count(volumes("a*", "b*"), volumes("c*", "d"))
+	_, err = d2.Attach("c", nil)
+	require.NoError(t, err)
+	max, attached := l.Slots()
+	assert.Equal(t, int64(3), max)
+	assert.Equal(t, int64(3), attached)
+
+	// attaching an attached volume does not take another slot
+	m2.EXPECT().Inspect([]string{"c"}).Return(volumes("c*"), nil)
+	m2.EXPECT().Attach("c", nil).Return("/dev/c", nil)
+	count(volumes("a*", "b*"), volumes("c*", "d"))
+	_, err = d2.Attach("c", nil)
+	assert.NoError(t, err)
+
+	m2.EXPECT().Inspect([]string{"d"}).Return(volumes("d"), nil)
+	count(volumes("a*", "b*"), volumes("c*", "d"))
+	_, err = d2.Attach("d", nil)
+	require.Error(t, err)
+	assert.IsType(t, &LimitError{}, err)
+
+	m1.EXPECT().Detach("a", nil).Return(nil)
+	count(volumes("a", "b*"), volumes("c*", "d"))
+	require.NoError(t, d1.Detach("a", nil))
+	_, attached = l.Slots()
+	assert.Equal(t, int64(2), attached)
+
+	m2.EXPECT().Inspect([]string{"d"}).Return(volumes("d"), nil)
+	count(volumes("a", "b*"), volumes("c*", "d"))
+	m2.EXPECT().Attach("d", nil).Return("/dev/d", nil)
+	count(volumes("a", "b*"), volumes("c*", "d*"))
+	_, err = d2.Attach("d", nil)
+	assert.NoError(t, err)
+}
+
+func TestConfig(t *testing.T) {
+	c := &Config{}
+	assert.False(t, c.Enabled())
+	c.Provider = "aws"
+	assert.Equal(t, DefaultLimits["aws"], c.Limit())
+	c.MaxVolumes = 10
+	assert.Equal(t, int64(10), c.Limit())
+	assert.True(t, c.Enabled())
+}
+
+func TestAttachSlots(t *testing.T) {
+	n := &api.Node{}
+	assert.Equal(t, int64(-1), n.AttachSlots())
+	n.MaxVolumeAttachments, n.VolumeAttachments = 3, 1
+	assert.Equal(t, int64(2), n.AttachSlots())
+	n.VolumeAttachments = 4
+	assert.Equal(t, int64(0), n.AttachSlots())
+}
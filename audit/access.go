@@ -0,0 +1,31 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/sirupsen/logrus"
+)
+
+// AccessActions are the actions of the records of the accesses to the
+// volumes, see LogAccess.
+var AccessActions = []string{
+	eventbus.EventVolumeAttach,
+	eventbus.EventVolumeDetach,
+	eventbus.EventVolumeMount,
+	eventbus.EventVolumeUnmount,
+}
+
+// LogAccess records an access to volumeID, one of AccessActions, by the
+// principal of ctx in the audit log, if initialized. The access is not
+// failed if it cannot be recorded.
+func LogAccess(ctx context.Context, action, volumeID string, details map[string]string) {
+	l, err := Inst()
+	if err != nil {
+		return
+	}
+	if _, err := l.LogWithContext(ctx, action, volumeID, details); err != nil {
+		logrus.WithField("pkg", "openstorage/audit").
+			Warnf("Failed to record the %s of volume %s: %v", action, volumeID, err)
+	}
+}
@@ -0,0 +1,109 @@
+// Package audit records security relevant events, such as secure volume
+// deletion, in a persistent audit log.
+package audit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/portworx/kvdb"
+)
+
+var (
+	// ErrNotInitialized returned when the audit log has not been initialized
+	ErrNotInitialized = errors.New("openstorage.audit: not initialized")
+	// ErrInitialized returned when the audit log is initialized twice
+	ErrInitialized = errors.New("openstorage.audit: already initialized")
+
+	inst Logger
+	// Inst returns an instance of an already instantiated audit log.
+	// This function can be overridden for testing purposes
+	Inst = func() (Logger, error) {
+		return auditInst()
+	}
+)
+
+// Record is an entry in the audit log.
+// swagger:model
+type Record struct {
+	// Id uniquely identifies the record
+	Id string
+	// Time is when the event happened
+	Time time.Time
+	// Action that was performed, for example "volume.securedelete"
+	Action string
+	// ResourceId is the id of the object the action was performed on
+	ResourceId string
+	// Details describing the event
+	Details map[string]string
+	// CorrelationId is the correlation id of the request which caused the
+	// event, if any
+	CorrelationId string `json:",omitempty"`
+	// Principal is who made the request which caused the event, see
+	// auth.FromContext
+	Principal string `json:",omitempty"`
+	// NodeId is the node which recorded the event
+	NodeId string `json:",omitempty"`
+}
+
+// EventMessage describes the record when it is forwarded by the event bus.
+func (r *Record) EventMessage() string {
+	return "audit " + r.Action + " " + r.ResourceId
+}
+
+// EventFields returns the structured fields of the record when it is
+// forwarded by the event bus.
+func (r *Record) EventFields() map[string]string {
+	fields := map[string]string{"action": r.Action}
+	if len(r.Principal) != 0 {
+		fields["principal"] = r.Principal
+	}
+	if len(r.NodeId) != 0 {
+		fields["node"] = r.NodeId
+	}
+	for k, v := range r.Details {
+		fields[k] = v
+	}
+	return fields
+}
+
+// Logger records and lists audit events.
+type Logger interface {
+	// Log records an event for resourceID.
+	Log(action, resourceID string, details map[string]string) (*Record, error)
+	// LogWithContext records an event for resourceID caused by the request of
+	// ctx, with its correlation id and principal.
+	LogWithContext(
+		ctx context.Context,
+		action, resourceID string,
+		details map[string]string,
+	) (*Record, error)
+	// Enumerate returns all audit records.
+	Enumerate() ([]*Record, error)
+	// EnumerateResource returns the records of resourceID, of one of actions
+	// if any, oldest first.
+	EnumerateResource(resourceID string, actions ...string) ([]*Record, error)
+}
+
+// NewLogger returns a kvdb backed audit log, recording the events of the node
+// nodeID.
+func NewLogger(kv kvdb.Kvdb, nodeID string) Logger {
+	return newLogger(kv, nodeID)
+}
+
+// Init instantiates the audit log singleton of the node nodeID.
+func Init(kv kvdb.Kvdb, nodeID string) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = newLogger(kv, nodeID)
+	return nil
+}
+
+func auditInst() (Logger, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
@@ -0,0 +1,123 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+	"github.com/libopenstorage/openstorage/pkg/redact"
+	"github.com/pborman/uuid"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	kvdbKey = "audit"
+)
+
+// logger implements Logger interface.
+type logger struct {
+	kv     kvdb.Kvdb
+	nodeID string
+}
+
+func newLogger(kv kvdb.Kvdb, nodeID string) *logger {
+	return &logger{kv: kv, nodeID: nodeID}
+}
+
+// getKey is a util func that constructs kvdb key.
+// kvdb tree structure is setup as follows:
+// <baseKey>/<recordID>/<recordObject>
+func getKey(id string) string {
+	return filepath.Join(kvdbKey, id)
+}
+
+func (l *logger) Log(action, resourceID string, details map[string]string) (*Record, error) {
+	return l.LogWithContext(context.Background(), action, resourceID, details)
+}
+
+func (l *logger) LogWithContext(
+	ctx context.Context,
+	action, resourceID string,
+	details map[string]string,
+) (*Record, error) {
+	record := &Record{
+		Id:            uuid.New(),
+		Time:          time.Now(),
+		Action:        action,
+		ResourceId:    resourceID,
+		Details:       redact.Map(details),
+		CorrelationId: correlation.FromContext(ctx),
+		Principal:     auth.FromContext(ctx),
+		NodeId:        l.nodeID,
+	}
+	if _, err := l.kv.Create(getKey(record.Id), record, 0); err != nil {
+		return nil, err
+	}
+
+	fields := logrus.Fields{
+		"pkg":       "openstorage/audit",
+		"action":    action,
+		"resource":  resourceID,
+		"principal": record.Principal,
+	}
+	for k, v := range record.Details {
+		fields[k] = v
+	}
+	if len(record.CorrelationId) != 0 {
+		fields[correlation.Key] = record.CorrelationId
+	}
+	logrus.WithFields(fields).Info("audit")
+	eventbus.PublishContext(ctx, eventbus.EventAuditRecord, resourceID, record)
+
+	return record, nil
+}
+
+func (l *logger) Enumerate() ([]*Record, error) {
+	kvps, err := l.kv.Enumerate(kvdbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*Record, 0, len(kvps))
+	for _, kvp := range kvps {
+		record := new(Record)
+		if err := json.Unmarshal(kvp.Value, record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (l *logger) EnumerateResource(resourceID string, actions ...string) ([]*Record, error) {
+	records, err := l.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+	selected := records[:0]
+	for _, record := range records {
+		if record.ResourceId != resourceID {
+			continue
+		}
+		if len(actions) == 0 {
+			selected = append(selected, record)
+			continue
+		}
+		for _, action := range actions {
+			if record.Action == action {
+				selected = append(selected, record)
+				break
+			}
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Time.Before(selected[j].Time)
+	})
+	return selected, nil
+}
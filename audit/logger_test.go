@@ -0,0 +1,77 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogEnumerate(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	l := NewLogger(kv, "node1")
+
+	record, err := l.Log("volume.delete", "vol1", map[string]string{"method": "overwrite"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, record.Id)
+	assert.False(t, record.Time.IsZero())
+
+	records, err := l.Enumerate()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "volume.delete", records[0].Action)
+	assert.Equal(t, "vol1", records[0].ResourceId)
+	assert.Equal(t, "overwrite", records[0].Details["method"])
+}
+
+func TestLogWithContext(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	l := NewLogger(kv, "node1")
+
+	ctx := correlation.NewContext(context.Background(), "req1")
+	record, err := l.LogWithContext(ctx, "volume.delete", "vol1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "req1", record.CorrelationId)
+
+	records, err := l.Enumerate()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "req1", records[0].CorrelationId)
+}
+
+func TestEnumerateResource(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	l := NewLogger(kv, "node1")
+
+	ctx := auth.NewContext(context.Background(), "uid:1000")
+	logTo(t, l, ctx, "volume.mount", "vol1")
+	logTo(t, l, context.Background(), "volume.unmount", "vol1")
+	logTo(t, l, ctx, "volume.mount", "vol2")
+	logTo(t, l, ctx, "volume.delete", "vol1")
+
+	records, err := l.EnumerateResource("vol1", AccessActions...)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "volume.mount", records[0].Action)
+	assert.Equal(t, "uid:1000", records[0].Principal)
+	assert.Equal(t, "node1", records[0].NodeId)
+	assert.Equal(t, "volume.unmount", records[1].Action)
+	assert.Equal(t, auth.Anonymous, records[1].Principal)
+
+	records, err = l.EnumerateResource("vol1")
+	require.NoError(t, err)
+	assert.Len(t, records, 3)
+}
+
+func logTo(t *testing.T, l Logger, ctx context.Context, action, resourceID string) {
+	_, err := l.LogWithContext(ctx, action, resourceID, nil)
+	require.NoError(t, err)
+}
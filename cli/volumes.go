@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 	"time"
@@ -12,6 +14,7 @@ import (
 	volumeclient "github.com/libopenstorage/openstorage/api/client/volume"
 	"github.com/libopenstorage/openstorage/cluster"
 	"github.com/libopenstorage/openstorage/pkg/options"
+	"github.com/libopenstorage/openstorage/speclint"
 	"github.com/libopenstorage/openstorage/volume"
 )
 
@@ -115,6 +118,51 @@ func (v *volDriver) volumeCreate(context *cli.Context) {
 	fmtOutput(context, &Format{UUID: []string{string(id)}})
 }
 
+func (v *volDriver) volumeValidate(context *cli.Context) {
+	fn := "validate"
+	req := &speclint.Request{}
+	if f := context.String("file"); f != "" {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			cmdError(context, fn, err)
+			return
+		}
+		if err := json.Unmarshal(b, req); err != nil {
+			cmdError(context, fn, err)
+			return
+		}
+	}
+	if o := context.String("opts"); o != "" {
+		opts, err := processLabels(o)
+		if err != nil {
+			cmdError(context, fn, err)
+			return
+		}
+		req.Options = opts
+	}
+	if len(context.Args()) == 1 {
+		if req.Locator == nil {
+			req.Locator = &api.VolumeLocator{}
+		}
+		req.Locator.Name = context.Args()[0]
+	}
+
+	clnt, err := volumeclient.NewDriverClient("", v.name, volume.APIVersion, "")
+	if err != nil {
+		cmdError(context, fn, err)
+		return
+	}
+	result, err := volumeclient.Lint(clnt, req)
+	if err != nil {
+		cmdError(context, fn, err)
+		return
+	}
+	cmdOutput(context, result)
+	if !result.Valid {
+		exitCli()
+	}
+}
+
 func (v *volDriver) volumeMount(context *cli.Context) {
 	v.volumeOptions(context)
 	fn := "mount"
@@ -338,6 +386,71 @@ func (v *volDriver) snapEnumerate(context *cli.Context) {
 	cmdOutputVolumes(snaps, context.GlobalBool("raw"))
 }
 
+func (v *volDriver) backupCreate(context *cli.Context) {
+	v.volumeOptions(context)
+	fn := "backup"
+	if len(context.Args()) != 1 {
+		missingParameter(context, fn, "volumeID", "Invalid number of arguments")
+		return
+	}
+	if context.String("cred") == "" {
+		missingParameter(context, fn, "cred", "Credential id is required")
+		return
+	}
+	resp, err := v.volDriver.CloudBackupCreate(&api.CloudBackupCreateRequest{
+		VolumeID:       context.Args()[0],
+		CredentialUUID: context.String("cred"),
+		Full:           context.Bool("full"),
+	})
+	if err != nil {
+		cmdError(context, fn, err)
+		return
+	}
+	fmtOutput(context, &Format{UUID: []string{resp.Name}})
+}
+
+func (v *volDriver) backupEnumerate(context *cli.Context) {
+	v.volumeOptions(context)
+	fn := "backupEnumerate"
+	if context.String("cred") == "" {
+		missingParameter(context, fn, "cred", "Credential id is required")
+		return
+	}
+	req := &api.CloudBackupEnumerateRequest{}
+	req.CredentialUUID = context.String("cred")
+	req.SrcVolumeID = context.String("volume")
+	resp, err := v.volDriver.CloudBackupEnumerate(req)
+	if err != nil {
+		cmdError(context, fn, err)
+		return
+	}
+	cmdOutput(context, resp.Backups)
+}
+
+func (v *volDriver) backupRestore(context *cli.Context) {
+	v.volumeOptions(context)
+	fn := "backupRestore"
+	if len(context.Args()) != 1 {
+		missingParameter(context, fn, "backupID", "Invalid number of arguments")
+		return
+	}
+	if context.String("cred") == "" {
+		missingParameter(context, fn, "cred", "Credential id is required")
+		return
+	}
+	resp, err := v.volDriver.CloudBackupRestore(&api.CloudBackupRestoreRequest{
+		ID:                context.Args()[0],
+		CredentialUUID:    context.String("cred"),
+		RestoreVolumeName: context.String("name"),
+		Passphrase:        context.String("passphrase"),
+	})
+	if err != nil {
+		cmdError(context, fn, err)
+		return
+	}
+	fmtOutput(context, &Format{UUID: []string{resp.RestoreVolumeID, resp.Name}})
+}
+
 func (v *volDriver) volumeAlerts(context *cli.Context) {
 	v.volumeOptions(context)
 
@@ -356,6 +469,11 @@ func (v *volDriver) volumeAlerts(context *cli.Context) {
 	cmdOutputProto(alerts, context.GlobalBool("raw"))
 }
 
+func (v *volDriver) volumeStatus(context *cli.Context) {
+	v.volumeOptions(context)
+	cmdOutput(context, volume.StatusOf(v.volDriver))
+}
+
 // baseVolumeCommand exports commands common to block and file volume drivers.
 func baseVolumeCommand(v *volDriver) []cli.Command {
 
@@ -407,6 +525,22 @@ func baseVolumeCommand(v *volDriver) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:    "validate",
+			Aliases: []string{"lint"},
+			Usage:   "Lint a volume spec against the cluster policies and the driver, exiting 1 on errors",
+			Action:  v.volumeValidate,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "file,f",
+					Usage: "JSON file of the locator, spec and options of the volume",
+				},
+				cli.StringFlag{
+					Name:  "opts,o",
+					Usage: "Comma separated spec options, e.g size=10G,repl=2",
+				},
+			},
+		},
 		{
 			Name:    "mount",
 			Aliases: []string{"m"},
@@ -469,6 +603,11 @@ func baseVolumeCommand(v *volDriver) []cli.Command {
 			Usage:  "volume stats",
 			Action: v.volumeStats,
 		},
+		{
+			Name:   "status",
+			Usage:  "driver status",
+			Action: v.volumeStatus,
+		},
 		{
 			Name:    "snap",
 			Aliases: []string{"sc"},
@@ -489,6 +628,58 @@ func baseVolumeCommand(v *volDriver) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:    "backup",
+			Aliases: []string{"b"},
+			Usage:   "Back up a volume to the object store of a credential",
+			Action:  v.backupCreate,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "cred,c",
+					Usage: "Credential id of the object store",
+				},
+				cli.BoolFlag{
+					Name:  "full",
+					Usage: "Full backup, even if it could be incremental to the last backup",
+				},
+			},
+		},
+		{
+			Name:    "backupEnumerate",
+			Aliases: []string{"be"},
+			Usage:   "Enumerate the backups in the object store of a credential",
+			Action:  v.backupEnumerate,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "cred,c",
+					Usage: "Credential id of the object store",
+				},
+				cli.StringFlag{
+					Name:  "volume",
+					Usage: "Only the backups of this volume id",
+				},
+			},
+		},
+		{
+			Name:    "backupRestore",
+			Aliases: []string{"br"},
+			Usage:   "Restore a backup to a new volume",
+			Action:  v.backupRestore,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "cred,c",
+					Usage: "Credential id of the object store",
+				},
+				cli.StringFlag{
+					Name:  "name",
+					Usage: "Name of the new volume, named after the volume backed up if unset",
+				},
+				cli.StringFlag{
+					Name:  "passphrase",
+					Usage: "Passphrase of the volume backed up, if encrypted with one",
+				},
+			},
+		},
 		{
 			Name:    "snapEnumerate",
 			Aliases: []string{"se"},
@@ -0,0 +1,133 @@
+// Package clouddrive provisions the backend disks of the storage pools of a
+// node running in a cloud, such as EBS volumes or persistent disks, from a
+// declarative spec. The drives are recorded in kvdb against the node id so
+// that a replacement instance of the node reattaches the same drives.
+package clouddrive
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// JobType is the job type used for cloud drive reconciliations
+	JobType = "clouddrive"
+	// DefaultInterval is the time between reconciliations when none is
+	// configured
+	DefaultInterval = 10 * time.Minute
+
+	// ProviderAWS provisions EBS volumes
+	ProviderAWS = "aws"
+	// ProviderGCE provisions GCE persistent disks
+	ProviderGCE = "gce"
+
+	// TagCluster is the tag holding the cluster id of a drive
+	TagCluster = "openstorage-cluster"
+	// TagNode is the tag holding the node id of a drive
+	TagNode = "openstorage-node"
+	// TagPool is the tag holding the pool id of a drive
+	TagPool = "openstorage-pool"
+)
+
+var (
+	// ErrNotInitialized returned when the cloud drive manager has not been initialized
+	ErrNotInitialized = errors.New("openstorage.clouddrive: not initialized")
+	// ErrInitialized returned when the cloud drive manager is initialized twice
+	ErrInitialized = errors.New("openstorage.clouddrive: already initialized")
+
+	inst *Manager
+)
+
+// DriveSpec declares a set of identical drives backing a pool.
+type DriveSpec struct {
+	// Type is the provider disk type, such as gp2 or pd-ssd
+	Type string `yaml:"type"`
+	// Size of each drive in GiB
+	Size uint64 `yaml:"size"`
+	// Iops provisioned for each drive, for the types that require it
+	Iops int64 `yaml:"iops"`
+	// Count is the number of drives
+	Count int `yaml:"count"`
+	// Pool is the id of the pool the drives are added to
+	Pool int32 `yaml:"pool"`
+}
+
+// Config configures cloud drive provisioning.
+type Config struct {
+	// Provider is ProviderAWS or ProviderGCE
+	Provider string `yaml:"provider"`
+	// Driver is the volume driver owning the pools, the default driver if
+	// unset
+	Driver string `yaml:"driver"`
+	// Interval between reconciliations, DefaultInterval if unset
+	Interval time.Duration `yaml:"interval"`
+	// Drives every node must have
+	Drives []DriveSpec `yaml:"drives"`
+	// PreemptionRescue releases the volumes and drives of a spot or
+	// preemptible instance when it receives a preemption notice
+	PreemptionRescue bool `yaml:"preemption_rescue"`
+}
+
+// Enabled returns true if drives are declared.
+func (c *Config) Enabled() bool {
+	return len(c.Drives) != 0
+}
+
+// Validate checks the provider and the drive specs.
+func (c *Config) Validate() error {
+	if c.Provider != ProviderAWS && c.Provider != ProviderGCE {
+		return fmt.Errorf("Unknown cloud drive provider %q, must be aws or gce", c.Provider)
+	}
+	for i, d := range c.Drives {
+		if len(d.Type) == 0 || d.Size == 0 || d.Count <= 0 {
+			return fmt.Errorf("Cloud drive spec %d must have a type, size and count", i)
+		}
+	}
+	return nil
+}
+
+func (c *Config) interval() time.Duration {
+	if c.Interval == 0 {
+		return DefaultInterval
+	}
+	return c.Interval
+}
+
+// Drive is a cloud drive provisioned for a node.
+// swagger:model
+type Drive struct {
+	// Id of the drive at the provider
+	Id string
+	// NodeId of the node owning the drive
+	NodeId string
+	// Pool the drive backs
+	Pool int32
+	// Type of the drive
+	Type string
+	// Size of the drive in GiB
+	Size uint64
+	// DevicePath is where the drive was last attached
+	DevicePath string
+	// Pooled is set once the drive has been added to its pool
+	Pooled bool
+	// CreateTime is when the drive was provisioned
+	CreateTime time.Time
+}
+
+// Init sets the cloud drive manager singleton.
+func Init(m *Manager) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = m
+	return nil
+}
+
+// Inst returns the cloud drive manager singleton.
+func Inst() (*Manager, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
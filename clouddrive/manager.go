@@ -0,0 +1,231 @@
+package clouddrive
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/jobs"
+	"github.com/libopenstorage/openstorage/pkg/storageops"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/pborman/uuid"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const kvdbKey = "clouddrives"
+
+// Manager provisions and attaches the cloud drives of this node.
+type Manager struct {
+	kv        kvdb.Kvdb
+	ops       storageops.Ops
+	pools     volume.PoolDriver
+	clusterID string
+	nodeID    string
+	template  TemplateFunc
+	config    Config
+
+	lock sync.Mutex
+}
+
+// NewManager returns a manager of the drives of nodeID declared by c. The
+// drives are provisioned with ops from template and added to the pools of
+// the driver pools.
+func NewManager(
+	kv kvdb.Kvdb,
+	ops storageops.Ops,
+	pools volume.PoolDriver,
+	clusterID, nodeID string,
+	template TemplateFunc,
+	c *Config,
+) (*Manager, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if len(nodeID) == 0 {
+		return nil, fmt.Errorf("Missing node id")
+	}
+	return &Manager{
+		kv:        kv,
+		ops:       ops,
+		pools:     pools,
+		clusterID: clusterID,
+		nodeID:    nodeID,
+		template:  template,
+		config:    *c,
+	}, nil
+}
+
+// Enumerate returns the drives provisioned for this node.
+func (m *Manager) Enumerate() ([]*Drive, error) {
+	kvps, err := m.kv.Enumerate(m.nodeKey())
+	if err != nil {
+		return nil, err
+	}
+	drives := make([]*Drive, 0, len(kvps))
+	for _, kvp := range kvps {
+		var d Drive
+		if err := json.Unmarshal(kvp.Value, &d); err != nil {
+			return nil, err
+		}
+		drives = append(drives, &d)
+	}
+	return drives, nil
+}
+
+// Reconcile attaches the drives of this node to this instance, detaching
+// them from the instance the node previously ran on, adds them to their
+// pool, and provisions the drives missing from the spec.
+func (m *Manager) Reconcile() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	drives, err := m.Enumerate()
+	if err != nil {
+		return err
+	}
+	for _, d := range drives {
+		if err := m.attach(d); err != nil {
+			return fmt.Errorf("Failed to attach cloud drive %s: %v", d.Id, err)
+		}
+	}
+
+	// Drives are matched to the spec by pool, type and size
+	have := make(map[string]int)
+	for _, d := range drives {
+		have[specKey(d.Pool, d.Type, d.Size)]++
+	}
+	for i := range m.config.Drives {
+		spec := &m.config.Drives[i]
+		key := specKey(spec.Pool, spec.Type, spec.Size)
+		for ; have[key] < spec.Count; have[key]++ {
+			d, err := m.provision(spec)
+			if err != nil {
+				return fmt.Errorf("Failed to provision cloud drive for pool %d: %v", spec.Pool, err)
+			}
+			if err := m.attach(d); err != nil {
+				return fmt.Errorf("Failed to attach cloud drive %s: %v", d.Id, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Start reconciles the drives now and every configured interval, tracked
+// as jobs.
+func (m *Manager) Start() {
+	go func() {
+		for {
+			if err := m.submit(); err != nil {
+				logrus.WithField("pkg", "openstorage/clouddrive").
+					Warnf("Failed to schedule cloud drive reconciliation: %v", err)
+			}
+			time.Sleep(m.config.interval())
+		}
+	}()
+}
+
+func (m *Manager) submit() error {
+	jm, err := jobs.Inst()
+	if err != nil {
+		return err
+	}
+	_, err = jm.Submit(JobType, m.nodeID, m.Reconcile)
+	return err
+}
+
+// provision creates a drive for spec and records it before it is attached,
+// so that it is not leaked if the node fails.
+func (m *Manager) provision(spec *DriveSpec) (*Drive, error) {
+	name := strings.ToLower(fmt.Sprintf("osd-%s-%s", m.nodeID, uuid.New()[:8]))
+	template, err := m.template(spec, name)
+	if err != nil {
+		return nil, err
+	}
+	disk, err := m.ops.Create(template, map[string]string{
+		TagCluster: m.clusterID,
+		TagNode:    m.nodeID,
+		TagPool:    strconv.Itoa(int(spec.Pool)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	id, err := m.ops.GetDeviceID(disk)
+	if err != nil {
+		return nil, err
+	}
+	d := &Drive{
+		Id:         id,
+		NodeId:     m.nodeID,
+		Pool:       spec.Pool,
+		Type:       spec.Type,
+		Size:       spec.Size,
+		CreateTime: time.Now(),
+	}
+	if err := m.put(d); err != nil {
+		return nil, err
+	}
+	logrus.WithField("pkg", "openstorage/clouddrive").
+		Infof("Provisioned cloud drive %s of %d GiB for pool %d", id, spec.Size, spec.Pool)
+	return d, nil
+}
+
+// attach attaches d to this instance if needed and adds it to its pool.
+func (m *Manager) attach(d *Drive) error {
+	devicePath, err := m.ops.DevicePath(d.Id)
+	if se, ok := err.(*storageops.StorageError); ok {
+		switch se.Code {
+		case storageops.ErrVolAttachedOnRemoteNode:
+			if len(se.Instance) == 0 || se.Instance == m.ops.InstanceID() {
+				return err
+			}
+			// The node runs on a new instance
+			if err := m.ops.DetachFrom(d.Id, se.Instance); err != nil {
+				return err
+			}
+			devicePath, err = m.ops.Attach(d.Id)
+		case storageops.ErrVolDetached:
+			devicePath, err = m.ops.Attach(d.Id)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if devicePath != d.DevicePath {
+		d.DevicePath = devicePath
+		if err := m.put(d); err != nil {
+			return err
+		}
+	}
+	if !d.Pooled {
+		pool, err := m.pools.PoolExpand(d.Pool, &api.PoolExpandRequest{Device: devicePath})
+		if err != nil {
+			return err
+		}
+		d.Pooled = true
+		if err := m.put(d); err != nil {
+			return err
+		}
+		logrus.WithField("pkg", "openstorage/clouddrive").
+			Infof("Added cloud drive %s to pool %d, capacity is now %d bytes", d.Id, pool.ID, pool.TotalSize)
+	}
+	return nil
+}
+
+func (m *Manager) put(d *Drive) error {
+	_, err := m.kv.Put(m.nodeKey()+"/"+d.Id, d, 0)
+	return err
+}
+
+func (m *Manager) nodeKey() string {
+	return kvdbKey + "/" + m.nodeID
+}
+
+func specKey(pool int32, driveType string, size uint64) string {
+	return fmt.Sprintf("%d/%s/%d", pool, driveType, size)
+}
@@ -0,0 +1,147 @@
+package clouddrive
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/storageops"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDisk struct {
+	id       string
+	labels   map[string]string
+	instance string
+}
+
+// fakeOps is an in-memory cloud where disks attach to one instance at a time.
+type fakeOps struct {
+	storageops.Ops
+	instance string
+	disks    map[string]*fakeDisk
+	next     int
+}
+
+func (o *fakeOps) Name() string { return "fake" }
+
+func (o *fakeOps) InstanceID() string { return o.instance }
+
+func (o *fakeOps) Create(template interface{}, labels map[string]string) (interface{}, error) {
+	o.next++
+	d := &fakeDisk{id: fmt.Sprintf("disk%d", o.next), labels: labels}
+	o.disks[d.id] = d
+	return d, nil
+}
+
+func (o *fakeOps) GetDeviceID(template interface{}) (string, error) {
+	return template.(*fakeDisk).id, nil
+}
+
+func (o *fakeOps) Attach(id string) (string, error) {
+	o.disks[id].instance = o.instance
+	return "/dev/" + id, nil
+}
+
+func (o *fakeOps) Detach(id string) error {
+	return o.DetachFrom(id, o.instance)
+}
+
+func (o *fakeOps) DetachFrom(id, instance string) error {
+	if o.disks[id].instance != instance {
+		return fmt.Errorf("%s is not attached to %s", id, instance)
+	}
+	o.disks[id].instance = ""
+	return nil
+}
+
+func (o *fakeOps) DevicePath(id string) (string, error) {
+	d, ok := o.disks[id]
+	if !ok {
+		return "", storageops.NewStorageError(storageops.ErrVolNotFound, "not found", "")
+	}
+	switch d.instance {
+	case "":
+		return "", storageops.NewStorageError(storageops.ErrVolDetached, "detached", "")
+	case o.instance:
+		return "/dev/" + id, nil
+	}
+	return "", storageops.NewStorageError(storageops.ErrVolAttachedOnRemoteNode, "remote", d.instance)
+}
+
+type fakePools map[int32][]string
+
+func (p fakePools) PoolExpand(poolID int32, request *api.PoolExpandRequest) (*api.StoragePool, error) {
+	p[poolID] = append(p[poolID], request.Device)
+	return &api.StoragePool{ID: poolID}, nil
+}
+
+func newTestManager(t *testing.T, kv kvdb.Kvdb, ops storageops.Ops, pools fakePools) *Manager {
+	m, err := NewManager(kv, ops, pools, "cluster1", "node1",
+		func(spec *DriveSpec, name string) (interface{}, error) {
+			return spec, nil
+		},
+		&Config{
+			Provider: ProviderAWS,
+			Drives: []DriveSpec{
+				{Type: "gp2", Size: 100, Count: 2, Pool: 0},
+				{Type: "io1", Size: 50, Count: 1, Pool: 1},
+			},
+		})
+	require.NoError(t, err)
+	return m
+}
+
+func TestReconcile(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	ops := &fakeOps{instance: "i-1", disks: make(map[string]*fakeDisk)}
+	pools := fakePools{}
+	m := newTestManager(t, kv, ops, pools)
+
+	require.NoError(t, m.Reconcile())
+	assert.Len(t, ops.disks, 3)
+	assert.Len(t, pools[0], 2)
+	assert.Len(t, pools[1], 1)
+	for _, d := range ops.disks {
+		assert.Equal(t, "i-1", d.instance)
+		assert.Equal(t, "node1", d.labels[TagNode])
+	}
+	drives, err := m.Enumerate()
+	require.NoError(t, err)
+	require.Len(t, drives, 3)
+	for _, d := range drives {
+		assert.True(t, d.Pooled)
+		assert.Equal(t, "/dev/"+d.Id, d.DevicePath)
+	}
+
+	// Reconciling again is a no-op
+	require.NoError(t, m.Reconcile())
+	assert.Len(t, ops.disks, 3)
+	assert.Len(t, pools[0], 2)
+
+	// The node is replaced by a new instance: the same drives move over
+	// without being added to the pools again
+	ops.instance = "i-2"
+	m = newTestManager(t, kv, ops, pools)
+	require.NoError(t, m.Reconcile())
+	assert.Len(t, ops.disks, 3)
+	assert.Len(t, pools[0], 2)
+	for _, d := range ops.disks {
+		assert.Equal(t, "i-2", d.instance)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	c := &Config{Provider: "azure", Drives: []DriveSpec{{Type: "gp2", Size: 1, Count: 1}}}
+	assert.Error(t, c.Validate())
+	c.Provider = ProviderGCE
+	assert.NoError(t, c.Validate())
+	c.Drives[0].Count = 0
+	assert.Error(t, c.Validate())
+	assert.True(t, c.Enabled())
+	assert.False(t, (&Config{}).Enabled())
+}
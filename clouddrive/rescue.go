@@ -0,0 +1,196 @@
+package clouddrive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/volume/drivers/common"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultPreemptionInterval is the time between two checks of the
+	// preemption notices. AWS gives a two minute notice and GCE 30 seconds.
+	DefaultPreemptionInterval = 5 * time.Second
+
+	awsPreemptionURL = "http://169.254.169.254/latest/meta-data/spot/instance-action"
+	gcePreemptionURL = "http://metadata.google.internal/computeMetadata/v1/instance/preempted"
+)
+
+// Notice is the notice of the imminent preemption of this instance.
+type Notice struct {
+	// Action is what happens to the instance, such as terminate or stop
+	Action string
+	// Time is when it happens, zero if unknown
+	Time time.Time
+}
+
+// PreemptionWatcher checks the cloud metadata service for a preemption
+// notice of this spot or preemptible instance.
+type PreemptionWatcher interface {
+	// Preemption returns the pending notice, nil if there is none.
+	Preemption() (*Notice, error)
+}
+
+// NewPreemptionWatcher returns the watcher of the notices of provider.
+func NewPreemptionWatcher(provider string) (PreemptionWatcher, error) {
+	client := &http.Client{Timeout: 2 * time.Second}
+	switch provider {
+	case ProviderAWS:
+		return &awsWatcher{client: client, url: awsPreemptionURL}, nil
+	case ProviderGCE:
+		return &gceWatcher{client: client, url: gcePreemptionURL}, nil
+	}
+	return nil, fmt.Errorf("Unknown cloud drive provider %q, must be aws or gce", provider)
+}
+
+// awsWatcher reads the spot instance action, which is only present once the
+// instance is marked for interruption.
+type awsWatcher struct {
+	client *http.Client
+	url    string
+}
+
+func (w *awsWatcher) Preemption() (*Notice, error) {
+	resp, err := w.client.Get(w.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unexpected status %d reading the spot instance action", resp.StatusCode)
+	}
+	var action struct {
+		Action string    `json:"action"`
+		Time   time.Time `json:"time"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&action); err != nil {
+		return nil, err
+	}
+	return &Notice{Action: action.Action, Time: action.Time}, nil
+}
+
+// gceWatcher reads the preempted flag of the instance.
+type gceWatcher struct {
+	client *http.Client
+	url    string
+}
+
+func (w *gceWatcher) Preemption() (*Notice, error) {
+	req, err := http.NewRequest("GET", w.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unexpected status %d reading the preempted flag", resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(string(data)) != "TRUE" {
+		return nil, nil
+	}
+	return &Notice{Action: "terminate"}, nil
+}
+
+// VolumeDriver is the part of a volume driver releasing the volumes of a
+// node being preempted.
+type VolumeDriver interface {
+	Enumerate(locator *api.VolumeLocator, labels map[string]string) ([]*api.Volume, error)
+	Unmount(volumeID string, mountPath string, options map[string]string) error
+	Detach(volumeID string, options map[string]string) error
+}
+
+// WatchPreemption checks w every interval and rescues the drives of this
+// node with Rescue once the instance is about to be preempted.
+func (m *Manager) WatchPreemption(w PreemptionWatcher, d VolumeDriver, interval time.Duration) {
+	if interval == 0 {
+		interval = DefaultPreemptionInterval
+	}
+	go func() {
+		for range time.Tick(interval) {
+			notice, err := w.Preemption()
+			if err != nil {
+				logrus.WithField("pkg", "openstorage/clouddrive").
+					Debugf("Failed to check for preemption: %v", err)
+				continue
+			}
+			if notice == nil {
+				continue
+			}
+			logrus.WithField("pkg", "openstorage/clouddrive").
+				Warnf("Instance %s is preempted: %s at %v, releasing volumes and drives",
+					m.ops.InstanceID(), notice.Action, notice.Time)
+			eventbus.Publish(eventbus.EventNodePreempt, m.nodeID, notice)
+			if err := m.Rescue(d); err != nil {
+				logrus.WithField("pkg", "openstorage/clouddrive").
+					Errorf("Failed to release drives before preemption: %v", err)
+			}
+			return
+		}
+	}()
+}
+
+// Rescue unmounts and detaches the volumes attached to this node, flushes
+// the file system buffers and detaches the cloud drives of the node, so
+// that a replacement instance can attach them without waiting for the
+// cloud to force detach them. Failures on a volume do not stop the others
+// from being released.
+func (m *Manager) Rescue(d VolumeDriver) error {
+	vols, err := d.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		return err
+	}
+	var failed []string
+	for _, v := range vols {
+		if !common.AttachedHere(v, m.nodeID, m.ops.InstanceID()) {
+			continue
+		}
+		for _, mountPath := range v.GetAttachPath() {
+			if err := d.Unmount(v.GetId(), mountPath, nil); err != nil {
+				failed = append(failed, fmt.Sprintf("unmount %s: %v", v.GetId(), err))
+			}
+		}
+		if err := d.Detach(v.GetId(), nil); err != nil {
+			failed = append(failed, fmt.Sprintf("detach %s: %v", v.GetId(), err))
+		}
+	}
+	syscall.Sync()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	drives, err := m.Enumerate()
+	if err != nil {
+		return err
+	}
+	for _, drive := range drives {
+		if err := m.ops.Detach(drive.Id); err != nil {
+			failed = append(failed, fmt.Sprintf("detach drive %s: %v", drive.Id, err))
+			continue
+		}
+		drive.DevicePath = ""
+		if err := m.put(drive); err != nil {
+			return err
+		}
+	}
+	if len(failed) != 0 {
+		return fmt.Errorf("Failed to release %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
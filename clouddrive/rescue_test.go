@@ -0,0 +1,117 @@
+package clouddrive
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAWSPreemption(t *testing.T) {
+	action := ""
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(action) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, action)
+	}))
+	defer ts.Close()
+	w := &awsWatcher{client: ts.Client(), url: ts.URL}
+
+	notice, err := w.Preemption()
+	require.NoError(t, err)
+	assert.Nil(t, notice)
+
+	action = `{"action": "terminate", "time": "2018-09-18T08:22:00Z"}`
+	notice, err = w.Preemption()
+	require.NoError(t, err)
+	require.NotNil(t, notice)
+	assert.Equal(t, "terminate", notice.Action)
+	assert.Equal(t, 2018, notice.Time.Year())
+}
+
+func TestGCEPreemption(t *testing.T) {
+	preempted := "FALSE"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata-Flavor") != "Google" {
+			http.Error(w, "missing header", http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, preempted)
+	}))
+	defer ts.Close()
+	w := &gceWatcher{client: ts.Client(), url: ts.URL}
+
+	notice, err := w.Preemption()
+	require.NoError(t, err)
+	assert.Nil(t, notice)
+
+	preempted = "TRUE"
+	notice, err = w.Preemption()
+	require.NoError(t, err)
+	assert.NotNil(t, notice)
+}
+
+type fakeVolumes struct {
+	vols      []*api.Volume
+	unmounted []string
+	detached  []string
+}
+
+func (f *fakeVolumes) Enumerate(locator *api.VolumeLocator, labels map[string]string) ([]*api.Volume, error) {
+	return f.vols, nil
+}
+
+func (f *fakeVolumes) Unmount(volumeID string, mountPath string, options map[string]string) error {
+	f.unmounted = append(f.unmounted, volumeID+":"+mountPath)
+	return nil
+}
+
+func (f *fakeVolumes) Detach(volumeID string, options map[string]string) error {
+	f.detached = append(f.detached, volumeID)
+	return nil
+}
+
+func TestRescue(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	ops := &fakeOps{instance: "i-1", disks: make(map[string]*fakeDisk)}
+	pools := fakePools{}
+	m := newTestManager(t, kv, ops, pools)
+	require.NoError(t, m.Reconcile())
+
+	vols := &fakeVolumes{vols: []*api.Volume{
+		{Id: "vol1", AttachedOn: "node1", AttachPath: []string{"/mnt/vol1"}},
+		{Id: "vol2", AttachedOn: "i-1"},
+		{Id: "vol3", AttachedOn: "node2", AttachPath: []string{"/mnt/vol3"}},
+		{Id: "vol4"},
+	}}
+	require.NoError(t, m.Rescue(vols))
+	assert.Equal(t, []string{"vol1:/mnt/vol1"}, vols.unmounted)
+	assert.Equal(t, []string{"vol1", "vol2"}, vols.detached)
+	for _, d := range ops.disks {
+		assert.Empty(t, d.instance)
+	}
+	drives, err := m.Enumerate()
+	require.NoError(t, err)
+	for _, d := range drives {
+		assert.Empty(t, d.DevicePath)
+	}
+
+	// A replacement instance attaches the released drives
+	ops.instance = "i-2"
+	m = newTestManager(t, kv, ops, pools)
+	require.NoError(t, m.Reconcile())
+	assert.Len(t, ops.disks, 3)
+	assert.Len(t, pools[0], 2)
+	for _, d := range ops.disks {
+		assert.Equal(t, "i-2", d.instance)
+	}
+}
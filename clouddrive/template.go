@@ -0,0 +1,54 @@
+package clouddrive
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/libopenstorage/openstorage/pkg/storageops"
+	compute "google.golang.org/api/compute/v1"
+)
+
+// TemplateFunc returns the provider template of a drive named name, as
+// passed to storageops.Ops.Create.
+type TemplateFunc func(spec *DriveSpec, name string) (interface{}, error)
+
+// NewTemplateFunc returns the templates of the drives of the instance ops
+// runs on, in the zone of the instance.
+func NewTemplateFunc(ops storageops.Ops) (TemplateFunc, error) {
+	instance, err := ops.Describe()
+	if err != nil {
+		return nil, err
+	}
+	switch v := instance.(type) {
+	case *ec2.Instance:
+		if v.Placement == nil || v.Placement.AvailabilityZone == nil {
+			return nil, fmt.Errorf("Unable to determine the availability zone of instance %s", ops.InstanceID())
+		}
+		zone := *v.Placement.AvailabilityZone
+		return func(spec *DriveSpec, name string) (interface{}, error) {
+			size := int64(spec.Size)
+			vol := &ec2.Volume{
+				AvailabilityZone: &zone,
+				VolumeType:       &spec.Type,
+				Size:             &size,
+			}
+			if spec.Iops != 0 {
+				iops := spec.Iops
+				vol.Iops = &iops
+			}
+			return vol, nil
+		}, nil
+	case *compute.Instance:
+		zone := path.Base(v.Zone)
+		return func(spec *DriveSpec, name string) (interface{}, error) {
+			return &compute.Disk{
+				Name:   name,
+				SizeGb: int64(spec.Size),
+				Type:   fmt.Sprintf("zones/%s/diskTypes/%s", zone, spec.Type),
+				Zone:   zone,
+			}, nil
+		}, nil
+	}
+	return nil, fmt.Errorf("Cloud drives are not supported on %s", ops.Name())
+}
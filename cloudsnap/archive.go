@@ -0,0 +1,183 @@
+package cloudsnap
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// writeTar writes a tar archive of the tree rooted at dir to w, with paths
+// relative to dir. Only the entries include returns true for are archived,
+// all of them if include is nil. Sockets are skipped.
+func writeTar(w io.Writer, dir string, include func(rel string, hdr *tar.Header) bool) error {
+	tw := tar.NewWriter(w)
+	err := walkTar(dir, func(path, rel string, hdr *tar.Header) error {
+		if include != nil && !include(rel, hdr) {
+			return nil
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// walkTar calls fn with the path, the path relative to dir and the tar
+// header of the entries of the tree rooted at dir, sockets excepted.
+func walkTar(dir string, fn func(path, rel string, hdr *tar.Header) error) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." || info.Mode()&os.ModeSocket != 0 {
+			// sockets cannot be archived, nor restored
+			return nil
+		}
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		return fn(path, hdr.Name, hdr)
+	})
+}
+
+// extractTar extracts the tar archive read from r into dir, replacing the
+// files which exist already. The entries outside of dir, or below a
+// symbolic link of the archive, are rejected.
+func extractTar(r io.Reader, dir string) error {
+	dir = filepath.Clean(dir)
+	var links []string
+	// the modes and times of the directories are set last, once their
+	// entries are extracted
+	var dirs []*tar.Header
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		path := filepath.Join(dir, filepath.FromSlash(hdr.Name))
+		if !strings.HasPrefix(path, dir+string(os.PathSeparator)) {
+			return fmt.Errorf("Archive entry %s is outside of the volume", hdr.Name)
+		}
+		for _, link := range links {
+			if strings.HasPrefix(path, link+string(os.PathSeparator)) {
+				return fmt.Errorf("Archive entry %s is below a symbolic link", hdr.Name)
+			}
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0700); err != nil {
+				return err
+			}
+			dirs = append(dirs, hdr)
+		case tar.TypeReg, tar.TypeRegA:
+			if err := extractFile(tr, path, hdr); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.RemoveAll(path); err != nil {
+				return err
+			}
+			if err := os.Symlink(hdr.Linkname, path); err != nil {
+				return err
+			}
+			links = append(links, path)
+		default:
+			// devices and fifos are not restored
+		}
+	}
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := setAttributes(filepath.Join(dir, filepath.FromSlash(dirs[i].Name)), dirs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeEntries removes the entries of paths, relative to dir, from the
+// tree rooted at dir. The paths outside of dir, including through symbolic
+// links, are rejected.
+func removeEntries(dir string, paths []string) error {
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		path := filepath.Join(root, filepath.FromSlash(p))
+		if !strings.HasPrefix(path, root+string(os.PathSeparator)) {
+			return fmt.Errorf("Path %s removed is outside of the volume", p)
+		}
+		parent, err := filepath.EvalSymlinks(filepath.Dir(path))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if parent != root && !strings.HasPrefix(parent, root+string(os.PathSeparator)) {
+			return fmt.Errorf("Path %s removed is below a symbolic link", p)
+		}
+		if err := os.RemoveAll(filepath.Join(parent, filepath.Base(path))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractFile(r io.Reader, path string, hdr *tar.Header) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return setAttributes(path, hdr)
+}
+
+// setAttributes sets the mode, the modification time and, if running as
+// root, the owner of hdr on path.
+func setAttributes(path string, hdr *tar.Header) error {
+	if os.Geteuid() == 0 {
+		if err := os.Lchown(path, hdr.Uid, hdr.Gid); err != nil {
+			return err
+		}
+	}
+	if err := os.Chmod(path, os.FileMode(hdr.Mode).Perm()); err != nil {
+		return err
+	}
+	return os.Chtimes(path, hdr.ModTime, hdr.ModTime)
+}
@@ -0,0 +1,232 @@
+// Package cloudsnap backs up the volumes of drivers without native cloud
+// backups to S3 compatible object stores, such as AWS S3 and minio. A backup
+// snapshots the volume, mounts the snapshot and streams an archive of its
+// content as chunked objects, followed by a manifest listing the chunks. A
+// backup without a manifest is incomplete and is not listed.
+//
+// The backups are full unless Incremental is set. The snapshot of the last
+// backup of a volume is then kept, so that the next backup is incremental:
+// it compares the snapshot of the volume to the kept one and archives only
+// the files changed since, its manifest naming the backup it is chained to,
+// its parent, and listing the files removed. A backup is full when
+// requested, when its parent or the kept snapshot are gone, or once
+// MaxIncrementals backups are chained. The drivers do not report the blocks
+// or files changed between two snapshots, so both snapshots are walked and
+// the files whose metadata match are compared by content: an incremental
+// backup reads both snapshots but uploads only the changes.
+//
+// A restore provisions a new volume and downloads the chunks of a backup,
+// and of the backups it is chained to, to a local staging directory,
+// retrying the chunks failing to download, then extracts the archives into
+// the volume from the full backup on. A failed restore can be resumed,
+// downloading only the chunks not staged yet.
+//
+// The object stores are described by the credentials of the driver, created
+// with the usual credential API and stored in kvdb.
+package cloudsnap
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/s3"
+)
+
+const (
+	// JobType is the job type of the backups
+	JobType = "cloudsnap"
+	// DefaultChunkSize is the size of the chunk objects of a backup
+	DefaultChunkSize = 8 * 1024 * 1024
+	// MinChunkSize is the smallest chunk size allowed
+	MinChunkSize = 64 * 1024
+	// DefaultPrefix is the object key prefix of the backups
+	DefaultPrefix = "cloudsnaps"
+	// DefaultStagingDir is the directory the chunks of the backups restored
+	// are downloaded to
+	DefaultStagingDir = "/var/lib/osd/cloudsnap"
+	// DefaultMaxIncrementals is the number of incremental backups chained
+	// to a full backup
+	DefaultMaxIncrementals = 7
+
+	// manifestVersion is the version of the backup format, 2 adding the
+	// incremental backups
+	manifestVersion = 2
+	// manifestName is the name of the manifest object of a backup
+	manifestName = "manifest.json"
+)
+
+var (
+	// ErrNotFound returned when a backup or a credential does not exist
+	ErrNotFound = errors.New("Cloud backup not found")
+)
+
+// Config configures the backups of the drivers without native cloud
+// backups.
+type Config struct {
+	// Drivers are the names of the drivers backed up by this package
+	Drivers []string `yaml:"drivers"`
+	// ChunkSize is the size in bytes of the chunk objects, DefaultChunkSize
+	// if not set
+	ChunkSize int64 `yaml:"chunk_size"`
+	// Prefix of the backup object keys, DefaultPrefix if not set
+	Prefix string `yaml:"prefix"`
+	// StagingDir is the directory the chunks of the backups restored are
+	// downloaded to, DefaultStagingDir if not set. It must hold the
+	// compressed size of the backups being restored.
+	StagingDir string `yaml:"staging_dir"`
+	// Incremental chains the backups of a volume to the previous one,
+	// keeping the snapshot of its last backup. The backups are full if not
+	// set.
+	Incremental bool `yaml:"incremental"`
+	// MaxIncrementals is the number of incremental backups chained to a
+	// full backup before the next backup is full, DefaultMaxIncrementals if
+	// not set. Restores download the whole chain.
+	MaxIncrementals int `yaml:"max_incrementals"`
+}
+
+// Enabled returns true if the driver named name is backed up by this
+// package.
+func (c *Config) Enabled(name string) bool {
+	for _, d := range c.Drivers {
+		if d == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the chunk size and the number of incremental backups.
+func (c *Config) Validate() error {
+	if c.ChunkSize != 0 && c.ChunkSize < MinChunkSize {
+		return fmt.Errorf("Cloud backup chunk size must be at least %d bytes", MinChunkSize)
+	}
+	if c.MaxIncrementals < 0 {
+		return fmt.Errorf("Cloud backup max incrementals cannot be negative")
+	}
+	return nil
+}
+
+func (c *Config) chunkSize() int64 {
+	if c.ChunkSize == 0 {
+		return DefaultChunkSize
+	}
+	return c.ChunkSize
+}
+
+func (c *Config) prefix() string {
+	if len(c.Prefix) == 0 {
+		return DefaultPrefix
+	}
+	return c.Prefix
+}
+
+func (c *Config) stagingDir() string {
+	if len(c.StagingDir) == 0 {
+		return DefaultStagingDir
+	}
+	return c.StagingDir
+}
+
+func (c *Config) maxIncrementals() int {
+	if c.MaxIncrementals == 0 {
+		return DefaultMaxIncrementals
+	}
+	return c.MaxIncrementals
+}
+
+// Chunk is an object of a backup.
+type Chunk struct {
+	// Key of the object
+	Key string
+	// Size of the object in bytes
+	Size int64
+	// Sha256 is the hex encoded SHA-256 of the object
+	Sha256 string
+}
+
+// Manifest describes a complete backup.
+// swagger:model
+type Manifest struct {
+	// Version of the backup format
+	Version int
+	// Id of the backup
+	Id string
+	// VolumeId of the volume backed up
+	VolumeId string
+	// Locator of the volume backed up
+	Locator *api.VolumeLocator
+	// Spec of the volume backed up, without its secrets
+	Spec *api.VolumeSpec
+	// PassphraseRequired is set if the volume backed up was encrypted with
+	// a passphrase, removed from Spec, to supply again to restore it
+	PassphraseRequired bool
+	// CreateTime is when the snapshot backed up was taken
+	CreateTime time.Time
+	// Parent is the id of the backup an incremental backup is chained to,
+	// empty for a full backup
+	Parent string
+	// Level is the number of backups the backup is chained to, 0 for a
+	// full backup
+	Level int
+	// Removed are the paths removed from the volume since the parent
+	// backup, or whose type changed, to remove before extracting the
+	// archive
+	Removed []string
+	// Size of the backup in bytes, the sum of the sizes of its chunks
+	Size int64
+	// Chunks are the objects of the gzipped tar archive of the volume, or
+	// of the files changed since the parent backup, in order
+	Chunks []Chunk
+}
+
+// Info returns the description of the backup by the cloud backup API.
+func (m *Manifest) Info() api.CloudBackupInfo {
+	info := api.CloudBackupInfo{
+		ID:            m.Id,
+		SrcVolumeID:   m.VolumeId,
+		SrcVolumeName: m.Locator.GetName(),
+		Timestamp:     m.CreateTime,
+		Metadata: map[string]string{
+			"size":   fmt.Sprintf("%d", m.Size),
+			"chunks": fmt.Sprintf("%d", len(m.Chunks)),
+		},
+		Status: string(api.CloudBackupStatusDone),
+	}
+	if len(m.Parent) != 0 {
+		info.Metadata["parent"] = m.Parent
+	}
+	return info
+}
+
+// Store holds the backup objects. It is implemented by *s3.Client.
+type Store interface {
+	// Put writes data to the object named key
+	Put(key string, data []byte) error
+	// Get returns the content of the object named key
+	Get(key string) ([]byte, error)
+	// List returns the keys starting with prefix in lexicographic order
+	List(prefix string) ([]string, error)
+	// Delete removes the object named key
+	Delete(key string) error
+}
+
+// newStore returns the client of the bucket described by c. It is
+// overridden in tests.
+var newStore = func(c *s3.Config) (Store, error) {
+	return s3.New(c)
+}
+
+// Driver is the part of a volume driver its volumes are backed up and
+// restored with.
+type Driver interface {
+	Create(locator *api.VolumeLocator, source *api.Source, spec *api.VolumeSpec) (string, error)
+	Inspect(volumeIDs []string) ([]*api.Volume, error)
+	Snapshot(volumeID string, readonly bool, locator *api.VolumeLocator, noRetry bool) (string, error)
+	Delete(volumeID string) error
+	Attach(volumeID string, attachOptions map[string]string) (string, error)
+	Detach(volumeID string, options map[string]string) error
+	Mount(volumeID string, mountPath string, options map[string]string) error
+	Unmount(volumeID string, mountPath string, options map[string]string) error
+}
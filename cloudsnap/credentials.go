@@ -0,0 +1,166 @@
+package cloudsnap
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+	"github.com/libopenstorage/openstorage/pkg/s3"
+	"github.com/pborman/uuid"
+	"github.com/portworx/kvdb"
+)
+
+const (
+	credentialsKey = "cloudsnap/credentials"
+	// credTypeS3 is the only type of credential supported
+	credTypeS3 = "s3"
+)
+
+// Credential is an object store the backups are written to.
+type Credential struct {
+	// Id of the credential
+	Id string
+	// Name of the credential, optional
+	Name string
+	// ObjectStore is the bucket of the backups
+	ObjectStore s3.Config
+}
+
+// params returns the credential as the parameters it was created with,
+// without its secret key.
+func (c *Credential) params() map[string]interface{} {
+	return map[string]interface{}{
+		api.OptCredName:      c.Name,
+		api.OptCredType:      credTypeS3,
+		api.OptCredEndpoint:  c.ObjectStore.Endpoint,
+		api.OptCredRegion:    c.ObjectStore.Region,
+		api.OptCredBucket:    c.ObjectStore.Bucket,
+		api.OptCredAccessKey: c.ObjectStore.AccessKey,
+	}
+}
+
+func credentialKey(id string) string {
+	return filepath.Join(credentialsKey, id)
+}
+
+// credentialFromParams returns the credential described by the parameters
+// of the credential API. The cipher of the backups encrypted with the
+// credential must be allowed by the crypto policy.
+func credentialFromParams(params map[string]string) (*Credential, error) {
+	if t, ok := params[api.OptCredType]; ok && t != credTypeS3 {
+		return nil, fmt.Errorf("Unsupported credential type %s, only %s is supported", t, credTypeS3)
+	}
+	if len(params[api.OptCredEncrKey]) != 0 {
+		cipher, ok := params[api.OptCredEncrCipher]
+		if !ok {
+			cipher = crypto.DefaultBackupCipher
+		}
+		if err := crypto.GetPolicy().CheckCipher(cipher); err != nil {
+			return nil, fmt.Errorf("Backup encryption not allowed: %v", err)
+		}
+	}
+	endpoint := params[api.OptCredEndpoint]
+	if len(endpoint) != 0 && !strings.Contains(endpoint, "://") {
+		scheme := "https://"
+		if disable, _ := strconv.ParseBool(params[api.OptCredDisableSSL]); disable {
+			scheme = "http://"
+		}
+		endpoint = scheme + endpoint
+	}
+	c := &Credential{
+		Name: params[api.OptCredName],
+		ObjectStore: s3.Config{
+			Endpoint:  endpoint,
+			Region:    params[api.OptCredRegion],
+			Bucket:    params[api.OptCredBucket],
+			AccessKey: params[api.OptCredAccessKey],
+			SecretKey: params[api.OptCredSecretKey],
+		},
+	}
+	if err := c.ObjectStore.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// CreateCredential stores the object store described by params and returns
+// the id of its credential.
+func (m *Manager) CreateCredential(params map[string]string) (string, error) {
+	c, err := credentialFromParams(params)
+	if err != nil {
+		return "", err
+	}
+	c.Id = uuid.New()
+	if _, err := m.kv.Create(credentialKey(c.Id), c, 0); err != nil {
+		return "", err
+	}
+	return c.Id, nil
+}
+
+// Credential returns the credential with the given id.
+// Errors ErrNotFound may be returned.
+func (m *Manager) Credential(id string) (*Credential, error) {
+	c := new(Credential)
+	if _, err := m.kv.GetVal(credentialKey(id), c); err != nil {
+		if err == kvdb.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// EnumerateCredentials returns the parameters of the credentials, without
+// their secret keys, by id.
+func (m *Manager) EnumerateCredentials() (map[string]interface{}, error) {
+	kvps, err := m.kv.Enumerate(credentialsKey)
+	if err != nil {
+		return nil, err
+	}
+	creds := make(map[string]interface{}, len(kvps))
+	for _, kvp := range kvps {
+		c := new(Credential)
+		if err := json.Unmarshal(kvp.Value, c); err != nil {
+			return nil, err
+		}
+		creds[c.Id] = c.params()
+	}
+	return creds, nil
+}
+
+// ValidateCredential checks that the bucket of the credential can be
+// listed.
+func (m *Manager) ValidateCredential(id string) error {
+	store, err := m.store(id)
+	if err != nil {
+		return err
+	}
+	if _, err := store.List(m.config.prefix() + "/"); err != nil {
+		return fmt.Errorf("Failed to access the bucket of credential %s: %v", id, err)
+	}
+	return nil
+}
+
+// DeleteCredential deletes the credential with the given id. The backups
+// written with it are kept.
+func (m *Manager) DeleteCredential(id string) error {
+	if _, err := m.kv.Delete(credentialKey(id)); err != nil && err != kvdb.ErrNotFound {
+		return err
+	}
+	return nil
+}
+
+// store returns the object store of the credential with the given id.
+func (m *Manager) store(credentialID string) (Store, error) {
+	c, err := m.Credential(credentialID)
+	if err == ErrNotFound {
+		return nil, fmt.Errorf("Credential id %s not found", credentialID)
+	} else if err != nil {
+		return nil, err
+	}
+	return newStore(&c.ObjectStore)
+}
@@ -0,0 +1,142 @@
+package cloudsnap
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+)
+
+// delta is the changes between the trees of two snapshots of a volume.
+type delta struct {
+	base map[string]*tar.Header
+	snap map[string]*tar.Header
+	// modified are the regular files of the same metadata in both trees
+	// whose content differ
+	modified map[string]bool
+}
+
+// diff compares the tree of the snapshot mounted on dir to the one of the
+// older snapshot mounted on baseDir. The regular files whose metadata are
+// the same in both are compared by content, as applications may restore
+// the modification times of the files they write.
+func diff(baseDir, dir string) (*delta, error) {
+	base, err := index(baseDir)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := index(dir)
+	if err != nil {
+		return nil, err
+	}
+	d := &delta{base: base, snap: snap, modified: make(map[string]bool)}
+	for rel, hdr := range snap {
+		old, ok := base[rel]
+		if !ok || hdr.Typeflag != tar.TypeReg || !sameHeader(old, hdr) {
+			continue
+		}
+		same, err := sameContent(
+			filepath.Join(baseDir, filepath.FromSlash(rel)),
+			filepath.Join(dir, filepath.FromSlash(rel)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if !same {
+			d.modified[rel] = true
+		}
+	}
+	return d, nil
+}
+
+// index returns the tar headers of the entries of the tree rooted at dir by
+// relative path.
+func index(dir string) (map[string]*tar.Header, error) {
+	hdrs := make(map[string]*tar.Header)
+	err := walkTar(dir, func(_, rel string, hdr *tar.Header) error {
+		hdrs[rel] = hdr
+		return nil
+	})
+	return hdrs, err
+}
+
+// sameHeader returns true if the metadata archived of an entry are the
+// same in both headers.
+func sameHeader(old, hdr *tar.Header) bool {
+	return old.Typeflag == hdr.Typeflag &&
+		old.Mode == hdr.Mode &&
+		old.Size == hdr.Size &&
+		old.ModTime.Equal(hdr.ModTime) &&
+		old.Uid == hdr.Uid &&
+		old.Gid == hdr.Gid &&
+		old.Linkname == hdr.Linkname
+}
+
+// sameContent returns true if the files at path1 and path2 have the same
+// content.
+func sameContent(path1, path2 string) (bool, error) {
+	f1, err := os.Open(path1)
+	if err != nil {
+		return false, err
+	}
+	defer f1.Close()
+	f2, err := os.Open(path2)
+	if err != nil {
+		return false, err
+	}
+	defer f2.Close()
+
+	buf1 := make([]byte, 64*1024)
+	buf2 := make([]byte, len(buf1))
+	for {
+		n1, err1 := io.ReadFull(f1, buf1)
+		n2, err2 := io.ReadFull(f2, buf2)
+		if n1 != n2 || !bytes.Equal(buf1[:n1], buf2[:n2]) {
+			return false, nil
+		}
+		eof1 := err1 == io.EOF || err1 == io.ErrUnexpectedEOF
+		eof2 := err2 == io.EOF || err2 == io.ErrUnexpectedEOF
+		if err1 != nil && !eof1 {
+			return false, err1
+		} else if err2 != nil && !eof2 {
+			return false, err2
+		}
+		if eof1 || eof2 {
+			return eof1 == eof2, nil
+		}
+	}
+}
+
+// changed returns true if the entry rel, of header hdr in the newer
+// snapshot, is new or was modified.
+func (d *delta) changed(rel string, hdr *tar.Header) bool {
+	old, ok := d.base[rel]
+	return !ok || !sameHeader(old, hdr) || d.modified[rel]
+}
+
+// removed returns the sorted paths of the entries of the older snapshot
+// which are gone, or whose type changed, from the newer one. The entries
+// below a directory removed are not listed.
+func (d *delta) removed() []string {
+	gone := make(map[string]bool)
+	for rel, old := range d.base {
+		if hdr, ok := d.snap[rel]; !ok || hdr.Typeflag != old.Typeflag {
+			gone[rel] = true
+		}
+	}
+	var paths []string
+	for rel := range gone {
+		below := false
+		for dir := path.Dir(rel); dir != "." && !below; dir = path.Dir(dir) {
+			below = gone[dir]
+		}
+		if !below {
+			paths = append(paths, rel)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,135 @@
+package cloudsnap
+
+import (
+	"fmt"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/portworx/kvdb"
+)
+
+// Wrap returns the function wrapping a volume driver so that its
+// credentials and cloud backups are those of this package, stored in kv and
+// configured by c.
+func Wrap(kv kvdb.Kvdb, c *Config) func(volume.VolumeDriver) volume.VolumeDriver {
+	return func(d volume.VolumeDriver) volume.VolumeDriver {
+		return &backupDriver{VolumeDriver: d, manager: NewManager(kv, d, c)}
+	}
+}
+
+// backupDriver is a volume driver backing up its volumes with a manager.
+type backupDriver struct {
+	volume.VolumeDriver
+	manager *Manager
+}
+
+// CredsCreate stores an S3 credential.
+func (d *backupDriver) CredsCreate(params map[string]string) (string, error) {
+	return d.manager.CreateCredential(params)
+}
+
+// CredsDelete deletes a credential.
+func (d *backupDriver) CredsDelete(id string) error {
+	return d.manager.DeleteCredential(id)
+}
+
+// CredsEnumerate lists the credentials without their secret keys.
+func (d *backupDriver) CredsEnumerate() (map[string]interface{}, error) {
+	return d.manager.EnumerateCredentials()
+}
+
+// CredsValidate checks that the bucket of a credential can be listed.
+func (d *backupDriver) CredsValidate(id string) error {
+	return d.manager.ValidateCredential(id)
+}
+
+// CloudBackupCreate starts a backup of the volume, incremental if enabled
+// unless a full one is requested, the name of the task being the id of the
+// backup.
+func (d *backupDriver) CloudBackupCreate(
+	input *api.CloudBackupCreateRequest,
+) (*api.CloudBackupCreateResponse, error) {
+	id, err := d.manager.Backup(input.VolumeID, input.CredentialUUID, input.Full)
+	if err != nil {
+		return nil, err
+	}
+	return &api.CloudBackupCreateResponse{Name: id}, nil
+}
+
+// CloudBackupEnumerate lists the complete backups of the object store of
+// the credential.
+func (d *backupDriver) CloudBackupEnumerate(
+	input *api.CloudBackupEnumerateRequest,
+) (*api.CloudBackupEnumerateResponse, error) {
+	manifests, err := d.manager.Enumerate(input.CredentialUUID, input.SrcVolumeID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.CloudBackupEnumerateResponse{}
+	for _, m := range manifests {
+		resp.Backups = append(resp.Backups, m.Info())
+	}
+	return resp, nil
+}
+
+// CloudBackupDelete deletes a backup from the object store of the
+// credential.
+func (d *backupDriver) CloudBackupDelete(input *api.CloudBackupDeleteRequest) error {
+	err := d.manager.DeleteBackup(input.CredentialUUID, input.ID)
+	if err == ErrNotFound {
+		return fmt.Errorf("Cloud backup %s not found", input.ID)
+	}
+	return err
+}
+
+// CloudBackupStatus returns the status of the backup named by the request,
+// or of the backups of its volume.
+func (d *backupDriver) CloudBackupStatus(
+	input *api.CloudBackupStatusRequest,
+) (*api.CloudBackupStatusResponse, error) {
+	volumeID := input.SrcVolumeID
+	if len(input.Name) != 0 {
+		volumeID = ""
+	}
+	statuses, err := d.manager.Status(volumeID)
+	if err != nil {
+		return nil, err
+	}
+	if len(input.Name) != 0 {
+		status, ok := statuses[input.Name]
+		if !ok {
+			return nil, fmt.Errorf("Cloud backup task %s not found", input.Name)
+		}
+		statuses = map[string]api.CloudBackupStatus{input.Name: status}
+	}
+	return &api.CloudBackupStatusResponse{Statuses: statuses}, nil
+}
+
+// CloudBackupRestore provisions a volume named by the request, or after the
+// volume backed up, and starts restoring the backup into it. The passphrase
+// of an encrypted volume backed up must be supplied with the request.
+func (d *backupDriver) CloudBackupRestore(
+	input *api.CloudBackupRestoreRequest,
+) (*api.CloudBackupRestoreResponse, error) {
+	volumeID, id, err := d.manager.Restore(input.CredentialUUID, input.ID,
+		&api.VolumeLocator{Name: input.RestoreVolumeName}, nil, input.Passphrase)
+	if err == ErrNotFound {
+		return nil, fmt.Errorf("Cloud backup %s not found", input.ID)
+	} else if err != nil {
+		return nil, err
+	}
+	return &api.CloudBackupRestoreResponse{RestoreVolumeID: volumeID, Name: id}, nil
+}
+
+// CloudBackupStateChange resumes a failed restore. Backups and restores
+// cannot be paused or stopped.
+func (d *backupDriver) CloudBackupStateChange(input *api.CloudBackupStateChangeRequest) error {
+	if input.RequestedState != api.CloudBackupRequestedStateResume {
+		return volume.ErrNotSupported
+	}
+	err := d.manager.Resume(input.Name)
+	if err == ErrNotFound {
+		return fmt.Errorf("Cloud backup task %s not found", input.Name)
+	}
+	return err
+}
@@ -0,0 +1,488 @@
+package cloudsnap
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/jobs"
+	"github.com/libopenstorage/openstorage/pkg/redact"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/pborman/uuid"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	statusesKey = "cloudsnap/status"
+	basesKey    = "cloudsnap/bases"
+)
+
+// base records the snapshot kept of the last backup of a volume to an
+// object store, the next backup of the volume being incremental to it.
+type base struct {
+	// BackupId of the last backup
+	BackupId string
+	// SnapshotId of the snapshot backed up
+	SnapshotId string
+}
+
+// Manager takes the backups of the volumes of a driver.
+type Manager struct {
+	kv     kvdb.Kvdb
+	driver Driver
+	config Config
+	// submit runs f in the background, it is overridden in tests
+	submit func(volumeID string, f func() error) error
+}
+
+// NewManager returns the manager of the backups of the volumes of driver d,
+// as configured by c, with the credentials and the statuses of the backups
+// stored in kv.
+func NewManager(kv kvdb.Kvdb, d Driver, c *Config) *Manager {
+	return &Manager{
+		kv:     kv,
+		driver: d,
+		config: *c,
+		submit: func(volumeID string, f func() error) error {
+			jm, err := jobs.Inst()
+			if err != nil {
+				return err
+			}
+			_, err = jm.Submit(JobType, volumeID, f)
+			return err
+		},
+	}
+}
+
+func statusKey(id string) string {
+	return filepath.Join(statusesKey, id)
+}
+
+func baseKey(credentialID, volumeID string) string {
+	return filepath.Join(basesKey, credentialID, volumeID)
+}
+
+// backupKey returns the key of the object name of backup id.
+func (m *Manager) backupKey(id, name string) string {
+	return strings.Trim(m.config.prefix(), "/") + "/" + id + "/" + name
+}
+
+// Backup starts the backup of volumeID to the object store of credentialID
+// as a job and returns the id of the backup, which is also the name of the
+// task reported by Status. If the backups are incremental, the backup is
+// incremental to the last backup of the volume to the object store unless
+// full is set.
+func (m *Manager) Backup(volumeID, credentialID string, full bool) (string, error) {
+	store, err := m.store(credentialID)
+	if err != nil {
+		return "", err
+	}
+	vols, err := m.driver.Inspect([]string{volumeID})
+	if err != nil {
+		return "", err
+	}
+	if len(vols) != 1 {
+		return "", fmt.Errorf("Volume %s not found", volumeID)
+	}
+	status := &api.CloudBackupStatus{
+		ID:             uuid.New(),
+		OpType:         api.CloudBackupOp,
+		Status:         api.CloudBackupStatusQueued,
+		BytesTotal:     vols[0].GetSpec().GetSize(),
+		StartTime:      time.Now(),
+		SrcVolumeID:    volumeID,
+		CredentialUUID: credentialID,
+	}
+	if err := m.putStatus(status); err != nil {
+		return "", err
+	}
+	err = m.submit(volumeID, func() error {
+		err := m.backup(vols[0], store, full, status)
+		status.CompletedTime = time.Now()
+		if err != nil {
+			status.Status = api.CloudBackupStatusFailed
+			status.Info = []string{err.Error()}
+		} else {
+			status.Status = api.CloudBackupStatusDone
+		}
+		if perr := m.putStatus(status); perr != nil {
+			logrus.WithField("pkg", "openstorage/cloudsnap").
+				Warnf("Failed to save the status of backup %s: %v", status.ID, perr)
+		}
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return status.ID, nil
+}
+
+// backup uploads a snapshot of vol to store, updating status as chunks are
+// uploaded, and keeps the snapshot as the base of the next backup. The
+// chunks uploaded and the snapshot are deleted if the backup fails.
+func (m *Manager) backup(vol *api.Volume, store Store, full bool, status *api.CloudBackupStatus) (err error) {
+	status.Status = api.CloudBackupStatusActive
+	if err := m.putStatus(status); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	snapID, err := m.driver.Snapshot(vol.GetId(), true, &api.VolumeLocator{
+		Name: fmt.Sprintf("%s.cloudsnap.%s", vol.GetLocator().GetName(), status.ID),
+	}, false)
+	if err != nil {
+		return fmt.Errorf("Failed to snapshot volume %s: %v", vol.GetId(), err)
+	}
+	// the snapshot is kept, or deleted, once unmounted
+	var old *base
+	defer func() {
+		switch {
+		case err != nil:
+			m.deleteSnapshot(snapID, status.ID)
+		case m.config.Incremental:
+			m.keepBase(status.CredentialUUID, vol.GetId(), status.ID, snapID, old)
+		default:
+			m.deleteSnapshot(snapID, status.ID)
+			m.dropBase(status.CredentialUUID, vol.GetId(), old)
+		}
+	}()
+
+	dir, unmount, err := m.mount(snapID)
+	if err != nil {
+		return err
+	}
+	defer unmount()
+
+	if old, err = m.base(status.CredentialUUID, vol.GetId()); err != nil {
+		return err
+	}
+	var parent *Manifest
+	if !full && m.config.Incremental && old != nil {
+		parent = m.parent(store, old)
+	}
+
+	manifest := &Manifest{
+		Version:    manifestVersion,
+		Id:         status.ID,
+		VolumeId:   vol.GetId(),
+		Locator:    vol.GetLocator(),
+		Spec:       specWithoutSecrets(vol.GetSpec()),
+		CreateTime: now,
+		// the passphrase is not uploaded with the backup
+		PassphraseRequired: len(vol.GetSpec().GetPassphrase()) != 0,
+	}
+	var include func(string, *tar.Header) bool
+	if parent != nil {
+		baseDir, unmountBase, err := m.mount(old.SnapshotId)
+		if err != nil {
+			return err
+		}
+		d, err := diff(baseDir, dir)
+		unmountBase()
+		if err != nil {
+			return fmt.Errorf("Failed to compare snapshot %s to snapshot %s: %v", snapID, old.SnapshotId, err)
+		}
+		include = d.changed
+		manifest.Parent = parent.Id
+		manifest.Level = parent.Level + 1
+		manifest.Removed = d.removed()
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, c := range manifest.Chunks {
+			if derr := store.Delete(c.Key); derr != nil {
+				logrus.WithField("pkg", "openstorage/cloudsnap").
+					Warnf("Failed to delete chunk %s of failed backup %s: %v", c.Key, status.ID, derr)
+			}
+		}
+	}()
+
+	pr, pw := io.Pipe()
+	go func() {
+		zw := gzip.NewWriter(pw)
+		err := writeTar(zw, dir, include)
+		if err == nil {
+			err = zw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	defer pr.Close()
+
+	buf := make([]byte, m.config.chunkSize())
+	for {
+		n, rerr := io.ReadFull(pr, buf)
+		if n > 0 {
+			chunk := Chunk{
+				Key:    m.backupKey(status.ID, fmt.Sprintf("chunks/%08d", len(manifest.Chunks))),
+				Size:   int64(n),
+				Sha256: checksum(buf[:n]),
+			}
+			if err := store.Put(chunk.Key, buf[:n]); err != nil {
+				return fmt.Errorf("Failed to upload chunk %s: %v", chunk.Key, err)
+			}
+			manifest.Chunks = append(manifest.Chunks, chunk)
+			manifest.Size += chunk.Size
+			status.BytesDone = uint64(manifest.Size)
+			if err := m.putStatus(status); err != nil {
+				return err
+			}
+		}
+		if rerr == io.EOF || rerr == io.ErrUnexpectedEOF {
+			break
+		} else if rerr != nil {
+			return fmt.Errorf("Failed to archive snapshot %s: %v", snapID, rerr)
+		}
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return store.Put(m.backupKey(status.ID, manifestName), data)
+}
+
+// specWithoutSecrets returns a copy of spec without its secrets, such as its
+// passphrase, to store in the object store.
+func specWithoutSecrets(spec *api.VolumeSpec) *api.VolumeSpec {
+	if spec == nil {
+		return nil
+	}
+	spec = proto.Clone(spec).(*api.VolumeSpec)
+	redact.Value(spec)
+	return spec
+}
+
+// base returns the base of the next backup of volumeID to the object store
+// of credentialID, nil if none.
+func (m *Manager) base(credentialID, volumeID string) (*base, error) {
+	var b base
+	if _, err := m.kv.GetVal(baseKey(credentialID, volumeID), &b); err == kvdb.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// parent returns the manifest of the backup of b in store, the next backup
+// being incremental to it, or nil if the next backup is full because the
+// backup or its snapshot are gone or the chain is complete.
+func (m *Manager) parent(store Store, b *base) *Manifest {
+	manifest, err := m.manifest(store, b.BackupId)
+	if err != nil || manifest.Level >= m.config.maxIncrementals() {
+		return nil
+	}
+	if vols, err := m.driver.Inspect([]string{b.SnapshotId}); err != nil || len(vols) != 1 {
+		return nil
+	}
+	return manifest
+}
+
+// keepBase keeps the snapshot snapID of the backup backupID of volumeID as
+// the base of the next backup of the volume to the object store of
+// credentialID, and deletes the snapshot of the previous base old. The
+// snapshot is deleted if it cannot be kept, the next backup being full.
+func (m *Manager) keepBase(credentialID, volumeID, backupID, snapID string, old *base) {
+	b := &base{BackupId: backupID, SnapshotId: snapID}
+	if _, err := m.kv.Put(baseKey(credentialID, volumeID), b, 0); err != nil {
+		logrus.WithField("pkg", "openstorage/cloudsnap").
+			Warnf("Failed to keep snapshot %s of backup %s: %v", snapID, backupID, err)
+		m.deleteSnapshot(snapID, backupID)
+		return
+	}
+	if old != nil {
+		m.deleteSnapshot(old.SnapshotId, old.BackupId)
+	}
+}
+
+// dropBase deletes the base old of the backups of volumeID to the object
+// store of credentialID, kept while the backups were incremental.
+func (m *Manager) dropBase(credentialID, volumeID string, old *base) {
+	if old == nil {
+		return
+	}
+	if _, err := m.kv.Delete(baseKey(credentialID, volumeID)); err != nil && err != kvdb.ErrNotFound {
+		logrus.WithField("pkg", "openstorage/cloudsnap").
+			Warnf("Failed to drop snapshot %s of backup %s: %v", old.SnapshotId, old.BackupId, err)
+		return
+	}
+	m.deleteSnapshot(old.SnapshotId, old.BackupId)
+}
+
+func (m *Manager) deleteSnapshot(snapID, backupID string) {
+	if err := m.driver.Delete(snapID); err != nil {
+		logrus.WithField("pkg", "openstorage/cloudsnap").
+			Warnf("Failed to delete snapshot %s of backup %s: %v", snapID, backupID, err)
+	}
+}
+
+// mount attaches, if the driver supports it, and mounts volumeID on a
+// temporary directory, and returns it with the function unmounting it.
+func (m *Manager) mount(volumeID string) (string, func(), error) {
+	attached := true
+	if _, err := m.driver.Attach(volumeID, nil); err == volume.ErrNotSupported {
+		attached = false
+	} else if err != nil {
+		return "", nil, fmt.Errorf("Failed to attach volume %s: %v", volumeID, err)
+	}
+	detach := func() {
+		if !attached {
+			return
+		}
+		if err := m.driver.Detach(volumeID, nil); err != nil {
+			logrus.WithField("pkg", "openstorage/cloudsnap").
+				Warnf("Failed to detach volume %s: %v", volumeID, err)
+		}
+	}
+	dir, err := ioutil.TempDir("", "cloudsnap")
+	if err != nil {
+		detach()
+		return "", nil, err
+	}
+	if err := m.driver.Mount(volumeID, dir, nil); err != nil {
+		os.RemoveAll(dir)
+		detach()
+		return "", nil, fmt.Errorf("Failed to mount volume %s: %v", volumeID, err)
+	}
+	return dir, func() {
+		if err := m.driver.Unmount(volumeID, dir, nil); err != nil {
+			logrus.WithField("pkg", "openstorage/cloudsnap").
+				Warnf("Failed to unmount volume %s: %v", volumeID, err)
+		}
+		os.RemoveAll(dir)
+		detach()
+	}, nil
+}
+
+// Manifest returns the manifest of the backup id in the object store of
+// credentialID.
+// Errors ErrNotFound may be returned.
+func (m *Manager) Manifest(credentialID, id string) (*Manifest, error) {
+	store, err := m.store(credentialID)
+	if err != nil {
+		return nil, err
+	}
+	return m.manifest(store, id)
+}
+
+func (m *Manager) manifest(store Store, id string) (*Manifest, error) {
+	data, err := store.Get(m.backupKey(id, manifestName))
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	manifest := new(Manifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("Invalid manifest of backup %s: %v", id, err)
+	}
+	return manifest, nil
+}
+
+// Enumerate returns the complete backups in the object store of
+// credentialID, of volumeID or of all volumes if empty, oldest first.
+func (m *Manager) Enumerate(credentialID, volumeID string) ([]*Manifest, error) {
+	store, err := m.store(credentialID)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := store.List(strings.Trim(m.config.prefix(), "/") + "/")
+	if err != nil {
+		return nil, err
+	}
+	var manifests []*Manifest
+	for _, k := range keys {
+		if path.Base(k) != manifestName {
+			continue
+		}
+		manifest, err := m.manifest(store, path.Base(path.Dir(k)))
+		if err != nil {
+			return nil, err
+		}
+		if len(volumeID) == 0 || manifest.VolumeId == volumeID {
+			manifests = append(manifests, manifest)
+		}
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].CreateTime.Before(manifests[j].CreateTime)
+	})
+	return manifests, nil
+}
+
+// DeleteBackup deletes the backup id from the object store of
+// credentialID, its manifest first so that it is never listed incomplete.
+// The backups with incremental backups chained to them cannot be deleted.
+// Errors ErrNotFound may be returned.
+func (m *Manager) DeleteBackup(credentialID, id string) error {
+	store, err := m.store(credentialID)
+	if err != nil {
+		return err
+	}
+	manifest, err := m.manifest(store, id)
+	if err != nil {
+		return err
+	}
+	manifests, err := m.Enumerate(credentialID, manifest.VolumeId)
+	if err != nil {
+		return err
+	}
+	for _, child := range manifests {
+		if child.Parent == id {
+			return fmt.Errorf("Cloud backup %s is the parent of incremental backup %s", id, child.Id)
+		}
+	}
+	if err := store.Delete(m.backupKey(id, manifestName)); err != nil {
+		return err
+	}
+	for _, c := range manifest.Chunks {
+		if err := store.Delete(c.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Status returns the status of the backups, of volumeID or of all volumes
+// if empty, by id.
+func (m *Manager) Status(volumeID string) (map[string]api.CloudBackupStatus, error) {
+	kvps, err := m.kv.Enumerate(statusesKey)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]api.CloudBackupStatus, len(kvps))
+	for _, kvp := range kvps {
+		var status api.CloudBackupStatus
+		if err := json.Unmarshal(kvp.Value, &status); err != nil {
+			return nil, err
+		}
+		if len(volumeID) == 0 || status.SrcVolumeID == volumeID {
+			statuses[status.ID] = status
+		}
+	}
+	return statuses, nil
+}
+
+// checksum returns the hex encoded SHA-256 of data.
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (m *Manager) putStatus(status *api.CloudBackupStatus) error {
+	_, err := m.kv.Put(statusKey(status.ID), status, 0)
+	return err
+}
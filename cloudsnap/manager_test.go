@@ -0,0 +1,529 @@
+package cloudsnap
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+	"github.com/libopenstorage/openstorage/pkg/s3"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memStore is an object store in memory, failing the puts while err is set
+// and the gets of a key as many times as set by getErrs.
+type memStore struct {
+	objects map[string][]byte
+	err     error
+	getErrs map[string]int
+	gets    int
+}
+
+func (s *memStore) Put(key string, data []byte) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.objects[key] = append([]byte{}, data...)
+	return nil
+}
+
+func (s *memStore) Get(key string) ([]byte, error) {
+	s.gets++
+	if s.getErrs[key] > 0 {
+		s.getErrs[key]--
+		return nil, errors.New("connection reset by peer")
+	}
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, s3.ErrNotFound
+	}
+	return data, nil
+}
+
+func (s *memStore) List(prefix string) ([]string, error) {
+	var keys []string
+	for k := range s.objects {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *memStore) Delete(key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+// testDriver is a mock driver of volumes whose content is files, written to
+// the directory a volume is mounted on with modTime as modification time and
+// read back when it is unmounted. Its volumes cannot be attached.
+type testDriver struct {
+	*mock.MockVolumeDriver
+	t     *testing.T
+	files map[string]map[string][]byte
+}
+
+var modTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// testVolume returns the volume backed up by the tests.
+func testVolume() *api.Volume {
+	return &api.Volume{
+		Id:      "vol1",
+		Locator: &api.VolumeLocator{Name: "data"},
+		Spec:    &api.VolumeSpec{Size: 1024 * 1024},
+	}
+}
+
+// expectMount expects volumeID to be mounted, then unmounted.
+func (d *testDriver) expectMount(volumeID string) {
+	d.EXPECT().Attach(volumeID, nil).Return("", volume.ErrNotSupported)
+	d.EXPECT().Mount(volumeID, gomock.Any(), nil).
+		Do(func(volumeID, mountPath string, options map[string]string) {
+			for name, data := range d.files[volumeID] {
+				p := filepath.Join(mountPath, name)
+				require.NoError(d.t, os.MkdirAll(filepath.Dir(p), 0755))
+				require.NoError(d.t, ioutil.WriteFile(p, data, 0644))
+				require.NoError(d.t, os.Chtimes(p, modTime, modTime))
+			}
+		}).Return(nil)
+	d.EXPECT().Unmount(volumeID, gomock.Any(), nil).
+		Do(func(volumeID, mountPath string, options map[string]string) {
+			files := make(map[string][]byte)
+			require.NoError(d.t, filepath.Walk(mountPath, func(p string, info os.FileInfo, err error) error {
+				if err != nil || !info.Mode().IsRegular() {
+					return err
+				}
+				rel, err := filepath.Rel(mountPath, p)
+				if err != nil {
+					return err
+				}
+				files[rel], err = ioutil.ReadFile(p)
+				return err
+			}))
+			d.files[volumeID] = files
+		}).Return(nil)
+}
+
+// expectBackup expects vol to be inspected, and snapshotted as snapID, which
+// is mounted to be backed up.
+func (d *testDriver) expectBackup(vol *api.Volume, snapID string) {
+	d.EXPECT().Inspect([]string{vol.GetId()}).Return([]*api.Volume{vol}, nil)
+	d.EXPECT().Snapshot(vol.GetId(), true, gomock.Any(), false).
+		Do(func(volumeID string, readonly bool, locator *api.VolumeLocator, noRetry bool) {
+			d.files[snapID] = make(map[string][]byte)
+			for name, data := range d.files[volumeID] {
+				d.files[snapID][name] = data
+			}
+		}).Return(snapID, nil)
+	d.expectMount(snapID)
+}
+
+// expectBase expects the snapshot snapID of the previous backup to be
+// inspected and mounted, to back up the changes since.
+func (d *testDriver) expectBase(snapID string) {
+	d.EXPECT().Inspect([]string{snapID}).Return([]*api.Volume{{Id: snapID, Readonly: true}}, nil)
+	d.expectMount(snapID)
+}
+
+// expectDelete expects the snapshot snapID to be deleted.
+func (d *testDriver) expectDelete(snapID string) {
+	d.EXPECT().Delete(snapID).Do(func(volumeID string) {
+		delete(d.files, volumeID)
+	}).Return(nil)
+}
+
+// expectRestore expects volumeID to be created, and mounted to be restored
+// unless mount is false. It returns the volume, with the locator and the
+// spec it is created with once created.
+func (d *testDriver) expectRestore(volumeID string, mount bool) *api.Volume {
+	created := &api.Volume{Id: volumeID}
+	d.EXPECT().Create(gomock.Any(), &api.Source{}, gomock.Any()).
+		Do(func(locator *api.VolumeLocator, source *api.Source, spec *api.VolumeSpec) {
+			created.Locator, created.Spec = locator, spec
+			d.files[volumeID] = make(map[string][]byte)
+		}).Return(volumeID, nil)
+	if mount {
+		d.expectMount(volumeID)
+	}
+	return created
+}
+
+func newTestManager(t *testing.T, c *Config) (*Manager, *testDriver, *memStore) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	d := &testDriver{
+		MockVolumeDriver: mock.NewMockVolumeDriver(ctrl),
+		t:                t,
+		files:            make(map[string]map[string][]byte),
+	}
+	store := &memStore{objects: make(map[string][]byte), getErrs: make(map[string]int)}
+	oldStore := newStore
+	newStore = func(c *s3.Config) (Store, error) {
+		return store, nil
+	}
+	t.Cleanup(func() {
+		newStore = oldStore
+	})
+	m := NewManager(kv, d, c)
+	m.submit = func(volumeID string, f func() error) error {
+		f()
+		return nil
+	}
+	return m, d, store
+}
+
+func createCredential(t *testing.T, m *Manager) string {
+	id, err := m.CreateCredential(map[string]string{
+		api.OptCredType:       "s3",
+		api.OptCredEndpoint:   "minio:9000",
+		api.OptCredDisableSSL: "true",
+		api.OptCredBucket:     "backups",
+		api.OptCredAccessKey:  "access",
+		api.OptCredSecretKey:  "secret",
+	})
+	require.NoError(t, err)
+	return id
+}
+
+// untar returns the files of the backup of manifest in store.
+func untar(t *testing.T, store *memStore, manifest *Manifest) map[string][]byte {
+	var data []byte
+	for _, c := range manifest.Chunks {
+		chunk, err := store.Get(c.Key)
+		require.NoError(t, err)
+		assert.Equal(t, c.Size, int64(len(chunk)))
+		data = append(data, chunk...)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(zr)
+	files := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if hdr.Typeflag == tar.TypeReg {
+			content, err := ioutil.ReadAll(tr)
+			require.NoError(t, err)
+			files[hdr.Name] = content
+		}
+	}
+	return files
+}
+
+func TestCredentials(t *testing.T) {
+	m, _, _ := newTestManager(t, &Config{})
+
+	_, err := m.CreateCredential(map[string]string{api.OptCredType: "azure"})
+	assert.Error(t, err)
+	_, err = m.CreateCredential(map[string]string{api.OptCredEndpoint: "minio:9000"})
+	assert.Error(t, err)
+
+	// the cipher of the backups must be allowed by the crypto policy
+	require.NoError(t, crypto.SetPolicy(&crypto.Policy{Ciphers: []string{crypto.DefaultCipher}}))
+	_, err = m.CreateCredential(map[string]string{
+		api.OptCredEndpoint:  "http://minio:9000",
+		api.OptCredBucket:    "backups",
+		api.OptCredAccessKey: "access",
+		api.OptCredSecretKey: "secret",
+		api.OptCredEncrKey:   "key",
+	})
+	crypto.SetPolicy(&crypto.Policy{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not allowed")
+
+	id := createCredential(t, m)
+	c, err := m.Credential(id)
+	require.NoError(t, err)
+	assert.Equal(t, "http://minio:9000", c.ObjectStore.Endpoint)
+
+	creds, err := m.EnumerateCredentials()
+	require.NoError(t, err)
+	require.Contains(t, creds, id)
+	params := creds[id].(map[string]interface{})
+	assert.Equal(t, "backups", params[api.OptCredBucket])
+	assert.NotContains(t, params, api.OptCredSecretKey)
+
+	assert.NoError(t, m.ValidateCredential(id))
+	require.NoError(t, m.DeleteCredential(id))
+	_, err = m.Credential(id)
+	assert.Equal(t, ErrNotFound, err)
+	assert.Error(t, m.ValidateCredential(id))
+}
+
+func TestBackup(t *testing.T) {
+	m, d, store := newTestManager(t, &Config{ChunkSize: MinChunkSize})
+	cred := createCredential(t, m)
+
+	random := make([]byte, 3*MinChunkSize)
+	rand.Read(random)
+	d.files["vol1"] = map[string][]byte{
+		"random":      random,
+		"dir/message": []byte("hello"),
+	}
+
+	d.EXPECT().Inspect([]string{"doesnotexist"}).Return(nil, nil)
+	_, err := m.Backup("doesnotexist", cred, false)
+	assert.Error(t, err)
+	_, err = m.Backup("vol1", "doesnotexist", false)
+	assert.Error(t, err)
+
+	// the snapshot is unmounted and deleted, the backups being full
+	d.expectBackup(testVolume(), "snap1")
+	d.expectDelete("snap1")
+	id, err := m.Backup("vol1", cred, false)
+	require.NoError(t, err)
+
+	statuses, err := m.Status("vol1")
+	require.NoError(t, err)
+	require.Contains(t, statuses, id)
+	assert.Equal(t, api.CloudBackupStatusDone, statuses[id].Status)
+	assert.Equal(t, cred, statuses[id].CredentialUUID)
+
+	manifest, err := m.Manifest(cred, id)
+	require.NoError(t, err)
+	assert.Equal(t, "vol1", manifest.VolumeId)
+	assert.Equal(t, "data", manifest.Locator.GetName())
+	assert.True(t, len(manifest.Chunks) > 3)
+	assert.Equal(t, uint64(manifest.Size), statuses[id].BytesDone)
+	assert.Equal(t, d.files["vol1"], untar(t, store, manifest))
+
+	manifests, err := m.Enumerate(cred, "vol1")
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+	assert.Equal(t, id, manifests[0].Id)
+	manifests, err = m.Enumerate(cred, "vol2")
+	require.NoError(t, err)
+	assert.Empty(t, manifests)
+
+	require.NoError(t, m.DeleteBackup(cred, id))
+	assert.Empty(t, store.objects)
+	assert.Equal(t, ErrNotFound, m.DeleteBackup(cred, id))
+}
+
+func TestBackupFailure(t *testing.T) {
+	m, d, store := newTestManager(t, &Config{})
+	cred := createCredential(t, m)
+	d.files["vol1"] = map[string][]byte{"message": []byte("hello")}
+
+	// the snapshot is deleted
+	store.err = errors.New("bucket is full")
+	d.expectBackup(testVolume(), "snap1")
+	d.expectDelete("snap1")
+	id, err := m.Backup("vol1", cred, false)
+	require.NoError(t, err)
+
+	statuses, err := m.Status("")
+	require.NoError(t, err)
+	assert.Equal(t, api.CloudBackupStatusFailed, statuses[id].Status)
+	assert.Contains(t, statuses[id].Info[0], "bucket is full")
+	assert.Empty(t, store.objects)
+}
+
+func TestIncrementalBackup(t *testing.T) {
+	m, d, store := newTestManager(t, &Config{ChunkSize: MinChunkSize, Incremental: true, MaxIncrementals: 2})
+	cred := createCredential(t, m)
+
+	random := make([]byte, 3*MinChunkSize)
+	rand.Read(random)
+	d.files["vol1"] = map[string][]byte{
+		"random":      random,
+		"dir/message": []byte("hello"),
+		"old":         []byte("bye"),
+	}
+	backup := func(full bool) *Manifest {
+		id, err := m.Backup("vol1", cred, full)
+		require.NoError(t, err)
+		manifest, err := m.Manifest(cred, id)
+		require.NoError(t, err)
+		return manifest
+	}
+
+	d.expectBackup(testVolume(), "snap1")
+	full := backup(false)
+	assert.Empty(t, full.Parent)
+	assert.Equal(t, 0, full.Level)
+
+	// only the snapshot of the last backup is kept
+	d.files["vol1"]["dir/message"] = []byte("hello world")
+	delete(d.files["vol1"], "old")
+	d.files["vol1"]["new"] = []byte("new file")
+	d.expectBackup(testVolume(), "snap2")
+	d.expectBase("snap1")
+	d.expectDelete("snap1")
+	inc := backup(false)
+	assert.Equal(t, full.Id, inc.Parent)
+	assert.Equal(t, 1, inc.Level)
+	assert.Equal(t, []string{"old"}, inc.Removed)
+	assert.Equal(t, map[string][]byte{
+		"dir/message": []byte("hello world"),
+		"new":         []byte("new file"),
+	}, untar(t, store, inc))
+	assert.True(t, inc.Size < full.Size)
+	assert.Equal(t, full.Id, inc.Info().Metadata["parent"])
+	b, err := m.base(cred, "vol1")
+	require.NoError(t, err)
+	assert.Equal(t, inc.Id, b.BackupId)
+	assert.Equal(t, "snap2", b.SnapshotId)
+
+	d.expectBackup(testVolume(), "snap3")
+	d.expectBase("snap2")
+	d.expectDelete("snap2")
+	unchanged := backup(false)
+	assert.Equal(t, inc.Id, unchanged.Parent)
+	assert.Empty(t, unchanged.Removed)
+	assert.Empty(t, untar(t, store, unchanged))
+
+	// the chain is complete
+	d.expectBackup(testVolume(), "snap4")
+	d.expectDelete("snap3")
+	next := backup(false)
+	assert.Empty(t, next.Parent)
+	assert.Equal(t, d.files["vol1"], untar(t, store, next))
+	d.expectBackup(testVolume(), "snap5")
+	d.expectBase("snap4")
+	d.expectDelete("snap4")
+	assert.Equal(t, next.Id, backup(false).Parent)
+	d.expectBackup(testVolume(), "snap6")
+	d.expectDelete("snap5")
+	assert.Empty(t, backup(true).Parent)
+
+	// the parent is gone
+	d.files["vol1"]["new"] = []byte("newer file")
+	d.expectBackup(testVolume(), "snap7")
+	d.expectBase("snap6")
+	d.expectDelete("snap6")
+	last := backup(false)
+	require.NoError(t, m.DeleteBackup(cred, last.Id))
+	d.expectBackup(testVolume(), "snap8")
+	d.expectDelete("snap7")
+	assert.Empty(t, backup(false).Parent)
+
+	assert.Error(t, m.DeleteBackup(cred, full.Id))
+	require.NoError(t, m.DeleteBackup(cred, unchanged.Id))
+	require.NoError(t, m.DeleteBackup(cred, inc.Id))
+	require.NoError(t, m.DeleteBackup(cred, full.Id))
+}
+
+func TestBackupFull(t *testing.T) {
+	m, d, _ := newTestManager(t, &Config{Incremental: true})
+	cred := createCredential(t, m)
+	d.files["vol1"] = map[string][]byte{"message": []byte("hello")}
+
+	d.expectBackup(testVolume(), "snap1")
+	id, err := m.Backup("vol1", cred, false)
+	require.NoError(t, err)
+	b, err := m.base(cred, "vol1")
+	require.NoError(t, err)
+	assert.Equal(t, "snap1", b.SnapshotId)
+
+	// the backups are full, and no snapshot is kept, unless incremental
+	m.config.Incremental = false
+	d.expectBackup(testVolume(), "snap2")
+	d.expectDelete("snap2")
+	d.expectDelete("snap1")
+	next, err := m.Backup("vol1", cred, false)
+	require.NoError(t, err)
+	manifest, err := m.Manifest(cred, next)
+	require.NoError(t, err)
+	assert.Empty(t, manifest.Parent)
+	b, err = m.base(cred, "vol1")
+	require.NoError(t, err)
+	assert.Nil(t, b)
+	require.NoError(t, m.DeleteBackup(cred, id))
+}
+
+func TestDiff(t *testing.T) {
+	base, snap := t.TempDir(), t.TempDir()
+	write := func(dir string, files map[string]string) {
+		for name, data := range files {
+			p := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+			require.NoError(t, ioutil.WriteFile(p, []byte(data), 0644))
+			require.NoError(t, os.Chtimes(p, modTime, modTime))
+		}
+	}
+	write(base, map[string]string{"same": "a", "changed": "b", "rewritten": "f", "gone/file": "c", "type/file": "d"})
+	write(snap, map[string]string{"same": "a", "changed": "bb", "rewritten": "g", "type": "d", "new": "e"})
+	require.NoError(t, os.Chmod(filepath.Join(snap, "same"), 0600))
+
+	d, err := diff(base, snap)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gone", "type"}, d.removed())
+	var changed []string
+	require.NoError(t, walkTar(snap, func(_, rel string, hdr *tar.Header) error {
+		if d.changed(rel, hdr) {
+			changed = append(changed, rel)
+		}
+		return nil
+	}))
+	sort.Strings(changed)
+	// the content of rewritten changed, but not its size nor its times
+	assert.Equal(t, []string{"changed", "new", "rewritten", "same", "type"}, changed)
+}
+
+func TestWrap(t *testing.T) {
+	m, d, _ := newTestManager(t, &Config{})
+	wrapped := &backupDriver{VolumeDriver: d, manager: m}
+	d.files["vol1"] = map[string][]byte{"message": []byte("hello")}
+
+	cred, err := wrapped.CredsCreate(map[string]string{
+		api.OptCredEndpoint:  "https://s3.amazonaws.com",
+		api.OptCredBucket:    "backups",
+		api.OptCredAccessKey: "access",
+		api.OptCredSecretKey: "secret",
+	})
+	require.NoError(t, err)
+
+	d.expectBackup(testVolume(), "snap1")
+	d.expectDelete("snap1")
+	resp, err := wrapped.CloudBackupCreate(&api.CloudBackupCreateRequest{
+		VolumeID:       "vol1",
+		CredentialUUID: cred,
+	})
+	require.NoError(t, err)
+
+	status, err := wrapped.CloudBackupStatus(&api.CloudBackupStatusRequest{Name: resp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, api.CloudBackupStatusDone, status.Statuses[resp.Name].Status)
+
+	req := &api.CloudBackupEnumerateRequest{}
+	req.CredentialUUID = cred
+	backups, err := wrapped.CloudBackupEnumerate(req)
+	require.NoError(t, err)
+	require.Len(t, backups.Backups, 1)
+	assert.Equal(t, resp.Name, backups.Backups[0].ID)
+	assert.Equal(t, "data", backups.Backups[0].SrcVolumeName)
+
+	require.NoError(t, wrapped.CloudBackupDelete(&api.CloudBackupDeleteRequest{
+		ID:             resp.Name,
+		CredentialUUID: cred,
+	}))
+	assert.Error(t, wrapped.CloudBackupDelete(&api.CloudBackupDeleteRequest{
+		ID:             resp.Name,
+		CredentialUUID: cred,
+	}))
+}
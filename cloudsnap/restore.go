@@ -0,0 +1,352 @@
+package cloudsnap
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/s3"
+	"github.com/pborman/uuid"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	restoresKey = "cloudsnap/restores"
+	// fetchAttempts is the number of times a chunk is downloaded before
+	// its restore fails
+	fetchAttempts = 5
+)
+
+// retryBackoff is the wait before downloading a chunk again, doubled at
+// every attempt. It is overridden in tests.
+var retryBackoff = time.Second
+
+// restore records the backup restored by a restore, to resume it.
+type restore struct {
+	// Id of the restore
+	Id string
+	// BackupId of the backup restored
+	BackupId string
+}
+
+func restoreKey(id string) string {
+	return filepath.Join(restoresKey, id)
+}
+
+// Restore provisions a volume with locator and spec, and restores the
+// backup backupID of the object store of credentialID, with the backups it
+// is chained to, into it as a job. The volume is named after the volume
+// backed up if locator sets no name, and created with the spec of the volume
+// backed up if spec is nil. The passphrase of a volume backed up with one is
+// not stored with the backup and must be supplied again, with passphrase or
+// spec. It returns the id of the volume and the id of the restore, which is
+// also the name of the task reported by Status.
+// Errors ErrNotFound may be returned.
+func (m *Manager) Restore(
+	credentialID, backupID string,
+	locator *api.VolumeLocator,
+	spec *api.VolumeSpec,
+	passphrase string,
+) (string, string, error) {
+	store, err := m.store(credentialID)
+	if err != nil {
+		return "", "", err
+	}
+	chain, err := m.chain(store, backupID)
+	if err != nil {
+		return "", "", err
+	}
+	manifest := chain[len(chain)-1]
+	id := uuid.New()
+
+	if locator == nil {
+		locator = &api.VolumeLocator{}
+	}
+	if len(locator.GetName()) == 0 {
+		locator = proto.Clone(locator).(*api.VolumeLocator)
+		locator.Name = fmt.Sprintf("%s.restore.%s", manifest.Locator.GetName(), id)
+	}
+	if spec == nil {
+		spec = manifest.Spec
+		if spec == nil {
+			spec = &api.VolumeSpec{}
+		}
+	}
+	if spec.GetSize() < manifest.Spec.GetSize() {
+		if spec.GetSize() != 0 {
+			return "", "", fmt.Errorf("Volume size %d is smaller than the size %d of the volume backed up",
+				spec.GetSize(), manifest.Spec.GetSize())
+		}
+		spec = proto.Clone(spec).(*api.VolumeSpec)
+		spec.Size = manifest.Spec.GetSize()
+	}
+	if len(passphrase) != 0 {
+		spec = proto.Clone(spec).(*api.VolumeSpec)
+		spec.Passphrase = passphrase
+	}
+	if manifest.PassphraseRequired && len(spec.GetPassphrase()) == 0 {
+		return "", "", fmt.Errorf("Backup %s is of a volume encrypted with a passphrase, "+
+			"which must be supplied to restore it", backupID)
+	}
+	volumeID, err := m.driver.Create(locator, &api.Source{}, spec)
+	if err != nil {
+		return "", "", fmt.Errorf("Failed to create volume %s: %v", locator.GetName(), err)
+	}
+
+	if _, err := m.kv.Put(restoreKey(id), &restore{Id: id, BackupId: backupID}, 0); err != nil {
+		return "", "", err
+	}
+	var size int64
+	for _, b := range chain {
+		size += b.Size
+	}
+	status := &api.CloudBackupStatus{
+		ID:             id,
+		OpType:         api.CloudRestoreOp,
+		Status:         api.CloudBackupStatusQueued,
+		BytesTotal:     uint64(size),
+		StartTime:      time.Now(),
+		SrcVolumeID:    volumeID,
+		CredentialUUID: credentialID,
+	}
+	if err := m.putStatus(status); err != nil {
+		return "", "", err
+	}
+	if err := m.startRestore(store, chain, status); err != nil {
+		return "", "", err
+	}
+	return volumeID, id, nil
+}
+
+// chain returns the manifests of the backup id of store and of the backups
+// it is chained to, from the full backup to the backup id.
+// Errors ErrNotFound may be returned.
+func (m *Manager) chain(store Store, id string) ([]*Manifest, error) {
+	var chain []*Manifest
+	for len(id) != 0 {
+		manifest, err := m.manifest(store, id)
+		if err == ErrNotFound && len(chain) != 0 {
+			return nil, fmt.Errorf("Parent %s of backup %s not found", id, chain[0].Id)
+		} else if err != nil {
+			return nil, err
+		}
+		if manifest.Version > manifestVersion {
+			return nil, fmt.Errorf("Backup %s has version %d, newer than the supported version %d",
+				id, manifest.Version, manifestVersion)
+		}
+		if len(chain) != 0 && manifest.Level != chain[0].Level-1 {
+			return nil, fmt.Errorf("Backup %s has level %d, parent %s has level %d",
+				chain[0].Id, chain[0].Level, id, manifest.Level)
+		}
+		chain = append([]*Manifest{manifest}, chain...)
+		id = manifest.Parent
+	}
+	return chain, nil
+}
+
+// Resume restores again the failed restore id into the same volume, the
+// chunks downloaded already being kept.
+// Errors ErrNotFound may be returned.
+func (m *Manager) Resume(id string) error {
+	var status api.CloudBackupStatus
+	if _, err := m.kv.GetVal(statusKey(id), &status); err == kvdb.ErrNotFound {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+	if status.OpType != api.CloudRestoreOp {
+		return fmt.Errorf("Cloud backup task %s is not a restore", id)
+	}
+	if status.Status != api.CloudBackupStatusFailed {
+		return fmt.Errorf("Restore %s has not failed, it is %s", id, status.Status)
+	}
+	var r restore
+	if _, err := m.kv.GetVal(restoreKey(id), &r); err == kvdb.ErrNotFound {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+	store, err := m.store(status.CredentialUUID)
+	if err != nil {
+		return err
+	}
+	chain, err := m.chain(store, r.BackupId)
+	if err != nil {
+		return err
+	}
+	status.Status = api.CloudBackupStatusQueued
+	status.Info = nil
+	status.CompletedTime = time.Time{}
+	if err := m.putStatus(&status); err != nil {
+		return err
+	}
+	return m.startRestore(store, chain, &status)
+}
+
+// startRestore submits the job restoring the backups of chain into the
+// volume of status.
+func (m *Manager) startRestore(store Store, chain []*Manifest, status *api.CloudBackupStatus) error {
+	return m.submit(status.SrcVolumeID, func() error {
+		err := m.restore(store, chain, status)
+		status.CompletedTime = time.Now()
+		status.EtaSeconds = 0
+		if err != nil {
+			status.Status = api.CloudBackupStatusFailed
+			status.Info = []string{err.Error()}
+		} else {
+			status.Status = api.CloudBackupStatusDone
+		}
+		if perr := m.putStatus(status); perr != nil {
+			logrus.WithField("pkg", "openstorage/cloudsnap").
+				Warnf("Failed to save the status of restore %s: %v", status.ID, perr)
+		}
+		return err
+	})
+}
+
+// restore downloads the chunks of the backups of chain to the staging
+// directory of the restore, then extracts them into the volume of status in
+// order, removing the paths removed by each backup first. The staging
+// directory is removed once the restore succeeds.
+func (m *Manager) restore(store Store, chain []*Manifest, status *api.CloudBackupStatus) error {
+	status.Status = api.CloudBackupStatusActive
+	status.BytesDone = 0
+	if err := m.putStatus(status); err != nil {
+		return err
+	}
+
+	staging := filepath.Join(m.config.stagingDir(), status.ID)
+	if err := m.stage(store, chain, staging, status); err != nil {
+		return err
+	}
+
+	dir, unmount, err := m.mount(status.SrcVolumeID)
+	if err != nil {
+		return err
+	}
+	defer unmount()
+
+	for _, manifest := range chain {
+		if err := removeEntries(dir, manifest.Removed); err != nil {
+			return fmt.Errorf("Failed to remove the paths removed by backup %s: %v", manifest.Id, err)
+		}
+		if err := extract(manifest, filepath.Join(staging, manifest.Id), dir); err != nil {
+			return err
+		}
+	}
+	return os.RemoveAll(staging)
+}
+
+// extract extracts the archive of the chunks of manifest staged in staging
+// into dir.
+func extract(manifest *Manifest, staging, dir string) error {
+	pr, pw := io.Pipe()
+	go func() {
+		var err error
+		for i := range manifest.Chunks {
+			if err = copyFile(pw, chunkPath(staging, i)); err != nil {
+				break
+			}
+		}
+		pw.CloseWithError(err)
+	}()
+	defer pr.Close()
+	zr, err := gzip.NewReader(pr)
+	if err != nil {
+		return fmt.Errorf("Failed to read backup %s: %v", manifest.Id, err)
+	}
+	if err := extractTar(zr, dir); err != nil {
+		return fmt.Errorf("Failed to extract backup %s: %v", manifest.Id, err)
+	}
+	return nil
+}
+
+// stage downloads the chunks of the backups of chain missing from staging,
+// in a directory per backup, updating the progress and the estimated time
+// left of status.
+func (m *Manager) stage(store Store, chain []*Manifest, staging string, status *api.CloudBackupStatus) error {
+	start := time.Now()
+	var fetched int64
+	for _, manifest := range chain {
+		dir := filepath.Join(staging, manifest.Id)
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+		for i, c := range manifest.Chunks {
+			path := chunkPath(dir, i)
+			if !staged(path, c) {
+				data, err := fetch(store, c)
+				if err != nil {
+					return err
+				}
+				if err := ioutil.WriteFile(path+".part", data, 0600); err != nil {
+					return err
+				}
+				if err := os.Rename(path+".part", path); err != nil {
+					return err
+				}
+				fetched += c.Size
+			}
+			status.BytesDone += uint64(c.Size)
+			if elapsed := time.Since(start).Seconds(); fetched > 0 && elapsed > 0 {
+				left := float64(status.BytesTotal - status.BytesDone)
+				status.EtaSeconds = int64(left / (float64(fetched) / elapsed))
+			}
+			if err := m.putStatus(status); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func chunkPath(staging string, i int) string {
+	return filepath.Join(staging, fmt.Sprintf("%08d", i))
+}
+
+// staged returns true if the chunk c was downloaded to path already.
+func staged(path string, c Chunk) bool {
+	data, err := ioutil.ReadFile(path)
+	return err == nil && checksum(data) == c.Sha256
+}
+
+// fetch downloads the chunk c, retrying fetchAttempts times with an
+// exponential backoff unless it does not exist.
+func fetch(store Store, c Chunk) ([]byte, error) {
+	var err error
+	for attempt := 0; attempt < fetchAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(retryBackoff << uint(attempt-1))
+		}
+		var data []byte
+		data, err = store.Get(c.Key)
+		if err == s3.ErrNotFound {
+			return nil, fmt.Errorf("Chunk %s not found", c.Key)
+		} else if err == nil && checksum(data) != c.Sha256 {
+			err = fmt.Errorf("checksum mismatch")
+		}
+		if err == nil {
+			return data, nil
+		}
+		logrus.WithField("pkg", "openstorage/cloudsnap").
+			Warnf("Failed to download chunk %s, attempt %d of %d: %v", c.Key, attempt+1, fetchAttempts, err)
+	}
+	return nil, fmt.Errorf("Failed to download chunk %s: %v", c.Key, err)
+}
+
+func copyFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
@@ -0,0 +1,291 @@
+package cloudsnap
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBackup returns a manager with a backup of vol1 in several chunks,
+// whose snapshot snap1 is kept, the id of its credential and the id of the
+// backup.
+func newTestBackup(t *testing.T) (*Manager, *testDriver, *memStore, string, string) {
+	oldBackoff := retryBackoff
+	retryBackoff = 0
+	t.Cleanup(func() {
+		retryBackoff = oldBackoff
+	})
+	m, d, store := newTestManager(t, &Config{ChunkSize: MinChunkSize, StagingDir: t.TempDir(), Incremental: true})
+	cred := createCredential(t, m)
+	random := make([]byte, 3*MinChunkSize)
+	rand.Read(random)
+	d.files["vol1"] = map[string][]byte{
+		"random":                        random,
+		filepath.Join("dir", "message"): []byte("hello"),
+	}
+	d.expectBackup(testVolume(), "snap1")
+	id, err := m.Backup("vol1", cred, false)
+	require.NoError(t, err)
+	return m, d, store, cred, id
+}
+
+func TestRestore(t *testing.T) {
+	m, d, _, cred, id := newTestBackup(t)
+
+	_, _, err := m.Restore(cred, "doesnotexist", nil, nil, "")
+	assert.Equal(t, ErrNotFound, err)
+	_, _, err = m.Restore(cred, id, nil, &api.VolumeSpec{Size: 1024}, "")
+	assert.Error(t, err)
+
+	created := d.expectRestore("restore1", true)
+	volumeID, restoreID, err := m.Restore(cred, id, nil, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, "restore1", volumeID)
+	assert.Equal(t, "data.restore."+restoreID, created.GetLocator().GetName())
+	assert.Equal(t, uint64(1024*1024), created.GetSpec().GetSize())
+	assert.Equal(t, d.files["vol1"], d.files[volumeID])
+
+	statuses, err := m.Status(volumeID)
+	require.NoError(t, err)
+	require.Contains(t, statuses, restoreID)
+	status := statuses[restoreID]
+	assert.Equal(t, api.CloudRestoreOp, status.OpType)
+	assert.Equal(t, api.CloudBackupStatusDone, status.Status)
+	assert.Equal(t, status.BytesTotal, status.BytesDone)
+
+	// the staging directory is removed
+	_, err = ioutil.ReadDir(filepath.Join(m.config.stagingDir(), restoreID))
+	assert.Error(t, err)
+
+	created = d.expectRestore("restore2", true)
+	_, _, err = m.Restore(cred, id, &api.VolumeLocator{Name: "restored"},
+		&api.VolumeSpec{Size: 2 * 1024 * 1024}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "restored", created.GetLocator().GetName())
+	assert.Equal(t, uint64(2*1024*1024), created.GetSpec().GetSize())
+}
+
+func TestRestorePassphrase(t *testing.T) {
+	m, d, store, cred, _ := newTestBackup(t)
+	vol := testVolume()
+	vol.Spec = &api.VolumeSpec{
+		Size:         1024 * 1024,
+		Encrypted:    true,
+		Passphrase:   "hunter2",
+		VolumeLabels: map[string]string{api.SpecPassphrase: "hunter2", "app": "db"},
+	}
+	d.expectBackup(vol, "snap2")
+	d.expectDelete("snap1")
+	id, err := m.Backup("vol1", cred, true)
+	require.NoError(t, err)
+
+	data, err := store.Get(m.backupKey(id, manifestName))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "hunter2")
+	manifest, err := m.Manifest(cred, id)
+	require.NoError(t, err)
+	assert.True(t, manifest.PassphraseRequired)
+	assert.True(t, manifest.Spec.GetEncrypted())
+	assert.Equal(t, map[string]string{"app": "db"}, manifest.Spec.GetVolumeLabels())
+	// the spec of the volume is left as is
+	assert.Equal(t, "hunter2", vol.Spec.Passphrase)
+
+	_, _, err = m.Restore(cred, id, nil, nil, "")
+	assert.Error(t, err)
+	created := d.expectRestore("restore1", true)
+	_, _, err = m.Restore(cred, id, nil, nil, "secret")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", created.GetSpec().GetPassphrase())
+	assert.True(t, created.GetSpec().GetEncrypted())
+	created = d.expectRestore("restore2", true)
+	_, _, err = m.Restore(cred, id, nil, &api.VolumeSpec{Passphrase: "other"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "other", created.GetSpec().GetPassphrase())
+}
+
+func TestRestoreRetries(t *testing.T) {
+	m, d, store, cred, id := newTestBackup(t)
+	manifest, err := m.Manifest(cred, id)
+	require.NoError(t, err)
+
+	// transient failures are retried
+	store.getErrs[manifest.Chunks[1].Key] = fetchAttempts - 1
+	d.expectRestore("restore1", true)
+	volumeID, restoreID, err := m.Restore(cred, id, nil, nil, "")
+	require.NoError(t, err)
+	statuses, err := m.Status(volumeID)
+	require.NoError(t, err)
+	assert.Equal(t, api.CloudBackupStatusDone, statuses[restoreID].Status)
+	assert.Equal(t, d.files["vol1"], d.files[volumeID])
+}
+
+func TestRestoreResume(t *testing.T) {
+	m, d, store, cred, id := newTestBackup(t)
+	manifest, err := m.Manifest(cred, id)
+	require.NoError(t, err)
+
+	// the volume is mounted once the chunks are downloaded
+	store.getErrs[manifest.Chunks[2].Key] = fetchAttempts
+	d.expectRestore("restore1", false)
+	volumeID, restoreID, err := m.Restore(cred, id, nil, nil, "")
+	require.NoError(t, err)
+	statuses, err := m.Status(volumeID)
+	require.NoError(t, err)
+	status := statuses[restoreID]
+	assert.Equal(t, api.CloudBackupStatusFailed, status.Status)
+	assert.Contains(t, status.Info[0], manifest.Chunks[2].Key)
+	assert.Equal(t, uint64(manifest.Chunks[0].Size+manifest.Chunks[1].Size), status.BytesDone)
+	assert.Empty(t, d.files[volumeID])
+
+	// the resumed restore downloads the manifest and the chunks not staged
+	// only
+	store.gets = 0
+	d.expectMount(volumeID)
+	wrapped := &backupDriver{VolumeDriver: d, manager: m}
+	require.NoError(t, wrapped.CloudBackupStateChange(&api.CloudBackupStateChangeRequest{
+		Name:           restoreID,
+		RequestedState: api.CloudBackupRequestedStateResume,
+	}))
+	assert.Equal(t, 1+len(manifest.Chunks)-2, store.gets)
+	statuses, err = m.Status(volumeID)
+	require.NoError(t, err)
+	assert.Equal(t, api.CloudBackupStatusDone, statuses[restoreID].Status)
+	assert.Empty(t, statuses[restoreID].Info)
+	assert.Equal(t, d.files["vol1"], d.files[volumeID])
+
+	assert.Error(t, m.Resume(restoreID))
+	assert.Error(t, m.Resume(id))
+	assert.Equal(t, ErrNotFound, m.Resume("doesnotexist"))
+	assert.Equal(t, volume.ErrNotSupported, wrapped.CloudBackupStateChange(&api.CloudBackupStateChangeRequest{
+		Name:           restoreID,
+		RequestedState: api.CloudBackupRequestedStatePause,
+	}))
+}
+
+func TestWrapRestore(t *testing.T) {
+	m, d, _, cred, id := newTestBackup(t)
+	wrapped := &backupDriver{VolumeDriver: d, manager: m}
+
+	created := d.expectRestore("restore1", true)
+	resp, err := wrapped.CloudBackupRestore(&api.CloudBackupRestoreRequest{
+		ID:                id,
+		CredentialUUID:    cred,
+		RestoreVolumeName: "restored",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "restore1", resp.RestoreVolumeID)
+	assert.Equal(t, "restored", created.GetLocator().GetName())
+	status, err := wrapped.CloudBackupStatus(&api.CloudBackupStatusRequest{Name: resp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, api.CloudBackupStatusDone, status.Statuses[resp.Name].Status)
+
+	_, err = wrapped.CloudBackupRestore(&api.CloudBackupRestoreRequest{
+		ID:             "doesnotexist",
+		CredentialUUID: cred,
+	})
+	assert.Error(t, err)
+}
+
+func TestRestoreIncremental(t *testing.T) {
+	m, d, store, cred, id := newTestBackup(t)
+	original := make(map[string][]byte)
+	for name, data := range d.files["vol1"] {
+		original[name] = data
+	}
+
+	delete(d.files["vol1"], filepath.Join("dir", "message"))
+	d.files["vol1"]["new"] = []byte("new file")
+	d.expectBackup(testVolume(), "snap2")
+	d.expectBase("snap1")
+	d.expectDelete("snap1")
+	inc, err := m.Backup("vol1", cred, false)
+	require.NoError(t, err)
+	incManifest, err := m.Manifest(cred, inc)
+	require.NoError(t, err)
+	require.Equal(t, id, incManifest.Parent)
+
+	d.expectRestore("restore1", true)
+	volumeID, restoreID, err := m.Restore(cred, inc, nil, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, d.files["vol1"], d.files[volumeID])
+	statuses, err := m.Status(volumeID)
+	require.NoError(t, err)
+	full, err := m.Manifest(cred, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.CloudBackupStatusDone, statuses[restoreID].Status)
+	assert.Equal(t, uint64(full.Size+incManifest.Size), statuses[restoreID].BytesTotal)
+
+	d.expectRestore("restore2", true)
+	volumeID, _, err = m.Restore(cred, id, nil, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, original, d.files[volumeID])
+
+	// the chain is broken
+	delete(store.objects, m.backupKey(id, manifestName))
+	_, _, err = m.Restore(cred, inc, nil, nil, "")
+	assert.Error(t, err)
+	assert.NotEqual(t, ErrNotFound, err)
+}
+
+func TestRemoveEntries(t *testing.T) {
+	outside := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(outside, "file"), []byte("keep"), 0644))
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "a", "b"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a", "b", "file"), nil, 0644))
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "link")))
+
+	assert.Error(t, removeEntries(dir, []string{"../file"}))
+	assert.Error(t, removeEntries(dir, []string{"link/file"}))
+	_, err := os.Stat(filepath.Join(outside, "file"))
+	assert.NoError(t, err)
+
+	require.NoError(t, removeEntries(dir, []string{"a", "link", "missing/file"}))
+	info, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, info)
+	_, err = os.Stat(filepath.Join(outside, "file"))
+	assert.NoError(t, err)
+}
+
+func TestExtractTar(t *testing.T) {
+	archive := func(hdrs ...*tar.Header) *bytes.Buffer {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for _, hdr := range hdrs {
+			require.NoError(t, tw.WriteHeader(hdr))
+		}
+		require.NoError(t, tw.Close())
+		return &buf
+	}
+
+	assert.Error(t, extractTar(archive(&tar.Header{
+		Name: "../escaped", Typeflag: tar.TypeReg, Mode: 0644,
+	}), t.TempDir()))
+	assert.Error(t, extractTar(archive(&tar.Header{
+		Name: "etc", Typeflag: tar.TypeSymlink, Linkname: "/etc",
+	}, &tar.Header{
+		Name: "etc/passwd", Typeflag: tar.TypeReg, Mode: 0644,
+	}), t.TempDir()))
+
+	dir := t.TempDir()
+	require.NoError(t, extractTar(archive(&tar.Header{
+		Name: "ro", Typeflag: tar.TypeDir, Mode: 0555,
+	}, &tar.Header{
+		Name: "ro/file", Typeflag: tar.TypeReg, Mode: 0644,
+	}), dir))
+	info, err := ioutil.ReadDir(filepath.Join(dir, "ro"))
+	require.NoError(t, err)
+	require.Len(t, info, 1)
+	assert.Equal(t, "file", info[0].Name())
+	require.NoError(t, os.Chmod(filepath.Join(dir, "ro"), 0755))
+}
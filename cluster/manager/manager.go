@@ -18,6 +18,7 @@ import (
 	"github.com/libopenstorage/gossip"
 	"github.com/libopenstorage/gossip/types"
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/attachlimit"
 	"github.com/libopenstorage/openstorage/cluster"
 	"github.com/libopenstorage/openstorage/config"
 	"github.com/libopenstorage/openstorage/objectstore"
@@ -341,6 +342,9 @@ func (c *ClusterManager) getCurrentState() *api.Node {
 
 	c.selfNode.Cpu, _, _ = c.system.CpuUsage()
 	c.selfNode.MemTotal, c.selfNode.MemUsed, c.selfNode.MemFree = c.system.MemUsage()
+	if limiter, err := attachlimit.Inst(); err == nil {
+		c.selfNode.MaxVolumeAttachments, c.selfNode.VolumeAttachments = limiter.Slots()
+	}
 
 	c.selfNode.Timestamp = time.Now()
 
@@ -28,31 +28,75 @@ import (
 	"net/url"
 	"os"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/codegangsta/cli"
 	"github.com/docker/docker/pkg/reexec"
+	"github.com/libopenstorage/openstorage/alerts"
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/api/client"
+	clusterclient "github.com/libopenstorage/openstorage/api/client/cluster"
 	"github.com/libopenstorage/openstorage/api/flexvolume"
 	"github.com/libopenstorage/openstorage/api/server"
 	"github.com/libopenstorage/openstorage/api/server/sdk"
+	"github.com/libopenstorage/openstorage/attachlimit"
+	"github.com/libopenstorage/openstorage/audit"
 	osdcli "github.com/libopenstorage/openstorage/cli"
+	"github.com/libopenstorage/openstorage/clouddrive"
+	"github.com/libopenstorage/openstorage/cloudsnap"
 	"github.com/libopenstorage/openstorage/cluster"
 	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
 	"github.com/libopenstorage/openstorage/config"
+	"github.com/libopenstorage/openstorage/eraser"
+	"github.com/libopenstorage/openstorage/cost"
 	"github.com/libopenstorage/openstorage/csi"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/freeze"
 	"github.com/libopenstorage/openstorage/graph/drivers"
+	"github.com/libopenstorage/openstorage/hibernate"
+	"github.com/libopenstorage/openstorage/idempotency"
+	"github.com/libopenstorage/openstorage/jobs"
+	"github.com/libopenstorage/openstorage/kvdbhealth"
+	"github.com/libopenstorage/openstorage/leader"
+	"github.com/libopenstorage/openstorage/metabackup"
+	"github.com/libopenstorage/openstorage/metering"
+	"github.com/libopenstorage/openstorage/nodelabels"
 	"github.com/libopenstorage/openstorage/objectstore"
+	"github.com/libopenstorage/openstorage/osdconfig"
+	"github.com/libopenstorage/openstorage/pkg/apiqueue"
+	"github.com/libopenstorage/openstorage/pkg/ca"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+	"github.com/libopenstorage/openstorage/pkg/datachannel"
+	"github.com/libopenstorage/openstorage/pkg/kvdbprefix"
+	"github.com/libopenstorage/openstorage/pkg/mount"
+	"github.com/libopenstorage/openstorage/pkg/redact"
+	"github.com/libopenstorage/openstorage/pkg/s3"
+	"github.com/libopenstorage/openstorage/pkg/storageops"
+	aws_ops "github.com/libopenstorage/openstorage/pkg/storageops/aws"
+	"github.com/libopenstorage/openstorage/pkg/storageops/gce"
+	"github.com/libopenstorage/openstorage/pkg/subpath"
+	"github.com/libopenstorage/openstorage/pkg/volumeid"
+	"github.com/libopenstorage/openstorage/preflight"
 	"github.com/libopenstorage/openstorage/schedpolicy"
+	"github.com/libopenstorage/openstorage/slo"
+	"github.com/libopenstorage/openstorage/snapcleanup"
+	"github.com/libopenstorage/openstorage/snapsched"
+	"github.com/libopenstorage/openstorage/statshistory"
+	"github.com/libopenstorage/openstorage/tenantpolicy"
+	"github.com/libopenstorage/openstorage/transform"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers"
+	"github.com/libopenstorage/openstorage/volume/drivers/common"
 	"github.com/portworx/kvdb"
 	"github.com/portworx/kvdb/consul"
 	etcd "github.com/portworx/kvdb/etcd/v2"
 	"github.com/portworx/kvdb/mem"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 var (
@@ -86,6 +130,11 @@ func main() {
 			Usage: "uri to kvdb e.g. kv-mem://localhost, etcd-kv://localhost:4001, consul-kv://localhost:8500",
 			Value: "kv-mem://localhost",
 		},
+		cli.StringFlag{
+			Name:  "kvdbprefix",
+			Usage: "prefix of all kvdb keys, e.g. the cluster id, to share a kvdb between clusters",
+			Value: "",
+		},
 		cli.StringFlag{
 			Name:  "file,f",
 			Usage: "file to read the OSD configuration from.",
@@ -126,6 +175,52 @@ func main() {
 			Usage:       "Manage cluster",
 			Subcommands: osdcli.ClusterCommands(),
 		},
+		{
+			Name:  "kvdb",
+			Usage: "Manage kvdb data",
+			Subcommands: []cli.Command{
+				{
+					Name:  "migrate",
+					Usage: "Move the data in kvdb from one key prefix to another, with the daemons stopped",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "from",
+							Usage: "current key prefix, empty for none",
+						},
+						cli.StringFlag{
+							Name:  "to",
+							Usage: "new key prefix",
+						},
+						cli.BoolFlag{
+							Name:  "delete",
+							Usage: "delete the data under the current prefix once copied",
+						},
+					},
+					Action: wrapAction(migrateKvdb),
+				},
+				{
+					Name:   "backup",
+					Usage:  "Back up the cluster metadata in kvdb to the metadata_backup object store of the config file",
+					Action: wrapAction(backupKvdb),
+				},
+				{
+					Name:   "backups",
+					Usage:  "List the metadata backups of the cluster",
+					Action: wrapAction(listKvdbBackups),
+				},
+				{
+					Name:  "restore",
+					Usage: "Restore the cluster metadata in kvdb from a backup, with the daemons stopped",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "backup",
+							Usage: "name of the backup, the latest one if not set",
+						},
+					},
+					Action: wrapAction(restoreKvdb),
+				},
+			},
+		},
 		{
 			Name:    "version",
 			Aliases: []string{"v"},
@@ -183,6 +278,9 @@ func start(c *cli.Context) error {
 		return nil
 	}
 
+	// Mask the secrets, such as the passphrases of docker volume specs, in the logs
+	redact.Logs()
+
 	var (
 		cfg *config.Config
 	)
@@ -207,6 +305,12 @@ func start(c *cli.Context) error {
 	if len(cfg.Osd.ClusterConfig.NodeId) == 0 {
 		cfg.Osd.ClusterConfig.NodeId = c.String("nodeid")
 	}
+	if len(cfg.Osd.KvdbPrefix) == 0 {
+		cfg.Osd.KvdbPrefix = c.String("kvdbprefix")
+	}
+	if err := kvdbprefix.Validate(cfg.Osd.KvdbPrefix); err != nil {
+		return err
+	}
 
 	// Get driver information
 	driverInfoList := c.StringSlice("driver")
@@ -245,22 +349,143 @@ func start(c *cli.Context) error {
 			cfg.Osd.Drivers[name] = params
 		}
 	}
+	if err := crypto.SetPolicy(&cfg.Osd.Crypto); err != nil {
+		return fmt.Errorf("Invalid crypto policy: %v", err)
+	}
+	if cfg.Osd.EventBus.Enabled() {
+		if err := eventbus.Init(&cfg.Osd.EventBus); err != nil {
+			return fmt.Errorf("Failed to initialize event bus: %v", err)
+		}
+	}
+	if cfg.Osd.StatsHistory.Enabled() {
+		if err := statshistory.Init(&cfg.Osd.StatsHistory); err != nil {
+			return fmt.Errorf("Failed to initialize stats history: %v", err)
+		}
+	}
 	if len(cfg.Osd.Drivers) == 0 {
 		return fmt.Errorf("Must supply driver information")
 	}
 
-	kvdbURL := c.String("kvdb")
-	u, err := url.Parse(kvdbURL)
-	scheme := u.Scheme
-	u.Scheme = "http"
-
-	kv, err := kvdb.New(scheme, "openstorage", []string{u.String()}, nil, logrus.Panicf)
+	kv, endpoints, err := newKvdb(c.String("kvdb"), cfg.Osd.KvdbPrefix)
 	if err != nil {
-		return fmt.Errorf("Failed to initialize KVDB: %v (%v)\nSupported datastores: %v", scheme, err, datastores)
+		return err
 	}
 	if err := kvdb.SetInstance(kv); err != nil {
 		return fmt.Errorf("Failed to initialize KVDB: %v", err)
 	}
+	if err := jobs.Init(kv); err != nil {
+		return fmt.Errorf("Failed to initialize jobs manager: %v", err)
+	}
+	if err := startJobScheduling(kv); err != nil {
+		return fmt.Errorf("Failed to start jobs scheduling: %v", err)
+	}
+	if err := audit.Init(kv, cfg.Osd.ClusterConfig.NodeId); err != nil {
+		return fmt.Errorf("Failed to initialize audit log: %v", err)
+	}
+	freezeManager := freeze.NewManager(kv)
+	if err := freeze.Init(freezeManager); err != nil {
+		return fmt.Errorf("Failed to initialize cluster freeze: %v", err)
+	}
+	if err := freezeManager.Start(); err != nil {
+		return fmt.Errorf("Failed to start cluster freeze: %v", err)
+	}
+	if err := cost.Init(kv); err != nil {
+		return fmt.Errorf("Failed to initialize cost manager: %v", err)
+	}
+	if err := idempotency.Init(idempotency.New(kv, &cfg.Osd.Idempotency)); err != nil {
+		return fmt.Errorf("Failed to initialize idempotency keys: %v", err)
+	}
+	restorePolicies, err := transform.New(&cfg.Osd.RestorePolicies)
+	if err != nil {
+		return fmt.Errorf("Invalid restore policies: %v", err)
+	}
+	if err := transform.Init(restorePolicies); err != nil {
+		return fmt.Errorf("Failed to initialize restore policies: %v", err)
+	}
+	if cfg.Osd.APIQueue.Enabled() {
+		if err := apiqueue.Init(apiqueue.New(&cfg.Osd.APIQueue)); err != nil {
+			return fmt.Errorf("Failed to initialize API request queue: %v", err)
+		}
+	}
+	var identity *ca.Identity
+	if cfg.Osd.ClusterConfig.NodeId != "" && cfg.Osd.ClusterConfig.ClusterId != "" {
+		if identity, err = initNodeIdentity(kv, cfg); err != nil {
+			return fmt.Errorf("Failed to initialize node identity: %v", err)
+		}
+		if err := datachannel.Init(&datachannel.Config{
+			Identity: identity,
+			Compress: cfg.Osd.DataChannel.Compress,
+		}); err != nil {
+			return fmt.Errorf("Failed to initialize data channels: %v", err)
+		}
+	}
+	alertsOptions := []alerts.Option{
+		alerts.NewGCIntervalOption(cfg.Osd.AlertsGCInterval),
+		alerts.NewEscalationIntervalOption(cfg.Osd.AlertsEscalationInterval),
+		alerts.NewRateLimitOption(cfg.Osd.AlertsRateLimit.Rate, cfg.Osd.AlertsRateLimit.Burst),
+		alerts.NewLabelsOption(resourceLabels(cfg.Osd.ClusterConfig.DefaultDriver)),
+	}
+	for i := range cfg.Osd.AlertsRetention {
+		opt, err := cfg.Osd.AlertsRetention[i].Option()
+		if err != nil {
+			return fmt.Errorf("Invalid alerts retention: %v", err)
+		}
+		alertsOptions = append(alertsOptions, opt)
+	}
+	for i := range cfg.Osd.AlertsFlapping {
+		opt, err := cfg.Osd.AlertsFlapping[i].Option()
+		if err != nil {
+			return fmt.Errorf("Invalid alerts flapping detection: %v", err)
+		}
+		alertsOptions = append(alertsOptions, opt)
+	}
+	if identity != nil && cfg.Osd.ClusterAPITLSPort != 0 {
+		alertsOptions = append(alertsOptions,
+			alerts.NewNodesOption(alertNodes(cfg.Osd.ClusterAPITLSPort, identity)))
+	}
+	alertsManager, err := alerts.NewManager(kv, alertsOptions...)
+	if err != nil {
+		return fmt.Errorf("Failed to initialize alerts manager: %v", err)
+	}
+	if err := alerts.Init(alertsManager); err != nil {
+		return fmt.Errorf("Failed to initialize alerts manager: %v", err)
+	}
+	if err := prometheus.Register(alertsManager.Collector()); err != nil {
+		return fmt.Errorf("Failed to register alerts metrics: %v", err)
+	}
+	if err := startAlertRouting(kv, alertsManager); err != nil {
+		return fmt.Errorf("Failed to start alert routing: %v", err)
+	}
+	if err := addAlertNotifiers(
+		alertsManager,
+		cfg.Osd.AlertWebhooks,
+		cfg.Osd.AlertEmails,
+		cfg.Osd.Alertmanagers,
+	); err != nil {
+		return fmt.Errorf("Failed to add alert notifiers: %v", err)
+	}
+	labelsManager := nodelabels.NewManager(kv)
+	if err := nodelabels.Init(labelsManager); err != nil {
+		return fmt.Errorf("Failed to initialize node labels manager: %v", err)
+	}
+	kvdbMonitor := kvdbhealth.NewMonitor(kv, endpoints, kvdbProbe(c.String("kvdb")), alertsManager, cfg.Osd.KvdbHealth)
+	if err := kvdbhealth.Init(kvdbMonitor); err != nil {
+		return fmt.Errorf("Failed to initialize kvdb health monitor: %v", err)
+	}
+	kvdbMonitor.Start()
+	if cfg.Osd.SLO.Enabled() {
+		if err := startSLOTracking(alertsManager, &cfg.Osd.SLO); err != nil {
+			return fmt.Errorf("Failed to start SLO tracking: %v", err)
+		}
+	}
+
+	if cfg.Osd.MetadataBackup.Enabled() {
+		backups, err := newMetadataBackupManager(kv, cfg)
+		if err != nil {
+			return fmt.Errorf("Failed to start metadata backups: %v", err)
+		}
+		backups.Start()
+	}
 
 	// Start the cluster state machine, if enabled.
 	clusterInit := false
@@ -269,19 +494,119 @@ func start(c *cli.Context) error {
 		if err := clustermanager.Init(cfg.Osd.ClusterConfig); err != nil {
 			return fmt.Errorf("Unable to init cluster server: %v", err)
 		}
-		if err := server.StartClusterAPI(cluster.APIBase, 0); err != nil {
+		if cfg.Osd.APIStandby.Enabled {
+			if len(cfg.Osd.APIStandby.Endpoint) == 0 && cfg.Osd.ClusterAPITLSPort != 0 {
+				cfg.Osd.APIStandby.Endpoint = fmt.Sprintf("https://%s:%d",
+					cfg.Osd.ClusterConfig.MgmtIp, cfg.Osd.ClusterAPITLSPort)
+			}
+			e := leader.New(kv, "api", cfg.Osd.ClusterConfig.NodeId, &cfg.Osd.APIStandby)
+			if err := leader.Init(e); err != nil {
+				return fmt.Errorf("Unable to init API server election: %v", err)
+			}
+			e.Start()
+		}
+		if cfg.Osd.ClusterAPITLSPort != 0 {
+			err = server.StartClusterAPIWithTLS(cluster.APIBase, cfg.Osd.ClusterAPITLSPort, identity)
+		} else {
+			err = server.StartClusterAPI(cluster.APIBase, 0)
+		}
+		if err != nil {
 			return fmt.Errorf("Unable to start cluster API server: %v", err)
 		}
 		clusterInit = true
 	}
 
-	isDefaultSet := false
-	// Start the volume drivers.
+	var limiter *attachlimit.Limiter
+	if cfg.Osd.AttachLimits.Enabled() {
+		limiter = attachlimit.New(cfg.Osd.ClusterConfig.NodeId, cfg.Osd.AttachLimits.Limit())
+		if err := attachlimit.Init(limiter); err != nil {
+			return fmt.Errorf("Unable to initialize attachment limits: %v", err)
+		}
+	}
+
+	mounter, err := mount.NewNamespaceMounter(cfg.Osd.MountNamespace)
+	if err != nil {
+		return fmt.Errorf("Invalid mount namespace configuration: %v", err)
+	}
+	mount.SetDefaultMountImpl(mounter)
+
+	ids, err := volumeid.NewGenerator(cfg.Osd.VolumeIDs)
+	if err != nil {
+		return fmt.Errorf("Invalid volume id configuration: %v", err)
+	}
+	volumeid.SetDefault(ids)
+
+	checker := preflight.New(cfg.Osd.Preflight)
+	if err := preflight.Init(checker); err != nil {
+		return fmt.Errorf("Unable to initialize preflight checks: %v", err)
+	}
+
 	for d, v := range cfg.Osd.Drivers {
-		logrus.Infof("Starting volume driver: %v", d)
-		if err := volumedrivers.Register(d, v); err != nil {
+		if _, ok := v[common.OptionNodeID]; !ok && len(cfg.Osd.ClusterConfig.NodeId) != 0 {
+			v[common.OptionNodeID] = cfg.Osd.ClusterConfig.NodeId
+		}
+		if _, err := checker.Run(d); err != nil {
 			return fmt.Errorf("Unable to start volume driver: %v, %v", d, err)
 		}
+	}
+	logrus.Infof("Starting volume drivers: %v", driverNames(cfg.Osd.Drivers))
+	if errs := volumedrivers.RegisterAll(cfg.Osd.Drivers, &cfg.Osd.DriverInit); len(errs) != 0 {
+		for _, d := range driverNames(cfg.Osd.Drivers) {
+			if err, ok := errs[d]; ok {
+				return fmt.Errorf("Unable to start volume driver: %v, %v", d, err)
+			}
+		}
+	}
+
+	if err := cfg.Osd.CloudSnap.Validate(); err != nil {
+		return fmt.Errorf("Invalid cloud backup configuration: %v", err)
+	}
+	if err := cfg.Osd.Eraser.Validate(); err != nil {
+		return fmt.Errorf("Invalid eraser configuration: %v", err)
+	}
+
+	var tenantPolicies *tenantpolicy.Policies
+	if cfg.Osd.TenantPolicies.Enabled() {
+		if tenantPolicies, err = tenantpolicy.New(&cfg.Osd.TenantPolicies); err != nil {
+			return fmt.Errorf("Invalid tenant policies: %v", err)
+		}
+		if err := tenantpolicy.Init(tenantPolicies); err != nil {
+			return fmt.Errorf("Failed to initialize tenant policies: %v", err)
+		}
+	}
+
+	isDefaultSet := false
+	// Set up the volume drivers.
+	for d, v := range cfg.Osd.Drivers {
+		if cfg.Osd.DriverInit.IsLazy(d) {
+			logrus.Infof("Volume driver %v will start on first use", d)
+		}
+		if err := volumedrivers.Wrap(d, checker.Wrap(d)); err != nil {
+			return fmt.Errorf("Unable to report the preflight checks of volume driver: %v, %v", d, err)
+		}
+		if limiter != nil {
+			if err := volumedrivers.Wrap(d, limiter.Wrap); err != nil {
+				return fmt.Errorf("Unable to limit attachments of volume driver: %v, %v", d, err)
+			}
+		}
+		if err := volumedrivers.Wrap(d, subpath.Wrap); err != nil {
+			return fmt.Errorf("Unable to mount subpaths of volume driver: %v, %v", d, err)
+		}
+		if tenantPolicies != nil {
+			if err := volumedrivers.Wrap(d, tenantPolicies.Wrap); err != nil {
+				return fmt.Errorf("Unable to apply tenant policies to volume driver: %v, %v", d, err)
+			}
+		}
+		if cfg.Osd.CloudSnap.Enabled(d) {
+			if err := volumedrivers.Wrap(d, cloudsnap.Wrap(kv, &cfg.Osd.CloudSnap)); err != nil {
+				return fmt.Errorf("Unable to back up volume driver to the cloud: %v, %v", d, err)
+			}
+		}
+		if cfg.Osd.Eraser.Erases(d, cfg.Osd.ClusterConfig.DefaultDriver) {
+			if err := volumedrivers.Wrap(d, eraser.Wrap(&cfg.Osd.Eraser)); err != nil {
+				return fmt.Errorf("Unable to erase the volumes of volume driver: %v, %v", d, err)
+			}
+		}
 
 		var mgmtPort, pluginPort uint64
 		if port, ok := v[config.MgmtPortKey]; ok {
@@ -315,6 +640,36 @@ func start(c *cli.Context) error {
 			isDefaultSet = true
 		}
 
+		if clusterInit {
+			if err := server.StartKeyRotationPolicy(d); err != nil {
+				return fmt.Errorf("Unable to start key rotation policy for driver %s: %v", d, err)
+			}
+			if err := startPlacementRevalidation(d, labelsManager, alertsManager); err != nil {
+				return fmt.Errorf("Unable to start placement revalidation for driver %s: %v", d, err)
+			}
+			if cfg.Osd.CloudDrives.Enabled() && d == cloudDriveDriver(cfg) {
+				if err := startCloudDrives(kv, d, cfg); err != nil {
+					return fmt.Errorf("Unable to start cloud drives for driver %s: %v", d, err)
+				}
+			}
+		}
+
+		if cfg.Osd.Metering.Enabled() {
+			if err := startMetering(kv, d, cfg); err != nil {
+				return fmt.Errorf("Unable to start usage metering for driver %s: %v", d, err)
+			}
+		}
+
+		if cfg.Osd.StatsHistory.Enabled() {
+			vd, err := volumedrivers.Get(d)
+			if err != nil {
+				return fmt.Errorf("Unable to start stats history for driver %s: %v", d, err)
+			}
+			if err := statshistory.Start(d, vd, cfg.Osd.StatsHistory.Interval); err != nil {
+				return fmt.Errorf("Unable to start stats history for driver %s: %v", d, err)
+			}
+		}
+
 		// Start CSI Server for this driver
 		csisock := os.Getenv("CSI_ENDPOINT")
 		if len(csisock) == 0 {
@@ -382,12 +737,371 @@ func start(c *cli.Context) error {
 		); err != nil {
 			return fmt.Errorf("Unable to start cluster manager: %v", err)
 		}
+		if isDefaultSet {
+			if err := startHibernate(kv, cfg); err != nil {
+				return fmt.Errorf("Unable to start cluster hibernation: %v", err)
+			}
+			if cfg.Osd.SnapshotCleanup.Enabled() {
+				if err := startSnapshotCleanup(kv, cfg); err != nil {
+					return fmt.Errorf("Unable to start orphaned snapshot cleanup: %v", err)
+				}
+			}
+			if err := startSnapshotSchedules(kv, cfg, alertsManager); err != nil {
+				return fmt.Errorf("Unable to start snapshot schedules: %v", err)
+			}
+		}
 	}
 
 	// Daemon does not exit.
 	select {}
 }
 
+// startPlacementRevalidation checks the placement of the volumes of driver
+// d against their placement rules whenever node labels change.
+func startPlacementRevalidation(d string, labels nodelabels.Manager, manager alerts.Manager) error {
+	vd, err := volumedrivers.Get(d)
+	if err != nil {
+		return err
+	}
+	cm, err := clustermanager.Inst()
+	if err != nil {
+		return err
+	}
+	labels.AddListener(nodelabels.NewRevalidator(cm, vd, labels, manager).Revalidate)
+	return nil
+}
+
+// startHibernate suspends and resumes the volumes of the default driver on
+// this node following the state requested for the cluster.
+func startHibernate(kv kvdb.Kvdb, cfg *config.Config) error {
+	vd, err := volumedrivers.Get(cfg.Osd.ClusterConfig.DefaultDriver)
+	if err != nil {
+		return err
+	}
+	m := hibernate.NewManager(kv, cfg.Osd.ClusterConfig.NodeId, vd)
+	if err := hibernate.Init(m); err != nil {
+		return err
+	}
+	return m.Start()
+}
+
+// startSnapshotCleanup preserves or expires the snapshots of the default
+// driver whose parent volume was deleted.
+func startSnapshotCleanup(kv kvdb.Kvdb, cfg *config.Config) error {
+	vd, err := volumedrivers.Get(cfg.Osd.ClusterConfig.DefaultDriver)
+	if err != nil {
+		return err
+	}
+	c, err := snapcleanup.NewCleaner(kv, vd, &cfg.Osd.SnapshotCleanup)
+	if err != nil {
+		return err
+	}
+	c.Start()
+	return nil
+}
+
+// startSnapshotSchedules takes the scheduled snapshots of the volumes of the
+// default driver, raising the alerts of the failed ones with manager.
+func startSnapshotSchedules(kv kvdb.Kvdb, cfg *config.Config, manager alerts.Manager) error {
+	vd, err := volumedrivers.Get(cfg.Osd.ClusterConfig.DefaultDriver)
+	if err != nil {
+		return err
+	}
+	m := snapsched.NewManager(kv, vd, manager)
+	if err := snapsched.Init(m); err != nil {
+		return err
+	}
+	m.Start()
+	return nil
+}
+
+// driverNames returns the names of the drivers, sorted.
+func driverNames(drivers map[string]map[string]string) []string {
+	names := make([]string, 0, len(drivers))
+	for d := range drivers {
+		names = append(names, d)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// cloudDriveDriver returns the driver owning the pools of the cloud drives.
+func cloudDriveDriver(cfg *config.Config) string {
+	if len(cfg.Osd.CloudDrives.Driver) != 0 {
+		return cfg.Osd.CloudDrives.Driver
+	}
+	return cfg.Osd.ClusterConfig.DefaultDriver
+}
+
+// startCloudDrives provisions and attaches the cloud drives of this node to
+// the pools of driver d.
+func startCloudDrives(kv kvdb.Kvdb, d string, cfg *config.Config) error {
+	if err := cfg.Osd.CloudDrives.Validate(); err != nil {
+		return err
+	}
+	vd, err := volumedrivers.Get(d)
+	if err != nil {
+		return err
+	}
+	var ops storageops.Ops
+	switch cfg.Osd.CloudDrives.Provider {
+	case clouddrive.ProviderAWS:
+		ops, err = aws_ops.NewEnvClient()
+	case clouddrive.ProviderGCE:
+		ops, err = gce.NewClient()
+	}
+	if err != nil {
+		return err
+	}
+	template, err := clouddrive.NewTemplateFunc(ops)
+	if err != nil {
+		return err
+	}
+	m, err := clouddrive.NewManager(
+		kv,
+		ops,
+		vd,
+		cfg.Osd.ClusterConfig.ClusterId,
+		cfg.Osd.ClusterConfig.NodeId,
+		template,
+		&cfg.Osd.CloudDrives,
+	)
+	if err != nil {
+		return err
+	}
+	if err := clouddrive.Init(m); err != nil {
+		return err
+	}
+	m.Start()
+	if cfg.Osd.CloudDrives.PreemptionRescue {
+		w, err := clouddrive.NewPreemptionWatcher(cfg.Osd.CloudDrives.Provider)
+		if err != nil {
+			return err
+		}
+		m.WatchPreemption(w, vd, clouddrive.DefaultPreemptionInterval)
+	}
+	return nil
+}
+
+// addAlertNotifiers registers a notifier with manager for each webhook,
+// email sink and Alertmanager.
+func addAlertNotifiers(
+	manager alerts.Manager,
+	webhooks []alerts.WebhookConfig,
+	emails []alerts.EmailConfig,
+	alertmanagers []alerts.AlertmanagerConfig,
+) error {
+	for i := range webhooks {
+		n, err := alerts.NewWebhookNotifier(&webhooks[i])
+		if err != nil {
+			return err
+		}
+		filters, err := webhooks[i].Filters()
+		if err != nil {
+			return err
+		}
+		if err := manager.AddNotifier(n, filters...); err != nil {
+			return err
+		}
+	}
+	for i := range emails {
+		n, err := alerts.NewEmailNotifier(&emails[i])
+		if err != nil {
+			return err
+		}
+		filters, err := emails[i].Filters()
+		if err != nil {
+			return err
+		}
+		if err := manager.AddNotifier(n, filters...); err != nil {
+			return err
+		}
+	}
+	for i := range alertmanagers {
+		n, err := alerts.NewAlertmanagerNotifier(&alertmanagers[i])
+		if err != nil {
+			return err
+		}
+		filters, err := alertmanagers[i].Filters()
+		if err != nil {
+			return err
+		}
+		if err := manager.AddNotifier(n, filters...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startAlertRouting routes the alerts with the routes of the cluster
+// configuration, following their changes.
+func startAlertRouting(kv kvdb.Kvdb, manager alerts.Manager) error {
+	configs, err := osdconfig.NewManager(kv)
+	if err != nil {
+		return err
+	}
+	setRoutes := func(conf *osdconfig.ClusterConfig) error {
+		return manager.SetRoutes(alertRoutes(conf)...)
+	}
+	// the cluster configuration is not stored until the cluster is set up
+	if conf, err := configs.GetClusterConf(); err == nil {
+		if err := setRoutes(conf); err != nil {
+			return err
+		}
+	}
+	return configs.WatchCluster("alertRoutes", setRoutes)
+}
+
+// alertRoutes returns the alert routes of the cluster configuration.
+func alertRoutes(conf *osdconfig.ClusterConfig) []*alerts.Route {
+	if conf.Alerts == nil {
+		return nil
+	}
+	routes := make([]*alerts.Route, 0, len(conf.Alerts.Routes))
+	for _, r := range conf.Alerts.Routes {
+		routes = append(routes, &alerts.Route{
+			Name:          r.Name,
+			ResourceTypes: r.ResourceTypes,
+			AlertTypes:    r.AlertTypes,
+			MinSeverity:   r.MinSeverity,
+			Labels:        r.Labels,
+			Notifiers:     r.Notifiers,
+		})
+	}
+	return routes
+}
+
+// startJobScheduling schedules the jobs with the concurrency classes and the
+// priorities of the cluster configuration, and applies its retention and
+// retries of the jobs, following their changes.
+func startJobScheduling(kv kvdb.Kvdb) error {
+	jm, err := jobs.Inst()
+	if err != nil {
+		return err
+	}
+	configs, err := osdconfig.NewManager(kv)
+	if err != nil {
+		return err
+	}
+	setConfig := func(conf *osdconfig.ClusterConfig) error {
+		if err := jm.SetSchedulerConfig(jobsScheduling(conf)); err != nil {
+			return err
+		}
+		retention, retry := jobsHistory(conf)
+		if err := jm.SetRetention(retention); err != nil {
+			return err
+		}
+		return jm.SetRetry(retry)
+	}
+	// the cluster configuration is not stored until the cluster is set up
+	if conf, err := configs.GetClusterConf(); err == nil {
+		if err := setConfig(conf); err != nil {
+			return err
+		}
+	}
+	return configs.WatchCluster("jobScheduling", setConfig)
+}
+
+// jobsScheduling returns the scheduling of the jobs of the cluster
+// configuration.
+func jobsScheduling(conf *osdconfig.ClusterConfig) *jobs.SchedulerConfig {
+	if conf.Jobs == nil {
+		return &jobs.SchedulerConfig{}
+	}
+	c := &jobs.SchedulerConfig{
+		Classes:    make([]jobs.ClassConfig, 0, len(conf.Jobs.Classes)),
+		Priorities: conf.Jobs.Priorities,
+	}
+	for _, class := range conf.Jobs.Classes {
+		c.Classes = append(c.Classes, jobs.ClassConfig{
+			Name:     class.Name,
+			JobTypes: class.JobTypes,
+			Limit:    class.Limit,
+			Scope:    class.Scope,
+		})
+	}
+	return c
+}
+
+// jobsHistory returns the retention and the retries of the jobs of the
+// cluster configuration.
+func jobsHistory(conf *osdconfig.ClusterConfig) (*jobs.RetentionConfig, *jobs.RetryConfig) {
+	retention, retry := &jobs.RetentionConfig{}, &jobs.RetryConfig{}
+	if conf.Jobs == nil {
+		return retention, retry
+	}
+	if r := conf.Jobs.Retention; r != nil {
+		retention.MaxAge = time.Duration(r.MaxAgeHours) * time.Hour
+		retention.MaxCount = r.MaxCount
+	}
+	if r := conf.Jobs.Retry; r != nil {
+		retry.MaxAttempts = r.MaxAttempts
+		retry.Backoff = time.Duration(r.BackoffSeconds) * time.Second
+		retry.MaxBackoff = time.Duration(r.MaxBackoffSeconds) * time.Second
+	}
+	return retention, retry
+}
+
+// resourceLabels returns the labels of the alerted nodes, set through the node
+// labels API, and of the alerted volumes of driver d.
+func resourceLabels(d string) alerts.LabelsFunc {
+	return func(resourceType api.ResourceType, resourceID string) (map[string]string, error) {
+		switch resourceType {
+		case api.ResourceType_RESOURCE_TYPE_NODE:
+			labels, err := nodelabels.Inst()
+			if err != nil {
+				return nil, err
+			}
+			return labels.Get(resourceID)
+		case api.ResourceType_RESOURCE_TYPE_VOLUME:
+			if len(d) == 0 {
+				return nil, nil
+			}
+			vd, err := volumedrivers.Get(d)
+			if err != nil {
+				return nil, err
+			}
+			vols, err := vd.Inspect([]string{resourceID})
+			if err != nil || len(vols) == 0 {
+				return nil, err
+			}
+			return vols[0].GetLocator().GetVolumeLabels(), nil
+		}
+		return nil, nil
+	}
+}
+
+func startSLOTracking(manager alerts.Manager, cfg *slo.Config) error {
+	tracker, err := slo.NewTracker(cfg.Objectives, manager)
+	if err != nil {
+		return err
+	}
+	if err := slo.Init(tracker); err != nil {
+		return err
+	}
+	tracker.Start(cfg.Interval)
+	return nil
+}
+
+// startMetering meters the volumes of driverName. In a cluster, the node
+// elected for the metering of the driver reports the usage of all of them.
+func startMetering(kv kvdb.Kvdb, driverName string, cfg *config.Config) error {
+	sink, err := metering.NewSink(&cfg.Osd.Metering)
+	if err != nil {
+		return err
+	}
+	var e *leader.Elector
+	if len(cfg.Osd.ClusterConfig.NodeId) != 0 {
+		e = leader.New(kv, "metering/"+driverName, cfg.Osd.ClusterConfig.NodeId, &leader.Config{})
+		e.Start()
+	}
+	driver := func() (volume.VolumeDriver, error) {
+		return volumedrivers.Get(driverName)
+	}
+	metering.NewMeter(driverName, driver, sink, e).Start(cfg.Osd.Metering.Interval)
+	return nil
+}
+
 func showVersion(c *cli.Context) error {
 	fmt.Println("OSD Version:", config.Version)
 	fmt.Println("Go Version:", runtime.Version())
@@ -396,6 +1110,202 @@ func showVersion(c *cli.Context) error {
 	return nil
 }
 
+// newKvdb returns the kvdb at kvdbURL and its endpoints. The url may list
+// several comma separated hosts, e.g. etcd-kv://host1:2379,host2:2379,
+// between which the kvdb client fails over.
+func newKvdb(kvdbURL, prefix string) (kvdb.Kvdb, []string, error) {
+	u, err := url.Parse(kvdbURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Invalid kvdb url %s: %v", kvdbURL, err)
+	}
+	scheme := u.Scheme
+
+	var endpoints []string
+	for _, host := range strings.Split(u.Host, ",") {
+		e := *u
+		e.Scheme = "http"
+		e.Host = host
+		endpoints = append(endpoints, e.String())
+	}
+
+	kv, err := kvdb.New(scheme, kvdbprefix.Domain(prefix), endpoints, nil, logrus.Panicf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to initialize KVDB: %v (%v)\nSupported datastores: %v", scheme, err, datastores)
+	}
+	if scheme == mem.Name {
+		endpoints = nil
+	}
+	return kv, endpoints, nil
+}
+
+// kvdbProbe returns the health probe of the endpoints of the kvdb at kvdbURL.
+func kvdbProbe(kvdbURL string) kvdbhealth.Probe {
+	u, err := url.Parse(kvdbURL)
+	if err != nil {
+		return nil
+	}
+	switch u.Scheme {
+	case etcd.Name:
+		return kvdbhealth.NewHTTPProbe("/health", 5*time.Second)
+	case consul.Name:
+		return kvdbhealth.NewHTTPProbe("/v1/status/leader", 5*time.Second)
+	}
+	return nil
+}
+
+func migrateKvdb(c *cli.Context) error {
+	from, to := c.String("from"), c.String("to")
+	if err := kvdbprefix.Validate(from); err != nil {
+		return err
+	}
+	if err := kvdbprefix.Validate(to); err != nil {
+		return err
+	}
+	if kvdbprefix.Domain(from) == kvdbprefix.Domain(to) {
+		return fmt.Errorf("Source and destination prefixes are the same")
+	}
+	src, _, err := newKvdb(c.GlobalString("kvdb"), from)
+	if err != nil {
+		return err
+	}
+	dst, _, err := newKvdb(c.GlobalString("kvdb"), to)
+	if err != nil {
+		return err
+	}
+	n, err := kvdbprefix.Migrate(src, dst, c.Bool("delete"))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Migrated %d keys from %s to %s\n", n, kvdbprefix.Domain(from), kvdbprefix.Domain(to))
+	return nil
+}
+
+// alertNodes returns the sources of the alerts of the other nodes of the
+// cluster, enumerated through their cluster API on tlsPort with the identity
+// of this node, for the cluster wide enumerations of alerts.
+func alertNodes(tlsPort uint16, identity *ca.Identity) alerts.NodesFunc {
+	return func() (map[string]alerts.NodeSource, error) {
+		cm, err := clustermanager.Inst()
+		if err != nil {
+			return nil, err
+		}
+		c, err := cm.Enumerate()
+		if err != nil {
+			return nil, err
+		}
+		sources := make(map[string]alerts.NodeSource, len(c.Nodes))
+		for _, n := range c.Nodes {
+			if n.Id == c.NodeId || len(n.MgmtIp) == 0 {
+				continue
+			}
+			clnt, err := client.NewClient(fmt.Sprintf("https://%s:%d", n.MgmtIp, tlsPort), cluster.APIVersion, "")
+			if err != nil {
+				return nil, err
+			}
+			if err := clnt.SetTLS(identity.ClientTLSConfig(n.Id)); err != nil {
+				return nil, err
+			}
+			sources[n.Id] = clusterclient.NewAlertsSource(clnt)
+		}
+		return sources, nil
+	}
+}
+
+// initNodeIdentity initializes the cluster CA and issues the certificate of
+// this node, rotated before it expires.
+func initNodeIdentity(kv kvdb.Kvdb, cfg *config.Config) (*ca.Identity, error) {
+	clusterCA, err := ca.New(kv, cfg.Osd.ClusterConfig.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ca.Init(clusterCA); err != nil {
+		return nil, err
+	}
+	hosts := []string{cfg.Osd.ClusterConfig.DataIp, cfg.Osd.ClusterConfig.MgmtIp}
+	identity, err := ca.NewIdentity(clusterCA, cfg.Osd.ClusterConfig.NodeId, hosts, 0)
+	if err != nil {
+		return nil, err
+	}
+	identity.Start()
+	return identity, nil
+}
+
+func newMetadataBackupManager(kv kvdb.Kvdb, cfg *config.Config) (*metabackup.Manager, error) {
+	if err := cfg.Osd.MetadataBackup.Validate(); err != nil {
+		return nil, err
+	}
+	store, err := s3.New(&cfg.Osd.MetadataBackup.ObjectStore)
+	if err != nil {
+		return nil, err
+	}
+	return metabackup.NewManager(kv, store, cfg.Osd.ClusterConfig.ClusterId, &cfg.Osd.MetadataBackup)
+}
+
+// metadataBackupManager returns the metadata backup manager configured by
+// the config file of the command line.
+func metadataBackupManager(c *cli.Context) (*metabackup.Manager, error) {
+	file := c.GlobalString("file")
+	if len(file) == 0 {
+		return nil, fmt.Errorf("Missing config file with the metadata_backup settings")
+	}
+	cfg, err := config.Parse(file)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Osd.ClusterConfig.ClusterId) == 0 {
+		cfg.Osd.ClusterConfig.ClusterId = c.GlobalString("clusterid")
+	}
+	if len(cfg.Osd.KvdbPrefix) == 0 {
+		cfg.Osd.KvdbPrefix = c.GlobalString("kvdbprefix")
+	}
+	kv, _, err := newKvdb(c.GlobalString("kvdb"), cfg.Osd.KvdbPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return newMetadataBackupManager(kv, cfg)
+}
+
+func backupKvdb(c *cli.Context) error {
+	m, err := metadataBackupManager(c)
+	if err != nil {
+		return err
+	}
+	name, err := m.Backup(time.Now())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created metadata backup %s\n", name)
+	return nil
+}
+
+func listKvdbBackups(c *cli.Context) error {
+	m, err := metadataBackupManager(c)
+	if err != nil {
+		return err
+	}
+	names, err := m.List()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		fmt.Println(name)
+	}
+	return nil
+}
+
+func restoreKvdb(c *cli.Context) error {
+	m, err := metadataBackupManager(c)
+	if err != nil {
+		return err
+	}
+	n, err := m.Restore(c.String("backup"))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Restored %d keys\n", n)
+	return nil
+}
+
 func wrapAction(f func(*cli.Context) error) func(*cli.Context) {
 	return func(c *cli.Context) {
 		if err := f(c); err != nil {
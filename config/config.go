@@ -4,9 +4,31 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/attachlimit"
+	"github.com/libopenstorage/openstorage/clouddrive"
+	"github.com/libopenstorage/openstorage/cloudsnap"
+	"github.com/libopenstorage/openstorage/eraser"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/idempotency"
+	"github.com/libopenstorage/openstorage/kvdbhealth"
+	"github.com/libopenstorage/openstorage/leader"
+	"github.com/libopenstorage/openstorage/metabackup"
+	"github.com/libopenstorage/openstorage/metering"
+	"github.com/libopenstorage/openstorage/pkg/apiqueue"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+	"github.com/libopenstorage/openstorage/pkg/mount"
+	"github.com/libopenstorage/openstorage/pkg/volumeid"
+	"github.com/libopenstorage/openstorage/preflight"
+	"github.com/libopenstorage/openstorage/slo"
+	"github.com/libopenstorage/openstorage/snapcleanup"
+	"github.com/libopenstorage/openstorage/statshistory"
+	"github.com/libopenstorage/openstorage/tenantpolicy"
+	"github.com/libopenstorage/openstorage/transform"
 	"github.com/libopenstorage/openstorage/volume"
 )
 
@@ -46,10 +68,97 @@ type ClusterConfig struct {
 type Config struct {
 	Osd struct {
 		ClusterConfig ClusterConfig `yaml:"cluster"`
+		// KvdbPrefix is prepended to every kvdb key, typically the cluster
+		// id, so that several clusters can share one kvdb
+		KvdbPrefix string `yaml:"kvdb_prefix"`
+		// KvdbHealth configures monitoring of the kvdb connection
+		KvdbHealth kvdbhealth.Config `yaml:"kvdb_health"`
 		// map[string]string is volume.VolumeParams equivalent
 		Drivers map[string]map[string]string
+		// DriverInit configures how the drivers are started: concurrently,
+		// within a timeout, or on first use
+		DriverInit volume.InitConfig `yaml:"driver_init"`
+		// VolumeIDs configures the format and the prefix of the ids of the
+		// volumes created by the drivers
+		VolumeIDs volumeid.Config `yaml:"volume_ids"`
 		// map[string]string is volume.VolumeParams equivalent
 		GraphDrivers map[string]map[string]string
+		// Crypto restricts the algorithms used for encryption and TLS
+		Crypto crypto.Policy `yaml:"crypto"`
+		// Metering configures the sink for usage metering records
+		Metering metering.Config `yaml:"metering"`
+		// EventBus configures publishing of events to Kafka or NATS
+		EventBus eventbus.Config `yaml:"eventbus"`
+		// StatsHistory configures sampling of volume stats for graphing
+		StatsHistory statshistory.Config `yaml:"stats_history"`
+		// SLO defines the service level objectives tracked for alerting
+		SLO slo.Config `yaml:"slo"`
+		// ClusterAPITLSPort serves the cluster API with TLS on this port,
+		// authenticating clients with certificates of the cluster CA
+		ClusterAPITLSPort uint16 `yaml:"cluster_api_tls_port"`
+		// DataChannel configures the channels carrying volume data between nodes
+		DataChannel struct {
+			// Compress compresses the data sent by this node
+			Compress bool `yaml:"compress"`
+		} `yaml:"data_channel"`
+		// MetadataBackup configures the scheduled backups of the kvdb data
+		MetadataBackup metabackup.Config `yaml:"metadata_backup"`
+		// SnapshotCleanup preserves or expires the snapshots of the default
+		// driver whose parent volume was deleted
+		SnapshotCleanup snapcleanup.Config `yaml:"snapshot_cleanup"`
+		// CloudSnap backs up the volumes of the drivers without native
+		// cloud backups to S3 compatible object stores
+		CloudSnap cloudsnap.Config `yaml:"cloudsnap"`
+		// Eraser erases the volumes of some pools once deleted, before
+		// their space is reused
+		Eraser eraser.Config `yaml:"eraser"`
+		// CloudDrives declares the cloud drives provisioned for the pools
+		// of every node
+		CloudDrives clouddrive.Config `yaml:"cloud_drives"`
+		// AttachLimits limits the number of volumes attached to this node
+		AttachLimits attachlimit.Config `yaml:"attach_limits"`
+		// Preflight configures the checks of the kernel prerequisites of
+		// the drivers run before they start
+		Preflight preflight.Config `yaml:"preflight"`
+		// MountNamespace sets the mount namespace of the volume mounts, so
+		// that the mounts of a containerized daemon are visible to the host
+		MountNamespace mount.NamespaceConfig `yaml:"mount_namespace"`
+		// AlertsGCInterval is the time between deletions of the expired
+		// alerts, alerts.DefaultGCInterval if unset
+		AlertsGCInterval time.Duration `yaml:"alerts_gc_interval"`
+		// AlertsEscalationInterval is the time between evaluations of the
+		// alerts escalation rules, alerts.DefaultEscalationInterval if unset
+		AlertsEscalationInterval time.Duration `yaml:"alerts_escalation_interval"`
+		// AlertsRateLimit limits the rate of the raises of every resource
+		// type and alert type, no limit if unset
+		AlertsRateLimit alerts.RateLimitConfig `yaml:"alerts_rate_limit"`
+		// AlertsRetention bounds the age and number of the alerts of some
+		// resource types, deleted every gc interval
+		AlertsRetention []alerts.RetentionConfig `yaml:"alerts_retention"`
+		// AlertsFlapping collapses the alerts of some alert types raised and
+		// cleared too often into a single flapping alert
+		AlertsFlapping []alerts.FlappingConfig `yaml:"alerts_flapping"`
+		// AlertWebhooks forwards the raised alerts to external systems
+		AlertWebhooks []alerts.WebhookConfig `yaml:"alert_webhooks"`
+		// AlertEmails emails the critical alerts raised
+		AlertEmails []alerts.EmailConfig `yaml:"alert_emails"`
+		// Alertmanagers receive the raised alerts, and their resolution
+		Alertmanagers []alerts.AlertmanagerConfig `yaml:"alertmanagers"`
+		// RestorePolicies transform the volumes restored from a backup or
+		// cloned on this cluster
+		RestorePolicies transform.Config `yaml:"restore_policies"`
+		// TenantPolicies set the spec of the volumes created by some tenants
+		// or in some namespaces
+		TenantPolicies tenantpolicy.Config `yaml:"tenant_policies"`
+		// Idempotency configures how long the outcomes of the calls made
+		// with an idempotency key are kept
+		Idempotency idempotency.Config `yaml:"idempotency"`
+		// APIQueue bounds the API requests served concurrently and queues
+		// the others by priority
+		APIQueue apiqueue.Config `yaml:"api_queue"`
+		// APIStandby runs the cluster API server as a standby of the node
+		// elected active
+		APIStandby leader.Config `yaml:"api_standby"`
 	}
 }
 
@@ -0,0 +1,124 @@
+// Package cost produces cost reports for volumes from provisioned capacity,
+// backend tier pricing and backup storage usage.
+package cost
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/portworx/kvdb"
+)
+
+const (
+	// GroupByTenant groups costs by the api.LabelTenant volume label
+	GroupByTenant = "tenant"
+	// GroupByNamespace groups costs by the api.LabelNamespace volume label
+	GroupByNamespace = "namespace"
+	// Unassigned is the group of volumes without the group by label
+	Unassigned = "unassigned"
+)
+
+var (
+	// ErrNotInitialized returned when the cost manager has not been initialized
+	ErrNotInitialized = errors.New("openstorage.cost: not initialized")
+	// ErrInitialized returned when the cost manager is initialized twice
+	ErrInitialized = errors.New("openstorage.cost: already initialized")
+	// ErrInvalidGroupBy returned when a report is requested with an unknown grouping
+	ErrInvalidGroupBy = errors.New("Invalid group by, must be tenant or namespace")
+	// ErrNoPriceSheet returned when a report is requested before a price
+	// sheet has been set
+	ErrNoPriceSheet = errors.New("No price sheet configured")
+
+	inst Manager
+	// Inst returns an instance of an already instantiated cost manager.
+	// This function can be overridden for testing purposes
+	Inst = func() (Manager, error) {
+		return costInst()
+	}
+)
+
+// PriceSheet defines the prices used to compute costs.
+// swagger:model
+type PriceSheet struct {
+	// Currency of the prices, for example USD
+	Currency string
+	// CapacityPrices is the price per GiB-month of provisioned capacity
+	// keyed by tier, the lower case name of the api.CosType of the volume
+	CapacityPrices map[string]float64
+	// BackupPrice is the price per GiB-month of cloud backup storage
+	BackupPrice float64
+}
+
+// Item is the cost of one group of volumes.
+// swagger:model
+type Item struct {
+	// Group is the value of the group by label
+	Group string
+	// Volumes is the number of volumes in the group
+	Volumes int
+	// ProvisionedBytes is the provisioned capacity of the volumes
+	ProvisionedBytes uint64
+	// CapacityCost is the monthly cost of the provisioned capacity
+	CapacityCost float64
+	// BackupBytes is the cloud backup storage used by the volumes
+	BackupBytes uint64
+	// BackupCost is the monthly cost of the cloud backup storage
+	BackupCost float64
+	// TotalCost is the sum of CapacityCost and BackupCost
+	TotalCost float64
+}
+
+// Report is a monthly cost report.
+// swagger:model
+type Report struct {
+	// GroupBy is the label the report is grouped by
+	GroupBy string
+	// Currency of the costs
+	Currency string
+	// Time the report was generated
+	Time time.Time
+	// Items are the costs per group sorted by group
+	Items []*Item
+	// TotalCost is the sum of all items
+	TotalCost float64
+}
+
+// WriteCSV writes the report to w as CSV, one row per item followed by a
+// total row.
+func (r *Report) WriteCSV(w io.Writer) error {
+	return writeCSV(w, r)
+}
+
+// Manager manages price sheets and generates cost reports.
+type Manager interface {
+	// SetPriceSheet stores the price sheet used for reports.
+	SetPriceSheet(sheet *PriceSheet) error
+	// GetPriceSheet returns the price sheet used for reports.
+	GetPriceSheet() (*PriceSheet, error)
+	// Report returns the costs of the volumes of driver d grouped by
+	// GroupByTenant or GroupByNamespace.
+	Report(d volume.VolumeDriver, groupBy string) (*Report, error)
+}
+
+// NewManager returns a kvdb backed cost manager.
+func NewManager(kv kvdb.Kvdb) Manager {
+	return newManager(kv)
+}
+
+// Init instantiates the cost manager singleton.
+func Init(kv kvdb.Kvdb) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = newManager(kv)
+	return nil
+}
+
+func costInst() (Manager, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
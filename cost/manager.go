@@ -0,0 +1,168 @@
+package cost
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/portworx/kvdb"
+)
+
+const (
+	priceSheetKey = "cost/pricesheet"
+	bytesPerGiB   = float64(1 << 30)
+)
+
+// manager implements Manager interface.
+type manager struct {
+	kv kvdb.Kvdb
+}
+
+func newManager(kv kvdb.Kvdb) *manager {
+	return &manager{kv: kv}
+}
+
+func (m *manager) SetPriceSheet(sheet *PriceSheet) error {
+	_, err := m.kv.Put(priceSheetKey, sheet, 0)
+	return err
+}
+
+func (m *manager) GetPriceSheet() (*PriceSheet, error) {
+	sheet := new(PriceSheet)
+	if _, err := m.kv.GetVal(priceSheetKey, sheet); err != nil {
+		if err == kvdb.ErrNotFound {
+			return nil, ErrNoPriceSheet
+		}
+		return nil, err
+	}
+	return sheet, nil
+}
+
+func (m *manager) Report(d volume.VolumeDriver, groupBy string) (*Report, error) {
+	var label string
+	switch groupBy {
+	case GroupByTenant:
+		label = api.LabelTenant
+	case GroupByNamespace:
+		label = api.LabelNamespace
+	default:
+		return nil, ErrInvalidGroupBy
+	}
+
+	sheet, err := m.GetPriceSheet()
+	if err != nil {
+		return nil, err
+	}
+	vols, err := d.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	backupBytes, err := backupUsage(d)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]*Item)
+	for _, v := range vols {
+		group := groupOf(v, label)
+		item, ok := items[group]
+		if !ok {
+			item = &Item{Group: group}
+			items[group] = item
+		}
+		size := v.GetSpec().GetSize()
+		backup := backupBytes[v.GetId()]
+		item.Volumes++
+		item.ProvisionedBytes += size
+		item.CapacityCost += gib(size) * sheet.CapacityPrices[v.GetSpec().GetCos().SimpleString()]
+		item.BackupBytes += backup
+		item.BackupCost += gib(backup) * sheet.BackupPrice
+	}
+
+	report := &Report{
+		GroupBy:  groupBy,
+		Currency: sheet.Currency,
+		Time:     time.Now(),
+		Items:    make([]*Item, 0, len(items)),
+	}
+	for _, item := range items {
+		item.TotalCost = item.CapacityCost + item.BackupCost
+		report.TotalCost += item.TotalCost
+		report.Items = append(report.Items, item)
+	}
+	sort.Slice(report.Items, func(i, j int) bool {
+		return report.Items[i].Group < report.Items[j].Group
+	})
+	return report, nil
+}
+
+// backupUsage returns the bytes of completed cloud backups per volume.
+// Drivers without cloud backup support report no backup usage.
+func backupUsage(d volume.VolumeDriver) (map[string]uint64, error) {
+	usage := make(map[string]uint64)
+	resp, err := d.CloudBackupStatus(&api.CloudBackupStatusRequest{})
+	if err == volume.ErrNotSupported {
+		return usage, nil
+	} else if err != nil {
+		return nil, err
+	}
+	for _, status := range resp.Statuses {
+		if status.OpType != api.CloudBackupOp ||
+			status.Status != api.CloudBackupStatusDone {
+			continue
+		}
+		usage[status.SrcVolumeID] += status.BytesDone
+	}
+	return usage, nil
+}
+
+// groupOf returns the value of label on the volume locator or spec, or
+// Unassigned if the volume does not carry it.
+func groupOf(v *api.Volume, label string) string {
+	if group, ok := v.GetLocator().GetVolumeLabels()[label]; ok && len(group) != 0 {
+		return group
+	}
+	if group, ok := v.GetSpec().GetVolumeLabels()[label]; ok && len(group) != 0 {
+		return group
+	}
+	return Unassigned
+}
+
+func gib(bytes uint64) float64 {
+	return float64(bytes) / bytesPerGiB
+}
+
+func writeCSV(w io.Writer, r *Report) error {
+	cw := csv.NewWriter(w)
+	rows := [][]string{{
+		r.GroupBy, "volumes", "provisioned_bytes", "capacity_cost",
+		"backup_bytes", "backup_cost", "total_cost", "currency",
+	}}
+	for _, item := range r.Items {
+		rows = append(rows, []string{
+			item.Group,
+			strconv.Itoa(item.Volumes),
+			strconv.FormatUint(item.ProvisionedBytes, 10),
+			formatCost(item.CapacityCost),
+			strconv.FormatUint(item.BackupBytes, 10),
+			formatCost(item.BackupCost),
+			formatCost(item.TotalCost),
+			r.Currency,
+		})
+	}
+	rows = append(rows, []string{
+		"total", "", "", "", "", "", formatCost(r.TotalCost), r.Currency,
+	})
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+func formatCost(c float64) string {
+	return strconv.FormatFloat(c, 'f', 2, 64)
+}
@@ -0,0 +1,146 @@
+package cost
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const oneGiB = uint64(1 << 30)
+
+func newTestManager(t *testing.T) Manager {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	return NewManager(kv)
+}
+
+func testPriceSheet() *PriceSheet {
+	return &PriceSheet{
+		Currency: "USD",
+		CapacityPrices: map[string]float64{
+			"low":  0.05,
+			"high": 0.20,
+		},
+		BackupPrice: 0.02,
+	}
+}
+
+func testVolumes() []*api.Volume {
+	return []*api.Volume{
+		{
+			Id:      "vol1",
+			Locator: &api.VolumeLocator{VolumeLabels: map[string]string{api.LabelTenant: "acme"}},
+			Spec:    &api.VolumeSpec{Size: 100 * oneGiB, Cos: api.CosType_LOW},
+		},
+		{
+			Id:      "vol2",
+			Locator: &api.VolumeLocator{},
+			Spec: &api.VolumeSpec{
+				Size:         10 * oneGiB,
+				Cos:          api.CosType_HIGH,
+				VolumeLabels: map[string]string{api.LabelTenant: "acme"},
+			},
+		},
+		{
+			Id:      "vol3",
+			Locator: &api.VolumeLocator{},
+			Spec:    &api.VolumeSpec{Size: 50 * oneGiB, Cos: api.CosType_LOW},
+		},
+	}
+}
+
+func TestPriceSheet(t *testing.T) {
+	m := newTestManager(t)
+
+	_, err := m.GetPriceSheet()
+	assert.Equal(t, ErrNoPriceSheet, err)
+
+	require.NoError(t, m.SetPriceSheet(testPriceSheet()))
+	sheet, err := m.GetPriceSheet()
+	require.NoError(t, err)
+	assert.Equal(t, testPriceSheet(), sheet)
+}
+
+func TestReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+
+	m := newTestManager(t)
+	require.NoError(t, m.SetPriceSheet(testPriceSheet()))
+
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(testVolumes(), nil)
+	d.EXPECT().CloudBackupStatus(&api.CloudBackupStatusRequest{}).
+		Return(&api.CloudBackupStatusResponse{
+			Statuses: map[string]api.CloudBackupStatus{
+				"b1": {OpType: api.CloudBackupOp, Status: api.CloudBackupStatusDone,
+					SrcVolumeID: "vol1", BytesDone: 20 * oneGiB},
+				"b2": {OpType: api.CloudBackupOp, Status: api.CloudBackupStatusActive,
+					SrcVolumeID: "vol1", BytesDone: 5 * oneGiB},
+				"r1": {OpType: api.CloudRestoreOp, Status: api.CloudBackupStatusDone,
+					SrcVolumeID: "vol3", BytesDone: 5 * oneGiB},
+			},
+		}, nil)
+
+	report, err := m.Report(d, GroupByTenant)
+	require.NoError(t, err)
+	assert.Equal(t, "USD", report.Currency)
+	require.Len(t, report.Items, 2)
+
+	acme := report.Items[0]
+	assert.Equal(t, "acme", acme.Group)
+	assert.Equal(t, 2, acme.Volumes)
+	assert.Equal(t, 110*oneGiB, acme.ProvisionedBytes)
+	assert.InDelta(t, 7.0, acme.CapacityCost, 0.001)
+	assert.Equal(t, 20*oneGiB, acme.BackupBytes)
+	assert.InDelta(t, 0.4, acme.BackupCost, 0.001)
+	assert.InDelta(t, 7.4, acme.TotalCost, 0.001)
+
+	unassigned := report.Items[1]
+	assert.Equal(t, Unassigned, unassigned.Group)
+	assert.InDelta(t, 2.5, unassigned.TotalCost, 0.001)
+	assert.InDelta(t, 9.9, report.TotalCost, 0.001)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteCSV(&buf))
+	assert.Equal(t,
+		"tenant,volumes,provisioned_bytes,capacity_cost,backup_bytes,backup_cost,total_cost,currency\n"+
+			"acme,2,118111600640,7.00,21474836480,0.40,7.40,USD\n"+
+			"unassigned,1,53687091200,2.50,0,0.00,2.50,USD\n"+
+			"total,,,,,,9.90,USD\n",
+		buf.String())
+}
+
+func TestReportBackupNotSupported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+
+	m := newTestManager(t)
+	require.NoError(t, m.SetPriceSheet(testPriceSheet()))
+
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(testVolumes(), nil)
+	d.EXPECT().CloudBackupStatus(&api.CloudBackupStatusRequest{}).
+		Return(nil, volume.ErrNotSupported)
+
+	report, err := m.Report(d, GroupByNamespace)
+	require.NoError(t, err)
+	require.Len(t, report.Items, 1)
+	assert.Equal(t, Unassigned, report.Items[0].Group)
+	assert.Equal(t, 3, report.Items[0].Volumes)
+	assert.Equal(t, uint64(0), report.Items[0].BackupBytes)
+}
+
+func TestReportInvalidGroupBy(t *testing.T) {
+	m := newTestManager(t)
+	_, err := m.Report(nil, "node")
+	assert.Equal(t, ErrInvalidGroupBy, err)
+}
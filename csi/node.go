@@ -21,7 +21,9 @@ import (
 	"os"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/attachlimit"
 	"github.com/libopenstorage/openstorage/pkg/options"
+	"github.com/libopenstorage/openstorage/pkg/subpath"
 	"github.com/libopenstorage/openstorage/pkg/util"
 
 	csi "github.com/container-storage-interface/spec/lib/go/csi/v0"
@@ -44,6 +46,9 @@ func (s *OsdCsiServer) NodeGetInfo(
 	result := &csi.NodeGetInfoResponse{
 		NodeId: clus.NodeId,
 	}
+	if limiter, err := attachlimit.Inst(); err == nil {
+		result.MaxVolumesPerNode, _ = limiter.Slots()
+	}
 
 	return result, nil
 }
@@ -155,8 +160,12 @@ func (s *OsdCsiServer) NodePublishVolume(
 				err.Error())
 		}
 
-		// Mount volume onto the path
-		if err := s.driver.Mount(req.GetVolumeId(), req.GetTargetPath(), nil); err != nil {
+		// Mount volume, or its subpath, onto the path
+		var mountOpts map[string]string
+		if sub := req.GetVolumeAttributes()[subpath.Label]; len(sub) != 0 {
+			mountOpts = map[string]string{options.OptionsSubpath: sub}
+		}
+		if err := s.driver.Mount(req.GetVolumeId(), req.GetTargetPath(), mountOpts); err != nil {
 			// Detach on error
 			detachErr := s.driver.Detach(v.GetId(), opts)
 			if detachErr != nil {
@@ -0,0 +1,101 @@
+package eraser
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/audit"
+	"github.com/libopenstorage/openstorage/jobs"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// AuditAction is the audit log action recorded for an erasure
+	AuditAction = "volume.erase"
+)
+
+// Wrap returns the function wrapping a volume driver so that the volumes of
+// the pools erased by c are erased before they are deleted.
+func Wrap(c *Config) func(volume.VolumeDriver) volume.VolumeDriver {
+	return func(d volume.VolumeDriver) volume.VolumeDriver {
+		return &eraseDriver{VolumeDriver: d, config: c, submit: submit}
+	}
+}
+
+// eraseDriver is a volume driver erasing the volumes of some pools before
+// deleting them.
+type eraseDriver struct {
+	volume.VolumeDriver
+	config *Config
+	// submit runs f in the background to erase volumeID
+	submit func(volumeID string, f func() error) error
+}
+
+// submit submits f as a job, unless volumeID is being erased already.
+func submit(volumeID string, f func() error) error {
+	jm, err := jobs.Inst()
+	if err != nil {
+		return err
+	}
+	all, err := jm.Enumerate()
+	if err != nil {
+		return err
+	}
+	for _, job := range all {
+		if job.Type == JobType && job.ResourceId == volumeID && !job.State.Done() {
+			return nil
+		}
+	}
+	_, err = jm.Submit(JobType, volumeID, f)
+	return err
+}
+
+// Delete deletes the volumes of the pools not erased at once. The volumes
+// of the erased pools are erased and deleted by a job, keeping their space
+// until erased. The volumes wiped on delete are already erased by their
+// driver.
+// Errors ErrVolAttached may be returned.
+func (d *eraseDriver) Delete(volumeID string) error {
+	vols, err := d.Inspect([]string{volumeID})
+	if err != nil || len(vols) != 1 {
+		return d.VolumeDriver.Delete(volumeID)
+	}
+	v := vols[0]
+	method := d.config.method(v)
+	if _, ok := v.GetSpec().GetVolumeLabels()[api.SpecSecureDelete]; ok || len(method) == 0 {
+		return d.VolumeDriver.Delete(volumeID)
+	}
+	if v.GetState() == api.VolumeState_VOLUME_STATE_ATTACHED || len(v.GetAttachPath()) != 0 {
+		return volume.ErrVolAttached
+	}
+	return d.submit(volumeID, func() error {
+		return d.erase(volumeID, method)
+	})
+}
+
+// erase erases volumeID with method, deletes it and records the erasure in
+// the audit log.
+func (d *eraseDriver) erase(volumeID, method string) error {
+	cert, err := Erase(d.VolumeDriver, volumeID, method)
+	if err != nil {
+		return err
+	}
+	if err := d.VolumeDriver.Delete(volumeID); err != nil {
+		return err
+	}
+	auditLog, err := audit.Inst()
+	if err != nil {
+		logrus.WithField("pkg", "openstorage/eraser").
+			Infof("Erased %d bytes of volume %s", cert.BytesWiped, volumeID)
+		return nil
+	}
+	_, err = auditLog.Log(AuditAction, volumeID, map[string]string{
+		"method":      cert.Method,
+		"bytes_wiped": strconv.FormatUint(cert.BytesWiped, 10),
+		"start_time":  cert.StartTime.Format(time.RFC3339),
+		"end_time":    cert.EndTime.Format(time.RFC3339),
+	})
+	return err
+}
@@ -0,0 +1,180 @@
+// Package eraser zeroes the space of the deleted volumes before it is
+// released, so that the data of a tenant cannot be read back from the
+// volumes of another tenant reusing it. Erasing is configured per pool: the
+// volumes of an erased pool are overwritten with zeros, or discarded, by a
+// background job, and only deleted once erased.
+//
+// The volumes are erased through the driver API alone, attaching them and
+// erasing their block device, or mounting them and erasing their files for
+// the drivers without block devices, so that any driver can be erased.
+package eraser
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/wipe"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// JobType is the job type of the erasures
+	JobType = "erase"
+)
+
+// Config configures the pools whose volumes are erased once deleted.
+type Config struct {
+	// Driver is the volume driver owning the pools, the default driver if
+	// unset
+	Driver string `yaml:"driver"`
+	// Pools are the pools erased, the volumes of the other pools are
+	// deleted at once
+	Pools []PoolConfig `yaml:"pools"`
+}
+
+// PoolConfig configures the erasure of the volumes of a pool.
+type PoolConfig struct {
+	// Pool is the id of the pool
+	Pool int32 `yaml:"pool"`
+	// Method is api.SecureDeleteOverwrite or api.SecureDeleteDiscard. The
+	// files of the drivers without block devices cannot be discarded and are
+	// overwritten.
+	Method string `yaml:"method"`
+}
+
+// Enabled returns true if a pool is erased.
+func (c *Config) Enabled() bool {
+	return len(c.Pools) != 0
+}
+
+// Erases returns true if the volumes of the driver named name are erased,
+// defaultDriver being the default driver of the cluster.
+func (c *Config) Erases(name, defaultDriver string) bool {
+	if !c.Enabled() {
+		return false
+	}
+	if len(c.Driver) == 0 {
+		return name == defaultDriver
+	}
+	return name == c.Driver
+}
+
+// Validate checks the method of the pools and that no pool is configured
+// twice.
+func (c *Config) Validate() error {
+	pools := make(map[int32]bool)
+	for _, p := range c.Pools {
+		switch p.Method {
+		case api.SecureDeleteOverwrite, api.SecureDeleteDiscard:
+		default:
+			return fmt.Errorf("Unknown erase method %q of pool %d, must be %s or %s",
+				p.Method, p.Pool, api.SecureDeleteOverwrite, api.SecureDeleteDiscard)
+		}
+		if pools[p.Pool] {
+			return fmt.Errorf("Erasure of pool %d is configured twice", p.Pool)
+		}
+		pools[p.Pool] = true
+	}
+	return nil
+}
+
+// method returns the erase method of the pool of v, empty if it is not
+// erased.
+func (c *Config) method(v *api.Volume) string {
+	pool, err := Pool(v)
+	if err != nil {
+		logrus.WithField("pkg", "openstorage/eraser").
+			Warnf("Volume %s: %v", v.GetId(), err)
+		return ""
+	}
+	for _, p := range c.Pools {
+		if p.Pool == pool {
+			return p.Method
+		}
+	}
+	return ""
+}
+
+// Pool returns the pool of v set by its api.LabelPool label, of its locator
+// or of its spec, pool 0 if not set.
+func Pool(v *api.Volume) (int32, error) {
+	label, ok := v.GetLocator().GetVolumeLabels()[api.LabelPool]
+	if !ok {
+		label, ok = v.GetSpec().GetVolumeLabels()[api.LabelPool]
+	}
+	if !ok {
+		return 0, nil
+	}
+	pool, err := strconv.ParseInt(label, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid pool %q", label)
+	}
+	return int32(pool), nil
+}
+
+// Driver is the part of a volume driver attaching and mounting the volumes
+// erased.
+type Driver interface {
+	Attach(volumeID string, attachOptions map[string]string) (string, error)
+	Detach(volumeID string, options map[string]string) error
+	Mount(volumeID string, mountPath string, options map[string]string) error
+	Unmount(volumeID string, mountPath string, options map[string]string) error
+}
+
+// Erase erases the data of volumeID with method, api.SecureDeleteOverwrite
+// or api.SecureDeleteDiscard. The block device of the volume is zeroed or
+// discarded. The volume is mounted and its files are overwritten if the
+// driver does not support attaching it.
+func Erase(d Driver, volumeID, method string) (*api.WipeCertificate, error) {
+	cert := &api.WipeCertificate{
+		VolumeId:  volumeID,
+		Method:    method,
+		StartTime: time.Now(),
+	}
+	devicePath, err := d.Attach(volumeID, nil)
+	switch {
+	case err == volume.ErrNotSupported:
+		cert.Method = api.SecureDeleteOverwrite
+		cert.BytesWiped, err = overwriteFiles(d, volumeID)
+	case err != nil:
+		return nil, fmt.Errorf("Failed to attach volume %s: %v", volumeID, err)
+	default:
+		if method == api.SecureDeleteDiscard {
+			cert.BytesWiped, err = wipe.Discard(devicePath)
+		} else {
+			cert.BytesWiped, err = wipe.Zero(devicePath)
+		}
+		if detachErr := d.Detach(volumeID, nil); detachErr != nil {
+			logrus.WithField("pkg", "openstorage/eraser").
+				Warnf("Failed to detach volume %s: %v", volumeID, detachErr)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	cert.EndTime = time.Now()
+	return cert, nil
+}
+
+// overwriteFiles mounts volumeID on a temporary directory and overwrites its
+// files with zeros.
+func overwriteFiles(d Driver, volumeID string) (uint64, error) {
+	dir, err := ioutil.TempDir("", "eraser")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(dir)
+	if err := d.Mount(volumeID, dir, nil); err != nil {
+		return 0, fmt.Errorf("Failed to mount volume %s: %v", volumeID, err)
+	}
+	n, err := wipe.Overwrite(dir)
+	if unmountErr := d.Unmount(volumeID, dir, nil); unmountErr != nil && err == nil {
+		err = fmt.Errorf("Failed to unmount volume %s: %v", volumeID, unmountErr)
+	}
+	return n, err
+}
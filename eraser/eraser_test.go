@@ -0,0 +1,148 @@
+package eraser
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testVolumes are volumes of pool 1, of pool 0 and of pool 1 attached.
+var testVolumes = map[string]*api.Volume{
+	"files": {Id: "files", Spec: &api.VolumeSpec{
+		VolumeLabels: map[string]string{api.LabelPool: "1"},
+	}},
+	"default": {Id: "default"},
+	"attached": {
+		Id:      "attached",
+		State:   api.VolumeState_VOLUME_STATE_ATTACHED,
+		Locator: &api.VolumeLocator{VolumeLabels: map[string]string{api.LabelPool: "1"}},
+	},
+}
+
+// newTestDevice returns the path of a file standing for a block device.
+func newTestDevice(t *testing.T) string {
+	f, err := ioutil.TempFile("", "eraser")
+	require.NoError(t, err)
+	_, err = f.Write([]byte("block device"))
+	require.NoError(t, err)
+	f.Close()
+	t.Cleanup(func() {
+		os.Remove(f.Name())
+	})
+	return f.Name()
+}
+
+// expectMount expects volumeID, which cannot be attached, to be mounted
+// with files and unmounted. files is updated with their content at unmount.
+func expectMount(d *mock.MockVolumeDriver, volumeID string, files map[string][]byte) {
+	d.EXPECT().Attach(volumeID, nil).Return("", volume.ErrNotSupported)
+	d.EXPECT().Mount(volumeID, gomock.Any(), nil).
+		Do(func(volumeID, mountPath string, options map[string]string) {
+			for name, data := range files {
+				ioutil.WriteFile(filepath.Join(mountPath, name), data, 0644)
+			}
+		}).Return(nil)
+	d.EXPECT().Unmount(volumeID, gomock.Any(), nil).
+		Do(func(volumeID, mountPath string, options map[string]string) {
+			for name := range files {
+				files[name], _ = ioutil.ReadFile(filepath.Join(mountPath, name))
+			}
+		}).Return(nil)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, (&Config{Pools: []PoolConfig{
+		{Pool: 0, Method: api.SecureDeleteOverwrite},
+		{Pool: 1, Method: api.SecureDeleteDiscard},
+	}}).Validate())
+	assert.Error(t, (&Config{Pools: []PoolConfig{{Method: api.SecureDeleteCryptoErase}}}).Validate())
+	assert.Error(t, (&Config{Pools: []PoolConfig{
+		{Pool: 1, Method: api.SecureDeleteOverwrite},
+		{Pool: 1, Method: api.SecureDeleteDiscard},
+	}}).Validate())
+
+	c := &Config{Pools: []PoolConfig{{Method: api.SecureDeleteOverwrite}}}
+	assert.True(t, c.Erases("nfs", "nfs"))
+	assert.False(t, c.Erases("vfs", "nfs"))
+	c.Driver = "vfs"
+	assert.True(t, c.Erases("vfs", "nfs"))
+	assert.False(t, (&Config{}).Erases("nfs", "nfs"))
+}
+
+func TestPool(t *testing.T) {
+	pool, err := Pool(&api.Volume{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), pool)
+	pool, err = Pool(&api.Volume{Spec: &api.VolumeSpec{
+		VolumeLabels: map[string]string{api.LabelPool: "2"},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), pool)
+	_, err = Pool(&api.Volume{Locator: &api.VolumeLocator{
+		VolumeLabels: map[string]string{api.LabelPool: "fast"},
+	}})
+	assert.Error(t, err)
+}
+
+func TestErase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+
+	device := newTestDevice(t)
+	d.EXPECT().Attach("block", nil).Return(device, nil)
+	d.EXPECT().Detach("block", nil).Return(nil)
+	cert, err := Erase(d, "block", api.SecureDeleteOverwrite)
+	require.NoError(t, err)
+	assert.Equal(t, api.SecureDeleteOverwrite, cert.Method)
+	assert.Equal(t, uint64(12), cert.BytesWiped)
+	data, err := ioutil.ReadFile(device)
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, 12), data)
+
+	// files cannot be discarded and are overwritten
+	files := map[string][]byte{"secret": []byte("tenant data")}
+	expectMount(d, "files", files)
+	cert, err = Erase(d, "files", api.SecureDeleteDiscard)
+	require.NoError(t, err)
+	assert.Equal(t, api.SecureDeleteOverwrite, cert.Method)
+	assert.Equal(t, uint64(11), cert.BytesWiped)
+	assert.Equal(t, make([]byte, 11), files["secret"])
+}
+
+func TestDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mock.NewMockVolumeDriver(ctrl)
+	d := Wrap(&Config{Pools: []PoolConfig{{Pool: 1, Method: api.SecureDeleteOverwrite}}})(m).(*eraseDriver)
+	var submitted []string
+	d.submit = func(volumeID string, f func() error) error {
+		submitted = append(submitted, volumeID)
+		return f()
+	}
+
+	// the volumes of pool 0 are deleted at once
+	m.EXPECT().Inspect([]string{"default"}).Return([]*api.Volume{testVolumes["default"]}, nil)
+	m.EXPECT().Delete("default").Return(nil)
+	require.NoError(t, d.Delete("default"))
+	assert.Empty(t, submitted)
+
+	files := map[string][]byte{"secret": []byte("tenant data")}
+	m.EXPECT().Inspect([]string{"files"}).Return([]*api.Volume{testVolumes["files"]}, nil)
+	expectMount(m, "files", files)
+	m.EXPECT().Delete("files").Return(nil)
+	require.NoError(t, d.Delete("files"))
+	assert.Equal(t, []string{"files"}, submitted)
+	assert.Equal(t, make([]byte, 11), files["secret"])
+
+	m.EXPECT().Inspect([]string{"attached"}).Return([]*api.Volume{testVolumes["attached"]}, nil)
+	assert.Equal(t, volume.ErrVolAttached, d.Delete("attached"))
+}
@@ -0,0 +1,124 @@
+package eventbus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+	"github.com/libopenstorage/openstorage/pkg/redact"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Bus serializes events and publishes them asynchronously so that callers
+// are never blocked by the message bus.
+type Bus struct {
+	pub        Publisher
+	forwarders []Forwarder
+	topic      string
+	format     string
+	events     chan *Event
+}
+
+// New returns a bus that publishes events with pub, if not nil, and hands
+// them to each of the forwarders.
+func New(pub Publisher, c *Config, forwarders ...Forwarder) (*Bus, error) {
+	b := &Bus{
+		pub:        pub,
+		forwarders: forwarders,
+		topic:      c.Topic,
+		format:     c.Format,
+	}
+	if len(b.topic) == 0 {
+		b.topic = DefaultTopic
+	}
+	if len(b.format) == 0 {
+		b.format = FormatJSON
+	}
+	if b.format != FormatJSON && b.format != FormatProto {
+		return nil, ErrUnknownFormat
+	}
+	size := c.QueueSize
+	if size <= 0 {
+		size = DefaultQueueSize
+	}
+	b.events = make(chan *Event, size)
+	go b.run()
+	return b, nil
+}
+
+// Publish queues an event. The event is dropped if the queue is full.
+func (b *Bus) Publish(eventType, resourceID string, payload interface{}) {
+	b.PublishContext(context.Background(), eventType, resourceID, payload)
+}
+
+// PublishContext queues an event caused by the request of ctx, tagged with
+// its correlation id. The event is dropped if the queue is full.
+func (b *Bus) PublishContext(ctx context.Context, eventType, resourceID string, payload interface{}) {
+	e := &Event{
+		Id:            uuid.New(),
+		Time:          time.Now(),
+		Type:          eventType,
+		ResourceId:    resourceID,
+		Payload:       payload,
+		CorrelationId: correlation.FromContext(ctx),
+	}
+	select {
+	case b.events <- e:
+	default:
+		logrus.WithField("pkg", "openstorage/eventbus").
+			Warnf("event queue full, dropping %s event for %s", eventType, resourceID)
+	}
+}
+
+func (b *Bus) run() {
+	for e := range b.events {
+		for _, f := range b.forwarders {
+			if err := f.Forward(e); err != nil {
+				logrus.WithField("pkg", "openstorage/eventbus").
+					Errorf("failed to forward %s event for %s: %v", e.Type, e.ResourceId, err)
+			}
+		}
+		if b.pub == nil {
+			continue
+		}
+		data, err := Encode(e, b.format)
+		if err != nil {
+			logrus.WithField("pkg", "openstorage/eventbus").
+				Errorf("failed to encode %s event for %s: %v", e.Type, e.ResourceId, err)
+			continue
+		}
+		if err := b.pub.Publish(e.Topic(b.topic), e.ResourceId, data); err != nil {
+			logrus.WithField("pkg", "openstorage/eventbus").
+				Errorf("failed to publish %s event for %s: %v", e.Type, e.ResourceId, err)
+		}
+	}
+}
+
+// Encode serializes an event in the given format, without the secrets of
+// its payload such as the passphrase of a volume spec. The proto format
+// encodes the JSON form of the event as a google.protobuf.Struct.
+func Encode(e *Event, format string) ([]byte, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimSuffix(redact.JSON(data), []byte("\n"))
+	switch format {
+	case FormatJSON:
+		return data, nil
+	case FormatProto:
+		s := &structpb.Struct{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(data), s); err != nil {
+			return nil, err
+		}
+		return proto.Marshal(s)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
@@ -0,0 +1,135 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/redact"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type message struct {
+	topic string
+	key   string
+	data  []byte
+}
+
+type fakePublisher struct {
+	sync.Mutex
+	messages []message
+}
+
+func (p *fakePublisher) Publish(topic, key string, data []byte) error {
+	p.Lock()
+	defer p.Unlock()
+	p.messages = append(p.messages, message{topic: topic, key: key, data: data})
+	return nil
+}
+
+func (p *fakePublisher) wait(t *testing.T, n int) []message {
+	for i := 0; i < 100; i++ {
+		p.Lock()
+		if len(p.messages) >= n {
+			defer p.Unlock()
+			return p.messages
+		}
+		p.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected %d messages", n)
+	return nil
+}
+
+func TestTopic(t *testing.T) {
+	assert.Equal(t, "osd.volume", (&Event{Type: EventVolumeCreate}).Topic("osd"))
+	assert.Equal(t, "osd.alert", (&Event{Type: EventAlertRaise}).Topic("osd"))
+	assert.Equal(t, "osd.custom", (&Event{Type: "custom"}).Topic("osd"))
+}
+
+func TestEncode(t *testing.T) {
+	e := &Event{
+		Id:         "e1",
+		Type:       EventVolumeCreate,
+		ResourceId: "vol1",
+		Payload:    map[string]string{"name": "myvol"},
+	}
+
+	data, err := Encode(e, FormatJSON)
+	require.NoError(t, err)
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "vol1", decoded["ResourceId"])
+
+	data, err = Encode(e, FormatProto)
+	require.NoError(t, err)
+	s := &structpb.Struct{}
+	require.NoError(t, proto.Unmarshal(data, s))
+	assert.Equal(t, EventVolumeCreate, s.Fields["Type"].GetStringValue())
+	assert.Equal(t, "myvol",
+		s.Fields["Payload"].GetStructValue().Fields["name"].GetStringValue())
+
+	_, err = Encode(e, "xml")
+	assert.Equal(t, ErrUnknownFormat, err)
+}
+
+func TestBusPublish(t *testing.T) {
+	pub := &fakePublisher{}
+	b, err := New(pub, &Config{})
+	require.NoError(t, err)
+
+	b.Publish(EventVolumeCreate, "vol1", nil)
+	b.Publish(EventAuditRecord, "vol1", nil)
+
+	messages := pub.wait(t, 2)
+	assert.Equal(t, DefaultTopic+".volume", messages[0].topic)
+	assert.Equal(t, "vol1", messages[0].key)
+	assert.Equal(t, DefaultTopic+".audit", messages[1].topic)
+
+	var e Event
+	require.NoError(t, json.Unmarshal(messages[0].data, &e))
+	assert.Equal(t, EventVolumeCreate, e.Type)
+	assert.NotEmpty(t, e.Id)
+}
+
+func TestBusPublishRedacts(t *testing.T) {
+	pub := &fakePublisher{}
+	b, err := New(pub, &Config{})
+	require.NoError(t, err)
+
+	spec := &api.VolumeSpec{Size: 1024, Encrypted: true, Passphrase: "hunter2"}
+	b.Publish(EventVolumeCreate, "vol1", spec)
+	b.Publish(EventVolumeCreate, "vol2", map[string]string{"name": "myvol", api.SpecPassphrase: "hunter2"})
+
+	messages := pub.wait(t, 2)
+	for _, m := range messages {
+		assert.NotContains(t, string(m.data), "hunter2")
+	}
+	var e struct{ Payload api.VolumeSpec }
+	require.NoError(t, json.Unmarshal(messages[0].data, &e))
+	assert.Equal(t, uint64(1024), e.Payload.Size)
+	assert.True(t, e.Payload.Encrypted)
+	// the spec published is left as is
+	assert.Equal(t, "hunter2", spec.Passphrase)
+
+	data, err := Encode(&Event{Type: EventVolumeCreate, Payload: spec}, FormatProto)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "hunter2")
+	_, _, fields := describe(&Event{Payload: map[string]string{api.SpecPassphrase: "hunter2"}})
+	assert.Equal(t, redact.Mask, fields[api.SpecPassphrase])
+}
+
+func TestNewUnknownFormat(t *testing.T) {
+	_, err := New(&fakePublisher{}, &Config{Format: "xml"})
+	assert.Equal(t, ErrUnknownFormat, err)
+}
+
+func TestNewPublisherUnknownTransport(t *testing.T) {
+	_, err := NewPublisher(&Config{Transport: "amqp"})
+	assert.Equal(t, ErrUnknownTransport, err)
+}
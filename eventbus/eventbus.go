@@ -0,0 +1,215 @@
+// Package eventbus streams volume lifecycle events, alerts and audit records
+// to a Kafka or NATS message bus so that other systems can integrate with
+// openstorage asynchronously. Events can also be forwarded to syslog and
+// journald with structured fields, and alerts can be sent as SNMP traps.
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Event types
+const (
+	// EventVolumeCreate is published when a volume is created
+	EventVolumeCreate = "volume.create"
+	// EventVolumeDelete is published when a volume is deleted
+	EventVolumeDelete = "volume.delete"
+	// EventVolumeAttach is published when a volume is attached
+	EventVolumeAttach = "volume.attach"
+	// EventVolumeDetach is published when a volume is detached
+	EventVolumeDetach = "volume.detach"
+	// EventVolumeMount is published when a volume is mounted
+	EventVolumeMount = "volume.mount"
+	// EventVolumeUnmount is published when a volume is unmounted
+	EventVolumeUnmount = "volume.unmount"
+	// EventAlertRaise is published when an alert is raised or updated
+	EventAlertRaise = "alert.raise"
+	// EventAuditRecord is published when an audit record is logged
+	EventAuditRecord = "audit.record"
+	// EventNodeLabels is published when the labels of a node change
+	EventNodeLabels = "node.labels"
+	// EventPoolExpand is published when a device is added to a storage pool
+	EventPoolExpand = "pool.expand"
+	// EventNodePreempt is published when the instance of a node is about to
+	// be preempted
+	EventNodePreempt = "node.preempt"
+)
+
+const (
+	// TransportKafka publishes events through a Kafka REST proxy
+	TransportKafka = "kafka"
+	// TransportNATS publishes events to a NATS server
+	TransportNATS = "nats"
+
+	// FormatJSON serializes events as JSON
+	FormatJSON = "json"
+	// FormatProto serializes events as a google.protobuf.Struct
+	FormatProto = "proto"
+
+	// DefaultTopic is the topic prefix used when none is configured
+	DefaultTopic = "openstorage"
+	// DefaultQueueSize is the number of events buffered when none is configured
+	DefaultQueueSize = 1024
+)
+
+var (
+	// ErrUnknownTransport returned when the configured transport is not known
+	ErrUnknownTransport = errors.New("Unknown event bus transport, must be kafka or nats")
+	// ErrUnknownFormat returned when the configured format is not known
+	ErrUnknownFormat = errors.New("Unknown event bus format, must be json or proto")
+	// ErrInitialized returned when the event bus is initialized twice
+	ErrInitialized = errors.New("openstorage.eventbus: already initialized")
+
+	inst *Bus
+)
+
+// Event is a message published on the bus.
+// swagger:model
+type Event struct {
+	// Id uniquely identifies the event
+	Id string
+	// Time the event occurred
+	Time time.Time
+	// Type is one of the Event* values
+	Type string
+	// ResourceId is the id of the volume, node, pool, alert resource, or
+	// audit resource
+	ResourceId string
+	// Payload is the volume, node labels, pool, preemption notice, alert or
+	// audit record the event is about
+	Payload interface{} `json:",omitempty"`
+	// CorrelationId is the correlation id of the request which caused the
+	// event, if any
+	CorrelationId string `json:",omitempty"`
+}
+
+// Topic returns the topic the event is published on. Events are grouped
+// by category, the part of the type before the dot, so an event of type
+// volume.create is published on <prefix>.volume.
+func (e *Event) Topic(prefix string) string {
+	category := e.Type
+	if i := strings.Index(category, "."); i >= 0 {
+		category = category[:i]
+	}
+	return prefix + "." + category
+}
+
+// Publisher delivers serialized events to a message bus.
+type Publisher interface {
+	// Publish sends data with key to topic.
+	Publish(topic, key string, data []byte) error
+}
+
+// Config configures the event bus.
+type Config struct {
+	// Transport is one of the Transport* values, the event bus is disabled
+	// if unset
+	Transport string `yaml:"transport"`
+	// URL is the Kafka REST proxy URL, or the NATS server URL
+	URL string `yaml:"url"`
+	// Topic is the prefix of the topics events are published on,
+	// DefaultTopic if unset
+	Topic string `yaml:"topic"`
+	// Format is one of the Format* values, FormatJSON if unset
+	Format string `yaml:"format"`
+	// QueueSize is the number of events buffered before events are dropped,
+	// DefaultQueueSize if unset
+	QueueSize int `yaml:"queue_size"`
+	// Syslog forwards events to a syslog server in RFC 5424 format
+	Syslog SyslogConfig `yaml:"syslog"`
+	// Journald forwards events to the systemd journal
+	Journald bool `yaml:"journald"`
+	// SNMP sends SNMPv2c traps for alerts
+	SNMP SNMPConfig `yaml:"snmp"`
+}
+
+// Enabled returns true if a transport or a forwarder is configured.
+func (c *Config) Enabled() bool {
+	return len(c.Transport) != 0 || len(c.Syslog.Address) != 0 || c.Journald ||
+		len(c.SNMP.Address) != 0
+}
+
+// NewForwarders returns the configured forwarders.
+func NewForwarders(c *Config) ([]Forwarder, error) {
+	var forwarders []Forwarder
+	if len(c.Syslog.Address) != 0 {
+		f, err := NewSyslogForwarder(&c.Syslog)
+		if err != nil {
+			return nil, err
+		}
+		forwarders = append(forwarders, f)
+	}
+	if c.Journald {
+		f, err := NewJournaldForwarder("")
+		if err != nil {
+			return nil, err
+		}
+		forwarders = append(forwarders, f)
+	}
+	if len(c.SNMP.Address) != 0 {
+		f, err := NewSNMPForwarder(&c.SNMP)
+		if err != nil {
+			return nil, err
+		}
+		forwarders = append(forwarders, f)
+	}
+	return forwarders, nil
+}
+
+// NewPublisher returns the publisher for the configured transport.
+func NewPublisher(c *Config) (Publisher, error) {
+	switch c.Transport {
+	case TransportKafka:
+		return NewKafkaPublisher(c.URL)
+	case TransportNATS:
+		return NewNATSPublisher(c.URL)
+	default:
+		return nil, ErrUnknownTransport
+	}
+}
+
+// Init instantiates the process wide event bus.
+func Init(c *Config) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	var pub Publisher
+	if len(c.Transport) != 0 {
+		var err error
+		if pub, err = NewPublisher(c); err != nil {
+			return err
+		}
+	}
+	forwarders, err := NewForwarders(c)
+	if err != nil {
+		return err
+	}
+	b, err := New(pub, c, forwarders...)
+	if err != nil {
+		return err
+	}
+	inst = b
+	return nil
+}
+
+// Publish publishes an event on the process wide event bus. It does nothing
+// if the event bus has not been initialized.
+func Publish(eventType, resourceID string, payload interface{}) {
+	if inst == nil {
+		return
+	}
+	inst.Publish(eventType, resourceID, payload)
+}
+
+// PublishContext publishes an event caused by the request of ctx on the
+// process wide event bus, tagged with its correlation id. It does nothing if
+// the event bus has not been initialized.
+func PublishContext(ctx context.Context, eventType, resourceID string, payload interface{}) {
+	if inst == nil {
+		return
+	}
+	inst.PublishContext(ctx, eventType, resourceID, payload)
+}
@@ -0,0 +1,90 @@
+package eventbus
+
+import (
+	"strconv"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+	"github.com/libopenstorage/openstorage/pkg/redact"
+)
+
+// Syslog severities used for forwarded events, see RFC 5424 section 6.2.1.
+const (
+	severityCritical = 2
+	severityWarning  = 4
+	severityNotice   = 5
+	severityInfo     = 6
+)
+
+// Forwarder receives every event published on the bus, typically to hand it
+// to a local logging system with structured fields.
+type Forwarder interface {
+	// Forward delivers a single event.
+	Forward(e *Event) error
+}
+
+// Describer is implemented by event payloads that provide a human readable
+// message and structured fields for forwarders.
+type Describer interface {
+	// EventMessage returns a one line description of the payload.
+	EventMessage() string
+	// EventFields returns structured fields describing the payload.
+	EventFields() map[string]string
+}
+
+// describe returns the severity, message and structured fields of an
+// event. Field names are lower case and use underscores.
+func describe(e *Event) (int, string, map[string]string) {
+	severity := severityInfo
+	message := e.Type + " " + e.ResourceId
+	fields := map[string]string{
+		"event_id":    e.Id,
+		"event_type":  e.Type,
+		"resource_id": e.ResourceId,
+	}
+	if len(e.CorrelationId) != 0 {
+		fields[correlation.Key] = e.CorrelationId
+	}
+
+	switch p := e.Payload.(type) {
+	case *api.Alert:
+		severity = alertSeverity(p)
+		message = p.GetMessage()
+		fields["alert_type"] = strconv.FormatInt(p.GetAlertType(), 10)
+		fields["resource_type"] = p.GetResource().String()
+		fields["severity"] = p.GetSeverity().String()
+		if p.GetCleared() {
+			fields["cleared"] = "true"
+		}
+	case Describer:
+		if m := p.EventMessage(); len(m) != 0 {
+			message = m
+		}
+		for k, v := range redact.Map(p.EventFields()) {
+			fields[k] = v
+		}
+		severity = severityNotice
+	case map[string]string:
+		for k, v := range redact.Map(p) {
+			fields[k] = v
+		}
+		severity = severityNotice
+	default:
+		severity = severityNotice
+	}
+	return severity, message, fields
+}
+
+func alertSeverity(a *api.Alert) int {
+	if a.GetCleared() {
+		return severityInfo
+	}
+	switch a.GetSeverity() {
+	case api.SeverityType_SEVERITY_TYPE_ALARM:
+		return severityCritical
+	case api.SeverityType_SEVERITY_TYPE_WARNING:
+		return severityWarning
+	default:
+		return severityNotice
+	}
+}
@@ -0,0 +1,165 @@
+package eventbus
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAlertEvent() *Event {
+	return &Event{
+		Id:         "e1",
+		Time:       time.Date(2018, 7, 1, 10, 0, 0, 0, time.UTC),
+		Type:       EventAlertRaise,
+		ResourceId: "vol1",
+		Payload: &api.Alert{
+			AlertType:  7,
+			Severity:   api.SeverityType_SEVERITY_TYPE_ALARM,
+			Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: "vol1",
+			Message:    `volume "vol1" is degraded`,
+		},
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	severity, message, fields := describe(testAlertEvent())
+	assert.Equal(t, severityCritical, severity)
+	assert.Equal(t, `volume "vol1" is degraded`, message)
+	assert.Equal(t, "7", fields["alert_type"])
+	assert.Equal(t, "vol1", fields["resource_id"])
+
+	severity, message, fields = describe(&Event{
+		Type:       EventVolumeMount,
+		ResourceId: "vol1",
+		Payload:    map[string]string{"mount_path": "/mnt"},
+	})
+	assert.Equal(t, severityNotice, severity)
+	assert.Equal(t, "volume.mount vol1", message)
+	assert.Equal(t, "/mnt", fields["mount_path"])
+}
+
+func TestSyslogForwarder(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// octet counting framing: MSG-LEN SP SYSLOG-MSG
+		r := bufio.NewReader(conn)
+		length, err := r.ReadString(' ')
+		if err != nil {
+			return
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(length))
+		if err != nil {
+			return
+		}
+		buf := make([]byte, n)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return
+		}
+		received <- string(buf)
+	}()
+
+	f, err := NewSyslogForwarder(&SyslogConfig{
+		Network:  "tcp",
+		Address:  l.Addr().String(),
+		Facility: "local0",
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.Forward(testAlertEvent()))
+
+	msg := <-received
+	// local0 (16) * 8 + critical (2)
+	assert.True(t, strings.HasPrefix(msg, "<130>1 2018-07-01T10:00:00Z "))
+	assert.Contains(t, msg, " osd ")
+	assert.Contains(t, msg, " alert.raise [openstorage@32473 alert_type=\"7\" ")
+	assert.Contains(t, msg, `resource_id="vol1" resource_type="RESOURCE_TYPE_VOLUME"`)
+	assert.True(t, strings.HasSuffix(msg, `] volume "vol1" is degraded`))
+}
+
+func TestSyslogForwarderInvalidConfig(t *testing.T) {
+	_, err := NewSyslogForwarder(&SyslogConfig{})
+	assert.Error(t, err)
+	_, err = NewSyslogForwarder(&SyslogConfig{Address: "localhost:514", Facility: "mail2"})
+	assert.Error(t, err)
+	_, err = NewSyslogForwarder(&SyslogConfig{Address: "localhost:514", Network: "sctp"})
+	assert.Error(t, err)
+}
+
+func TestEscapeSDValue(t *testing.T) {
+	assert.Equal(t, `a\"b\\c\]`, escapeSDValue(`a"b\c]`))
+}
+
+func TestJournaldForwarder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journald")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "socket")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	f, err := NewJournaldForwarder(socket)
+	require.NoError(t, err)
+	e := testAlertEvent()
+	e.Payload.(*api.Alert).Message = "line1\nline2"
+	require.NoError(t, f.Forward(e))
+
+	buf := make([]byte, 4096)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	datagram := buf[:n]
+
+	var length bytes.Buffer
+	binary.Write(&length, binary.LittleEndian, uint64(len("line1\nline2")))
+	assert.True(t, bytes.HasPrefix(datagram, []byte("MESSAGE\n"+length.String()+"line1\nline2\n")))
+	assert.Contains(t, string(datagram), "PRIORITY=2\n")
+	assert.Contains(t, string(datagram), "SYSLOG_IDENTIFIER=osd\n")
+	assert.Contains(t, string(datagram), "OSD_ALERT_TYPE=7\n")
+	assert.Contains(t, string(datagram), "OSD_RESOURCE_ID=vol1\n")
+}
+
+func TestBusForward(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journald")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "socket")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	f, err := NewJournaldForwarder(socket)
+	require.NoError(t, err)
+	b, err := New(nil, &Config{}, f)
+	require.NoError(t, err)
+	b.Publish(EventVolumeDelete, "vol1", nil)
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buf := make([]byte, 4096)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	assert.Contains(t, string(buf[:n]), "OSD_EVENT_TYPE=volume.delete\n")
+}
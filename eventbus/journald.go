@@ -0,0 +1,100 @@
+package eventbus
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultJournalSocket is the socket of the systemd journal native protocol
+	DefaultJournalSocket = "/run/systemd/journal/socket"
+)
+
+// journaldForwarder writes events to the systemd journal using the native
+// protocol, so that every structured field is indexed by the journal.
+type journaldForwarder struct {
+	sync.Mutex
+	socket string
+	tag    string
+	conn   net.Conn
+}
+
+// NewJournaldForwarder returns a forwarder to the journal listening on
+// socket, DefaultJournalSocket if empty.
+func NewJournaldForwarder(socket string) (Forwarder, error) {
+	if len(socket) == 0 {
+		socket = DefaultJournalSocket
+	}
+	return &journaldForwarder{socket: socket, tag: DefaultSyslogTag}, nil
+}
+
+func (f *journaldForwarder) Forward(e *Event) error {
+	msg := f.format(e)
+
+	f.Lock()
+	defer f.Unlock()
+	if f.conn == nil {
+		conn, err := net.Dial("unixgram", f.socket)
+		if err != nil {
+			return err
+		}
+		f.conn = conn
+	}
+	if _, err := f.conn.Write(msg); err != nil {
+		f.conn.Close()
+		f.conn = nil
+		return err
+	}
+	return nil
+}
+
+// format returns the native protocol datagram for an event. Structured
+// fields are prefixed with OSD_ and upper cased as required by the journal.
+func (f *journaldForwarder) format(e *Event) []byte {
+	severity, message, fields := describe(e)
+
+	var buf bytes.Buffer
+	writeJournalField(&buf, "MESSAGE", message)
+	writeJournalField(&buf, "PRIORITY", strconv.Itoa(severity))
+	writeJournalField(&buf, "SYSLOG_IDENTIFIER", f.tag)
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		writeJournalField(&buf, journalFieldName(k), fields[k])
+	}
+	return buf.Bytes()
+}
+
+// writeJournalField writes KEY=value, or the binary form for values that
+// contain a newline.
+func writeJournalField(buf *bytes.Buffer, key, value string) {
+	if !strings.Contains(value, "\n") {
+		buf.WriteString(key + "=" + value + "\n")
+		return
+	}
+	buf.WriteString(key + "\n")
+	binary.Write(buf, binary.LittleEndian, uint64(len(value)))
+	buf.WriteString(value + "\n")
+}
+
+func journalFieldName(name string) string {
+	var b strings.Builder
+	b.WriteString("OSD_")
+	for _, c := range strings.ToUpper(name) {
+		if (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package eventbus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	kafkaTimeout     = 30 * time.Second
+	kafkaContentType = "application/vnd.kafka.binary.v2+json"
+)
+
+// kafkaPublisher produces messages through a Kafka REST proxy.
+type kafkaPublisher struct {
+	url    string
+	client *http.Client
+}
+
+// kafkaRecord is a record of a Kafka REST proxy binary produce request.
+// Keys and values are base64 encoded by encoding/json.
+type kafkaRecord struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// NewKafkaPublisher returns a publisher that produces messages to the
+// Kafka REST proxy at proxyURL.
+func NewKafkaPublisher(proxyURL string) (Publisher, error) {
+	if len(proxyURL) == 0 {
+		return nil, fmt.Errorf("Kafka event bus requires a url")
+	}
+	return &kafkaPublisher{
+		url:    strings.TrimSuffix(proxyURL, "/"),
+		client: &http.Client{Timeout: kafkaTimeout},
+	}, nil
+}
+
+func (p *kafkaPublisher) Publish(topic, key string, data []byte) error {
+	body, err := json.Marshal(struct {
+		Records []kafkaRecord `json:"records"`
+	}{Records: []kafkaRecord{{Key: []byte(key), Value: data}}})
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Post(p.url+"/topics/"+topic, kafkaContentType, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Kafka REST proxy returned %s for topic %s", resp.Status, topic)
+	}
+	return nil
+}
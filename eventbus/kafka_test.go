@@ -0,0 +1,41 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaPublish(t *testing.T) {
+	var got struct {
+		Records []kafkaRecord `json:"records"`
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/osd.volume", r.URL.Path)
+		assert.Equal(t, kafkaContentType, r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer ts.Close()
+
+	pub, err := NewPublisher(&Config{Transport: TransportKafka, URL: ts.URL + "/"})
+	require.NoError(t, err)
+	require.NoError(t, pub.Publish("osd.volume", "vol1", []byte("data")))
+	require.Len(t, got.Records, 1)
+	assert.Equal(t, "vol1", string(got.Records[0].Key))
+	assert.Equal(t, "data", string(got.Records[0].Value))
+}
+
+func TestKafkaPublishError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	pub, err := NewKafkaPublisher(ts.URL)
+	require.NoError(t, err)
+	assert.Error(t, pub.Publish("osd.volume", "vol1", []byte("data")))
+}
@@ -0,0 +1,118 @@
+package eventbus
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	natsDialTimeout = 10 * time.Second
+	natsDefaultPort = "4222"
+)
+
+// natsPublisher publishes messages to a NATS server using the NATS client
+// protocol. The connection is established on first use and re-established
+// after a write error.
+type natsPublisher struct {
+	sync.Mutex
+	addr string
+	conn net.Conn
+}
+
+// NewNATSPublisher returns a publisher for the NATS server at serverURL,
+// for example nats://localhost:4222.
+func NewNATSPublisher(serverURL string) (Publisher, error) {
+	if len(serverURL) == 0 {
+		return nil, fmt.Errorf("NATS event bus requires a url")
+	}
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+	addr := u.Host
+	if len(addr) == 0 {
+		addr = serverURL
+	}
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, natsDefaultPort)
+	}
+	return &natsPublisher{addr: addr}, nil
+}
+
+// Publish sends data to the subject topic. NATS messages have no key, so
+// the key is ignored.
+func (p *natsPublisher) Publish(topic, key string, data []byte) error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.conn == nil {
+		if err := p.connect(); err != nil {
+			return err
+		}
+	}
+	msg := fmt.Sprintf("PUB %s %d\r\n%s\r\n", topic, len(data), data)
+	if _, err := p.conn.Write([]byte(msg)); err != nil {
+		p.conn.Close()
+		p.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (p *natsPublisher) connect() error {
+	conn, err := net.DialTimeout("tcp", p.addr, natsDialTimeout)
+	if err != nil {
+		return err
+	}
+	r := bufio.NewReader(conn)
+	conn.SetReadDeadline(time.Now().Add(natsDialTimeout))
+	info, err := r.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	if !strings.HasPrefix(info, "INFO") {
+		conn.Close()
+		return fmt.Errorf("Unexpected NATS greeting: %q", strings.TrimSpace(info))
+	}
+	conn.SetReadDeadline(time.Time{})
+	if _, err := conn.Write([]byte("CONNECT {\"verbose\":false,\"pedantic\":false,\"name\":\"openstorage\"}\r\n")); err != nil {
+		conn.Close()
+		return err
+	}
+	p.conn = conn
+	go p.serve(conn, r)
+	return nil
+}
+
+// serve answers server pings so that the server keeps the connection open.
+func (p *natsPublisher) serve(conn net.Conn, r *bufio.Reader) {
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			p.Lock()
+			if p.conn == conn {
+				p.conn.Close()
+				p.conn = nil
+			}
+			p.Unlock()
+			return
+		}
+		switch {
+		case strings.HasPrefix(line, "PING"):
+			p.Lock()
+			conn.Write([]byte("PONG\r\n"))
+			p.Unlock()
+		case strings.HasPrefix(line, "-ERR"):
+			logrus.WithField("pkg", "openstorage/eventbus").
+				Warnf("NATS server error: %s", strings.TrimSpace(line))
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package eventbus
+
+import (
+	"bufio"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNATSPublish(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	lines := make(chan string, 10)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.Write([]byte("INFO {\"server_id\":\"test\"}\r\n"))
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				close(lines)
+				return
+			}
+			lines <- line
+		}
+	}()
+
+	pub, err := NewPublisher(&Config{Transport: TransportNATS, URL: "nats://" + l.Addr().String()})
+	require.NoError(t, err)
+	require.NoError(t, pub.Publish("osd.volume", "vol1", []byte("data")))
+
+	assert.Contains(t, <-lines, "CONNECT ")
+	assert.Equal(t, "PUB osd.volume 4\r\n", <-lines)
+	assert.Equal(t, "data\r\n", <-lines)
+}
+
+func TestNATSDefaultPort(t *testing.T) {
+	pub, err := NewNATSPublisher("nats://localhost")
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:"+natsDefaultPort, pub.(*natsPublisher).addr)
+}
@@ -0,0 +1,220 @@
+package eventbus
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// OIDs of the OPENSTORAGE-ALERT-MIB, see etc/mibs/OPENSTORAGE-ALERT-MIB.txt.
+const (
+	// SNMPRootOID is the OID of the openstorageAlertMIB module
+	SNMPRootOID = "1.3.6.1.3.7373"
+	// snmpObjectsOID is the parent of the notification varbind objects
+	snmpObjectsOID = SNMPRootOID + ".1"
+	// SNMPAlertRaisedOID is the osdAlertRaised notification
+	SNMPAlertRaisedOID = SNMPRootOID + ".2.1"
+	// SNMPAlertClearedOID is the osdAlertCleared notification
+	SNMPAlertClearedOID = SNMPRootOID + ".2.2"
+
+	sysUpTimeOID    = "1.3.6.1.2.1.1.3.0"
+	snmpTrapOIDOID  = "1.3.6.1.6.3.1.1.4.1.0"
+	defaultSNMPPort = "162"
+	// DefaultSNMPCommunity is the community used when none is configured
+	DefaultSNMPCommunity = "public"
+)
+
+// BER tags used by SNMPv2c traps.
+const (
+	berInteger     = 0x02
+	berOctetString = 0x04
+	berOID         = 0x06
+	berSequence    = 0x30
+	berTimeTicks   = 0x43
+	berTrapV2      = 0xa7
+	snmpVersion2c  = 1
+)
+
+// SNMPConfig configures SNMPv2c traps for alerts.
+type SNMPConfig struct {
+	// Address of the trap receiver, traps are disabled if unset. The port
+	// defaults to 162.
+	Address string `yaml:"address"`
+	// Community string, DefaultSNMPCommunity if unset
+	Community string `yaml:"community"`
+}
+
+// snmpForwarder sends an SNMPv2c trap for every alert event and ignores
+// the other events.
+type snmpForwarder struct {
+	sync.Mutex
+	address   string
+	community string
+	start     time.Time
+	conn      net.Conn
+}
+
+// NewSNMPForwarder returns a forwarder sending traps to the configured
+// trap receiver.
+func NewSNMPForwarder(c *SNMPConfig) (Forwarder, error) {
+	if len(c.Address) == 0 {
+		return nil, fmt.Errorf("SNMP traps require an address")
+	}
+	address := c.Address
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, defaultSNMPPort)
+	}
+	community := c.Community
+	if len(community) == 0 {
+		community = DefaultSNMPCommunity
+	}
+	return &snmpForwarder{
+		address:   address,
+		community: community,
+		start:     time.Now(),
+	}, nil
+}
+
+func (f *snmpForwarder) Forward(e *Event) error {
+	alert, ok := e.Payload.(*api.Alert)
+	if !ok {
+		return nil
+	}
+	msg := f.trap(alert)
+
+	f.Lock()
+	defer f.Unlock()
+	if f.conn == nil {
+		conn, err := net.Dial("udp", f.address)
+		if err != nil {
+			return err
+		}
+		f.conn = conn
+	}
+	if _, err := f.conn.Write(msg); err != nil {
+		f.conn.Close()
+		f.conn = nil
+		return err
+	}
+	return nil
+}
+
+// trap encodes an SNMPv2-Trap-PDU for an alert as described in RFC 3416.
+func (f *snmpForwarder) trap(a *api.Alert) []byte {
+	trapOID := SNMPAlertRaisedOID
+	if a.GetCleared() {
+		trapOID = SNMPAlertClearedOID
+	}
+	timestamp := ""
+	if t, err := ptypes.Timestamp(a.GetTimestamp()); err == nil {
+		timestamp = t.UTC().Format(time.RFC3339)
+	}
+	uptime := uint32(time.Since(f.start) / (10 * time.Millisecond))
+
+	varbinds := [][]byte{
+		varbind(sysUpTimeOID, berUint(berTimeTicks, uptime)),
+		varbind(snmpTrapOIDOID, berObjectID(trapOID)),
+		varbind(snmpObjectsOID+".1.0", berString(strconv.FormatInt(a.GetId(), 10))),
+		varbind(snmpObjectsOID+".2.0", berInt(a.GetAlertType())),
+		varbind(snmpObjectsOID+".3.0", berInt(int64(a.GetSeverity()))),
+		varbind(snmpObjectsOID+".4.0", berInt(int64(a.GetResource()))),
+		varbind(snmpObjectsOID+".5.0", berString(a.GetResourceId())),
+		varbind(snmpObjectsOID+".6.0", berString(a.GetMessage())),
+		varbind(snmpObjectsOID+".7.0", berInt(a.GetCount())),
+		varbind(snmpObjectsOID+".8.0", berString(timestamp)),
+	}
+	pdu := ber(berTrapV2,
+		berInt(int64(rand.Int31())),
+		berInt(0),
+		berInt(0),
+		ber(berSequence, varbinds...),
+	)
+	return ber(berSequence,
+		berInt(snmpVersion2c),
+		berString(f.community),
+		pdu,
+	)
+}
+
+func varbind(oid string, value []byte) []byte {
+	return ber(berSequence, berObjectID(oid), value)
+}
+
+// ber returns a TLV with the concatenation of contents as value.
+func ber(tag byte, contents ...[]byte) []byte {
+	var value []byte
+	for _, c := range contents {
+		value = append(value, c...)
+	}
+	out := []byte{tag}
+	out = append(out, berLength(len(value))...)
+	return append(out, value...)
+}
+
+func berLength(n int) []byte {
+	if n < 0x80 {
+		return []byte{byte(n)}
+	}
+	var b []byte
+	for ; n > 0; n >>= 8 {
+		b = append([]byte{byte(n)}, b...)
+	}
+	return append([]byte{0x80 | byte(len(b))}, b...)
+}
+
+// berInt encodes a two's complement INTEGER in the minimum number of bytes.
+func berInt(v int64) []byte {
+	b := []byte{byte(v)}
+	for v > 127 || v < -128 {
+		v >>= 8
+		b = append([]byte{byte(v)}, b...)
+	}
+	return ber(berInteger, b)
+}
+
+// berUint encodes an unsigned application type such as TimeTicks.
+func berUint(tag byte, v uint32) []byte {
+	b := []byte{byte(v)}
+	for v >>= 8; v > 0; v >>= 8 {
+		b = append([]byte{byte(v)}, b...)
+	}
+	if b[0]&0x80 != 0 {
+		b = append([]byte{0}, b...)
+	}
+	return ber(tag, b)
+}
+
+func berString(s string) []byte {
+	return ber(berOctetString, []byte(s))
+}
+
+// berObjectID encodes a dotted OID. The first two arcs are combined into a
+// single sub-identifier and each sub-identifier uses base 128.
+func berObjectID(oid string) []byte {
+	arcs := strings.Split(oid, ".")
+	ids := make([]uint64, 0, len(arcs))
+	for _, arc := range arcs {
+		id, _ := strconv.ParseUint(arc, 10, 32)
+		ids = append(ids, id)
+	}
+	if len(ids) < 2 {
+		return ber(berOID)
+	}
+	var b []byte
+	sub := append([]uint64{ids[0]*40 + ids[1]}, ids[2:]...)
+	for _, id := range sub {
+		enc := []byte{byte(id & 0x7f)}
+		for id >>= 7; id > 0; id >>= 7 {
+			enc = append([]byte{byte(id&0x7f) | 0x80}, enc...)
+		}
+		b = append(b, enc...)
+	}
+	return ber(berOID, b)
+}
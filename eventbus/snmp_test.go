@@ -0,0 +1,94 @@
+package eventbus
+
+import (
+	"encoding/asn1"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type snmpMessage struct {
+	Version   int
+	Community []byte
+	PDU       asn1.RawValue
+}
+
+type snmpVarbind struct {
+	OID   asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+func parseOID(t *testing.T, oid string) asn1.ObjectIdentifier {
+	var parsed asn1.ObjectIdentifier
+	_, err := asn1.Unmarshal(berObjectID(oid), &parsed)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestBERInt(t *testing.T) {
+	for _, v := range []int64{0, 1, 127, 128, 255, 256, -1, -128, -129, 1 << 40} {
+		var decoded int64
+		_, err := asn1.Unmarshal(berInt(v), &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, v, decoded)
+	}
+}
+
+func TestBERObjectID(t *testing.T) {
+	assert.Equal(t, asn1.ObjectIdentifier{1, 3, 6, 1, 3, 7373, 2, 1},
+		parseOID(t, SNMPAlertRaisedOID))
+}
+
+func TestSNMPForwarder(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	f, err := NewSNMPForwarder(&SNMPConfig{Address: conn.LocalAddr().String(), Community: "osd"})
+	require.NoError(t, err)
+
+	// Events that are not alerts do not send traps
+	require.NoError(t, f.Forward(&Event{Type: EventVolumeCreate, ResourceId: "vol1"}))
+	require.NoError(t, f.Forward(testAlertEvent()))
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buf := make([]byte, 4096)
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+
+	var msg snmpMessage
+	_, err = asn1.Unmarshal(buf[:n], &msg)
+	require.NoError(t, err)
+	assert.Equal(t, snmpVersion2c, msg.Version)
+	assert.Equal(t, "osd", string(msg.Community))
+	assert.Equal(t, asn1.ClassContextSpecific, msg.PDU.Class)
+	assert.Equal(t, 7, msg.PDU.Tag)
+
+	rest := msg.PDU.Bytes
+	var requestID, errorStatus, errorIndex int
+	for _, v := range []*int{&requestID, &errorStatus, &errorIndex} {
+		rest, err = asn1.Unmarshal(rest, v)
+		require.NoError(t, err)
+	}
+	var varbinds []snmpVarbind
+	_, err = asn1.Unmarshal(rest, &varbinds)
+	require.NoError(t, err)
+	require.Len(t, varbinds, 10)
+
+	assert.Equal(t, parseOID(t, snmpTrapOIDOID), varbinds[1].OID)
+	var trapOID asn1.ObjectIdentifier
+	_, err = asn1.Unmarshal(varbinds[1].Value.FullBytes, &trapOID)
+	require.NoError(t, err)
+	assert.Equal(t, parseOID(t, SNMPAlertRaisedOID), trapOID)
+
+	assert.Equal(t, parseOID(t, snmpObjectsOID+".3.0"), varbinds[4].OID)
+	var severity int
+	_, err = asn1.Unmarshal(varbinds[4].Value.FullBytes, &severity)
+	require.NoError(t, err)
+	assert.Equal(t, int(api.SeverityType_SEVERITY_TYPE_ALARM), severity)
+	assert.Equal(t, `volume "vol1" is degraded`, string(varbinds[7].Value.Bytes))
+}
@@ -0,0 +1,160 @@
+package eventbus
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultSyslogTag is the APP-NAME of forwarded syslog messages
+	DefaultSyslogTag = "osd"
+	// syslogSDID is the SD-ID of the structured data element. 32473 is the
+	// private enterprise number reserved for documentation by RFC 5612.
+	syslogSDID        = "openstorage@32473"
+	syslogDialTimeout = 10 * time.Second
+)
+
+var syslogFacilities = map[string]int{
+	"kern":   0,
+	"user":   1,
+	"daemon": 3,
+	"auth":   4,
+	"local0": 16,
+	"local1": 17,
+	"local2": 18,
+	"local3": 19,
+	"local4": 20,
+	"local5": 21,
+	"local6": 22,
+	"local7": 23,
+}
+
+// SyslogConfig configures forwarding of events to a syslog server.
+type SyslogConfig struct {
+	// Network is udp, tcp or unix
+	Network string `yaml:"network"`
+	// Address of the syslog server, forwarding is disabled if unset
+	Address string `yaml:"address"`
+	// Facility is the syslog facility name, daemon if unset
+	Facility string `yaml:"facility"`
+	// Tag is the APP-NAME of the messages, DefaultSyslogTag if unset
+	Tag string `yaml:"tag"`
+}
+
+// syslogForwarder writes events as RFC 5424 messages. Messages sent over
+// tcp are framed with octet counting as described in RFC 6587.
+type syslogForwarder struct {
+	sync.Mutex
+	network  string
+	address  string
+	facility int
+	tag      string
+	hostname string
+	conn     net.Conn
+}
+
+// NewSyslogForwarder returns a forwarder to the configured syslog server.
+func NewSyslogForwarder(c *SyslogConfig) (Forwarder, error) {
+	f := &syslogForwarder{
+		network:  c.Network,
+		address:  c.Address,
+		facility: syslogFacilities["daemon"],
+		tag:      c.Tag,
+	}
+	if len(f.address) == 0 {
+		return nil, fmt.Errorf("Syslog forwarding requires an address")
+	}
+	if len(f.network) == 0 {
+		f.network = "udp"
+	}
+	if f.network != "udp" && f.network != "tcp" && f.network != "unix" {
+		return nil, fmt.Errorf("Unknown syslog network %q, must be udp, tcp or unix", f.network)
+	}
+	if len(c.Facility) != 0 {
+		facility, ok := syslogFacilities[c.Facility]
+		if !ok {
+			return nil, fmt.Errorf("Unknown syslog facility %q", c.Facility)
+		}
+		f.facility = facility
+	}
+	if len(f.tag) == 0 {
+		f.tag = DefaultSyslogTag
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		f.hostname = hostname
+	} else {
+		f.hostname = "-"
+	}
+	return f, nil
+}
+
+func (f *syslogForwarder) Forward(e *Event) error {
+	msg := f.format(e)
+	if f.network == "tcp" {
+		msg = strconv.Itoa(len(msg)) + " " + msg
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	if f.conn == nil {
+		conn, err := net.DialTimeout(f.network, f.address, syslogDialTimeout)
+		if err != nil {
+			return err
+		}
+		f.conn = conn
+	}
+	if _, err := f.conn.Write([]byte(msg)); err != nil {
+		f.conn.Close()
+		f.conn = nil
+		return err
+	}
+	return nil
+}
+
+// format returns the RFC 5424 message for an event:
+// <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID [SD-ELEMENT] MSG
+func (f *syslogForwarder) format(e *Event) string {
+	severity, message, fields := describe(e)
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	sd := "[" + syslogSDID
+	for _, k := range keys {
+		sd += " " + k + "=\"" + escapeSDValue(fields[k]) + "\""
+	}
+	sd += "]"
+
+	return fmt.Sprintf("<%d>1 %s %s %s %d %s %s %s",
+		f.facility*8+severity,
+		e.Time.UTC().Format(time.RFC3339Nano),
+		f.hostname,
+		f.tag,
+		os.Getpid(),
+		msgID(e.Type),
+		sd,
+		message)
+}
+
+// msgID returns an RFC 5424 MSGID, printable US-ASCII of at most 32 bytes.
+func msgID(eventType string) string {
+	if len(eventType) == 0 {
+		return "-"
+	}
+	if len(eventType) > 32 {
+		return eventType[:32]
+	}
+	return eventType
+}
+
+func escapeSDValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(v)
+}
@@ -0,0 +1,85 @@
+// Package freeze freezes the operations of a whole cluster, such as during a
+// maintenance, a kvdb migration or an incident. The state is persisted in
+// kvdb and watched by every node, whose API servers reject the requests
+// which modify state while the cluster is frozen and keep serving reads.
+package freeze
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrNotInitialized returned when the freeze manager has not been initialized
+	ErrNotInitialized = errors.New("openstorage.freeze: not initialized")
+	// ErrInitialized returned when the freeze manager is initialized twice
+	ErrInitialized = errors.New("openstorage.freeze: already initialized")
+	// ErrFrozen returned by the API servers for writes while the cluster is
+	// frozen, wrapped in a *FrozenError with the freeze
+	ErrFrozen = errors.New("cluster operations are frozen, the API is read only")
+
+	inst *Manager
+	// Inst returns the freeze manager singleton.
+	// This function can be overridden for testing purposes
+	Inst = func() (*Manager, error) {
+		return freezeInst()
+	}
+)
+
+// State is the freeze state of the cluster.
+// swagger:model
+type State struct {
+	// Frozen is true if the operations which modify state are rejected
+	Frozen bool
+	// Reason the cluster was frozen
+	Reason string
+	// Principal who froze or thawed the cluster
+	Principal string
+	// Time the cluster was frozen or thawed
+	Time time.Time
+}
+
+// FrozenError is returned for the operations rejected while the cluster is
+// frozen.
+type FrozenError struct {
+	State *State
+}
+
+func (e *FrozenError) Error() string {
+	msg := fmt.Sprintf("%v since %s by %s", ErrFrozen, e.State.Time.UTC().Format(time.RFC3339), e.State.Principal)
+	if len(e.State.Reason) != 0 {
+		msg += ": " + e.State.Reason
+	}
+	return msg
+}
+
+// Init sets the freeze manager singleton.
+func Init(m *Manager) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = m
+	return nil
+}
+
+func freezeInst() (*Manager, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// Check returns a *FrozenError if the cluster is frozen, so that the
+// operations which modify state are rejected. It returns nil if the manager
+// has not been initialized.
+func Check() error {
+	m, err := Inst()
+	if err != nil {
+		return nil
+	}
+	if s := m.Cached(); s.Frozen {
+		return &FrozenError{State: s}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package freeze
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const stateKey = "freeze/state"
+
+// Manager freezes and thaws the cluster, and tracks its freeze state for
+// the API servers of this node.
+type Manager struct {
+	kv kvdb.Kvdb
+
+	lock   sync.RWMutex
+	cached *State
+}
+
+// NewManager returns the freeze manager of the cluster state in kv.
+func NewManager(kv kvdb.Kvdb) *Manager {
+	return &Manager{
+		kv:     kv,
+		cached: &State{},
+	}
+}
+
+// State returns the freeze state of the cluster read from kvdb, thawed if
+// it was never frozen.
+func (m *Manager) State() (*State, error) {
+	kvp, err := m.kv.Get(stateKey)
+	if err == kvdb.ErrNotFound {
+		return &State{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var s State
+	if err := json.Unmarshal(kvp.Value, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Cached returns the freeze state of the cluster last seen by this node,
+// without reading kvdb.
+func (m *Manager) Cached() *State {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.cached
+}
+
+// Freeze freezes the operations of the cluster for reason, as requested by
+// principal.
+func (m *Manager) Freeze(reason, principal string) (*State, error) {
+	return m.set(&State{Frozen: true, Reason: reason, Principal: principal, Time: time.Now()})
+}
+
+// Thaw resumes the operations of the cluster, as requested by principal.
+func (m *Manager) Thaw(principal string) (*State, error) {
+	return m.set(&State{Principal: principal, Time: time.Now()})
+}
+
+func (m *Manager) set(s *State) (*State, error) {
+	if _, err := m.kv.Put(stateKey, s, 0); err != nil {
+		return nil, err
+	}
+	m.cache(s)
+	return s, nil
+}
+
+func (m *Manager) cache(s *State) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if s.Frozen != m.cached.Frozen {
+		entry := logrus.WithField("pkg", "openstorage/freeze").WithField("principal", s.Principal)
+		if s.Frozen {
+			entry.Warnf("Cluster operations frozen: %s", s.Reason)
+		} else {
+			entry.Infof("Cluster operations thawed")
+		}
+	}
+	m.cached = s
+}
+
+// Start loads the freeze state of the cluster and keeps it up to date as
+// other nodes freeze and thaw the cluster. A node booting while the
+// cluster is frozen rejects writes until the cluster is thawed.
+func (m *Manager) Start() error {
+	s, err := m.State()
+	if err != nil {
+		return err
+	}
+	m.cache(s)
+	return m.kv.WatchKey(stateKey, 0, nil, m.watch)
+}
+
+func (m *Manager) watch(key string, opaque interface{}, kvp *kvdb.KVPair, watchErr error) error {
+	if watchErr != nil {
+		logrus.WithField("pkg", "openstorage/freeze").
+			Errorf("Stopped watching the cluster freeze state: %v", watchErr)
+		return watchErr
+	}
+	if kvp == nil {
+		return nil
+	}
+	if kvp.Action == kvdb.KVDelete {
+		m.cache(&State{})
+		return nil
+	}
+	var s State
+	if err := json.Unmarshal(kvp.Value, &s); err != nil {
+		logrus.WithField("pkg", "openstorage/freeze").
+			Warnf("Invalid cluster freeze state: %v", err)
+		return nil
+	}
+	m.cache(&s)
+	return nil
+}
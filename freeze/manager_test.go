@@ -0,0 +1,76 @@
+package freeze
+
+import (
+	"testing"
+	"time"
+
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitForFrozen waits for the watch of m to see the cluster frozen or thawed.
+func waitForFrozen(t *testing.T, m *Manager, frozen bool) {
+	for i := 0; i < 100 && m.Cached().Frozen != frozen; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, frozen, m.Cached().Frozen)
+}
+
+func TestFreeze(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m := NewManager(kv)
+	other := NewManager(kv)
+	require.NoError(t, other.Start())
+
+	s, err := m.State()
+	require.NoError(t, err)
+	assert.False(t, s.Frozen)
+
+	_, err = m.Freeze("kvdb migration", "uid:0")
+	require.NoError(t, err)
+	assert.True(t, m.Cached().Frozen)
+	s, err = m.State()
+	require.NoError(t, err)
+	assert.True(t, s.Frozen)
+	assert.Equal(t, "kvdb migration", s.Reason)
+	assert.Equal(t, "uid:0", s.Principal)
+	waitForFrozen(t, other, true)
+	assert.Equal(t, "kvdb migration", other.Cached().Reason)
+
+	// a node starting while the cluster is frozen rejects writes
+	late := NewManager(kv)
+	require.NoError(t, late.Start())
+	assert.True(t, late.Cached().Frozen)
+
+	_, err = m.Thaw("uid:0")
+	require.NoError(t, err)
+	assert.False(t, m.Cached().Frozen)
+	waitForFrozen(t, other, false)
+}
+
+func TestCheck(t *testing.T) {
+	assert.NoError(t, Check())
+
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m := NewManager(kv)
+	oldInst := Inst
+	Inst = func() (*Manager, error) {
+		return m, nil
+	}
+	defer func() {
+		Inst = oldInst
+	}()
+	assert.NoError(t, Check())
+
+	_, err = m.Freeze("incident", "cert:admin")
+	require.NoError(t, err)
+	err = Check()
+	require.Error(t, err)
+	assert.IsType(t, &FrozenError{}, err)
+	assert.Contains(t, err.Error(), ErrFrozen.Error())
+	assert.Contains(t, err.Error(), "cert:admin: incident")
+}
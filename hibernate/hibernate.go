@@ -0,0 +1,92 @@
+// Package hibernate suspends and resumes a whole cluster, such as a lab
+// environment powered off nightly. The requested state is persisted in
+// kvdb: on suspend every node records and releases the mounts and
+// attachments of its volumes, and on resume restores them in dependency
+// order, parents before their snapshots and clones and outer mounts before
+// the mounts nested in them.
+package hibernate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	// StateRunning is the state of a cluster serving its volumes
+	StateRunning = "running"
+	// StateSuspended is the state of a cluster whose volumes are released
+	StateSuspended = "suspended"
+)
+
+var (
+	// ErrNotInitialized returned when the hibernate manager has not been initialized
+	ErrNotInitialized = errors.New("openstorage.hibernate: not initialized")
+	// ErrInitialized returned when the hibernate manager is initialized twice
+	ErrInitialized = errors.New("openstorage.hibernate: already initialized")
+
+	inst *Manager
+	// Inst returns the hibernate manager singleton.
+	// This function can be overridden for testing purposes
+	Inst = func() (*Manager, error) {
+		return hibernateInst()
+	}
+)
+
+// Intent is the state requested for the cluster.
+// swagger:model
+type Intent struct {
+	// State is StateRunning or StateSuspended
+	State string
+	// Time the state was requested
+	Time time.Time
+}
+
+// Record is a volume released by a node on suspend, to be restored on
+// resume.
+// swagger:model
+type Record struct {
+	// VolumeId of the volume
+	VolumeId string
+	// NodeId of the node the volume was attached to
+	NodeId string
+	// Parent is the volume the volume was created from, if any
+	Parent string
+	// MountPaths the volume was mounted at
+	MountPaths []string
+}
+
+// Status is the hibernation status of the cluster.
+// swagger:model
+type Status struct {
+	// Intent is the state requested for the cluster
+	Intent *Intent
+	// Records are the volumes released and not yet restored
+	Records []*Record
+}
+
+// Driver is the part of a volume driver releasing and restoring volumes.
+type Driver interface {
+	Enumerate(locator *api.VolumeLocator, labels map[string]string) ([]*api.Volume, error)
+	Attach(volumeID string, attachOptions map[string]string) (string, error)
+	Detach(volumeID string, options map[string]string) error
+	Mount(volumeID string, mountPath string, options map[string]string) error
+	Unmount(volumeID string, mountPath string, options map[string]string) error
+}
+
+// Init sets the hibernate manager singleton.
+func Init(m *Manager) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = m
+	return nil
+}
+
+func hibernateInst() (*Manager, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
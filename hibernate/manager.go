@@ -0,0 +1,299 @@
+package hibernate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/common"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	intentKey = "hibernate/intent"
+	// recordsKey holds the records of every node under <nodeID>/<volumeID>
+	recordsKey = "hibernate/records"
+)
+
+// Manager suspends and resumes the volumes of this node following the
+// state requested for the cluster.
+type Manager struct {
+	kv     kvdb.Kvdb
+	nodeID string
+	driver Driver
+
+	lock sync.Mutex
+}
+
+// NewManager returns the manager of the volumes of driver d on nodeID.
+func NewManager(kv kvdb.Kvdb, nodeID string, d Driver) *Manager {
+	return &Manager{
+		kv:     kv,
+		nodeID: nodeID,
+		driver: d,
+	}
+}
+
+// Intent returns the state requested for the cluster, StateRunning if none
+// was ever requested.
+func (m *Manager) Intent() (*Intent, error) {
+	kvp, err := m.kv.Get(intentKey)
+	if err == kvdb.ErrNotFound {
+		return &Intent{State: StateRunning}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var intent Intent
+	if err := json.Unmarshal(kvp.Value, &intent); err != nil {
+		return nil, err
+	}
+	return &intent, nil
+}
+
+// Status returns the requested state and the volumes still to be restored.
+func (m *Manager) Status() (*Status, error) {
+	intent, err := m.Intent()
+	if err != nil {
+		return nil, err
+	}
+	records, err := m.records(recordsKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Status{Intent: intent, Records: records}, nil
+}
+
+// Suspend requests every node of the cluster to release its volumes.
+func (m *Manager) Suspend() error {
+	return m.setIntent(StateSuspended)
+}
+
+// Resume requests every node of the cluster to restore the volumes it
+// released.
+func (m *Manager) Resume() error {
+	return m.setIntent(StateRunning)
+}
+
+func (m *Manager) setIntent(state string) error {
+	_, err := m.kv.Put(intentKey, &Intent{State: state, Time: time.Now()}, 0)
+	return err
+}
+
+// Start applies the requested state to this node, now and whenever it
+// changes. A node booting while the cluster is suspended keeps its volumes
+// released until the cluster is resumed.
+func (m *Manager) Start() error {
+	intent, err := m.Intent()
+	if err != nil {
+		return err
+	}
+	m.apply(intent)
+	return m.kv.WatchKey(intentKey, 0, nil, m.watch)
+}
+
+func (m *Manager) watch(key string, opaque interface{}, kvp *kvdb.KVPair, watchErr error) error {
+	if watchErr != nil {
+		logrus.WithField("pkg", "openstorage/hibernate").
+			Errorf("Stopped watching the cluster hibernation state: %v", watchErr)
+		return watchErr
+	}
+	if kvp == nil || kvp.Action == kvdb.KVDelete {
+		return nil
+	}
+	var intent Intent
+	if err := json.Unmarshal(kvp.Value, &intent); err != nil {
+		logrus.WithField("pkg", "openstorage/hibernate").
+			Warnf("Invalid cluster hibernation state: %v", err)
+		return nil
+	}
+	m.apply(&intent)
+	return nil
+}
+
+func (m *Manager) apply(intent *Intent) {
+	var err error
+	switch intent.State {
+	case StateSuspended:
+		err = m.SuspendNode()
+	case StateRunning:
+		err = m.ResumeNode()
+	default:
+		err = fmt.Errorf("unknown state %q", intent.State)
+	}
+	if err != nil {
+		logrus.WithField("pkg", "openstorage/hibernate").
+			Errorf("Failed to apply cluster hibernation state %s: %v", intent.State, err)
+	}
+}
+
+// SuspendNode records the volumes attached to this node, then unmounts and
+// detaches them in reverse dependency order. The records are written
+// first, so that volumes released before a failure are still restored.
+func (m *Manager) SuspendNode() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	vols, err := m.driver.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		return err
+	}
+	var records []*Record
+	for _, v := range vols {
+		if !common.AttachedHere(v, m.nodeID) {
+			continue
+		}
+		r := &Record{
+			VolumeId:   v.GetId(),
+			NodeId:     m.nodeID,
+			Parent:     v.GetSource().GetParent(),
+			MountPaths: v.GetAttachPath(),
+		}
+		if _, err := m.kv.Put(m.recordKey(r.VolumeId), r, 0); err != nil {
+			return err
+		}
+		records = append(records, r)
+	}
+
+	records = dependencyOrder(records)
+	var failed []string
+	for i := len(records) - 1; i >= 0; i-- {
+		r := records[i]
+		for j := len(r.MountPaths) - 1; j >= 0; j-- {
+			if err := m.driver.Unmount(r.VolumeId, r.MountPaths[j], nil); err != nil {
+				failed = append(failed, fmt.Sprintf("unmount %s: %v", r.VolumeId, err))
+			}
+		}
+		if err := m.driver.Detach(r.VolumeId, nil); err != nil && err != volume.ErrNotSupported {
+			failed = append(failed, fmt.Sprintf("detach %s: %v", r.VolumeId, err))
+		}
+	}
+	if len(failed) != 0 {
+		return fmt.Errorf("Failed to release %s", strings.Join(failed, ", "))
+	}
+	logrus.WithField("pkg", "openstorage/hibernate").
+		Infof("Released %d volumes for cluster suspend", len(records))
+	return nil
+}
+
+// ResumeNode attaches and mounts the volumes recorded by SuspendNode in
+// dependency order. The record of a volume is deleted once it is restored.
+func (m *Manager) ResumeNode() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	records, err := m.records(recordsKey + "/" + m.nodeID)
+	if err != nil {
+		return err
+	}
+	var failed []string
+	restored := 0
+Records:
+	for _, r := range dependencyOrder(records) {
+		if _, err := m.driver.Attach(r.VolumeId, nil); err != nil && err != volume.ErrNotSupported {
+			failed = append(failed, fmt.Sprintf("attach %s: %v", r.VolumeId, err))
+			continue
+		}
+		for _, mountPath := range r.MountPaths {
+			if err := m.driver.Mount(r.VolumeId, mountPath, nil); err != nil {
+				failed = append(failed, fmt.Sprintf("mount %s: %v", r.VolumeId, err))
+				continue Records
+			}
+		}
+		if _, err := m.kv.Delete(m.recordKey(r.VolumeId)); err != nil {
+			return err
+		}
+		restored++
+	}
+	if len(failed) != 0 {
+		return fmt.Errorf("Failed to restore %s", strings.Join(failed, ", "))
+	}
+	if restored != 0 {
+		logrus.WithField("pkg", "openstorage/hibernate").
+			Infof("Restored %d volumes for cluster resume", restored)
+	}
+	return nil
+}
+
+func (m *Manager) records(prefix string) ([]*Record, error) {
+	kvps, err := m.kv.Enumerate(prefix)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*Record, 0, len(kvps))
+	for _, kvp := range kvps {
+		var r Record
+		if err := json.Unmarshal(kvp.Value, &r); err != nil {
+			return nil, err
+		}
+		records = append(records, &r)
+	}
+	return records, nil
+}
+
+func (m *Manager) recordKey(volumeID string) string {
+	return recordsKey + "/" + m.nodeID + "/" + volumeID
+}
+
+// dependencyOrder sorts records so that every record comes after the
+// records it depends on. Records in a dependency cycle keep their order.
+func dependencyOrder(records []*Record) []*Record {
+	sorted := make([]*Record, len(records))
+	copy(sorted, records)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].VolumeId < sorted[j].VolumeId
+	})
+
+	ordered := make([]*Record, 0, len(sorted))
+	placed := make(map[*Record]bool)
+	for len(ordered) < len(sorted) {
+		progress := false
+		for _, r := range sorted {
+			if placed[r] {
+				continue
+			}
+			ready := true
+			for _, dep := range sorted {
+				if dep != r && !placed[dep] && dependsOn(r, dep) {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				ordered = append(ordered, r)
+				placed[r] = true
+				progress = true
+			}
+		}
+		if !progress {
+			for _, r := range sorted {
+				if !placed[r] {
+					ordered = append(ordered, r)
+					placed[r] = true
+				}
+			}
+		}
+	}
+	return ordered
+}
+
+// dependsOn returns true if r was created from dep or is mounted in a path
+// dep is mounted at.
+func dependsOn(r, dep *Record) bool {
+	if r.Parent == dep.VolumeId {
+		return true
+	}
+	for _, p := range r.MountPaths {
+		for _, d := range dep.MountPaths {
+			if strings.HasPrefix(p, strings.TrimSuffix(d, "/")+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package hibernate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testVolumes returns the volumes attached to node1, with one mounted in
+// the mount of another and a clone, and a volume attached to node2.
+func testVolumes() []*api.Volume {
+	return []*api.Volume{
+		{Id: "base", AttachedOn: "node1", AttachPath: []string{"/mnt/base"}},
+		{Id: "a-nested", AttachedOn: "node1", AttachPath: []string{"/mnt/base/data"}},
+		{Id: "clone", AttachedOn: "node1", Source: &api.Source{Parent: "base"},
+			AttachPath: []string{"/mnt/clone"}},
+		{Id: "remote", AttachedOn: "node2", AttachPath: []string{"/mnt/remote"}},
+	}
+}
+
+// expectSuspend expects the volumes of node1 released in reverse
+// dependency order. It returns the last call.
+func expectSuspend(d *mock.MockVolumeDriver) *gomock.Call {
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(testVolumes(), nil)
+	last := d.EXPECT().Detach("base", nil).Return(nil)
+	gomock.InOrder(
+		d.EXPECT().Unmount("a-nested", "/mnt/base/data", nil).Return(nil),
+		d.EXPECT().Detach("a-nested", nil).Return(nil),
+		d.EXPECT().Unmount("clone", "/mnt/clone", nil).Return(nil),
+		d.EXPECT().Detach("clone", nil).Return(nil),
+		d.EXPECT().Unmount("base", "/mnt/base", nil).Return(nil),
+		last,
+	)
+	return last
+}
+
+// expectResume expects the volumes of node1 restored in dependency order.
+// It returns the last call.
+func expectResume(d *mock.MockVolumeDriver) *gomock.Call {
+	last := d.EXPECT().Mount("a-nested", "/mnt/base/data", nil).Return(nil)
+	gomock.InOrder(
+		d.EXPECT().Attach("base", nil).Return("", volume.ErrNotSupported),
+		d.EXPECT().Mount("base", "/mnt/base", nil).Return(nil),
+		d.EXPECT().Attach("clone", nil).Return("", volume.ErrNotSupported),
+		d.EXPECT().Mount("clone", "/mnt/clone", nil).Return(nil),
+		d.EXPECT().Attach("a-nested", nil).Return("", volume.ErrNotSupported),
+		last,
+	)
+	return last
+}
+
+func TestDependencyOrder(t *testing.T) {
+	records := dependencyOrder([]*Record{
+		{VolumeId: "a", MountPaths: []string{"/mnt/b/a"}},
+		{VolumeId: "b", MountPaths: []string{"/mnt/b"}},
+		{VolumeId: "c", Parent: "a"},
+		{VolumeId: "d"},
+	})
+	var ids []string
+	for _, r := range records {
+		ids = append(ids, r.VolumeId)
+	}
+	assert.Equal(t, []string{"b", "d", "a", "c"}, ids)
+
+	// cycles do not drop records
+	assert.Len(t, dependencyOrder([]*Record{
+		{VolumeId: "a", Parent: "b"},
+		{VolumeId: "b", Parent: "a"},
+	}), 2)
+}
+
+func TestSuspendResumeNode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m := NewManager(kv, "node1", d)
+
+	expectSuspend(d)
+	require.NoError(t, m.SuspendNode())
+	status, err := m.Status()
+	require.NoError(t, err)
+	assert.Equal(t, StateRunning, status.Intent.State)
+	assert.Len(t, status.Records, 3)
+
+	// suspending again keeps the records of the released volumes
+	released := testVolumes()
+	for _, v := range released[:3] {
+		v.AttachedOn = ""
+		v.AttachPath = nil
+	}
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(released, nil)
+	require.NoError(t, m.SuspendNode())
+	status, err = m.Status()
+	require.NoError(t, err)
+	assert.Len(t, status.Records, 3)
+
+	expectResume(d)
+	require.NoError(t, m.ResumeNode())
+	status, err = m.Status()
+	require.NoError(t, err)
+	assert.Empty(t, status.Records)
+}
+
+func TestWatchIntent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	m := NewManager(kv, "node1", d)
+	require.NoError(t, m.Start())
+
+	suspended := make(chan struct{})
+	expectSuspend(d).Do(func(volumeID string, options map[string]string) {
+		close(suspended)
+	})
+	require.NoError(t, m.Suspend())
+	select {
+	case <-suspended:
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for the volumes to be released")
+	}
+	intent, err := m.Intent()
+	require.NoError(t, err)
+	assert.Equal(t, StateSuspended, intent.State)
+
+	resumed := make(chan struct{})
+	expectResume(d).Do(func(volumeID string, mountPath string, options map[string]string) {
+		close(resumed)
+	})
+	require.NoError(t, m.Resume())
+	select {
+	case <-resumed:
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for the volumes to be restored")
+	}
+}
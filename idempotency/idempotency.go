@@ -0,0 +1,169 @@
+// Package idempotency stores the outcome of the mutating API calls made
+// with an idempotency key, so that a client retrying a call after a timeout
+// gets the outcome of the first call instead of running it twice, such as
+// creating a second volume.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/portworx/kvdb"
+)
+
+const (
+	// Header is the HTTP header holding the idempotency key of a request
+	Header = "Idempotency-Key"
+	// MetadataKey is the gRPC metadata holding the idempotency key of a call
+	MetadataKey = "idempotency-key"
+	// DefaultTTL is how long outcomes are kept when no TTL is configured
+	DefaultTTL = 24 * time.Hour
+
+	kvdbKey = "idempotency"
+)
+
+var (
+	// ErrNotInitialized returned when the store has not been initialized
+	ErrNotInitialized = errors.New("openstorage.idempotency: not initialized")
+	// ErrInitialized returned when the store is initialized twice
+	ErrInitialized = errors.New("openstorage.idempotency: already initialized")
+	// ErrInProgress returned when a call with the same key is still running
+	ErrInProgress = errors.New("A request with this idempotency key is in progress")
+	// ErrMismatch returned when a key is reused for a different request
+	ErrMismatch = errors.New("Idempotency key was used for a different request")
+
+	inst *Store
+)
+
+// Config configures the idempotency keys.
+type Config struct {
+	// TTL is how long the outcome of a call is kept, DefaultTTL if unset
+	TTL time.Duration `yaml:"ttl"`
+}
+
+// Outcome is the stored result of a call.
+type Outcome struct {
+	// Fingerprint identifies the request the key was first used for
+	Fingerprint string
+	// Done is set once the call returned
+	Done bool
+	// Code is the HTTP status or the gRPC code of the call
+	Code int
+	// Message is the error message of a failed call
+	Message string
+	// Status is the marshaled google.rpc.Status of a failed gRPC call, so
+	// that its details are returned again
+	Status []byte `json:",omitempty"`
+	// Type is the content type of a HTTP response, or the message name of a
+	// gRPC response
+	Type string
+	// Body is the response
+	Body []byte
+}
+
+// Store keeps the outcomes in kvdb.
+type Store struct {
+	kv  kvdb.Kvdb
+	ttl time.Duration
+}
+
+// Init sets the idempotency store singleton.
+func Init(s *Store) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = s
+	return nil
+}
+
+// Inst returns the idempotency store singleton.
+func Inst() (*Store, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// New returns a store of the outcomes in kv.
+func New(kv kvdb.Kvdb, c *Config) *Store {
+	ttl := c.TTL
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	return &Store{kv: kv, ttl: ttl}
+}
+
+// Fingerprint returns the fingerprint of a request from its parts.
+func Fingerprint(parts ...[]byte) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write(p)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Begin reserves key of scope, such as the method called, for the request
+// identified by fingerprint. It returns the outcome of the first call if
+// key was already used, nil if the call must run. The keys of a scope are
+// those of the principal of ctx, so that a caller cannot get the outcome of
+// the calls of another one.
+// Errors ErrInProgress and ErrMismatch may be returned.
+func (s *Store) Begin(ctx context.Context, scope, key, fingerprint string) (*Outcome, error) {
+	k := s.key(ctx, scope, key)
+	_, err := s.kv.Create(k, &Outcome{Fingerprint: fingerprint}, s.ttlSeconds())
+	if err == nil {
+		return nil, nil
+	} else if err != kvdb.ErrExist {
+		return nil, err
+	}
+	var o Outcome
+	if _, err := s.kv.GetVal(k, &o); err == kvdb.ErrNotFound {
+		// The outcome expired or the first call was abandoned
+		return s.Begin(ctx, scope, key, fingerprint)
+	} else if err != nil {
+		return nil, err
+	}
+	if o.Fingerprint != fingerprint {
+		return nil, ErrMismatch
+	}
+	if !o.Done {
+		return nil, ErrInProgress
+	}
+	return &o, nil
+}
+
+// Complete stores the outcome of the call reserved by Begin.
+func (s *Store) Complete(ctx context.Context, scope, key string, o *Outcome) error {
+	k := s.key(ctx, scope, key)
+	var reserved Outcome
+	if _, err := s.kv.GetVal(k, &reserved); err != nil {
+		return err
+	}
+	o.Fingerprint = reserved.Fingerprint
+	o.Done = true
+	_, err := s.kv.Put(k, o, s.ttlSeconds())
+	return err
+}
+
+// Abandon releases key so that the call runs again when retried, such as
+// after a transient failure.
+func (s *Store) Abandon(ctx context.Context, scope, key string) error {
+	_, err := s.kv.Delete(s.key(ctx, scope, key))
+	if err == kvdb.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+func (s *Store) key(ctx context.Context, scope, key string) string {
+	return kvdbKey + "/" + Fingerprint([]byte(auth.FromContext(ctx)), []byte(scope), []byte(key))
+}
+
+func (s *Store) ttlSeconds() uint64 {
+	return uint64(s.ttl.Seconds())
+}
@@ -0,0 +1,54 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	s := New(kv, &Config{})
+	assert.Equal(t, DefaultTTL, s.ttl)
+
+	ctx := auth.NewContext(context.Background(), "uid:1000")
+	fp := Fingerprint([]byte("create"), []byte("vol1"))
+	o, err := s.Begin(ctx, "create", "key1", fp)
+	require.NoError(t, err)
+	assert.Nil(t, o)
+
+	// the first call is running
+	_, err = s.Begin(ctx, "create", "key1", fp)
+	assert.Equal(t, ErrInProgress, err)
+
+	require.NoError(t, s.Complete(ctx, "create", "key1", &Outcome{Code: 200, Body: []byte("vol1")}))
+	o, err = s.Begin(ctx, "create", "key1", fp)
+	require.NoError(t, err)
+	require.NotNil(t, o)
+	assert.Equal(t, 200, o.Code)
+	assert.Equal(t, "vol1", string(o.Body))
+
+	// the key belongs to another request
+	_, err = s.Begin(ctx, "create", "key1", Fingerprint([]byte("create"), []byte("vol2")))
+	assert.Equal(t, ErrMismatch, err)
+
+	// keys are scoped by method and by principal
+	o, err = s.Begin(ctx, "delete", "key1", fp)
+	require.NoError(t, err)
+	assert.Nil(t, o)
+	o, err = s.Begin(auth.NewContext(context.Background(), "uid:1001"), "create", "key1", fp)
+	require.NoError(t, err)
+	assert.Nil(t, o)
+
+	// abandoned calls run again
+	require.NoError(t, s.Abandon(ctx, "delete", "key1"))
+	o, err = s.Begin(ctx, "delete", "key1", fp)
+	require.NoError(t, err)
+	assert.Nil(t, o)
+}
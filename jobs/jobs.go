@@ -0,0 +1,150 @@
+// Package jobs tracks long running operations, such as key rotation, that are
+// executed asynchronously on behalf of API requests, as allowed by their
+// concurrency classes.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/portworx/kvdb"
+)
+
+var (
+	// ErrNotFound returned when a job does not exist
+	ErrNotFound = errors.New("Job not found")
+	// ErrNotInitialized returned when the jobs manager has not been initialized
+	ErrNotInitialized = errors.New("openstorage.jobs: not initialized")
+	// ErrInitialized returned when the jobs manager is initialized twice
+	ErrInitialized = errors.New("openstorage.jobs: already initialized")
+	// ErrDone returned when canceling a job which has completed
+	ErrDone = errors.New("Job has completed")
+	// ErrNotDone returned when requeuing a job which has not completed
+	ErrNotDone = errors.New("Job has not completed")
+	// ErrNotLocal returned when canceling or requeuing a job submitted on
+	// another node, or before a restart
+	ErrNotLocal = errors.New("Job was not submitted on this node")
+
+	inst Manager
+	// Inst returns an instance of an already instantiated jobs manager.
+	// This function can be overridden for testing purposes
+	Inst = func() (Manager, error) {
+		return jobsInst()
+	}
+)
+
+// State is the state of a job.
+type State string
+
+const (
+	// StatePending indicates that the job has been accepted but not started
+	StatePending State = "pending"
+	// StateRunning indicates that the job is in progress
+	StateRunning State = "running"
+	// StateDone indicates that the job completed successfully
+	StateDone State = "done"
+	// StateFailed indicates that the job completed with an error
+	StateFailed State = "failed"
+	// StateCanceled indicates that the job was canceled
+	StateCanceled State = "canceled"
+)
+
+// Done returns true if the job has reached a terminal state.
+func (s State) Done() bool {
+	return s == StateDone || s == StateFailed || s == StateCanceled
+}
+
+// Job describes an operation executed asynchronously.
+// swagger:model
+type Job struct {
+	// Id uniquely identifies the job
+	Id string
+	// Type of operation, for example "rotatekey"
+	Type string
+	// ResourceId is the id of the object the job operates on
+	ResourceId string
+	// State of the job
+	State State
+	// Error is set when the job failed, or when its last attempt failed
+	// while it waits to be retried
+	Error string
+	// Attempts is the number of times the job ran since it was submitted or
+	// requeued
+	Attempts int
+	// CorrelationId is the correlation id of the request which submitted the
+	// job, if any
+	CorrelationId string `json:",omitempty"`
+	// CreateTime is when the job was submitted
+	CreateTime time.Time
+	// UpdateTime is when the job state last changed
+	UpdateTime time.Time
+}
+
+// Manager submits and tracks jobs.
+type Manager interface {
+	// Submit records a new job of the given type for resourceID and runs f
+	// asynchronously, once its scheduler allows it. The returned job is in
+	// the pending state.
+	Submit(jobType, resourceID string, f func() error) (*Job, error)
+	// SubmitWithContext submits a job like Submit on behalf of the request of
+	// ctx, recording its correlation id. f runs with a context carrying the
+	// correlation id, canceled when the job is canceled rather than when ctx
+	// is.
+	SubmitWithContext(
+		ctx context.Context,
+		jobType, resourceID string,
+		f func(ctx context.Context) error,
+	) (*Job, error)
+	// Cancel cancels the job with the given id. A pending job never runs, a
+	// running one is canceled once its function returns, which a job
+	// submitted with SubmitWithContext is asked to do.
+	// Errors ErrNotFound, ErrDone and ErrNotLocal may be returned.
+	Cancel(id string) error
+	// Requeue runs the completed job with the given id again, such as a
+	// failed or canceled one, and returns it in the pending state.
+	// Errors ErrNotFound, ErrNotDone and ErrNotLocal may be returned.
+	Requeue(id string) (*Job, error)
+	// SetSchedulerConfig replaces the concurrency classes and the priorities
+	// of the jobs.
+	SetSchedulerConfig(c *SchedulerConfig) error
+	// SetRetention replaces the retention of the records of the completed
+	// jobs, kept forever by default.
+	SetRetention(c *RetentionConfig) error
+	// SetRetry replaces the automatic retries of the jobs failing with a
+	// retriable error, see Retriable. The jobs are not retried by default.
+	SetRetry(c *RetryConfig) error
+	// Inspect returns the job with the given id.
+	// Errors ErrNotFound may be returned.
+	Inspect(id string) (*Job, error)
+	// Enumerate returns all known jobs.
+	Enumerate() ([]*Job, error)
+}
+
+// NewManager returns a kvdb backed jobs manager, scheduling the jobs with
+// NewScheduler.
+func NewManager(kv kvdb.Kvdb) Manager {
+	return newManager(kv, newScheduler(kv))
+}
+
+// NewManagerWithScheduler returns a kvdb backed jobs manager, scheduling the
+// jobs with scheduler.
+func NewManagerWithScheduler(kv kvdb.Kvdb, scheduler Scheduler) Manager {
+	return newManager(kv, scheduler)
+}
+
+// Init instantiates the jobs manager singleton.
+func Init(kv kvdb.Kvdb) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = NewManager(kv)
+	return nil
+}
+
+func jobsInst() (Manager, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
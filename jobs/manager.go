@@ -0,0 +1,288 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+	"github.com/pborman/uuid"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	kvdbKey = "jobs"
+)
+
+// manager implements Manager interface.
+type manager struct {
+	kv        kvdb.Kvdb
+	scheduler Scheduler
+	lock      sync.Mutex
+	// local are the jobs submitted on this node, by id, until their records
+	// are pruned
+	local     map[string]*localJob
+	retention RetentionConfig
+	retry     RetryConfig
+	// pruning starts the worker pruning the completed jobs once
+	pruning sync.Once
+}
+
+// localJob is a job submitted on this node, updated with the lock of the
+// manager held.
+type localJob struct {
+	job *Job
+	f   func(ctx context.Context) error
+	// ctx is the parent of the contexts of the attempts, carrying the
+	// correlation id of the job
+	ctx context.Context
+	// gen is incremented when the job is requeued, so that the attempts
+	// scheduled before do not run
+	gen int
+	// cancel cancels the context of the current attempt
+	cancel context.CancelFunc
+	// canceled is set once the job is canceled
+	canceled bool
+	// retry schedules the next attempt after its backoff, nil if none
+	retry *time.Timer
+}
+
+func newManager(kv kvdb.Kvdb, scheduler Scheduler) *manager {
+	return &manager{
+		kv:        kv,
+		scheduler: scheduler,
+		local:     make(map[string]*localJob),
+	}
+}
+
+// getKey is a util func that constructs kvdb key.
+// kvdb tree structure is setup as follows:
+// <baseKey>/<jobID>/<jobObject>
+func getKey(id string) string {
+	return filepath.Join(kvdbKey, id)
+}
+
+func (m *manager) Submit(jobType, resourceID string, f func() error) (*Job, error) {
+	return m.SubmitWithContext(context.Background(), jobType, resourceID, func(context.Context) error {
+		return f()
+	})
+}
+
+func (m *manager) SubmitWithContext(
+	ctx context.Context,
+	jobType, resourceID string,
+	f func(ctx context.Context) error,
+) (*Job, error) {
+	now := time.Now()
+	job := &Job{
+		Id:            uuid.New(),
+		Type:          jobType,
+		ResourceId:    resourceID,
+		State:         StatePending,
+		CreateTime:    now,
+		UpdateTime:    now,
+		CorrelationId: correlation.FromContext(ctx),
+	}
+	if _, err := m.kv.Create(getKey(job.Id), job, 0); err != nil {
+		return nil, err
+	}
+
+	// keep a copy so that the caller owns the returned job
+	running := *job
+	l := &localJob{job: &running, f: f, ctx: correlation.Detach(ctx)}
+	m.lock.Lock()
+	m.local[job.Id] = l
+	m.lock.Unlock()
+	m.schedule(l)
+
+	return job, nil
+}
+
+// schedule runs the next attempt of l once its scheduler allows it.
+func (m *manager) schedule(l *localJob) {
+	ctx, cancel := context.WithCancel(l.ctx)
+	m.lock.Lock()
+	l.cancel = cancel
+	gen := l.gen
+	m.lock.Unlock()
+	m.scheduler.Schedule(l.job, func() {
+		m.run(ctx, l, gen)
+	})
+}
+
+// run runs an attempt of l, of the generation gen, and records its outcome:
+// the job is retried after a backoff if it failed with a retriable error and
+// has attempts left.
+func (m *manager) run(ctx context.Context, l *localJob, gen int) {
+	m.lock.Lock()
+	if l.canceled || l.gen != gen {
+		m.lock.Unlock()
+		return
+	}
+	l.job.Attempts++
+	m.update(l.job, StateRunning, nil)
+	m.lock.Unlock()
+
+	err := l.f(ctx)
+
+	m.lock.Lock()
+	l.cancel()
+	switch {
+	case l.canceled:
+		m.update(l.job, StateCanceled, nil)
+	case err == nil:
+		m.update(l.job, StateDone, nil)
+	case IsRetriable(err) && l.job.Attempts < m.retry.MaxAttempts:
+		m.update(l.job, StatePending, err)
+		l.retry = time.AfterFunc(m.retry.backoff(l.job.Attempts), func() {
+			m.lock.Lock()
+			l.retry = nil
+			m.lock.Unlock()
+			m.schedule(l)
+		})
+	default:
+		m.update(l.job, StateFailed, err)
+		logrus.WithField("pkg", "openstorage/jobs").
+			WithField("job", l.job.Id).
+			WithField(correlation.Key, l.job.CorrelationId).
+			Warnf("%s job for %s failed: %v", l.job.Type, l.job.ResourceId, err)
+	}
+	done := l.job.State.Done()
+	m.lock.Unlock()
+
+	if done {
+		if _, err := m.prune(time.Now()); err != nil {
+			logrus.WithField("pkg", "openstorage/jobs").
+				Errorf("failed to prune completed jobs: %v", err)
+		}
+	}
+}
+
+func (m *manager) update(job *Job, state State, jobErr error) {
+	job.State = state
+	job.UpdateTime = time.Now()
+	job.Error = ""
+	if jobErr != nil {
+		job.Error = jobErr.Error()
+	}
+	if _, err := m.kv.Put(getKey(job.Id), job, 0); err != nil {
+		logrus.WithField("pkg", "openstorage/jobs").
+			WithField("job", job.Id).
+			Errorf("failed to update job state to %v: %v", state, err)
+	}
+}
+
+func (m *manager) Cancel(id string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	l, ok := m.local[id]
+	if !ok {
+		return m.notLocal(id)
+	}
+	if l.job.State.Done() {
+		return ErrDone
+	}
+	if l.canceled {
+		return nil
+	}
+	l.canceled = true
+	l.cancel()
+	if l.retry != nil {
+		l.retry.Stop()
+		l.retry = nil
+	}
+	// a running job is recorded canceled once its function returns
+	if l.job.State == StatePending {
+		m.update(l.job, StateCanceled, nil)
+	}
+	return nil
+}
+
+func (m *manager) Requeue(id string) (*Job, error) {
+	m.lock.Lock()
+	l, ok := m.local[id]
+	if !ok {
+		m.lock.Unlock()
+		return nil, m.notLocal(id)
+	}
+	if !l.job.State.Done() {
+		m.lock.Unlock()
+		return nil, ErrNotDone
+	}
+	l.gen++
+	l.canceled = false
+	l.job.Attempts = 0
+	m.update(l.job, StatePending, nil)
+	job := *l.job
+	m.lock.Unlock()
+
+	m.schedule(l)
+	return &job, nil
+}
+
+// notLocal returns the error of an operation on the job with the given id,
+// which was not submitted on this node.
+func (m *manager) notLocal(id string) error {
+	if _, err := m.Inspect(id); err != nil {
+		return err
+	}
+	return ErrNotLocal
+}
+
+func (m *manager) SetSchedulerConfig(c *SchedulerConfig) error {
+	return m.scheduler.SetConfig(c)
+}
+
+func (m *manager) SetRetention(c *RetentionConfig) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	m.retention = *c
+	m.lock.Unlock()
+	m.pruning.Do(func() {
+		go m.pruneEvery(pruneInterval)
+	})
+	return nil
+}
+
+func (m *manager) SetRetry(c *RetryConfig) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.retry = *c
+	return nil
+}
+
+func (m *manager) Inspect(id string) (*Job, error) {
+	job := new(Job)
+	if _, err := m.kv.GetVal(getKey(id), job); err != nil {
+		if err == kvdb.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+func (m *manager) Enumerate() ([]*Job, error) {
+	kvps, err := m.kv.Enumerate(kvdbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*Job, 0, len(kvps))
+	for _, kvp := range kvps {
+		job := new(Job)
+		if err := json.Unmarshal(kvp.Value, job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
@@ -0,0 +1,226 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/pkg/correlation"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManager(t *testing.T) Manager {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	return NewManager(kv)
+}
+
+func waitForJob(t *testing.T, m Manager, id string) *Job {
+	for i := 0; i < 100; i++ {
+		job, err := m.Inspect(id)
+		require.NoError(t, err)
+		if job.State.Done() {
+			return job
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("job %s did not complete", id)
+	return nil
+}
+
+func TestSubmitSuccess(t *testing.T) {
+	m := newTestManager(t)
+
+	job, err := m.Submit("test", "vol1", func() error { return nil })
+	require.NoError(t, err)
+	assert.NotEmpty(t, job.Id)
+	assert.Equal(t, StatePending, job.State)
+	assert.Equal(t, "vol1", job.ResourceId)
+
+	job = waitForJob(t, m, job.Id)
+	assert.Equal(t, StateDone, job.State)
+	assert.Empty(t, job.Error)
+}
+
+func TestSubmitFailure(t *testing.T) {
+	m := newTestManager(t)
+
+	job, err := m.Submit("test", "vol1", func() error { return errors.New("boom") })
+	require.NoError(t, err)
+
+	job = waitForJob(t, m, job.Id)
+	assert.Equal(t, StateFailed, job.State)
+	assert.Equal(t, "boom", job.Error)
+}
+
+func TestSubmitCorrelation(t *testing.T) {
+	m := newTestManager(t)
+
+	ctx, cancel := context.WithCancel(correlation.NewContext(context.Background(), "abc"))
+	ids := make(chan string, 1)
+	job, err := m.SubmitWithContext(ctx, "test", "vol1", func(ctx context.Context) error {
+		ids <- correlation.FromContext(ctx)
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", job.CorrelationId)
+	// the job outlives the request which submitted it
+	cancel()
+
+	job = waitForJob(t, m, job.Id)
+	assert.Equal(t, StateDone, job.State)
+	assert.Equal(t, "abc", job.CorrelationId)
+	assert.Equal(t, "abc", <-ids)
+}
+
+func TestInspectNotFound(t *testing.T) {
+	m := newTestManager(t)
+
+	_, err := m.Inspect("nope")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestEnumerate(t *testing.T) {
+	m := newTestManager(t)
+
+	for _, id := range []string{"vol1", "vol2"} {
+		job, err := m.Submit("test", id, func() error { return nil })
+		require.NoError(t, err)
+		waitForJob(t, m, job.Id)
+	}
+
+	jobs, err := m.Enumerate()
+	require.NoError(t, err)
+	assert.Len(t, jobs, 2)
+}
+
+func TestCancel(t *testing.T) {
+	m := newTestManager(t)
+	require.NoError(t, m.SetSchedulerConfig(&SchedulerConfig{
+		Classes: []ClassConfig{{Name: "movers", JobTypes: []string{"move"}, Limit: 1}},
+	}))
+
+	started := make(chan struct{})
+	running, err := m.SubmitWithContext(context.Background(), "move", "vol1", func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+	<-started
+	ran := false
+	pending, err := m.Submit("move", "vol2", func() error {
+		ran = true
+		return nil
+	})
+	require.NoError(t, err)
+
+	// the pending job is canceled at once, the running one once it returns
+	require.NoError(t, m.Cancel(pending.Id))
+	pending, err = m.Inspect(pending.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StateCanceled, pending.State)
+	require.NoError(t, m.Cancel(running.Id))
+	running = waitForJob(t, m, running.Id)
+	assert.Equal(t, StateCanceled, running.State)
+	assert.False(t, ran)
+
+	assert.Equal(t, ErrDone, m.Cancel(running.Id))
+	assert.Equal(t, ErrNotFound, m.Cancel("nope"))
+}
+
+func TestRequeue(t *testing.T) {
+	m := newTestManager(t)
+
+	fail := true
+	job, err := m.Submit("test", "vol1", func() error {
+		if fail {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	job = waitForJob(t, m, job.Id)
+	assert.Equal(t, StateFailed, job.State)
+
+	fail = false
+	job, err = m.Requeue(job.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StatePending, job.State)
+	assert.Empty(t, job.Error)
+	job = waitForJob(t, m, job.Id)
+	assert.Equal(t, StateDone, job.State)
+	assert.Equal(t, 1, job.Attempts)
+
+	// the jobs of the other nodes are not requeued
+	other := NewManager(m.(*manager).kv)
+	_, err = other.Requeue(job.Id)
+	assert.Equal(t, ErrNotLocal, err)
+}
+
+func TestRetry(t *testing.T) {
+	m := newTestManager(t)
+	require.NoError(t, m.SetRetry(&RetryConfig{MaxAttempts: 3, Backoff: time.Millisecond}))
+
+	attempts := 0
+	job, err := m.Submit("test", "vol1", func() error {
+		attempts++
+		if attempts < 3 {
+			return Retriable(errors.New("busy"))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	job = waitForJob(t, m, job.Id)
+	assert.Equal(t, StateDone, job.State)
+	assert.Equal(t, 3, job.Attempts)
+	assert.Empty(t, job.Error)
+
+	// the other errors are not retried
+	job, err = m.Submit("test", "vol2", func() error { return errors.New("boom") })
+	require.NoError(t, err)
+	job = waitForJob(t, m, job.Id)
+	assert.Equal(t, StateFailed, job.State)
+	assert.Equal(t, 1, job.Attempts)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	c := &RetryConfig{MaxAttempts: 10, Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	assert.Equal(t, time.Second, c.backoff(1))
+	assert.Equal(t, 2*time.Second, c.backoff(2))
+	assert.Equal(t, 4*time.Second, c.backoff(3))
+	assert.Equal(t, 5*time.Second, c.backoff(4))
+	assert.Error(t, (&RetryConfig{MaxAttempts: -1}).Validate())
+}
+
+func TestRetention(t *testing.T) {
+	m := newTestManager(t)
+
+	var ids []string
+	for _, id := range []string{"vol1", "vol2", "vol3"} {
+		job, err := m.Submit("test", id, func() error { return nil })
+		require.NoError(t, err)
+		waitForJob(t, m, job.Id)
+		ids = append(ids, job.Id)
+	}
+	require.NoError(t, m.SetRetention(&RetentionConfig{MaxCount: 2}))
+	n, err := m.(*manager).prune(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	_, err = m.Inspect(ids[0])
+	assert.Equal(t, ErrNotFound, err)
+
+	// the completed jobs expire
+	require.NoError(t, m.SetRetention(&RetentionConfig{MaxAge: time.Hour}))
+	n, err = m.(*manager).prune(time.Now().Add(2 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	jobs, err := m.Enumerate()
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+	assert.Error(t, m.SetRetention(&RetentionConfig{MaxCount: -1}))
+}
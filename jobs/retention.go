@@ -0,0 +1,102 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+// pruneInterval is the time between the deletions of the records of the
+// completed jobs beyond their retention, which are also deleted whenever a
+// job completes
+const pruneInterval = 10 * time.Minute
+
+// RetentionConfig configures how long the records of the completed jobs are
+// kept. The pending and running jobs are always kept.
+type RetentionConfig struct {
+	// MaxAge is how long the completed jobs are kept after they completed, no
+	// limit if unset
+	MaxAge time.Duration `yaml:"max_age"`
+	// MaxCount is the number of completed jobs kept, the most recent ones, no
+	// limit if unset
+	MaxCount int `yaml:"max_count"`
+}
+
+// Validate checks that the limits are not negative.
+func (c *RetentionConfig) Validate() error {
+	if c.MaxAge < 0 || c.MaxCount < 0 {
+		return fmt.Errorf("Job retention must not be negative, got %v and %d",
+			c.MaxAge, c.MaxCount)
+	}
+	return nil
+}
+
+// prune deletes the records of the completed jobs beyond the retention at now
+// and returns how many were deleted.
+func (m *manager) prune(now time.Time) (int, error) {
+	m.lock.Lock()
+	retention := m.retention
+	m.lock.Unlock()
+	if retention.MaxAge == 0 && retention.MaxCount == 0 {
+		return 0, nil
+	}
+
+	kvps, err := m.kv.Enumerate(kvdbKey)
+	if err != nil {
+		return 0, err
+	}
+	type record struct {
+		kvp *kvdb.KVPair
+		job *Job
+	}
+	var done []record
+	for _, kvp := range kvps {
+		job := new(Job)
+		if err := json.Unmarshal(kvp.Value, job); err != nil {
+			return 0, err
+		}
+		if job.State.Done() {
+			done = append(done, record{kvp: kvp, job: job})
+		}
+	}
+	// most recent first
+	sort.Slice(done, func(i, j int) bool {
+		return done[i].job.UpdateTime.After(done[j].job.UpdateTime)
+	})
+
+	n := 0
+	for i, r := range done {
+		keep := retention.MaxCount == 0 || i < retention.MaxCount
+		if keep && retention.MaxAge > 0 {
+			keep = now.Sub(r.job.UpdateTime) < retention.MaxAge
+		}
+		if keep {
+			continue
+		}
+		// a job requeued since it was read is not deleted
+		if _, err := m.kv.CompareAndDelete(r.kvp, kvdb.KVFlags(0)); err != nil {
+			continue
+		}
+		m.lock.Lock()
+		if l, ok := m.local[r.job.Id]; ok && l.job.State.Done() {
+			delete(m.local, r.job.Id)
+		}
+		m.lock.Unlock()
+		n++
+	}
+	return n, nil
+}
+
+// pruneEvery prunes the completed jobs every interval.
+func (m *manager) pruneEvery(interval time.Duration) {
+	for range time.Tick(interval) {
+		if _, err := m.prune(time.Now()); err != nil {
+			logrus.WithField("pkg", "openstorage/jobs").
+				Errorf("failed to prune completed jobs: %v", err)
+		}
+	}
+}
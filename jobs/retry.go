@@ -0,0 +1,83 @@
+package jobs
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultRetryBackoff is the time before the first retry of a job if
+	// none is set
+	DefaultRetryBackoff = 10 * time.Second
+	// DefaultMaxRetryBackoff bounds the time between the retries of a job if
+	// no bound is set
+	DefaultMaxRetryBackoff = 10 * time.Minute
+)
+
+// RetryConfig configures the automatic retries of the jobs failing with a
+// retriable error.
+type RetryConfig struct {
+	// MaxAttempts is the number of times a job runs before it fails, the
+	// jobs are not retried if 1 or less
+	MaxAttempts int `yaml:"max_attempts"`
+	// Backoff is the time before the first retry, DefaultRetryBackoff if
+	// unset. It doubles with every retry.
+	Backoff time.Duration `yaml:"backoff"`
+	// MaxBackoff bounds the time between the retries, DefaultMaxRetryBackoff
+	// if unset
+	MaxBackoff time.Duration `yaml:"max_backoff"`
+}
+
+// Validate checks that the attempts and the backoffs are not negative.
+func (c *RetryConfig) Validate() error {
+	if c.MaxAttempts < 0 {
+		return fmt.Errorf("Job max attempts must not be negative, got %d", c.MaxAttempts)
+	}
+	if c.Backoff < 0 || c.MaxBackoff < 0 {
+		return fmt.Errorf("Job retry backoff must not be negative, got %v and %v",
+			c.Backoff, c.MaxBackoff)
+	}
+	return nil
+}
+
+// backoff returns the time before the retry following the given attempt.
+func (c *RetryConfig) backoff(attempt int) time.Duration {
+	backoff, maxBackoff := c.Backoff, c.MaxBackoff
+	if backoff == 0 {
+		backoff = DefaultRetryBackoff
+	}
+	if maxBackoff == 0 {
+		maxBackoff = DefaultMaxRetryBackoff
+	}
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+// retriableError is an error after which a job may succeed if run again.
+type retriableError struct {
+	err error
+}
+
+func (e *retriableError) Error() string {
+	return e.err.Error()
+}
+
+// Retriable marks err as transient: a job failing with it is retried, as
+// configured by SetRetry.
+func Retriable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retriableError{err: err}
+}
+
+// IsRetriable returns true if err was marked by Retriable.
+func IsRetriable(err error) bool {
+	_, ok := err.(*retriableError)
+	return ok
+}
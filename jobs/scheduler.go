@@ -0,0 +1,288 @@
+package jobs
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ScopeNode limits the jobs of a concurrency class running on each node
+	ScopeNode = "node"
+	// ScopeCluster limits the jobs of a concurrency class running in the
+	// cluster
+	ScopeCluster = "cluster"
+
+	// slotsKey is the kvdb tree of the slots of the cluster wide classes:
+	// <slotsKey>/<class>/<slot>, holding the id of the job running in it
+	slotsKey = "jobs-slots"
+	// slotPollInterval is how often the jobs waiting for a cluster wide
+	// class try again, since the slots freed by the other nodes are not
+	// watched
+	slotPollInterval = 5 * time.Second
+)
+
+// ClassConfig is a concurrency class, limiting the number of jobs of some
+// types running at once.
+type ClassConfig struct {
+	// Name identifies the class
+	Name string `yaml:"name"`
+	// JobTypes are the types of the jobs of the class, such as "backup".
+	// A job type may belong to several classes, its jobs then run once
+	// every class allows it.
+	JobTypes []string `yaml:"job_types"`
+	// Limit is the number of jobs of the class running at once
+	Limit int `yaml:"limit"`
+	// Scope is ScopeNode or ScopeCluster, ScopeNode if unset
+	Scope string `yaml:"scope"`
+}
+
+// SchedulerConfig configures the concurrency classes and the priorities of
+// the jobs.
+type SchedulerConfig struct {
+	// Classes limit the concurrency of the jobs, unlimited if they belong
+	// to none
+	Classes []ClassConfig `yaml:"classes"`
+	// Priorities are the priorities of the job types, 0 if unset. The
+	// waiting jobs of higher priority run first, those of the same priority
+	// in the order they were submitted.
+	Priorities map[string]int `yaml:"priorities"`
+}
+
+// Validate checks that the classes are named once, with a positive limit
+// and a known scope.
+func (c *SchedulerConfig) Validate() error {
+	names := make(map[string]bool, len(c.Classes))
+	for _, class := range c.Classes {
+		if len(class.Name) == 0 {
+			return fmt.Errorf("Job class name is required")
+		}
+		if names[class.Name] {
+			return fmt.Errorf("Job class %s is defined twice", class.Name)
+		}
+		names[class.Name] = true
+		if class.Limit < 1 {
+			return fmt.Errorf("Job class %s limit must be at least 1, got %d",
+				class.Name, class.Limit)
+		}
+		switch class.Scope {
+		case "", ScopeNode, ScopeCluster:
+		default:
+			return fmt.Errorf("Job class %s scope must be %s or %s, got %q",
+				class.Name, ScopeNode, ScopeCluster, class.Scope)
+		}
+	}
+	return nil
+}
+
+// Scheduler decides when the submitted jobs run.
+type Scheduler interface {
+	// Schedule calls run, in its own goroutine, once job may run. run
+	// returns once the job completed.
+	Schedule(job *Job, run func())
+	// SetConfig replaces the concurrency classes and the priorities, applied
+	// to the waiting jobs and to those submitted afterwards.
+	SetConfig(c *SchedulerConfig) error
+}
+
+// NewScheduler returns a scheduler running the jobs as allowed by their
+// concurrency classes, by priority. The slots of the cluster wide classes
+// are kept in kv.
+func NewScheduler(kv kvdb.Kvdb) Scheduler {
+	return newScheduler(kv)
+}
+
+// scheduler implements Scheduler.
+type scheduler struct {
+	kv     kvdb.Kvdb
+	lock   sync.Mutex
+	config SchedulerConfig
+	// running counts the jobs running in each class on this node
+	running map[string]int
+	// waiting are the jobs not allowed to run yet
+	waiting []*waitingJob
+	// poll retries the waiting jobs, nil if not armed
+	poll *time.Timer
+}
+
+// waitingJob is a job waiting for its classes to allow it to run.
+type waitingJob struct {
+	job *Job
+	run func()
+}
+
+func newScheduler(kv kvdb.Kvdb) *scheduler {
+	return &scheduler{
+		kv:      kv,
+		running: make(map[string]int),
+	}
+}
+
+func (s *scheduler) Schedule(job *Job, run func()) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.waiting = append(s.waiting, &waitingJob{job: job, run: run})
+	s.dispatch()
+}
+
+func (s *scheduler) SetConfig(c *SchedulerConfig) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.config = *c
+	s.dispatch()
+	return nil
+}
+
+// dispatch starts the waiting jobs allowed to run, highest priority first.
+// It is called with the lock held.
+func (s *scheduler) dispatch() {
+	sort.SliceStable(s.waiting, func(i, j int) bool {
+		pi := s.config.Priorities[s.waiting[i].job.Type]
+		pj := s.config.Priorities[s.waiting[j].job.Type]
+		if pi != pj {
+			return pi > pj
+		}
+		return s.waiting[i].job.CreateTime.Before(s.waiting[j].job.CreateTime)
+	})
+	waiting := s.waiting[:0]
+	clusterWide := false
+	for _, w := range s.waiting {
+		classes := s.classes(w.job.Type)
+		slots, ok := s.acquire(w.job, classes)
+		if !ok {
+			waiting = append(waiting, w)
+			for _, class := range classes {
+				clusterWide = clusterWide || class.Scope == ScopeCluster
+			}
+			continue
+		}
+		go func(w *waitingJob) {
+			w.run()
+			s.release(classes, slots)
+		}(w)
+	}
+	s.waiting = waiting
+	if clusterWide && s.poll == nil {
+		s.poll = time.AfterFunc(slotPollInterval, func() {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+			s.poll = nil
+			s.dispatch()
+		})
+	}
+}
+
+// classes returns the classes of the jobs of jobType.
+func (s *scheduler) classes(jobType string) []ClassConfig {
+	var classes []ClassConfig
+	for _, class := range s.config.Classes {
+		for _, t := range class.JobTypes {
+			if t == jobType {
+				classes = append(classes, class)
+				break
+			}
+		}
+	}
+	return classes
+}
+
+// acquire reserves a place for job in every class and returns the kvdb keys
+// of the slots reserved in the cluster wide ones, or false, reserving none,
+// if a class is full.
+func (s *scheduler) acquire(job *Job, classes []ClassConfig) ([]string, bool) {
+	for _, class := range classes {
+		if class.Scope != ScopeCluster && s.running[class.Name] >= class.Limit {
+			return nil, false
+		}
+	}
+	var slots []string
+	for _, class := range classes {
+		if class.Scope != ScopeCluster {
+			continue
+		}
+		slot, ok := s.acquireSlot(job, class)
+		if !ok {
+			s.releaseSlots(slots)
+			return nil, false
+		}
+		slots = append(slots, slot)
+	}
+	for _, class := range classes {
+		if class.Scope != ScopeCluster {
+			s.running[class.Name]++
+		}
+	}
+	return slots, true
+}
+
+// acquireSlot reserves a slot of a cluster wide class for job and returns
+// its key, or false if they are all held by running jobs. The slots held by
+// completed or deleted jobs, such as those a node stopped before releasing,
+// are reclaimed.
+func (s *scheduler) acquireSlot(job *Job, class ClassConfig) (string, bool) {
+	for i := 0; i < class.Limit; i++ {
+		key := filepath.Join(slotsKey, class.Name, strconv.Itoa(i))
+		_, err := s.kv.Create(key, job.Id, 0)
+		if err == nil {
+			return key, true
+		}
+		if err != kvdb.ErrExist || !s.reclaimSlot(key) {
+			continue
+		}
+		if _, err := s.kv.Create(key, job.Id, 0); err == nil {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// reclaimSlot deletes the slot at key if the job holding it is not running
+// anymore and returns true if it was deleted.
+func (s *scheduler) reclaimSlot(key string) bool {
+	kvp, err := s.kv.Get(key)
+	if err != nil {
+		return false
+	}
+	holder := new(Job)
+	if _, err := s.kv.GetVal(getKey(string(kvp.Value)), holder); err == nil && !holder.State.Done() {
+		return false
+	} else if err != nil && err != kvdb.ErrNotFound {
+		return false
+	}
+	_, err = s.kv.CompareAndDelete(kvp, kvdb.KVFlags(0))
+	return err == nil
+}
+
+// release frees the places of a completed job in classes and in the slots
+// of the cluster wide ones, and starts the jobs waiting for them.
+func (s *scheduler) release(classes []ClassConfig, slots []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, class := range classes {
+		if class.Scope != ScopeCluster {
+			s.running[class.Name]--
+		}
+	}
+	s.releaseSlots(slots)
+	s.dispatch()
+}
+
+// releaseSlots deletes the slots of the cluster wide classes at keys.
+func (s *scheduler) releaseSlots(keys []string) {
+	for _, key := range keys {
+		if _, err := s.kv.Delete(key); err != nil && err != kvdb.ErrNotFound {
+			logrus.WithField("pkg", "openstorage/jobs").
+				WithField("slot", key).
+				Errorf("failed to release job slot: %v", err)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blocker returns a job function blocking until the returned channel is
+// closed, and a channel receiving name once it started.
+func blocker(name string, started chan<- string) (func() error, chan struct{}) {
+	unblock := make(chan struct{})
+	return func() error {
+		started <- name
+		<-unblock
+		return nil
+	}, unblock
+}
+
+func TestSchedulerNodeClass(t *testing.T) {
+	m := newTestManager(t)
+	require.NoError(t, m.SetSchedulerConfig(&SchedulerConfig{
+		Classes: []ClassConfig{{Name: "movers", JobTypes: []string{"move", "urgent"}, Limit: 1}},
+		Priorities: map[string]int{
+			"urgent": 10,
+		},
+	}))
+
+	started := make(chan string, 3)
+	first, unblockFirst := blocker("first", started)
+	second, unblockSecond := blocker("second", started)
+	urgent, unblockUrgent := blocker("urgent", started)
+	defer close(unblockSecond)
+	defer close(unblockUrgent)
+
+	_, err := m.Submit("move", "vol1", first)
+	require.NoError(t, err)
+	assert.Equal(t, "first", <-started)
+	job, err := m.Submit("move", "vol2", second)
+	require.NoError(t, err)
+	_, err = m.Submit("urgent", "vol3", urgent)
+	require.NoError(t, err)
+
+	// the class is full
+	select {
+	case name := <-started:
+		t.Fatalf("job %s started beyond the class limit", name)
+	case <-time.After(50 * time.Millisecond):
+	}
+	job, err = m.Inspect(job.Id)
+	require.NoError(t, err)
+	assert.Equal(t, StatePending, job.State)
+
+	// the job of higher priority runs first
+	close(unblockFirst)
+	assert.Equal(t, "urgent", <-started)
+}
+
+func TestSchedulerClusterClass(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	config := &SchedulerConfig{
+		Classes: []ClassConfig{{Name: "backups", JobTypes: []string{"backup"}, Limit: 1, Scope: ScopeCluster}},
+	}
+	// managers of two nodes
+	m1, m2 := NewManager(kv), NewManager(kv)
+	require.NoError(t, m1.SetSchedulerConfig(config))
+	require.NoError(t, m2.SetSchedulerConfig(config))
+
+	started := make(chan string, 2)
+	first, unblock := blocker("first", started)
+	job, err := m1.Submit("backup", "vol1", first)
+	require.NoError(t, err)
+	assert.Equal(t, "first", <-started)
+
+	second := func() error {
+		started <- "second"
+		return nil
+	}
+	_, err = m2.Submit("backup", "vol2", second)
+	require.NoError(t, err)
+	select {
+	case <-started:
+		t.Fatal("job started beyond the cluster wide class limit")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(unblock)
+	waitForJob(t, m1, job.Id)
+	select {
+	case name := <-started:
+		assert.Equal(t, "second", name)
+	case <-time.After(2 * slotPollInterval):
+		t.Fatal("job did not start once the slot was released")
+	}
+}
+
+func TestSchedulerReclaimSlot(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	// the slot of a job which is not running anymore
+	_, err = kv.Create(slotsKey+"/backups/0", "dead", 0)
+	require.NoError(t, err)
+
+	m := NewManager(kv)
+	require.NoError(t, m.SetSchedulerConfig(&SchedulerConfig{
+		Classes: []ClassConfig{{Name: "backups", JobTypes: []string{"backup"}, Limit: 1, Scope: ScopeCluster}},
+	}))
+	job, err := m.Submit("backup", "vol1", func() error { return nil })
+	require.NoError(t, err)
+	job = waitForJob(t, m, job.Id)
+	assert.Equal(t, StateDone, job.State)
+}
+
+func TestSchedulerConfigValidate(t *testing.T) {
+	for _, c := range []SchedulerConfig{
+		{Classes: []ClassConfig{{JobTypes: []string{"backup"}, Limit: 1}}},
+		{Classes: []ClassConfig{{Name: "backups", Limit: 0}}},
+		{Classes: []ClassConfig{{Name: "backups", Limit: 1, Scope: "region"}}},
+		{Classes: []ClassConfig{{Name: "backups", Limit: 1}, {Name: "backups", Limit: 2}}},
+	} {
+		assert.Error(t, c.Validate(), "%+v", c)
+	}
+}
@@ -0,0 +1,133 @@
+// Package kvdbhealth monitors the connection to the kvdb backend and its
+// endpoints, raises alerts when quorum is lost, and tells the API servers
+// to serve read only requests while kvdb is down.
+package kvdbhealth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// State of the kvdb backend.
+type State string
+
+const (
+	// StateHealthy indicates that kvdb and all its endpoints are reachable
+	StateHealthy State = "healthy"
+	// StateDegraded indicates that kvdb is reachable but some endpoints are not
+	StateDegraded State = "degraded"
+	// StateDown indicates that kvdb is unreachable or has lost quorum
+	StateDown State = "down"
+)
+
+const (
+	// DefaultInterval is the time between checks while kvdb is healthy
+	DefaultInterval = 10 * time.Second
+	// DefaultMaxBackoff is the longest time between reconnect attempts
+	// while kvdb is down
+	DefaultMaxBackoff = 2 * time.Minute
+
+	// ResourceID is the resource id of the alerts raised for kvdb
+	ResourceID = "kvdb"
+	// AlertTypeBase is the base of the alert types of the kvdbhealth component
+	AlertTypeBase int64 = 4100
+	// AlertTypeQuorumLost is raised on the cluster when kvdb is down
+	AlertTypeQuorumLost = AlertTypeBase + 1
+	// AlertTypeEndpointDown is raised on the cluster, with the endpoint as
+	// resource id, when an endpoint is unreachable
+	AlertTypeEndpointDown = AlertTypeBase + 2
+)
+
+func init() {
+	alerts.MustRegisterComponent("kvdbhealth", AlertTypeBase).MustRegisterReasons(
+		alerts.Reason{
+			AlertType:   AlertTypeQuorumLost,
+			Code:        "KVDB_QUORUM_LOST",
+			Resource:    api.ResourceType_RESOURCE_TYPE_CLUSTER,
+			Description: "kvdb is unreachable or has lost its quorum, the API is read only",
+		},
+		alerts.Reason{
+			AlertType:   AlertTypeEndpointDown,
+			Code:        "KVDB_ENDPOINT_DOWN",
+			Resource:    api.ResourceType_RESOURCE_TYPE_CLUSTER,
+			Description: "a kvdb endpoint, the resource id, is unreachable",
+		},
+	)
+}
+
+var (
+	// ErrNotInitialized returned when the monitor has not been initialized
+	ErrNotInitialized = errors.New("openstorage.kvdbhealth: not initialized")
+	// ErrInitialized returned when the monitor is initialized twice
+	ErrInitialized = errors.New("openstorage.kvdbhealth: already initialized")
+	// ErrReadOnly returned by the API servers for writes while kvdb is down
+	ErrReadOnly = errors.New("kvdb is unavailable, the API is read only")
+
+	inst *Monitor
+)
+
+// Config configures kvdb health monitoring.
+type Config struct {
+	// Interval between checks while healthy, DefaultInterval if unset
+	Interval time.Duration `yaml:"interval"`
+	// MaxBackoff between reconnect attempts while down, DefaultMaxBackoff
+	// if unset
+	MaxBackoff time.Duration `yaml:"max_backoff"`
+}
+
+// EndpointStatus is the health of a kvdb endpoint.
+// swagger:model
+type EndpointStatus struct {
+	// Endpoint url
+	Endpoint string
+	// Healthy is true if the endpoint responded to the last probe
+	Healthy bool
+	// Error returned by the last probe
+	Error string
+}
+
+// Status is the health of the kvdb backend.
+// swagger:model
+type Status struct {
+	// State of the backend
+	State State
+	// Since is when the backend entered the state
+	Since time.Time
+	// LastCheck is when the backend was last checked
+	LastCheck time.Time
+	// Error returned by the last backend probe
+	Error string
+	// Failures is the number of consecutive failed checks
+	Failures int
+	// Endpoints health
+	Endpoints []EndpointStatus
+}
+
+// Init instantiates the process wide monitor.
+func Init(m *Monitor) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = m
+	return nil
+}
+
+// Inst returns the process wide monitor.
+func Inst() (*Monitor, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// ReadOnly returns true if the API must only serve read requests because
+// kvdb is down. It returns false if the monitor has not been initialized.
+func ReadOnly() bool {
+	if inst == nil {
+		return false
+	}
+	return inst.Status().State == StateDown
+}
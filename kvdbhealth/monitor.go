@@ -0,0 +1,236 @@
+package kvdbhealth
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+// healthKey is read to check that kvdb serves requests.
+const healthKey = "health"
+
+// Probe checks a kvdb endpoint.
+type Probe func(endpoint string) error
+
+// NewHTTPProbe returns a probe sending a GET request for path to the
+// endpoint, for example /health for etcd or /v1/status/leader for consul.
+func NewHTTPProbe(path string, timeout time.Duration) Probe {
+	client := &http.Client{Timeout: timeout}
+	return func(endpoint string) error {
+		resp, err := client.Get(endpoint + path)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("%s%s returned %s", endpoint, path, resp.Status)
+		}
+		return nil
+	}
+}
+
+// Monitor checks the health of kvdb and its endpoints.
+type Monitor struct {
+	sync.Mutex
+	kv        kvdb.Kvdb
+	endpoints []string
+	probe     Probe
+	alerts    alerts.Manager
+	config    Config
+	status    Status
+	// raised alerts, keyed by alert type and resource id, so that they are
+	// cleared on recovery and raised again if kvdb was down when raised
+	raised map[string]*alertState
+}
+
+// alertState tracks whether an alert reached the alerts manager.
+type alertState struct {
+	alert     *api.Alert
+	stored    bool
+	published bool
+}
+
+// NewMonitor returns a monitor of kv, probing each endpoint with probe.
+// Alerts are raised with manager if not nil.
+func NewMonitor(
+	kv kvdb.Kvdb,
+	endpoints []string,
+	probe Probe,
+	manager alerts.Manager,
+	c Config,
+) *Monitor {
+	if c.Interval == 0 {
+		c.Interval = DefaultInterval
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = DefaultMaxBackoff
+	}
+	return &Monitor{
+		kv:        kv,
+		endpoints: endpoints,
+		probe:     probe,
+		alerts:    manager,
+		config:    c,
+		status:    Status{State: StateHealthy, Since: time.Now()},
+		raised:    make(map[string]*alertState),
+	}
+}
+
+// Status returns the health of kvdb as of the last check.
+func (m *Monitor) Status() *Status {
+	m.Lock()
+	defer m.Unlock()
+	s := m.status
+	s.Endpoints = append([]EndpointStatus{}, m.status.Endpoints...)
+	return &s
+}
+
+// Check probes kvdb and its endpoints and raises or clears alerts.
+func (m *Monitor) Check(now time.Time) *Status {
+	var kvErr error
+	if _, err := m.kv.Get(healthKey); err != nil && err != kvdb.ErrNotFound {
+		kvErr = err
+	}
+	endpoints := make([]EndpointStatus, 0, len(m.endpoints))
+	healthy := 0
+	for _, e := range m.endpoints {
+		s := EndpointStatus{Endpoint: e, Healthy: true}
+		if m.probe != nil {
+			if err := m.probe(e); err != nil {
+				s.Healthy = false
+				s.Error = err.Error()
+			}
+		}
+		if s.Healthy {
+			healthy++
+		}
+		endpoints = append(endpoints, s)
+	}
+
+	state := StateHealthy
+	if kvErr != nil || (len(endpoints) != 0 && healthy*2 <= len(endpoints)) {
+		state = StateDown
+	} else if healthy < len(endpoints) {
+		state = StateDegraded
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if state != m.status.State {
+		logrus.WithField("pkg", "openstorage/kvdbhealth").
+			Warnf("kvdb is %s, was %s", state, m.status.State)
+		m.status.Since = now
+	}
+	m.status.State = state
+	m.status.LastCheck = now
+	m.status.Endpoints = endpoints
+	m.status.Error = ""
+	if kvErr != nil {
+		m.status.Error = kvErr.Error()
+	}
+	if state == StateDown {
+		m.status.Failures++
+	} else {
+		m.status.Failures = 0
+	}
+
+	m.setAlert(AlertTypeQuorumLost, ResourceID, api.SeverityType_SEVERITY_TYPE_ALARM,
+		state == StateDown, fmt.Sprintf("kvdb has lost quorum: %d of %d endpoints healthy, %s",
+			healthy, len(endpoints), m.status.Error))
+	for _, e := range endpoints {
+		m.setAlert(AlertTypeEndpointDown, e.Endpoint, api.SeverityType_SEVERITY_TYPE_WARNING,
+			!e.Healthy, fmt.Sprintf("kvdb endpoint %s is unreachable: %s", e.Endpoint, e.Error))
+	}
+	m.flushAlerts()
+
+	s := m.status
+	return &s
+}
+
+// Start checks kvdb at every interval while it is up, and backs off
+// exponentially up to the maximum backoff while it is down.
+func (m *Monitor) Start() {
+	go func() {
+		for {
+			s := m.Check(time.Now())
+			time.Sleep(m.nextCheck(s))
+		}
+	}()
+}
+
+func (m *Monitor) nextCheck(s *Status) time.Duration {
+	if s.State != StateDown {
+		return m.config.Interval
+	}
+	backoff := time.Second
+	for i := 1; i < s.Failures && backoff < m.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > m.config.MaxBackoff {
+		backoff = m.config.MaxBackoff
+	}
+	return backoff
+}
+
+// setAlert records the alert to raise, or to clear if it was raised.
+func (m *Monitor) setAlert(
+	alertType int64,
+	resourceID string,
+	severity api.SeverityType,
+	firing bool,
+	message string,
+) {
+	key := fmt.Sprintf("%d/%s", alertType, resourceID)
+	prev, ok := m.raised[key]
+	if ok && prev.alert.Cleared == !firing {
+		return
+	}
+	if !ok && !firing {
+		return
+	}
+	if !firing {
+		message = fmt.Sprintf("Resolved: %s", prev.alert.Message)
+	}
+	m.raised[key] = &alertState{alert: &api.Alert{
+		AlertType:  alertType,
+		Severity:   severity,
+		Resource:   api.ResourceType_RESOURCE_TYPE_CLUSTER,
+		ResourceId: resourceID,
+		Message:    message,
+		Cleared:    !firing,
+	}}
+}
+
+// flushAlerts raises the alerts not stored yet. Alerts are stored in kvdb,
+// so while it is down they are published on the event bus instead, which
+// forwards them to syslog and SNMP, and raised once kvdb is back.
+func (m *Monitor) flushAlerts() {
+	for key, a := range m.raised {
+		if a.stored {
+			continue
+		}
+		if m.alerts == nil || m.status.State == StateDown {
+			if !a.published {
+				eventbus.Publish(eventbus.EventAlertRaise, a.alert.ResourceId, a.alert)
+				a.published = true
+			}
+			if m.alerts != nil {
+				continue
+			}
+		} else if err := m.alerts.Raise(a.alert); err != nil {
+			continue
+		}
+		a.stored = true
+		if a.alert.Cleared {
+			delete(m.raised, key)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package kvdbhealth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnreachable = errors.New("unreachable")
+
+// flakyKvdb fails reads while down.
+type flakyKvdb struct {
+	kvdb.Kvdb
+	down bool
+}
+
+func (f *flakyKvdb) Get(key string) (*kvdb.KVPair, error) {
+	if f.down {
+		return nil, errUnreachable
+	}
+	return f.Kvdb.Get(key)
+}
+
+func newTestMonitor(t *testing.T, endpoints []string, probe Probe) (*Monitor, *flakyKvdb, alerts.Manager) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	flaky := &flakyKvdb{Kvdb: kv}
+	// Alerts are stored in a separate kvdb so that they can be checked
+	// while the monitored one is down.
+	akv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	manager, err := alerts.NewManager(akv)
+	require.NoError(t, err)
+	return NewMonitor(flaky, endpoints, probe, manager, Config{}), flaky, manager
+}
+
+func alertsByType(t *testing.T, manager alerts.Manager) map[int64][]*api.Alert {
+	list, err := manager.Enumerate()
+	require.NoError(t, err)
+	m := make(map[int64][]*api.Alert)
+	for _, a := range list {
+		m[a.AlertType] = append(m[a.AlertType], a)
+	}
+	return m
+}
+
+func TestCheckEndpoints(t *testing.T) {
+	down := map[string]bool{}
+	probe := func(e string) error {
+		if down[e] {
+			return errUnreachable
+		}
+		return nil
+	}
+	m, _, manager := newTestMonitor(t, []string{"http://a", "http://b", "http://c"}, probe)
+	now := time.Now()
+
+	s := m.Check(now)
+	assert.Equal(t, StateHealthy, s.State)
+	assert.Empty(t, alertsByType(t, manager))
+
+	down["http://a"] = true
+	s = m.Check(now)
+	assert.Equal(t, StateDegraded, s.State)
+	assert.False(t, s.Endpoints[0].Healthy)
+	raised := alertsByType(t, manager)
+	require.Len(t, raised[AlertTypeEndpointDown], 1)
+	assert.Equal(t, "http://a", raised[AlertTypeEndpointDown][0].ResourceId)
+	assert.Empty(t, raised[AlertTypeQuorumLost])
+
+	down["http://b"] = true
+	s = m.Check(now)
+	assert.Equal(t, StateDown, s.State)
+	raised = alertsByType(t, manager)
+	assert.Len(t, raised[AlertTypeEndpointDown], 1, "not stored while down")
+
+	down["http://a"], down["http://b"] = false, false
+	s = m.Check(now)
+	assert.Equal(t, StateHealthy, s.State)
+	raised = alertsByType(t, manager)
+	for _, a := range raised[AlertTypeEndpointDown] {
+		assert.True(t, a.Cleared, a.ResourceId)
+	}
+	require.Len(t, raised[AlertTypeQuorumLost], 1)
+	assert.True(t, raised[AlertTypeQuorumLost][0].Cleared)
+}
+
+func TestCheckKvdbDown(t *testing.T) {
+	m, kv, manager := newTestMonitor(t, nil, nil)
+	now := time.Now()
+
+	kv.down = true
+	s := m.Check(now)
+	assert.Equal(t, StateDown, s.State)
+	assert.Equal(t, errUnreachable.Error(), s.Error)
+	assert.Equal(t, 1, s.Failures)
+
+	// Backoff doubles up to the maximum
+	assert.Equal(t, time.Second, m.nextCheck(s))
+	m.Check(now)
+	s = m.Check(now)
+	assert.Equal(t, 4*time.Second, m.nextCheck(s))
+	s.Failures = 100
+	assert.Equal(t, DefaultMaxBackoff, m.nextCheck(s))
+
+	// The quorum alert is raised once kvdb is back, then cleared
+	kv.down = false
+	s = m.Check(now.Add(time.Minute))
+	assert.Equal(t, StateHealthy, s.State)
+	assert.Equal(t, DefaultInterval, m.nextCheck(s))
+	raised := alertsByType(t, manager)
+	require.Len(t, raised[AlertTypeQuorumLost], 1)
+	assert.True(t, raised[AlertTypeQuorumLost][0].Cleared)
+	assert.Equal(t, now.Add(time.Minute), m.Status().Since)
+}
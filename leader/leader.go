@@ -0,0 +1,249 @@
+// Package leader elects one of the nodes of a cluster as the active
+// instance of a service, such as the cluster API server, through a lease
+// stored in kvdb. The other nodes are standbys: they watch the lease and
+// take it over once the active node stops renewing it.
+package leader
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultTTL is the lifetime of a lease when none is configured
+	DefaultTTL = 15 * time.Second
+	// EndpointHeader is set by the standby API servers to the endpoint of
+	// the active one when they fail requests instead of proxying them
+	EndpointHeader = "X-Openstorage-Active"
+
+	kvdbKey = "leader"
+)
+
+var (
+	// ErrNotInitialized returned when the elector has not been initialized
+	ErrNotInitialized = errors.New("openstorage.leader: not initialized")
+	// ErrInitialized returned when the elector is initialized twice
+	ErrInitialized = errors.New("openstorage.leader: already initialized")
+
+	inst *Elector
+)
+
+// Config configures the election of the active API server.
+type Config struct {
+	// Enabled runs the API server of this node as a standby while another
+	// node holds the lease
+	Enabled bool `yaml:"enabled"`
+	// Endpoint is the URL the API server of this node is reachable at,
+	// such as https://10.0.0.1:9011
+	Endpoint string `yaml:"endpoint"`
+	// TTL of the lease, renewed every third of it, DefaultTTL if unset
+	TTL time.Duration `yaml:"ttl"`
+	// Proxy forwards the requests received by a standby to the active
+	// node. Standbys fail them with the endpoint of the active node
+	// otherwise.
+	Proxy bool `yaml:"proxy"`
+}
+
+func (c *Config) ttl() time.Duration {
+	if c.TTL == 0 {
+		return DefaultTTL
+	}
+	return c.TTL
+}
+
+// Lease is held by the active instance of a service.
+// swagger:model
+type Lease struct {
+	// NodeId of the active node
+	NodeId string
+	// Endpoint of the active node
+	Endpoint string
+	// Expires is when the lease may be taken over if not renewed
+	Expires time.Time
+}
+
+// Elector campaigns for the lease of a service on behalf of this node.
+type Elector struct {
+	kv     kvdb.Kvdb
+	key    string
+	nodeID string
+	config Config
+
+	lock  sync.RWMutex
+	lease *Lease
+	stop  chan struct{}
+}
+
+// Init sets the elector of the API server.
+func Init(e *Elector) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = e
+	return nil
+}
+
+// Inst returns the elector of the API server.
+func Inst() (*Elector, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// New returns an elector of this node for the lease of service.
+func New(kv kvdb.Kvdb, service, nodeID string, c *Config) *Elector {
+	return &Elector{
+		kv:     kv,
+		key:    kvdbKey + "/" + service,
+		nodeID: nodeID,
+		config: *c,
+	}
+}
+
+// Config returns the configuration of the elector.
+func (e *Elector) Config() Config {
+	return e.config
+}
+
+// IsLeader returns true if this node holds an unexpired lease.
+func (e *Elector) IsLeader() bool {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.lease != nil && e.lease.NodeId == e.nodeID && time.Now().Before(e.lease.Expires)
+}
+
+// Leader returns the last known lease, nil if none is held.
+func (e *Elector) Leader() *Lease {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	if e.lease == nil || !time.Now().Before(e.lease.Expires) {
+		return nil
+	}
+	lease := *e.lease
+	return &lease
+}
+
+// Campaign acquires or renews the lease for this node if it is free,
+// expired, or already held by this node, and records the current holder.
+func (e *Elector) Campaign(now time.Time) error {
+	lease := &Lease{
+		NodeId:   e.nodeID,
+		Endpoint: e.config.Endpoint,
+		Expires:  now.Add(e.config.ttl()),
+	}
+	kvp, err := e.kv.Get(e.key)
+	if err == kvdb.ErrNotFound {
+		if _, err = e.kv.Create(e.key, lease, 0); err == kvdb.ErrExist {
+			// Another node acquired the lease first
+			return e.refresh()
+		} else if err != nil {
+			return err
+		}
+		e.set(lease)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var current Lease
+	if err := json.Unmarshal(kvp.Value, &current); err != nil {
+		return err
+	}
+	if current.NodeId != e.nodeID && now.Before(current.Expires) {
+		e.set(&current)
+		return nil
+	}
+	value, err := json.Marshal(lease)
+	if err != nil {
+		return err
+	}
+	// Compare with the lease read so that only one standby takes over
+	kvp.Value = value
+	if _, err := e.kv.CompareAndSet(kvp, kvdb.KVModifiedIndex, nil); err == kvdb.ErrValueMismatch {
+		return e.refresh()
+	} else if err != nil {
+		return err
+	}
+	if current.NodeId != e.nodeID {
+		logrus.WithField("pkg", "openstorage/leader").
+			Infof("Node %s took over %s from node %s", e.nodeID, e.key, current.NodeId)
+	}
+	e.set(lease)
+	return nil
+}
+
+// Resign releases the lease if this node holds it, so that a standby
+// takes over without waiting for it to expire.
+func (e *Elector) Resign() error {
+	if e.stop != nil {
+		close(e.stop)
+		e.stop = nil
+	}
+	if !e.IsLeader() {
+		return nil
+	}
+	e.set(nil)
+	kvp, err := e.kv.Get(e.key)
+	if err == kvdb.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var current Lease
+	if err := json.Unmarshal(kvp.Value, &current); err != nil {
+		return err
+	}
+	if current.NodeId != e.nodeID {
+		return nil
+	}
+	_, err = e.kv.CompareAndDelete(kvp, 0)
+	if err == kvdb.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// Start campaigns every third of the lease TTL until Resign is called.
+func (e *Elector) Start() {
+	e.stop = make(chan struct{})
+	go func(stop chan struct{}) {
+		ticker := time.NewTicker(e.config.ttl() / 3)
+		defer ticker.Stop()
+		for {
+			if err := e.Campaign(time.Now()); err != nil {
+				logrus.WithField("pkg", "openstorage/leader").
+					Warnf("Failed to campaign for %s: %v", e.key, err)
+			}
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}(e.stop)
+}
+
+// refresh records the lease currently stored.
+func (e *Elector) refresh() error {
+	var lease Lease
+	if _, err := e.kv.GetVal(e.key, &lease); err == kvdb.ErrNotFound {
+		e.set(nil)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	e.set(&lease)
+	return nil
+}
+
+func (e *Elector) set(lease *Lease) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.lease = lease
+}
@@ -0,0 +1,48 @@
+package leader
+
+import (
+	"testing"
+	"time"
+
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestElection(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+
+	c := &Config{Enabled: true, TTL: time.Minute}
+	a := New(kv, "api", "node1", &Config{Enabled: true, Endpoint: "http://node1:9001", TTL: time.Minute})
+	b := New(kv, "api", "node2", c)
+
+	now := time.Now()
+	require.NoError(t, a.Campaign(now))
+	require.NoError(t, b.Campaign(now))
+	assert.True(t, a.IsLeader())
+	assert.False(t, b.IsLeader())
+	lease := b.Leader()
+	require.NotNil(t, lease)
+	assert.Equal(t, "node1", lease.NodeId)
+	assert.Equal(t, "http://node1:9001", lease.Endpoint)
+
+	// renewing keeps the lease
+	require.NoError(t, a.Campaign(now.Add(30*time.Second)))
+	require.NoError(t, b.Campaign(now.Add(time.Minute+time.Second)))
+	assert.False(t, b.IsLeader())
+
+	// standby takes over an expired lease
+	require.NoError(t, b.Campaign(now.Add(2*time.Minute)))
+	assert.True(t, b.IsLeader())
+	require.NoError(t, a.Campaign(now.Add(2*time.Minute)))
+	assert.False(t, a.IsLeader())
+	assert.Equal(t, "node2", a.Leader().NodeId)
+
+	// resigning frees the lease
+	require.NoError(t, b.Resign())
+	assert.False(t, b.IsLeader())
+	require.NoError(t, a.Campaign(now.Add(2*time.Minute)))
+	assert.True(t, a.IsLeader())
+}
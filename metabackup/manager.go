@@ -0,0 +1,227 @@
+package metabackup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/libopenstorage/openstorage/jobs"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+// Manager takes and restores the metadata backups of a cluster.
+type Manager struct {
+	kv        kvdb.Kvdb
+	store     Store
+	clusterID string
+	config    Config
+	key       []byte
+}
+
+// NewManager returns a manager backing up the cluster metadata in kv to
+// store, as configured by c. The object store of c is not used.
+func NewManager(kv kvdb.Kvdb, store Store, clusterID string, c *Config) (*Manager, error) {
+	key, err := c.key()
+	if err != nil {
+		return nil, err
+	}
+	if len(clusterID) == 0 {
+		return nil, fmt.Errorf("Missing cluster id")
+	}
+	return &Manager{
+		kv:        kv,
+		store:     store,
+		clusterID: clusterID,
+		config:    *c,
+		key:       key,
+	}, nil
+}
+
+// Backup writes an encrypted copy of every kvdb key of the cluster to the
+// store and returns the name of the backup. Keys with a TTL, such as
+// locks, are transient and not backed up. Backups beyond the retention
+// count are deleted, oldest first. The backups are not written if the
+// crypto policy does not allow their cipher.
+func (m *Manager) Backup(now time.Time) (string, error) {
+	if err := crypto.GetPolicy().CheckCipher(crypto.DefaultBackupCipher); err != nil {
+		return "", fmt.Errorf("Backup encryption not allowed: %v", err)
+	}
+	kvps, err := m.kv.Enumerate("")
+	if err != nil {
+		return "", err
+	}
+	b := &Backup{
+		Version:    backupVersion,
+		ClusterId:  m.clusterID,
+		CreateTime: now.UTC(),
+	}
+	for _, kvp := range kvps {
+		if kvp.TTL > 0 {
+			continue
+		}
+		b.Entries = append(b.Entries, Entry{Key: kvp.Key, Value: kvp.Value})
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(b); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	data, err := crypto.Encrypt(m.key, buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	name := b.CreateTime.Format(nameFormat)
+	if err := m.store.Put(m.objectKey(name), data); err != nil {
+		return "", fmt.Errorf("Failed to upload metadata backup %s: %v", name, err)
+	}
+	if err := m.prune(); err != nil {
+		logrus.WithField("pkg", "openstorage/metabackup").
+			Warnf("Failed to delete old metadata backups: %v", err)
+	}
+	return name, nil
+}
+
+// List returns the names of the backups of the cluster, oldest first.
+func (m *Manager) List() ([]string, error) {
+	keys, err := m.store.List(m.objectKey(""))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(keys))
+	for _, k := range keys {
+		names = append(names, path.Base(k))
+	}
+	return names, nil
+}
+
+// Get returns the content of the named backup, or of the latest backup if
+// name is empty.
+// Errors ErrNoBackup may be returned.
+func (m *Manager) Get(name string) (*Backup, error) {
+	if len(name) == 0 {
+		names, err := m.List()
+		if err != nil {
+			return nil, err
+		}
+		if len(names) == 0 {
+			return nil, ErrNoBackup
+		}
+		name = names[len(names)-1]
+	}
+
+	data, err := m.store.Get(m.objectKey(name))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to download metadata backup %s: %v", name, err)
+	}
+	data, err = crypto.Decrypt(m.key, data)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decrypt metadata backup %s: %v", name, err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if data, err = ioutil.ReadAll(zr); err != nil {
+		return nil, err
+	}
+	var b Backup
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, err
+	}
+	if b.Version != backupVersion {
+		return nil, fmt.Errorf("Unsupported metadata backup version %d", b.Version)
+	}
+	return &b, nil
+}
+
+// Restore writes the keys of the named backup, or of the latest backup if
+// name is empty, back to kvdb and returns the number of keys restored.
+// Keys created after the backup are left in place. The daemons of the
+// cluster must be stopped while it runs.
+func (m *Manager) Restore(name string) (int, error) {
+	b, err := m.Get(name)
+	if err != nil {
+		return 0, err
+	}
+	if b.ClusterId != m.clusterID {
+		return 0, fmt.Errorf("Metadata backup belongs to cluster %s, not %s", b.ClusterId, m.clusterID)
+	}
+	for i, e := range b.Entries {
+		if _, err := m.kv.Put(e.Key, e.Value, 0); err != nil {
+			return i, fmt.Errorf("Failed to restore %s: %v", e.Key, err)
+		}
+	}
+	return len(b.Entries), nil
+}
+
+// Start takes a backup every configured interval, tracked as a job. Every
+// node of the cluster may run it: a node skips the backup if another one
+// has taken it during the last half interval.
+func (m *Manager) Start() {
+	go func() {
+		for range time.Tick(m.config.interval()) {
+			if err := m.submit(); err != nil {
+				logrus.WithField("pkg", "openstorage/metabackup").
+					Warnf("Failed to schedule metadata backup: %v", err)
+			}
+		}
+	}()
+}
+
+func (m *Manager) submit() error {
+	if due, err := m.due(time.Now()); err != nil || !due {
+		return err
+	}
+	jm, err := jobs.Inst()
+	if err != nil {
+		return err
+	}
+	_, err = jm.Submit(JobType, m.clusterID, func() error {
+		_, err := m.Backup(time.Now())
+		return err
+	})
+	return err
+}
+
+// due returns true if no backup was taken during the last half interval.
+func (m *Manager) due(now time.Time) (bool, error) {
+	names, err := m.List()
+	if err != nil || len(names) == 0 {
+		return true, err
+	}
+	last, err := time.Parse(nameFormat, names[len(names)-1])
+	if err != nil {
+		return true, nil
+	}
+	return now.Sub(last) >= m.config.interval()/2, nil
+}
+
+func (m *Manager) prune() error {
+	names, err := m.List()
+	if err != nil {
+		return err
+	}
+	for len(names) > m.config.retention() {
+		if err := m.store.Delete(m.objectKey(names[0])); err != nil {
+			return err
+		}
+		names = names[1:]
+	}
+	return nil
+}
+
+func (m *Manager) objectKey(name string) string {
+	return strings.Trim(m.config.prefix(), "/") + "/" + m.clusterID + "/" + name
+}
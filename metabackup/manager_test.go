@@ -0,0 +1,196 @@
+package metabackup
+
+import (
+	"encoding/base64"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+	"github.com/libopenstorage/openstorage/pkg/s3"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memStore map[string][]byte
+
+func (s memStore) Put(key string, data []byte) error {
+	s[key] = data
+	return nil
+}
+
+func (s memStore) Get(key string) ([]byte, error) {
+	data, ok := s[key]
+	if !ok {
+		return nil, s3.ErrNotFound
+	}
+	return data, nil
+}
+
+func (s memStore) List(prefix string) ([]string, error) {
+	var keys []string
+	for k := range s {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s memStore) Delete(key string) error {
+	delete(s, key)
+	return nil
+}
+
+func newTestManager(t *testing.T, store Store, c *Config) (*Manager, kvdb.Kvdb) {
+	kv, err := kvdb.New(mem.Name, "test", []string{}, nil, nil)
+	require.NoError(t, err)
+	if len(c.EncryptionKey) == 0 {
+		key, err := crypto.NewKey()
+		require.NoError(t, err)
+		c.EncryptionKey = base64.StdEncoding.EncodeToString(key)
+	}
+	m, err := NewManager(kv, store, "cluster1", c)
+	require.NoError(t, err)
+	return m, kv
+}
+
+func TestBackupRestore(t *testing.T) {
+	store := memStore{}
+	m, kv := newTestManager(t, store, &Config{})
+
+	_, err := kv.Put("volumes/vol1", []byte(`{"id":"vol1"}`), 0)
+	require.NoError(t, err)
+	_, err = kv.Put("cluster/database", []byte("db"), 0)
+	require.NoError(t, err)
+	_, err = kv.Put("locks/vol1", []byte("node1"), 60)
+	require.NoError(t, err)
+
+	now := time.Date(2018, 5, 1, 12, 0, 0, 0, time.UTC)
+	name, err := m.Backup(now)
+	require.NoError(t, err)
+	assert.Equal(t, "20180501T120000Z", name)
+
+	data, ok := store[DefaultPrefix+"/cluster1/"+name]
+	require.True(t, ok)
+	assert.NotContains(t, string(data), "vol1", "backups are encrypted")
+
+	b, err := m.Get("")
+	require.NoError(t, err)
+	assert.Equal(t, "cluster1", b.ClusterId)
+	assert.Len(t, b.Entries, 2, "keys with a TTL are not backed up")
+
+	_, err = kv.Delete("volumes/vol1")
+	require.NoError(t, err)
+	_, err = kv.Put("cluster/database", []byte("changed"), 0)
+	require.NoError(t, err)
+
+	n, err := m.Restore(name)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	kvp, err := kv.Get("volumes/vol1")
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"vol1"}`, string(kvp.Value))
+	kvp, err = kv.Get("cluster/database")
+	require.NoError(t, err)
+	assert.Equal(t, "db", string(kvp.Value))
+}
+
+func TestRetention(t *testing.T) {
+	store := memStore{}
+	m, _ := newTestManager(t, store, &Config{Retention: 2})
+
+	now := time.Date(2018, 5, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		_, err := m.Backup(now.Add(time.Duration(i) * time.Hour))
+		require.NoError(t, err)
+	}
+	names, err := m.List()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20180501T140000Z", "20180501T150000Z"}, names)
+
+	b, err := m.Get("")
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(3*time.Hour), b.CreateTime)
+}
+
+func TestRestoreErrors(t *testing.T) {
+	store := memStore{}
+	m, _ := newTestManager(t, store, &Config{})
+
+	_, err := m.Restore("")
+	assert.Equal(t, ErrNoBackup, err)
+
+	name, err := m.Backup(time.Now())
+	require.NoError(t, err)
+
+	// Wrong key
+	other, _ := newTestManager(t, store, &Config{})
+	_, err = other.Restore(name)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "decrypt")
+
+	// Wrong cluster
+	c := m.config
+	other, err = NewManager(m.kv, store, "cluster2", &c)
+	require.NoError(t, err)
+	store[other.objectKey(name)] = store[m.objectKey(name)]
+	_, err = other.Restore(name)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cluster1")
+}
+
+func TestBackupCryptoPolicy(t *testing.T) {
+	store := memStore{}
+	m, _ := newTestManager(t, store, &Config{})
+
+	require.NoError(t, crypto.SetPolicy(&crypto.Policy{Ciphers: []string{crypto.DefaultCipher}}))
+	defer crypto.SetPolicy(&crypto.Policy{})
+	_, err := m.Backup(time.Now())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not allowed")
+	assert.Empty(t, store)
+}
+
+func TestConfigValidate(t *testing.T) {
+	c := &Config{
+		ObjectStore: s3.Config{
+			Endpoint:  "http://minio:9000",
+			Bucket:    "backups",
+			AccessKey: "access",
+			SecretKey: "secret",
+		},
+	}
+	assert.True(t, c.Enabled())
+	assert.Error(t, c.Validate(), "missing key")
+
+	c.EncryptionKey = base64.StdEncoding.EncodeToString([]byte("short"))
+	assert.Error(t, c.Validate())
+
+	key, err := crypto.NewKey()
+	require.NoError(t, err)
+	c.EncryptionKey = base64.StdEncoding.EncodeToString(key)
+	assert.NoError(t, c.Validate())
+}
+
+func TestDue(t *testing.T) {
+	m, _ := newTestManager(t, memStore{}, &Config{Interval: time.Hour})
+
+	now := time.Date(2018, 5, 1, 12, 0, 0, 0, time.UTC)
+	due, err := m.due(now)
+	require.NoError(t, err)
+	assert.True(t, due)
+
+	_, err = m.Backup(now)
+	require.NoError(t, err)
+	due, err = m.due(now.Add(20 * time.Minute))
+	require.NoError(t, err)
+	assert.False(t, due)
+	due, err = m.due(now.Add(30 * time.Minute))
+	require.NoError(t, err)
+	assert.True(t, due)
+}
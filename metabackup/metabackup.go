@@ -0,0 +1,133 @@
+// Package metabackup backs up the cluster metadata held in kvdb to object
+// storage, so that the control plane can be recovered independently of the
+// volume data backups.
+package metabackup
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+	"github.com/libopenstorage/openstorage/pkg/s3"
+)
+
+const (
+	// JobType is the job type of the scheduled metadata backups
+	JobType = "metabackup"
+	// DefaultInterval is the time between two scheduled backups
+	DefaultInterval = 24 * time.Hour
+	// DefaultRetention is the number of backups kept per cluster
+	DefaultRetention = 7
+	// DefaultPrefix is the object key prefix of the backups
+	DefaultPrefix = "metadata-backups"
+
+	// backupVersion is the version of the backup format
+	backupVersion = 1
+	// nameFormat formats the backup creation time into its name, so that
+	// backups sort by age
+	nameFormat = "20060102T150405Z"
+)
+
+var (
+	// ErrNoBackup returned when a cluster has no metadata backup
+	ErrNoBackup = errors.New("No metadata backup found")
+)
+
+// Store holds the backup objects. It is implemented by *s3.Client.
+type Store interface {
+	// Put writes data to the object named key
+	Put(key string, data []byte) error
+	// Get returns the content of the object named key
+	Get(key string) ([]byte, error)
+	// List returns the keys starting with prefix in lexicographic order
+	List(prefix string) ([]string, error)
+	// Delete removes the object named key
+	Delete(key string) error
+}
+
+// Config configures the metadata backups.
+// swagger:model
+type Config struct {
+	// Interval between scheduled backups, DefaultInterval if not set
+	Interval time.Duration `yaml:"interval"`
+	// Retention is the number of backups kept, DefaultRetention if not set
+	Retention int `yaml:"retention"`
+	// Prefix of the backup object keys, DefaultPrefix if not set
+	Prefix string `yaml:"prefix"`
+	// EncryptionKey is the base64 encoded 32 byte key encrypting the backups
+	EncryptionKey string `yaml:"encryption_key"`
+	// ObjectStore is the bucket the backups are written to
+	ObjectStore s3.Config `yaml:"object_store"`
+}
+
+// Enabled returns true if an object store is configured.
+func (c *Config) Enabled() bool {
+	return len(c.ObjectStore.Endpoint) != 0
+}
+
+// Validate checks that the object store and the encryption key are usable.
+func (c *Config) Validate() error {
+	if err := c.ObjectStore.Validate(); err != nil {
+		return err
+	}
+	if _, err := c.key(); err != nil {
+		return err
+	}
+	if c.Interval < 0 || c.Retention < 0 {
+		return fmt.Errorf("Metadata backup interval and retention must not be negative")
+	}
+	return nil
+}
+
+func (c *Config) key() ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(c.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid metadata backup encryption key: %v", err)
+	}
+	if len(key) != crypto.KeySize {
+		return nil, fmt.Errorf("Metadata backup encryption key must be %d bytes", crypto.KeySize)
+	}
+	return key, nil
+}
+
+func (c *Config) interval() time.Duration {
+	if c.Interval == 0 {
+		return DefaultInterval
+	}
+	return c.Interval
+}
+
+func (c *Config) retention() int {
+	if c.Retention == 0 {
+		return DefaultRetention
+	}
+	return c.Retention
+}
+
+func (c *Config) prefix() string {
+	if len(c.Prefix) == 0 {
+		return DefaultPrefix
+	}
+	return c.Prefix
+}
+
+// Entry is a kvdb key and its value.
+type Entry struct {
+	Key   string
+	Value []byte
+}
+
+// Backup is the content of a metadata backup.
+// swagger:model
+type Backup struct {
+	// Version of the backup format
+	Version int
+	// ClusterId of the cluster backed up
+	ClusterId string
+	// CreateTime is when the backup was taken
+	CreateTime time.Time
+	// Entries are the kvdb keys of the cluster
+	Entries []Entry
+}
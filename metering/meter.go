@@ -0,0 +1,151 @@
+package metering
+
+import (
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/leader"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const bytesPerGiB = float64(1 << 30)
+
+// Meter periodically measures the usage of the volumes of a driver and
+// writes the records to a sink.
+type Meter struct {
+	driverName string
+	driver     func() (volume.VolumeDriver, error)
+	sink       Sink
+	elector    *leader.Elector
+}
+
+// NewMeter returns a meter for the volumes of the driver returned by
+// driver, which is only called when the usage is collected so that lazy
+// drivers are not created at start. In a cluster, the usage is reported by
+// the node elected by elector only, so that every volume is metered once.
+// elector is nil on a single node.
+func NewMeter(
+	driverName string,
+	driver func() (volume.VolumeDriver, error),
+	sink Sink,
+	elector *leader.Elector,
+) *Meter {
+	return &Meter{driverName: driverName, driver: driver, sink: sink, elector: elector}
+}
+
+// Start writes the records of each interval to the sink. A period whose
+// records could not be delivered is merged into the next one.
+func (m *Meter) Start(interval time.Duration) {
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+	go func() {
+		start := time.Now()
+		for end := range time.Tick(interval) {
+			start = m.tick(start, end)
+		}
+	}()
+}
+
+// tick reports the usage of the period from start to end if this node is
+// elected, and returns the start of the next period. The periods are not
+// merged on the other nodes, as the elected node reports them.
+func (m *Meter) tick(start, end time.Time) time.Time {
+	if m.elector != nil && !m.elector.IsLeader() {
+		return end
+	}
+	if err := m.Report(start, end); err != nil {
+		logrus.WithField("pkg", "openstorage/metering").
+			WithField("driver", m.driverName).
+			Warnf("failed to report usage since %v: %v", start, err)
+		return start
+	}
+	return end
+}
+
+// Report collects the records for the period and writes them to the sink.
+func (m *Meter) Report(start, end time.Time) error {
+	records, err := m.Collect(start, end)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	return m.sink.Write(records)
+}
+
+// Collect returns the usage records of the period from start to end.
+func (m *Meter) Collect(start, end time.Time) ([]*Record, error) {
+	hours := end.Sub(start).Hours()
+
+	d, err := m.driver()
+	if err != nil {
+		return nil, err
+	}
+	vols, err := d.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var records []*Record
+	for _, v := range vols {
+		metric := MetricVolumeGBHours
+		if v.IsSnapshot() {
+			metric = MetricSnapshotGBHours
+		}
+		r := m.newRecord(v, metric, UnitGBHours, start, end)
+		r.Value = float64(v.GetSpec().GetSize()) / bytesPerGiB * hours
+		records = append(records, r)
+	}
+
+	resp, err := d.CloudBackupStatus(&api.CloudBackupStatusRequest{})
+	if err == volume.ErrNotSupported {
+		return records, nil
+	} else if err != nil {
+		return nil, err
+	}
+	transferred := make(map[string]uint64)
+	for _, status := range resp.Statuses {
+		if status.OpType != api.CloudBackupOp ||
+			status.Status != api.CloudBackupStatusDone ||
+			status.CompletedTime.Before(start) ||
+			!status.CompletedTime.Before(end) {
+			continue
+		}
+		transferred[status.SrcVolumeID] += status.BytesDone
+	}
+	for _, v := range vols {
+		bytes, ok := transferred[v.GetId()]
+		if !ok {
+			continue
+		}
+		r := m.newRecord(v, MetricBackupBytes, UnitBytes, start, end)
+		r.Value = float64(bytes)
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+func (m *Meter) newRecord(v *api.Volume, metric, unit string, start, end time.Time) *Record {
+	return &Record{
+		Id:         uuid.New(),
+		Driver:     m.driverName,
+		ResourceId: v.GetId(),
+		Tenant:     label(v, api.LabelTenant),
+		Namespace:  label(v, api.LabelNamespace),
+		Metric:     metric,
+		Unit:       unit,
+		StartTime:  start,
+		EndTime:    end,
+	}
+}
+
+// label returns the value of a volume label from the locator or the spec.
+func label(v *api.Volume, key string) string {
+	if val, ok := v.GetLocator().GetVolumeLabels()[key]; ok {
+		return val
+	}
+	return v.GetSpec().GetVolumeLabels()[key]
+}
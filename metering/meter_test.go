@@ -0,0 +1,156 @@
+package metering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/leader"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	records []*Record
+	err     error
+}
+
+func (s *fakeSink) Write(records []*Record) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, records...)
+	return nil
+}
+
+func driver(d volume.VolumeDriver) func() (volume.VolumeDriver, error) {
+	return func() (volume.VolumeDriver, error) {
+		return d, nil
+	}
+}
+
+func testVolumes() []*api.Volume {
+	return []*api.Volume{
+		{
+			Id: "vol1",
+			Locator: &api.VolumeLocator{
+				VolumeLabels: map[string]string{api.LabelTenant: "acme"},
+			},
+			Spec: &api.VolumeSpec{Size: 10 << 30},
+		},
+		{
+			Id:       "snap1",
+			Locator:  &api.VolumeLocator{},
+			Spec:     &api.VolumeSpec{Size: 2 << 30},
+			Source:   &api.Source{Parent: "vol1"},
+			Readonly: true,
+		},
+	}
+}
+
+func TestCollect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+
+	end := time.Now()
+	start := end.Add(-2 * time.Hour)
+
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(testVolumes(), nil)
+	d.EXPECT().CloudBackupStatus(&api.CloudBackupStatusRequest{}).
+		Return(&api.CloudBackupStatusResponse{
+			Statuses: map[string]api.CloudBackupStatus{
+				"b1": {OpType: api.CloudBackupOp, Status: api.CloudBackupStatusDone,
+					SrcVolumeID: "vol1", BytesDone: 100, CompletedTime: end.Add(-time.Hour)},
+				"b2": {OpType: api.CloudBackupOp, Status: api.CloudBackupStatusDone,
+					SrcVolumeID: "vol1", BytesDone: 50, CompletedTime: start.Add(-time.Minute)},
+				"b3": {OpType: api.CloudBackupOp, Status: api.CloudBackupStatusActive,
+					SrcVolumeID: "vol1", BytesDone: 10},
+			},
+		}, nil)
+
+	records, err := NewMeter("mock", driver(d), &fakeSink{}, nil).Collect(start, end)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	assert.Equal(t, "vol1", records[0].ResourceId)
+	assert.Equal(t, MetricVolumeGBHours, records[0].Metric)
+	assert.Equal(t, "acme", records[0].Tenant)
+	assert.InDelta(t, 20.0, records[0].Value, 0.001)
+
+	assert.Equal(t, "snap1", records[1].ResourceId)
+	assert.Equal(t, MetricSnapshotGBHours, records[1].Metric)
+	assert.InDelta(t, 4.0, records[1].Value, 0.001)
+
+	assert.Equal(t, "vol1", records[2].ResourceId)
+	assert.Equal(t, MetricBackupBytes, records[2].Metric)
+	assert.Equal(t, UnitBytes, records[2].Unit)
+	assert.Equal(t, 100.0, records[2].Value)
+}
+
+func TestReportBackupNotSupported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(testVolumes(), nil)
+	d.EXPECT().CloudBackupStatus(&api.CloudBackupStatusRequest{}).
+		Return(nil, volume.ErrNotSupported)
+
+	sink := &fakeSink{}
+	end := time.Now()
+	err := NewMeter("mock", driver(d), sink, nil).Report(end.Add(-time.Hour), end)
+	require.NoError(t, err)
+	require.Len(t, sink.records, 2)
+	assert.Equal(t, "mock", sink.records[0].Driver)
+}
+
+func TestTickElected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+
+	// Every node meters the same volumes, only the elected one reports them.
+	sinks := []*fakeSink{{}, {}}
+	electors := []*leader.Elector{
+		leader.New(kv, "metering", "node1", &leader.Config{TTL: time.Minute}),
+		leader.New(kv, "metering", "node2", &leader.Config{TTL: time.Minute}),
+	}
+	meters := make([]*Meter, len(electors))
+	for i, e := range electors {
+		require.NoError(t, e.Campaign(time.Now()))
+		meters[i] = NewMeter("mock", driver(d), sinks[i], e)
+	}
+	require.True(t, electors[0].IsLeader())
+	require.False(t, electors[1].IsLeader())
+
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(testVolumes(), nil).Times(2)
+	d.EXPECT().CloudBackupStatus(&api.CloudBackupStatusRequest{}).
+		Return(nil, volume.ErrNotSupported).Times(2)
+
+	end := time.Now()
+	start := end.Add(-time.Hour)
+	for _, m := range meters {
+		assert.Equal(t, end, m.tick(start, end))
+	}
+	assert.Len(t, sinks[0].records, 2)
+	assert.Empty(t, sinks[1].records)
+
+	// The node taking over reports from the end of the last period.
+	require.NoError(t, electors[0].Resign())
+	require.NoError(t, electors[1].Campaign(time.Now()))
+	next := end.Add(time.Hour)
+	for _, m := range meters {
+		assert.Equal(t, next, m.tick(end, next))
+	}
+	assert.Len(t, sinks[0].records, 2)
+	require.Len(t, sinks[1].records, 2)
+	assert.Equal(t, end, sinks[1].records[0].StartTime)
+}
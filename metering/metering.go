@@ -0,0 +1,107 @@
+// Package metering emits periodic usage records for volumes, snapshots and
+// cloud backups to a pluggable sink so that usage can be charged back to
+// internal customers.
+package metering
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// MetricVolumeGBHours is the provisioned capacity of a volume in
+	// GiB multiplied by the hours of the metering period
+	MetricVolumeGBHours = "volume_gb_hours"
+	// MetricSnapshotGBHours is the provisioned capacity of a snapshot in
+	// GiB multiplied by the hours of the metering period
+	MetricSnapshotGBHours = "snapshot_gb_hours"
+	// MetricBackupBytes is the number of bytes transferred by the cloud
+	// backups of a volume that completed during the metering period
+	MetricBackupBytes = "backup_bytes_transferred"
+
+	// UnitGBHours is the unit of the GB-hours metrics
+	UnitGBHours = "GB-hours"
+	// UnitBytes is the unit of the bytes metrics
+	UnitBytes = "bytes"
+
+	// SinkFile appends records as JSON lines to a file
+	SinkFile = "file"
+	// SinkHTTP posts records as a JSON array to an HTTP endpoint
+	SinkHTTP = "http"
+	// SinkKafka produces records to a Kafka topic through a Kafka REST proxy
+	SinkKafka = "kafka"
+
+	// DefaultInterval is the metering period used when none is configured
+	DefaultInterval = time.Hour
+)
+
+var (
+	// ErrUnknownSink returned when the configured sink type is not known
+	ErrUnknownSink = errors.New("Unknown metering sink, must be file, http or kafka")
+)
+
+// Record is a single usage measurement of a resource over a period.
+// swagger:model
+type Record struct {
+	// Id uniquely identifies the record so that sinks can deduplicate
+	Id string
+	// Driver is the volume driver that owns the resource
+	Driver string
+	// ResourceId is the id of the volume or snapshot
+	ResourceId string
+	// Tenant is the value of the api.LabelTenant label of the resource
+	Tenant string `json:",omitempty"`
+	// Namespace is the value of the api.LabelNamespace label of the resource
+	Namespace string `json:",omitempty"`
+	// Metric is one of the Metric* values
+	Metric string
+	// Value of the metric over the period
+	Value float64
+	// Unit of the value
+	Unit string
+	// StartTime is the start of the metering period
+	StartTime time.Time
+	// EndTime is the end of the metering period
+	EndTime time.Time
+}
+
+// Sink receives metering records.
+type Sink interface {
+	// Write delivers a batch of records. Records are delivered again if
+	// Write returns an error.
+	Write(records []*Record) error
+}
+
+// Config configures usage metering.
+type Config struct {
+	// Interval is the metering period, DefaultInterval if unset
+	Interval time.Duration `yaml:"interval"`
+	// Sink is one of the Sink* values, metering is disabled if unset
+	Sink string `yaml:"sink"`
+	// Path of the file for the file sink
+	Path string `yaml:"path"`
+	// URL of the HTTP endpoint for the http sink, or of the Kafka REST
+	// proxy for the kafka sink
+	URL string `yaml:"url"`
+	// Topic for the kafka sink
+	Topic string `yaml:"topic"`
+}
+
+// Enabled returns true if a sink is configured.
+func (c *Config) Enabled() bool {
+	return len(c.Sink) != 0
+}
+
+// NewSink returns the sink described by the configuration.
+func NewSink(c *Config) (Sink, error) {
+	switch c.Sink {
+	case SinkFile:
+		return NewFileSink(c.Path)
+	case SinkHTTP:
+		return NewHTTPSink(c.URL)
+	case SinkKafka:
+		return NewKafkaSink(c.URL, c.Topic)
+	default:
+		return nil, ErrUnknownSink
+	}
+}
@@ -0,0 +1,123 @@
+package metering
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	sinkTimeout      = 30 * time.Second
+	kafkaContentType = "application/vnd.kafka.json.v2+json"
+)
+
+// fileSink appends records to a file, one JSON object per line.
+type fileSink struct {
+	sync.Mutex
+	path string
+}
+
+// NewFileSink returns a sink that appends records to the file at path.
+func NewFileSink(path string) (Sink, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("Metering file sink requires a path")
+	}
+	return &fileSink{path: path}, nil
+}
+
+func (s *fileSink) Write(records []*Record) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// httpSink posts records as a JSON array.
+type httpSink struct {
+	url         string
+	contentType string
+	client      *http.Client
+	// wrap returns the request body for a batch of records
+	wrap func(records []*Record) interface{}
+}
+
+// NewHTTPSink returns a sink that posts each batch of records as a JSON
+// array to url.
+func NewHTTPSink(url string) (Sink, error) {
+	if len(url) == 0 {
+		return nil, fmt.Errorf("Metering http sink requires a url")
+	}
+	return &httpSink{
+		url:         url,
+		contentType: "application/json",
+		client:      &http.Client{Timeout: sinkTimeout},
+		wrap: func(records []*Record) interface{} {
+			return records
+		},
+	}, nil
+}
+
+// kafkaRecord is a record in a Kafka REST proxy produce request.
+type kafkaRecord struct {
+	Key   string  `json:"key"`
+	Value *Record `json:"value"`
+}
+
+// NewKafkaSink returns a sink that produces records to topic through the
+// Kafka REST proxy at proxyURL. Records are keyed by resource id so that
+// the records of a resource stay ordered within a partition.
+func NewKafkaSink(proxyURL, topic string) (Sink, error) {
+	if len(proxyURL) == 0 || len(topic) == 0 {
+		return nil, fmt.Errorf("Metering kafka sink requires a url and a topic")
+	}
+	return &httpSink{
+		url:         strings.TrimSuffix(proxyURL, "/") + "/topics/" + topic,
+		contentType: kafkaContentType,
+		client:      &http.Client{Timeout: sinkTimeout},
+		wrap: func(records []*Record) interface{} {
+			req := struct {
+				Records []kafkaRecord `json:"records"`
+			}{Records: make([]kafkaRecord, 0, len(records))}
+			for _, r := range records {
+				req.Records = append(req.Records, kafkaRecord{Key: r.ResourceId, Value: r})
+			}
+			return req
+		},
+	}, nil
+}
+
+func (s *httpSink) Write(records []*Record) error {
+	body, err := json.Marshal(s.wrap(records))
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, s.contentType, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Metering sink %s returned %s", s.url, resp.Status)
+	}
+	return nil
+}
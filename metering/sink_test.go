@@ -0,0 +1,94 @@
+package metering
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRecords() []*Record {
+	return []*Record{
+		{Id: "r1", ResourceId: "vol1", Metric: MetricVolumeGBHours, Value: 1},
+		{Id: "r2", ResourceId: "vol2", Metric: MetricBackupBytes, Value: 2},
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metering")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sink, err := NewSink(&Config{Sink: SinkFile, Path: filepath.Join(dir, "usage.json")})
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(testRecords()))
+	require.NoError(t, sink.Write(testRecords()[:1]))
+
+	f, err := os.Open(filepath.Join(dir, "usage.json"))
+	require.NoError(t, err)
+	defer f.Close()
+	var ids []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		ids = append(ids, r.Id)
+	}
+	assert.Equal(t, []string{"r1", "r2", "r1"}, ids)
+}
+
+func TestHTTPSink(t *testing.T) {
+	var got []*Record
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer ts.Close()
+
+	sink, err := NewSink(&Config{Sink: SinkHTTP, URL: ts.URL})
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(testRecords()))
+	assert.Equal(t, testRecords(), got)
+}
+
+func TestHTTPSinkError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	sink, err := NewHTTPSink(ts.URL)
+	require.NoError(t, err)
+	assert.Error(t, sink.Write(testRecords()))
+}
+
+func TestKafkaSink(t *testing.T) {
+	var got struct {
+		Records []kafkaRecord `json:"records"`
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/usage", r.URL.Path)
+		assert.Equal(t, kafkaContentType, r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer ts.Close()
+
+	sink, err := NewSink(&Config{Sink: SinkKafka, URL: ts.URL + "/", Topic: "usage"})
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(testRecords()))
+	require.Len(t, got.Records, 2)
+	assert.Equal(t, "vol1", got.Records[0].Key)
+	assert.Equal(t, "r1", got.Records[0].Value.Id)
+}
+
+func TestUnknownSink(t *testing.T) {
+	_, err := NewSink(&Config{Sink: "syslog"})
+	assert.Equal(t, ErrUnknownSink, err)
+}
@@ -0,0 +1,138 @@
+package nodelabels
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/portworx/kvdb"
+)
+
+const (
+	kvdbKey = "nodelabels"
+	lockKey = "locks/nodelabels"
+)
+
+// manager implements Manager interface.
+type manager struct {
+	kv kvdb.Kvdb
+
+	lock      sync.Mutex
+	listeners []Listener
+}
+
+func newManager(kv kvdb.Kvdb) *manager {
+	return &manager{kv: kv}
+}
+
+func (m *manager) Get(nodeID string) (map[string]string, error) {
+	kvp, err := m.kv.Get(kvdbKey + "/" + nodeID)
+	if err == kvdb.ErrNotFound {
+		return map[string]string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	labels := make(map[string]string)
+	if err := json.Unmarshal(kvp.Value, &labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+func (m *manager) Enumerate() (map[string]map[string]string, error) {
+	kvps, err := m.kv.Enumerate(kvdbKey)
+	if err != nil {
+		return nil, err
+	}
+	all := make(map[string]map[string]string, len(kvps))
+	for _, kvp := range kvps {
+		labels := make(map[string]string)
+		if err := json.Unmarshal(kvp.Value, &labels); err != nil {
+			return nil, err
+		}
+		all[strings.TrimPrefix(kvp.Key, kvdbKey+"/")] = labels
+	}
+	return all, nil
+}
+
+func (m *manager) Update(nodeID string, u *Update) (map[string]string, error) {
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+	key := kvdbKey + "/" + nodeID
+	// Serialize the updates of a node across the cluster
+	lock, err := m.kv.Lock(lockKey + "/" + nodeID)
+	if err != nil {
+		return nil, err
+	}
+	defer m.kv.Unlock(lock)
+
+	old, err := m.Get(nodeID)
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[string]string, len(old)+len(u.Set))
+	for k, v := range old {
+		labels[k] = v
+	}
+	for _, k := range u.Remove {
+		delete(labels, k)
+	}
+	for k, v := range u.Set {
+		labels[k] = v
+	}
+	if reflect.DeepEqual(old, labels) {
+		return labels, nil
+	}
+
+	if len(labels) == 0 {
+		_, err = m.kv.Delete(key)
+	} else {
+		_, err = m.kv.Put(key, labels, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	eventbus.Publish(eventbus.EventNodeLabels, nodeID, labels)
+	m.lock.Lock()
+	listeners := append([]Listener{}, m.listeners...)
+	m.lock.Unlock()
+	for _, l := range listeners {
+		l(nodeID, old, labels)
+	}
+	return labels, nil
+}
+
+func (m *manager) AddListener(l Listener) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.listeners = append(m.listeners, l)
+}
+
+func (m *manager) Apply(nodes []api.Node) ([]api.Node, error) {
+	all, err := m.Enumerate()
+	if err != nil {
+		return nil, err
+	}
+	applied := make([]api.Node, len(nodes))
+	for i, n := range nodes {
+		applied[i] = n
+		stored, ok := all[n.Id]
+		if !ok {
+			continue
+		}
+		labels := make(map[string]string, len(n.NodeLabels)+len(stored))
+		for k, v := range n.NodeLabels {
+			labels[k] = v
+		}
+		for k, v := range stored {
+			labels[k] = v
+		}
+		applied[i].NodeLabels = labels
+	}
+	return applied, nil
+}
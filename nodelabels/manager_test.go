@@ -0,0 +1,170 @@
+package nodelabels
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManager(t *testing.T) (kvdb.Kvdb, Manager) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	return kv, NewManager(kv)
+}
+
+func TestUpdate(t *testing.T) {
+	_, m := newTestManager(t)
+
+	var changes int
+	m.AddListener(func(nodeID string, old, new map[string]string) {
+		changes++
+	})
+
+	labels, err := m.Get("node1")
+	require.NoError(t, err)
+	assert.Empty(t, labels)
+
+	labels, err = m.Update("node1", &Update{Set: map[string]string{"rack": "r1", "dedicated": "db"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"rack": "r1", "dedicated": "db"}, labels)
+	assert.Equal(t, 1, changes)
+
+	// Unchanged labels do not notify the listeners
+	_, err = m.Update("node1", &Update{Set: map[string]string{"rack": "r1"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, changes)
+
+	labels, err = m.Update("node1", &Update{Remove: []string{"dedicated"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"rack": "r1"}, labels)
+	assert.Equal(t, 2, changes)
+
+	_, err = m.Update("node2", &Update{Set: map[string]string{"zone": "z1"}})
+	require.NoError(t, err)
+	all, err := m.Enumerate()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"node1": {"rack": "r1"},
+		"node2": {"zone": "z1"},
+	}, all)
+
+	_, err = m.Update("node1", &Update{Set: map[string]string{"bad key": "v"}})
+	assert.Error(t, err)
+	_, err = m.Update("node1", &Update{Set: map[string]string{"rack": ""}})
+	assert.Error(t, err)
+}
+
+func TestApply(t *testing.T) {
+	_, m := newTestManager(t)
+
+	_, err := m.Update("node1", &Update{Set: map[string]string{"rack": "r2"}})
+	require.NoError(t, err)
+
+	nodes := []api.Node{
+		{Id: "node1", NodeLabels: map[string]string{"rack": "r1", "zone": "z1"}},
+		{Id: "node2", NodeLabels: map[string]string{"zone": "z2"}},
+	}
+	applied, err := m.Apply(nodes)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"rack": "r2", "zone": "z1"}, applied[0].NodeLabels)
+	assert.Equal(t, map[string]string{"zone": "z2"}, applied[1].NodeLabels)
+	// The nodes passed in are not modified
+	assert.Equal(t, "r1", nodes[0].NodeLabels["rack"])
+}
+
+func TestFilter(t *testing.T) {
+	nodes := []api.Node{
+		{Id: "node1", Hostname: "host1", NodeLabels: map[string]string{"diskclass": "ssd"}},
+		{Id: "node2", Hostname: "host2", NodeLabels: map[string]string{"diskclass": "hdd"}},
+	}
+	resp := Filter(nodes, &FilterRequest{
+		NodeNames: []string{"host1", "host2", "host3"},
+		Selector: []*api.LabelSelectorRequirement{{
+			Key:      "diskclass",
+			Operator: api.LabelSelectorRequirement_In,
+			Values:   []string{"ssd"},
+		}},
+	})
+	assert.Equal(t, []string{"host1"}, resp.NodeNames)
+	assert.Len(t, resp.FailedNodes, 2)
+	assert.Contains(t, resp.FailedNodes, "host2")
+	assert.Contains(t, resp.FailedNodes, "host3")
+}
+
+type fakeCluster []api.Node
+
+func (c fakeCluster) Enumerate() (api.Cluster, error) {
+	return api.Cluster{Nodes: c}, nil
+}
+
+type fakeVolumes []*api.Volume
+
+func (v fakeVolumes) Inspect(volumeIDs []string) ([]*api.Volume, error) {
+	return nil, nil
+}
+
+func (v fakeVolumes) Enumerate(locator *api.VolumeLocator, labels map[string]string) ([]*api.Volume, error) {
+	return v, nil
+}
+
+func (v fakeVolumes) SnapEnumerate(volID []string, snapLabels map[string]string) ([]*api.Volume, error) {
+	return nil, nil
+}
+
+func TestRevalidate(t *testing.T) {
+	kv, m := newTestManager(t)
+	alertsManager, err := alerts.NewManager(kv)
+	require.NoError(t, err)
+
+	nodes := fakeCluster{{Id: "node1"}, {Id: "node2"}}
+	vols := fakeVolumes{{
+		Id: "vol1",
+		Spec: &api.VolumeSpec{
+			PlacementStrategy: &api.VolumePlacementStrategy{
+				Rules: []*api.VolumePlacementRule{{
+					Enforcement: api.VolumePlacementRule_Required,
+					MatchExpressions: []*api.LabelSelectorRequirement{{
+						Key:      "dedicated",
+						Operator: api.LabelSelectorRequirement_In,
+						Values:   []string{"db"},
+					}},
+				}},
+			},
+		},
+		ReplicaSets: []*api.ReplicaSet{{Nodes: []string{"node1"}}},
+	}}
+	r := NewRevalidator(nodes, vols, m, alertsManager)
+	m.AddListener(r.Revalidate)
+
+	firing := func() []*api.Alert {
+		raised, err := alertsManager.Enumerate(
+			alerts.NewAlertTypeFilter(AlertTypePlacementViolation, api.ResourceType_RESOURCE_TYPE_VOLUME))
+		require.NoError(t, err)
+		var active []*api.Alert
+		for _, a := range raised {
+			if !a.Cleared {
+				active = append(active, a)
+			}
+		}
+		return active
+	}
+
+	_, err = m.Update("node1", &Update{Set: map[string]string{"dedicated": "db"}})
+	require.NoError(t, err)
+	assert.Empty(t, firing())
+
+	_, err = m.Update("node1", &Update{Remove: []string{"dedicated"}})
+	require.NoError(t, err)
+	active := firing()
+	require.Len(t, active, 1)
+	assert.Equal(t, "vol1", active[0].ResourceId)
+
+	_, err = m.Update("node1", &Update{Set: map[string]string{"dedicated": "db"}})
+	require.NoError(t, err)
+	assert.Empty(t, firing())
+}
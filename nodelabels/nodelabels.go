@@ -0,0 +1,162 @@
+// Package nodelabels stores user defined node labels, such as rack,
+// diskclass or dedicated=db, that constrain the placement of volumes, and
+// revalidates the placement of existing volumes when they change.
+package nodelabels
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/planner"
+	"github.com/portworx/kvdb"
+)
+
+const (
+	// maxKeyLength is the maximum length of a label key
+	maxKeyLength = 253
+	// maxValueLength is the maximum length of a label value
+	maxValueLength = 63
+)
+
+var (
+	// ErrNotInitialized returned when the node labels manager has not been initialized
+	ErrNotInitialized = errors.New("openstorage.nodelabels: not initialized")
+	// ErrInitialized returned when the node labels manager is initialized twice
+	ErrInitialized = errors.New("openstorage.nodelabels: already initialized")
+
+	inst Manager
+	// Inst returns an instance of an already instantiated node labels
+	// manager. This function can be overridden for testing purposes
+	Inst = func() (Manager, error) {
+		return nodeLabelsInst()
+	}
+)
+
+// Listener is called after the labels of a node changed.
+type Listener func(nodeID string, old, new map[string]string)
+
+// Update changes the labels of a node.
+// swagger:model
+type Update struct {
+	// Set adds or replaces these labels
+	Set map[string]string
+	// Remove deletes the labels with these keys
+	Remove []string
+}
+
+// Manager stores the labels of the nodes in kvdb.
+type Manager interface {
+	// Get returns the labels of a node, empty if it has none.
+	Get(nodeID string) (map[string]string, error)
+	// Enumerate returns the labels of every labelled node.
+	Enumerate() (map[string]map[string]string, error)
+	// Update applies u to the labels of a node and returns the new labels.
+	// Listeners are called if the labels changed.
+	Update(nodeID string, u *Update) (map[string]string, error)
+	// AddListener registers a listener of the label changes.
+	AddListener(l Listener)
+	// Apply returns a copy of nodes with the stored labels added to their
+	// own labels, the stored ones winning.
+	Apply(nodes []api.Node) ([]api.Node, error)
+}
+
+// NewManager returns a kvdb backed node labels manager.
+func NewManager(kv kvdb.Kvdb) Manager {
+	return newManager(kv)
+}
+
+// Init instantiates the node labels manager singleton.
+func Init(m Manager) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = m
+	return nil
+}
+
+func nodeLabelsInst() (Manager, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// Validate checks that the update sets well formed labels.
+func (u *Update) Validate() error {
+	for k, v := range u.Set {
+		if err := validateKey(k); err != nil {
+			return err
+		}
+		if len(v) == 0 || len(v) > maxValueLength || strings.ContainsAny(v, " \t\n") {
+			return fmt.Errorf("Invalid value %q of node label %s", v, k)
+		}
+	}
+	for _, k := range u.Remove {
+		if err := validateKey(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateKey(k string) error {
+	if len(k) == 0 || len(k) > maxKeyLength || strings.ContainsAny(k, " \t\n=,") {
+		return fmt.Errorf("Invalid node label key %q", k)
+	}
+	return nil
+}
+
+// FilterRequest asks which of the candidate nodes of a scheduler satisfy a
+// label selector.
+// swagger:model
+type FilterRequest struct {
+	// NodeNames are the candidate nodes, by id or hostname. Every node of
+	// the cluster is a candidate if empty
+	NodeNames []string
+	// Selector must be satisfied by the labels of the node
+	Selector []*api.LabelSelectorRequirement
+}
+
+// FilterResponse holds the candidate nodes satisfying the selector.
+// swagger:model
+type FilterResponse struct {
+	// NodeNames are the candidate nodes satisfying the selector
+	NodeNames []string
+	// FailedNodes maps the other candidate nodes to the reason they failed
+	FailedNodes map[string]string
+}
+
+// Filter returns the candidate nodes of req satisfying its selector. Nodes
+// must carry their stored labels, see Manager.Apply.
+func Filter(nodes []api.Node, req *FilterRequest) *FilterResponse {
+	resp := &FilterResponse{
+		NodeNames:   []string{},
+		FailedNodes: make(map[string]string),
+	}
+	byName := make(map[string]*api.Node, 2*len(nodes))
+	for i := range nodes {
+		byName[nodes[i].Id] = &nodes[i]
+		if len(nodes[i].Hostname) != 0 {
+			byName[nodes[i].Hostname] = &nodes[i]
+		}
+	}
+	names := req.NodeNames
+	if len(names) == 0 {
+		for _, n := range nodes {
+			names = append(names, n.Id)
+		}
+	}
+	for _, name := range names {
+		n, ok := byName[name]
+		if !ok {
+			resp.FailedNodes[name] = "Node is not part of the cluster"
+		} else if !planner.Matches(n.NodeLabels, req.Selector) {
+			resp.FailedNodes[name] = "Node labels do not satisfy the selector"
+		} else {
+			resp.NodeNames = append(resp.NodeNames, name)
+		}
+	}
+	return resp
+}
@@ -0,0 +1,120 @@
+package nodelabels
+
+import (
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/planner"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// AlertTypeBase is the base of the alert types of the nodelabels component
+	AlertTypeBase int64 = 4200
+	// AlertTypePlacementViolation is raised on a volume with replicas on
+	// nodes breaking its required placement rules
+	AlertTypePlacementViolation = AlertTypeBase + 1
+)
+
+func init() {
+	alerts.MustRegisterComponent("nodelabels", AlertTypeBase).MustRegisterReasons(alerts.Reason{
+		AlertType:   AlertTypePlacementViolation,
+		Code:        "VOLUME_PLACEMENT_VIOLATION",
+		Resource:    api.ResourceType_RESOURCE_TYPE_VOLUME,
+		Description: "replicas of the volume are on nodes breaking its placement rules",
+	})
+}
+
+// NodeEnumerator lists the nodes of the cluster, as cluster.Cluster does.
+type NodeEnumerator interface {
+	Enumerate() (api.Cluster, error)
+}
+
+// Revalidator checks the placement of the volumes against their placement
+// rules when node labels change, raising an alert per volume breaking its
+// required rules and clearing it once the volume complies again.
+type Revalidator struct {
+	cluster NodeEnumerator
+	volumes volume.Enumerator
+	labels  Manager
+	alerts  alerts.Manager
+}
+
+// NewRevalidator returns a revalidator of volumes. Register its Revalidate
+// method as a listener of the labels manager.
+func NewRevalidator(c NodeEnumerator, volumes volume.Enumerator, labels Manager, manager alerts.Manager) *Revalidator {
+	return &Revalidator{
+		cluster: c,
+		volumes: volumes,
+		labels:  labels,
+		alerts:  manager,
+	}
+}
+
+// Revalidate is a Listener checking every volume with placement rules.
+func (r *Revalidator) Revalidate(nodeID string, old, new map[string]string) {
+	if err := r.Check(); err != nil {
+		logrus.WithField("pkg", "openstorage/nodelabels").
+			Warnf("Failed to revalidate volume placement after labels of node %s changed: %v", nodeID, err)
+	}
+}
+
+// Check raises and clears the placement alerts of the volumes.
+func (r *Revalidator) Check() error {
+	c, err := r.cluster.Enumerate()
+	if err != nil {
+		return err
+	}
+	nodes, err := r.labels.Apply(c.Nodes)
+	if err != nil {
+		return err
+	}
+	vols, err := r.volumes.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		return err
+	}
+
+	violations := make(map[string][]planner.Violation)
+	for _, v := range planner.Revalidate(nodes, vols) {
+		violations[v.VolumeId] = append(violations[v.VolumeId], v)
+	}
+	raised, err := r.alerts.Enumerate(
+		alerts.NewAlertTypeFilter(AlertTypePlacementViolation, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	if err != nil {
+		return err
+	}
+	firing := make(map[string]bool)
+	for _, a := range raised {
+		if !a.Cleared {
+			firing[a.ResourceId] = true
+		}
+	}
+
+	for volumeID, vs := range violations {
+		if err := r.alerts.Raise(&api.Alert{
+			AlertType:  AlertTypePlacementViolation,
+			Severity:   api.SeverityType_SEVERITY_TYPE_WARNING,
+			Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: volumeID,
+			Message:    vs[0].String(),
+		}); err != nil {
+			return err
+		}
+	}
+	for volumeID := range firing {
+		if _, ok := violations[volumeID]; ok {
+			continue
+		}
+		if err := r.alerts.Raise(&api.Alert{
+			AlertType:  AlertTypePlacementViolation,
+			Severity:   api.SeverityType_SEVERITY_TYPE_NOTIFY,
+			Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+			ResourceId: volumeID,
+			Message:    "Volume " + volumeID + " complies with its placement rules",
+			Cleared:    true,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -58,12 +58,97 @@ type ClusterConfig struct {
 	Domain      string         `json:"domain,omitempty" yaml:"domain,omitempty" enable:"true" hidden:"false" usage:"usage to be added"`
 	Secrets     *SecretsConfig `json:"secrets,omitempty" yaml:"secrets,omitempty" enable:"true" hidden:"false" usage:"usage to be added" description:"description to be added"`
 	Kvdb        *KvdbConfig    `json:"kvdb,omitempty" yaml:"kvdb,omitempty" enable:"false" hidden:"false" usage:"usage to be added" description:"description to be added"`
+	Alerts      *AlertsConfig  `json:"alerts,omitempty" yaml:"alerts,omitempty" enable:"true" hidden:"false" usage:"Alerts configuration" description:"Routes the alerts to the notifiers"`
+	Jobs        *JobsConfig    `json:"jobs,omitempty" yaml:"jobs,omitempty" enable:"true" hidden:"false" usage:"Jobs configuration" description:"Limits the concurrency of the background jobs"`
 	Private     interface{}    `json:"private,omitempty" yaml:"private,omitempty" enable:"true" hidden:"false" usage:"usage to be added"`
 }
 
 func (conf *ClusterConfig) Init() *ClusterConfig {
 	conf.Secrets = new(SecretsConfig).Init()
 	conf.Kvdb = new(KvdbConfig).Init()
+	conf.Alerts = new(AlertsConfig).Init()
+	conf.Jobs = new(JobsConfig).Init()
+	return conf
+}
+
+// AlertsConfig is the cluster wide configuration of the alerts
+// swagger:model
+type AlertsConfig struct {
+	Routes []*AlertRouteConfig `json:"routes,omitempty" yaml:"routes,omitempty" enable:"true" hidden:"false" usage:"Routes of the alerts, the first matching an alert wins"`
+}
+
+func (conf *AlertsConfig) Init() *AlertsConfig {
+	conf.Routes = make([]*AlertRouteConfig, 0, 0)
+	return conf
+}
+
+// AlertRouteConfig selects the notifiers of the alerts it matches
+// swagger:model
+type AlertRouteConfig struct {
+	Name          string            `json:"name,omitempty" yaml:"name,omitempty" enable:"true" hidden:"false" usage:"Name of the route"`
+	ResourceTypes []string          `json:"resource_types,omitempty" yaml:"resource_types,omitempty" enable:"true" hidden:"false" usage:"Resource types matched, all if empty"`
+	AlertTypes    []int64           `json:"alert_types,omitempty" yaml:"alert_types,omitempty" enable:"true" hidden:"false" usage:"Alert types matched, all if empty"`
+	MinSeverity   string            `json:"min_severity,omitempty" yaml:"min_severity,omitempty" enable:"true" hidden:"false" usage:"Least severe alert matched"`
+	Labels        map[string]string `json:"labels,omitempty" yaml:"labels,omitempty" enable:"true" hidden:"false" usage:"Labels of the resource matched"`
+	Notifiers     []string          `json:"notifiers,omitempty" yaml:"notifiers,omitempty" enable:"true" hidden:"false" usage:"Notifiers the alerts are delivered to, none drops them"`
+}
+
+func (conf *AlertRouteConfig) Init() *AlertRouteConfig {
+	return conf
+}
+
+// JobsConfig is the cluster wide configuration of the scheduling, the
+// retention and the retries of the background jobs
+// swagger:model
+type JobsConfig struct {
+	Classes    []*JobClassConfig   `json:"classes,omitempty" yaml:"classes,omitempty" enable:"true" hidden:"false" usage:"Concurrency classes of the jobs"`
+	Priorities map[string]int      `json:"priorities,omitempty" yaml:"priorities,omitempty" enable:"true" hidden:"false" usage:"Priorities of the job types, higher first"`
+	Retention  *JobRetentionConfig `json:"retention,omitempty" yaml:"retention,omitempty" enable:"true" hidden:"false" usage:"Retention of the completed jobs"`
+	Retry      *JobRetryConfig     `json:"retry,omitempty" yaml:"retry,omitempty" enable:"true" hidden:"false" usage:"Retries of the jobs failing with a transient error"`
+}
+
+func (conf *JobsConfig) Init() *JobsConfig {
+	conf.Classes = make([]*JobClassConfig, 0, 0)
+	conf.Priorities = make(map[string]int)
+	conf.Retention = new(JobRetentionConfig).Init()
+	conf.Retry = new(JobRetryConfig).Init()
+	return conf
+}
+
+// JobRetentionConfig limits the records of the completed jobs kept
+// swagger:model
+type JobRetentionConfig struct {
+	MaxAgeHours uint32 `json:"max_age_hours,omitempty" yaml:"max_age_hours,omitempty" enable:"true" hidden:"false" usage:"Hours the completed jobs are kept, forever if unset"`
+	MaxCount    int    `json:"max_count,omitempty" yaml:"max_count,omitempty" enable:"true" hidden:"false" usage:"Number of completed jobs kept, all if unset"`
+}
+
+func (conf *JobRetentionConfig) Init() *JobRetentionConfig {
+	return conf
+}
+
+// JobRetryConfig configures the retries of the jobs failing with a transient
+// error, with an exponential backoff
+// swagger:model
+type JobRetryConfig struct {
+	MaxAttempts       int    `json:"max_attempts,omitempty" yaml:"max_attempts,omitempty" enable:"true" hidden:"false" usage:"Number of times a job runs before it fails"`
+	BackoffSeconds    uint32 `json:"backoff_seconds,omitempty" yaml:"backoff_seconds,omitempty" enable:"true" hidden:"false" usage:"Seconds before the first retry, doubled with every retry"`
+	MaxBackoffSeconds uint32 `json:"max_backoff_seconds,omitempty" yaml:"max_backoff_seconds,omitempty" enable:"true" hidden:"false" usage:"Maximum seconds between retries"`
+}
+
+func (conf *JobRetryConfig) Init() *JobRetryConfig {
+	return conf
+}
+
+// JobClassConfig limits the number of jobs of some types running at once
+// swagger:model
+type JobClassConfig struct {
+	Name     string   `json:"name,omitempty" yaml:"name,omitempty" enable:"true" hidden:"false" usage:"Name of the class"`
+	JobTypes []string `json:"job_types,omitempty" yaml:"job_types,omitempty" enable:"true" hidden:"false" usage:"Job types of the class"`
+	Limit    int      `json:"limit,omitempty" yaml:"limit,omitempty" enable:"true" hidden:"false" usage:"Number of jobs of the class running at once"`
+	Scope    string   `json:"scope,omitempty" yaml:"scope,omitempty" enable:"true" hidden:"false" usage:"Scope of the limit, node or cluster"`
+}
+
+func (conf *JobClassConfig) Init() *JobClassConfig {
 	return conf
 }
 
@@ -92,15 +177,29 @@ func (conf *GeoConfig) Init() *GeoConfig {
 // SecretsConfig is a secrets configuration parameters struct
 // swagger:model
 type SecretsConfig struct {
-	SecretType       string       `json:"secret_type,omitempty" yaml:"secret_type,omitempty" enable:"true" hidden:"false" usage:"Secret type"`
-	ClusterSecretKey string       `json:"cluster_secret_key,omitempty" yaml:"cluster_secret_key,omitempty" enable:"true" hidden:"false" usage:"Secret key"`
-	Vault            *VaultConfig `json:"vault,omitempty" yaml:"vault,omitempty" enable:"true" hidden:"false" usage:"Vault configuration"`
-	Aws              *AWSConfig   `json:"aws,omitempty" yaml:"aws,omitempty" enable:"true" hidden:"false" usage:"AWS configuration"`
+	SecretType       string             `json:"secret_type,omitempty" yaml:"secret_type,omitempty" enable:"true" hidden:"false" usage:"Secret type"`
+	ClusterSecretKey string             `json:"cluster_secret_key,omitempty" yaml:"cluster_secret_key,omitempty" enable:"true" hidden:"false" usage:"Secret key"`
+	Vault            *VaultConfig       `json:"vault,omitempty" yaml:"vault,omitempty" enable:"true" hidden:"false" usage:"Vault configuration"`
+	Aws              *AWSConfig         `json:"aws,omitempty" yaml:"aws,omitempty" enable:"true" hidden:"false" usage:"AWS configuration"`
+	KeyRotation      *KeyRotationConfig `json:"key_rotation,omitempty" yaml:"key_rotation,omitempty" enable:"true" hidden:"false" usage:"Volume key rotation policy"`
 }
 
 func (conf *SecretsConfig) Init() *SecretsConfig {
 	conf.Vault = new(VaultConfig).Init()
 	conf.Aws = new(AWSConfig).Init()
+	conf.KeyRotation = new(KeyRotationConfig).Init()
+	return conf
+}
+
+// KeyRotationConfig is the cluster wide policy for rotating the key
+// encryption keys of encrypted volumes
+// swagger:model
+type KeyRotationConfig struct {
+	Enabled      bool   `json:"enabled,omitempty" yaml:"enabled,omitempty" enable:"true" hidden:"false" usage:"Enable periodic key rotation"`
+	IntervalDays uint32 `json:"interval_days,omitempty" yaml:"interval_days,omitempty" enable:"true" hidden:"false" usage:"Days between key rotations"`
+}
+
+func (conf *KeyRotationConfig) Init() *KeyRotationConfig {
 	return conf
 }
 
@@ -0,0 +1,216 @@
+/*
+Package apiqueue bounds the number of API requests served concurrently and
+queues the others by priority class, so that bulk and background requests
+cannot delay the node critical ones, such as the mounts of starting pods.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apiqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Class is the priority class of a request.
+type Class int
+
+const (
+	// Critical requests are node critical, such as mounts and detaches
+	Critical Class = iota
+	// Normal requests are the default
+	Normal
+	// Background requests are bulk or batch requests of cleanup jobs
+	Background
+
+	numClasses = 3
+)
+
+const (
+	// Header is the HTTP header a client sets to background to lower the
+	// priority of its request
+	Header = "X-Openstorage-Priority"
+	// MetadataKey is the gRPC metadata a client sets to background to lower
+	// the priority of its call
+	MetadataKey = "openstorage-priority"
+)
+
+var (
+	// DefaultWeights are the shares of the queued requests served per class
+	DefaultWeights = Weights{Critical: 8, Normal: 4, Background: 1}
+
+	// ErrNotInitialized returned when the queue has not been initialized
+	ErrNotInitialized = errors.New("openstorage.apiqueue: not initialized")
+	// ErrInitialized returned when the queue is initialized twice
+	ErrInitialized = errors.New("openstorage.apiqueue: already initialized")
+
+	inst *Queue
+)
+
+// Weights are the shares of the queued requests served per class.
+type Weights struct {
+	Critical   int `yaml:"critical"`
+	Normal     int `yaml:"normal"`
+	Background int `yaml:"background"`
+}
+
+// Config configures the API request queue.
+type Config struct {
+	// MaxInFlight is the number of requests served concurrently, requests
+	// are not queued if zero
+	MaxInFlight int `yaml:"max_in_flight"`
+	// Weights of the classes, DefaultWeights if unset
+	Weights Weights `yaml:"weights"`
+}
+
+// Enabled returns true if requests are queued.
+func (c *Config) Enabled() bool {
+	return c.MaxInFlight > 0
+}
+
+// ParseClass returns the class a client requested, Background for
+// "background" and Normal otherwise. Clients cannot raise the priority of
+// their requests.
+func ParseClass(s string) Class {
+	if s == "background" {
+		return Background
+	}
+	return Normal
+}
+
+// Queue serves up to a maximum number of requests concurrently. Once a
+// request completes, a queued request is picked by weighted round robin of
+// the classes, in order of arrival within a class.
+type Queue struct {
+	lock     sync.Mutex
+	max      int
+	inFlight int
+	weights  [numClasses]int
+	credits  [numClasses]int
+	waiting  [numClasses][]chan struct{}
+}
+
+// Init sets the API request queue singleton.
+func Init(q *Queue) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = q
+	return nil
+}
+
+// Inst returns the API request queue singleton.
+func Inst() (*Queue, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// New returns a queue configured by c.
+func New(c *Config) *Queue {
+	w := c.Weights
+	if w.Critical == 0 && w.Normal == 0 && w.Background == 0 {
+		w = DefaultWeights
+	}
+	q := &Queue{max: c.MaxInFlight}
+	q.weights = [numClasses]int{w.Critical, w.Normal, w.Background}
+	for i := range q.weights {
+		if q.weights[i] <= 0 {
+			q.weights[i] = 1
+		}
+	}
+	q.credits = q.weights
+	return q
+}
+
+// Acquire waits until a request of class may be served, or ctx is done.
+// The returned function must be called once the request completes.
+func (q *Queue) Acquire(ctx context.Context, class Class) (func(), error) {
+	if class < Critical || class > Background {
+		class = Normal
+	}
+	q.lock.Lock()
+	if q.inFlight < q.max && q.queued() == 0 {
+		q.inFlight++
+		q.lock.Unlock()
+		return q.release, nil
+	}
+	ready := make(chan struct{})
+	q.waiting[class] = append(q.waiting[class], ready)
+	q.lock.Unlock()
+
+	select {
+	case <-ready:
+		return q.release, nil
+	case <-ctx.Done():
+		q.lock.Lock()
+		removed := q.remove(class, ready)
+		q.lock.Unlock()
+		if !removed {
+			// The slot was handed over while giving up, pass it on
+			q.release()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// Queued returns the number of queued requests of class.
+func (q *Queue) Queued(class Class) int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.waiting[class])
+}
+
+// release hands the slot of a completed request over to the next queued
+// one, if any.
+func (q *Queue) release() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.queued() == 0 {
+		q.inFlight--
+		return
+	}
+	for {
+		for c := range q.waiting {
+			if len(q.waiting[c]) != 0 && q.credits[c] > 0 {
+				q.credits[c]--
+				close(q.waiting[c][0])
+				q.waiting[c] = q.waiting[c][1:]
+				return
+			}
+		}
+		// Every class with queued requests used its share
+		q.credits = q.weights
+	}
+}
+
+func (q *Queue) queued() int {
+	n := 0
+	for _, w := range q.waiting {
+		n += len(w)
+	}
+	return n
+}
+
+func (q *Queue) remove(class Class, ready chan struct{}) bool {
+	for i, w := range q.waiting[class] {
+		if w == ready {
+			q.waiting[class] = append(q.waiting[class][:i], q.waiting[class][i+1:]...)
+			return true
+		}
+	}
+	return false
+}
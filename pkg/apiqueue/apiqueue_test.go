@@ -0,0 +1,83 @@
+package apiqueue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitQueued waits until n requests of class are queued.
+func waitQueued(t *testing.T, q *Queue, class Class, n int) {
+	for i := 0; i < 100; i++ {
+		if q.Queued(class) == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected %d queued requests of class %d, found %d", n, class, q.Queued(class))
+}
+
+func TestQueueOrder(t *testing.T) {
+	q := New(&Config{MaxInFlight: 1, Weights: Weights{Critical: 2, Normal: 1, Background: 1}})
+	release, err := q.Acquire(context.Background(), Normal)
+	require.NoError(t, err)
+
+	var lock sync.Mutex
+	var order []Class
+	var wg sync.WaitGroup
+	enqueue := func(class Class, n int) {
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r, err := q.Acquire(context.Background(), class)
+				require.NoError(t, err)
+				lock.Lock()
+				order = append(order, class)
+				lock.Unlock()
+				r()
+			}()
+		}
+		waitQueued(t, q, class, n)
+	}
+	enqueue(Background, 2)
+	enqueue(Critical, 3)
+
+	release()
+	wg.Wait()
+	assert.Equal(t, []Class{Critical, Critical, Background, Critical, Background}, order)
+
+	// the slot is free again
+	release, err = q.Acquire(context.Background(), Background)
+	require.NoError(t, err)
+	release()
+}
+
+func TestQueueCancel(t *testing.T) {
+	q := New(&Config{MaxInFlight: 1})
+	release, err := q.Acquire(context.Background(), Normal)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = q.Acquire(ctx, Critical)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 0, q.Queued(Critical))
+
+	release()
+	release, err = q.Acquire(context.Background(), Normal)
+	require.NoError(t, err)
+	release()
+}
+
+func TestParseClass(t *testing.T) {
+	assert.Equal(t, Background, ParseClass("background"))
+	assert.Equal(t, Normal, ParseClass("critical"))
+	assert.Equal(t, Normal, ParseClass(""))
+	assert.Equal(t, Critical, MethodClass(context.Background(), "/openstorage.api.OpenStorageMountAttach/Mount"))
+	assert.Equal(t, Normal, MethodClass(context.Background(), "/openstorage.api.OpenStorageVolume/Create"))
+}
@@ -0,0 +1,63 @@
+package apiqueue
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// criticalMethods are the SDK and CSI methods a pod waits for to start or
+// terminate.
+var criticalMethods = map[string]bool{
+	"Attach":                    true,
+	"Detach":                    true,
+	"Mount":                     true,
+	"Unmount":                   true,
+	"ControllerPublishVolume":   true,
+	"ControllerUnpublishVolume": true,
+	"NodeStageVolume":           true,
+	"NodeUnstageVolume":         true,
+	"NodePublishVolume":         true,
+	"NodeUnpublishVolume":       true,
+}
+
+// MethodClass returns the class of a call to the gRPC method fullMethod.
+func MethodClass(ctx context.Context, fullMethod string) Class {
+	if criticalMethods[path.Base(fullMethod)] {
+		return Critical
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md[MetadataKey]; len(v) != 0 {
+			return ParseClass(v[0])
+		}
+	}
+	return Normal
+}
+
+// UnaryServerInterceptor queues the calls in the API request queue, once
+// initialized.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		q, err := Inst()
+		if err != nil {
+			return handler(ctx, req)
+		}
+		release, err := q.Acquire(ctx, MethodClass(ctx, info.FullMethod))
+		if err == context.DeadlineExceeded {
+			return nil, status.Error(codes.DeadlineExceeded, err.Error())
+		} else if err != nil {
+			return nil, status.Error(codes.Canceled, err.Error())
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
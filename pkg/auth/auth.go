@@ -0,0 +1,70 @@
+/*
+Package auth identifies the principal on whose behalf an API request is made:
+the node or user of the certificate of a TLS client, or the user of the
+process on the other end of a unix socket, so that the accesses to the
+volumes can be reviewed.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+)
+
+// Anonymous is the principal of the requests whose client is not identified,
+// such as those received on a TCP port without TLS.
+const Anonymous = "anonymous"
+
+// contextKey is the key of the principal in a context.
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying principal.
+func NewContext(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, contextKey{}, principal)
+}
+
+// FromContext returns the principal of ctx, Anonymous if none.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return Anonymous
+	}
+	if principal, ok := ctx.Value(contextKey{}).(string); ok && len(principal) != 0 {
+		return principal
+	}
+	return Anonymous
+}
+
+// FromTLS returns the principal of a TLS connection, cert:<common name> of
+// the verified client certificate, or empty if the client presented none.
+func FromTLS(state *tls.ConnectionState) string {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return "cert:" + state.VerifiedChains[0][0].Subject.CommonName
+}
+
+// ConnContext returns a copy of ctx carrying the principal of c, for the
+// ConnContext of an http.Server. The principal of a unix socket is
+// uid:<user id> of the peer process, where supported.
+func ConnContext(ctx context.Context, c net.Conn) context.Context {
+	if uc, ok := c.(*net.UnixConn); ok {
+		if principal := fromUnixConn(uc); len(principal) != 0 {
+			return NewContext(ctx, principal)
+		}
+	}
+	return ctx
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestContext(t *testing.T) {
+	assert.Equal(t, Anonymous, FromContext(context.Background()))
+	assert.Equal(t, "uid:0", FromContext(NewContext(context.Background(), "uid:0")))
+}
+
+func TestFromTLS(t *testing.T) {
+	assert.Empty(t, FromTLS(nil))
+	assert.Empty(t, FromTLS(&tls.ConnectionState{}))
+	state := &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{
+		{Subject: pkix.Name{CommonName: "node1"}},
+	}}}
+	assert.Equal(t, "cert:node1", FromTLS(state))
+
+	interceptor := UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return FromContext(ctx), nil
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: *state}})
+	principal, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "cert:node1", principal)
+	principal, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, Anonymous, principal)
+}
+
+func TestConnContext(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("peer credentials are only read on linux")
+	}
+	dir, err := ioutil.TempDir("", "auth")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	l, err := net.Listen("unix", filepath.Join(dir, "test.sock"))
+	require.NoError(t, err)
+	defer l.Close()
+	go func() {
+		if c, err := net.Dial("unix", filepath.Join(dir, "test.sock")); err == nil {
+			defer c.Close()
+			c.Read(make([]byte, 1))
+		}
+	}()
+	c, err := l.Accept()
+	require.NoError(t, err)
+	defer c.Close()
+
+	ctx := ConnContext(context.Background(), c)
+	assert.Equal(t, "uid:"+strconv.Itoa(os.Getuid()), FromContext(ctx))
+}
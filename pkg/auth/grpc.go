@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// UnaryServerInterceptor sets the principal of the calls in their context:
+// the one of the certificate of their TLS client, Anonymous otherwise.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if p, ok := peer.FromContext(ctx); ok {
+			if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+				if principal := FromTLS(&tlsInfo.State); len(principal) != 0 {
+					ctx = NewContext(ctx, principal)
+				}
+			}
+		}
+		return handler(ctx, req)
+	}
+}
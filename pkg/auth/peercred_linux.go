@@ -0,0 +1,28 @@
+// +build linux
+
+package auth
+
+import (
+	"net"
+	"strconv"
+	"syscall"
+)
+
+// fromUnixConn returns uid:<user id> of the process on the other end of c,
+// or empty if its credentials cannot be read.
+func fromUnixConn(c *net.UnixConn) string {
+	raw, err := c.SyscallConn()
+	if err != nil {
+		return ""
+	}
+	var (
+		cred    *syscall.Ucred
+		credErr error
+	)
+	if err := raw.Control(func(fd uintptr) {
+		cred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil || credErr != nil {
+		return ""
+	}
+	return "uid:" + strconv.FormatUint(uint64(cred.Uid), 10)
+}
@@ -0,0 +1,384 @@
+/*
+Package ca is the built-in certificate authority of a cluster. It issues the
+node certificates used to authenticate the connections between nodes and
+the clients of the cluster API, enrolls joining nodes with one time tokens,
+and tracks the revoked certificates.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ca
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/portworx/kvdb"
+)
+
+const (
+	// CAValidity is the lifetime of the cluster CA certificate
+	CAValidity = 10 * 365 * 24 * time.Hour
+	// DefaultNodeValidity is the lifetime of node certificates
+	DefaultNodeValidity = 365 * 24 * time.Hour
+
+	kvdbKey = "ca/root"
+	// certsKey holds a CertRecord per issued certificate
+	certsKey = "ca/certs"
+)
+
+var (
+	// ErrInvalidCA returned when the CA stored in kvdb cannot be parsed
+	ErrInvalidCA = errors.New("Invalid cluster CA")
+	// ErrNotFound returned when a certificate was not issued by the CA
+	ErrNotFound = errors.New("Certificate not found")
+	// ErrNotInitialized returned when the cluster CA has not been initialized
+	ErrNotInitialized = errors.New("openstorage.ca: not initialized")
+	// ErrInitialized returned when the cluster CA is initialized twice
+	ErrInitialized = errors.New("openstorage.ca: already initialized")
+
+	inst *CA
+	// Inst returns the cluster CA singleton.
+	// This function can be overridden for testing purposes
+	Inst = func() (*CA, error) {
+		return caInst()
+	}
+)
+
+// CA issues certificates signed by the cluster root certificate. The root
+// key pair is created by the first node and shared through kvdb.
+type CA struct {
+	kv      kvdb.Kvdb
+	cert    *x509.Certificate
+	certPEM []byte
+	key     *ecdsa.PrivateKey
+
+	lock sync.RWMutex
+	// revoked caches the serial numbers of the revoked certificates
+	revoked map[string]bool
+}
+
+// CertRecord describes a certificate issued by the CA.
+// swagger:model
+type CertRecord struct {
+	// Serial is the hex encoded serial number of the certificate
+	Serial string
+	// NodeId the certificate was issued to
+	NodeId string
+	// NotAfter is when the certificate expires
+	NotAfter time.Time
+	// Revoked is set once the certificate has been revoked
+	Revoked bool
+	// RevokeTime is when the certificate was revoked
+	RevokeTime time.Time
+}
+
+// Init sets the cluster CA singleton.
+func Init(c *CA) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = c
+	return nil
+}
+
+func caInst() (*CA, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// storedCA is the kvdb representation of the CA.
+type storedCA struct {
+	Cert []byte
+	Key  []byte
+}
+
+// New returns the CA of the cluster, creating it if this is the first node.
+func New(kv kvdb.Kvdb, clusterID string) (*CA, error) {
+	kvp, err := kv.Get(kvdbKey)
+	if err == kvdb.ErrNotFound {
+		var stored *storedCA
+		if stored, err = newStoredCA(clusterID); err != nil {
+			return nil, err
+		}
+		if _, err = kv.Create(kvdbKey, stored, 0); err == nil {
+			return parse(kv, stored)
+		} else if err != kvdb.ErrExist {
+			return nil, err
+		}
+		// Another node created the CA first
+		kvp, err = kv.Get(kvdbKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var stored storedCA
+	if err := json.Unmarshal(kvp.Value, &stored); err != nil {
+		return nil, ErrInvalidCA
+	}
+	return parse(kv, &stored)
+}
+
+func newStoredCA(clusterID string) (*storedCA, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "openstorage CA " + clusterID},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(CAValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &storedCA{
+		Cert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		Key:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
+
+func parse(kv kvdb.Kvdb, stored *storedCA) (*CA, error) {
+	certBlock, _ := pem.Decode(stored.Cert)
+	keyBlock, _ := pem.Decode(stored.Key)
+	if certBlock == nil || keyBlock == nil {
+		return nil, ErrInvalidCA
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, ErrInvalidCA
+	}
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, ErrInvalidCA
+	}
+	c := &CA{
+		kv:      kv,
+		cert:    cert,
+		certPEM: stored.Cert,
+		key:     key,
+		revoked: make(map[string]bool),
+	}
+	if err := c.RefreshRevoked(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// CertPEM returns the PEM encoded CA certificate.
+func (c *CA) CertPEM() []byte {
+	return c.certPEM
+}
+
+// CertPool returns a pool holding the CA certificate, to verify the
+// certificates it issued.
+func (c *CA) CertPool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(c.cert)
+	return pool
+}
+
+// IssueNodeCert returns a certificate identifying nodeID, valid for both
+// client and server authentication. The node id is the common name and a
+// DNS name of the certificate, hosts are added as IP or DNS names.
+func (c *CA) IssueNodeCert(nodeID string, hosts []string, validity time.Duration) (*tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	leaf, err := c.sign(&key.PublicKey, nodeID, hosts, validity)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Certificate{
+		Certificate: [][]byte{leaf.Raw},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
+
+// sign issues a node certificate for pub and records it.
+func (c *CA) sign(pub interface{}, nodeID string, hosts []string, validity time.Duration) (*x509.Certificate, error) {
+	if len(nodeID) == 0 {
+		return nil, fmt.Errorf("Missing node id")
+	}
+	if validity == 0 {
+		validity = DefaultNodeValidity
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: nodeID},
+		DNSNames:     []string{nodeID},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else if len(h) != 0 && h != nodeID {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, c.cert, pub, c.key)
+	if err != nil {
+		return nil, err
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	record := &CertRecord{
+		Serial:   SerialOf(leaf),
+		NodeId:   nodeID,
+		NotAfter: leaf.NotAfter,
+	}
+	if _, err := c.kv.Put(certsKey+"/"+record.Serial, record, 0); err != nil {
+		return nil, err
+	}
+	return leaf, nil
+}
+
+// Enumerate returns the records of the certificates issued by the CA.
+func (c *CA) Enumerate() ([]*CertRecord, error) {
+	kvps, err := c.kv.Enumerate(certsKey)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*CertRecord, 0, len(kvps))
+	for _, kvp := range kvps {
+		var record CertRecord
+		if err := json.Unmarshal(kvp.Value, &record); err != nil {
+			return nil, err
+		}
+		records = append(records, &record)
+	}
+	return records, nil
+}
+
+// Revoke revokes the certificate with the given hex encoded serial number.
+// Nodes reject the revoked certificates once they refresh their list of
+// revocations.
+// Errors ErrNotFound may be returned.
+func (c *CA) Revoke(serial string) error {
+	kvp, err := c.kv.Get(certsKey + "/" + serial)
+	if err == kvdb.ErrNotFound {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+	var record CertRecord
+	if err := json.Unmarshal(kvp.Value, &record); err != nil {
+		return err
+	}
+	if !record.Revoked {
+		record.Revoked = true
+		record.RevokeTime = time.Now()
+		if _, err := c.kv.Put(certsKey+"/"+serial, &record, 0); err != nil {
+			return err
+		}
+	}
+	c.lock.Lock()
+	c.revoked[serial] = true
+	c.lock.Unlock()
+	return nil
+}
+
+// RefreshRevoked reloads the revoked certificates from kvdb.
+func (c *CA) RefreshRevoked() error {
+	records, err := c.Enumerate()
+	if err != nil {
+		return err
+	}
+	revoked := make(map[string]bool)
+	for _, r := range records {
+		if r.Revoked {
+			revoked[r.Serial] = true
+		}
+	}
+	c.lock.Lock()
+	c.revoked = revoked
+	c.lock.Unlock()
+	return nil
+}
+
+// IsRevoked returns true if cert has been revoked.
+func (c *CA) IsRevoked(cert *x509.Certificate) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.revoked[SerialOf(cert)]
+}
+
+// VerifyPeerCertificate is a tls.Config callback rejecting the revoked
+// certificates. It runs after the chain has been verified against the CA.
+func (c *CA) VerifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return nil
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return err
+	}
+	if c.IsRevoked(cert) {
+		return fmt.Errorf("Certificate %s of node %s has been revoked", SerialOf(cert), NodeID(cert))
+	}
+	return nil
+}
+
+// SerialOf returns the hex encoded serial number of cert.
+func SerialOf(cert *x509.Certificate) string {
+	return fmt.Sprintf("%x", cert.SerialNumber)
+}
+
+// NodeID returns the node identified by a certificate issued by the CA.
+func NodeID(cert *x509.Certificate) string {
+	return cert.Subject.CommonName
+}
+
+func newSerial() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
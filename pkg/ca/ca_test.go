@@ -0,0 +1,68 @@
+package ca
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSharesCA(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "test", []string{}, nil, nil)
+	require.NoError(t, err)
+
+	first, err := New(kv, "cluster1")
+	require.NoError(t, err)
+	second, err := New(kv, "cluster1")
+	require.NoError(t, err)
+	assert.Equal(t, first.CertPEM(), second.CertPEM())
+
+	// Certificates issued by one node verify with the CA of another
+	cert, err := first.IssueNodeCert("node1", []string{"10.0.0.1", "node1.example.com"}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "node1", NodeID(cert.Leaf))
+	assert.Len(t, cert.Leaf.IPAddresses, 1)
+	assert.Equal(t, []string{"node1", "node1.example.com"}, cert.Leaf.DNSNames)
+
+	_, err = cert.Leaf.Verify(x509.VerifyOptions{
+		DNSName:   "node1",
+		Roots:     second.CertPool(),
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	assert.NoError(t, err)
+}
+
+func TestIssueNodeCert(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "test", []string{}, nil, nil)
+	require.NoError(t, err)
+	c, err := New(kv, "cluster1")
+	require.NoError(t, err)
+
+	_, err = c.IssueNodeCert("", nil, 0)
+	assert.Error(t, err)
+
+	cert, err := c.IssueNodeCert("node1", nil, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, cert.Leaf.NotAfter.Before(time.Now().Add(2*time.Hour)))
+
+	// Another cluster does not trust it
+	other, err := kvdb.New(mem.Name, "other", []string{}, nil, nil)
+	require.NoError(t, err)
+	otherCA, err := New(other, "cluster2")
+	require.NoError(t, err)
+	_, err = cert.Leaf.Verify(x509.VerifyOptions{Roots: otherCA.CertPool()})
+	assert.Error(t, err)
+}
+
+func TestInvalidCA(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "test", []string{}, nil, nil)
+	require.NoError(t, err)
+	_, err = kv.Put(kvdbKey, []byte("garbage"), 0)
+	require.NoError(t, err)
+	_, err = New(kv, "cluster1")
+	assert.Equal(t, ErrInvalidCA, err)
+}
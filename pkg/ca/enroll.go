@@ -0,0 +1,148 @@
+package ca
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/portworx/kvdb"
+)
+
+const (
+	// DefaultTokenTTL is the lifetime of enrollment tokens
+	DefaultTokenTTL = time.Hour
+
+	// tokensKey holds the hash of every unused enrollment token
+	tokensKey = "ca/tokens"
+)
+
+var (
+	// ErrInvalidToken returned when an enrollment token is unknown, used or
+	// expired
+	ErrInvalidToken = errors.New("Invalid enrollment token")
+	// ErrInvalidCSR returned when a certificate signing request cannot be
+	// parsed or its signature is invalid
+	ErrInvalidCSR = errors.New("Invalid certificate signing request")
+)
+
+// CreateToken returns a one time token allowing a node to enroll during
+// ttl, DefaultTokenTTL if zero. Only the hash of the token is stored.
+func (c *CA) CreateToken(ttl time.Duration) (string, error) {
+	if ttl == 0 {
+		ttl = DefaultTokenTTL
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(secret)
+	if _, err := c.kv.Put(tokenKey(token), time.Now(), uint64(ttl.Seconds())); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Enroll consumes token and issues a certificate for the PEM encoded
+// certificate signing request of a joining node. The node id is the common
+// name of the request, its DNS names and IP addresses are kept. The
+// private key of the node never leaves it.
+// Errors ErrInvalidToken and ErrInvalidCSR may be returned.
+func (c *CA) Enroll(token string, csrPEM []byte) ([]byte, error) {
+	csr, err := parseCSR(csrPEM)
+	if err != nil {
+		return nil, err
+	}
+	// Deleting the token makes it single use, even with concurrent requests,
+	// and kvdb expires it after its TTL.
+	_, err = c.kv.Delete(tokenKey(token))
+	if err == kvdb.ErrNotFound {
+		return nil, ErrInvalidToken
+	} else if err != nil {
+		return nil, err
+	}
+
+	var hosts []string
+	for _, ip := range csr.IPAddresses {
+		hosts = append(hosts, ip.String())
+	}
+	hosts = append(hosts, csr.DNSNames...)
+	leaf, err := c.sign(csr.PublicKey, csr.Subject.CommonName, hosts, 0)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw}), nil
+}
+
+// NewCSR returns a new private key for nodeID and the PEM encoded
+// certificate signing request to enroll it with.
+func NewCSR(nodeID string, hosts []string) (*ecdsa.PrivateKey, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: nodeID},
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else if len(h) != 0 {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), nil
+}
+
+func parseCSR(csrPEM []byte) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, ErrInvalidCSR
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, ErrInvalidCSR
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, ErrInvalidCSR
+	}
+	if len(csr.Subject.CommonName) == 0 {
+		return nil, fmt.Errorf("Certificate signing request is missing the node id")
+	}
+	return csr, nil
+}
+
+func tokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return tokensKey + "/" + hex.EncodeToString(sum[:])
+}
+
+// EnrollRequest is the request of a node joining the cluster.
+// swagger:model
+type EnrollRequest struct {
+	// Token is the one time enrollment token
+	Token string
+	// Csr is the PEM encoded certificate signing request of the node
+	Csr string
+}
+
+// EnrollResponse holds the certificate issued to a joining node.
+// swagger:model
+type EnrollResponse struct {
+	// Cert is the PEM encoded node certificate
+	Cert string
+	// CA is the PEM encoded cluster CA certificate
+	CA string
+}
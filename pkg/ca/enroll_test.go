@@ -0,0 +1,107 @@
+package ca
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnroll(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "test", []string{}, nil, nil)
+	require.NoError(t, err)
+	c, err := New(kv, "cluster1")
+	require.NoError(t, err)
+
+	token, err := c.CreateToken(0)
+	require.NoError(t, err)
+	_, csr, err := NewCSR("node2", []string{"10.0.0.2", "node2.example.com"})
+	require.NoError(t, err)
+
+	certPEM, err := c.Enroll(token, csr)
+	require.NoError(t, err)
+	block, _ := pem.Decode(certPEM)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, "node2", NodeID(cert))
+	assert.Equal(t, []string{"node2", "node2.example.com"}, cert.DNSNames)
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:     c.CertPool(),
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	assert.NoError(t, err)
+
+	// Tokens are single use
+	_, err = c.Enroll(token, csr)
+	assert.Equal(t, ErrInvalidToken, err)
+	_, err = c.Enroll("unknown", csr)
+	assert.Equal(t, ErrInvalidToken, err)
+
+	// A bad request does not consume the token
+	token, err = c.CreateToken(0)
+	require.NoError(t, err)
+	_, err = c.Enroll(token, []byte("garbage"))
+	assert.Equal(t, ErrInvalidCSR, err)
+	_, err = c.Enroll(token, csr)
+	assert.NoError(t, err)
+}
+
+func TestRevoke(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "test", []string{}, nil, nil)
+	require.NoError(t, err)
+	c, err := New(kv, "cluster1")
+	require.NoError(t, err)
+
+	cert, err := c.IssueNodeCert("node1", nil, 0)
+	require.NoError(t, err)
+	records, err := c.Enumerate()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "node1", records[0].NodeId)
+	assert.False(t, records[0].Revoked)
+
+	assert.Equal(t, ErrNotFound, c.Revoke("ff"))
+	require.NoError(t, c.Revoke(SerialOf(cert.Leaf)))
+	assert.True(t, c.IsRevoked(cert.Leaf))
+	assert.Error(t, c.VerifyPeerCertificate([][]byte{cert.Leaf.Raw}, nil))
+
+	// Other nodes see it once they refresh
+	other, err := New(kv, "cluster1")
+	require.NoError(t, err)
+	assert.True(t, other.IsRevoked(cert.Leaf))
+
+	records, err = c.Enumerate()
+	require.NoError(t, err)
+	assert.True(t, records[0].Revoked)
+}
+
+func TestIdentityRotation(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "test", []string{}, nil, nil)
+	require.NoError(t, err)
+	c, err := New(kv, "cluster1")
+	require.NoError(t, err)
+
+	identity, err := NewIdentity(c, "node1", nil, 0)
+	require.NoError(t, err)
+	first := identity.Certificate()
+	now := first.Leaf.NotBefore
+
+	identity.check(now)
+	assert.Equal(t, first, identity.Certificate())
+
+	// Close to expiry
+	identity.check(first.Leaf.NotAfter.Add(-DefaultNodeValidity / 4))
+	second := identity.Certificate()
+	assert.NotEqual(t, SerialOf(first.Leaf), SerialOf(second.Leaf))
+
+	// Revoked
+	require.NoError(t, c.Revoke(SerialOf(second.Leaf)))
+	identity.check(now)
+	assert.NotEqual(t, SerialOf(second.Leaf), SerialOf(identity.Certificate().Leaf))
+	assert.False(t, c.IsRevoked(identity.Certificate().Leaf))
+}
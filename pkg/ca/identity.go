@@ -0,0 +1,137 @@
+package ca
+
+import (
+	"crypto/tls"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// identityCheckInterval is how often revocations are refreshed and the
+	// node certificate checked for rotation
+	identityCheckInterval = time.Hour
+)
+
+// Identity is the rotating certificate of this node. The TLS configurations
+// it returns always present the current certificate, so connections opened
+// after a rotation use the new one.
+type Identity struct {
+	ca       *CA
+	nodeID   string
+	hosts    []string
+	validity time.Duration
+
+	lock sync.RWMutex
+	cert *tls.Certificate
+}
+
+// NewIdentity issues the certificate of nodeID and returns its identity.
+// The certificate is valid for validity, DefaultNodeValidity if zero.
+func NewIdentity(c *CA, nodeID string, hosts []string, validity time.Duration) (*Identity, error) {
+	if validity == 0 {
+		validity = DefaultNodeValidity
+	}
+	i := &Identity{
+		ca:       c,
+		nodeID:   nodeID,
+		hosts:    hosts,
+		validity: validity,
+	}
+	if err := i.Rotate(); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// NodeID returns the node identified.
+func (i *Identity) NodeID() string {
+	return i.nodeID
+}
+
+// Certificate returns the current certificate of the node.
+func (i *Identity) Certificate() *tls.Certificate {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.cert
+}
+
+// Rotate replaces the certificate of the node with a newly issued one. The
+// previous certificate stays valid until it expires, so that established
+// connections are not affected.
+func (i *Identity) Rotate() error {
+	cert, err := i.ca.IssueNodeCert(i.nodeID, i.hosts, i.validity)
+	if err != nil {
+		return err
+	}
+	i.lock.Lock()
+	i.cert = cert
+	i.lock.Unlock()
+	return nil
+}
+
+// needsRotation returns true if the certificate has been revoked or less
+// than a third of its lifetime remains.
+func (i *Identity) needsRotation(now time.Time) bool {
+	cert := i.Certificate()
+	if i.ca.IsRevoked(cert.Leaf) {
+		return true
+	}
+	return cert.Leaf.NotAfter.Sub(now) < i.validity/3
+}
+
+// Start refreshes the revoked certificates and rotates the node certificate
+// when needed, every hour.
+func (i *Identity) Start() {
+	go func() {
+		for range time.Tick(identityCheckInterval) {
+			i.check(time.Now())
+		}
+	}()
+}
+
+func (i *Identity) check(now time.Time) {
+	log := logrus.WithField("pkg", "openstorage/ca")
+	if err := i.ca.RefreshRevoked(); err != nil {
+		log.Warnf("Failed to refresh revoked certificates: %v", err)
+	}
+	if !i.needsRotation(now) {
+		return
+	}
+	if err := i.Rotate(); err != nil {
+		log.Warnf("Failed to rotate certificate of node %s: %v", i.nodeID, err)
+		return
+	}
+	log.Infof("Rotated certificate of node %s", i.nodeID)
+}
+
+// ServerTLSConfig returns the configuration of a server identified by the
+// node certificate, verifying the clients certificates according to
+// clientAuth.
+func (i *Identity) ServerTLSConfig(clientAuth tls.ClientAuthType) *tls.Config {
+	return &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return i.Certificate(), nil
+		},
+		ClientCAs:             i.ca.CertPool(),
+		ClientAuth:            clientAuth,
+		VerifyPeerCertificate: i.ca.VerifyPeerCertificate,
+		MinVersion:            tls.VersionTLS12,
+	}
+}
+
+// ClientTLSConfig returns the configuration of a client identified by the
+// node certificate, connecting to the node serverNodeID. It may be used
+// with credentials.NewTLS for gRPC connections between nodes.
+func (i *Identity) ClientTLSConfig(serverNodeID string) *tls.Config {
+	return &tls.Config{
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return i.Certificate(), nil
+		},
+		RootCAs:               i.ca.CertPool(),
+		ServerName:            serverNodeID,
+		VerifyPeerCertificate: i.ca.VerifyPeerCertificate,
+		MinVersion:            tls.VersionTLS12,
+	}
+}
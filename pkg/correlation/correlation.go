@@ -0,0 +1,72 @@
+/*
+Package correlation carries the id correlating the work done on behalf of an
+API request, from the client to the server and into the jobs, alerts, events
+and audit records it spawns, so that a request can be traced through every
+subsystem with one identifier.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package correlation
+
+import (
+	"context"
+
+	"github.com/pborman/uuid"
+)
+
+const (
+	// Header is the HTTP header carrying the correlation id of a request,
+	// set by the client or assigned by the server and returned with the
+	// response
+	Header = "X-Correlation-Id"
+	// MetadataKey is the gRPC metadata carrying the correlation id of a call,
+	// set by the client or assigned by the server and returned in the header
+	// of the response
+	MetadataKey = "x-correlation-id"
+	// Key is the name of the correlation id in the logs, the alert payloads
+	// and the audit record details
+	Key = "correlation_id"
+)
+
+// contextKey is the key of the correlation id in a context.
+type contextKey struct{}
+
+// NewID returns a new correlation id.
+func NewID() string {
+	return uuid.New()
+}
+
+// NewContext returns a copy of ctx carrying the correlation id id.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the correlation id of ctx, empty if none.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Detach returns a background context carrying the correlation id of ctx,
+// for the work outliving the request of ctx, such as a job.
+func Detach(ctx context.Context) context.Context {
+	id := FromContext(ctx)
+	if len(id) == 0 {
+		return context.Background()
+	}
+	return NewContext(context.Background(), id)
+}
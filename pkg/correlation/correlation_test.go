@@ -0,0 +1,59 @@
+package correlation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestContext(t *testing.T) {
+	assert.Empty(t, FromContext(context.Background()))
+
+	ctx, cancel := context.WithCancel(NewContext(context.Background(), "abc"))
+	assert.Equal(t, "abc", FromContext(ctx))
+
+	// the detached context outlives the request
+	detached := Detach(ctx)
+	cancel()
+	assert.Error(t, ctx.Err())
+	assert.NoError(t, detached.Err())
+	assert.Equal(t, "abc", FromContext(detached))
+	assert.Empty(t, FromContext(Detach(context.Background())))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return FromContext(ctx), nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "abc"))
+	id, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", id)
+
+	id, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, id)
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := UnaryClientInterceptor()
+	var sent []string
+	invoker := func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		sent = md[MetadataKey]
+		return nil
+	}
+
+	ctx := NewContext(context.Background(), "abc")
+	assert.NoError(t, interceptor(ctx, "method", nil, nil, nil, invoker))
+	assert.Equal(t, []string{"abc"}, sent)
+
+	assert.NoError(t, interceptor(context.Background(), "method", nil, nil, nil, invoker))
+	assert.Empty(t, sent)
+}
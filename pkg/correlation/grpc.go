@@ -0,0 +1,51 @@
+package correlation
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryServerInterceptor sets the correlation id of the calls in their
+// context: the one of their metadata, or a new one. The id is returned in the
+// header of the response.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		id := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if v := md[MetadataKey]; len(v) != 0 {
+				id = v[0]
+			}
+		}
+		if len(id) == 0 {
+			id = NewID()
+		}
+		grpc.SetHeader(ctx, metadata.Pairs(MetadataKey, id))
+		return handler(NewContext(ctx, id), req)
+	}
+}
+
+// UnaryClientInterceptor sends the correlation id of the context of the calls
+// in their metadata.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if id := FromContext(ctx); len(id) != 0 {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			ctx = metadata.NewOutgoingContext(ctx, metadata.Join(md, metadata.Pairs(MetadataKey, id)))
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,130 @@
+// Package cron parses cron expressions of five fields and tells when they
+// fire.
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxNext bounds the search of the next time a schedule fires, as a
+// schedule such as "0 0 31 2 *" never does
+const maxNext = 5 * 366 * 24 * time.Hour
+
+// field is the range of a field of a cron schedule.
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 6},
+}
+
+// Schedule is a parsed cron schedule: minute, hour, day of month, month and
+// day of week, each field a set of values.
+type Schedule struct {
+	fields [5]uint64
+	// set if the day of the month or the day of the week is *, a day
+	// matching either matches when both are restricted, as with cron
+	domStar, dowStar bool
+}
+
+// Parse parses the five fields of a cron expression, such as "0 2 * * 6",
+// each field being *, a value, a range a-b, a step */n, a/n or a-b/n, or a
+// comma separated list of those.
+func Parse(expr string) (*Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("%s: expected 5 fields", expr)
+	}
+	s := &Schedule{domStar: parts[2] == "*", dowStar: parts[4] == "*"}
+	for i, part := range parts {
+		bits, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", expr, err)
+		}
+		s.fields[i] = bits
+	}
+	return s, nil
+}
+
+func parseField(expr string, f field) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		step, stepped := 1, false
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, errors.New("invalid step of the " + f.name)
+			}
+			step, stepped, part = n, true, part[:i]
+		}
+		lo, hi := f.min, f.max
+		if part != "*" {
+			bounds := strings.SplitN(part, "-", 2)
+			var err error
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, errors.New("invalid " + f.name)
+			}
+			if !stepped {
+				hi = lo
+			}
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return 0, errors.New("invalid " + f.name)
+				}
+			}
+			if lo < f.min || hi > f.max || lo > hi {
+				return 0, errors.New("out of range " + f.name)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// Matches returns true if the schedule fires at the minute of t.
+func (s *Schedule) Matches(t time.Time) bool {
+	has := func(i, v int) bool { return s.fields[i]&(1<<uint(v)) != 0 }
+	if !has(0, t.Minute()) || !has(1, t.Hour()) || !has(3, int(t.Month())) {
+		return false
+	}
+	dom, dow := has(2, t.Day()), has(4, int(t.Weekday()))
+	if !s.domStar && !s.dowStar {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// Last returns the last time the schedule fired after from, up to t, and
+// false if it did not.
+func (s *Schedule) Last(from, t time.Time) (time.Time, bool) {
+	for m := t.Truncate(time.Minute); m.After(from); m = m.Add(-time.Minute) {
+		if s.Matches(m) {
+			return m, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Next returns the next time the schedule fires after t, and false if it
+// does not within five years.
+func (s *Schedule) Next(t time.Time) (time.Time, bool) {
+	end := t.Add(maxNext)
+	for m := t.Truncate(time.Minute).Add(time.Minute); m.Before(end); m = m.Add(time.Minute) {
+		if s.Matches(m) {
+			return m, true
+		}
+	}
+	return time.Time{}, false
+}
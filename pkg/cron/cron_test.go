@@ -0,0 +1,42 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+	s, err := Parse("*/15 9-17 * 1,6 1-5")
+	require.NoError(t, err)
+	assert.True(t, s.Matches(time.Date(2026, 6, 1, 9, 15, 0, 0, time.UTC)))
+	assert.False(t, s.Matches(time.Date(2026, 6, 1, 9, 10, 0, 0, time.UTC)))
+	assert.False(t, s.Matches(time.Date(2026, 6, 6, 9, 15, 0, 0, time.UTC)))
+}
+
+func TestLastNext(t *testing.T) {
+	s, err := Parse("30 2 * * *")
+	require.NoError(t, err)
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	last, fired := s.Last(now.Add(-24*time.Hour), now)
+	assert.True(t, fired)
+	assert.Equal(t, time.Date(2026, 6, 1, 2, 30, 0, 0, time.UTC), last)
+	_, fired = s.Last(now.Add(-time.Hour), now)
+	assert.False(t, fired)
+
+	next, ok := s.Next(now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 6, 2, 2, 30, 0, 0, time.UTC), next)
+
+	s, err = Parse("0 0 31 2 *")
+	require.NoError(t, err)
+	_, ok = s.Next(now)
+	assert.False(t, ok)
+}
@@ -0,0 +1,117 @@
+/*
+Package crypto provides envelope encryption helpers for volume keys.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+)
+
+const (
+	// KeySize is the size in bytes of data and key encryption keys.
+	KeySize = 32
+)
+
+var (
+	// ErrInvalidKeySize returned when a key is not KeySize bytes long
+	ErrInvalidKeySize = errors.New("Invalid key size")
+	// ErrInvalidWrappedKey returned when a wrapped key cannot be unwrapped
+	ErrInvalidWrappedKey = errors.New("Invalid wrapped key")
+	// ErrInvalidCiphertext returned when data cannot be decrypted
+	ErrInvalidCiphertext = errors.New("Invalid ciphertext")
+)
+
+// NewKey returns a new random key of KeySize bytes. It is used both for
+// data encryption keys (DEK) and key encryption keys (KEK).
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// WrapKey encrypts dek with kek using AES-GCM. The returned value carries
+// the nonce as a prefix.
+func WrapKey(kek, dek []byte) ([]byte, error) {
+	return Encrypt(kek, dek)
+}
+
+// UnwrapKey decrypts a key previously wrapped with WrapKey.
+func UnwrapKey(kek, wrapped []byte) ([]byte, error) {
+	dek, err := Decrypt(kek, wrapped)
+	if err == ErrInvalidCiphertext {
+		return nil, ErrInvalidWrappedKey
+	}
+	return dek, err
+}
+
+// RewrapKey unwraps a key with oldKek and wraps it again with newKek.
+// The data encryption key itself does not change, so volume data does not
+// need to be re-encrypted.
+func RewrapKey(oldKek, newKek, wrapped []byte) ([]byte, error) {
+	dek, err := UnwrapKey(oldKek, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	return WrapKey(newKek, dek)
+}
+
+// Encrypt encrypts plaintext with key using AES-GCM. The returned value
+// carries the nonce as a prefix.
+func Encrypt(key, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt decrypts data previously encrypted with Encrypt.
+func Decrypt(key, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+	return plaintext, nil
+}
+
+func newAEAD(kek []byte) (cipher.AEAD, error) {
+	if len(kek) != KeySize {
+		return nil, ErrInvalidKeySize
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,65 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapUnwrap(t *testing.T) {
+	kek, err := NewKey()
+	require.NoError(t, err)
+	dek, err := NewKey()
+	require.NoError(t, err)
+
+	wrapped, err := WrapKey(kek, dek)
+	require.NoError(t, err)
+	assert.NotEqual(t, dek, wrapped)
+
+	unwrapped, err := UnwrapKey(kek, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dek, unwrapped)
+}
+
+func TestRewrapKey(t *testing.T) {
+	oldKek, err := NewKey()
+	require.NoError(t, err)
+	newKek, err := NewKey()
+	require.NoError(t, err)
+	dek, err := NewKey()
+	require.NoError(t, err)
+
+	wrapped, err := WrapKey(oldKek, dek)
+	require.NoError(t, err)
+
+	rewrapped, err := RewrapKey(oldKek, newKek, wrapped)
+	require.NoError(t, err)
+
+	_, err = UnwrapKey(oldKek, rewrapped)
+	assert.Equal(t, ErrInvalidWrappedKey, err)
+
+	unwrapped, err := UnwrapKey(newKek, rewrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dek, unwrapped)
+}
+
+func TestInvalidKeySize(t *testing.T) {
+	_, err := WrapKey([]byte("short"), []byte("dek"))
+	assert.Equal(t, ErrInvalidKeySize, err)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key, err := NewKey()
+	require.NoError(t, err)
+
+	ciphertext, err := Encrypt(key, []byte("cluster metadata"))
+	require.NoError(t, err)
+	plaintext, err := Decrypt(key, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "cluster metadata", string(plaintext))
+
+	ciphertext[len(ciphertext)-1] ^= 1
+	_, err = Decrypt(key, ciphertext)
+	assert.Equal(t, ErrInvalidCiphertext, err)
+}
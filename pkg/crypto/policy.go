@@ -0,0 +1,273 @@
+/*
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package crypto
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultCipher is the dm-crypt cipher used for encrypted volumes when
+	// none is requested
+	DefaultCipher = "aes-xts-plain64"
+	// DefaultKDF is the key derivation function used for volume passphrases
+	// when none is requested
+	DefaultKDF = "pbkdf2"
+	// DefaultBackupCipher is the cipher used to encrypt cloud backups
+	DefaultBackupCipher = "aes-256-gcm"
+)
+
+var (
+	// ErrFIPSNotEnabled returned when FIPS mode is requested but the host
+	// kernel is not running in FIPS mode
+	ErrFIPSNotEnabled = errors.New("FIPS mode requested but host is not in FIPS mode")
+
+	// fipsEnabledFile reports whether the kernel is running in FIPS mode.
+	// Overridden in tests.
+	fipsEnabledFile = "/proc/sys/crypto/fips_enabled"
+
+	// ciphers maps each known cipher to whether it is FIPS 140-2 approved.
+	ciphers = map[string]bool{
+		"aes-xts-plain64":      true,
+		"aes-cbc-essiv:sha256": true,
+		"aes-256-gcm":          true,
+		"serpent-xts-plain64":  false,
+		"twofish-xts-plain64":  false,
+		"chacha20-poly1305":    false,
+	}
+	// kdfs maps each known key derivation function to whether it is FIPS
+	// approved.
+	kdfs = map[string]bool{
+		"pbkdf2":   true,
+		"argon2i":  false,
+		"argon2id": false,
+	}
+	// fipsTLSCipherSuites are the TLS cipher suites allowed in FIPS mode.
+	fipsTLSCipherSuites = map[uint16]bool{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256: true,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384: true,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256:   true,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384:   true,
+		tls.TLS_RSA_WITH_AES_128_GCM_SHA256:         true,
+		tls.TLS_RSA_WITH_AES_256_GCM_SHA384:         true,
+	}
+	tlsVersions = map[string]uint16{
+		"1.0": tls.VersionTLS10,
+		"1.1": tls.VersionTLS11,
+		"1.2": tls.VersionTLS12,
+	}
+
+	policyLock sync.RWMutex
+	policy     = &Policy{}
+)
+
+// Policy restricts the ciphers, key derivation functions and TLS settings
+// used for volume encryption, backup encryption and TLS connections.
+// Empty lists allow every known algorithm, limited to the FIPS approved
+// ones when FIPSOnly is set.
+// swagger:model
+type Policy struct {
+	// FIPSOnly allows only FIPS 140-2 approved algorithms and requires the
+	// host to be running in FIPS mode
+	FIPSOnly bool `yaml:"fips_only"`
+	// Ciphers allowed for volume and backup encryption
+	Ciphers []string `yaml:"ciphers"`
+	// KDFs allowed for deriving keys from passphrases
+	KDFs []string `yaml:"kdfs"`
+	// TLSMinVersion is the minimum TLS version, one of 1.0, 1.1 or 1.2
+	TLSMinVersion string `yaml:"tls_min_version"`
+	// TLSCipherSuites allowed, using the Go names of the suites
+	TLSCipherSuites []string `yaml:"tls_cipher_suites"`
+}
+
+// SetPolicy validates and installs the process wide crypto policy.
+func SetPolicy(p *Policy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	policyLock.Lock()
+	defer policyLock.Unlock()
+	policy = p
+	return nil
+}
+
+// GetPolicy returns the process wide crypto policy.
+func GetPolicy() *Policy {
+	policyLock.RLock()
+	defer policyLock.RUnlock()
+	return policy
+}
+
+// Validate checks that every algorithm in the policy is known, that all of
+// them are FIPS approved in FIPS mode, and that the host can comply.
+func (p *Policy) Validate() error {
+	for _, c := range p.Ciphers {
+		if err := p.checkAlgorithm("cipher", c, ciphers); err != nil {
+			return err
+		}
+	}
+	for _, k := range p.KDFs {
+		if err := p.checkAlgorithm("kdf", k, kdfs); err != nil {
+			return err
+		}
+	}
+	if _, err := p.tlsCipherSuites(); err != nil {
+		return err
+	}
+	if _, err := p.tlsMinVersion(); err != nil {
+		return err
+	}
+	if p.FIPSOnly && !hostFIPSEnabled() {
+		return ErrFIPSNotEnabled
+	}
+	return nil
+}
+
+// CheckCipher returns an error if the cipher is not allowed by the policy.
+func (p *Policy) CheckCipher(name string) error {
+	if err := p.checkAlgorithm("cipher", name, ciphers); err != nil {
+		return err
+	}
+	return checkAllowed("cipher", name, p.Ciphers)
+}
+
+// CheckKDF returns an error if the key derivation function is not allowed
+// by the policy.
+func (p *Policy) CheckKDF(name string) error {
+	if err := p.checkAlgorithm("kdf", name, kdfs); err != nil {
+		return err
+	}
+	return checkAllowed("kdf", name, p.KDFs)
+}
+
+// TLSConfig returns a copy of base, or a new config if base is nil, with
+// the minimum version and cipher suites restricted by the policy.
+func (p *Policy) TLSConfig(base *tls.Config) (*tls.Config, error) {
+	var config *tls.Config
+	if base != nil {
+		config = base.Clone()
+	} else {
+		config = &tls.Config{}
+	}
+
+	suites, err := p.tlsCipherSuites()
+	if err != nil {
+		return nil, err
+	}
+	if len(suites) != 0 {
+		config.CipherSuites = suites
+	}
+	minVersion, err := p.tlsMinVersion()
+	if err != nil {
+		return nil, err
+	}
+	if minVersion > config.MinVersion {
+		config.MinVersion = minVersion
+	}
+	return config, nil
+}
+
+func (p *Policy) checkAlgorithm(kind, name string, known map[string]bool) error {
+	approved, ok := known[name]
+	if !ok {
+		return fmt.Errorf("Unknown %s %q", kind, name)
+	}
+	if p.FIPSOnly && !approved {
+		return fmt.Errorf("%s %q is not allowed in FIPS mode", kind, name)
+	}
+	return nil
+}
+
+func (p *Policy) tlsCipherSuites() ([]uint16, error) {
+	var ids []uint16
+	if len(p.TLSCipherSuites) == 0 {
+		if !p.FIPSOnly {
+			return nil, nil
+		}
+		for id := range fipsTLSCipherSuites {
+			ids = append(ids, id)
+		}
+		return ids, nil
+	}
+
+	for _, name := range p.TLSCipherSuites {
+		id, ok := tlsCipherSuiteID(name)
+		if !ok {
+			return nil, fmt.Errorf("Unknown TLS cipher suite %q", name)
+		}
+		if p.FIPSOnly && !fipsTLSCipherSuites[id] {
+			return nil, fmt.Errorf("TLS cipher suite %q is not allowed in FIPS mode", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (p *Policy) tlsMinVersion() (uint16, error) {
+	if len(p.TLSMinVersion) == 0 {
+		if p.FIPSOnly {
+			return tls.VersionTLS12, nil
+		}
+		return 0, nil
+	}
+	version, ok := tlsVersions[p.TLSMinVersion]
+	if !ok {
+		return 0, fmt.Errorf("Unknown TLS version %q", p.TLSMinVersion)
+	}
+	if p.FIPSOnly && version < tls.VersionTLS12 {
+		return 0, fmt.Errorf("TLS version %q is not allowed in FIPS mode", p.TLSMinVersion)
+	}
+	return version, nil
+}
+
+func checkAllowed(kind, name string, allowed []string) error {
+	if len(allowed) == 0 {
+		return nil
+	}
+	for _, a := range allowed {
+		if a == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s %q is not allowed by the crypto policy", kind, name)
+}
+
+func tlsCipherSuiteID(name string) (uint16, bool) {
+	for _, s := range tls.CipherSuites() {
+		if s.Name == name {
+			return s.ID, true
+		}
+	}
+	for _, s := range tls.InsecureCipherSuites() {
+		if s.Name == name {
+			return s.ID, true
+		}
+	}
+	return 0, false
+}
+
+func hostFIPSEnabled() bool {
+	data, err := ioutil.ReadFile(fipsEnabledFile)
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(string(data)) == "1"
+}
@@ -0,0 +1,89 @@
+package crypto
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setFIPSEnabled(t *testing.T, enabled string) func() {
+	f, err := ioutil.TempFile("", "fips_enabled")
+	require.NoError(t, err)
+	_, err = f.WriteString(enabled + "\n")
+	require.NoError(t, err)
+	f.Close()
+
+	orig := fipsEnabledFile
+	fipsEnabledFile = f.Name()
+	return func() {
+		fipsEnabledFile = orig
+		os.Remove(f.Name())
+	}
+}
+
+func TestPolicyDefault(t *testing.T) {
+	p := &Policy{}
+	assert.NoError(t, p.Validate())
+	assert.NoError(t, p.CheckCipher("twofish-xts-plain64"))
+	assert.NoError(t, p.CheckKDF("argon2id"))
+	assert.Error(t, p.CheckCipher("rot13"))
+
+	config, err := p.TLSConfig(nil)
+	require.NoError(t, err)
+	assert.Empty(t, config.CipherSuites)
+}
+
+func TestPolicyAllowList(t *testing.T) {
+	p := &Policy{
+		Ciphers: []string{DefaultCipher},
+		KDFs:    []string{DefaultKDF},
+	}
+	require.NoError(t, p.Validate())
+	assert.NoError(t, p.CheckCipher(DefaultCipher))
+	assert.Error(t, p.CheckCipher("aes-cbc-essiv:sha256"))
+	assert.NoError(t, p.CheckKDF(DefaultKDF))
+	assert.Error(t, p.CheckKDF("argon2i"))
+}
+
+func TestPolicyFIPS(t *testing.T) {
+	defer setFIPSEnabled(t, "1")()
+
+	p := &Policy{FIPSOnly: true}
+	require.NoError(t, p.Validate())
+	assert.NoError(t, p.CheckCipher(DefaultCipher))
+	assert.Error(t, p.CheckCipher("serpent-xts-plain64"))
+	assert.Error(t, p.CheckKDF("argon2id"))
+
+	config, err := p.TLSConfig(&tls.Config{ServerName: "osd"})
+	require.NoError(t, err)
+	assert.Equal(t, "osd", config.ServerName)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+	assert.NotEmpty(t, config.CipherSuites)
+	for _, id := range config.CipherSuites {
+		assert.True(t, fipsTLSCipherSuites[id])
+	}
+
+	p.Ciphers = []string{"chacha20-poly1305"}
+	assert.Error(t, p.Validate())
+
+	p.Ciphers = nil
+	p.TLSCipherSuites = []string{"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256"}
+	assert.Error(t, p.Validate())
+
+	p.TLSCipherSuites = nil
+	p.TLSMinVersion = "1.0"
+	assert.Error(t, p.Validate())
+}
+
+func TestPolicyFIPSHostNotEnabled(t *testing.T) {
+	defer setFIPSEnabled(t, "0")()
+
+	p := &Policy{FIPSOnly: true}
+	assert.Equal(t, ErrFIPSNotEnabled, p.Validate())
+	assert.Equal(t, ErrFIPSNotEnabled, SetPolicy(p))
+	assert.False(t, GetPolicy().FIPSOnly)
+}
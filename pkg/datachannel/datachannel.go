@@ -0,0 +1,248 @@
+/*
+Package datachannel provides mutually authenticated, encrypted and
+optionally compressed connections between nodes, for volume replication
+and migration traffic.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package datachannel
+
+import (
+	"compress/flate"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/libopenstorage/openstorage/pkg/ca"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+)
+
+const (
+	// handshakeTimeout bounds the TLS and channel handshakes
+	handshakeTimeout = 30 * time.Second
+
+	flagCompress byte = 1 << 0
+)
+
+var (
+	// ErrNotInitialized returned when the data channels have not been initialized
+	ErrNotInitialized = errors.New("openstorage.datachannel: not initialized")
+	// ErrInitialized returned when the data channels are initialized twice
+	ErrInitialized = errors.New("openstorage.datachannel: already initialized")
+
+	inst *Config
+)
+
+// Config holds the identity of this node, issued by the cluster CA.
+type Config struct {
+	// Identity identifies this node to its peers and verifies theirs
+	Identity *ca.Identity
+	// Compress compresses the data sent on the channels this node opens
+	Compress bool
+}
+
+// Init sets the configuration of the channels of this node.
+func Init(c *Config) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = c
+	return nil
+}
+
+// Inst returns the configuration of the channels of this node.
+func Inst() (*Config, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// tlsConfig returns the server configuration if peerNodeID is empty, and
+// the client configuration to connect to peerNodeID otherwise.
+func (c *Config) tlsConfig(peerNodeID string) (*tls.Config, error) {
+	if c.Identity == nil {
+		return nil, fmt.Errorf("Data channel requires a node identity")
+	}
+	if len(peerNodeID) == 0 {
+		return crypto.GetPolicy().TLSConfig(c.Identity.ServerTLSConfig(tls.RequireAndVerifyClientCert))
+	}
+	return crypto.GetPolicy().TLSConfig(c.Identity.ClientTLSConfig(peerNodeID))
+}
+
+// Conn is a data channel to a peer node.
+type Conn struct {
+	conn   *tls.Conn
+	peer   string
+	reader io.Reader
+	writer *flate.Writer
+}
+
+// PeerNodeID returns the id of the node at the other end of the channel.
+func (c *Conn) PeerNodeID() string {
+	return c.peer
+}
+
+// Compressed returns true if the data on the channel is compressed.
+func (c *Conn) Compressed() bool {
+	return c.writer != nil
+}
+
+// Read reads data sent by the peer.
+func (c *Conn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
+
+// Write sends p to the peer. Compressed data is flushed on every write so
+// that the peer can read it immediately.
+func (c *Conn) Write(p []byte) (int, error) {
+	if c.writer == nil {
+		return c.conn.Write(p)
+	}
+	n, err := c.writer.Write(p)
+	if err != nil {
+		return n, err
+	}
+	return n, c.writer.Flush()
+}
+
+// CloseWrite signals the peer that no more data will be sent.
+func (c *Conn) CloseWrite() error {
+	if c.writer != nil {
+		if err := c.writer.Close(); err != nil {
+			return err
+		}
+	}
+	return c.conn.CloseWrite()
+}
+
+// Close closes the channel.
+func (c *Conn) Close() error {
+	if c.writer != nil {
+		c.writer.Close()
+	}
+	return c.conn.Close()
+}
+
+// setup exchanges the channel flags once the TLS handshake is done. The
+// dialer sends the flags and the listener adopts them.
+func setup(conn *tls.Conn, dialer bool, flags byte) (*Conn, error) {
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	if err := conn.Handshake(); err != nil {
+		return nil, err
+	}
+	state := conn.ConnectionState()
+	if len(state.PeerCertificates) == 0 {
+		return nil, fmt.Errorf("Peer did not present a certificate")
+	}
+
+	buf := []byte{flags}
+	if dialer {
+		_, err := conn.Write(buf)
+		if err != nil {
+			return nil, err
+		}
+	} else if _, err := io.ReadFull(conn, buf); err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+
+	c := &Conn{
+		conn:   conn,
+		peer:   ca.NodeID(state.PeerCertificates[0]),
+		reader: conn,
+	}
+	if buf[0]&flagCompress != 0 {
+		w, err := flate.NewWriter(conn, flate.DefaultCompression)
+		if err != nil {
+			return nil, err
+		}
+		c.writer = w
+		c.reader = flate.NewReader(conn)
+	}
+	return c, nil
+}
+
+// Dial opens a channel to the node peerNodeID listening at addr. The peer
+// must present a certificate issued to peerNodeID by the cluster CA.
+func (c *Config) Dial(addr, peerNodeID string) (*Conn, error) {
+	if len(peerNodeID) == 0 {
+		return nil, fmt.Errorf("Missing peer node id")
+	}
+	config, err := c.tlsConfig(peerNodeID)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: handshakeTimeout}, "tcp", addr, config)
+	if err != nil {
+		return nil, err
+	}
+	var flags byte
+	if c.Compress {
+		flags |= flagCompress
+	}
+	dc, err := setup(conn, true, flags)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return dc, nil
+}
+
+// Listener accepts channels from peer nodes.
+type Listener struct {
+	listener net.Listener
+}
+
+// Listen accepts channels at addr from nodes presenting a certificate
+// issued by the cluster CA.
+func (c *Config) Listen(addr string) (*Listener, error) {
+	config, err := c.tlsConfig("")
+	if err != nil {
+		return nil, err
+	}
+	l, err := tls.Listen("tcp", addr, config)
+	if err != nil {
+		return nil, err
+	}
+	return &Listener{listener: l}, nil
+}
+
+// Accept waits for the next channel. Errors returned for a single peer,
+// such as a failed authentication, do not close the listener.
+func (l *Listener) Accept() (*Conn, error) {
+	conn, err := l.listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	dc, err := setup(conn.(*tls.Conn), false, 0)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return dc, nil
+}
+
+// Addr returns the address the listener is bound to.
+func (l *Listener) Addr() net.Addr {
+	return l.listener.Addr()
+}
+
+// Close stops accepting channels.
+func (l *Listener) Close() error {
+	return l.listener.Close()
+}
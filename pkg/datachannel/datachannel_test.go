@@ -0,0 +1,112 @@
+package datachannel
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/pkg/ca"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConfig(t *testing.T, c *ca.CA, nodeID string, compress bool) *Config {
+	identity, err := ca.NewIdentity(c, nodeID, []string{"127.0.0.1"}, 0)
+	require.NoError(t, err)
+	return &Config{Identity: identity, Compress: compress}
+}
+
+func newTestCA(t *testing.T, domain string) *ca.CA {
+	kv, err := kvdb.New(mem.Name, domain, []string{}, nil, nil)
+	require.NoError(t, err)
+	c, err := ca.New(kv, domain)
+	require.NoError(t, err)
+	return c
+}
+
+// echo accepts a single channel and sends back what it reads.
+func echo(l *Listener) chan error {
+	done := make(chan error, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			done <- err
+			return
+		}
+		defer conn.Close()
+		data, err := ioutil.ReadAll(conn)
+		if err != nil {
+			done <- err
+			return
+		}
+		if _, err := conn.Write(append([]byte(conn.PeerNodeID()+":"), data...)); err != nil {
+			done <- err
+			return
+		}
+		done <- conn.CloseWrite()
+	}()
+	return done
+}
+
+func TestChannel(t *testing.T) {
+	c := newTestCA(t, "cluster1")
+	server := newTestConfig(t, c, "node1", false)
+	l, err := server.Listen("127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	for _, compress := range []bool{false, true} {
+		done := echo(l)
+		client := newTestConfig(t, c, "node2", compress)
+		conn, err := client.Dial(l.Addr().String(), "node1")
+		require.NoError(t, err)
+		assert.Equal(t, "node1", conn.PeerNodeID())
+		assert.Equal(t, compress, conn.Compressed())
+
+		data := bytes.Repeat([]byte("volume data "), 1024)
+		_, err = conn.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, conn.CloseWrite())
+		reply, err := ioutil.ReadAll(conn)
+		require.NoError(t, err)
+		assert.Equal(t, append([]byte("node2:"), data...), reply)
+		conn.Close()
+		assert.NoError(t, <-done)
+	}
+}
+
+func TestChannelAuthentication(t *testing.T) {
+	c := newTestCA(t, "cluster1")
+	server := newTestConfig(t, c, "node1", false)
+	l, err := server.Listen("127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	// Wrong peer node id
+	done := echo(l)
+	client := newTestConfig(t, c, "node2", false)
+	_, err = client.Dial(l.Addr().String(), "node3")
+	assert.Error(t, err)
+	assert.Error(t, <-done)
+
+	// Certificate of another cluster, or revoked
+	stranger := newTestConfig(t, newTestCA(t, "cluster2"), "node2", false)
+	revoked := newTestConfig(t, c, "node2", false)
+	require.NoError(t, c.Revoke(ca.SerialOf(revoked.Identity.Certificate().Leaf)))
+	for _, client := range []*Config{stranger, revoked} {
+		done = echo(l)
+		conn, err := client.Dial(l.Addr().String(), "node1")
+		if err == nil {
+			// TLS 1.3 reports the client certificate rejection on first read
+			_, err = ioutil.ReadAll(conn)
+			conn.Close()
+		}
+		assert.Error(t, err)
+		assert.Error(t, <-done)
+	}
+
+	_, err = (&Config{}).Dial(l.Addr().String(), "node1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+// Package errdetails attaches typed details to the gRPC status errors of the
+// SDK, so that clients, and tools such as grpcurl through server reflection,
+// can tell the errors apart and find the invalid fields of a request or the
+// resource not found without parsing the error messages.
+package errdetails
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Domain is the domain of the reasons of the errors
+	Domain = "openstorage.org"
+)
+
+// InvalidArgument returns an InvalidArgument status error with message,
+// reporting field of the request as invalid.
+func InvalidArgument(field, message string) error {
+	return withDetails(status.New(codes.InvalidArgument, message), &api.SdkBadRequest{
+		FieldViolations: []*api.SdkFieldViolation{{Field: field, Description: message}},
+	})
+}
+
+// NotFound returns a NotFound status error with message, reporting the
+// resource id of resourceType as not found.
+func NotFound(resourceType, id, message string) error {
+	return withDetails(status.New(codes.NotFound, message), &api.SdkResourceInfo{
+		ResourceType: resourceType,
+		ResourceId:   id,
+	})
+}
+
+// ErrorInfo returns the error info attached to err, nil if none.
+func ErrorInfo(err error) *api.SdkErrorInfo {
+	for _, d := range details(err) {
+		if info, ok := d.(*api.SdkErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+// FieldViolations returns the invalid fields attached to err.
+func FieldViolations(err error) []*api.SdkFieldViolation {
+	var violations []*api.SdkFieldViolation
+	for _, d := range details(err) {
+		if r, ok := d.(*api.SdkBadRequest); ok {
+			violations = append(violations, r.GetFieldViolations()...)
+		}
+	}
+	return violations
+}
+
+// ResourceInfo returns the resource not found attached to err, nil if none.
+func ResourceInfo(err error) *api.SdkResourceInfo {
+	for _, d := range details(err) {
+		if info, ok := d.(*api.SdkResourceInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+func details(err error) []interface{} {
+	s, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	return s.Details()
+}
+
+// withDetails returns the error of s with details attached, or without if
+// they fail to marshal.
+func withDetails(s *status.Status, details ...proto.Message) error {
+	if d, err := s.WithDetails(details...); err == nil {
+		return d.Err()
+	}
+	return s.Err()
+}
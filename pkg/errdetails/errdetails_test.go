@@ -0,0 +1,76 @@
+package errdetails
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDetails(t *testing.T) {
+	err := InvalidArgument("volume_id", "Must supply volume id")
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, s.Code())
+	assert.Equal(t, "Must supply volume id", s.Message())
+	violations := FieldViolations(err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "volume_id", violations[0].GetField())
+	assert.Nil(t, ResourceInfo(err))
+
+	err = NotFound("backup", "b1", "Backup b1 not found")
+	s, ok = status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, s.Code())
+	assert.Equal(t, &api.SdkResourceInfo{ResourceType: "backup", ResourceId: "b1"}, ResourceInfo(err))
+	assert.Empty(t, FieldViolations(err))
+
+	assert.Nil(t, ErrorInfo(fmt.Errorf("plain")))
+	assert.Empty(t, FieldViolations(fmt.Errorf("plain")))
+}
+
+func TestReason(t *testing.T) {
+	assert.Equal(t, "NOT_FOUND", reason(codes.NotFound))
+	assert.Equal(t, "INVALID_ARGUMENT", reason(codes.InvalidArgument))
+	assert.Equal(t, "UNAVAILABLE", reason(codes.Unavailable))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/openstorage.api.OpenStorageVolume/Inspect"}
+	call := func(req interface{}, err error) error {
+		_, err = interceptor(context.Background(), req, info,
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, err
+			})
+		return err
+	}
+
+	assert.NoError(t, call(nil, nil))
+	plain := fmt.Errorf("plain")
+	assert.Equal(t, plain, call(nil, plain))
+
+	req := &api.SdkVolumeInspectRequest{VolumeId: "vol1"}
+	err := call(req, status.Error(codes.NotFound, "Volume vol1 not found"))
+	assert.Equal(t, &api.SdkErrorInfo{
+		Reason: "NOT_FOUND",
+		Domain: Domain,
+		Method: info.FullMethod,
+	}, ErrorInfo(err))
+	assert.Equal(t, &api.SdkResourceInfo{ResourceType: "volume", ResourceId: "vol1"}, ResourceInfo(err))
+
+	// the details attached by the handler are kept
+	err = call(req, InvalidArgument("spec", "Must supply spec"))
+	assert.Equal(t, "INVALID_ARGUMENT", ErrorInfo(err).GetReason())
+	assert.Len(t, FieldViolations(err), 1)
+	assert.Nil(t, ResourceInfo(err))
+
+	err = call(req, NotFound("snapshot", "snap1", "Snapshot snap1 not found"))
+	assert.Equal(t, "snap1", ResourceInfo(err).GetResourceId())
+}
@@ -0,0 +1,77 @@
+package errdetails
+
+import (
+	"context"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor attaches an api.SdkErrorInfo to the status errors
+// of the calls, with their code as reason. The NotFound errors are attached
+// the resource named by the request too, unless the handler attached one.
+// The other errors are left for gRPC to convert.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil || ErrorInfo(err) != nil {
+			return resp, err
+		}
+		s, ok := status.FromError(err)
+		if !ok || s.Code() == codes.OK {
+			return resp, err
+		}
+		errInfo := &api.SdkErrorInfo{
+			Reason: reason(s.Code()),
+			Domain: Domain,
+			Method: info.FullMethod,
+		}
+		if s.Code() == codes.NotFound && ResourceInfo(err) == nil {
+			if resourceType, id := resource(req); len(id) != 0 {
+				return resp, withDetails(s, errInfo, &api.SdkResourceInfo{
+					ResourceType: resourceType,
+					ResourceId:   id,
+				})
+			}
+		}
+		return resp, withDetails(s, errInfo)
+	}
+}
+
+// reason returns the name of code in upper snake case, such as NOT_FOUND.
+func reason(code codes.Code) string {
+	name := code.String()
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String())
+}
+
+// resource returns the type and the id of the resource named by req.
+func resource(req interface{}) (string, string) {
+	switch r := req.(type) {
+	case interface{ GetVolumeId() string }:
+		return "volume", r.GetVolumeId()
+	case interface{ GetNodeId() string }:
+		return "node", r.GetNodeId()
+	case interface{ GetBackupId() string }:
+		return "backup", r.GetBackupId()
+	case interface{ GetCredentialId() string }:
+		return "credential", r.GetCredentialId()
+	case interface{ GetObjectstoreId() string }:
+		return "objectstore", r.GetObjectstoreId()
+	}
+	return "", ""
+}
@@ -0,0 +1,102 @@
+/*
+Package kvdbprefix scopes the openstorage kvdb data under a key prefix, so
+that several clusters can share one kvdb, and migrates existing data
+between prefixes.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package kvdbprefix
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/portworx/kvdb"
+)
+
+// BaseDomain is the kvdb domain of the openstorage data without a prefix.
+const BaseDomain = "openstorage"
+
+// Domain returns the kvdb domain holding the openstorage data under prefix.
+func Domain(prefix string) string {
+	prefix = strings.Trim(prefix, "/")
+	if len(prefix) == 0 {
+		return BaseDomain
+	}
+	return prefix + "/" + BaseDomain
+}
+
+// Validate checks that a prefix is usable as a kvdb key prefix.
+func Validate(prefix string) error {
+	if strings.ContainsAny(prefix, " \t\n") {
+		return fmt.Errorf("Invalid kvdb prefix %q", prefix)
+	}
+	return nil
+}
+
+// Migrate copies every key of src to dst, with the TTLs they were set with,
+// which restart, and deletes the keys from src if deleteSource is set. It
+// returns the number of keys migrated. Migrate is safe to run again after a
+// partial failure, but the daemons using src must be stopped while it runs.
+func Migrate(src, dst kvdb.Kvdb, deleteSource bool) (int, error) {
+	kvps, err := enumerate(src, "", make(map[string]bool))
+	if err != nil {
+		return 0, err
+	}
+	for _, kvp := range kvps {
+		ttl := uint64(0)
+		if kvp.TTL > 0 {
+			ttl = uint64(kvp.TTL)
+		}
+		if _, err := dst.Put(kvp.Key, kvp.Value, ttl); err != nil {
+			return 0, fmt.Errorf("Failed to copy %s: %v", kvp.Key, err)
+		}
+	}
+	if deleteSource {
+		for i, kvp := range kvps {
+			if _, err := src.Delete(kvp.Key); err != nil && err != kvdb.ErrNotFound {
+				return i, fmt.Errorf("Failed to delete %s: %v", kvp.Key, err)
+			}
+		}
+	}
+	return len(kvps), nil
+}
+
+// enumerate returns the leaf keys below prefix. Enumerate only returns the
+// keys right below prefix on some kvdbs, such as etcd and consul, and the
+// directories, of empty values, whose keys are enumerated in turn. The keys
+// in seen were already enumerated.
+func enumerate(kv kvdb.Kvdb, prefix string, seen map[string]bool) (kvdb.KVPairs, error) {
+	kvps, err := kv.Enumerate(prefix)
+	if err != nil {
+		return nil, err
+	}
+	var out kvdb.KVPairs
+	for _, kvp := range kvps {
+		if seen[kvp.Key] {
+			continue
+		}
+		seen[kvp.Key] = true
+		if len(kvp.Value) != 0 {
+			out = append(out, kvp)
+			continue
+		}
+		below, err := enumerate(kv, kvp.Key, seen)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, below...)
+	}
+	return out, nil
+}
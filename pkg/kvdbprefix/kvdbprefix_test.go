@@ -0,0 +1,113 @@
+package kvdbprefix
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomain(t *testing.T) {
+	assert.Equal(t, "openstorage", Domain(""))
+	assert.Equal(t, "cluster1/openstorage", Domain("cluster1"))
+	assert.Equal(t, "cluster1/openstorage", Domain("/cluster1/"))
+	assert.Error(t, Validate("cluster 1"))
+	assert.NoError(t, Validate("cluster1"))
+}
+
+func TestMigrate(t *testing.T) {
+	src, err := kvdb.New(mem.Name, Domain(""), []string{}, nil, nil)
+	require.NoError(t, err)
+	dst, err := kvdb.New(mem.Name, Domain("cluster1"), []string{}, nil, nil)
+	require.NoError(t, err)
+
+	_, err = src.Put("vfs/volumes/vol1", []byte(`{"id":"vol1"}`), 0)
+	require.NoError(t, err)
+	_, err = src.Put("alerts/1", []byte("alert"), 0)
+	require.NoError(t, err)
+
+	n, err := Migrate(src, dst, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	kvp, err := dst.Get("vfs/volumes/vol1")
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"vol1"}`, string(kvp.Value))
+	_, err = src.Get("alerts/1")
+	assert.NoError(t, err)
+
+	// Running again is harmless, and deletes the source
+	n, err = Migrate(src, dst, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	_, err = src.Get("alerts/1")
+	assert.Equal(t, kvdb.ErrNotFound, err)
+	kvp, err = dst.Get("alerts/1")
+	require.NoError(t, err)
+	assert.Equal(t, "alert", string(kvp.Value))
+}
+
+// flatKvdb enumerates the keys right below a prefix only, and the
+// directories holding the others, like etcd and consul.
+type flatKvdb struct {
+	kvdb.Kvdb
+}
+
+func (kv flatKvdb) Enumerate(prefix string) (kvdb.KVPairs, error) {
+	kvps, err := kv.Kvdb.Enumerate(prefix)
+	if err != nil {
+		return nil, err
+	}
+	dir := strings.Trim(prefix, "/")
+	if len(dir) != 0 {
+		dir += "/"
+	}
+	dirs := make(map[string]bool)
+	var out kvdb.KVPairs
+	for _, kvp := range kvps {
+		if !strings.HasPrefix(kvp.Key, dir) {
+			continue
+		}
+		rel := strings.TrimPrefix(kvp.Key, dir)
+		if i := strings.Index(rel, "/"); i >= 0 {
+			if !dirs[rel[:i]] {
+				dirs[rel[:i]] = true
+				out = append(out, &kvdb.KVPair{Key: dir + rel[:i]})
+			}
+			continue
+		}
+		out = append(out, kvp)
+	}
+	return out, nil
+}
+
+func TestMigrateNested(t *testing.T) {
+	mkv, err := kvdb.New(mem.Name, Domain(""), []string{}, nil, nil)
+	require.NoError(t, err)
+	src := flatKvdb{mkv}
+	dst, err := kvdb.New(mem.Name, Domain("cluster1"), []string{}, nil, nil)
+	require.NoError(t, err)
+
+	keys := []string{"alerts/1", "vfs/volumes/vol1", "vfs/volumes/vol2", "vfs/snaps/a/b/snap1"}
+	for _, key := range keys {
+		_, err = src.Put(key, []byte(key), 0)
+		require.NoError(t, err)
+	}
+	kvps, err := src.Enumerate("")
+	require.NoError(t, err)
+	require.Len(t, kvps, 2)
+
+	n, err := Migrate(src, dst, true)
+	require.NoError(t, err)
+	assert.Equal(t, len(keys), n)
+	for _, key := range keys {
+		kvp, err := dst.Get(key)
+		require.NoError(t, err)
+		assert.Equal(t, key, string(kvp.Value))
+		_, err = src.Get(key)
+		assert.Equal(t, kvdb.ErrNotFound, err, key)
+	}
+}
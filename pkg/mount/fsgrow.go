@@ -0,0 +1,41 @@
+// +build linux
+
+package mount
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// growfs runs the command growing a filesystem and returns its output.
+var growfs = func(name string, args ...string) ([]byte, error) {
+	return exec.Command(name, args...).CombinedOutput()
+}
+
+// GrowFs grows the filesystem of format on devicePath to the size of the
+// device. btrfs and xfs only grow online, through mountPath. ext4 grows
+// through devicePath, mounted or not.
+func GrowFs(format api.FSType, devicePath, mountPath string) error {
+	var args []string
+	switch format {
+	case api.FSType_FS_TYPE_BTRFS:
+		args = []string{"btrfs", "filesystem", "resize", "max", mountPath}
+	case api.FSType_FS_TYPE_XFS:
+		args = []string{"xfs_growfs", mountPath}
+	case api.FSType_FS_TYPE_EXT4:
+		args = []string{"resize2fs", devicePath}
+	default:
+		return fmt.Errorf("Cannot grow a %s filesystem", format.SimpleString())
+	}
+	if format != api.FSType_FS_TYPE_EXT4 && len(mountPath) == 0 {
+		return fmt.Errorf("Cannot grow the %s filesystem of %s: not mounted", format.SimpleString(), devicePath)
+	}
+	if out, err := growfs(args[0], args[1:]...); err != nil {
+		return fmt.Errorf("Failed to grow the filesystem of %s: %v: %s",
+			devicePath, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
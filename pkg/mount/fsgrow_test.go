@@ -0,0 +1,42 @@
+// +build linux
+
+package mount
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrowFs(t *testing.T) {
+	defer func(f func(string, ...string) ([]byte, error)) { growfs = f }(growfs)
+	var ran []string
+	growfs = func(name string, args ...string) ([]byte, error) {
+		ran = append(ran, strings.Join(append([]string{name}, args...), " "))
+		if name == "resize2fs" && args[0] == "/dev/bad" {
+			return []byte("Bad magic number\n"), errors.New("exit status 1")
+		}
+		return nil, nil
+	}
+
+	require.NoError(t, GrowFs(api.FSType_FS_TYPE_BTRFS, "/dev/nbd0", "/mnt/a"))
+	require.NoError(t, GrowFs(api.FSType_FS_TYPE_XFS, "/dev/nbd1", "/mnt/b"))
+	require.NoError(t, GrowFs(api.FSType_FS_TYPE_EXT4, "/dev/nbd2", ""))
+	require.Equal(t, []string{
+		"btrfs filesystem resize max /mnt/a",
+		"xfs_growfs /mnt/b",
+		"resize2fs /dev/nbd2",
+	}, ran)
+
+	// xfs and btrfs only grow mounted
+	require.Error(t, GrowFs(api.FSType_FS_TYPE_XFS, "/dev/nbd1", ""))
+	require.Error(t, GrowFs(api.FSType_FS_TYPE_ZFS, "/dev/nbd3", "/mnt/c"))
+
+	err := GrowFs(api.FSType_FS_TYPE_EXT4, "/dev/bad", "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Bad magic number")
+	require.Len(t, ran, 4)
+}
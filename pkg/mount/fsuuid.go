@@ -0,0 +1,78 @@
+// +build linux
+
+package mount
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// FsUUIDMismatchError is returned when the filesystem of the device to mount
+// is not the filesystem of the volume, such as when a device was renumbered or
+// the wrong device attached.
+type FsUUIDMismatchError struct {
+	// VolumeID of the volume to mount
+	VolumeID string
+	// DevicePath of the device to mount
+	DevicePath string
+	// Expected is the UUID of the filesystem of the volume
+	Expected string
+	// Found is the UUID of the filesystem of the device, empty if none
+	Found string
+}
+
+func (e *FsUUIDMismatchError) Error() string {
+	found := e.Found
+	if len(found) == 0 {
+		found = "no filesystem"
+	}
+	return fmt.Sprintf("Refusing to mount volume %s: device %s has filesystem UUID %s, expected %s",
+		e.VolumeID, e.DevicePath, found, e.Expected)
+}
+
+// blkid prints the value of the tag of the filesystem on devicePath.
+var blkid = func(devicePath, tag string) ([]byte, error) {
+	return exec.Command("blkid", "-o", "value", "-s", tag, devicePath).Output()
+}
+
+// FsUUID returns the UUID of the filesystem on devicePath, empty if the
+// device has no filesystem.
+func FsUUID(devicePath string) (string, error) {
+	out, err := blkid(devicePath, "UUID")
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		// blkid exits with 2 when the device has no filesystem
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.ExitStatus() == 2 {
+			return "", nil
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("Failed to read the filesystem UUID of %s: %v", devicePath, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// VerifyFsUUID returns a FsUUIDMismatchError if the filesystem on devicePath
+// is not the one recorded for v. The volumes formatted before their
+// filesystem UUID was recorded are not verified.
+func VerifyFsUUID(v *api.Volume, devicePath string) error {
+	if len(v.GetFsUuid()) == 0 {
+		return nil
+	}
+	found, err := FsUUID(devicePath)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(found, v.GetFsUuid()) {
+		return &FsUUIDMismatchError{
+			VolumeID:   v.GetId(),
+			DevicePath: devicePath,
+			Expected:   v.GetFsUuid(),
+			Found:      found,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// +build linux
+
+package mount
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyFsUUID(t *testing.T) {
+	defer func(f func(string, string) ([]byte, error)) { blkid = f }(blkid)
+	uuids := map[string]string{
+		"/dev/xvdf": "4b2f8c3e-51e0-4a8c-9d3b-0c2e6f7a1b9d\n",
+		"/dev/xvdg": "a1c0e5d2-7f3b-4e9a-8c6d-2b1f0e9d8c7a\n",
+	}
+	blkid = func(devicePath, tag string) ([]byte, error) {
+		require.Equal(t, "UUID", tag)
+		if uuid, ok := uuids[devicePath]; ok {
+			return []byte(uuid), nil
+		}
+		return nil, errors.New("no such device")
+	}
+
+	uuid, err := FsUUID("/dev/xvdf")
+	require.NoError(t, err)
+	require.Equal(t, "4b2f8c3e-51e0-4a8c-9d3b-0c2e6f7a1b9d", uuid)
+
+	v := &api.Volume{Id: "vol", FsUuid: "4B2F8C3E-51E0-4A8C-9D3B-0C2E6F7A1B9D"}
+	require.NoError(t, VerifyFsUUID(v, "/dev/xvdf"))
+
+	err = VerifyFsUUID(v, "/dev/xvdg")
+	require.Error(t, err)
+	mismatch, ok := err.(*FsUUIDMismatchError)
+	require.True(t, ok)
+	require.Equal(t, "a1c0e5d2-7f3b-4e9a-8c6d-2b1f0e9d8c7a", mismatch.Found)
+
+	require.Error(t, VerifyFsUUID(v, "/dev/xvdh"))
+
+	// volumes without a recorded filesystem UUID are not verified
+	require.NoError(t, VerifyFsUUID(&api.Volume{Id: "old"}, "/dev/xvdh"))
+}
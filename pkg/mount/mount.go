@@ -792,7 +792,7 @@ func New(
 ) (Manager, error) {
 
 	if mountImpl == nil {
-		mountImpl = &DefaultMounter{}
+		mountImpl = DefaultMountImpl()
 	}
 
 	switch mounterType {
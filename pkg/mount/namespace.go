@@ -0,0 +1,252 @@
+// +build linux
+
+package mount
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/docker/docker/pkg/mount"
+	osdexec "github.com/libopenstorage/openstorage/pkg/exec"
+	"github.com/sirupsen/logrus"
+)
+
+// NamespaceMode tells in which mount namespace the volumes are mounted.
+type NamespaceMode string
+
+const (
+	// NamespaceContainer mounts the volumes in the mount namespace of the
+	// daemon, the host namespace unless the daemon is containerized.
+	NamespaceContainer NamespaceMode = "container"
+	// NamespaceHost mounts the volumes in the mount namespace of the host,
+	// entered with nsenter through the host pid 1. The container must share
+	// the pid namespace of the host and be privileged.
+	NamespaceHost NamespaceMode = "host"
+	// NamespacePropagation mounts the volumes in the mount namespace of the
+	// daemon, under directories bind mounted from the host with shared
+	// propagation so that the mounts propagate to the host.
+	NamespacePropagation NamespaceMode = "propagation"
+)
+
+// NamespaceConfig configures the mount namespace of the volume mounts.
+type NamespaceConfig struct {
+	// Mode is the mount namespace of the mounts, NamespaceContainer if unset
+	Mode NamespaceMode `yaml:"mode"`
+	// HostProc is where the /proc of the host is mounted, /proc if unset
+	HostProc string `yaml:"host_proc"`
+	// Paths are the directories whose mounts must be visible to the host,
+	// such as /var/lib/kubelet, checked with NamespacePropagation
+	Paths []string `yaml:"paths"`
+}
+
+var (
+	defaultMountImplLock sync.Mutex
+	defaultMountImpl     MountImpl = &DefaultMounter{}
+)
+
+// SetDefaultMountImpl sets the mount implementation of the mounters created
+// without one and of the volume drivers, such as the one returned by
+// NewNamespaceMounter.
+func SetDefaultMountImpl(impl MountImpl) {
+	defaultMountImplLock.Lock()
+	defer defaultMountImplLock.Unlock()
+	defaultMountImpl = impl
+}
+
+// DefaultMountImpl returns the mount implementation set by
+// SetDefaultMountImpl, a DefaultMounter if none.
+func DefaultMountImpl() MountImpl {
+	defaultMountImplLock.Lock()
+	defer defaultMountImplLock.Unlock()
+	return defaultMountImpl
+}
+
+// Containerized returns true if the daemon runs in a container, whose mounts
+// are not visible to the host unless propagated.
+func Containerized() bool {
+	if _, err := os.Stat("/.dockerenv"); err == nil {
+		return true
+	}
+	cgroup, err := ioutil.ReadFile("/proc/1/cgroup")
+	if err != nil {
+		return false
+	}
+	for _, runtime := range []string{"docker", "kubepods", "containerd", "crio", "lxc"} {
+		if strings.Contains(string(cgroup), runtime) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewNamespaceMounter returns the mount implementation of c, after checking
+// that the daemon is deployed as c requires. The errors tell how to fix the
+// deployment.
+func NewNamespaceMounter(c NamespaceConfig) (MountImpl, error) {
+	switch c.Mode {
+	case "", NamespaceContainer:
+		if Containerized() {
+			logrus.Warnf("The daemon is containerized, its mounts are not visible to the host " +
+				"unless propagated, see the host and propagation mount namespace modes")
+		}
+		return &DefaultMounter{}, nil
+	case NamespaceHost:
+		hostProc := c.HostProc
+		if len(hostProc) == 0 {
+			hostProc = "/proc"
+		}
+		ns := filepath.Join(hostProc, "1", "ns", "mnt")
+		hostNs, err := os.Readlink(ns)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot read the mount namespace of the host at %s: %v, "+
+				"run the container privileged, with the pid namespace of the host", ns, err)
+		}
+		if self, err := os.Readlink("/proc/self/ns/mnt"); err == nil && self == hostNs {
+			if Containerized() {
+				return nil, fmt.Errorf("The pid 1 of %s runs in the mount namespace of the daemon, "+
+					"run the container with the pid namespace of the host or set the host proc", hostProc)
+			}
+			// not containerized, the mounts are made in the host namespace
+			return &DefaultMounter{}, nil
+		}
+		nsenter := osdexec.Which("nsenter")
+		if _, err := os.Stat(nsenter); err != nil {
+			return nil, fmt.Errorf("Cannot find nsenter to mount in the host namespace: %v, "+
+				"install the util-linux package in the container image", err)
+		}
+		return &hostMounter{nsenter: nsenter, ns: ns}, nil
+	case NamespacePropagation:
+		infos, err := GetMounts()
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range c.Paths {
+			if err := checkPropagation(infos, path); err != nil {
+				return nil, err
+			}
+		}
+		return &DefaultMounter{}, nil
+	}
+	return nil, fmt.Errorf("Unknown mount namespace mode %q, expected %s, %s or %s",
+		c.Mode, NamespaceContainer, NamespaceHost, NamespacePropagation)
+}
+
+// checkPropagation returns an error unless path is on a mount propagating its
+// submounts to the host.
+func checkPropagation(infos []*mount.Info, path string) error {
+	path = filepath.Clean(path)
+	var mp *mount.Info
+	for _, info := range infos {
+		if info.Mountpoint != "/" && path != info.Mountpoint &&
+			!strings.HasPrefix(path, info.Mountpoint+"/") {
+			continue
+		}
+		if mp == nil || len(info.Mountpoint) >= len(mp.Mountpoint) {
+			mp = info
+		}
+	}
+	if mp == nil {
+		return fmt.Errorf("Cannot find the mount of %s", path)
+	}
+	for _, field := range strings.Fields(mp.Optional) {
+		if strings.HasPrefix(field, "shared:") {
+			return nil
+		}
+	}
+	return fmt.Errorf("The mounts under %s do not propagate to the host, mount %s is not shared: "+
+		"bind mount %s with rshared propagation, such as a Bidirectional mountPropagation, "+
+		"and make the host mount shared with mount --make-rshared", path, mp.Mountpoint, path)
+}
+
+// hostMounter mounts in the mount namespace of the host with nsenter.
+type hostMounter struct {
+	nsenter string
+	// ns is the mount namespace of the host
+	ns string
+}
+
+// mountFlags are the mount options of the mount flags.
+var mountFlags = []struct {
+	flag   uintptr
+	option string
+}{
+	{syscall.MS_RDONLY, "ro"},
+	{syscall.MS_NOSUID, "nosuid"},
+	{syscall.MS_NODEV, "nodev"},
+	{syscall.MS_NOEXEC, "noexec"},
+	{syscall.MS_SYNCHRONOUS, "sync"},
+	{syscall.MS_REMOUNT, "remount"},
+	{syscall.MS_NOATIME, "noatime"},
+}
+
+// mountArgs returns the arguments of the mount command of a mount call.
+func mountArgs(source, target, fstype string, flags uintptr, data string) []string {
+	var options []string
+	if flags&syscall.MS_BIND != 0 {
+		if flags&syscall.MS_REC != 0 {
+			options = append(options, "rbind")
+		} else {
+			options = append(options, "bind")
+		}
+	}
+	for _, f := range mountFlags {
+		if flags&f.flag != 0 {
+			options = append(options, f.option)
+		}
+	}
+	if len(data) != 0 {
+		options = append(options, data)
+	}
+	var args []string
+	if len(fstype) != 0 && flags&syscall.MS_BIND == 0 {
+		args = append(args, "-t", fstype)
+	}
+	if len(options) != 0 {
+		args = append(args, "-o", strings.Join(options, ","))
+	}
+	return append(args, source, target)
+}
+
+// umountArgs returns the arguments of the umount command of an unmount call.
+func umountArgs(target string, flags int) []string {
+	var args []string
+	if flags&syscall.MNT_FORCE != 0 {
+		args = append(args, "-f")
+	}
+	if flags&syscall.MNT_DETACH != 0 {
+		args = append(args, "-l")
+	}
+	return append(args, target)
+}
+
+func (m *hostMounter) Mount(
+	source string,
+	target string,
+	fstype string,
+	flags uintptr,
+	data string,
+	timeout int,
+) error {
+	return m.run("mount", mountArgs(source, target, fstype, flags, data))
+}
+
+func (m *hostMounter) Unmount(target string, flags int, timeout int) error {
+	return m.run("umount", umountArgs(target, flags))
+}
+
+// run runs cmd with args in the mount namespace of the host.
+func (m *hostMounter) run(cmd string, args []string) error {
+	out, err := exec.Command(m.nsenter,
+		append([]string{"--mount=" + m.ns, "--", cmd}, args...)...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s %s in the host mount namespace failed: %v: %s",
+			cmd, strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
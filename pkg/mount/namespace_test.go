@@ -0,0 +1,50 @@
+// +build linux
+
+package mount
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/docker/docker/pkg/mount"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMountArgs(t *testing.T) {
+	require.Equal(t, []string{"-t", "ext4", "/dev/nbd0", "/mnt/vol"},
+		mountArgs("/dev/nbd0", "/mnt/vol", "ext4", 0, ""))
+	require.Equal(t, []string{"-o", "bind,ro", "/var/lib/osd/vol", "/mnt/vol"},
+		mountArgs("/var/lib/osd/vol", "/mnt/vol", "ext4", syscall.MS_BIND|syscall.MS_RDONLY, ""))
+	require.Equal(t, []string{"-o", "rbind", "/var/lib/osd/vol", "/mnt/vol"},
+		mountArgs("/var/lib/osd/vol", "/mnt/vol", "", syscall.MS_BIND|syscall.MS_REC, ""))
+	require.Equal(t, []string{"-t", "xfs", "-o", "nosuid,nouuid", "/dev/nbd0", "/mnt/vol"},
+		mountArgs("/dev/nbd0", "/mnt/vol", "xfs", syscall.MS_NOSUID, "nouuid"))
+
+	require.Equal(t, []string{"/mnt/vol"}, umountArgs("/mnt/vol", 0))
+	require.Equal(t, []string{"-f", "-l", "/mnt/vol"},
+		umountArgs("/mnt/vol", syscall.MNT_FORCE|syscall.MNT_DETACH))
+}
+
+func TestCheckPropagation(t *testing.T) {
+	infos := []*mount.Info{
+		{Mountpoint: "/", Optional: "master:1"},
+		{Mountpoint: "/var/lib/kubelet", Optional: "shared:12 master:3"},
+		{Mountpoint: "/var/lib/osd", Optional: ""},
+	}
+	require.NoError(t, checkPropagation(infos, "/var/lib/kubelet"))
+	require.NoError(t, checkPropagation(infos, "/var/lib/kubelet/pods/"))
+	require.Error(t, checkPropagation(infos, "/var/lib/osd/mounts"))
+	require.Error(t, checkPropagation(infos, "/var/lib/kubeletx"))
+}
+
+func TestNewNamespaceMounter(t *testing.T) {
+	_, err := NewNamespaceMounter(NamespaceConfig{Mode: "nowhere"})
+	require.Error(t, err)
+
+	_, err = NewNamespaceMounter(NamespaceConfig{Mode: NamespaceHost, HostProc: "/nonexistent/proc"})
+	require.Error(t, err)
+
+	impl, err := NewNamespaceMounter(NamespaceConfig{})
+	require.NoError(t, err)
+	require.IsType(t, &DefaultMounter{}, impl)
+}
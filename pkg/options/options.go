@@ -49,6 +49,11 @@ const (
 	// - Detach
 	// It indicates the Volume Driver to forcefully detach device from kernel
 	OptionsForceDetach = "FORCE_DETACH"
+	// OptionsSubpath is an option provided to the following Openstorage Volume API
+	// - Mount
+	// It indicates the Volume Driver to bind mount this subdirectory of the volume,
+	// relative to its root, at the mount path instead of the root of the volume
+	OptionsSubpath = "SUBPATH"
 )
 
 func IsBoolOptionSet(options map[string]string, key string) bool {
@@ -0,0 +1,28 @@
+package redact
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor removes the secrets from the responses. Handlers
+// may return the objects of the drivers, so the responses holding secrets
+// are copied before being redacted.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if msg, ok := resp.(proto.Message); ok && Contains(msg) {
+			msg = proto.Clone(msg)
+			Value(msg)
+			resp = msg
+		}
+		return resp, err
+	}
+}
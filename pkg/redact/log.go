@@ -0,0 +1,39 @@
+package redact
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Formatter masks the secrets of the log entries formatted by the wrapped
+// formatter, in their message and their fields.
+type Formatter struct {
+	logrus.Formatter
+}
+
+// Format masks the secrets of entry and formats it.
+func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
+	e := *entry
+	e.Message = String(entry.Message)
+	e.Data = make(logrus.Fields, len(entry.Data))
+	for k, v := range entry.Data {
+		if IsSecret(k) {
+			v = Mask
+		} else if s, ok := v.(string); ok {
+			v = String(s)
+		} else if _, ok := v.(error); !ok && Contains(v) {
+			v = String(fmt.Sprintf("%+v", v))
+		}
+		e.Data[k] = v
+	}
+	return f.Formatter.Format(&e)
+}
+
+// Logs masks the secrets of the entries of the standard logger.
+func Logs() {
+	logger := logrus.StandardLogger()
+	if _, ok := logger.Formatter.(*Formatter); !ok {
+		logrus.SetFormatter(&Formatter{Formatter: logger.Formatter})
+	}
+}
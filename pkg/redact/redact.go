@@ -0,0 +1,207 @@
+/*
+Package redact removes the secrets, such as the encryption passphrases and
+the cloud credentials, from the API responses, the audit log and the logs.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// Mask replaces the secrets in the audit log and the logs.
+const Mask = "********"
+
+var (
+	// secretKeys are the lower case names of the fields, parameters and
+	// labels holding secrets
+	secretKeys = map[string]bool{
+		"passphrase":                                true,
+		api.SpecPassphrase:                          true,
+		strings.ToLower(api.OptCredSecretKey):       true,
+		strings.ToLower(api.OptCredEncrKey):         true,
+		strings.ToLower(api.OptCredGoogleJsonKey):   true,
+		strings.ToLower(api.OptCredAzureAccountKey): true,
+		"cluster_secret_key":                        true,
+		"aws_secret_access_key":                     true,
+	}
+
+	// secretPattern matches the secrets set as key=value or key: value,
+	// such as in the volume specs of docker volume names and in formatted
+	// structs
+	secretPattern = regexp.MustCompile(`(?i)(\b(?:` + strings.Join(keys(), "|") +
+		`)["']?\s*[=:]\s*)("[^"]*"|[^,\s&"}\]]+)`)
+)
+
+func keys() []string {
+	var k []string
+	for key := range secretKeys {
+		k = append(k, regexp.QuoteMeta(key))
+	}
+	return k
+}
+
+// IsSecret returns true if the field or parameter named key holds a secret.
+func IsSecret(key string) bool {
+	return secretKeys[strings.ToLower(key)]
+}
+
+// Map returns a copy of m where the values of the secrets are masked.
+func Map(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	redacted := make(map[string]string, len(m))
+	for k, v := range m {
+		if IsSecret(k) {
+			v = Mask
+		}
+		redacted[k] = v
+	}
+	return redacted
+}
+
+// String masks the secrets set as key=value or key: value in s.
+func String(s string) string {
+	return secretPattern.ReplaceAllString(s, "${1}"+Mask)
+}
+
+// Contains returns true if v, such as an API response, holds a secret.
+func Contains(v interface{}) bool {
+	return walk(reflect.ValueOf(v), false)
+}
+
+// Value clears the secrets of v in place: the string fields named after a
+// secret and the secret entries of the string maps. v is walked through
+// pointers, interfaces, such as the oneof fields, slices and maps of
+// pointers, so that the nested messages are redacted too.
+func Value(v interface{}) {
+	walk(reflect.ValueOf(v), true)
+}
+
+// walk returns true if v holds a secret, clearing it if clear is set.
+func walk(v reflect.Value, clear bool) bool {
+	found := false
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			found = walk(v.Elem(), clear)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			if len(t.Field(i).PkgPath) != 0 {
+				// unexported
+				continue
+			}
+			if f.Kind() == reflect.String && IsSecret(fieldName(t.Field(i))) {
+				if f.Len() != 0 {
+					found = true
+					if clear && f.CanSet() {
+						f.SetString("")
+					}
+				}
+				continue
+			}
+			found = walk(f, clear) || found
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			found = walk(v.Index(i), clear) || found
+		}
+	case reflect.Map:
+		stringKeys := v.Type().Key().Kind() == reflect.String
+		for _, k := range v.MapKeys() {
+			e := v.MapIndex(k)
+			if stringKeys && e.Kind() == reflect.String && IsSecret(k.String()) {
+				found = true
+				if clear {
+					v.SetMapIndex(k, reflect.Value{})
+				}
+				continue
+			}
+			found = walk(e, clear) || found
+		}
+	}
+	return found
+}
+
+// fieldName returns the json name of a struct field, its name otherwise.
+func fieldName(f reflect.StructField) string {
+	if tag := strings.Split(f.Tag.Get("json"), ",")[0]; len(tag) != 0 && tag != "-" {
+		return tag
+	}
+	return f.Name
+}
+
+// JSON returns body without the secrets of its objects. Body is returned
+// as is if it holds no secret or is not JSON.
+func JSON(body []byte) []byte {
+	lower := bytes.ToLower(body)
+	found := false
+	for key := range secretKeys {
+		if bytes.Contains(lower, []byte(`"`+key+`"`)) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return body
+	}
+
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return body
+	}
+	if !walkJSON(v) {
+		return body
+	}
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return append(redacted, '\n')
+}
+
+// walkJSON deletes the secrets of a decoded JSON value and returns true if
+// there were any.
+func walkJSON(v interface{}) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if _, ok := e.(string); ok && IsSecret(k) {
+				delete(v, k)
+				found = true
+				continue
+			}
+			found = walkJSON(e) || found
+		}
+	case []interface{}:
+		for _, e := range v {
+			found = walkJSON(e) || found
+		}
+	}
+	return found
+}
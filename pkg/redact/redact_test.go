@@ -0,0 +1,71 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValue(t *testing.T) {
+	vol := &api.Volume{
+		Id: "vol1",
+		Spec: &api.VolumeSpec{
+			Passphrase:   "hunter2",
+			VolumeLabels: map[string]string{"secret_key": "hunter2", "app": "db"},
+		},
+	}
+	update := &api.VolumeSpecUpdate{PassphraseOpt: &api.VolumeSpecUpdate_Passphrase{Passphrase: "hunter2"}}
+	creds := map[string]interface{}{"id": map[string]string{api.OptCredSecretKey: "hunter2", api.OptCredRegion: "us"}}
+
+	resp := []interface{}{vol, update, creds}
+	assert.True(t, Contains(resp))
+	Value(resp)
+	assert.False(t, Contains(resp))
+	assert.Empty(t, vol.Spec.Passphrase)
+	assert.Equal(t, map[string]string{"app": "db"}, vol.Spec.VolumeLabels)
+	assert.Empty(t, update.GetPassphrase())
+	assert.Equal(t, map[string]string{api.OptCredRegion: "us"}, creds["id"])
+	assert.Equal(t, "vol1", vol.Id)
+}
+
+func TestJSON(t *testing.T) {
+	body, err := json.Marshal([]*api.Volume{{Id: "vol1", Spec: &api.VolumeSpec{Passphrase: "hunter2", Size: 1 << 40}}})
+	require.NoError(t, err)
+	redacted := JSON(body)
+	assert.NotContains(t, string(redacted), "hunter2")
+
+	var vols []*api.Volume
+	require.NoError(t, json.Unmarshal(redacted, &vols))
+	assert.Equal(t, "vol1", vols[0].Id)
+	assert.Equal(t, uint64(1<<40), vols[0].Spec.Size)
+
+	// bodies without secrets are not decoded
+	plain := []byte(`{"id": "vol1"}`)
+	assert.Equal(t, plain, JSON(plain))
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "name=vol1,secret_key=********,size=10G", String("name=vol1,secret_key=hunter2,size=10G"))
+	assert.Equal(t, `{"passphrase":********}`, String(`{"passphrase":"hunter2"}`))
+	assert.Equal(t, "Spec:{Passphrase:******** Size:10}", String("Spec:{Passphrase:hunter2 Size:10}"))
+	assert.Equal(t, map[string]string{api.OptCredAzureAccountKey: Mask, "a": "b"},
+		Map(map[string]string{api.OptCredAzureAccountKey: "hunter2", "a": "b"}))
+}
+
+func TestFormatter(t *testing.T) {
+	var out bytes.Buffer
+	logger := logrus.New()
+	logger.Out = &out
+	logger.Formatter = &Formatter{Formatter: &logrus.TextFormatter{DisableColors: true}}
+
+	logger.WithField(api.OptCredSecretKey, "hunter2").
+		WithField("spec", &api.VolumeSpec{Passphrase: "hunter2"}).
+		Infof("Creating volume secret_key=%v,name=vol1", "hunter2")
+	assert.NotContains(t, out.String(), "hunter2")
+	assert.Contains(t, out.String(), "name=vol1")
+}
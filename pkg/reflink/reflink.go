@@ -0,0 +1,68 @@
+// Package reflink copies files and directory trees sharing their extents
+// with the copy where the filesystem supports it, such as btrfs or xfs, and
+// falls back to a full copy otherwise. Either way the copy is independent:
+// writes to the copy or the source do not show in the other.
+package reflink
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// CopyFile copies the file src to dst, replacing dst if it exists.
+func CopyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if err := clone(out, in); err != nil {
+		if _, err := io.Copy(out, in); err != nil {
+			out.Close()
+			return fmt.Errorf("Failed to copy %s to %s: %v", src, dst, err)
+		}
+	}
+	return out.Close()
+}
+
+// CopyTree copies the directory src to dst recursively, with the modes of
+// the files and directories and the targets of the symbolic links.
+func CopyTree(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		switch mode := info.Mode(); {
+		case mode.IsDir():
+			if err := os.MkdirAll(target, mode.Perm()); err != nil {
+				return err
+			}
+			return os.Chmod(target, mode.Perm())
+		case mode&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case mode.IsRegular():
+			return CopyFile(path, target)
+		}
+		// devices, sockets and pipes are not copied
+		return nil
+	})
+}
@@ -0,0 +1,18 @@
+package reflink
+
+import (
+	"os"
+	"syscall"
+)
+
+// ficlone is the FICLONE ioctl, sharing the extents of a file with another
+const ficlone = 0x40049409
+
+// clone makes dst share the extents of src.
+func clone(dst, src *os.File) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, dst.Fd(), ficlone, src.Fd())
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+// +build !linux
+
+package reflink
+
+import (
+	"errors"
+	"os"
+)
+
+// clone is not supported, the files are copied.
+func clone(dst, src *os.File) error {
+	return errors.New("reflinks are not supported")
+}
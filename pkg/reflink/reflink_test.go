@@ -0,0 +1,51 @@
+package reflink
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyTree(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reflink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "src")
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0750))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "a"), []byte("a"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "sub", "b"), []byte("b"), 0644))
+	require.NoError(t, os.Symlink("sub/b", filepath.Join(src, "link")))
+
+	dst := filepath.Join(dir, "dst")
+	require.NoError(t, CopyTree(src, dst))
+
+	data, err := ioutil.ReadFile(filepath.Join(dst, "a"))
+	require.NoError(t, err)
+	assert.Equal(t, "a", string(data))
+	info, err := os.Stat(filepath.Join(dst, "a"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	data, err = ioutil.ReadFile(filepath.Join(dst, "sub", "b"))
+	require.NoError(t, err)
+	assert.Equal(t, "b", string(data))
+	link, err := os.Readlink(filepath.Join(dst, "link"))
+	require.NoError(t, err)
+	assert.Equal(t, "sub/b", link)
+
+	// the copy is independent of the source
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dst, "a"), []byte("changed"), 0600))
+	data, err = ioutil.ReadFile(filepath.Join(src, "a"))
+	require.NoError(t, err)
+	assert.Equal(t, "a", string(data))
+
+	// copying again replaces the files
+	require.NoError(t, CopyFile(filepath.Join(src, "a"), filepath.Join(dst, "a")))
+	data, err = ioutil.ReadFile(filepath.Join(dst, "a"))
+	require.NoError(t, err)
+	assert.Equal(t, "a", string(data))
+}
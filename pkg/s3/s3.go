@@ -0,0 +1,315 @@
+/*
+Package s3 is a minimal client for S3 compatible object stores, such as AWS
+S3 and minio, using path style requests signed with AWS signature version 4.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package s3
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultRegion is used when no region is configured
+	DefaultRegion = "us-east-1"
+
+	amzDateFormat = "20060102T150405Z"
+	algorithm     = "AWS4-HMAC-SHA256"
+)
+
+var (
+	// ErrNotFound returned when an object does not exist
+	ErrNotFound = errors.New("Object not found")
+)
+
+// Config locates a bucket and the credentials to access it.
+// swagger:model
+type Config struct {
+	// Endpoint is the url of the object store, e.g. https://s3.amazonaws.com
+	Endpoint string `yaml:"endpoint"`
+	// Region of the bucket, DefaultRegion if not set
+	Region string `yaml:"region"`
+	// Bucket holding the objects
+	Bucket string `yaml:"bucket"`
+	// AccessKey is the access key id
+	AccessKey string `yaml:"access_key"`
+	// SecretKey is the secret access key
+	SecretKey string `yaml:"secret_key"`
+}
+
+// Validate checks that the bucket is fully specified.
+func (c *Config) Validate() error {
+	if len(c.Endpoint) == 0 {
+		return fmt.Errorf("Missing object store endpoint")
+	}
+	if _, err := url.Parse(c.Endpoint); err != nil {
+		return fmt.Errorf("Invalid object store endpoint %s: %v", c.Endpoint, err)
+	}
+	if len(c.Bucket) == 0 {
+		return fmt.Errorf("Missing object store bucket")
+	}
+	if len(c.AccessKey) == 0 || len(c.SecretKey) == 0 {
+		return fmt.Errorf("Missing object store credentials")
+	}
+	return nil
+}
+
+// Client reads and writes the objects of one bucket.
+type Client struct {
+	config   Config
+	endpoint *url.URL
+	client   *http.Client
+	// now is overridden in tests
+	now func() time.Time
+}
+
+// New returns a client of the bucket described by c.
+func New(c *Config) (*Client, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(c.Endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	config := *c
+	if len(config.Region) == 0 {
+		config.Region = DefaultRegion
+	}
+	return &Client{
+		config:   config,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 5 * time.Minute},
+		now:      time.Now,
+	}, nil
+}
+
+// Put writes data to the object named key.
+func (c *Client) Put(key string, data []byte) error {
+	resp, err := c.do("PUT", key, nil, data)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Get returns the content of the object named key.
+// Errors ErrNotFound may be returned.
+func (c *Client) Get(key string) ([]byte, error) {
+	resp, err := c.do("GET", key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+// Delete removes the object named key. Deleting a missing object succeeds.
+func (c *Client) Delete(key string) error {
+	resp, err := c.do("DELETE", key, nil, nil)
+	if err == ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key string
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+// List returns the keys of the objects starting with prefix, in
+// lexicographic order.
+func (c *Client) List(prefix string) ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if len(token) != 0 {
+			query.Set("continuation-token", token)
+		}
+		resp, err := c.do("GET", "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse object list: %v", err)
+		}
+		for _, o := range result.Contents {
+			keys = append(keys, o.Key)
+		}
+		if !result.IsTruncated || len(result.NextContinuationToken) == 0 {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+type errorResponse struct {
+	Code    string
+	Message string
+}
+
+// do sends a signed request for the object named key, or for the bucket if
+// key is empty, and returns the response if it succeeded.
+func (c *Client) do(method, key string, query url.Values, body []byte) (*http.Response, error) {
+	u := *c.endpoint
+	u.Path = u.Path + "/" + c.config.Bucket
+	if len(key) != 0 {
+		u.Path += "/" + key
+	}
+	u.RawPath = escapePath(u.Path)
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	c.sign(req, body)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound && len(key) != 0 {
+		return nil, ErrNotFound
+	}
+	var e errorResponse
+	if err := xml.NewDecoder(resp.Body).Decode(&e); err != nil || len(e.Code) == 0 {
+		return nil, fmt.Errorf("%s %s failed: %s", method, u.Path, resp.Status)
+	}
+	return nil, fmt.Errorf("%s %s failed: %s: %s", method, u.Path, e.Code, e.Message)
+}
+
+// sign adds the AWS signature version 4 authorization headers to req.
+func (c *Client) sign(req *http.Request, body []byte) {
+	now := c.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + c.config.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		algorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.config.SecretKey), date)
+	key = hmacSHA256(key, c.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, c.config.AccessKey, scope, signedHeaders, signature))
+}
+
+// canonicalQuery encodes query sorted by key, as required for signing.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		for _, v := range query[k] {
+			parts = append(parts, escape(k)+"="+escape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		segments[i] = escape(s)
+	}
+	return strings.Join(segments, "/")
+}
+
+// escape percent encodes every byte but the unreserved characters.
+func escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,150 @@
+package s3
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBucket serves a single in memory bucket.
+type fakeBucket struct {
+	sync.Mutex
+	name    string
+	objects map[string][]byte
+	auth    []string
+}
+
+func (f *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	f.auth = append(f.auth, r.Header.Get("Authorization"))
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if path != f.name && !strings.HasPrefix(path, f.name+"/") {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("<Error><Code>NoSuchBucket</Code><Message>missing</Message></Error>"))
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(path, f.name), "/")
+
+	switch {
+	case len(key) == 0 && r.Method == "GET":
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		// One key per page to exercise continuation
+		var result listBucketResult
+		start := 0
+		for i, k := range keys {
+			if k == r.URL.Query().Get("continuation-token") {
+				start = i
+			}
+		}
+		if start < len(keys) {
+			result.Contents = append(result.Contents, struct{ Key string }{keys[start]})
+		}
+		if start+1 < len(keys) {
+			result.IsTruncated = true
+			result.NextContinuationToken = keys[start+1]
+		}
+		xml.NewEncoder(w).Encode(&result)
+	case r.Method == "PUT":
+		data, _ := ioutil.ReadAll(r.Body)
+		f.objects[key] = data
+	case r.Method == "GET":
+		data, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	case r.Method == "DELETE":
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func newTestClient(t *testing.T) (*Client, *fakeBucket, func()) {
+	bucket := &fakeBucket{name: "backups", objects: make(map[string][]byte)}
+	ts := httptest.NewServer(bucket)
+	c, err := New(&Config{
+		Endpoint:  ts.URL,
+		Bucket:    "backups",
+		AccessKey: "AKIDEXAMPLE",
+		SecretKey: "secret",
+	})
+	require.NoError(t, err)
+	return c, bucket, ts.Close
+}
+
+func TestPutGetDelete(t *testing.T) {
+	c, _, done := newTestClient(t)
+	defer done()
+
+	require.NoError(t, c.Put("meta/a b", []byte("hello")))
+	data, err := c.Get("meta/a b")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	require.NoError(t, c.Delete("meta/a b"))
+	_, err = c.Get("meta/a b")
+	assert.Equal(t, ErrNotFound, err)
+	assert.NoError(t, c.Delete("meta/a b"))
+}
+
+func TestList(t *testing.T) {
+	c, _, done := newTestClient(t)
+	defer done()
+
+	for _, k := range []string{"meta/3", "meta/1", "other/1", "meta/2"} {
+		require.NoError(t, c.Put(k, []byte(k)))
+	}
+	keys, err := c.List("meta/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"meta/1", "meta/2", "meta/3"}, keys)
+}
+
+func TestSign(t *testing.T) {
+	c, bucket, done := newTestClient(t)
+	defer done()
+	c.now = func() time.Time {
+		return time.Date(2018, 5, 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	require.NoError(t, c.Put("key", []byte("data")))
+	require.Len(t, bucket.auth, 1)
+	auth := bucket.auth[0]
+	assert.True(t, strings.HasPrefix(auth,
+		"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20180501/us-east-1/s3/aws4_request, "+
+			"SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature="), auth)
+
+	// The signature covers the payload
+	require.NoError(t, c.Put("key", []byte("other")))
+	assert.NotEqual(t, auth, bucket.auth[1])
+}
+
+func TestErrors(t *testing.T) {
+	c, _, done := newTestClient(t)
+	defer done()
+	c.config.Bucket = "missing"
+
+	_, err := c.List("")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "NoSuchBucket")
+
+	_, err = New(&Config{Endpoint: "http://localhost", Bucket: "b"})
+	assert.Error(t, err)
+}
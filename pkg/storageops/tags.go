@@ -0,0 +1,42 @@
+package storageops
+
+import "strings"
+
+// ManagedTagPrefix is the prefix of the tags that openstorage manages itself
+// on cloud volumes. Tags with this prefix that are no longer desired are
+// removed during reconciliation, other tags are left untouched.
+const ManagedTagPrefix = "openstorage.io/"
+
+// ReconcileTags makes the tags on the given volume match desired. Missing or
+// changed tags are applied and stale managed tags are removed.
+func ReconcileTags(ops Ops, volumeID string, desired map[string]string) error {
+	current, err := ops.Tags(volumeID)
+	if err != nil {
+		return err
+	}
+
+	apply := make(map[string]string)
+	for k, v := range desired {
+		if cv, ok := current[k]; !ok || cv != v {
+			apply[k] = v
+		}
+	}
+	remove := make(map[string]string)
+	for k, v := range current {
+		if _, ok := desired[k]; !ok && strings.HasPrefix(k, ManagedTagPrefix) {
+			remove[k] = v
+		}
+	}
+
+	if len(apply) != 0 {
+		if err := ops.ApplyTags(volumeID, apply); err != nil {
+			return err
+		}
+	}
+	if len(remove) != 0 {
+		if err := ops.RemoveTags(volumeID, remove); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package storageops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTagOps keeps tags in memory. Only the tag operations are implemented.
+type fakeTagOps struct {
+	Ops
+	tags map[string]string
+}
+
+func (f *fakeTagOps) Tags(volumeID string) (map[string]string, error) {
+	tags := make(map[string]string)
+	for k, v := range f.tags {
+		tags[k] = v
+	}
+	return tags, nil
+}
+
+func (f *fakeTagOps) ApplyTags(volumeID string, labels map[string]string) error {
+	for k, v := range labels {
+		f.tags[k] = v
+	}
+	return nil
+}
+
+func (f *fakeTagOps) RemoveTags(volumeID string, labels map[string]string) error {
+	for k := range labels {
+		delete(f.tags, k)
+	}
+	return nil
+}
+
+func TestReconcileTags(t *testing.T) {
+	ops := &fakeTagOps{
+		tags: map[string]string{
+			"team":                      "old",
+			"cloud-only":                "keep",
+			ManagedTagPrefix + "owner":  "alice",
+			ManagedTagPrefix + "tenant": "stale",
+		},
+	}
+
+	err := ReconcileTags(ops, "vol1", map[string]string{
+		"team":                     "storage",
+		ManagedTagPrefix + "owner": "alice",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"team":                     "storage",
+		"cloud-only":               "keep",
+		ManagedTagPrefix + "owner": "alice",
+	}, ops.tags)
+}
@@ -0,0 +1,260 @@
+// +build linux
+
+/*
+Package subpath mounts a subdirectory of a volume, its subpath, at the mount
+path instead of the root of the volume, such as for the Kubernetes subPath of
+a volume shared by several containers.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package subpath
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	dockermount "github.com/docker/docker/pkg/mount"
+	"github.com/libopenstorage/openstorage/pkg/mount"
+	"github.com/libopenstorage/openstorage/pkg/options"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// StagingBase is the directory under which the volumes whose subpaths
+	// are mounted are themselves mounted, by volume id
+	StagingBase = "/var/lib/osd/subpath/"
+	// Label is the volume attribute of the CSI volumes, and the option of
+	// the inline volume names of the Docker plugin, giving the subpath
+	Label = "subpath"
+)
+
+// StagingPath returns where the volume volumeID is mounted for its subpaths
+// to be bind mounted.
+func StagingPath(volumeID string) string {
+	return filepath.Join(StagingBase, volumeID)
+}
+
+// Open opens the subpath of the volume mounted at root, creating its missing
+// directories. The subpath must be relative and resolve, symbolic links
+// followed, strictly within root. The returned file is opened with O_PATH,
+// the subpath cannot be replaced by a symbolic link once verified.
+func Open(root, subpath string) (*os.File, error) {
+	clean := filepath.Clean(subpath)
+	if filepath.IsAbs(clean) {
+		return nil, fmt.Errorf("Subpath %s must be relative to the volume root", subpath)
+	}
+	if clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+		return nil, fmt.Errorf("Subpath %s must be within the volume root", subpath)
+	}
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, err
+	}
+
+	p := realRoot
+	for _, name := range strings.Split(clean, string(filepath.Separator)) {
+		next := filepath.Join(p, name)
+		fi, err := os.Lstat(next)
+		if os.IsNotExist(err) {
+			if err := os.Mkdir(next, 0755); err != nil && !os.IsExist(err) {
+				return nil, err
+			}
+		} else if err != nil {
+			return nil, err
+		} else if fi.Mode()&os.ModeSymlink != 0 {
+			if next, err = filepath.EvalSymlinks(next); err != nil {
+				return nil, err
+			}
+		}
+		if !within(realRoot, next) {
+			return nil, fmt.Errorf("Subpath %s resolves outside of the volume root", subpath)
+		}
+		p = next
+	}
+
+	f, err := os.OpenFile(p, unix.O_PATH|unix.O_NOFOLLOW, 0)
+	if err != nil {
+		return nil, err
+	}
+	// the path opened is checked again, a component may have been replaced
+	// since resolved
+	opened, err := os.Readlink(fmt.Sprintf("/proc/self/fd/%d", f.Fd()))
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if !within(realRoot, opened) {
+		f.Close()
+		return nil, fmt.Errorf("Subpath %s resolves outside of the volume root", subpath)
+	}
+	return f, nil
+}
+
+// within returns true if path is strictly under root.
+func within(root, path string) bool {
+	prefix := strings.TrimSuffix(root, "/") + "/"
+	return len(path) > len(prefix) && strings.HasPrefix(path, prefix)
+}
+
+// Wrap returns d mounting the subpath given by the options.OptionsSubpath
+// mount option. The volume is mounted once at its StagingPath and each
+// subpath bind mounted from there, the volume is unmounted with its last
+// subpath.
+func Wrap(d volume.VolumeDriver) volume.VolumeDriver {
+	return &driver{VolumeDriver: d}
+}
+
+// driver mounts subpaths of the volumes of a volume driver.
+type driver struct {
+	volume.VolumeDriver
+	// lock serializes the subpath mounts and unmounts
+	lock sync.Mutex
+}
+
+func (d *driver) Mount(volumeID string, mountPath string, opts map[string]string) error {
+	subpath := opts[options.OptionsSubpath]
+	if len(subpath) == 0 {
+		return d.VolumeDriver.Mount(volumeID, mountPath, opts)
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	infos, err := mount.GetMounts()
+	if err != nil {
+		return err
+	}
+	staging := StagingPath(volumeID)
+	if mountInfo(infos, staging) == nil {
+		if err := os.MkdirAll(staging, 0755); err != nil {
+			return err
+		}
+		volumeOpts := make(map[string]string, len(opts))
+		for k, v := range opts {
+			if k != options.OptionsSubpath {
+				volumeOpts[k] = v
+			}
+		}
+		if err := d.VolumeDriver.Mount(volumeID, staging, volumeOpts); err != nil {
+			return err
+		}
+	}
+
+	if err := bind(staging, subpath, mountPath); err != nil {
+		if releaseErr := d.release(volumeID, nil); releaseErr != nil {
+			logrus.Warnf("Failed to unmount volume %s from %s: %v", volumeID, staging, releaseErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// bind bind mounts the subpath of the volume mounted at staging at
+// mountPath.
+func bind(staging, subpath, mountPath string) error {
+	f, err := Open(staging, subpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() {
+		err = os.MkdirAll(mountPath, 0755)
+	} else {
+		var target *os.File
+		if target, err = os.OpenFile(mountPath, os.O_CREATE|os.O_RDONLY, 0644); err == nil {
+			target.Close()
+		}
+	}
+	if err != nil {
+		return err
+	}
+	// the verified file is mounted through its descriptor, the pid is the
+	// one of this process in the mount namespace of the mounter too
+	source := fmt.Sprintf("/proc/%d/fd/%d", os.Getpid(), f.Fd())
+	if err := mount.DefaultMountImpl().Mount(source, mountPath, "", syscall.MS_BIND, "", 0); err != nil {
+		return fmt.Errorf("Failed to mount subpath %s at %s: %v", subpath, mountPath, err)
+	}
+	return nil
+}
+
+func (d *driver) Unmount(volumeID string, mountPath string, opts map[string]string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	infos, err := mount.GetMounts()
+	if err != nil {
+		return err
+	}
+	staging := mountInfo(infos, StagingPath(volumeID))
+	target := mountInfo(infos, mountPath)
+	if staging == nil || target == nil || !isSubpathOf(target, staging) {
+		return d.VolumeDriver.Unmount(volumeID, mountPath, opts)
+	}
+
+	if err := mount.DefaultMountImpl().Unmount(mountPath, 0, 0); err != nil {
+		return fmt.Errorf("Failed to unmount subpath at %s: %v", mountPath, err)
+	}
+	if options.IsBoolOptionSet(opts, options.OptionsDeleteAfterUnmount) {
+		os.Remove(mountPath)
+	}
+	return d.release(volumeID, opts)
+}
+
+// release unmounts the volume from its staging path once none of its
+// subpaths are mounted.
+func (d *driver) release(volumeID string, opts map[string]string) error {
+	infos, err := mount.GetMounts()
+	if err != nil {
+		return err
+	}
+	staging := mountInfo(infos, StagingPath(volumeID))
+	if staging == nil {
+		return nil
+	}
+	for _, info := range infos {
+		if isSubpathOf(info, staging) {
+			return nil
+		}
+	}
+	return d.VolumeDriver.Unmount(volumeID, staging.Mountpoint, opts)
+}
+
+// mountInfo returns the last mount at path, nil if none.
+func mountInfo(infos []*dockermount.Info, path string) *dockermount.Info {
+	path = filepath.Clean(path)
+	var found *dockermount.Info
+	for _, info := range infos {
+		if info.Mountpoint == path {
+			found = info
+		}
+	}
+	return found
+}
+
+// isSubpathOf returns true if info is a bind mount of a subpath of the
+// filesystem mounted by staging.
+func isSubpathOf(info, staging *dockermount.Info) bool {
+	return info.ID != staging.ID &&
+		info.Major == staging.Major &&
+		info.Minor == staging.Minor &&
+		within(staging.Root, info.Root)
+}
@@ -0,0 +1,93 @@
+// +build linux
+
+package subpath
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	dockermount "github.com/docker/docker/pkg/mount"
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/pkg/options"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "subpath")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	root := filepath.Join(dir, "volume")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "data", "logs"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "data", "app.conf"), []byte("conf"), 0644))
+	require.NoError(t, os.Symlink("data/logs", filepath.Join(root, "logs")))
+	require.NoError(t, os.Symlink(dir, filepath.Join(root, "escape")))
+	require.NoError(t, os.Symlink("../../..", filepath.Join(root, "data", "up")))
+
+	realRoot, err := filepath.EvalSymlinks(root)
+	require.NoError(t, err)
+	for subpath, expected := range map[string]string{
+		"data":          "data",
+		"data/app.conf": "data/app.conf",
+		"logs":          "data/logs",
+		"logs/../logs/": "data/logs",
+		"new/dir":       "new/dir",
+	} {
+		f, err := Open(root, subpath)
+		require.NoError(t, err, subpath)
+		opened, err := os.Readlink("/proc/self/fd/" + fdString(f))
+		f.Close()
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(realRoot, expected), opened, subpath)
+	}
+
+	for _, subpath := range []string{
+		"",
+		".",
+		"/data",
+		"..",
+		"../volume/data",
+		"data/../..",
+		"escape",
+		"escape/volume/data",
+		"data/up",
+		"data/up/tmp",
+	} {
+		_, err := Open(root, subpath)
+		assert.Error(t, err, subpath)
+	}
+	_, err = os.Stat(filepath.Join(dir, "tmp"))
+	assert.True(t, os.IsNotExist(err), "expected no directory created outside of the volume")
+}
+
+func TestIsSubpathOf(t *testing.T) {
+	staging := &dockermount.Info{ID: 1, Major: 8, Minor: 1, Root: "/", Mountpoint: StagingPath("vol")}
+	infos := []*dockermount.Info{
+		staging,
+		{ID: 2, Major: 8, Minor: 1, Root: "/data", Mountpoint: "/mnt/data"},
+		{ID: 3, Major: 8, Minor: 1, Root: "/", Mountpoint: "/mnt/vol"},
+		{ID: 4, Major: 8, Minor: 2, Root: "/data", Mountpoint: "/mnt/other"},
+	}
+	assert.Equal(t, staging, mountInfo(infos, StagingPath("vol")+"/"))
+	assert.Nil(t, mountInfo(infos, "/mnt"))
+	assert.True(t, isSubpathOf(infos[1], staging))
+	assert.False(t, isSubpathOf(infos[0], staging))
+	assert.False(t, isSubpathOf(infos[2], staging))
+	assert.False(t, isSubpathOf(infos[3], staging))
+}
+
+func TestMountWithoutSubpath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+	d.EXPECT().Mount("vol", "/mnt/vol", map[string]string{options.OptionsSecret: "secret"}).Return(nil)
+	require.NoError(t, Wrap(d).Mount("vol", "/mnt/vol", map[string]string{options.OptionsSecret: "secret"}))
+}
+
+func fdString(f *os.File) string {
+	return strconv.Itoa(int(f.Fd()))
+}
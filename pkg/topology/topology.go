@@ -0,0 +1,148 @@
+/*
+Package topology selects volume replicas by their locality to a node.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package topology
+
+import (
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/libopenstorage/openstorage/volume"
+)
+
+// Locality of a node relative to another, from nearest to farthest.
+type Locality int
+
+const (
+	// LocalityNode is the same node
+	LocalityNode Locality = iota
+	// LocalityZone is a different node in the same zone
+	LocalityZone
+	// LocalityRegion is a different zone in the same region
+	LocalityRegion
+	// LocalityRemote is a different region, or unknown topology
+	LocalityRemote
+)
+
+var (
+	// zoneLabels are the node labels holding the zone, in order of preference
+	zoneLabels = []string{api.NodeLabelZone, "failure-domain.beta.kubernetes.io/zone"}
+	// regionLabels are the node labels holding the region
+	regionLabels = []string{api.NodeLabelRegion, "failure-domain.beta.kubernetes.io/region"}
+)
+
+func (l Locality) String() string {
+	switch l {
+	case LocalityNode:
+		return "node"
+	case LocalityZone:
+		return "zone"
+	case LocalityRegion:
+		return "region"
+	default:
+		return "remote"
+	}
+}
+
+// Of returns the locality of other relative to self.
+func Of(self, other *api.Node) Locality {
+	if self.Id == other.Id {
+		return LocalityNode
+	}
+	if same(self, other, zoneLabels) {
+		// Zone names are only unique within a region.
+		region := label(self, regionLabels)
+		if len(region) == 0 || region == label(other, regionLabels) {
+			return LocalityZone
+		}
+	}
+	if same(self, other, regionLabels) {
+		return LocalityRegion
+	}
+	return LocalityRemote
+}
+
+// Nearest returns the online candidate nearest to self and its locality.
+// Candidates of equal locality are preferred in the given order. It returns
+// nil if no candidate is online.
+func Nearest(self *api.Node, candidates []*api.Node) (*api.Node, Locality) {
+	var (
+		nearest  *api.Node
+		locality = LocalityRemote
+	)
+	for _, c := range candidates {
+		if c.Status != api.Status_STATUS_OK {
+			continue
+		}
+		l := Of(self, c)
+		if nearest == nil || l < locality {
+			nearest, locality = c, l
+		}
+	}
+	return nearest, locality
+}
+
+// BackupSource returns the replica node of the volume nearest to the node
+// running the cluster, which moves the backup data. It returns an empty id
+// if the volume is not replicated, letting the driver pick the source.
+func BackupSource(c cluster.Cluster, d volume.VolumeDriver, volumeID string) (string, Locality, error) {
+	vols, err := d.Inspect([]string{volumeID})
+	if err != nil {
+		return "", LocalityRemote, err
+	}
+	if len(vols) == 0 || len(vols[0].GetReplicaSets()) == 0 ||
+		len(vols[0].GetReplicaSets()[0].GetNodes()) < 2 {
+		return "", LocalityRemote, nil
+	}
+
+	info, err := c.Enumerate()
+	if err != nil {
+		return "", LocalityRemote, err
+	}
+	nodes := make(map[string]*api.Node)
+	for i := range info.Nodes {
+		nodes[info.Nodes[i].Id] = &info.Nodes[i]
+	}
+	self, ok := nodes[info.NodeId]
+	if !ok {
+		self = &api.Node{Id: info.NodeId}
+	}
+
+	var replicas []*api.Node
+	for _, id := range vols[0].GetReplicaSets()[0].GetNodes() {
+		if n, ok := nodes[id]; ok {
+			replicas = append(replicas, n)
+		}
+	}
+	nearest, locality := Nearest(self, replicas)
+	if nearest == nil {
+		return "", LocalityRemote, nil
+	}
+	return nearest.Id, locality, nil
+}
+
+func label(n *api.Node, keys []string) string {
+	for _, k := range keys {
+		if v := n.NodeLabels[k]; len(v) != 0 {
+			return v
+		}
+	}
+	return ""
+}
+
+func same(a, b *api.Node, keys []string) bool {
+	v := label(a, keys)
+	return len(v) != 0 && v == label(b, keys)
+}
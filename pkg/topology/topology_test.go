@@ -0,0 +1,54 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func node(id, region, zone string) *api.Node {
+	return &api.Node{
+		Id:     id,
+		Status: api.Status_STATUS_OK,
+		NodeLabels: map[string]string{
+			api.NodeLabelRegion: region,
+			api.NodeLabelZone:   zone,
+		},
+	}
+}
+
+func TestOf(t *testing.T) {
+	self := node("1", "us-east", "a")
+	assert.Equal(t, LocalityNode, Of(self, self))
+	assert.Equal(t, LocalityZone, Of(self, node("2", "us-east", "a")))
+	assert.Equal(t, LocalityRegion, Of(self, node("3", "us-east", "b")))
+	assert.Equal(t, LocalityRemote, Of(self, node("4", "us-west", "a")))
+	assert.Equal(t, LocalityRemote, Of(self, &api.Node{Id: "5"}))
+
+	k8s := &api.Node{Id: "6", NodeLabels: map[string]string{
+		"failure-domain.beta.kubernetes.io/region": "us-east",
+		"failure-domain.beta.kubernetes.io/zone":   "a",
+	}}
+	assert.Equal(t, LocalityZone, Of(self, k8s))
+}
+
+func TestNearest(t *testing.T) {
+	self := node("1", "us-east", "a")
+	remote := node("2", "us-west", "a")
+	region := node("3", "us-east", "b")
+	zone := node("4", "us-east", "a")
+	offline := node("5", "us-east", "a")
+	offline.Status = api.Status_STATUS_OFFLINE
+
+	n, l := Nearest(self, []*api.Node{remote, region, offline, zone})
+	assert.Equal(t, zone, n)
+	assert.Equal(t, LocalityZone, l)
+
+	n, l = Nearest(self, []*api.Node{remote, region})
+	assert.Equal(t, region, n)
+	assert.Equal(t, LocalityRegion, l)
+
+	n, _ = Nearest(self, []*api.Node{offline})
+	assert.Nil(t, n)
+}
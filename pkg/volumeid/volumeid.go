@@ -0,0 +1,168 @@
+/*
+Package volumeid generates the ids of the volumes, as UUIDs or as ULIDs
+sorted by creation time, optionally prefixed so that the ids tell which
+cluster created them.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package volumeid
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+)
+
+const (
+	// FormatUUID generates random UUIDs, the default
+	FormatUUID = "uuid"
+	// FormatULID generates ULIDs, sorted by creation time to the
+	// millisecond, so that the kvdb keys of volumes created together are
+	// close
+	FormatULID = "ulid"
+
+	// Separator separates the prefix of an id from the rest of the id
+	Separator = "_"
+
+	// crockford is the base32 alphabet of the ULIDs
+	crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+var (
+	prefixRegexp = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+
+	defaultGeneratorLock sync.Mutex
+	defaultGenerator     = &Generator{format: FormatUUID}
+)
+
+// Config configures the ids of the volumes.
+type Config struct {
+	// Format is FormatUUID or FormatULID, FormatUUID if unset
+	Format string `yaml:"format"`
+	// Prefix is prepended to the ids, such as the cluster id, none if unset.
+	// It is made of letters, digits and hyphens.
+	Prefix string `yaml:"prefix"`
+}
+
+// Generator generates ids in the format and with the prefix of a Config.
+type Generator struct {
+	format string
+	prefix string
+	// now returns the time of the ULIDs
+	now func() time.Time
+	// entropy is the randomness of the ULIDs
+	entropy io.Reader
+}
+
+// NewGenerator returns a generator of the ids configured by c.
+func NewGenerator(c Config) (*Generator, error) {
+	format := strings.ToLower(c.Format)
+	switch format {
+	case "":
+		format = FormatUUID
+	case FormatUUID, FormatULID:
+	default:
+		return nil, fmt.Errorf("Invalid volume id format %q, expected %s or %s",
+			c.Format, FormatUUID, FormatULID)
+	}
+	if len(c.Prefix) != 0 && !prefixRegexp.MatchString(c.Prefix) {
+		return nil, fmt.Errorf("Invalid volume id prefix %q", c.Prefix)
+	}
+	return &Generator{
+		format: format,
+		prefix: c.Prefix,
+	}, nil
+}
+
+// New returns a new id.
+func (g *Generator) New() string {
+	var id string
+	if g.format == FormatULID {
+		id = g.ulid()
+	} else {
+		id = strings.TrimSuffix(uuid.New(), "\n")
+	}
+	if len(g.prefix) == 0 {
+		return id
+	}
+	return g.prefix + Separator + id
+}
+
+// ulid returns a ULID: 48 bits of milliseconds since the epoch followed by
+// 80 random bits, encoded in 26 characters of Crockford's base32.
+func (g *Generator) ulid() string {
+	now, entropy := time.Now, io.Reader(rand.Reader)
+	if g.now != nil {
+		now = g.now
+	}
+	if g.entropy != nil {
+		entropy = g.entropy
+	}
+
+	var b [16]byte
+	ms := uint64(now().UnixNano() / int64(time.Millisecond))
+	for i := 5; i >= 0; i-- {
+		b[i] = byte(ms)
+		ms >>= 8
+	}
+	if _, err := io.ReadFull(entropy, b[6:]); err != nil {
+		// the id stays unique to the millisecond
+		copy(b[6:], uuid.NewRandom()[:10])
+	}
+
+	// 128 bits are encoded in 26 characters of 5 bits, the first one
+	// carrying the 3 most significant bits
+	id := make([]byte, 26)
+	hi := uint64(b[0])<<56 | uint64(b[1])<<48 | uint64(b[2])<<40 | uint64(b[3])<<32 |
+		uint64(b[4])<<24 | uint64(b[5])<<16 | uint64(b[6])<<8 | uint64(b[7])
+	lo := uint64(b[8])<<56 | uint64(b[9])<<48 | uint64(b[10])<<40 | uint64(b[11])<<32 |
+		uint64(b[12])<<24 | uint64(b[13])<<16 | uint64(b[14])<<8 | uint64(b[15])
+	for i := 25; i >= 0; i-- {
+		id[i] = crockford[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(id)
+}
+
+// Prefix returns the prefix of id, empty if it has none.
+func Prefix(id string) string {
+	if i := strings.Index(id, Separator); i > 0 {
+		return id[:i]
+	}
+	return ""
+}
+
+// SetDefault sets the generator of the ids of the volumes created by the
+// drivers.
+func SetDefault(g *Generator) {
+	defaultGeneratorLock.Lock()
+	defer defaultGeneratorLock.Unlock()
+	defaultGenerator = g
+}
+
+// New returns a new id generated by the generator set by SetDefault, a
+// UUID if none.
+func New() string {
+	defaultGeneratorLock.Lock()
+	g := defaultGenerator
+	defaultGeneratorLock.Unlock()
+	return g.New()
+}
@@ -0,0 +1,57 @@
+package volumeid
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGenerator(t *testing.T) {
+	_, err := NewGenerator(Config{Format: "snowflake"})
+	assert.Error(t, err)
+	_, err = NewGenerator(Config{Prefix: "east_1"})
+	assert.Error(t, err)
+
+	g, err := NewGenerator(Config{})
+	require.NoError(t, err)
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`, g.New())
+
+	g, err = NewGenerator(Config{Format: "UUID", Prefix: "east-1"})
+	require.NoError(t, err)
+	id := g.New()
+	assert.Regexp(t, `^east-1_[0-9a-f-]{36}$`, id)
+	assert.Equal(t, "east-1", Prefix(id))
+}
+
+func TestULID(t *testing.T) {
+	g, err := NewGenerator(Config{Format: FormatULID, Prefix: "east"})
+	require.NoError(t, err)
+	g.now = func() time.Time {
+		return time.Unix(0, 1469918176385*int64(time.Millisecond))
+	}
+	g.entropy = bytes.NewReader(make([]byte, 10))
+	assert.Equal(t, "east_01ARYZ6S410000000000000000", g.New())
+
+	// ids created later sort after
+	g.entropy = nil
+	g.now = nil
+	first := g.New()
+	time.Sleep(2 * time.Millisecond)
+	second := g.New()
+	assert.Regexp(t, regexp.MustCompile(`^east_[0-9A-HJKMNP-TV-Z]{26}$`), second)
+	assert.True(t, first < second, "%s >= %s", first, second)
+	assert.Equal(t, "", Prefix(first[len("east_"):]))
+}
+
+func TestDefault(t *testing.T) {
+	defer SetDefault(&Generator{format: FormatUUID})
+
+	g, err := NewGenerator(Config{Format: FormatULID, Prefix: "west"})
+	require.NoError(t, err)
+	SetDefault(g)
+	assert.Equal(t, "west", Prefix(New()))
+}
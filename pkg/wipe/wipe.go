@@ -0,0 +1,165 @@
+/*
+Package wipe provides helpers to securely erase volume storage.
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package wipe
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/audit"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/libopenstorage/openstorage/jobs"
+	osdexec "github.com/libopenstorage/openstorage/pkg/exec"
+	"github.com/libopenstorage/openstorage/volume"
+)
+
+const (
+	// JobType is the job type used for secure deletes
+	JobType = "securedelete"
+	// AuditAction is the audit log action recorded for a secure delete
+	AuditAction = "volume.securedelete"
+
+	blockSize = 1024 * 1024
+)
+
+// ValidMethod returns an error if method is not a known secure delete method.
+func ValidMethod(method string) error {
+	switch method {
+	case api.SecureDeleteOverwrite, api.SecureDeleteDiscard, api.SecureDeleteCryptoErase:
+		return nil
+	}
+	return fmt.Errorf("Unknown secure delete method %q", method)
+}
+
+// Overwrite overwrites every regular file under path with zeros and syncs
+// it to disk. It returns the number of bytes overwritten.
+func Overwrite(path string) (uint64, error) {
+	var total uint64
+	err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		n, err := overwriteFile(p, info.Size())
+		total += n
+		return err
+	})
+	return total, err
+}
+
+// Discard discards all blocks of the block device at devicePath. It returns
+// the size of the device.
+func Discard(devicePath string) (uint64, error) {
+	f, err := os.Open(devicePath)
+	if err != nil {
+		return 0, err
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	f.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := exec.Command(osdexec.Which("blkdiscard"), devicePath).CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("blkdiscard %s failed: %v: %s", devicePath, err, string(out))
+	}
+	return uint64(size), nil
+}
+
+// Zero overwrites the whole block device at devicePath with zeros and
+// syncs it. It returns the size of the device.
+func Zero(devicePath string) (uint64, error) {
+	f, err := os.Open(devicePath)
+	if err != nil {
+		return 0, err
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	f.Close()
+	if err != nil {
+		return 0, err
+	}
+	return overwriteFile(devicePath, size)
+}
+
+// SecureDelete wipes and then deletes the volume as a job, on behalf of the
+// request of ctx. Once the wipe completes the certificate is recorded in the
+// audit log, with the correlation id of the request.
+func SecureDelete(ctx context.Context, d volume.VolumeDriver, volumeID, method string) (*jobs.Job, error) {
+	if err := ValidMethod(method); err != nil {
+		return nil, err
+	}
+	jm, err := jobs.Inst()
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := audit.Inst()
+	if err != nil {
+		return nil, err
+	}
+
+	return jm.SubmitWithContext(ctx, JobType, volumeID, func(ctx context.Context) error {
+		cert, err := d.Wipe(volumeID, method)
+		if err != nil {
+			return err
+		}
+		if err := d.Delete(volumeID); err != nil {
+			return err
+		}
+		eventbus.PublishContext(ctx, eventbus.EventVolumeDelete, volumeID, nil)
+		_, err = auditLog.LogWithContext(ctx, AuditAction, volumeID, map[string]string{
+			"method":      cert.Method,
+			"bytes_wiped": strconv.FormatUint(cert.BytesWiped, 10),
+			"start_time":  cert.StartTime.Format(time.RFC3339),
+			"end_time":    cert.EndTime.Format(time.RFC3339),
+		})
+		return err
+	})
+}
+
+func overwriteFile(path string, size int64) (uint64, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	zeros := make([]byte, blockSize)
+	var written uint64
+	for remaining := size; remaining > 0; {
+		n := int64(len(zeros))
+		if remaining < n {
+			n = remaining
+		}
+		w, err := f.Write(zeros[:n])
+		written += uint64(w)
+		if err != nil {
+			return written, err
+		}
+		remaining -= int64(w)
+	}
+	return written, f.Sync()
+}
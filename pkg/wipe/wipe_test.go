@@ -0,0 +1,111 @@
+package wipe
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/audit"
+	"github.com/libopenstorage/openstorage/jobs"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidMethod(t *testing.T) {
+	assert.NoError(t, ValidMethod(api.SecureDeleteOverwrite))
+	assert.NoError(t, ValidMethod(api.SecureDeleteDiscard))
+	assert.NoError(t, ValidMethod(api.SecureDeleteCryptoErase))
+	assert.Error(t, ValidMethod("shred"))
+}
+
+func TestOverwrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wipe")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a"), []byte("secret"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "sub", "b"), []byte("more secrets"), 0644))
+
+	n, err := Overwrite(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(18), n)
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "sub", "b"))
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, 12), data)
+}
+
+func TestZero(t *testing.T) {
+	f, err := ioutil.TempFile("", "wipe")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.Write([]byte("secret device"))
+	require.NoError(t, err)
+	f.Close()
+
+	n, err := Zero(f.Name())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(13), n)
+	data, err := ioutil.ReadFile(f.Name())
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, 13), data)
+}
+
+func TestSecureDelete(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	jm := jobs.NewManager(kv)
+	jobs.Inst = func() (jobs.Manager, error) {
+		return jm, nil
+	}
+	auditLog := audit.NewLogger(kv, "node1")
+	audit.Inst = func() (audit.Logger, error) {
+		return auditLog, nil
+	}
+
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+	d := mock.NewMockVolumeDriver(mc)
+
+	_, err = SecureDelete(context.Background(), d, "vol1", "shred")
+	assert.Error(t, err)
+
+	now := time.Now()
+	gomock.InOrder(
+		d.EXPECT().Wipe("vol1", api.SecureDeleteOverwrite).Return(&api.WipeCertificate{
+			VolumeId:   "vol1",
+			Method:     api.SecureDeleteOverwrite,
+			BytesWiped: 1024,
+			StartTime:  now,
+			EndTime:    now,
+		}, nil),
+		d.EXPECT().Delete("vol1").Return(nil),
+	)
+
+	job, err := SecureDelete(context.Background(), d, "vol1", api.SecureDeleteOverwrite)
+	require.NoError(t, err)
+	assert.Equal(t, JobType, job.Type)
+
+	for i := 0; i < 100 && !job.State.Done(); i++ {
+		time.Sleep(10 * time.Millisecond)
+		job, err = jm.Inspect(job.Id)
+		require.NoError(t, err)
+	}
+	require.Equal(t, jobs.StateDone, job.State)
+
+	records, err := auditLog.Enumerate()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, AuditAction, records[0].Action)
+	assert.Equal(t, "vol1", records[0].ResourceId)
+	assert.Equal(t, "1024", records[0].Details["bytes_wiped"])
+}
@@ -0,0 +1,146 @@
+package planner
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// Violation is a volume replica on a node breaking a required placement
+// rule of the volume.
+// swagger:model
+type Violation struct {
+	// VolumeId of the volume
+	VolumeId string
+	// NodeId of the node holding the replica
+	NodeId string
+	// Replica index of the replica
+	Replica int
+}
+
+// Matches returns true if labels satisfy every requirement.
+func Matches(labels map[string]string, reqs []*api.LabelSelectorRequirement) bool {
+	for _, req := range reqs {
+		if !matchRequirement(labels, req) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchRequirement(labels map[string]string, req *api.LabelSelectorRequirement) bool {
+	value, ok := labels[req.GetKey()]
+	switch req.GetOperator() {
+	case api.LabelSelectorRequirement_In:
+		return ok && contains(req.GetValues(), value)
+	case api.LabelSelectorRequirement_NotIn:
+		return !ok || !contains(req.GetValues(), value)
+	case api.LabelSelectorRequirement_Exists:
+		return ok
+	case api.LabelSelectorRequirement_DoesNotExist:
+		return !ok
+	case api.LabelSelectorRequirement_Gt, api.LabelSelectorRequirement_Lt:
+		if !ok || len(req.GetValues()) != 1 {
+			return false
+		}
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return false
+		}
+		limit, err := strconv.ParseInt(req.GetValues()[0], 10, 64)
+		if err != nil {
+			return false
+		}
+		if req.GetOperator() == api.LabelSelectorRequirement_Gt {
+			return v > limit
+		}
+		return v < limit
+	}
+	return false
+}
+
+// applies returns true if rule constrains the replica with the given index.
+func applies(rule *api.VolumePlacementRule, replica int) bool {
+	return rule.GetAffectedReplicas() == 0 || replica < int(rule.GetAffectedReplicas())
+}
+
+// satisfies returns true if a node with labels satisfies rule: it matches
+// the expressions of an affinity rule, or not those of an anti-affinity rule.
+func satisfies(rule *api.VolumePlacementRule, labels map[string]string) bool {
+	match := Matches(labels, rule.GetMatchExpressions())
+	if rule.GetType() == api.VolumePlacementRule_AntiAffinity {
+		return !match
+	}
+	return match
+}
+
+// Allowed returns true if a node with labels may hold the replica with the
+// given index under the required rules of strategy.
+func Allowed(strategy *api.VolumePlacementStrategy, labels map[string]string, replica int) bool {
+	for _, rule := range strategy.GetRules() {
+		if rule.GetEnforcement() == api.VolumePlacementRule_Required &&
+			applies(rule, replica) && !satisfies(rule, labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// preference is the weight of the preferred rules of strategy a node with
+// labels satisfies for the replica with the given index.
+func preference(strategy *api.VolumePlacementStrategy, labels map[string]string, replica int) int64 {
+	var weight int64
+	for _, rule := range strategy.GetRules() {
+		if rule.GetEnforcement() == api.VolumePlacementRule_Preferred &&
+			applies(rule, replica) && satisfies(rule, labels) {
+			weight += rule.GetWeight()
+		}
+	}
+	return weight
+}
+
+// Revalidate returns the replicas of vols placed on nodes that no longer
+// satisfy the required placement rules of their volume, e.g. after a change
+// of the node labels. Replicas on unknown nodes are ignored.
+func Revalidate(nodes []api.Node, vols []*api.Volume) []Violation {
+	labels := make(map[string]map[string]string)
+	for _, n := range nodes {
+		labels[n.Id] = n.NodeLabels
+	}
+	var violations []Violation
+	for _, v := range vols {
+		strategy := v.GetSpec().GetPlacementStrategy()
+		if len(strategy.GetRules()) == 0 {
+			continue
+		}
+		for _, set := range v.GetReplicaSets() {
+			for i, nodeID := range set.GetNodes() {
+				l, ok := labels[nodeID]
+				if ok && !Allowed(strategy, l, i) {
+					violations = append(violations, Violation{
+						VolumeId: v.GetId(),
+						NodeId:   nodeID,
+						Replica:  i,
+					})
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// String describes the violation.
+func (v Violation) String() string {
+	return fmt.Sprintf("Replica %d of volume %s on node %s breaks a required placement rule",
+		v.Replica, v.VolumeId, v.NodeId)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package planner
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requirement(key string, op api.LabelSelectorRequirement_Operator, values ...string) *api.LabelSelectorRequirement {
+	return &api.LabelSelectorRequirement{Key: key, Operator: op, Values: values}
+}
+
+func TestMatches(t *testing.T) {
+	labels := map[string]string{"rack": "r1", "disks": "12"}
+
+	assert.True(t, Matches(labels, nil))
+	assert.True(t, Matches(labels, []*api.LabelSelectorRequirement{
+		requirement("rack", api.LabelSelectorRequirement_In, "r1", "r2"),
+		requirement("zone", api.LabelSelectorRequirement_DoesNotExist),
+		requirement("disks", api.LabelSelectorRequirement_Gt, "8"),
+	}))
+	assert.False(t, Matches(labels, []*api.LabelSelectorRequirement{
+		requirement("rack", api.LabelSelectorRequirement_NotIn, "r1"),
+	}))
+	assert.False(t, Matches(labels, []*api.LabelSelectorRequirement{
+		requirement("zone", api.LabelSelectorRequirement_Exists),
+	}))
+	assert.False(t, Matches(labels, []*api.LabelSelectorRequirement{
+		requirement("disks", api.LabelSelectorRequirement_Lt, "8"),
+	}))
+	assert.False(t, Matches(labels, []*api.LabelSelectorRequirement{
+		requirement("rack", api.LabelSelectorRequirement_Gt, "8"),
+	}))
+}
+
+func TestAllowed(t *testing.T) {
+	strategy := &api.VolumePlacementStrategy{
+		Rules: []*api.VolumePlacementRule{
+			{
+				Enforcement:      api.VolumePlacementRule_Required,
+				AffectedReplicas: 1,
+				MatchExpressions: []*api.LabelSelectorRequirement{
+					requirement("diskclass", api.LabelSelectorRequirement_In, "ssd"),
+				},
+			},
+			{
+				Enforcement: api.VolumePlacementRule_Required,
+				Type:        api.VolumePlacementRule_AntiAffinity,
+				MatchExpressions: []*api.LabelSelectorRequirement{
+					requirement("dedicated", api.LabelSelectorRequirement_Exists),
+				},
+			},
+		},
+	}
+
+	assert.True(t, Allowed(strategy, map[string]string{"diskclass": "ssd"}, 0))
+	assert.False(t, Allowed(strategy, map[string]string{"diskclass": "hdd"}, 0))
+	// The first rule only affects the first replica
+	assert.True(t, Allowed(strategy, map[string]string{"diskclass": "hdd"}, 1))
+	assert.False(t, Allowed(strategy, map[string]string{"dedicated": "db"}, 1))
+	assert.True(t, Allowed(nil, map[string]string{}, 0))
+}
+
+func TestSimulatePlacement(t *testing.T) {
+	nodes := testNodes()
+	nodes[0].NodeLabels = map[string]string{"rack": "r1"}
+	nodes[1].NodeLabels = map[string]string{"rack": "r2"}
+	nodes[2].Status = api.Status_STATUS_OK
+	nodes[2].NodeLabels = map[string]string{"rack": "r1", "dedicated": "db"}
+
+	plan, err := Simulate(nodes, &Request{
+		Workloads: []Workload{{
+			Name:    "db",
+			Count:   2,
+			Size:    100 * oneGB,
+			HaLevel: 1,
+			Cos:     api.CosType_HIGH,
+			Placement: &api.VolumePlacementStrategy{
+				Rules: []*api.VolumePlacementRule{
+					{
+						Enforcement: api.VolumePlacementRule_Required,
+						MatchExpressions: []*api.LabelSelectorRequirement{
+							requirement("rack", api.LabelSelectorRequirement_In, "r1"),
+						},
+					},
+					{
+						Enforcement: api.VolumePlacementRule_Preferred,
+						Weight:      10,
+						MatchExpressions: []*api.LabelSelectorRequirement{
+							requirement("dedicated", api.LabelSelectorRequirement_In, "db"),
+						},
+					},
+				},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	assert.True(t, plan.Fits)
+	require.Len(t, plan.Placements, 2)
+	for _, p := range plan.Placements {
+		assert.Equal(t, []string{"node3"}, p.Nodes)
+	}
+
+	plan, err = Simulate(nodes, &Request{
+		Workloads: []Workload{{
+			Name:    "db",
+			Count:   1,
+			Size:    100 * oneGB,
+			HaLevel: 3,
+			Placement: &api.VolumePlacementStrategy{
+				Rules: []*api.VolumePlacementRule{{
+					Enforcement: api.VolumePlacementRule_Required,
+					MatchExpressions: []*api.LabelSelectorRequirement{
+						requirement("rack", api.LabelSelectorRequirement_In, "r1"),
+					},
+				}},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	assert.False(t, plan.Fits)
+	require.Len(t, plan.Unplaced, 1)
+	assert.Contains(t, plan.Unplaced[0].Reason, "placement rules of replica 2")
+}
+
+func TestRevalidate(t *testing.T) {
+	nodes := []api.Node{
+		{Id: "node1", NodeLabels: map[string]string{"diskclass": "ssd"}},
+		{Id: "node2", NodeLabels: map[string]string{"diskclass": "hdd"}},
+	}
+	strategy := &api.VolumePlacementStrategy{
+		Rules: []*api.VolumePlacementRule{{
+			Enforcement: api.VolumePlacementRule_Required,
+			MatchExpressions: []*api.LabelSelectorRequirement{
+				requirement("diskclass", api.LabelSelectorRequirement_In, "ssd"),
+			},
+		}},
+	}
+	vols := []*api.Volume{
+		{
+			Id:          "vol1",
+			Spec:        &api.VolumeSpec{PlacementStrategy: strategy},
+			ReplicaSets: []*api.ReplicaSet{{Nodes: []string{"node1", "node2", "node3"}}},
+		},
+		{
+			Id:          "vol2",
+			Spec:        &api.VolumeSpec{},
+			ReplicaSets: []*api.ReplicaSet{{Nodes: []string{"node2"}}},
+		},
+	}
+	assert.Equal(t, []Violation{{VolumeId: "vol1", NodeId: "node2", Replica: 1}}, Revalidate(nodes, vols))
+}
@@ -0,0 +1,119 @@
+// Package planner simulates the placement of hypothetical volume workloads
+// on a snapshot of the cluster for capacity planning. It never calls into
+// volume drivers, so no real volumes are created.
+package planner
+
+import (
+	"fmt"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// Workload is a set of identical hypothetical volumes, for example
+// 200 volumes of 100GB with HA level 2.
+// swagger:model
+type Workload struct {
+	// Name identifies the workload in the plan
+	Name string
+	// Count of volumes
+	Count int
+	// Size of each volume in bytes
+	Size uint64
+	// HaLevel is the number of replicas, each on a different node
+	HaLevel int64
+	// Cos restricts replicas to pools of that class of service, any pool
+	// if COS_TYPE_NONE
+	Cos api.CosType
+	// Placement rules matched against the node labels
+	Placement *api.VolumePlacementStrategy
+}
+
+// Request is a what-if question for the planner.
+// swagger:model
+type Request struct {
+	// Workloads to place, in order
+	Workloads []Workload
+	// AddNodes are hypothetical nodes added to the cluster before placement
+	AddNodes []api.Node
+}
+
+// Placement is where the replicas of a planned volume would go.
+// swagger:model
+type Placement struct {
+	// Workload the volume belongs to
+	Workload string
+	// Index of the volume in the workload
+	Index int
+	// Nodes holding a replica of the volume
+	Nodes []string
+}
+
+// Unplaced counts the volumes of a workload that would not fit.
+// swagger:model
+type Unplaced struct {
+	// Workload the volumes belong to
+	Workload string
+	// Count of volumes that could not be placed
+	Count int
+	// Reason the first of them could not be placed
+	Reason string
+}
+
+// NodeCapacity is the capacity of a node before and after the plan.
+// swagger:model
+type NodeCapacity struct {
+	// NodeId of the node
+	NodeId string
+	// TotalSize of the node pools in bytes
+	TotalSize uint64
+	// Used bytes before the plan
+	Used uint64
+	// Planned bytes that the plan would add
+	Planned uint64
+}
+
+// Free returns the bytes left on the node after the plan.
+func (n *NodeCapacity) Free() uint64 {
+	if n.Used+n.Planned > n.TotalSize {
+		return 0
+	}
+	return n.TotalSize - n.Used - n.Planned
+}
+
+// Plan is the outcome of a simulation.
+// swagger:model
+type Plan struct {
+	// Fits is true when every volume of every workload can be placed
+	Fits bool
+	// Placements of the volumes that fit
+	Placements []*Placement
+	// Unplaced volumes per workload
+	Unplaced []*Unplaced
+	// Nodes capacity after the plan
+	Nodes []*NodeCapacity
+	// RequiredBytes is the capacity needed by all replicas of the workloads
+	RequiredBytes uint64
+	// PlannedBytes is the capacity used by the volumes that fit
+	PlannedBytes uint64
+}
+
+// Validate checks the request.
+func (r *Request) Validate() error {
+	for i, w := range r.Workloads {
+		if w.Count <= 0 {
+			return fmt.Errorf("Workload %d must have a positive count", i)
+		}
+		if w.Size == 0 {
+			return fmt.Errorf("Workload %d must have a size", i)
+		}
+		if w.HaLevel < 0 {
+			return fmt.Errorf("Workload %d has an invalid HA level %d", i, w.HaLevel)
+		}
+	}
+	for _, n := range r.AddNodes {
+		if len(n.Id) == 0 {
+			return fmt.Errorf("Added nodes must have an id")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package planner
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// pool is the simulated state of a storage pool.
+type pool struct {
+	cos    api.CosType
+	free   uint64
+	node   *NodeCapacity
+	labels map[string]string
+}
+
+// Simulate places the workloads of the request on the nodes. Each replica
+// of a volume goes to the pool with the most free space on a node that does
+// not already hold a replica of that volume, which spreads replicas the way
+// the drivers balance pools. Nodes breaking a required placement rule of the
+// workload are skipped, and the nodes best satisfying the preferred rules
+// win over free space. A volume is placed only if all its replicas fit.
+// The nodes are not modified.
+func Simulate(nodes []api.Node, req *Request) (*Plan, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Fits: true}
+	var pools []*pool
+	all := append(append([]api.Node{}, nodes...), req.AddNodes...)
+	seen := make(map[string]bool)
+	for _, n := range all {
+		if seen[n.Id] {
+			return nil, fmt.Errorf("Duplicate node %s", n.Id)
+		}
+		seen[n.Id] = true
+		nc := &NodeCapacity{NodeId: n.Id}
+		plan.Nodes = append(plan.Nodes, nc)
+		if n.Status != api.Status_STATUS_OK && n.Status != api.Status_STATUS_NONE {
+			continue
+		}
+		for _, p := range n.Pools {
+			nc.TotalSize += p.TotalSize
+			nc.Used += p.Used
+			free := uint64(0)
+			if p.TotalSize > p.Used {
+				free = p.TotalSize - p.Used
+			}
+			pools = append(pools, &pool{cos: p.Cos, free: free, node: nc, labels: n.NodeLabels})
+		}
+	}
+
+	for _, w := range req.Workloads {
+		replicas := w.HaLevel
+		if replicas == 0 {
+			replicas = 1
+		}
+		plan.RequiredBytes += uint64(w.Count) * uint64(replicas) * w.Size
+
+		var unplaced *Unplaced
+		for i := 0; i < w.Count; i++ {
+			chosen, reason := choose(pools, &w, replicas)
+			if chosen == nil {
+				if unplaced == nil {
+					unplaced = &Unplaced{Workload: w.Name, Reason: reason}
+					plan.Unplaced = append(plan.Unplaced, unplaced)
+				}
+				unplaced.Count++
+				plan.Fits = false
+				continue
+			}
+			placement := &Placement{Workload: w.Name, Index: i}
+			for _, p := range chosen {
+				p.free -= w.Size
+				p.node.Planned += w.Size
+				plan.PlannedBytes += w.Size
+				placement.Nodes = append(placement.Nodes, p.node.NodeId)
+			}
+			plan.Placements = append(plan.Placements, placement)
+		}
+	}
+	return plan, nil
+}
+
+// choose returns one pool per replica, on distinct nodes, or the reason the
+// volume does not fit.
+func choose(pools []*pool, w *Workload, replicas int64) ([]*pool, string) {
+	candidates := make([]*pool, 0, len(pools))
+	nodes := make(map[string]bool)
+	for _, p := range pools {
+		if w.Cos != api.CosType_NONE && p.cos != w.Cos {
+			continue
+		}
+		nodes[p.node.NodeId] = true
+		if p.free >= w.Size {
+			candidates = append(candidates, p)
+		}
+	}
+	if int64(len(nodes)) < replicas {
+		return nil, fmt.Sprintf("HA level %d requires %d nodes with %s pools, found %d",
+			replicas, replicas, w.Cos.SimpleString(), len(nodes))
+	}
+	// Most free space first, by node id for a stable plan.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].free != candidates[j].free {
+			return candidates[i].free > candidates[j].free
+		}
+		return candidates[i].node.NodeId < candidates[j].node.NodeId
+	})
+
+	var chosen []*pool
+	used := make(map[string]bool)
+	for r := 0; r < int(replicas); r++ {
+		var best *pool
+		var bestPreference int64
+		constrained := false
+		for _, p := range candidates {
+			if used[p.node.NodeId] {
+				continue
+			}
+			if !Allowed(w.Placement, p.labels, r) {
+				constrained = true
+				continue
+			}
+			pref := preference(w.Placement, p.labels, r)
+			if best == nil || pref > bestPreference {
+				best, bestPreference = p, pref
+			}
+		}
+		if best == nil {
+			if constrained {
+				return nil, fmt.Sprintf("No node with free capacity satisfies the placement rules of replica %d", r)
+			}
+			return nil, fmt.Sprintf("Not enough free capacity for %d replicas of %d bytes",
+				replicas, w.Size)
+		}
+		used[best.node.NodeId] = true
+		chosen = append(chosen, best)
+	}
+	return chosen, ""
+}
@@ -0,0 +1,114 @@
+package planner
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const oneGB = uint64(1000 * 1000 * 1000)
+
+func testNodes() []api.Node {
+	return []api.Node{
+		{
+			Id:     "node1",
+			Status: api.Status_STATUS_OK,
+			Pools: []api.StoragePool{
+				{Cos: api.CosType_HIGH, TotalSize: 1000 * oneGB, Used: 100 * oneGB},
+			},
+		},
+		{
+			Id:     "node2",
+			Status: api.Status_STATUS_OK,
+			Pools: []api.StoragePool{
+				{Cos: api.CosType_HIGH, TotalSize: 1000 * oneGB},
+				{Cos: api.CosType_LOW, TotalSize: 4000 * oneGB},
+			},
+		},
+		{
+			Id:     "node3",
+			Status: api.Status_STATUS_OFFLINE,
+			Pools: []api.StoragePool{
+				{Cos: api.CosType_HIGH, TotalSize: 1000 * oneGB},
+			},
+		},
+	}
+}
+
+func TestSimulateFits(t *testing.T) {
+	plan, err := Simulate(testNodes(), &Request{
+		Workloads: []Workload{
+			{Name: "db", Count: 8, Size: 100 * oneGB, HaLevel: 2, Cos: api.CosType_HIGH},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, plan.Fits)
+	assert.Empty(t, plan.Unplaced)
+	require.Len(t, plan.Placements, 8)
+	for _, p := range plan.Placements {
+		sort.Strings(p.Nodes)
+		assert.Equal(t, []string{"node1", "node2"}, p.Nodes)
+	}
+	assert.Equal(t, 1600*oneGB, plan.RequiredBytes)
+	assert.Equal(t, 1600*oneGB, plan.PlannedBytes)
+	assert.Equal(t, 800*oneGB, plan.Nodes[0].Planned)
+	assert.Equal(t, 100*oneGB, plan.Nodes[0].Free())
+	assert.Equal(t, uint64(0), plan.Nodes[2].TotalSize)
+}
+
+func TestSimulateDoesNotFit(t *testing.T) {
+	nodes := testNodes()
+	plan, err := Simulate(nodes, &Request{
+		Workloads: []Workload{
+			{Name: "db", Count: 200, Size: 100 * oneGB, HaLevel: 2},
+		},
+	})
+	require.NoError(t, err)
+	assert.False(t, plan.Fits)
+	require.Len(t, plan.Unplaced, 1)
+	assert.Equal(t, "db", plan.Unplaced[0].Workload)
+	assert.Equal(t, 200-len(plan.Placements), plan.Unplaced[0].Count)
+	// Replicas spill over to the low pool of node2, but both replicas of a
+	// volume never share a node, so node1 caps the plan.
+	assert.Equal(t, 9, len(plan.Placements))
+	// Input nodes are not modified.
+	assert.Equal(t, 100*oneGB, nodes[0].Pools[0].Used)
+}
+
+func TestSimulateAddNodes(t *testing.T) {
+	plan, err := Simulate(testNodes(), &Request{
+		Workloads: []Workload{
+			{Name: "db", Count: 10, Size: 100 * oneGB, HaLevel: 3, Cos: api.CosType_HIGH},
+		},
+	})
+	require.NoError(t, err)
+	assert.False(t, plan.Fits)
+	assert.Equal(t, 10, plan.Unplaced[0].Count)
+	assert.Contains(t, plan.Unplaced[0].Reason, "requires 3 nodes")
+
+	plan, err = Simulate(testNodes(), &Request{
+		Workloads: []Workload{
+			{Name: "db", Count: 9, Size: 100 * oneGB, HaLevel: 3, Cos: api.CosType_HIGH},
+		},
+		AddNodes: []api.Node{
+			{
+				Id:    "new1",
+				Pools: []api.StoragePool{{Cos: api.CosType_HIGH, TotalSize: 2000 * oneGB}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, plan.Fits)
+	assert.Len(t, plan.Placements, 9)
+}
+
+func TestSimulateInvalid(t *testing.T) {
+	_, err := Simulate(testNodes(), &Request{Workloads: []Workload{{Name: "db", Size: oneGB}}})
+	assert.Error(t, err)
+
+	_, err = Simulate(testNodes(), &Request{AddNodes: []api.Node{{Id: "node1"}}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,346 @@
+// Package preflight verifies the kernel prerequisites of the volume drivers,
+// such as their kernel modules and sysctls, before they are initialized, and
+// reports the failures with a hint to fix them.
+package preflight
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// DefaultChecks are the checks of the volume drivers, by driver name,
+	// run before the checks configured
+	DefaultChecks = map[string][]Check{
+		"btrfs": {{Module: "btrfs"}},
+		"buse":  {{Module: "nbd"}},
+		"nfs":   {{Module: "nfs"}},
+	}
+
+	// ErrNotInitialized returned when the checker has not been initialized
+	ErrNotInitialized = errors.New("openstorage.preflight: not initialized")
+	// ErrInitialized returned when the checker is initialized twice
+	ErrInitialized = errors.New("openstorage.preflight: already initialized")
+
+	inst *Checker
+)
+
+// Config configures the preflight checks of this node.
+type Config struct {
+	// Checks are added to the DefaultChecks of the drivers, by driver name,
+	// such as a dm_crypt module for the encrypted volumes
+	Checks map[string][]Check `yaml:"checks"`
+	// AutoLoad loads the missing kernel modules with modprobe
+	AutoLoad bool `yaml:"auto_load"`
+	// Enforce fails the initialization of a driver whose checks fail,
+	// instead of only reporting the failures
+	Enforce bool `yaml:"enforce"`
+}
+
+// Check is a kernel prerequisite of a driver, either a kernel module,
+// loaded or built in, or a sysctl.
+type Check struct {
+	// Module is the name of the kernel module, such as nbd
+	Module string `yaml:"module"`
+	// Sysctl is the name of the kernel parameter, such as fs.aio-max-nr
+	Sysctl string `yaml:"sysctl"`
+	// Value of Sysctl required, its minimum if numeric
+	Value string `yaml:"value"`
+	// Hint tells how to fix a failure, the modprobe or sysctl command if
+	// unset
+	Hint string `yaml:"hint"`
+}
+
+// Name describes the check, such as "module nbd".
+func (c *Check) Name() string {
+	if len(c.Module) != 0 {
+		return "module " + c.Module
+	}
+	return "sysctl " + c.Sysctl
+}
+
+// Result is the outcome of a check.
+// swagger:model
+type Result struct {
+	// Check is the name of the check
+	Check string
+	// Passed is true if the prerequisite is met
+	Passed bool
+	// Loaded is true if the module was missing and loaded by the check
+	Loaded bool
+	// Error tells why the check failed
+	Error string
+	// Hint tells how to fix the failure
+	Hint string
+}
+
+// Status is the outcome of the checks of the drivers.
+// swagger:model
+type Status struct {
+	// Passed is true if every check passed
+	Passed bool
+	// LastRun is when the checks last ran
+	LastRun time.Time
+	// Drivers are the results of the checks, by driver name
+	Drivers map[string][]Result
+}
+
+// FailedError is returned when the checks of a driver fail.
+type FailedError struct {
+	// Driver name
+	Driver string
+	// Failures are the results of the checks failed
+	Failures []Result
+}
+
+func (e *FailedError) Error() string {
+	failures := make([]string, 0, len(e.Failures))
+	for _, r := range e.Failures {
+		failure := r.Check + ": " + r.Error
+		if len(r.Hint) != 0 {
+			failure += ", " + r.Hint
+		}
+		failures = append(failures, failure)
+	}
+	return fmt.Sprintf("Preflight checks of driver %s failed: %s", e.Driver, strings.Join(failures, "; "))
+}
+
+// Checker runs the checks of the drivers and keeps their results.
+type Checker struct {
+	config Config
+	// root is the root of /proc and /sys
+	root string
+	// modprobe loads a kernel module
+	modprobe func(module string) error
+
+	lock    sync.Mutex
+	lastRun time.Time
+	results map[string][]Result
+}
+
+// New returns a checker of the prerequisites of this node.
+func New(config Config) *Checker {
+	return newChecker(config, "/", modprobe)
+}
+
+func newChecker(config Config, root string, modprobe func(module string) error) *Checker {
+	return &Checker{
+		config:   config,
+		root:     root,
+		modprobe: modprobe,
+		results:  make(map[string][]Result),
+	}
+}
+
+// Init sets the checker singleton.
+func Init(c *Checker) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = c
+	return nil
+}
+
+// Inst returns the checker singleton.
+func Inst() (*Checker, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// Run runs the checks of driver and returns their results. A FailedError is
+// returned if a check failed and the checks are enforced, the failures are
+// only logged otherwise.
+func (c *Checker) Run(driver string) ([]Result, error) {
+	checks := append(append([]Check{}, DefaultChecks[driver]...), c.config.Checks[driver]...)
+	results := make([]Result, 0, len(checks))
+	var failures []Result
+	for _, check := range checks {
+		r := c.run(&check)
+		if !r.Passed {
+			failures = append(failures, r)
+			logrus.WithField("pkg", "openstorage/preflight").
+				WithField("driver", driver).
+				Warnf("Preflight check %s failed: %s, %s", r.Check, r.Error, r.Hint)
+		}
+		results = append(results, r)
+	}
+
+	c.lock.Lock()
+	c.results[driver] = results
+	c.lastRun = time.Now()
+	c.lock.Unlock()
+
+	if len(failures) != 0 && c.config.Enforce {
+		return results, &FailedError{Driver: driver, Failures: failures}
+	}
+	return results, nil
+}
+
+// run runs check, loading its module if missing and configured to.
+func (c *Checker) run(check *Check) Result {
+	r := Result{Check: check.Name(), Hint: check.Hint}
+	var err error
+	switch {
+	case len(check.Module) != 0:
+		if err = c.moduleLoaded(check.Module); err != nil && c.config.AutoLoad {
+			if loadErr := c.modprobe(check.Module); loadErr != nil {
+				err = fmt.Errorf("%v, failed to load it: %v", err, loadErr)
+			} else if err = c.moduleLoaded(check.Module); err == nil {
+				r.Loaded = true
+			}
+		}
+		if err != nil && len(r.Hint) == 0 {
+			r.Hint = "run modprobe " + check.Module
+		}
+	case len(check.Sysctl) != 0:
+		err = c.sysctl(check.Sysctl, check.Value)
+		if err != nil && len(r.Hint) == 0 {
+			r.Hint = fmt.Sprintf("run sysctl -w %s=%s", check.Sysctl, check.Value)
+		}
+	default:
+		err = fmt.Errorf("no module or sysctl to check")
+	}
+	if err != nil {
+		r.Error = err.Error()
+	} else {
+		r.Passed = true
+	}
+	return r
+}
+
+// moduleLoaded returns an error unless module is loaded or built in, as
+// listed by /sys/module.
+func (c *Checker) moduleLoaded(module string) error {
+	name := strings.Replace(module, "-", "_", -1)
+	if _, err := os.Stat(filepath.Join(c.root, "sys", "module", name)); os.IsNotExist(err) {
+		return fmt.Errorf("module %s is not loaded", module)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// sysctl returns an error unless the sysctl key is set to value, or to at
+// least value if numeric.
+func (c *Checker) sysctl(key, value string) error {
+	data, err := ioutil.ReadFile(filepath.Join(c.root, "proc", "sys", strings.Replace(key, ".", "/", -1)))
+	if os.IsNotExist(err) {
+		return fmt.Errorf("sysctl %s does not exist", key)
+	} else if err != nil {
+		return err
+	}
+	current := strings.TrimSpace(string(data))
+	if min, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if v, err := strconv.ParseInt(current, 10, 64); err == nil {
+			if v < min {
+				return fmt.Errorf("sysctl %s is %d, at least %d required", key, v, min)
+			}
+			return nil
+		}
+	}
+	if current != value {
+		return fmt.Errorf("sysctl %s is %q, %q required", key, current, value)
+	}
+	return nil
+}
+
+// Status returns the results of the checks last run.
+func (c *Checker) Status() *Status {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	s := &Status{
+		Passed:  true,
+		LastRun: c.lastRun,
+		Drivers: make(map[string][]Result, len(c.results)),
+	}
+	for driver, results := range c.results {
+		s.Drivers[driver] = append([]Result{}, results...)
+		for _, r := range results {
+			if !r.Passed {
+				s.Passed = false
+			}
+		}
+	}
+	return s
+}
+
+// Wrap returns d reporting the results of the checks of driver in its
+// Status.
+func (c *Checker) Wrap(driver string) func(volume.VolumeDriver) volume.VolumeDriver {
+	return func(d volume.VolumeDriver) volume.VolumeDriver {
+		return &checkedDriver{VolumeDriver: d, checker: c, driver: driver}
+	}
+}
+
+// checkedDriver is a volume driver whose status includes the results of its
+// preflight checks.
+type checkedDriver struct {
+	volume.VolumeDriver
+	checker *Checker
+	driver  string
+}
+
+// Status returns the status of the driver followed by a line per check.
+func (d *checkedDriver) Status() [][2]string {
+	status := d.VolumeDriver.Status()
+	d.checker.lock.Lock()
+	defer d.checker.lock.Unlock()
+	for _, r := range d.checker.results[d.driver] {
+		line := "ok"
+		if !r.Passed {
+			line = "failed: " + r.Error
+			if len(r.Hint) != 0 {
+				line += ", " + r.Hint
+			}
+		}
+		status = append(status, [2]string{"Preflight " + r.Check, line})
+	}
+	return status
+}
+
+// DriverStatus returns the structured status of the driver followed by a
+// component per check, up if it passed at the last run.
+func (d *checkedDriver) DriverStatus() []*api.DriverStatus {
+	status := volume.StatusOf(d.VolumeDriver)
+	d.checker.lock.Lock()
+	defer d.checker.lock.Unlock()
+	for _, r := range d.checker.results[d.driver] {
+		s := &api.DriverStatus{
+			Component: "Preflight " + r.Check,
+			State:     api.DriverStatusUp,
+			Since:     d.checker.lastRun,
+		}
+		if !r.Passed {
+			s.State = api.DriverStatusDown
+			s.Details = r.Error
+			if len(r.Hint) != 0 {
+				s.Details += ", " + r.Hint
+			}
+		}
+		status = append(status, s)
+	}
+	return status
+}
+
+// modprobe loads module into the kernel.
+func modprobe(module string) error {
+	out, err := exec.Command("modprobe", module).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package preflight
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRoot returns a root with the modules and sysctls given.
+func newRoot(t *testing.T, modules []string, sysctls map[string]string) string {
+	root, err := ioutil.TempDir("", "preflight")
+	require.NoError(t, err)
+	for _, module := range modules {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "sys", "module", module), 0755))
+	}
+	for key, value := range sysctls {
+		p := filepath.Join(root, "proc", "sys", key)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, ioutil.WriteFile(p, []byte(value+"\n"), 0644))
+	}
+	return root
+}
+
+func TestRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mock.NewMockVolumeDriver(ctrl)
+	root := newRoot(t, []string{"nbd"}, map[string]string{
+		"fs/aio-max-nr":    "65536",
+		"vm/max_map_count": "262144",
+	})
+	defer os.RemoveAll(root)
+
+	c := newChecker(Config{Checks: map[string][]Check{
+		"buse": {
+			{Module: "dm-crypt"},
+			{Sysctl: "fs.aio-max-nr", Value: "1048576"},
+			{Sysctl: "vm.max_map_count", Value: "65530"},
+		},
+	}}, root, nil)
+	results, err := c.Run("buse")
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	assert.True(t, results[0].Passed)
+	assert.Equal(t, "module nbd", results[0].Check)
+	assert.False(t, results[1].Passed)
+	assert.Equal(t, "run modprobe dm-crypt", results[1].Hint)
+	assert.False(t, results[2].Passed)
+	assert.Equal(t, "run sysctl -w fs.aio-max-nr=1048576", results[2].Hint)
+	assert.True(t, results[3].Passed)
+
+	status := c.Status()
+	assert.False(t, status.Passed)
+	assert.Len(t, status.Drivers["buse"], 4)
+
+	// the status of the driver is followed by the checks
+	m.EXPECT().Status().Return([][2]string{{"Driver", "fake"}}).Times(2)
+	d := c.Wrap("buse")(m)
+	lines := d.Status()
+	require.Len(t, lines, 5)
+	assert.Equal(t, [2]string{"Preflight module nbd", "ok"}, lines[1])
+	assert.Contains(t, lines[2][1], "failed: module dm-crypt is not loaded")
+
+	structured := volume.StatusOf(d)
+	require.Len(t, structured, 5)
+	assert.Equal(t, api.DriverStatusUnknown, structured[0].State)
+	assert.Equal(t, "fake", structured[0].Details)
+	assert.Equal(t, api.DriverStatusUp, structured[1].State)
+	assert.Equal(t, api.DriverStatusDown, structured[2].State)
+	assert.Equal(t, status.LastRun, structured[2].Since)
+	assert.Equal(t, "down: "+structured[2].Details, volume.StatusLines(structured)[2][1])
+
+	c.config.Enforce = true
+	_, err = c.Run("buse")
+	require.Error(t, err)
+	failed, ok := err.(*FailedError)
+	require.True(t, ok)
+	assert.Len(t, failed.Failures, 2)
+
+	// drivers without checks pass
+	results, err = c.Run("vfs")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestAutoLoad(t *testing.T) {
+	root := newRoot(t, nil, nil)
+	defer os.RemoveAll(root)
+
+	var loaded []string
+	c := newChecker(Config{AutoLoad: true, Enforce: true}, root, func(module string) error {
+		loaded = append(loaded, module)
+		return os.MkdirAll(filepath.Join(root, "sys", "module", module), 0755)
+	})
+	results, err := c.Run("btrfs")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.True(t, results[0].Passed)
+	assert.True(t, results[0].Loaded)
+	assert.Equal(t, []string{"btrfs"}, loaded)
+	assert.True(t, c.Status().Passed)
+
+	// loaded modules are not loaded again
+	results, err = c.Run("btrfs")
+	require.NoError(t, err)
+	assert.False(t, results[0].Loaded)
+	assert.Len(t, loaded, 1)
+}
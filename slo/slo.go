@@ -0,0 +1,198 @@
+// Package slo tracks service level objectives, such as mount latency and
+// API availability, and raises burn rate alerts through the alerts manager
+// when their error budgets are being depleted.
+package slo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	// IndicatorLatency counts an event as good when it succeeds within the
+	// objective threshold
+	IndicatorLatency = "latency"
+	// IndicatorAvailability counts an event as good when it succeeds
+	IndicatorAvailability = "availability"
+
+	// OperationAPI is a REST API request, failed when the response is a 5xx
+	OperationAPI = "api"
+	// OperationMount is a volume mount
+	OperationMount = "mount"
+	// OperationAttach is a volume attach
+	OperationAttach = "attach"
+
+	// DefaultWindow is the error budget window when none is configured
+	DefaultWindow = 30 * 24 * time.Hour
+	// DefaultInterval is how often objectives are evaluated when not configured
+	DefaultInterval = time.Minute
+)
+
+// Alert types raised on the cluster resource for an objective, with the
+// objective name as resource id.
+const (
+	// AlertTypeBase is the base of the alert types of the slo component
+	AlertTypeBase int64 = 4000
+	// AlertTypeFastBurn is raised when the error budget burns at more than
+	// FastBurnRate over both the long and short fast burn windows
+	AlertTypeFastBurn = AlertTypeBase + 1
+	// AlertTypeSlowBurn is raised when the error budget burns at more than
+	// SlowBurnRate over both the long and short slow burn windows
+	AlertTypeSlowBurn = AlertTypeBase + 2
+	// AlertTypeBudgetExhausted is raised when the error budget of the
+	// window is used up
+	AlertTypeBudgetExhausted = AlertTypeBase + 3
+)
+
+func init() {
+	alerts.MustRegisterComponent("slo", AlertTypeBase).MustRegisterReasons(
+		alerts.Reason{
+			AlertType:   AlertTypeFastBurn,
+			Code:        "SLO_FAST_BURN",
+			Resource:    api.ResourceType_RESOURCE_TYPE_CLUSTER,
+			Description: "the error budget of the objective burns fast",
+		},
+		alerts.Reason{
+			AlertType:   AlertTypeSlowBurn,
+			Code:        "SLO_SLOW_BURN",
+			Resource:    api.ResourceType_RESOURCE_TYPE_CLUSTER,
+			Description: "the error budget of the objective burns slowly",
+		},
+		alerts.Reason{
+			AlertType:   AlertTypeBudgetExhausted,
+			Code:        "SLO_BUDGET_EXHAUSTED",
+			Resource:    api.ResourceType_RESOURCE_TYPE_CLUSTER,
+			Description: "the error budget of the objective is used up",
+		},
+	)
+}
+
+// Burn rate thresholds and windows. A burn rate of 1 uses up the error
+// budget exactly at the end of a 30 day window; 14.4 uses 2% of it in an
+// hour and 6 uses 5% of it in six hours.
+const (
+	FastBurnRate        = 14.4
+	FastBurnLongWindow  = time.Hour
+	FastBurnShortWindow = 5 * time.Minute
+	SlowBurnRate        = 6
+	SlowBurnLongWindow  = 6 * time.Hour
+	SlowBurnShortWindow = 30 * time.Minute
+)
+
+var (
+	// ErrNotInitialized returned when the tracker has not been initialized
+	ErrNotInitialized = errors.New("openstorage.slo: not initialized")
+	// ErrInitialized returned when the tracker is initialized twice
+	ErrInitialized = errors.New("openstorage.slo: already initialized")
+
+	inst *Tracker
+)
+
+// Objective defines a service level objective.
+// swagger:model
+type Objective struct {
+	// Name uniquely identifies the objective
+	Name string `yaml:"name"`
+	// Operation measured, one of the Operation* values
+	Operation string `yaml:"operation"`
+	// Indicator is IndicatorLatency or IndicatorAvailability
+	Indicator string `yaml:"indicator"`
+	// Threshold is the latency under which an operation is good
+	Threshold time.Duration `yaml:"threshold"`
+	// Target is the fraction of good operations, for example 0.99 for a
+	// p99 latency objective or 0.999 for 99.9% availability
+	Target float64 `yaml:"target"`
+	// Window of the error budget, DefaultWindow if unset
+	Window time.Duration `yaml:"window"`
+}
+
+// Validate checks the objective definition.
+func (o *Objective) Validate() error {
+	if len(o.Name) == 0 {
+		return fmt.Errorf("SLO requires a name")
+	}
+	if len(o.Operation) == 0 {
+		return fmt.Errorf("SLO %s requires an operation", o.Name)
+	}
+	switch o.Indicator {
+	case IndicatorAvailability:
+	case IndicatorLatency:
+		if o.Threshold <= 0 {
+			return fmt.Errorf("Latency SLO %s requires a threshold", o.Name)
+		}
+	default:
+		return fmt.Errorf("SLO %s has unknown indicator %q", o.Name, o.Indicator)
+	}
+	if o.Target <= 0 || o.Target >= 1 {
+		return fmt.Errorf("SLO %s target must be between 0 and 1", o.Name)
+	}
+	return nil
+}
+
+// good returns true if an operation meets the objective.
+func (o *Objective) good(latency time.Duration, err error) bool {
+	if err != nil {
+		return false
+	}
+	return o.Indicator != IndicatorLatency || latency <= o.Threshold
+}
+
+// Status is the state of an objective.
+// swagger:model
+type Status struct {
+	// Objective evaluated
+	Objective Objective
+	// Good is the number of good operations in the window
+	Good uint64
+	// Total is the number of operations in the window
+	Total uint64
+	// BudgetRemaining is the fraction of the error budget left in the
+	// window. It is negative when the budget is overspent.
+	BudgetRemaining float64
+	// BurnRates are the burn rates over the alerting windows, keyed by
+	// window duration
+	BurnRates map[string]float64
+}
+
+// Config configures SLO tracking.
+type Config struct {
+	// Objectives to track, SLO tracking is disabled if empty
+	Objectives []Objective `yaml:"objectives"`
+	// Interval between evaluations, DefaultInterval if unset
+	Interval time.Duration `yaml:"interval"`
+}
+
+// Enabled returns true if objectives are configured.
+func (c *Config) Enabled() bool {
+	return len(c.Objectives) != 0
+}
+
+// Init instantiates the process wide tracker.
+func Init(t *Tracker) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = t
+	return nil
+}
+
+// Inst returns the process wide tracker.
+func Inst() (*Tracker, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// Observe records an operation on the process wide tracker. It does nothing
+// if the tracker has not been initialized.
+func Observe(operation string, latency time.Duration, err error) {
+	if inst == nil {
+		return
+	}
+	inst.Observe(operation, latency, err)
+}
@@ -0,0 +1,224 @@
+package slo
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/sirupsen/logrus"
+)
+
+// bucketSize is the resolution at which operations are counted.
+const bucketSize = time.Minute
+
+type counts struct {
+	good  uint64
+	total uint64
+}
+
+// objectiveState holds the counts of an objective per bucket.
+type objectiveState struct {
+	objective Objective
+	buckets   map[int64]*counts
+	// raised alert types, so that they are cleared when resolved
+	raised map[int64]bool
+}
+
+// Tracker counts operations against objectives and raises alerts.
+type Tracker struct {
+	sync.Mutex
+	states []*objectiveState
+	alerts alerts.Manager
+}
+
+// NewTracker returns a tracker for the objectives that raises alerts with
+// manager.
+func NewTracker(objectives []Objective, manager alerts.Manager) (*Tracker, error) {
+	t := &Tracker{alerts: manager}
+	names := make(map[string]bool)
+	for _, o := range objectives {
+		if err := o.Validate(); err != nil {
+			return nil, err
+		}
+		if names[o.Name] {
+			return nil, fmt.Errorf("Duplicate SLO %s", o.Name)
+		}
+		names[o.Name] = true
+		if o.Window == 0 {
+			o.Window = DefaultWindow
+		}
+		t.states = append(t.states, &objectiveState{
+			objective: o,
+			buckets:   make(map[int64]*counts),
+			raised:    make(map[int64]bool),
+		})
+	}
+	return t, nil
+}
+
+// Observe records an operation completed now.
+func (t *Tracker) Observe(operation string, latency time.Duration, err error) {
+	t.observe(time.Now(), operation, latency, err)
+}
+
+func (t *Tracker) observe(now time.Time, operation string, latency time.Duration, err error) {
+	t.Lock()
+	defer t.Unlock()
+
+	bucket := now.Truncate(bucketSize).Unix()
+	for _, s := range t.states {
+		if s.objective.Operation != operation {
+			continue
+		}
+		c, ok := s.buckets[bucket]
+		if !ok {
+			c = &counts{}
+			s.buckets[bucket] = c
+		}
+		c.total++
+		if s.objective.good(latency, err) {
+			c.good++
+		}
+	}
+}
+
+// Status returns the state of every objective at now.
+func (t *Tracker) Status(now time.Time) []*Status {
+	t.Lock()
+	defer t.Unlock()
+
+	statuses := make([]*Status, 0, len(t.states))
+	for _, s := range t.states {
+		statuses = append(statuses, s.status(now))
+	}
+	return statuses
+}
+
+// Evaluate raises the alerts of objectives burning their error budget too
+// fast, and clears the alerts of objectives that recovered.
+func (t *Tracker) Evaluate(now time.Time) error {
+	t.Lock()
+	defer t.Unlock()
+
+	for _, s := range t.states {
+		s.prune(now)
+		st := s.status(now)
+		burn := func(long, short time.Duration, rate float64) bool {
+			return st.BurnRates[long.String()] > rate && st.BurnRates[short.String()] > rate
+		}
+		conditions := []struct {
+			alertType int64
+			severity  api.SeverityType
+			firing    bool
+			message   string
+		}{
+			{
+				AlertTypeFastBurn,
+				api.SeverityType_SEVERITY_TYPE_ALARM,
+				burn(FastBurnLongWindow, FastBurnShortWindow, FastBurnRate),
+				fmt.Sprintf("SLO %s is burning its error budget at %.1fx over the last %v",
+					s.objective.Name, st.BurnRates[FastBurnLongWindow.String()], FastBurnLongWindow),
+			},
+			{
+				AlertTypeSlowBurn,
+				api.SeverityType_SEVERITY_TYPE_WARNING,
+				burn(SlowBurnLongWindow, SlowBurnShortWindow, SlowBurnRate),
+				fmt.Sprintf("SLO %s is burning its error budget at %.1fx over the last %v",
+					s.objective.Name, st.BurnRates[SlowBurnLongWindow.String()], SlowBurnLongWindow),
+			},
+			{
+				AlertTypeBudgetExhausted,
+				api.SeverityType_SEVERITY_TYPE_ALARM,
+				st.Total != 0 && st.BudgetRemaining <= 0,
+				fmt.Sprintf("SLO %s has exhausted its error budget for the last %v",
+					s.objective.Name, s.objective.Window),
+			},
+		}
+		for _, c := range conditions {
+			if !c.firing && !s.raised[c.alertType] {
+				continue
+			}
+			alert := &api.Alert{
+				AlertType:  c.alertType,
+				Severity:   c.severity,
+				Resource:   api.ResourceType_RESOURCE_TYPE_CLUSTER,
+				ResourceId: s.objective.Name,
+				Message:    c.message,
+				Cleared:    !c.firing,
+			}
+			if !c.firing {
+				alert.Message = fmt.Sprintf("SLO %s recovered", s.objective.Name)
+			}
+			if err := t.alerts.Raise(alert); err != nil {
+				return err
+			}
+			s.raised[c.alertType] = c.firing
+		}
+	}
+	return nil
+}
+
+// Start evaluates the objectives at every interval.
+func (t *Tracker) Start(interval time.Duration) {
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+	go func() {
+		for now := range time.Tick(interval) {
+			if err := t.Evaluate(now); err != nil {
+				logrus.WithField("pkg", "openstorage/slo").
+					Warnf("failed to evaluate SLOs: %v", err)
+			}
+		}
+	}()
+}
+
+func (s *objectiveState) prune(now time.Time) {
+	cutoff := now.Add(-s.objective.Window).Unix()
+	for b := range s.buckets {
+		if b < cutoff {
+			delete(s.buckets, b)
+		}
+	}
+}
+
+// sum returns the counts of the buckets within window of now.
+func (s *objectiveState) sum(now time.Time, window time.Duration) counts {
+	var total counts
+	cutoff := now.Add(-window).Unix()
+	for b, c := range s.buckets {
+		if b >= cutoff && b <= now.Unix() {
+			total.good += c.good
+			total.total += c.total
+		}
+	}
+	return total
+}
+
+func (s *objectiveState) status(now time.Time) *Status {
+	budget := 1 - s.objective.Target
+	burnRate := func(c counts) float64 {
+		if c.total == 0 {
+			return 0
+		}
+		return float64(c.total-c.good) / float64(c.total) / budget
+	}
+
+	window := s.sum(now, s.objective.Window)
+	st := &Status{
+		Objective:       s.objective,
+		Good:            window.good,
+		Total:           window.total,
+		BudgetRemaining: 1 - burnRate(window),
+		BurnRates:       make(map[string]float64),
+	}
+	for _, w := range []time.Duration{
+		FastBurnLongWindow, FastBurnShortWindow,
+		SlowBurnLongWindow, SlowBurnShortWindow,
+	} {
+		st.BurnRates[w.String()] = burnRate(s.sum(now, w))
+	}
+	return st
+}
@@ -0,0 +1,154 @@
+package slo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTest = errors.New("failed")
+
+func mountLatency() Objective {
+	return Objective{
+		Name:      "mount-latency-p99",
+		Operation: OperationMount,
+		Indicator: IndicatorLatency,
+		Threshold: 2 * time.Second,
+		Target:    0.99,
+	}
+}
+
+func newTestTracker(t *testing.T, objectives ...Objective) (*Tracker, alerts.Manager) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	manager, err := alerts.NewManager(kv)
+	require.NoError(t, err)
+	tracker, err := NewTracker(objectives, manager)
+	require.NoError(t, err)
+	return tracker, manager
+}
+
+func raisedAlerts(t *testing.T, manager alerts.Manager) map[int64]*api.Alert {
+	list, err := manager.Enumerate()
+	require.NoError(t, err)
+	raised := make(map[int64]*api.Alert)
+	for _, a := range list {
+		raised[a.AlertType] = a
+	}
+	return raised
+}
+
+func TestValidate(t *testing.T) {
+	o := mountLatency()
+	assert.NoError(t, o.Validate())
+
+	o.Threshold = 0
+	assert.Error(t, o.Validate())
+
+	o = mountLatency()
+	o.Target = 1
+	assert.Error(t, o.Validate())
+
+	o = mountLatency()
+	o.Indicator = "throughput"
+	assert.Error(t, o.Validate())
+
+	_, err := NewTracker([]Objective{mountLatency(), mountLatency()}, nil)
+	assert.Error(t, err)
+}
+
+func TestStatus(t *testing.T) {
+	tracker, _ := newTestTracker(t, mountLatency(), Objective{
+		Name:      "api-availability",
+		Operation: OperationAPI,
+		Indicator: IndicatorAvailability,
+		Target:    0.999,
+	})
+	now := time.Now()
+
+	for i := 0; i < 98; i++ {
+		tracker.observe(now, OperationMount, time.Second, nil)
+	}
+	tracker.observe(now, OperationMount, 3*time.Second, nil)
+	tracker.observe(now, OperationMount, time.Second, errTest)
+	tracker.observe(now, OperationAPI, 3*time.Second, nil)
+
+	statuses := tracker.Status(now)
+	require.Len(t, statuses, 2)
+
+	mount := statuses[0]
+	assert.Equal(t, uint64(98), mount.Good)
+	assert.Equal(t, uint64(100), mount.Total)
+	assert.InDelta(t, -1, mount.BudgetRemaining, 0.0001)
+	assert.InDelta(t, 2, mount.BurnRates[FastBurnShortWindow.String()], 0.0001)
+
+	availability := statuses[1]
+	assert.Equal(t, uint64(1), availability.Good)
+	assert.Equal(t, uint64(1), availability.Total)
+	assert.InDelta(t, 1, availability.BudgetRemaining, 0.0001)
+
+	// Observations older than the burn rate windows only count against the
+	// whole window.
+	later := now.Add(7 * time.Hour)
+	mount = tracker.Status(later)[0]
+	assert.Equal(t, uint64(100), mount.Total)
+	assert.Equal(t, float64(0), mount.BurnRates[SlowBurnLongWindow.String()])
+}
+
+func TestEvaluate(t *testing.T) {
+	tracker, manager := newTestTracker(t, mountLatency())
+	now := time.Now()
+
+	for i := 0; i < 100; i++ {
+		tracker.observe(now, OperationMount, time.Second, nil)
+	}
+	require.NoError(t, tracker.Evaluate(now))
+	assert.Empty(t, raisedAlerts(t, manager))
+
+	// 20% failures burns the budget at 20x.
+	for i := 0; i < 25; i++ {
+		tracker.observe(now, OperationMount, time.Second, errTest)
+	}
+	require.NoError(t, tracker.Evaluate(now))
+	raised := raisedAlerts(t, manager)
+	require.Contains(t, raised, AlertTypeFastBurn)
+	require.Contains(t, raised, AlertTypeSlowBurn)
+	require.Contains(t, raised, AlertTypeBudgetExhausted)
+	fast := raised[AlertTypeFastBurn]
+	assert.Equal(t, api.SeverityType_SEVERITY_TYPE_ALARM, fast.Severity)
+	assert.Equal(t, api.ResourceType_RESOURCE_TYPE_CLUSTER, fast.Resource)
+	assert.Equal(t, "mount-latency-p99", fast.ResourceId)
+	assert.False(t, fast.Cleared)
+	assert.Equal(t, api.SeverityType_SEVERITY_TYPE_WARNING,
+		raised[AlertTypeSlowBurn].Severity)
+
+	// Once the failures are outside the burn rate windows the burn rate
+	// alerts clear, while the budget stays exhausted for the window.
+	later := now.Add(7 * time.Hour)
+	tracker.observe(later, OperationMount, time.Second, nil)
+	require.NoError(t, tracker.Evaluate(later))
+	raised = raisedAlerts(t, manager)
+	assert.True(t, raised[AlertTypeFastBurn].Cleared)
+	assert.True(t, raised[AlertTypeSlowBurn].Cleared)
+	assert.False(t, raised[AlertTypeBudgetExhausted].Cleared)
+
+	// Budget recovers when the window rolls over.
+	muchLater := now.Add(DefaultWindow + time.Hour)
+	tracker.observe(muchLater, OperationMount, time.Second, nil)
+	require.NoError(t, tracker.Evaluate(muchLater))
+	assert.True(t, raisedAlerts(t, manager)[AlertTypeBudgetExhausted].Cleared)
+}
+
+func TestObserveNotInitialized(t *testing.T) {
+	// Must not panic without a tracker.
+	Observe(OperationMount, time.Second, nil)
+	_, err := Inst()
+	assert.Equal(t, ErrNotInitialized, err)
+}
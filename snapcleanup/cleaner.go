@@ -0,0 +1,129 @@
+package snapcleanup
+
+import (
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/eventbus"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+// lockKey serializes the sweeps of the nodes of a cluster
+const lockKey = "snapcleanup/lock"
+
+// Cleaner sweeps the snapshots of a volume driver for the ones orphaned by
+// the deletion of their parent volume.
+type Cleaner struct {
+	kv     kvdb.Kvdb
+	driver Driver
+	config Config
+}
+
+// NewCleaner returns a cleaner of the orphaned snapshots of driver d, as
+// configured by c.
+func NewCleaner(kv kvdb.Kvdb, d Driver, c *Config) (*Cleaner, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &Cleaner{
+		kv:     kv,
+		driver: d,
+		config: *c,
+	}, nil
+}
+
+// Start sweeps the snapshots every interval.
+func (c *Cleaner) Start() {
+	go func() {
+		for range time.Tick(c.config.interval()) {
+			if _, err := c.Sweep(time.Now()); err != nil {
+				logrus.WithField("pkg", "openstorage/snapcleanup").
+					Warnf("Failed to sweep orphaned snapshots: %v", err)
+			}
+		}
+	}()
+}
+
+// Sweep marks the snapshots whose parent volume no longer exists with
+// api.LabelOrphanedSince at now, then preserves them or deletes those
+// marked for longer than the grace period. The nodes of a cluster sweep in
+// turn, so that a snapshot is marked once.
+func (c *Cleaner) Sweep(now time.Time) (*Report, error) {
+	lock, err := c.kv.Lock(lockKey)
+	if err != nil {
+		return nil, err
+	}
+	defer c.kv.Unlock(lock)
+
+	vols, err := c.driver.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[string]bool, len(vols))
+	for _, v := range vols {
+		exists[v.GetId()] = true
+	}
+
+	report := &Report{}
+	for _, v := range vols {
+		if !v.IsSnapshot() || exists[v.GetSource().GetParent()] {
+			continue
+		}
+		since, err := c.mark(v, now)
+		if err != nil {
+			logrus.WithField("pkg", "openstorage/snapcleanup").
+				Warnf("Failed to mark orphaned snapshot %s: %v", v.GetId(), err)
+			continue
+		}
+		switch c.config.Action {
+		case ActionPreserve:
+			report.Preserved = append(report.Preserved, v.GetId())
+		case ActionExpire:
+			if now.Sub(since) < c.config.gracePeriod() {
+				report.Pending = append(report.Pending, v.GetId())
+				continue
+			}
+			if err := c.driver.Delete(v.GetId()); err != nil {
+				logrus.WithField("pkg", "openstorage/snapcleanup").
+					Warnf("Failed to delete orphaned snapshot %s: %v", v.GetId(), err)
+				continue
+			}
+			logrus.WithField("pkg", "openstorage/snapcleanup").
+				Infof("Deleted snapshot %s orphaned since %v", v.GetId(), since)
+			eventbus.Publish(eventbus.EventVolumeDelete, v.GetId(), nil)
+			report.Expired = append(report.Expired, v.GetId())
+		}
+	}
+	return report, nil
+}
+
+// mark labels the orphaned snapshot v with the time its parent was found
+// deleted, now unless it was marked before, and moves it to the namespace
+// of the policy if preserved. It returns the time it was marked.
+func (c *Cleaner) mark(v *api.Volume, now time.Time) (time.Time, error) {
+	labels := v.GetLocator().GetVolumeLabels()
+	since, err := time.Parse(time.RFC3339, labels[api.LabelOrphanedSince])
+	marked := err == nil
+	if !marked {
+		since = now
+	}
+	preserve := c.config.Action == ActionPreserve
+	if marked && (!preserve || labels[api.LabelNamespace] == c.config.namespace()) {
+		return since, nil
+	}
+
+	updated := make(map[string]string, len(labels)+2)
+	for k, val := range labels {
+		updated[k] = val
+	}
+	updated[api.LabelOrphanedSince] = since.UTC().Format(time.RFC3339)
+	if preserve {
+		updated[api.LabelNamespace] = c.config.namespace()
+	}
+	locator := &api.VolumeLocator{
+		Name:         v.GetLocator().GetName(),
+		VolumeLabels: updated,
+	}
+	return since, c.driver.Set(v.GetId(), locator, nil)
+}
@@ -0,0 +1,115 @@
+package snapcleanup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testVolumes returns a volume with a snapshot, a clone and a snapshot
+// orphaned by the deletion of their parent, labeled with orphanLabels.
+func testVolumes(orphanLabels map[string]string) []*api.Volume {
+	return []*api.Volume{
+		{Id: "base", Locator: &api.VolumeLocator{Name: "base"}},
+		{Id: "snap", Readonly: true, Source: &api.Source{Parent: "base"},
+			Locator: &api.VolumeLocator{Name: "snap"}},
+		{Id: "orphan", Readonly: true, Source: &api.Source{Parent: "deleted"},
+			Locator: &api.VolumeLocator{Name: "orphan", VolumeLabels: orphanLabels}},
+		{Id: "clone", Source: &api.Source{Parent: "deleted"},
+			Locator: &api.VolumeLocator{Name: "clone"}},
+	}
+}
+
+func newTestCleaner(t *testing.T, d Driver, c *Config) *Cleaner {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	cleaner, err := NewCleaner(kv, d, c)
+	require.NoError(t, err)
+	return cleaner
+}
+
+func TestValidate(t *testing.T) {
+	assert.False(t, (&Config{}).Enabled())
+	assert.Error(t, (&Config{}).Validate())
+	assert.Error(t, (&Config{Action: "archive"}).Validate())
+	assert.Error(t, (&Config{Action: ActionExpire, GracePeriod: -time.Hour}).Validate())
+	assert.NoError(t, (&Config{Action: ActionPreserve}).Validate())
+}
+
+func TestSweepPreserve(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+	c := newTestCleaner(t, d, &Config{Action: ActionPreserve})
+
+	// snapshots of existing volumes and clones are left alone
+	now := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+	marked := map[string]string{
+		api.LabelNamespace:     DefaultNamespace,
+		api.LabelOrphanedSince: "2018-06-01T00:00:00Z",
+	}
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).
+		Return(testVolumes(map[string]string{api.LabelNamespace: "prod"}), nil)
+	d.EXPECT().Set("orphan", &api.VolumeLocator{Name: "orphan", VolumeLabels: marked}, nil).
+		Return(nil)
+	report, err := c.Sweep(now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orphan"}, report.Preserved)
+	assert.Empty(t, report.Expired)
+
+	// snapshots are preserved forever, marked once
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(testVolumes(marked), nil)
+	report, err = c.Sweep(now.Add(365 * 24 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orphan"}, report.Preserved)
+}
+
+func TestSweepExpire(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+	c := newTestCleaner(t, d, &Config{Action: ActionExpire, GracePeriod: time.Hour})
+
+	now := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+	marked := map[string]string{
+		api.LabelNamespace:     "prod",
+		api.LabelOrphanedSince: "2018-06-01T00:00:00Z",
+	}
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).
+		Return(testVolumes(map[string]string{api.LabelNamespace: "prod"}), nil)
+	d.EXPECT().Set("orphan", &api.VolumeLocator{Name: "orphan", VolumeLabels: marked}, nil).
+		Return(nil)
+	report, err := c.Sweep(now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orphan"}, report.Pending)
+	assert.Empty(t, report.Expired)
+
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(testVolumes(marked), nil)
+	report, err = c.Sweep(now.Add(30 * time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orphan"}, report.Pending)
+
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(testVolumes(marked), nil)
+	d.EXPECT().Delete("orphan").Return(nil)
+	report, err = c.Sweep(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orphan"}, report.Expired)
+
+	// the snapshots of the deleted parent become orphans in turn
+	vols := testVolumes(nil)
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return([]*api.Volume{vols[1], vols[3]}, nil)
+	d.EXPECT().Set("snap", &api.VolumeLocator{
+		Name:         "snap",
+		VolumeLabels: map[string]string{api.LabelOrphanedSince: "2018-06-01T02:00:00Z"},
+	}, nil).Return(nil)
+	report, err = c.Sweep(now.Add(2 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"snap"}, report.Pending)
+}
@@ -0,0 +1,104 @@
+// Package snapcleanup handles the snapshots orphaned by the deletion of
+// their parent volume. Instead of leaving them around indefinitely, a
+// cleaner periodically marks them with the time their parent was found
+// deleted, then either preserves them in a dedicated namespace or deletes
+// them once a grace period has passed.
+package snapcleanup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	// ActionPreserve moves the orphaned snapshots to the namespace of the
+	// policy and keeps them
+	ActionPreserve = "preserve"
+	// ActionExpire deletes the orphaned snapshots after the grace period
+	ActionExpire = "expire"
+
+	// DefaultInterval is the time between two sweeps of the snapshots
+	DefaultInterval = time.Hour
+	// DefaultGracePeriod is how long an orphaned snapshot is kept before it
+	// expires
+	DefaultGracePeriod = 7 * 24 * time.Hour
+	// DefaultNamespace is the namespace the orphaned snapshots are preserved
+	// in
+	DefaultNamespace = "orphaned-snapshots"
+)
+
+// Config configures the handling of the orphaned snapshots.
+// swagger:model
+type Config struct {
+	// Action is ActionPreserve or ActionExpire, the orphaned snapshots are
+	// left alone if not set
+	Action string `yaml:"action"`
+	// GracePeriod is how long an orphaned snapshot is kept before it expires,
+	// DefaultGracePeriod if not set
+	GracePeriod time.Duration `yaml:"grace_period"`
+	// Namespace the orphaned snapshots are preserved in, DefaultNamespace if
+	// not set
+	Namespace string `yaml:"namespace"`
+	// Interval between the sweeps of the snapshots, DefaultInterval if not set
+	Interval time.Duration `yaml:"interval"`
+}
+
+// Enabled returns true if an action is configured.
+func (c *Config) Enabled() bool {
+	return len(c.Action) != 0
+}
+
+// Validate checks the action and that the durations are not negative.
+func (c *Config) Validate() error {
+	switch c.Action {
+	case ActionPreserve, ActionExpire:
+	default:
+		return fmt.Errorf("Unknown orphaned snapshot action %q", c.Action)
+	}
+	if c.GracePeriod < 0 || c.Interval < 0 {
+		return fmt.Errorf("Orphaned snapshot grace period and interval must not be negative")
+	}
+	return nil
+}
+
+func (c *Config) gracePeriod() time.Duration {
+	if c.GracePeriod == 0 {
+		return DefaultGracePeriod
+	}
+	return c.GracePeriod
+}
+
+func (c *Config) namespace() string {
+	if len(c.Namespace) == 0 {
+		return DefaultNamespace
+	}
+	return c.Namespace
+}
+
+func (c *Config) interval() time.Duration {
+	if c.Interval == 0 {
+		return DefaultInterval
+	}
+	return c.Interval
+}
+
+// Report lists the orphaned snapshots handled by a sweep.
+// swagger:model
+type Report struct {
+	// Preserved are the snapshots moved to the namespace of the policy
+	Preserved []string
+	// Pending are the snapshots within their grace period
+	Pending []string
+	// Expired are the snapshots deleted
+	Expired []string
+}
+
+// Driver is the part of a volume driver listing, relabeling and deleting
+// snapshots.
+type Driver interface {
+	Enumerate(locator *api.VolumeLocator, labels map[string]string) ([]*api.Volume, error)
+	Set(volumeID string, locator *api.VolumeLocator, spec *api.VolumeSpec) error
+	Delete(volumeID string) error
+}
@@ -0,0 +1,233 @@
+package snapsched
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/pborman/uuid"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	schedulesKey = "snapsched/schedules"
+	// lockKey serializes the runs of the nodes of a cluster
+	lockKey = "snapsched/lock"
+	// nameFormat formats the time a snapshot is taken into its name
+	nameFormat = "20060102T150405Z"
+)
+
+// Manager stores the snapshot schedules and takes their snapshots.
+type Manager struct {
+	kv     kvdb.Kvdb
+	driver Driver
+	alerts alerts.Manager
+	pruner *Pruner
+}
+
+// NewManager returns the manager of the schedules of the volumes of driver
+// d, raising the alerts of the failed snapshots with alertsManager.
+func NewManager(kv kvdb.Kvdb, d Driver, alertsManager alerts.Manager) *Manager {
+	return &Manager{
+		kv:     kv,
+		driver: d,
+		alerts: alertsManager,
+		pruner: NewPruner(d, alertsManager),
+	}
+}
+
+func scheduleKey(id string) string {
+	return filepath.Join(schedulesKey, id)
+}
+
+// Create validates s and stores it as a new schedule of an existing volume.
+// The first snapshot is taken an interval after its creation, or the next
+// time its cron expression fires.
+func (m *Manager) Create(s *Schedule) (*Schedule, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	vols, err := m.driver.Inspect([]string{s.VolumeId})
+	if err != nil {
+		return nil, err
+	}
+	if len(vols) != 1 {
+		return nil, fmt.Errorf("Volume %s not found", s.VolumeId)
+	}
+	created := &Schedule{
+		Id:           uuid.New(),
+		VolumeId:     s.VolumeId,
+		Interval:     s.Interval,
+		Cron:         s.Cron,
+		MaxSnapshots: s.MaxSnapshots,
+		Retention:    s.Retention,
+		CreateTime:   time.Now(),
+	}
+	if _, err := m.kv.Create(scheduleKey(created.Id), created, 0); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Inspect returns the schedule with the given id.
+// Errors ErrNotFound may be returned.
+func (m *Manager) Inspect(id string) (*Schedule, error) {
+	s := new(Schedule)
+	if _, err := m.kv.GetVal(scheduleKey(id), s); err != nil {
+		if err == kvdb.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+// Enumerate returns the schedules of volumeID, of every volume if empty,
+// oldest first.
+func (m *Manager) Enumerate(volumeID string) ([]*Schedule, error) {
+	kvps, err := m.kv.Enumerate(schedulesKey)
+	if err != nil {
+		return nil, err
+	}
+	schedules := make([]*Schedule, 0, len(kvps))
+	for _, kvp := range kvps {
+		s := new(Schedule)
+		if err := json.Unmarshal(kvp.Value, s); err != nil {
+			return nil, err
+		}
+		if len(volumeID) == 0 || s.VolumeId == volumeID {
+			schedules = append(schedules, s)
+		}
+	}
+	sort.Slice(schedules, func(i, j int) bool {
+		return schedules[i].CreateTime.Before(schedules[j].CreateTime)
+	})
+	return schedules, nil
+}
+
+// Delete deletes the schedule with the given id. The snapshots it took are
+// kept.
+// Errors ErrNotFound may be returned.
+func (m *Manager) Delete(id string) error {
+	if _, err := m.kv.Delete(scheduleKey(id)); err != nil {
+		if err == kvdb.ErrNotFound {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Start runs the schedules every minute.
+func (m *Manager) Start() {
+	go func() {
+		for now := range time.Tick(MinInterval) {
+			if err := m.Run(now); err != nil {
+				logrus.WithField("pkg", "openstorage/snapsched").
+					Warnf("Failed to run snapshot schedules: %v", err)
+			}
+		}
+	}()
+}
+
+// Run takes the snapshots of the schedules due at now, prunes their
+// snapshots expired by their retention and raises or clears the alerts of their
+// volumes. The nodes of a cluster run in turn, so that a snapshot is taken
+// once.
+func (m *Manager) Run(now time.Time) error {
+	lock, err := m.kv.Lock(lockKey)
+	if err != nil {
+		return err
+	}
+	defer m.kv.Unlock(lock)
+
+	schedules, err := m.Enumerate("")
+	if err != nil {
+		return err
+	}
+	changed := make(map[string]bool)
+	for _, s := range schedules {
+		if !s.due(now) {
+			continue
+		}
+		failed := len(s.LastError) != 0
+		s.LastRun = now
+		s.LastError = ""
+		if id, err := m.snapshot(s, now); err != nil {
+			s.LastError = err.Error()
+		} else {
+			s.LastSnapshotId = id
+		}
+		if _, err := m.kv.Update(scheduleKey(s.Id), s, 0); err != nil {
+			// the schedule was deleted since
+			continue
+		}
+		if failed != (len(s.LastError) != 0) {
+			changed[s.VolumeId] = true
+		}
+	}
+	for volumeID := range changed {
+		if err := m.alert(volumeID, schedules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshot takes a snapshot of the volume of s, labeled with the id of s,
+// then prunes its snapshots expired by its retention.
+func (m *Manager) snapshot(s *Schedule, now time.Time) (string, error) {
+	vols, err := m.driver.Inspect([]string{s.VolumeId})
+	if err != nil {
+		return "", err
+	}
+	if len(vols) != 1 {
+		return "", fmt.Errorf("Volume %s not found", s.VolumeId)
+	}
+	locator := volume.InheritLocator(vols[0], &api.VolumeLocator{
+		Name:         fmt.Sprintf("%s.%s", vols[0].GetLocator().GetName(), now.UTC().Format(nameFormat)),
+		VolumeLabels: map[string]string{LabelSchedule: s.Id},
+	})
+	id, err := m.driver.Snapshot(s.VolumeId, true, locator, false)
+	if err != nil {
+		return "", err
+	}
+	if _, err := m.pruner.Prune(s); err != nil {
+		logrus.WithField("pkg", "openstorage/snapsched").
+			Warnf("Failed to prune the snapshots of schedule %s: %v", s.Id, err)
+	}
+	return id, nil
+}
+
+// alert raises the alert of volumeID if one of its schedules failed last,
+// or clears it.
+func (m *Manager) alert(volumeID string, schedules []*Schedule) error {
+	var failed *Schedule
+	for _, s := range schedules {
+		if s.VolumeId == volumeID && len(s.LastError) != 0 {
+			failed = s
+			break
+		}
+	}
+	alert := &api.Alert{
+		AlertType:  AlertTypeSnapshotFailed,
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: volumeID,
+	}
+	if failed != nil {
+		alert.Severity = api.SeverityType_SEVERITY_TYPE_WARNING
+		alert.Message = fmt.Sprintf("Snapshot schedule %s failed to snapshot volume %s: %s",
+			failed.Id, volumeID, failed.LastError)
+	} else {
+		alert.Severity = api.SeverityType_SEVERITY_TYPE_NOTIFY
+		alert.Message = "Snapshot schedules of volume " + volumeID + " succeed again"
+		alert.Cleared = true
+	}
+	return m.alerts.Raise(alert)
+}
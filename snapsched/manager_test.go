@@ -0,0 +1,166 @@
+package snapsched
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// vol1 is the volume snapshotted by the schedules.
+var vol1 = &api.Volume{Id: "vol1", Locator: &api.VolumeLocator{Name: "data"}}
+
+func newTestManager(t *testing.T) (*Manager, *mock.MockVolumeDriver, alerts.Manager) {
+	kv, err := kvdb.New(mem.Name, "", []string{}, nil, nil)
+	require.NoError(t, err)
+	alertsManager, err := alerts.NewManager(kv)
+	require.NoError(t, err)
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	d := mock.NewMockVolumeDriver(ctrl)
+	return NewManager(kv, d, alertsManager), d, alertsManager
+}
+
+// expectSnapshot expects the snapshot of vol1 taken by s at now.
+func expectSnapshot(d *mock.MockVolumeDriver, s *Schedule, now time.Time) *gomock.Call {
+	d.EXPECT().Inspect([]string{"vol1"}).Return([]*api.Volume{vol1}, nil)
+	return d.EXPECT().Snapshot("vol1", true, volume.InheritLocator(vol1, &api.VolumeLocator{
+		Name:         "data." + now.UTC().Format(nameFormat),
+		VolumeLabels: map[string]string{LabelSchedule: s.Id},
+	}), false)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, (&Schedule{VolumeId: "vol1", Interval: time.Hour}).Validate())
+	assert.NoError(t, (&Schedule{VolumeId: "vol1", Cron: "0 2 * * *", MaxSnapshots: 7}).Validate())
+	assert.Error(t, (&Schedule{Interval: time.Hour}).Validate())
+	assert.Error(t, (&Schedule{VolumeId: "vol1"}).Validate())
+	assert.Error(t, (&Schedule{VolumeId: "vol1", Interval: time.Second}).Validate())
+	assert.Error(t, (&Schedule{VolumeId: "vol1", Cron: "0 25 * * *"}).Validate())
+	assert.Error(t, (&Schedule{VolumeId: "vol1", Interval: time.Hour, Cron: "0 2 * * *"}).Validate())
+	assert.Error(t, (&Schedule{VolumeId: "vol1", Interval: time.Hour, MaxSnapshots: -1}).Validate())
+}
+
+func TestSchedules(t *testing.T) {
+	m, d, _ := newTestManager(t)
+
+	d.EXPECT().Inspect([]string{"doesnotexist"}).Return(nil, nil)
+	_, err := m.Create(&Schedule{VolumeId: "doesnotexist", Interval: time.Hour})
+	assert.Error(t, err)
+
+	d.EXPECT().Inspect([]string{"vol1"}).Return([]*api.Volume{vol1}, nil).Times(2)
+	s1, err := m.Create(&Schedule{VolumeId: "vol1", Interval: time.Hour})
+	require.NoError(t, err)
+	s2, err := m.Create(&Schedule{VolumeId: "vol1", Cron: "0 2 * * *"})
+	require.NoError(t, err)
+
+	s, err := m.Inspect(s1.Id)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, s.Interval)
+
+	schedules, err := m.Enumerate("vol1")
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	assert.Equal(t, s1.Id, schedules[0].Id)
+	assert.Equal(t, s2.Id, schedules[1].Id)
+	schedules, err = m.Enumerate("vol2")
+	require.NoError(t, err)
+	assert.Empty(t, schedules)
+
+	require.NoError(t, m.Delete(s1.Id))
+	_, err = m.Inspect(s1.Id)
+	assert.Equal(t, ErrNotFound, err)
+	assert.Equal(t, ErrNotFound, m.Delete(s1.Id))
+}
+
+func TestRun(t *testing.T) {
+	m, d, _ := newTestManager(t)
+
+	d.EXPECT().Inspect([]string{"vol1"}).Return([]*api.Volume{vol1}, nil)
+	s, err := m.Create(&Schedule{VolumeId: "vol1", Interval: time.Hour, MaxSnapshots: 2})
+	require.NoError(t, err)
+
+	now := s.CreateTime
+	require.NoError(t, m.Run(now.Add(30*time.Minute)))
+
+	var snaps []*api.Volume
+	for i := 1; i <= 3; i++ {
+		at := now.Add(time.Duration(i) * time.Hour)
+		id := fmt.Sprintf("snap%d", i)
+		snaps = append(snaps, snapAt(id, s.Id, at))
+		expectSnapshot(d, s, at).Return(id, nil)
+		d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return(snaps, nil)
+		if i == 3 {
+			// the oldest snapshot is deleted
+			d.EXPECT().Delete("snap1").Return(nil)
+		}
+		require.NoError(t, m.Run(at))
+	}
+
+	s, err = m.Inspect(s.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "snap3", s.LastSnapshotId)
+	assert.Empty(t, s.LastError)
+}
+
+func TestRunCron(t *testing.T) {
+	m, d, _ := newTestManager(t)
+
+	d.EXPECT().Inspect([]string{"vol1"}).Return([]*api.Volume{vol1}, nil)
+	s, err := m.Create(&Schedule{VolumeId: "vol1", Cron: "0 2 * * *"})
+	require.NoError(t, err)
+	day := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	s.CreateTime = day
+	_, err = m.kv.Put(scheduleKey(s.Id), s, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Run(day.Add(time.Hour)))
+	expectSnapshot(d, s, day.Add(2*time.Hour)).Return("snap1", nil)
+	require.NoError(t, m.Run(day.Add(2*time.Hour)))
+	require.NoError(t, m.Run(day.Add(3*time.Hour)))
+	expectSnapshot(d, s, day.Add(26*time.Hour)).Return("snap2", nil)
+	require.NoError(t, m.Run(day.Add(26*time.Hour)))
+}
+
+func TestRunAlerts(t *testing.T) {
+	m, d, alertsManager := newTestManager(t)
+
+	d.EXPECT().Inspect([]string{"vol1"}).Return([]*api.Volume{vol1}, nil)
+	s, err := m.Create(&Schedule{VolumeId: "vol1", Interval: time.Hour})
+	require.NoError(t, err)
+	now := s.CreateTime
+
+	raised := func() []*api.Alert {
+		list, err := alertsManager.Enumerate(
+			alerts.NewAlertTypeFilter(AlertTypeSnapshotFailed, api.ResourceType_RESOURCE_TYPE_VOLUME))
+		require.NoError(t, err)
+		return list
+	}
+
+	expectSnapshot(d, s, now.Add(time.Hour)).Return("", errors.New("pool is full"))
+	require.NoError(t, m.Run(now.Add(time.Hour)))
+	list := raised()
+	require.Len(t, list, 1)
+	assert.Equal(t, "vol1", list[0].ResourceId)
+	assert.False(t, list[0].Cleared)
+	assert.Contains(t, list[0].Message, "pool is full")
+	s, err = m.Inspect(s.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "pool is full", s.LastError)
+
+	expectSnapshot(d, s, now.Add(2*time.Hour)).Return("snap1", nil)
+	require.NoError(t, m.Run(now.Add(2*time.Hour)))
+	list = raised()
+	require.Len(t, list, 1)
+	assert.True(t, list[0].Cleared)
+}
@@ -0,0 +1,80 @@
+package snapsched
+
+import (
+	"fmt"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// Payload keys of the alerts raised for the pruned snapshots.
+const (
+	// PayloadScheduleID is the id of the schedule which took the snapshot
+	PayloadScheduleID = "schedule_id"
+	// PayloadVolumeID is the id of the volume of the pruned snapshot
+	PayloadVolumeID = "volume_id"
+)
+
+// Pruner deletes the snapshots of the schedules expired by their retention.
+// Every snapshot deleted is recorded as an alert on the snapshot, so that the
+// pruning can be audited.
+type Pruner struct {
+	driver Driver
+	alerts alerts.Manager
+}
+
+// NewPruner returns a pruner of the snapshots of driver d, recording the
+// snapshots deleted with alertsManager.
+func NewPruner(d Driver, alertsManager alerts.Manager) *Pruner {
+	return &Pruner{
+		driver: d,
+		alerts: alertsManager,
+	}
+}
+
+// Prune deletes the snapshots taken by s expired by its retention, and
+// returns their ids.
+func (p *Pruner) Prune(s *Schedule) ([]string, error) {
+	retention := s.retention()
+	if retention.Empty() {
+		return nil, nil
+	}
+	vols, err := p.driver.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var snaps []*api.Volume
+	for _, v := range vols {
+		if v.IsSnapshot() && v.GetSource().GetParent() == s.VolumeId &&
+			v.GetLocator().GetVolumeLabels()[LabelSchedule] == s.Id {
+			snaps = append(snaps, v)
+		}
+	}
+	var pruned []string
+	for _, snap := range retention.Expired(snaps) {
+		if err := p.driver.Delete(snap.GetId()); err != nil {
+			return pruned, err
+		}
+		pruned = append(pruned, snap.GetId())
+		if err := p.record(s, snap); err != nil {
+			return pruned, err
+		}
+	}
+	return pruned, nil
+}
+
+// record raises the alert auditing the deletion of snap.
+func (p *Pruner) record(s *Schedule, snap *api.Volume) error {
+	return p.alerts.Raise(&api.Alert{
+		AlertType:  AlertTypeSnapshotPruned,
+		Severity:   api.SeverityType_SEVERITY_TYPE_NOTIFY,
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: snap.GetId(),
+		Message: fmt.Sprintf("Snapshot schedule %s deleted snapshot %s of volume %s, expired by %s",
+			s.Id, snap.GetId(), s.VolumeId, s.retention()),
+		Payload: map[string]string{
+			PayloadScheduleID: s.Id,
+			PayloadVolumeID:   s.VolumeId,
+		},
+	})
+}
@@ -0,0 +1,116 @@
+package snapsched
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// Retention selects the snapshots of a schedule kept, the others expiring.
+// A snapshot is kept if it is one of the last ones or the newest snapshot
+// of one of the last days, weeks or months with a snapshot. All snapshots
+// are kept by an empty retention.
+// swagger:model
+type Retention struct {
+	// Last is the number of newest snapshots kept
+	Last int
+	// Daily is the number of days the newest snapshot of is kept
+	Daily int
+	// Weekly is the number of ISO weeks the newest snapshot of is kept
+	Weekly int
+	// Monthly is the number of months the newest snapshot of is kept
+	Monthly int
+}
+
+// Empty returns true if the retention keeps all snapshots.
+func (r *Retention) Empty() bool {
+	return r == nil || (r.Last == 0 && r.Daily == 0 && r.Weekly == 0 && r.Monthly == 0)
+}
+
+// String returns the rules of the retention, such as
+// "retention last=3,daily=7".
+func (r *Retention) String() string {
+	var rules []string
+	for _, rule := range []struct {
+		name  string
+		count int
+	}{
+		{"last", r.Last},
+		{"daily", r.Daily},
+		{"weekly", r.Weekly},
+		{"monthly", r.Monthly},
+	} {
+		if rule.count > 0 {
+			rules = append(rules, fmt.Sprintf("%s=%d", rule.name, rule.count))
+		}
+	}
+	return "retention " + strings.Join(rules, ",")
+}
+
+// Validate checks that the counts are not negative.
+func (r *Retention) Validate() error {
+	if r.Last < 0 || r.Daily < 0 || r.Weekly < 0 || r.Monthly < 0 {
+		return fmt.Errorf("Snapshot retention counts must not be negative")
+	}
+	return nil
+}
+
+// Expired returns the snapshots not kept by the retention, newest first.
+func (r *Retention) Expired(snaps []*api.Volume) []*api.Volume {
+	if r.Empty() {
+		return nil
+	}
+	sorted := make([]*api.Volume, len(snaps))
+	copy(sorted, snaps)
+	// newest first
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return ctime(sorted[i]).After(ctime(sorted[j]))
+	})
+
+	kept := make(map[string]bool)
+	for i := 0; i < r.Last && i < len(sorted); i++ {
+		kept[sorted[i].GetId()] = true
+	}
+	// keep keeps the newest snapshot of each of the last count periods
+	keep := func(count int, period func(time.Time) string) {
+		seen := make(map[string]bool)
+		for _, snap := range sorted {
+			if len(seen) == count {
+				return
+			}
+			p := period(ctime(snap))
+			if seen[p] {
+				continue
+			}
+			seen[p] = true
+			kept[snap.GetId()] = true
+		}
+	}
+	keep(r.Daily, func(t time.Time) string {
+		return t.Format("2006-01-02")
+	})
+	keep(r.Weekly, func(t time.Time) string {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	})
+	keep(r.Monthly, func(t time.Time) string {
+		return t.Format("2006-01")
+	})
+
+	var expired []*api.Volume
+	for _, snap := range sorted {
+		if !kept[snap.GetId()] {
+			expired = append(expired, snap)
+		}
+	}
+	return expired
+}
+
+// ctime returns the creation time of snap in UTC.
+func ctime(snap *api.Volume) time.Time {
+	c := snap.GetCtime()
+	return time.Unix(c.GetSeconds(), int64(c.GetNanos())).UTC()
+}
@@ -0,0 +1,110 @@
+package snapsched
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// snapAt returns the snapshot id of vol1 taken at t by schedule sched.
+func snapAt(id, sched string, t time.Time) *api.Volume {
+	return &api.Volume{
+		Id:       id,
+		Readonly: true,
+		Locator:  &api.VolumeLocator{VolumeLabels: map[string]string{LabelSchedule: sched}},
+		Source:   &api.Source{Parent: "vol1"},
+		Ctime:    &timestamp.Timestamp{Seconds: t.Unix()},
+	}
+}
+
+func ids(vols []*api.Volume) []string {
+	var ids []string
+	for _, v := range vols {
+		ids = append(ids, v.GetId())
+	}
+	return ids
+}
+
+func TestRetentionExpired(t *testing.T) {
+	day := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	// two snapshots a day over the last 60 days, newest first
+	var snaps []*api.Volume
+	for i := 0; i < 60; i++ {
+		d := day.AddDate(0, 0, -i)
+		snaps = append(snaps,
+			snapAt(d.Format("0102")+"b", "s", d.Add(6*time.Hour)),
+			snapAt(d.Format("0102")+"a", "s", d))
+	}
+
+	assert.Empty(t, (&Retention{}).Expired(snaps))
+	assert.Len(t, (&Retention{Last: 3}).Expired(snaps), 117)
+
+	expired := (&Retention{Last: 3, Daily: 2}).Expired(snaps)
+	assert.Len(t, expired, 117)
+	assert.NotContains(t, ids(expired), "0601b")
+	assert.NotContains(t, ids(expired), "0601a")
+	assert.NotContains(t, ids(expired), "0531b")
+
+	expired = (&Retention{Daily: 3, Weekly: 2, Monthly: 3}).Expired(snaps)
+	kept := make(map[string]bool)
+	for _, s := range snaps {
+		kept[s.GetId()] = true
+	}
+	for _, id := range ids(expired) {
+		delete(kept, id)
+	}
+	// June 1st is a Monday: the newest of the last 3 days, of the week of
+	// May 31st and of the months of May and April
+	assert.Equal(t, map[string]bool{
+		"0601b": true, "0531b": true, "0530b": true,
+		"0430b": true,
+	}, kept)
+}
+
+func TestRetentionValidate(t *testing.T) {
+	assert.NoError(t, (&Schedule{VolumeId: "vol1", Interval: time.Hour,
+		Retention: &Retention{Last: 1, Daily: 7}}).Validate())
+	assert.Error(t, (&Schedule{VolumeId: "vol1", Interval: time.Hour,
+		Retention: &Retention{Weekly: -1}}).Validate())
+	assert.Error(t, (&Schedule{VolumeId: "vol1", Interval: time.Hour,
+		MaxSnapshots: 1, Retention: &Retention{Daily: 7}}).Validate())
+	assert.Equal(t, "retention last=1,daily=7", (&Retention{Last: 1, Daily: 7}).String())
+}
+
+func TestPrune(t *testing.T) {
+	m, d, alertsManager := newTestManager(t)
+	day := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	s := &Schedule{Id: "s", VolumeId: "vol1", Retention: &Retention{Daily: 2}}
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).Return([]*api.Volume{
+		snapAt("today", "s", day),
+		snapAt("earlier", "s", day.Add(-time.Hour)),
+		snapAt("yesterday", "s", day.AddDate(0, 0, -1)),
+		snapAt("old", "s", day.AddDate(0, 0, -2)),
+		snapAt("other", "other", day.AddDate(0, 0, -2)),
+	}, nil)
+	d.EXPECT().Delete("earlier").Return(nil)
+	d.EXPECT().Delete("old").Return(nil)
+
+	pruned, err := m.pruner.Prune(s)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"earlier", "old"}, pruned)
+
+	list, err := alertsManager.Enumerate(
+		alerts.NewAlertTypeFilter(AlertTypeSnapshotPruned, api.ResourceType_RESOURCE_TYPE_VOLUME))
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	var recorded []string
+	for _, a := range list {
+		assert.Equal(t, "s", a.Payload[PayloadScheduleID])
+		assert.Equal(t, "vol1", a.Payload[PayloadVolumeID])
+		recorded = append(recorded, a.ResourceId)
+	}
+	sort.Strings(recorded)
+	assert.Equal(t, []string{"earlier", "old"}, recorded)
+}
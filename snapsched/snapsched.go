@@ -0,0 +1,181 @@
+// Package snapsched takes the snapshots of volumes on schedules stored in
+// kvdb, every interval or following a cron expression, and prunes the
+// snapshots of a schedule expired by its retention, such as keeping the last
+// snapshots and the newest of the last days, weeks and months. A failed
+// snapshot raises an alert on the volume, cleared once the schedules of the
+// volume succeed again, and every pruned snapshot is recorded as an alert.
+package snapsched
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libopenstorage/openstorage/alerts"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/cron"
+)
+
+const (
+	// MinInterval is the shortest interval between the snapshots of a
+	// schedule, the schedules being checked every minute
+	MinInterval = time.Minute
+
+	// LabelSchedule is set on the snapshots taken by a schedule to its id
+	LabelSchedule = "snapshot_schedule_id"
+)
+
+// Alert types raised on the volume resource.
+const (
+	// AlertTypeBase is the base of the alert types of the snapsched component
+	AlertTypeBase int64 = 4300
+	// AlertTypeSnapshotFailed is raised on a volume a schedule failed to
+	// snapshot
+	AlertTypeSnapshotFailed = AlertTypeBase + 1
+	// AlertTypeSnapshotPruned is raised on every snapshot deleted by the
+	// retention of a schedule
+	AlertTypeSnapshotPruned = AlertTypeBase + 2
+)
+
+func init() {
+	alerts.MustRegisterComponent("snapsched", AlertTypeBase).MustRegisterReasons(alerts.Reason{
+		AlertType:   AlertTypeSnapshotFailed,
+		Code:        "SCHEDULED_SNAPSHOT_FAILED",
+		Resource:    api.ResourceType_RESOURCE_TYPE_VOLUME,
+		Description: "a snapshot schedule of the volume failed to take a snapshot",
+	}, alerts.Reason{
+		AlertType:   AlertTypeSnapshotPruned,
+		Code:        "SCHEDULED_SNAPSHOT_PRUNED",
+		Resource:    api.ResourceType_RESOURCE_TYPE_VOLUME,
+		Description: "the snapshot was deleted, expired by the retention of its schedule",
+	})
+}
+
+var (
+	// ErrNotFound returned when a schedule does not exist
+	ErrNotFound = errors.New("Snapshot schedule not found")
+	// ErrNotInitialized returned when the schedule manager has not been initialized
+	ErrNotInitialized = errors.New("openstorage.snapsched: not initialized")
+	// ErrInitialized returned when the schedule manager is initialized twice
+	ErrInitialized = errors.New("openstorage.snapsched: already initialized")
+
+	inst *Manager
+	// Inst returns the schedule manager singleton.
+	// This function can be overridden for testing purposes
+	Inst = func() (*Manager, error) {
+		return snapschedInst()
+	}
+)
+
+// Schedule is a snapshot policy of a volume.
+// swagger:model
+type Schedule struct {
+	// Id of the schedule
+	Id string
+	// VolumeId of the volume snapshotted
+	VolumeId string
+	// Interval between two snapshots, unset if Cron is set
+	Interval time.Duration
+	// Cron expression the snapshots are taken at, such as "0 2 * * *",
+	// unset if Interval is set
+	Cron string
+	// MaxSnapshots is the number of snapshots of the schedule kept, the
+	// oldest ones are deleted first. It is a shorthand for a retention
+	// keeping the last MaxSnapshots snapshots, unset if Retention is set.
+	MaxSnapshots int
+	// Retention selects the snapshots of the schedule kept. All are kept if
+	// neither Retention nor MaxSnapshots is set.
+	Retention *Retention
+	// CreateTime is when the schedule was created
+	CreateTime time.Time
+	// LastRun is when the schedule last took a snapshot, or failed to
+	LastRun time.Time
+	// LastSnapshotId is the id of the snapshot taken last
+	LastSnapshotId string
+	// LastError is the error of the last run, empty if it succeeded
+	LastError string
+}
+
+// Validate checks that the volume is set, exactly one of a cron expression
+// and an interval of at least MinInterval, and at most one of max snapshots
+// and a retention.
+func (s *Schedule) Validate() error {
+	if len(s.VolumeId) == 0 {
+		return fmt.Errorf("Snapshot schedule requires a volume id")
+	}
+	switch {
+	case s.Interval != 0 && len(s.Cron) != 0:
+		return fmt.Errorf("Snapshot schedule sets both an interval and a cron expression")
+	case len(s.Cron) != 0:
+		if _, err := cron.Parse(s.Cron); err != nil {
+			return fmt.Errorf("Invalid snapshot schedule: %v", err)
+		}
+	case s.Interval < MinInterval:
+		return fmt.Errorf("Snapshot schedule interval must be at least %v", MinInterval)
+	}
+	if s.MaxSnapshots < 0 {
+		return fmt.Errorf("Snapshot schedule max snapshots must not be negative")
+	}
+	if s.Retention != nil {
+		if s.MaxSnapshots != 0 {
+			return fmt.Errorf("Snapshot schedule sets both max snapshots and a retention")
+		}
+		if err := s.Retention.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retention returns the retention of the schedule, nil if all snapshots
+// are kept.
+func (s *Schedule) retention() *Retention {
+	if s.Retention != nil {
+		return s.Retention
+	}
+	if s.MaxSnapshots > 0 {
+		return &Retention{Last: s.MaxSnapshots}
+	}
+	return nil
+}
+
+// due returns true if the schedule takes a snapshot at now.
+func (s *Schedule) due(now time.Time) bool {
+	from := s.LastRun
+	if from.IsZero() {
+		from = s.CreateTime
+	}
+	if len(s.Cron) == 0 {
+		return now.Sub(from) >= s.Interval
+	}
+	c, err := cron.Parse(s.Cron)
+	if err != nil {
+		return false
+	}
+	_, fired := c.Last(from, now)
+	return fired
+}
+
+// Driver is the part of a volume driver taking and deleting snapshots.
+type Driver interface {
+	Inspect(volumeIDs []string) ([]*api.Volume, error)
+	Enumerate(locator *api.VolumeLocator, labels map[string]string) ([]*api.Volume, error)
+	Snapshot(volumeID string, readonly bool, locator *api.VolumeLocator, noRetry bool) (string, error)
+	Delete(volumeID string) error
+}
+
+// Init sets the schedule manager singleton.
+func Init(m *Manager) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = m
+	return nil
+}
+
+func snapschedInst() (*Manager, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
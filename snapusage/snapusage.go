@@ -0,0 +1,115 @@
+// Package snapusage reports the space used by the snapshots of a volume,
+// exclusive to each snapshot or shared with the volume and the other
+// snapshots, from the capacity usage reported by the driver for each of them
+// (such as btrfs qgroups). Deleting a snapshot only reclaims its exclusive
+// space, so the report lists the snapshots freeing the most space first.
+package snapusage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
+	"github.com/libopenstorage/openstorage/volume"
+)
+
+// Driver is the part of a volume driver reporting the capacity usage of the
+// snapshots of a volume.
+type Driver interface {
+	SnapEnumerate(volID []string, snapLabels map[string]string) ([]*api.Volume, error)
+	CapacityUsage(ID string) (*api.CapacityUsageResponse, error)
+}
+
+// Usage is the space used by a volume or a snapshot.
+// swagger:model
+type Usage struct {
+	// Id of the volume or snapshot
+	Id string
+	// Name of the volume or snapshot
+	Name string
+	// Ctime is when the volume or snapshot was created
+	Ctime time.Time
+	// ExclusiveBytes are only used by the volume or snapshot, reclaimed when
+	// it is deleted
+	ExclusiveBytes int64
+	// SharedBytes are shared with the volume or its other snapshots
+	SharedBytes int64
+	// TotalBytes used by the volume or snapshot
+	TotalBytes int64
+	// Error reading the usage, the usage is unknown or partial if set
+	Error string
+}
+
+// Report is the space used by a volume and its snapshots.
+// swagger:model
+type Report struct {
+	// Volume usage
+	Volume *Usage
+	// Snapshots usage, the snapshots reclaiming the most space when deleted
+	// first. The snapshots whose usage could not be read come last.
+	Snapshots []*Usage
+	// ReclaimableBytes are the exclusive bytes of all the snapshots, reclaimed
+	// if they were all deleted
+	ReclaimableBytes int64
+}
+
+// Compute returns the space used by the volume v of driver d and by its
+// snapshots. It returns volume.ErrNotSupported if d does not report the
+// capacity usage of v.
+func Compute(d Driver, v *api.Volume) (*Report, error) {
+	vu, err := usage(d, v)
+	if err == volume.ErrNotSupported {
+		return nil, err
+	}
+	snaps, err := d.SnapEnumerate([]string{v.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Volume: vu, Snapshots: make([]*Usage, 0, len(snaps))}
+	for _, snap := range snaps {
+		if !snap.IsSnapshot() {
+			continue
+		}
+		su, _ := usage(d, snap)
+		report.Snapshots = append(report.Snapshots, su)
+		report.ReclaimableBytes += su.ExclusiveBytes
+	}
+	sort.SliceStable(report.Snapshots, func(i, j int) bool {
+		si, sj := report.Snapshots[i], report.Snapshots[j]
+		if (len(si.Error) == 0) != (len(sj.Error) == 0) {
+			return len(si.Error) == 0
+		}
+		if si.ExclusiveBytes != sj.ExclusiveBytes {
+			return si.ExclusiveBytes > sj.ExclusiveBytes
+		}
+		return si.Ctime.Before(sj.Ctime)
+	})
+	return report, nil
+}
+
+// usage returns the usage of v, with the error reading it if any.
+func usage(d Driver, v *api.Volume) (*Usage, error) {
+	u := &Usage{
+		Id:   v.GetId(),
+		Name: v.GetLocator().GetName(),
+	}
+	if v.GetCtime() != nil {
+		u.Ctime = prototime.TimestampToTime(v.GetCtime())
+	}
+	resp, err := d.CapacityUsage(v.GetId())
+	if err != nil {
+		u.Error = err.Error()
+		return u, err
+	}
+	// the driver may only report the total usage, with an error
+	info := resp.CapacityUsageInfo
+	u.ExclusiveBytes = info.GetExclusiveBytes()
+	u.SharedBytes = info.GetSharedBytes()
+	u.TotalBytes = info.GetTotalBytes()
+	if resp.Error != nil {
+		u.Error = resp.Error.Error()
+	}
+	return u, nil
+}
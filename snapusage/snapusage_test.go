@@ -0,0 +1,72 @@
+package snapusage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func snap(id string, readonly bool) *api.Volume {
+	return &api.Volume{
+		Id:       id,
+		Locator:  &api.VolumeLocator{Name: id},
+		Source:   &api.Source{Parent: "vol"},
+		Readonly: readonly,
+	}
+}
+
+func capacityUsage(exclusive int64) *api.CapacityUsageResponse {
+	return &api.CapacityUsageResponse{CapacityUsageInfo: &api.CapacityUsageInfo{
+		ExclusiveBytes: exclusive,
+		SharedBytes:    100,
+		TotalBytes:     exclusive + 100,
+	}}
+}
+
+func TestCompute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+
+	d.EXPECT().CapacityUsage("vol").Return(capacityUsage(1000), nil)
+	d.EXPECT().SnapEnumerate([]string{"vol"}, nil).Return([]*api.Volume{
+		snap("small", true),
+		snap("broken", true),
+		snap("large", true),
+		snap("clone", false),
+	}, nil)
+	d.EXPECT().CapacityUsage("small").Return(capacityUsage(10), nil)
+	d.EXPECT().CapacityUsage("broken").Return(nil, errors.New("usage unavailable"))
+	d.EXPECT().CapacityUsage("large").Return(capacityUsage(500), nil)
+
+	report, err := Compute(d, &api.Volume{Id: "vol", Locator: &api.VolumeLocator{Name: "data"}})
+	require.NoError(t, err)
+	assert.Equal(t, "data", report.Volume.Name)
+	assert.Equal(t, int64(1000), report.Volume.ExclusiveBytes)
+	assert.Equal(t, int64(1100), report.Volume.TotalBytes)
+
+	// clones are not snapshots
+	require.Len(t, report.Snapshots, 3)
+	assert.Equal(t, "large", report.Snapshots[0].Id)
+	assert.Equal(t, "small", report.Snapshots[1].Id)
+	assert.Equal(t, "broken", report.Snapshots[2].Id)
+	assert.Equal(t, "usage unavailable", report.Snapshots[2].Error)
+	assert.Equal(t, int64(510), report.ReclaimableBytes)
+}
+
+func TestComputeNotSupported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+
+	d.EXPECT().CapacityUsage("vol").Return(nil, volume.ErrNotSupported)
+
+	_, err := Compute(d, &api.Volume{Id: "vol"})
+	assert.Equal(t, volume.ErrNotSupported, err)
+}
@@ -0,0 +1,234 @@
+// Package speclint lints the spec of a volume, or a template of spec
+// options, against the tenant policies of the cluster and the capabilities
+// of a volume driver without creating the volume, so that the storage
+// manifests of an application can be checked before it is deployed.
+package speclint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/api/spec"
+	"github.com/libopenstorage/openstorage/tenantpolicy"
+)
+
+// Severity of a finding.
+type Severity string
+
+const (
+	// SeverityWarning is a finding the volume is created despite, such as
+	// a field ignored by the driver or overridden by a policy
+	SeverityWarning Severity = "warning"
+	// SeverityError is a finding the volume is not created because of
+	SeverityError Severity = "error"
+
+	// MaxHaLevel is the largest number of replicas of a volume
+	MaxHaLevel = 3
+)
+
+// Request is the volume to lint, as created with Locator and Spec, then
+// Options applied.
+// swagger:model
+type Request struct {
+	// Locator of the volume, its labels select the tenant policies
+	Locator *api.VolumeLocator
+	// Spec of the volume
+	Spec *api.VolumeSpec
+	// Options is a template of spec options applied to Spec, such as
+	// {"size": "10G", "repl": "2"}
+	Options map[string]string
+}
+
+// Finding is a problem of a field of the volume.
+// swagger:model
+type Finding struct {
+	// Severity of the finding
+	Severity Severity
+	// Field is the field of the spec or the locator, such as spec.size, or
+	// the option of the template the finding is about
+	Field string
+	// Message describes the finding
+	Message string
+}
+
+// Result lists the findings of a volume.
+// swagger:model
+type Result struct {
+	// Valid is true if no finding is an error
+	Valid bool
+	// Spec is the spec the volume would be created with, the options and
+	// the policies applied
+	Spec *api.VolumeSpec
+	// Findings are the findings, errors first
+	Findings []Finding
+}
+
+// Driver is the part of a volume driver its capabilities are known from.
+type Driver interface {
+	Name() string
+	Type() api.DriverType
+}
+
+// linter accumulates the findings of a volume.
+type linter struct {
+	findings []Finding
+}
+
+func (l *linter) errorf(field, format string, args ...interface{}) {
+	l.findings = append(l.findings, Finding{
+		Severity: SeverityError,
+		Field:    field,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) warnf(field, format string, args ...interface{}) {
+	l.findings = append(l.findings, Finding{
+		Severity: SeverityWarning,
+		Field:    field,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Lint returns the findings of the volume of req if created with driver d
+// and the tenant policies p, none if p is nil.
+func Lint(d Driver, p *tenantpolicy.Policies, req *Request) *Result {
+	l := &linter{}
+
+	locator := &api.VolumeLocator{}
+	if req.Locator != nil {
+		locator = proto.Clone(req.Locator).(*api.VolumeLocator)
+	}
+	requested := &api.VolumeSpec{}
+	if req.Spec != nil {
+		requested = proto.Clone(req.Spec).(*api.VolumeSpec)
+	} else if len(req.Options) == 0 {
+		l.errorf("spec", "Volume has no spec or options")
+	}
+	l.options(req.Options, requested, locator)
+
+	if len(locator.GetName()) == 0 {
+		l.errorf("locator.name", "Volume has no name")
+	}
+
+	applied := requested
+	if p != nil {
+		var err error
+		if applied, err = p.Spec(locator, requested); err != nil {
+			l.errorf("policy", "%v", err)
+			applied = requested
+		} else {
+			l.overrides(requested, applied)
+		}
+	}
+	l.spec(applied)
+	l.driver(d, applied)
+
+	// errors first, then by field
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		fi, fj := l.findings[i], l.findings[j]
+		if fi.Severity != fj.Severity {
+			return fi.Severity == SeverityError
+		}
+		return fi.Field < fj.Field
+	})
+	valid := true
+	for _, f := range l.findings {
+		if f.Severity == SeverityError {
+			valid = false
+		}
+	}
+	return &Result{
+		Valid:    valid,
+		Spec:     applied,
+		Findings: l.findings,
+	}
+}
+
+// options applies the options one by one to s and locator, so that an
+// invalid option is reported as its own finding.
+func (l *linter) options(options map[string]string, s *api.VolumeSpec, locator *api.VolumeLocator) {
+	if s.VolumeLabels == nil {
+		s.VolumeLabels = make(map[string]string)
+	}
+	if locator.VolumeLabels == nil {
+		locator.VolumeLabels = make(map[string]string)
+	}
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	handler := spec.NewSpecHandler()
+	for _, k := range keys {
+		if k == api.Name {
+			locator.Name = options[k]
+			continue
+		}
+		if _, _, _, err := handler.UpdateSpecFromOpts(
+			map[string]string{k: options[k]}, s, locator, &api.Source{},
+		); err != nil {
+			l.errorf(k, "Invalid option %s=%s: %v", k, options[k], err)
+		}
+	}
+}
+
+// overrides warns of the fields of requested set by the policies.
+func (l *linter) overrides(requested, applied *api.VolumeSpec) {
+	if applied.Encrypted && !requested.Encrypted {
+		l.warnf("spec.encrypted", "Volume is encrypted by policy")
+	}
+	if applied.Size != requested.Size {
+		l.warnf("spec.size", "Volume size is set to %d by policy", applied.Size)
+	}
+	if applied.SnapshotSchedule != requested.SnapshotSchedule {
+		l.warnf("spec.snapshot_schedule", "Volume snapshot schedule is set to %q by policy",
+			applied.SnapshotSchedule)
+	}
+}
+
+// spec checks the fields of s.
+func (l *linter) spec(s *api.VolumeSpec) {
+	if s.Size == 0 {
+		l.errorf("spec.size", "Volume has no size")
+	}
+	if s.HaLevel < 0 || s.HaLevel > MaxHaLevel {
+		l.errorf("spec.ha_level", "Volume ha level %d is not between 1 and %d", s.HaLevel, MaxHaLevel)
+	}
+	if nodes := len(s.GetReplicaSet().GetNodes()); nodes != 0 && int64(nodes) != haLevel(s) {
+		l.warnf("spec.replica_set", "Volume places %d replicas on %d nodes", haLevel(s), nodes)
+	}
+	if s.BlockSize < 0 || s.BlockSize&(s.BlockSize-1) != 0 {
+		l.errorf("spec.block_size", "Volume block size %d is not a power of 2", s.BlockSize)
+	}
+	if s.SnapshotInterval != 0 && len(s.SnapshotSchedule) != 0 {
+		l.warnf("spec.snapshot_interval",
+			"Volume snapshot interval is ignored, the snapshot schedule is set")
+	}
+}
+
+// driver checks s against the capabilities of d.
+func (l *linter) driver(d Driver, s *api.VolumeSpec) {
+	if haLevel(s) > 1 && d.Type() != api.DriverType_DRIVER_TYPE_CLUSTERED {
+		l.warnf("spec.ha_level", "Volume driver %s does not replicate volumes", d.Name())
+	}
+	if d.Type() == api.DriverType_DRIVER_TYPE_FILE {
+		if s.Format != api.FSType_FS_TYPE_NONE {
+			l.warnf("spec.format", "Volume driver %s does not format volumes", d.Name())
+		}
+		if s.BlockSize != 0 {
+			l.warnf("spec.block_size", "Volume driver %s ignores the block size", d.Name())
+		}
+	}
+}
+
+// haLevel returns the number of replicas of s, a volume without ha level
+// having one.
+func haLevel(s *api.VolumeSpec) int64 {
+	if s.HaLevel == 0 {
+		return 1
+	}
+	return s.HaLevel
+}
@@ -0,0 +1,96 @@
+package speclint
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/tenantpolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDriver is a driver of the given type.
+type fakeDriver api.DriverType
+
+func (d fakeDriver) Name() string         { return "fake" }
+func (d fakeDriver) Type() api.DriverType { return api.DriverType(d) }
+
+// fields returns the fields of the findings of r with severity s.
+func fields(r *Result, s Severity) []string {
+	var fields []string
+	for _, f := range r.Findings {
+		if f.Severity == s {
+			fields = append(fields, f.Field)
+		}
+	}
+	return fields
+}
+
+func TestLint(t *testing.T) {
+	block := fakeDriver(api.DriverType_DRIVER_TYPE_BLOCK)
+
+	r := Lint(block, nil, &Request{
+		Locator: &api.VolumeLocator{Name: "vol"},
+		Spec:    &api.VolumeSpec{Size: 1024, HaLevel: 1, Format: api.FSType_FS_TYPE_EXT4},
+	})
+	assert.True(t, r.Valid)
+	assert.Empty(t, r.Findings)
+
+	r = Lint(block, nil, &Request{})
+	assert.False(t, r.Valid)
+	assert.Equal(t, []string{"locator.name", "spec", "spec.size"}, fields(r, SeverityError))
+
+	r = Lint(block, nil, &Request{
+		Locator: &api.VolumeLocator{Name: "vol"},
+		Spec: &api.VolumeSpec{
+			Size:             1024,
+			HaLevel:          4,
+			BlockSize:        3000,
+			SnapshotInterval: 60,
+			SnapshotSchedule: "daily=12:00",
+		},
+	})
+	assert.False(t, r.Valid)
+	assert.Equal(t, []string{"spec.block_size", "spec.ha_level"}, fields(r, SeverityError))
+	assert.Equal(t, []string{"spec.ha_level", "spec.snapshot_interval"}, fields(r, SeverityWarning))
+}
+
+func TestLintOptions(t *testing.T) {
+	file := fakeDriver(api.DriverType_DRIVER_TYPE_FILE)
+
+	r := Lint(file, nil, &Request{Options: map[string]string{
+		api.Name:           "vol",
+		api.SpecSize:       "10G",
+		api.SpecFilesystem: "xfs",
+		api.SpecPriority:   "extreme",
+		api.SpecSharedv4:   "true",
+		"app":              "db",
+	}})
+	assert.False(t, r.Valid)
+	assert.Equal(t, []string{api.SpecPriority}, fields(r, SeverityError))
+	assert.Equal(t, []string{"spec.format"}, fields(r, SeverityWarning))
+	assert.Equal(t, uint64(10*1024*1024*1024), r.Spec.Size)
+	assert.True(t, r.Spec.Sharedv4)
+	assert.Equal(t, "db", r.Spec.VolumeLabels["app"])
+}
+
+func TestLintPolicies(t *testing.T) {
+	p, err := tenantpolicy.New(&tenantpolicy.Config{Policies: []tenantpolicy.Policy{
+		{Tenant: "acme", Encrypted: true, Size: 1024, MaxSize: 4096},
+	}})
+	require.NoError(t, err)
+	block := fakeDriver(api.DriverType_DRIVER_TYPE_BLOCK)
+	locator := &api.VolumeLocator{
+		Name:         "vol",
+		VolumeLabels: map[string]string{api.LabelTenant: "acme"},
+	}
+
+	r := Lint(block, p, &Request{Locator: locator, Spec: &api.VolumeSpec{}})
+	assert.True(t, r.Valid)
+	assert.True(t, r.Spec.Encrypted)
+	assert.Equal(t, []string{"spec.encrypted", "spec.size"}, fields(r, SeverityWarning))
+
+	r = Lint(block, p, &Request{Locator: locator, Spec: &api.VolumeSpec{Size: 8192}})
+	assert.False(t, r.Valid)
+	assert.Equal(t, []string{"policy"}, fields(r, SeverityError))
+}
@@ -0,0 +1,47 @@
+package statshistory
+
+import (
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/sirupsen/logrus"
+)
+
+// Sample records the cumulative stats of every volume of driver d.
+func Sample(d volume.VolumeDriver, s Store, now time.Time) error {
+	vols, err := d.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		return err
+	}
+	for _, v := range vols {
+		stats, err := d.Stats(v.GetId(), true)
+		if err != nil {
+			logrus.WithField("pkg", "openstorage/statshistory").
+				Debugf("failed to get stats of volume %s: %v", v.GetId(), err)
+			continue
+		}
+		s.Add(v.GetId(), now, stats)
+	}
+	s.Prune(now)
+	return nil
+}
+
+// Start samples the volumes of driver d into the stats history singleton
+// at every interval.
+func Start(driverName string, d volume.VolumeDriver, interval time.Duration) error {
+	s, err := Inst()
+	if err != nil {
+		return err
+	}
+	go func() {
+		for now := range time.Tick(interval) {
+			if err := Sample(d, s, now); err != nil {
+				logrus.WithField("pkg", "openstorage/statshistory").
+					WithField("driver", driverName).
+					Warnf("failed to sample volume stats: %v", err)
+			}
+		}
+	}()
+	return nil
+}
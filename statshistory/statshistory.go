@@ -0,0 +1,107 @@
+// Package statshistory keeps a bounded in-memory history of volume stats and
+// serves downsampled time series from it, so that small deployments can
+// graph volume performance without running a time series database.
+package statshistory
+
+import (
+	"errors"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// Metrics computed from the stats history.
+const (
+	// MetricReadIOPS is the number of reads per second
+	MetricReadIOPS = "read_iops"
+	// MetricWriteIOPS is the number of writes per second
+	MetricWriteIOPS = "write_iops"
+	// MetricReadBPS is the number of bytes read per second
+	MetricReadBPS = "read_bps"
+	// MetricWriteBPS is the number of bytes written per second
+	MetricWriteBPS = "write_bps"
+	// MetricBytesUsed is the number of bytes used by the volume
+	MetricBytesUsed = "bytes_used"
+
+	// DefaultRetention is how long samples are kept when not configured
+	DefaultRetention = 24 * time.Hour
+)
+
+var (
+	// Metrics lists every metric served by a Store
+	Metrics = []string{
+		MetricReadIOPS,
+		MetricWriteIOPS,
+		MetricReadBPS,
+		MetricWriteBPS,
+		MetricBytesUsed,
+	}
+
+	// ErrUnknownMetric returned when a query names an unknown metric
+	ErrUnknownMetric = errors.New("Unknown metric")
+	// ErrNotInitialized returned when the stats history has not been initialized
+	ErrNotInitialized = errors.New("openstorage.statshistory: not initialized")
+	// ErrInitialized returned when the stats history is initialized twice
+	ErrInitialized = errors.New("openstorage.statshistory: already initialized")
+
+	inst Store
+	// Inst returns an instance of an already instantiated stats history.
+	// This function can be overridden for testing purposes
+	Inst = func() (Store, error) {
+		return statsHistoryInst()
+	}
+)
+
+// Config configures the stats history.
+type Config struct {
+	// Interval between samples, the stats history is disabled if unset
+	Interval time.Duration `yaml:"interval"`
+	// Retention is how long samples are kept, DefaultRetention if unset
+	Retention time.Duration `yaml:"retention"`
+}
+
+// Enabled returns true if sampling is configured.
+func (c *Config) Enabled() bool {
+	return c.Interval > 0
+}
+
+// Point is a single value of a time series.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Store holds the stats samples of volumes.
+type Store interface {
+	// Add records a sample of the cumulative stats of a volume.
+	Add(volumeID string, t time.Time, stats *api.Stats)
+	// Prune drops the samples older than the retention, including the
+	// history of volumes that are no longer sampled.
+	Prune(now time.Time)
+	// Volumes returns the ids of the volumes with samples.
+	Volumes() []string
+	// Query returns the metric of a volume between from and to, averaged
+	// over buckets of duration step. A zero step returns every point.
+	Query(volumeID, metric string, from, to time.Time, step time.Duration) ([]Point, error)
+}
+
+// NewStore returns an in-memory store keeping samples for retention.
+func NewStore(retention time.Duration) Store {
+	return newStore(retention)
+}
+
+// Init instantiates the stats history singleton.
+func Init(c *Config) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = newStore(c.Retention)
+	return nil
+}
+
+func statsHistoryInst() (Store, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
@@ -0,0 +1,155 @@
+package statshistory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+type sample struct {
+	time  time.Time
+	stats api.Stats
+}
+
+// store implements Store interface.
+type store struct {
+	sync.RWMutex
+	retention time.Duration
+	samples   map[string][]sample
+}
+
+func newStore(retention time.Duration) *store {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	return &store{retention: retention, samples: make(map[string][]sample)}
+}
+
+func (s *store) Add(volumeID string, t time.Time, stats *api.Stats) {
+	s.Lock()
+	defer s.Unlock()
+
+	samples := append(s.samples[volumeID], sample{time: t, stats: *stats})
+	// samples are appended in time order, drop the expired prefix
+	cutoff := t.Add(-s.retention)
+	i := sort.Search(len(samples), func(i int) bool {
+		return !samples[i].time.Before(cutoff)
+	})
+	s.samples[volumeID] = samples[i:]
+}
+
+func (s *store) Prune(now time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	cutoff := now.Add(-s.retention)
+	for id, samples := range s.samples {
+		i := sort.Search(len(samples), func(i int) bool {
+			return !samples[i].time.Before(cutoff)
+		})
+		if i == len(samples) {
+			delete(s.samples, id)
+		} else {
+			s.samples[id] = samples[i:]
+		}
+	}
+}
+
+func (s *store) Volumes() []string {
+	s.RLock()
+	defer s.RUnlock()
+	ids := make([]string, 0, len(s.samples))
+	for id := range s.samples {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (s *store) Query(
+	volumeID, metric string,
+	from, to time.Time,
+	step time.Duration,
+) ([]Point, error) {
+	value, rate, err := metricFunc(metric)
+	if err != nil {
+		return nil, err
+	}
+
+	s.RLock()
+	samples := s.samples[volumeID]
+	s.RUnlock()
+
+	var points []Point
+	for i, cur := range samples {
+		if cur.time.Before(from) || cur.time.After(to) {
+			continue
+		}
+		if !rate {
+			points = append(points, Point{Time: cur.time, Value: float64(value(&cur.stats))})
+			continue
+		}
+		if i == 0 {
+			continue
+		}
+		prev := samples[i-1]
+		elapsed := cur.time.Sub(prev.time).Seconds()
+		c, p := value(&cur.stats), value(&prev.stats)
+		// skip counter resets and duplicate samples
+		if elapsed <= 0 || c < p {
+			continue
+		}
+		points = append(points, Point{Time: cur.time, Value: float64(c-p) / elapsed})
+	}
+	return Downsample(points, from, step), nil
+}
+
+// Downsample averages points into buckets of duration step starting at
+// from. Each bucket is reported at its start time. A zero step returns the
+// points unchanged.
+func Downsample(points []Point, from time.Time, step time.Duration) []Point {
+	if step <= 0 || len(points) == 0 {
+		return points
+	}
+	var out []Point
+	var sum float64
+	var n int
+	bucket := int64(-1)
+	for _, p := range points {
+		b := int64(p.Time.Sub(from) / step)
+		if b != bucket && n > 0 {
+			out = append(out, Point{
+				Time:  from.Add(time.Duration(bucket) * step),
+				Value: sum / float64(n),
+			})
+			sum, n = 0, 0
+		}
+		bucket = b
+		sum += p.Value
+		n++
+	}
+	return append(out, Point{
+		Time:  from.Add(time.Duration(bucket) * step),
+		Value: sum / float64(n),
+	})
+}
+
+// metricFunc returns the stats field of a metric and whether the metric is
+// the rate of a cumulative counter.
+func metricFunc(metric string) (func(*api.Stats) uint64, bool, error) {
+	switch metric {
+	case MetricReadIOPS:
+		return (*api.Stats).GetReads, true, nil
+	case MetricWriteIOPS:
+		return (*api.Stats).GetWrites, true, nil
+	case MetricReadBPS:
+		return (*api.Stats).GetReadBytes, true, nil
+	case MetricWriteBPS:
+		return (*api.Stats).GetWriteBytes, true, nil
+	case MetricBytesUsed:
+		return (*api.Stats).GetBytesUsed, false, nil
+	default:
+		return nil, false, ErrUnknownMetric
+	}
+}
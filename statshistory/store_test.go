@@ -0,0 +1,102 @@
+package statshistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryRate(t *testing.T) {
+	s := NewStore(time.Hour)
+	start := time.Unix(1000, 0)
+	for i := 0; i < 4; i++ {
+		s.Add("vol1", start.Add(time.Duration(i)*10*time.Second), &api.Stats{
+			Reads:     uint64(i * 100),
+			BytesUsed: uint64(i),
+		})
+	}
+
+	points, err := s.Query("vol1", MetricReadIOPS, start, start.Add(time.Minute), 0)
+	require.NoError(t, err)
+	require.Len(t, points, 3)
+	for _, p := range points {
+		assert.Equal(t, 10.0, p.Value)
+	}
+
+	points, err = s.Query("vol1", MetricBytesUsed, start.Add(15*time.Second), start.Add(time.Minute), 0)
+	require.NoError(t, err)
+	require.Len(t, points, 2)
+	assert.Equal(t, 2.0, points[0].Value)
+
+	_, err = s.Query("vol1", "latency", start, start.Add(time.Minute), 0)
+	assert.Equal(t, ErrUnknownMetric, err)
+}
+
+func TestQueryCounterReset(t *testing.T) {
+	s := NewStore(time.Hour)
+	start := time.Unix(1000, 0)
+	s.Add("vol1", start, &api.Stats{Writes: 500})
+	s.Add("vol1", start.Add(time.Second), &api.Stats{Writes: 10})
+	s.Add("vol1", start.Add(2*time.Second), &api.Stats{Writes: 20})
+
+	points, err := s.Query("vol1", MetricWriteIOPS, start, start.Add(time.Minute), 0)
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+	assert.Equal(t, 10.0, points[0].Value)
+}
+
+func TestDownsample(t *testing.T) {
+	from := time.Unix(0, 0)
+	var points []Point
+	for i := 0; i < 6; i++ {
+		points = append(points, Point{Time: from.Add(time.Duration(i) * time.Second), Value: float64(i)})
+	}
+
+	out := Downsample(points, from, 2*time.Second)
+	require.Len(t, out, 3)
+	assert.Equal(t, Point{Time: from, Value: 0.5}, out[0])
+	assert.Equal(t, Point{Time: from.Add(2 * time.Second), Value: 2.5}, out[1])
+	assert.Equal(t, Point{Time: from.Add(4 * time.Second), Value: 4.5}, out[2])
+
+	assert.Equal(t, points, Downsample(points, from, 0))
+}
+
+func TestRetention(t *testing.T) {
+	s := NewStore(time.Minute)
+	start := time.Unix(1000, 0)
+	s.Add("vol1", start, &api.Stats{})
+	s.Add("vol2", start, &api.Stats{})
+	s.Add("vol1", start.Add(2*time.Minute), &api.Stats{BytesUsed: 1})
+
+	points, err := s.Query("vol1", MetricBytesUsed, start, start.Add(time.Hour), 0)
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+
+	s.Prune(start.Add(2 * time.Minute))
+	assert.Equal(t, []string{"vol1"}, s.Volumes())
+}
+
+func TestSample(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockVolumeDriver(ctrl)
+
+	d.EXPECT().Enumerate(&api.VolumeLocator{}, nil).
+		Return([]*api.Volume{{Id: "vol1"}, {Id: "vol2"}}, nil)
+	d.EXPECT().Stats("vol1", true).Return(&api.Stats{BytesUsed: 42}, nil)
+	d.EXPECT().Stats("vol2", true).Return(nil, assert.AnError)
+
+	s := NewStore(time.Hour)
+	now := time.Unix(1000, 0)
+	require.NoError(t, Sample(d, s, now))
+	assert.Equal(t, []string{"vol1"}, s.Volumes())
+
+	points, err := s.Query("vol1", MetricBytesUsed, now, now, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []Point{{Time: now, Value: 42}}, points)
+}
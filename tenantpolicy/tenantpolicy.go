@@ -0,0 +1,225 @@
+// Package tenantpolicy applies the volume defaults of tenants and
+// namespaces, such as always encrypting their volumes, to the volumes they
+// create. The policy of a tenant applies first, then the policy of the
+// namespace, so that a namespace inherits the defaults of its tenant and
+// refines them.
+package tenantpolicy
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+)
+
+var (
+	// ErrNotInitialized returned when the policies have not been initialized
+	ErrNotInitialized = errors.New("openstorage.tenantpolicy: not initialized")
+	// ErrInitialized returned when the policies are initialized twice
+	ErrInitialized = errors.New("openstorage.tenantpolicy: already initialized")
+
+	inst *Policies
+)
+
+// Config declares the tenant and namespace policies.
+type Config struct {
+	// Policies are the policies, of a tenant or of a namespace each
+	Policies []Policy `yaml:"policies"`
+}
+
+// Enabled returns true if a policy is declared.
+func (c *Config) Enabled() bool {
+	return len(c.Policies) != 0
+}
+
+// Policy sets the spec of the volumes created by a tenant or in a
+// namespace, as set by their api.LabelTenant or api.LabelNamespace label.
+type Policy struct {
+	// Tenant is the tenant whose volumes the policy applies to
+	Tenant string `yaml:"tenant"`
+	// Namespace is the namespace whose volumes the policy applies to
+	Namespace string `yaml:"namespace"`
+	// Encrypted encrypts the volumes. A volume cannot request to be left
+	// unencrypted, even if overrides are allowed.
+	Encrypted bool `yaml:"encrypted"`
+	// Size in bytes of the volumes created without a size
+	Size uint64 `yaml:"size"`
+	// MaxSize rejects the volumes larger, unless overrides are allowed
+	MaxSize uint64 `yaml:"max_size"`
+	// SnapshotSchedule replaces the snapshot schedule of the volumes, or
+	// only sets it on the volumes created without one if overrides are
+	// allowed
+	SnapshotSchedule string `yaml:"snapshot_schedule"`
+	// AllowOverride lets the volumes exceed MaxSize and keep the snapshot
+	// schedule they request
+	AllowOverride bool `yaml:"allow_override"`
+}
+
+// PolicyError is returned by Create for a volume rejected by a policy.
+type PolicyError struct {
+	// Policy is the tenant or the namespace of the policy
+	Policy string
+	// Reason the volume was rejected
+	Reason string
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("Volume rejected by the policy of %s: %s", e.Policy, e.Reason)
+}
+
+// Policies holds the policies by tenant and by namespace.
+type Policies struct {
+	tenants    map[string]*Policy
+	namespaces map[string]*Policy
+}
+
+// New validates the policies of c.
+func New(c *Config) (*Policies, error) {
+	p := &Policies{
+		tenants:    make(map[string]*Policy),
+		namespaces: make(map[string]*Policy),
+	}
+	for i := range c.Policies {
+		policy := &c.Policies[i]
+		var policies map[string]*Policy
+		switch {
+		case len(policy.Tenant) != 0 && len(policy.Namespace) != 0:
+			return nil, fmt.Errorf("tenant policy %d sets both a tenant and a namespace", i)
+		case len(policy.Tenant) != 0:
+			policies = p.tenants
+		case len(policy.Namespace) != 0:
+			policies = p.namespaces
+		default:
+			return nil, fmt.Errorf("tenant policy %d sets no tenant or namespace", i)
+		}
+		if _, ok := policies[policy.name()]; ok {
+			return nil, fmt.Errorf("policy of %s is declared twice", policy.String())
+		}
+		if policy.MaxSize > 0 && policy.Size > policy.MaxSize {
+			return nil, fmt.Errorf("policy of %s: size %d is larger than max size %d",
+				policy.String(), policy.Size, policy.MaxSize)
+		}
+		policies[policy.name()] = policy
+	}
+	return p, nil
+}
+
+// Init sets the tenant policies singleton.
+func Init(p *Policies) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = p
+	return nil
+}
+
+// Inst returns the tenant policies singleton.
+func Inst() (*Policies, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// Spec returns a copy of spec with the policies of the tenant and the
+// namespace of the volume created with locator applied, tenant first.
+// Errors PolicyError may be returned.
+func (p *Policies) Spec(locator *api.VolumeLocator, spec *api.VolumeSpec) (*api.VolumeSpec, error) {
+	requested := spec
+	if requested == nil {
+		requested = &api.VolumeSpec{}
+	}
+	applied := proto.Clone(requested).(*api.VolumeSpec)
+	tenant := label(locator, spec, api.LabelTenant)
+	namespace := label(locator, spec, api.LabelNamespace)
+	var policies []*Policy
+	for _, policy := range []*Policy{p.tenants[tenant], p.namespaces[namespace]} {
+		if policy != nil {
+			policies = append(policies, policy)
+		}
+	}
+	for _, policy := range policies {
+		policy.apply(applied, requested)
+	}
+	for _, policy := range policies {
+		if err := policy.check(applied); err != nil {
+			return nil, err
+		}
+	}
+	return applied, nil
+}
+
+// label returns the value of the label key of the volume created with
+// locator and spec, the labels of the locator taking precedence.
+func label(locator *api.VolumeLocator, spec *api.VolumeSpec, key string) string {
+	if v, ok := locator.GetVolumeLabels()[key]; ok {
+		return v
+	}
+	return spec.GetVolumeLabels()[key]
+}
+
+func (p *Policy) name() string {
+	if len(p.Tenant) != 0 {
+		return p.Tenant
+	}
+	return p.Namespace
+}
+
+func (p *Policy) String() string {
+	if len(p.Tenant) != 0 {
+		return "tenant " + p.Tenant
+	}
+	return "namespace " + p.Namespace
+}
+
+// apply sets the fields of spec following the policy, those of the
+// volume requested with requested unset or not overridable.
+func (p *Policy) apply(spec *api.VolumeSpec, requested *api.VolumeSpec) {
+	if p.Encrypted {
+		spec.Encrypted = true
+	}
+	if requested.Size == 0 && p.Size != 0 {
+		spec.Size = p.Size
+	}
+	if len(p.SnapshotSchedule) != 0 && (!p.AllowOverride || len(requested.SnapshotSchedule) == 0) {
+		spec.SnapshotSchedule = p.SnapshotSchedule
+	}
+}
+
+// check returns an error if spec is beyond the limits of the policy.
+func (p *Policy) check(spec *api.VolumeSpec) error {
+	if p.MaxSize > 0 && spec.Size > p.MaxSize && !p.AllowOverride {
+		return &PolicyError{
+			Policy: p.String(),
+			Reason: fmt.Sprintf("size %d is larger than %d", spec.Size, p.MaxSize),
+		}
+	}
+	return nil
+}
+
+// Wrap returns d applying the policies to the volumes it creates.
+func (p *Policies) Wrap(d volume.VolumeDriver) volume.VolumeDriver {
+	return &policyDriver{VolumeDriver: d, policies: p}
+}
+
+// policyDriver is a volume driver creating volumes following the policies.
+type policyDriver struct {
+	volume.VolumeDriver
+	policies *Policies
+}
+
+// Create creates the volume with the spec set by the policies of its tenant
+// and namespace.
+func (d *policyDriver) Create(
+	locator *api.VolumeLocator,
+	source *api.Source,
+	spec *api.VolumeSpec,
+) (string, error) {
+	spec, err := d.policies.Spec(locator, spec)
+	if err != nil {
+		return "", err
+	}
+	return d.VolumeDriver.Create(locator, source, spec)
+}
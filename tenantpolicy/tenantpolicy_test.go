@@ -0,0 +1,112 @@
+package tenantpolicy
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume/drivers/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPolicies(t *testing.T) *Policies {
+	p, err := New(&Config{Policies: []Policy{
+		{Tenant: "acme", Encrypted: true, Size: 1024, MaxSize: 4096, SnapshotSchedule: "daily=12:00"},
+		{Namespace: "dev", Size: 2048, SnapshotSchedule: "periodic=60", AllowOverride: true},
+	}})
+	require.NoError(t, err)
+	return p
+}
+
+func locator(tenant, namespace string) *api.VolumeLocator {
+	labels := make(map[string]string)
+	if len(tenant) != 0 {
+		labels[api.LabelTenant] = tenant
+	}
+	if len(namespace) != 0 {
+		labels[api.LabelNamespace] = namespace
+	}
+	return &api.VolumeLocator{Name: "vol", VolumeLabels: labels}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(&Config{Policies: []Policy{{Encrypted: true}}})
+	assert.Error(t, err)
+	_, err = New(&Config{Policies: []Policy{{Tenant: "acme", Namespace: "dev"}}})
+	assert.Error(t, err)
+	_, err = New(&Config{Policies: []Policy{{Tenant: "acme"}, {Tenant: "acme"}}})
+	assert.Error(t, err)
+	_, err = New(&Config{Policies: []Policy{{Tenant: "acme"}, {Namespace: "acme"}}})
+	assert.NoError(t, err)
+	_, err = New(&Config{Policies: []Policy{{Namespace: "dev", Size: 2, MaxSize: 1}}})
+	assert.Error(t, err)
+}
+
+func TestSpec(t *testing.T) {
+	p := newTestPolicies(t)
+
+	// the tenant policy is enforced
+	spec, err := p.Spec(locator("acme", ""), &api.VolumeSpec{SnapshotSchedule: "weekly=sunday@12:00"})
+	require.NoError(t, err)
+	assert.True(t, spec.Encrypted)
+	assert.Equal(t, uint64(1024), spec.Size)
+	assert.Equal(t, "daily=12:00", spec.SnapshotSchedule)
+
+	_, err = p.Spec(locator("acme", ""), &api.VolumeSpec{Size: 8192})
+	assert.IsType(t, &PolicyError{}, err)
+
+	// the namespace inherits the tenant policy and refines its defaults
+	spec, err = p.Spec(locator("acme", "dev"), nil)
+	require.NoError(t, err)
+	assert.True(t, spec.Encrypted)
+	assert.Equal(t, uint64(2048), spec.Size)
+	assert.Equal(t, "periodic=60", spec.SnapshotSchedule)
+
+	// the namespace policy allows overrides, not the tenant one
+	spec, err = p.Spec(locator("", "dev"), &api.VolumeSpec{Size: 8192, SnapshotSchedule: "weekly=sunday@12:00"})
+	require.NoError(t, err)
+	assert.False(t, spec.Encrypted)
+	assert.Equal(t, uint64(8192), spec.Size)
+	assert.Equal(t, "weekly=sunday@12:00", spec.SnapshotSchedule)
+
+	_, err = p.Spec(locator("acme", "dev"), &api.VolumeSpec{Size: 8192})
+	assert.IsType(t, &PolicyError{}, err)
+
+	// the labels of the spec select the policies too
+	spec, err = p.Spec(&api.VolumeLocator{Name: "vol"}, &api.VolumeSpec{
+		VolumeLabels: map[string]string{api.LabelTenant: "acme"},
+	})
+	require.NoError(t, err)
+	assert.True(t, spec.Encrypted)
+
+	// volumes without a policy are created as requested
+	requested := &api.VolumeSpec{Size: 8192}
+	spec, err = p.Spec(locator("other", "prod"), requested)
+	require.NoError(t, err)
+	assert.Equal(t, requested, spec)
+}
+
+func TestWrap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mock.NewMockVolumeDriver(ctrl)
+	p := newTestPolicies(t)
+	d := p.Wrap(m)
+
+	// the volume is created with the spec of the policy
+	requested := &api.VolumeSpec{Size: 512}
+	spec, err := p.Spec(locator("acme", ""), requested)
+	require.NoError(t, err)
+	assert.True(t, spec.Encrypted)
+	assert.Equal(t, uint64(512), spec.Size)
+	m.EXPECT().Create(locator("acme", ""), &api.Source{}, spec).Return("vol", nil)
+	id, err := d.Create(locator("acme", ""), &api.Source{}, requested)
+	require.NoError(t, err)
+	assert.Equal(t, "vol", id)
+	assert.False(t, requested.Encrypted)
+
+	// the volumes rejected by the policy are not created
+	_, err = d.Create(locator("acme", ""), &api.Source{}, &api.VolumeSpec{Size: 8192})
+	assert.Error(t, err)
+}
@@ -0,0 +1,218 @@
+// Package transform rewrites the labels and the spec of the volumes created
+// by restoring a backup or cloning a volume, following restore policies, so
+// that a production volume restored into a staging environment does not
+// carry its production labels, class of service, placement or size.
+package transform
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/libopenstorage/openstorage/api"
+)
+
+var (
+	// ErrNotInitialized returned when the policies have not been initialized
+	ErrNotInitialized = errors.New("openstorage.transform: not initialized")
+	// ErrInitialized returned when the policies are initialized twice
+	ErrInitialized = errors.New("openstorage.transform: already initialized")
+
+	inst *Policies
+)
+
+// Config declares the restore policies.
+type Config struct {
+	// Policies are the restore policies, selected by name on restore or clone
+	Policies []Policy `yaml:"policies"`
+	// Default is the name of the policy applied to the restores and the
+	// clones which name none, no policy if unset
+	Default string `yaml:"default"`
+}
+
+// Policy transforms the volumes restored or cloned with it.
+type Policy struct {
+	// Name identifies the policy
+	Name string `yaml:"name"`
+	// StripLabels are the keys of the labels removed, a key ending with *
+	// removes the labels with that prefix, e.g. prod.io/*
+	StripLabels []string `yaml:"strip_labels"`
+	// SetLabels are added to the labels, after the stripped ones are removed
+	SetLabels map[string]string `yaml:"set_labels"`
+	// Cos sets the class of service, such as low, kept if unset
+	Cos string `yaml:"cos"`
+	// IoProfile sets the IO profile, such as sequential, kept if unset
+	IoProfile string `yaml:"io_profile"`
+	// HaLevel caps the number of replicas, kept if unset
+	HaLevel int64 `yaml:"ha_level"`
+	// MaxSize caps the size in bytes, kept if unset. Drivers may refuse to
+	// shrink a volume below the space already used.
+	MaxSize uint64 `yaml:"max_size"`
+	// ClearPlacement drops the replica set, so that the driver places the
+	// replicas itself
+	ClearPlacement bool `yaml:"clear_placement"`
+	// ClearSnapshotSchedule drops the snapshot schedule
+	ClearSnapshotSchedule bool `yaml:"clear_snapshot_schedule"`
+}
+
+// Policies holds the restore policies by name.
+type Policies struct {
+	policies map[string]*Policy
+	def      string
+}
+
+// New validates the policies of c.
+func New(c *Config) (*Policies, error) {
+	p := &Policies{policies: make(map[string]*Policy), def: c.Default}
+	for i := range c.Policies {
+		policy := &c.Policies[i]
+		if len(policy.Name) == 0 {
+			return nil, fmt.Errorf("restore policy %d has no name", i)
+		}
+		if _, ok := p.policies[policy.Name]; ok {
+			return nil, fmt.Errorf("restore policy %s is declared twice", policy.Name)
+		}
+		if _, err := enumValue(api.CosType_value, "", policy.Cos); err != nil {
+			return nil, fmt.Errorf("restore policy %s: %v", policy.Name, err)
+		}
+		if _, err := enumValue(api.IoProfile_value, "IO_PROFILE_", policy.IoProfile); err != nil {
+			return nil, fmt.Errorf("restore policy %s: %v", policy.Name, err)
+		}
+		p.policies[policy.Name] = policy
+	}
+	if len(p.def) != 0 {
+		if _, ok := p.policies[p.def]; !ok {
+			return nil, fmt.Errorf("default restore policy %s is not declared", p.def)
+		}
+	}
+	return p, nil
+}
+
+// Init sets the restore policies singleton.
+func Init(p *Policies) error {
+	if inst != nil {
+		return ErrInitialized
+	}
+	inst = p
+	return nil
+}
+
+// Inst returns the restore policies singleton.
+func Inst() (*Policies, error) {
+	if inst == nil {
+		return nil, ErrNotInitialized
+	}
+	return inst, nil
+}
+
+// Select returns the policy named name, the default policy if name is
+// empty, nil if there is none.
+func (p *Policies) Select(name string) (*Policy, error) {
+	if len(name) == 0 {
+		name = p.def
+		if len(name) == 0 {
+			return nil, nil
+		}
+	}
+	policy, ok := p.policies[name]
+	if !ok {
+		return nil, fmt.Errorf("restore policy %s not found", name)
+	}
+	return policy, nil
+}
+
+// Select returns the policy named name, or the default one, of the restore
+// policies singleton. A name is an error if the policies are not
+// initialized, no name selects no policy.
+func Select(name string) (*Policy, error) {
+	p, err := Inst()
+	if err != nil {
+		if len(name) == 0 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return p.Select(name)
+}
+
+// Locator returns a copy of locator with the labels of the policy.
+func (p *Policy) Locator(locator *api.VolumeLocator) *api.VolumeLocator {
+	transformed := &api.VolumeLocator{
+		Name:         locator.GetName(),
+		VolumeLabels: make(map[string]string),
+	}
+	for k, v := range locator.GetVolumeLabels() {
+		if !p.strip(k) {
+			transformed.VolumeLabels[k] = v
+		}
+	}
+	for k, v := range p.SetLabels {
+		transformed.VolumeLabels[k] = v
+	}
+	return transformed
+}
+
+// Spec returns a copy of spec transformed by the policy.
+func (p *Policy) Spec(spec *api.VolumeSpec) *api.VolumeSpec {
+	transformed := proto.Clone(spec).(*api.VolumeSpec)
+	if len(p.Cos) != 0 {
+		v, _ := enumValue(api.CosType_value, "", p.Cos)
+		transformed.Cos = api.CosType(v)
+	}
+	if len(p.IoProfile) != 0 {
+		v, _ := enumValue(api.IoProfile_value, "IO_PROFILE_", p.IoProfile)
+		transformed.IoProfile = api.IoProfile(v)
+	}
+	if p.HaLevel > 0 && transformed.HaLevel > p.HaLevel {
+		transformed.HaLevel = p.HaLevel
+	}
+	if p.MaxSize > 0 && transformed.Size > p.MaxSize {
+		transformed.Size = p.MaxSize
+	}
+	if p.ClearPlacement {
+		transformed.ReplicaSet = nil
+	}
+	if p.ClearSnapshotSchedule {
+		transformed.SnapshotSchedule = ""
+		transformed.SnapshotInterval = 0
+	}
+	transformed.VolumeLabels = make(map[string]string, len(spec.GetVolumeLabels()))
+	for k, v := range spec.GetVolumeLabels() {
+		if !p.strip(k) {
+			transformed.VolumeLabels[k] = v
+		}
+	}
+	return transformed
+}
+
+// strip returns true if the policy removes the label key.
+func (p *Policy) strip(key string) bool {
+	for _, pattern := range p.StripLabels {
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(key, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+		} else if key == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// enumValue returns the value of the enum named name, with or without
+// prefix, in any case, zero if name is empty.
+func enumValue(values map[string]int32, prefix, name string) (int32, error) {
+	if len(name) == 0 {
+		return 0, nil
+	}
+	upper := strings.ToUpper(name)
+	if !strings.HasPrefix(upper, prefix) {
+		upper = prefix + upper
+	}
+	v, ok := values[upper]
+	if !ok {
+		return 0, fmt.Errorf("unknown value %s", name)
+	}
+	return v, nil
+}
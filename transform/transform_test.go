@@ -0,0 +1,84 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy(t *testing.T) {
+	p, err := New(&Config{
+		Default: "staging",
+		Policies: []Policy{
+			{
+				Name:                  "staging",
+				StripLabels:           []string{"env", "prod.io/*"},
+				SetLabels:             map[string]string{"env": "staging"},
+				Cos:                   "low",
+				IoProfile:             "sequential",
+				HaLevel:               1,
+				MaxSize:               100,
+				ClearPlacement:        true,
+				ClearSnapshotSchedule: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// no name selects the default policy
+	policy, err := p.Select("")
+	require.NoError(t, err)
+	require.NotNil(t, policy)
+	_, err = p.Select("missing")
+	assert.Error(t, err)
+
+	locator := policy.Locator(&api.VolumeLocator{
+		Name: "vol",
+		VolumeLabels: map[string]string{
+			"app":          "db",
+			"env":          "prod",
+			"prod.io/tier": "gold",
+		},
+	})
+	assert.Equal(t, "vol", locator.GetName())
+	assert.Equal(t, map[string]string{"app": "db", "env": "staging"}, locator.GetVolumeLabels())
+
+	parent := &api.VolumeSpec{
+		Size:             1000,
+		HaLevel:          3,
+		Cos:              api.CosType_HIGH,
+		IoProfile:        api.IoProfile_IO_PROFILE_DB,
+		ReplicaSet:       &api.ReplicaSet{Nodes: []string{"node1"}},
+		SnapshotSchedule: "daily=12:00",
+		VolumeLabels:     map[string]string{"prod.io/zone": "a", "fs": "ext4"},
+	}
+	spec := policy.Spec(parent)
+	assert.Equal(t, uint64(100), spec.GetSize())
+	assert.Equal(t, int64(1), spec.GetHaLevel())
+	assert.Equal(t, api.CosType_LOW, spec.GetCos())
+	assert.Equal(t, api.IoProfile_IO_PROFILE_SEQUENTIAL, spec.GetIoProfile())
+	assert.Nil(t, spec.GetReplicaSet())
+	assert.Empty(t, spec.GetSnapshotSchedule())
+	assert.Equal(t, map[string]string{"fs": "ext4"}, spec.GetVolumeLabels())
+
+	// the spec of the parent is unchanged
+	assert.Equal(t, uint64(1000), parent.GetSize())
+	assert.NotNil(t, parent.GetReplicaSet())
+	assert.Len(t, parent.GetVolumeLabels(), 2)
+
+	// the smaller volumes keep their size and replicas
+	spec = policy.Spec(&api.VolumeSpec{Size: 10, HaLevel: 1})
+	assert.Equal(t, uint64(10), spec.GetSize())
+	assert.Equal(t, int64(1), spec.GetHaLevel())
+}
+
+func TestNewInvalid(t *testing.T) {
+	_, err := New(&Config{Policies: []Policy{{Name: "a", Cos: "gold"}}})
+	assert.Error(t, err)
+	_, err = New(&Config{Policies: []Policy{{Name: "a"}, {Name: "a"}}})
+	assert.Error(t, err)
+	_, err = New(&Config{Default: "a"})
+	assert.Error(t, err)
+}
@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
-	"syscall"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/pkg/chaos"
+	"github.com/libopenstorage/openstorage/pkg/mount"
 	"github.com/libopenstorage/openstorage/pkg/proto/time"
 	"github.com/libopenstorage/openstorage/pkg/storageops"
 	aws_ops "github.com/libopenstorage/openstorage/pkg/storageops/aws"
@@ -36,6 +36,11 @@ const (
 	awsAccessKeyID = "AWS_ACCESS_KEY_ID"
 	// awsSecretAccessKey identifier for authentication.
 	awsSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+	// tagReconcileIntervalKey is the driver parameter for how often volume
+	// tags on EBS are reconciled with openstorage labels. 0 disables.
+	tagReconcileIntervalKey = "TAG_RECONCILE_INTERVAL"
+	// defaultTagReconcileInterval is used when tagReconcileIntervalKey is not set
+	defaultTagReconcileInterval = time.Hour
 )
 
 var (
@@ -58,8 +63,15 @@ type Driver struct {
 	volume.CredsDriver
 	volume.CloudBackupDriver
 	volume.CloudMigrateDriver
+	volume.EncryptionDriver
+	volume.WipeDriver
+	volume.CloneDriver
+	volume.ResizeDriver
+	volume.PoolDriver
 	ops storageops.Ops
 	md  *Metadata
+	// tagLabels are the keys of the volume labels propagated as EBS tags
+	tagLabels []string
 }
 
 // Init aws volume driver metadata.
@@ -104,7 +116,23 @@ func Init(params map[string]string) (volume.VolumeDriver, error) {
 		CredsDriver:        volume.CredsNotSupported,
 		CloudBackupDriver:  volume.CloudBackupNotSupported,
 		CloudMigrateDriver: volume.CloudMigrateNotSupported,
+		EncryptionDriver:   volume.EncryptionNotSupported,
+		WipeDriver:         volume.WipeNotSupported,
+		CloneDriver:        volume.CloneNotSupported,
+		ResizeDriver:       volume.ResizeNotSupported,
+		PoolDriver:         volume.PoolNotSupported,
 		StoreEnumerator:    common.NewDefaultStoreEnumerator(Name, kvdb.Instance()),
+		tagLabels:          common.TagLabels(params),
+	}
+
+	interval := defaultTagReconcileInterval
+	if v, ok := params[tagReconcileIntervalKey]; ok {
+		if interval, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("Invalid %s: %v", tagReconcileIntervalKey, err)
+		}
+	}
+	if interval > 0 {
+		go d.reconcileTagsLoop(interval)
 	}
 	return d, nil
 }
@@ -244,6 +272,9 @@ func (d *Driver) Create(
 	if err != nil {
 		return "", err
 	}
+	if err := storageops.ReconcileTags(d.ops, volume.Id, common.CloudTags(volume, d.tagLabels)); err != nil {
+		logrus.Warnf("Failed to tag volume %s: %v", volume.Id, err)
+	}
 	if _, err := d.Attach(volume.Id, nil); err != nil {
 		return "", err
 	}
@@ -449,6 +480,12 @@ func (d *Driver) Format(volumeID string) error {
 		return err
 	}
 	volume.Format = volume.Spec.Format
+	// The filesystem is not verified on mount if its UUID is unknown.
+	if fsUUID, err := mount.FsUUID(devicePath); err != nil {
+		logrus.Warnf("Failed to get the filesystem UUID of volume %s: %v", volumeID, err)
+	} else {
+		volume.FsUuid = fsUUID
+	}
 	return d.UpdateVol(volume)
 }
 
@@ -496,7 +533,10 @@ func (d *Driver) Mount(volumeID string, mountpath string, options map[string]str
 	if err != nil {
 		return err
 	}
-	err = syscall.Mount(devicePath, mountpath, volume.Spec.Format.SimpleString(), 0, "")
+	if err := mount.VerifyFsUUID(volume, devicePath); err != nil {
+		return err
+	}
+	err = mount.DefaultMountImpl().Mount(devicePath, mountpath, volume.Spec.Format.SimpleString(), 0, "", 0)
 	if err != nil {
 		return err
 	}
@@ -505,7 +545,7 @@ func (d *Driver) Mount(volumeID string, mountpath string, options map[string]str
 
 func (d *Driver) Unmount(volumeID string, mountpath string, options map[string]string) error {
 	// XXX:  determine if valid mount path
-	err := syscall.Unmount(mountpath, 0)
+	err := mount.DefaultMountImpl().Unmount(mountpath, 0, 0)
 	return err
 }
 
@@ -520,3 +560,20 @@ func (d *Driver) Set(volumeID string, locator *api.VolumeLocator, spec *api.Volu
 func (d *Driver) Catalog(volumeID, path, depth string) (api.CatalogResponse, error) {
 	return api.CatalogResponse{}, volume.ErrNotSupported
 }
+
+// reconcileTagsLoop periodically corrects drift between openstorage labels
+// and the tags on the EBS volumes.
+func (d *Driver) reconcileTagsLoop(interval time.Duration) {
+	for range time.Tick(interval) {
+		d.reconcileTags()
+	}
+}
+
+func (d *Driver) reconcileTags() {
+	vols, err := d.StoreEnumerator.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		logrus.Warnf("Failed to enumerate volumes for tag reconciliation: %v", err)
+		return
+	}
+	common.ReconcileCloudTags(d.ops, vols, d.tagLabels)
+}
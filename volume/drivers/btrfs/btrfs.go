@@ -13,9 +13,9 @@ import (
 	"github.com/docker/docker/daemon/graphdriver/btrfs"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/pkg/chaos"
+	"github.com/libopenstorage/openstorage/pkg/volumeid"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers/common"
-	"github.com/pborman/uuid"
 	"github.com/portworx/kvdb"
 )
 
@@ -49,8 +49,12 @@ func Init(params map[string]string) (volume.VolumeDriver, error) {
 	if err != nil {
 		return nil, err
 	}
+	store, err := common.NewStoreEnumerator(Name, kvdb.Instance(), params)
+	if err != nil {
+		return nil, err
+	}
 	return &driver{
-		common.NewDefaultStoreEnumerator(Name, kvdb.Instance()),
+		store,
 		common.IONotSupported,
 		common.BlockNotSupported,
 		d,
@@ -87,7 +91,7 @@ func (d *driver) Create(
 		return "", fmt.Errorf("Filesystem format (%v) must be %v", spec.Format.SimpleString(), api.FSType_FS_TYPE_BTRFS.SimpleString())
 	}
 	volume := common.NewVolume(
-		uuid.New(),
+		volumeid.New(),
 		api.FSType_FS_TYPE_BTRFS,
 		locator,
 		source,
@@ -165,7 +169,7 @@ func (d *driver) Snapshot(volumeID string, readonly bool, locator *api.VolumeLoc
 	if len(vols) != 1 {
 		return "", fmt.Errorf("Failed to inspect %v len %v", volumeID, len(vols))
 	}
-	snapID := uuid.New()
+	snapID := volumeid.New()
 	vols[0].Id = snapID
 	vols[0].Source = &api.Source{Parent: volumeID}
 	vols[0].Locator = locator
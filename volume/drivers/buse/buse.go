@@ -3,20 +3,23 @@ package buse
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"path"
-	"strings"
 	"syscall"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/cluster"
 	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
+	"github.com/libopenstorage/openstorage/pkg/mount"
+	"github.com/libopenstorage/openstorage/pkg/reflink"
+	"github.com/libopenstorage/openstorage/pkg/volumeid"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers/common"
-	"github.com/pborman/uuid"
 	"github.com/portworx/kvdb"
 )
 
@@ -40,6 +43,9 @@ type driver struct {
 	volume.CredsDriver
 	volume.CloudBackupDriver
 	volume.CloudMigrateDriver
+	volume.EncryptionDriver
+	volume.WipeDriver
+	volume.PoolDriver
 	buseDevices map[string]*buseDev
 	cl          cluster.ClusterListener
 }
@@ -94,15 +100,21 @@ func copyFile(source string, dest string) (err error) {
 func Init(params map[string]string) (volume.VolumeDriver, error) {
 	nbdInit()
 
+	store, err := common.NewStoreEnumerator(Name, kvdb.Instance(), params)
+	if err != nil {
+		return nil, err
+	}
 	inst := &driver{
-		IODriver: volume.IONotSupported,
-		StoreEnumerator: common.NewDefaultStoreEnumerator(Name,
-			kvdb.Instance()),
+		IODriver:           volume.IONotSupported,
+		StoreEnumerator:    store,
 		StatsDriver:        volume.StatsNotSupported,
 		QuiesceDriver:      volume.QuiesceNotSupported,
 		CredsDriver:        volume.CredsNotSupported,
 		CloudBackupDriver:  volume.CloudBackupNotSupported,
 		CloudMigrateDriver: volume.CloudMigrateNotSupported,
+		EncryptionDriver:   volume.EncryptionNotSupported,
+		WipeDriver:         volume.WipeNotSupported,
+		PoolDriver:         volume.PoolNotSupported,
 	}
 	inst.buseDevices = make(map[string]*buseDev)
 	if err := os.MkdirAll(BuseMountPath, 0744); err != nil {
@@ -169,8 +181,7 @@ func (d *driver) Create(
 	source *api.Source,
 	spec *api.VolumeSpec,
 ) (string, error) {
-	volumeID := uuid.New()
-	volumeID = strings.TrimSuffix(volumeID, "\n")
+	volumeID := volumeid.New()
 	if spec.Size == 0 {
 		return "", fmt.Errorf("Volume size cannot be zero: buse")
 	}
@@ -212,6 +223,11 @@ func (d *driver) Create(
 		return "", err
 	}
 
+	fsUUID, err := mount.FsUUID(dev)
+	if err != nil {
+		return "", err
+	}
+
 	logrus.Infof("BUSE mapped NBD device %s (size=%v) to block file %s", dev,
 		spec.Size, buseFile)
 
@@ -223,6 +239,7 @@ func (d *driver) Create(
 		spec,
 	)
 	v.DevicePath = dev
+	v.FsUuid = fsUUID
 
 	d.buseDevices[dev] = bd
 
@@ -275,7 +292,10 @@ func (d *driver) Mount(volumeID string, mountpath string, options map[string]str
 	if len(v.AttachPath) > 0 && len(v.AttachPath) > 0 {
 		return fmt.Errorf("Volume %q already mounted at %q", volumeID, v.AttachPath[0])
 	}
-	if err := syscall.Mount(v.DevicePath, mountpath, v.Spec.Format.SimpleString(), 0, ""); err != nil {
+	if err := mount.VerifyFsUUID(v, v.DevicePath); err != nil {
+		return err
+	}
+	if err := mount.DefaultMountImpl().Mount(v.DevicePath, mountpath, v.Spec.Format.SimpleString(), 0, "", 0); err != nil {
 		return fmt.Errorf("Failed to mount %v at %v: %v", v.DevicePath, mountpath, err)
 	}
 
@@ -296,7 +316,7 @@ func (d *driver) Unmount(volumeID string, mountpath string, options map[string]s
 	if len(v.AttachPath) == 0 || len(v.AttachPath[0]) == 0 {
 		return fmt.Errorf("Device %v not mounted", volumeID)
 	}
-	if err := syscall.Unmount(v.AttachPath[0], 0); err != nil {
+	if err := mount.DefaultMountImpl().Unmount(v.AttachPath[0], 0, 0); err != nil {
 		return err
 	}
 	v.AttachPath = nil
@@ -323,17 +343,63 @@ func (d *driver) Snapshot(volumeID string, readonly bool, locator *api.VolumeLoc
 		d.Delete(newVolumeID)
 		return "", nil
 	}
+	// the copy has the filesystem of the parent
+	if err := d.setFsUUID(newVolumeID, vols[0].FsUuid); err != nil {
+		return "", err
+	}
 
 	return newVolumeID, nil
 }
 
+// Clone creates a volume with the spec of the volume and copies its block
+// file, sharing its extents where the filesystem of BuseMountPath supports
+// it.
+func (d *driver) Clone(volumeID string, locator *api.VolumeLocator) (string, error) {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return "", err
+	}
+	newVolumeID, err := d.Create(locator, &api.Source{}, proto.Clone(v.Spec).(*api.VolumeSpec))
+	if err != nil {
+		return "", err
+	}
+	if err := reflink.CopyFile(BuseMountPath+volumeID, BuseMountPath+newVolumeID); err != nil {
+		d.Delete(newVolumeID)
+		return "", err
+	}
+	// the copy has the filesystem of the volume
+	if err := d.setFsUUID(newVolumeID, v.FsUuid); err != nil {
+		return "", err
+	}
+	return newVolumeID, nil
+}
+
+// setFsUUID records the filesystem UUID of volumeID, once its block file is copied.
+func (d *driver) setFsUUID(volumeID string, fsUUID string) error {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	v.FsUuid = fsUUID
+	return d.UpdateVol(v)
+}
+
 func (d *driver) Restore(volumeID string, snapID string) error {
-	if _, err := d.Inspect([]string{volumeID, snapID}); err != nil {
+	vols, err := d.Inspect([]string{volumeID, snapID})
+	if err != nil {
 		return err
 	}
 
 	// BUSE does not support restore, so just copy the block files.
-	return copyFile(BuseMountPath+snapID, BuseMountPath+volumeID)
+	if err := copyFile(BuseMountPath+snapID, BuseMountPath+volumeID); err != nil {
+		return err
+	}
+	for _, v := range vols {
+		if v.Id == snapID {
+			return d.setFsUUID(volumeID, v.FsUuid)
+		}
+	}
+	return nil
 }
 
 func (d *driver) SnapshotGroup(groupID string, labels map[string]string) (*api.GroupSnapCreateResponse, error) {
@@ -355,6 +421,68 @@ func (d *driver) Set(volumeID string, locator *api.VolumeLocator, spec *api.Volu
 	return d.UpdateVol(v)
 }
 
+// Resize grows the block file of the volume and its NBD device, then its
+// filesystem. An unmounted xfs or btrfs filesystem is mounted in a
+// temporary directory to be grown.
+func (d *driver) Resize(volumeID string, newSize uint64) error {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	if newSize < v.Spec.Size {
+		return fmt.Errorf("Cannot shrink volume %s from %d to %d bytes: %v",
+			volumeID, v.Spec.Size, newSize, volume.ErrEinval)
+	}
+	if newSize == v.Spec.Size {
+		return nil
+	}
+	bd, ok := d.buseDevices[v.DevicePath]
+	if !ok {
+		return fmt.Errorf("Cannot locate a BUSE device for %s", v.DevicePath)
+	}
+	if err := bd.f.Truncate(int64(newSize)); err != nil {
+		return err
+	}
+	if err := bd.nbd.Size(int64(newSize)); err != nil {
+		return err
+	}
+	bd.nbd.size = int64(newSize)
+
+	if len(v.AttachPath) != 0 || v.Spec.Format == api.FSType_FS_TYPE_EXT4 {
+		var mountPath string
+		if len(v.AttachPath) != 0 {
+			mountPath = v.AttachPath[0]
+		}
+		err = mount.GrowFs(v.Spec.Format, v.DevicePath, mountPath)
+	} else {
+		err = d.growUnmounted(v)
+	}
+	if err != nil {
+		return err
+	}
+	logrus.Infof("BUSE resized volume %v at NBD device %s to %v bytes", volumeID,
+		v.DevicePath, newSize)
+
+	v.Spec.Size = newSize
+	return d.UpdateVol(v)
+}
+
+// growUnmounted grows the filesystem of v, which only grows online, mounted
+// in a temporary directory.
+func (d *driver) growUnmounted(v *api.Volume) error {
+	mountPath, err := ioutil.TempDir(BuseMountPath, "resize-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(mountPath)
+	m := mount.DefaultMountImpl()
+	if err := m.Mount(v.DevicePath, mountPath, v.Spec.Format.SimpleString(), 0, "", 0); err != nil {
+		return fmt.Errorf("Failed to mount %v at %v: %v", v.DevicePath, mountPath, err)
+	}
+	defer m.Unmount(mountPath, 0, 0)
+	return mount.GrowFs(v.Spec.Format, v.DevicePath, mountPath)
+}
+
 func (d *driver) Attach(volumeID string, attachOptions map[string]string) (string, error) {
 	// Nothing to do on attach.
 	return path.Join(BuseMountPath, volumeID), nil
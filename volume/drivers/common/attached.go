@@ -0,0 +1,19 @@
+package common
+
+import "github.com/libopenstorage/openstorage/api"
+
+// AttachedHere returns true if v is attached to this node, known to the
+// driver by one of ids, such as its node id or its cloud instance id. Single
+// node drivers do not record the node a volume is attached to: their
+// volumes are attached here if they have a device or are mounted.
+func AttachedHere(v *api.Volume, ids ...string) bool {
+	if len(v.GetAttachedOn()) == 0 {
+		return len(v.GetDevicePath()) != 0 || len(v.GetAttachPath()) != 0
+	}
+	for _, id := range ids {
+		if len(id) != 0 && v.GetAttachedOn() == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachedHere(t *testing.T) {
+	assert.True(t, AttachedHere(&api.Volume{AttachedOn: "node1"}, "node1"))
+	assert.True(t, AttachedHere(&api.Volume{AttachedOn: "i-1234"}, "node1", "i-1234"))
+	assert.False(t, AttachedHere(&api.Volume{AttachedOn: "node2", DevicePath: "/dev/xvdf"}, "node1", ""))
+
+	// single node drivers
+	assert.True(t, AttachedHere(&api.Volume{DevicePath: "/dev/loop0"}, "node1"))
+	assert.True(t, AttachedHere(&api.Volume{AttachPath: []string{"/mnt/vol"}}, "node1"))
+	assert.False(t, AttachedHere(&api.Volume{}, "node1"))
+}
@@ -0,0 +1,327 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// OptionMetadataCache is the driver option with the path of the node
+	// local metadata cache. The cache is disabled if the option is not set.
+	OptionMetadataCache = "metadata_cache"
+	// OptionNodeID is the driver option with the id of this node, used to
+	// decide which volumes are cached. Every volume is cached if not set.
+	OptionNodeID = "node_id"
+)
+
+var volumesBucket = []byte("volumes")
+
+// cachedStoreEnumerator writes volume records through to kvdb and keeps a
+// copy of the records of node local volumes in a bolt database, which
+// serves reads while kvdb is unreachable.
+type cachedStoreEnumerator struct {
+	*defaultStoreEnumerator
+	db     *bolt.DB
+	nodeID string
+
+	lock sync.Mutex
+	// stale is set when kvdb could not be reached, so that the cache is
+	// resynced once it is reachable again
+	stale     bool
+	resyncing bool
+}
+
+// NewStoreEnumerator returns the store enumerator for a driver, cached on
+// the node when the OptionMetadataCache driver option is set.
+func NewStoreEnumerator(
+	driver string,
+	kv kvdb.Kvdb,
+	params map[string]string,
+) (volume.StoreEnumerator, error) {
+	path, ok := params[OptionMetadataCache]
+	if !ok || len(path) == 0 {
+		return NewDefaultStoreEnumerator(driver, kv), nil
+	}
+	return NewCachedStoreEnumerator(driver, kv, path, params[OptionNodeID])
+}
+
+// NewCachedStoreEnumerator returns a store enumerator that caches the
+// volumes attached to or replicated on nodeID in a bolt database at path.
+func NewCachedStoreEnumerator(
+	driver string,
+	kv kvdb.Kvdb,
+	path string,
+	nodeID string,
+) (volume.StoreEnumerator, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(volumesBucket)
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, err
+	}
+	e := &cachedStoreEnumerator{
+		defaultStoreEnumerator: newDefaultStoreEnumerator(driver, kv),
+		db:                     db,
+		nodeID:                 nodeID,
+	}
+	if err := e.Resync(); err != nil {
+		logrus.Warnf("Serving %s volumes from metadata cache %s: %v",
+			driver, path, err)
+	}
+	return e, nil
+}
+
+// CreateVol returns error if volume with the same ID already exists.
+func (e *cachedStoreEnumerator) CreateVol(vol *api.Volume) error {
+	if err := e.check(e.defaultStoreEnumerator.CreateVol(vol)); err != nil {
+		return err
+	}
+	return e.cache(vol)
+}
+
+// GetVol from volumeID, from the cache if kvdb is unreachable.
+func (e *cachedStoreEnumerator) GetVol(volumeID string) (*api.Volume, error) {
+	vol, err := e.defaultStoreEnumerator.GetVol(volumeID)
+	if err == nil {
+		e.check(nil)
+		return vol, e.cache(vol)
+	}
+	if err == kvdb.ErrNotFound {
+		return vol, err
+	}
+	e.check(err)
+	if cached, cerr := e.cached(volumeID); cerr == nil && cached != nil {
+		return cached, nil
+	}
+	return nil, err
+}
+
+// UpdateVol with vol
+func (e *cachedStoreEnumerator) UpdateVol(vol *api.Volume) error {
+	if err := e.check(e.defaultStoreEnumerator.UpdateVol(vol)); err != nil {
+		return err
+	}
+	return e.cache(vol)
+}
+
+// DeleteVol. Returns error if volume does not exist.
+func (e *cachedStoreEnumerator) DeleteVol(volumeID string) error {
+	if err := e.check(e.defaultStoreEnumerator.DeleteVol(volumeID)); err != nil {
+		return err
+	}
+	return e.uncache(volumeID)
+}
+
+// Inspect specified volumes.
+// Returns slice of volumes that were found.
+func (e *cachedStoreEnumerator) Inspect(ids []string) ([]*api.Volume, error) {
+	volumes := make([]*api.Volume, 0, len(ids))
+	for _, id := range ids {
+		volume, err := e.GetVol(id)
+		if err != nil {
+			continue
+		}
+		volumes = append(volumes, volume)
+	}
+	return volumes, nil
+}
+
+// Enumerate volumes that map to the volumeLocator. Only the cached volumes
+// are returned while kvdb is unreachable.
+func (e *cachedStoreEnumerator) Enumerate(
+	locator *api.VolumeLocator,
+	labels map[string]string,
+) ([]*api.Volume, error) {
+	volumes, err := e.defaultStoreEnumerator.Enumerate(locator, labels)
+	if e.check(err) == nil {
+		return volumes, nil
+	}
+	cached, cerr := e.cachedAll()
+	if cerr != nil {
+		return nil, err
+	}
+	volumes = make([]*api.Volume, 0, len(cached))
+	for _, v := range cached {
+		if match(v, locator, labels) {
+			volumes = append(volumes, v)
+		}
+	}
+	return volumes, nil
+}
+
+// SnapEnumerate for specified volume. Only the cached snapshots are
+// returned while kvdb is unreachable.
+func (e *cachedStoreEnumerator) SnapEnumerate(
+	volumeIDs []string,
+	labels map[string]string,
+) ([]*api.Volume, error) {
+	volumes, err := e.defaultStoreEnumerator.SnapEnumerate(volumeIDs, labels)
+	if e.check(err) == nil {
+		return volumes, nil
+	}
+	cached, cerr := e.cachedAll()
+	if cerr != nil {
+		return nil, err
+	}
+	volumes = make([]*api.Volume, 0, len(cached))
+	for _, v := range cached {
+		if v.Source == nil ||
+			v.Source.Parent == "" ||
+			(volumeIDs != nil && !contains(v.Source.Parent, volumeIDs)) {
+			continue
+		}
+		if hasSubset(v.GetLocator().GetVolumeLabels(), labels) {
+			volumes = append(volumes, v)
+		}
+	}
+	return volumes, nil
+}
+
+// Resync replaces the cache with the node local volumes in kvdb.
+func (e *cachedStoreEnumerator) Resync() error {
+	volumes, err := e.defaultStoreEnumerator.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		e.lock.Lock()
+		e.stale = true
+		e.lock.Unlock()
+		return err
+	}
+	return e.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(volumesBucket); err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+		b, err := tx.CreateBucket(volumesBucket)
+		if err != nil {
+			return err
+		}
+		for _, v := range volumes {
+			if !e.local(v) {
+				continue
+			}
+			data, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			if err := b.Put([]byte(v.Id), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// check records whether kvdb was reachable and starts a resync when it is
+// reachable again. It returns err.
+func (e *cachedStoreEnumerator) check(err error) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if err != nil {
+		if err != kvdb.ErrNotFound && err != kvdb.ErrExist {
+			e.stale = true
+		}
+		return err
+	}
+	if e.stale && !e.resyncing {
+		e.resyncing = true
+		go func() {
+			if err := e.Resync(); err != nil {
+				logrus.Warnf("Failed to resync %s metadata cache: %v", e.driver, err)
+			}
+			e.lock.Lock()
+			e.resyncing = false
+			e.lock.Unlock()
+		}()
+		e.stale = false
+	}
+	return nil
+}
+
+// local returns true if the volume is attached to or replicated on this node.
+func (e *cachedStoreEnumerator) local(vol *api.Volume) bool {
+	if len(e.nodeID) == 0 || vol.AttachedOn == e.nodeID {
+		return true
+	}
+	for _, rs := range vol.GetReplicaSets() {
+		for _, n := range rs.GetNodes() {
+			if n == e.nodeID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (e *cachedStoreEnumerator) cache(vol *api.Volume) error {
+	if !e.local(vol) {
+		return e.uncache(vol.Id)
+	}
+	data, err := json.Marshal(vol)
+	if err != nil {
+		return err
+	}
+	// Avoid a disk write on every read of an unchanged volume.
+	unchanged := false
+	e.db.View(func(tx *bolt.Tx) error {
+		unchanged = bytes.Equal(tx.Bucket(volumesBucket).Get([]byte(vol.Id)), data)
+		return nil
+	})
+	if unchanged {
+		return nil
+	}
+	return e.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(volumesBucket).Put([]byte(vol.Id), data)
+	})
+}
+
+func (e *cachedStoreEnumerator) uncache(volumeID string) error {
+	return e.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(volumesBucket).Delete([]byte(volumeID))
+	})
+}
+
+func (e *cachedStoreEnumerator) cached(volumeID string) (*api.Volume, error) {
+	var vol *api.Volume
+	err := e.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(volumesBucket).Get([]byte(volumeID))
+		if data == nil {
+			return nil
+		}
+		vol = &api.Volume{}
+		return json.Unmarshal(data, vol)
+	})
+	return vol, err
+}
+
+func (e *cachedStoreEnumerator) cachedAll() ([]*api.Volume, error) {
+	var volumes []*api.Volume
+	err := e.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(volumesBucket).ForEach(func(k, data []byte) error {
+			vol := &api.Volume{}
+			if err := json.Unmarshal(data, vol); err != nil {
+				return err
+			}
+			volumes = append(volumes, vol)
+			return nil
+		})
+	})
+	return volumes, err
+}
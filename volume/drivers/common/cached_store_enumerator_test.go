@@ -0,0 +1,163 @@
+package common
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnreachable = errors.New("kvdb unreachable")
+
+// flakyKvdb fails every call used by the store enumerator while down.
+type flakyKvdb struct {
+	kvdb.Kvdb
+	down bool
+}
+
+func (f *flakyKvdb) GetVal(key string, v interface{}) (*kvdb.KVPair, error) {
+	if f.down {
+		return nil, errUnreachable
+	}
+	return f.Kvdb.GetVal(key, v)
+}
+
+func (f *flakyKvdb) Put(key string, v interface{}, ttl uint64) (*kvdb.KVPair, error) {
+	if f.down {
+		return nil, errUnreachable
+	}
+	return f.Kvdb.Put(key, v, ttl)
+}
+
+func (f *flakyKvdb) Create(key string, v interface{}, ttl uint64) (*kvdb.KVPair, error) {
+	if f.down {
+		return nil, errUnreachable
+	}
+	return f.Kvdb.Create(key, v, ttl)
+}
+
+func (f *flakyKvdb) Delete(key string) (*kvdb.KVPair, error) {
+	if f.down {
+		return nil, errUnreachable
+	}
+	return f.Kvdb.Delete(key)
+}
+
+func (f *flakyKvdb) Enumerate(prefix string) (kvdb.KVPairs, error) {
+	if f.down {
+		return nil, errUnreachable
+	}
+	return f.Kvdb.Enumerate(prefix)
+}
+
+func newTestCachedEnumerator(t *testing.T) (*cachedStoreEnumerator, *flakyKvdb, string) {
+	dir, err := ioutil.TempDir("", "metadata_cache")
+	require.NoError(t, err)
+	kv, err := kvdb.New(mem.Name, "cache_test", []string{}, nil, nil)
+	require.NoError(t, err)
+	flaky := &flakyKvdb{Kvdb: kv}
+
+	e, err := NewStoreEnumerator("cache_test", flaky, map[string]string{
+		OptionMetadataCache: filepath.Join(dir, "meta.db"),
+		OptionNodeID:        "node1",
+	})
+	require.NoError(t, err)
+	return e.(*cachedStoreEnumerator), flaky, dir
+}
+
+func resyncing(e *cachedStoreEnumerator) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.resyncing
+}
+
+func TestCachedStoreEnumerator(t *testing.T) {
+	e, kv, dir := newTestCachedEnumerator(t)
+	defer os.RemoveAll(dir)
+	defer e.db.Close()
+
+	local := newTestVolume("local")
+	local.AttachedOn = "node1"
+	replica := newTestVolume("replica")
+	replica.ReplicaSets = []*api.ReplicaSet{{Nodes: []string{"node2", "node1"}}}
+	remote := newTestVolume("remote")
+	remote.AttachedOn = "node2"
+	for _, v := range []*api.Volume{local, replica, remote} {
+		require.NoError(t, e.CreateVol(v))
+	}
+
+	kv.down = true
+	vol, err := e.GetVol("local")
+	require.NoError(t, err)
+	assert.Equal(t, "node1", vol.AttachedOn)
+	_, err = e.GetVol("remote")
+	assert.Equal(t, errUnreachable, err)
+
+	vols, err := e.Inspect([]string{"local", "replica", "remote"})
+	require.NoError(t, err)
+	assert.Len(t, vols, 2)
+
+	vols, err = e.Enumerate(&api.VolumeLocator{}, nil)
+	require.NoError(t, err)
+	assert.Len(t, vols, 2)
+
+	// Writes go through to kvdb and fail while it is unreachable.
+	assert.Equal(t, errUnreachable, e.UpdateVol(local))
+	assert.True(t, e.stale)
+
+	// Changes made by other nodes while unreachable are picked up when
+	// kvdb is reachable again.
+	remote.AttachedOn = "node1"
+	_, err = kv.Kvdb.Put(e.volKey(remote.Id), remote, 0)
+	require.NoError(t, err)
+	_, err = kv.Kvdb.Delete(e.volKey(local.Id))
+	require.NoError(t, err)
+	kv.down = false
+
+	_, err = e.GetVol("replica")
+	require.NoError(t, err)
+	for i := 0; i < 100 && resyncing(e); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.False(t, resyncing(e))
+
+	kv.down = true
+	vols, err = e.Enumerate(&api.VolumeLocator{}, nil)
+	require.NoError(t, err)
+	ids := make(map[string]bool)
+	for _, v := range vols {
+		ids[v.Id] = true
+	}
+	assert.Equal(t, map[string]bool{"replica": true, "remote": true}, ids)
+}
+
+func TestCachedStoreEnumeratorDelete(t *testing.T) {
+	e, kv, dir := newTestCachedEnumerator(t)
+	defer os.RemoveAll(dir)
+	defer e.db.Close()
+
+	vol := newTestVolume("vol")
+	require.NoError(t, e.CreateVol(vol))
+	require.NoError(t, e.DeleteVol(vol.Id))
+
+	kv.down = true
+	_, err := e.GetVol(vol.Id)
+	assert.Error(t, err)
+}
+
+func TestNewStoreEnumeratorDefault(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "cache_test", []string{}, nil, nil)
+	require.NoError(t, err)
+	e, err := NewStoreEnumerator("cache_test", kv, map[string]string{})
+	require.NoError(t, err)
+	_, ok := e.(*defaultStoreEnumerator)
+	assert.True(t, ok)
+}
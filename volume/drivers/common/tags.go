@@ -0,0 +1,75 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/storageops"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// OptionTagLabels is the driver option with the comma separated keys of
+	// the volume labels propagated as cloud tags. No label is propagated if
+	// the option is not set, as labels may be internal to openstorage.
+	OptionTagLabels = "tag_labels"
+	// LabelTagPrefix is the prefix of the cloud tags of volume labels. The
+	// tags are managed, so that the tag of a label removed is removed too.
+	LabelTagPrefix = storageops.ManagedTagPrefix + "label/"
+)
+
+// TagLabels returns the keys of the volume labels propagated as cloud tags
+// as set by the OptionTagLabels driver option.
+func TagLabels(params map[string]string) []string {
+	var labels []string
+	for _, label := range strings.Split(params[OptionTagLabels], ",") {
+		if label = strings.TrimSpace(label); len(label) != 0 {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// CloudTags returns the cloud provider tags for a volume. These are the
+// volume labels listed in labels plus managed tags identifying the volume,
+// its owner and its tenant, so that cloud cost allocation matches
+// openstorage ownership.
+func CloudTags(v *api.Volume, labels []string) map[string]string {
+	vals := make(map[string]string)
+	for k, val := range v.GetSpec().GetVolumeLabels() {
+		vals[k] = val
+	}
+	for k, val := range v.GetLocator().GetVolumeLabels() {
+		vals[k] = val
+	}
+
+	tags := make(map[string]string)
+	for _, label := range labels {
+		if val, ok := vals[label]; ok {
+			tags[LabelTagPrefix+label] = val
+		}
+	}
+	if len(v.GetId()) != 0 {
+		tags[storageops.ManagedTagPrefix+"volume-id"] = v.GetId()
+	}
+	if len(v.GetLocator().GetName()) != 0 {
+		tags[storageops.ManagedTagPrefix+"volume-name"] = v.GetLocator().GetName()
+	}
+	for _, label := range []string{api.LabelOwner, api.LabelTenant} {
+		if val, ok := vals[label]; ok {
+			tags[storageops.ManagedTagPrefix+label] = val
+		}
+	}
+	return tags
+}
+
+// ReconcileCloudTags makes the tags of the cloud volumes backing vols match
+// their CloudTags. Volumes whose tags could not be reconciled are logged and
+// left to the next reconciliation.
+func ReconcileCloudTags(ops storageops.Ops, vols []*api.Volume, labels []string) {
+	for _, v := range vols {
+		if err := storageops.ReconcileTags(ops, v.GetId(), CloudTags(v, labels)); err != nil {
+			logrus.Warnf("Failed to reconcile tags of volume %s: %v", v.GetId(), err)
+		}
+	}
+}
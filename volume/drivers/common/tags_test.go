@@ -0,0 +1,92 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/storageops"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTagOps keeps tags in memory. Only the tag operations are implemented.
+type fakeTagOps struct {
+	storageops.Ops
+	tags map[string]string
+}
+
+func (f *fakeTagOps) Tags(volumeID string) (map[string]string, error) {
+	tags := make(map[string]string)
+	for k, v := range f.tags {
+		tags[k] = v
+	}
+	return tags, nil
+}
+
+func (f *fakeTagOps) ApplyTags(volumeID string, labels map[string]string) error {
+	for k, v := range labels {
+		f.tags[k] = v
+	}
+	return nil
+}
+
+func (f *fakeTagOps) RemoveTags(volumeID string, labels map[string]string) error {
+	for k := range labels {
+		delete(f.tags, k)
+	}
+	return nil
+}
+
+func TestCloudTags(t *testing.T) {
+	v := &api.Volume{
+		Id: "vol1",
+		Locator: &api.VolumeLocator{
+			Name: "myvol",
+			VolumeLabels: map[string]string{
+				"team":          "storage",
+				api.LabelOwner:  "alice",
+				api.LabelTenant: "acme",
+			},
+		},
+		Spec: &api.VolumeSpec{
+			VolumeLabels: map[string]string{
+				"env":                "prod",
+				api.SpecSecureDelete: "true",
+			},
+		},
+	}
+
+	labels := TagLabels(map[string]string{OptionTagLabels: "team, env,missing"})
+	assert.Equal(t, []string{"team", "env", "missing"}, labels)
+	assert.Equal(t, map[string]string{
+		LabelTagPrefix + "team":                     "storage",
+		LabelTagPrefix + "env":                      "prod",
+		storageops.ManagedTagPrefix + "volume-id":   "vol1",
+		storageops.ManagedTagPrefix + "volume-name": "myvol",
+		storageops.ManagedTagPrefix + "owner":       "alice",
+		storageops.ManagedTagPrefix + "tenant":      "acme",
+	}, CloudTags(v, labels))
+	assert.Empty(t, TagLabels(nil))
+}
+
+func TestReconcileCloudTags(t *testing.T) {
+	v := &api.Volume{
+		Id: "vol1",
+		Locator: &api.VolumeLocator{
+			VolumeLabels: map[string]string{"team": "storage", "env": "prod"},
+		},
+	}
+	ops := &fakeTagOps{tags: map[string]string{"cloud-only": "keep"}}
+	labels := []string{"team", "env"}
+
+	ReconcileCloudTags(ops, []*api.Volume{v}, labels)
+	assert.Equal(t, "prod", ops.tags[LabelTagPrefix+"env"])
+
+	// The tag of a label removed from the volume is removed.
+	delete(v.Locator.VolumeLabels, "env")
+	ReconcileCloudTags(ops, []*api.Volume{v}, labels)
+	assert.Equal(t, map[string]string{
+		"cloud-only":            "keep",
+		LabelTagPrefix + "team": "storage",
+		storageops.ManagedTagPrefix + "volume-id": "vol1",
+	}, ops.tags)
+}
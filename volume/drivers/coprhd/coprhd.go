@@ -65,6 +65,11 @@ type driver struct {
 	volume.CredsDriver
 	volume.CloudBackupDriver
 	volume.CloudMigrateDriver
+	volume.EncryptionDriver
+	volume.WipeDriver
+	volume.CloneDriver
+	volume.ResizeDriver
+	volume.PoolDriver
 	consistencyGroup string
 	project          string
 	varray           string
@@ -119,6 +124,11 @@ func Init(params map[string]string) (volume.VolumeDriver, error) {
 		CredsDriver:        volume.CredsNotSupported,
 		CloudBackupDriver:  volume.CloudBackupNotSupported,
 		CloudMigrateDriver: volume.CloudMigrateNotSupported,
+		EncryptionDriver:   volume.EncryptionNotSupported,
+		WipeDriver:         volume.WipeNotSupported,
+		CloneDriver:        volume.CloneNotSupported,
+		ResizeDriver:       volume.ResizeNotSupported,
+		PoolDriver:         volume.PoolNotSupported,
 		consistencyGroup:   consistencyGroup,
 		project:            project,
 		varray:             varray,
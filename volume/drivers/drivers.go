@@ -77,7 +77,18 @@ func Remove(name string) {
 	volumeDriverRegistry.Remove(name)
 }
 
+// Wrap replaces a registered driver with the one returned by wrap.
+func Wrap(name string, wrap func(volume.VolumeDriver) volume.VolumeDriver) error {
+	return volumeDriverRegistry.Wrap(name, wrap)
+}
+
 // Shutdown stops the volume driver registry
 func Shutdown() error {
 	return volumeDriverRegistry.Shutdown()
 }
+
+// RegisterAll registers the drivers, by name, as configured by config, and
+// returns the errors of the drivers which failed to start, by name.
+func RegisterAll(drivers map[string]map[string]string, config *volume.InitConfig) map[string]error {
+	return volumeDriverRegistry.RegisterAll(drivers, config)
+}
@@ -25,10 +25,13 @@ import (
 
 	"strings"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/cluster"
 	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
+	"github.com/libopenstorage/openstorage/pkg/volumeid"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers/common"
 	"github.com/pborman/uuid"
@@ -41,7 +44,13 @@ const (
 	credsKeyPrefix   = "/fake/credentials"
 	backupsKeyPrefix = "/fake/backups"
 	schedPrefix      = "/fake/schedules"
+	keysKeyPrefix    = "/fake/keys"
+	kekKeyPrefix     = "/fake/kek"
+	poolsKeyPrefix   = "/fake/pools"
 	Type             = api.DriverType_DRIVER_TYPE_BLOCK
+
+	// fakeDeviceSize is the capacity every device adds to a pool
+	fakeDeviceSize = 100 * 1024 * 1024 * 1024
 )
 
 // Implements the open storage volume interface.
@@ -53,6 +62,7 @@ type driver struct {
 	volume.CredsDriver
 	volume.CloudBackupDriver
 	volume.CloudMigrateDriver
+	volume.EncryptionDriver
 	kv          kvdb.Kvdb
 	thisCluster cluster.Cluster
 }
@@ -69,6 +79,19 @@ type fakeBackups struct {
 	ClusterId string
 }
 
+// fakeVolumeKey holds the wrapped data encryption key of a volume along
+// with the id of the key encryption key used to wrap it.
+type fakeVolumeKey struct {
+	KekId      string
+	WrappedKey []byte
+}
+
+// fakePool is a storage pool and the devices it was expanded with.
+type fakePool struct {
+	Pool    api.StoragePool
+	Devices []string
+}
+
 type fakeSchedules struct {
 	Id   string
 	Info api.CloudBackupScheduleInfo
@@ -163,7 +186,13 @@ func (d *driver) Create(
 		return "", fmt.Errorf("HA level cannot be zero")
 	}
 
-	volumeID := strings.TrimSuffix(uuid.New(), "\n")
+	if spec.GetEncrypted() {
+		if err := checkCryptoPolicy(spec); err != nil {
+			return "", err
+		}
+	}
+
+	volumeID := volumeid.New()
 
 	if _, err := d.GetVol(volumeID); err == nil {
 		return "", fmt.Errorf("volume with that id already exists")
@@ -185,6 +214,13 @@ func (d *driver) Create(
 	if err := d.CreateVol(v); err != nil {
 		return "", err
 	}
+
+	if spec.GetEncrypted() {
+		if err := d.createVolumeKey(v.Id); err != nil {
+			d.DeleteVol(v.Id)
+			return "", err
+		}
+	}
 	return v.Id, nil
 }
 
@@ -201,6 +237,7 @@ func (d *driver) Delete(volumeID string) error {
 		return err
 	}
 
+	d.kv.Delete(keysKeyPrefix + "/" + volumeID)
 	return nil
 }
 
@@ -253,6 +290,21 @@ func (d *driver) Snapshot(volumeID string, readonly bool, locator *api.VolumeLoc
 	return newVolumeID, nil
 }
 
+// Clone creates a volume with the spec of the volume. Volumes have no data
+// to copy.
+func (d *driver) Clone(volumeID string, locator *api.VolumeLocator) (string, error) {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return "", err
+	}
+	if len(locator.GetName()) == 0 {
+		return "", fmt.Errorf("Name for clone must be provided")
+	}
+	spec := proto.Clone(v.GetSpec()).(*api.VolumeSpec)
+	logrus.Infof("Creating clone %s of vol %s", locator.Name, volumeID)
+	return d.Create(locator, &api.Source{}, spec)
+}
+
 func (d *driver) Restore(volumeID string, snapID string) error {
 	if _, err := d.Inspect([]string{volumeID, snapID}); err != nil {
 		return err
@@ -484,6 +536,7 @@ func (d *driver) cloudBackupCreate(input *api.CloudBackupCreateRequest) (string,
 			NodeID:         clusterInfo.NodeId,
 			CredentialUUID: input.CredentialUUID,
 			SrcVolumeID:    input.VolumeID,
+			SourceNodeID:   input.SourceNodeID,
 		},
 		Info: api.CloudBackupInfo{
 			ID:            cloudId,
@@ -924,3 +977,192 @@ func (d *driver) Catalog(volumeID, path, depth string) (api.CatalogResponse, err
 		},
 	}, nil
 }
+
+func (d *driver) RotateKey(volumeID string) error {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	if !v.GetSpec().GetEncrypted() {
+		return fmt.Errorf("Volume %s is not encrypted", volumeID)
+	}
+
+	var volKey fakeVolumeKey
+	if _, err := d.kv.GetVal(keysKeyPrefix+"/"+volumeID, &volKey); err != nil {
+		return err
+	}
+	oldKek, err := d.getKek(volKey.KekId)
+	if err != nil {
+		return err
+	}
+	newKekId, newKek, err := d.newKek()
+	if err != nil {
+		return err
+	}
+	wrapped, err := crypto.RewrapKey(oldKek, newKek, volKey.WrappedKey)
+	if err != nil {
+		return err
+	}
+
+	oldKekId := volKey.KekId
+	volKey.KekId = newKekId
+	volKey.WrappedKey = wrapped
+	if _, err := d.kv.Put(keysKeyPrefix+"/"+volumeID, &volKey, 0); err != nil {
+		return err
+	}
+
+	// Retire the previous key encryption key, which may be compromised
+	if _, err := d.kv.Delete(kekKeyPrefix + "/" + oldKekId); err != nil && err != kvdb.ErrNotFound {
+		return fmt.Errorf("Failed to delete key encryption key %s: %v", oldKekId, err)
+	}
+	return nil
+}
+
+// PoolExpand adds a device to a pool, creating the pool if it does not
+// exist. Pools hold no data, so there is nothing to rebalance.
+func (d *driver) PoolExpand(poolID int32, request *api.PoolExpandRequest) (*api.StoragePool, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("%s/%d", poolsKeyPrefix, poolID)
+	pool := fakePool{Pool: api.StoragePool{ID: poolID}}
+	if _, err := d.kv.GetVal(key, &pool); err != nil && err != kvdb.ErrNotFound {
+		return nil, err
+	}
+	for _, dev := range pool.Devices {
+		if dev == request.Device {
+			return nil, fmt.Errorf("Device %s is already part of pool %d", dev, poolID)
+		}
+	}
+	pool.Devices = append(pool.Devices, request.Device)
+	pool.Pool.TotalSize += fakeDeviceSize
+	if _, err := d.kv.Put(key, &pool, 0); err != nil {
+		return nil, err
+	}
+	return &pool.Pool, nil
+}
+
+// Resize grows the size of the volume. Volumes have no filesystem to grow.
+func (d *driver) Resize(volumeID string, newSize uint64) error {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	if newSize < v.GetSpec().GetSize() {
+		return fmt.Errorf("Cannot shrink volume %s from %d to %d bytes: %v",
+			volumeID, v.GetSpec().GetSize(), newSize, volume.ErrEinval)
+	}
+	v.Spec.Size = newSize
+	return d.UpdateVol(v)
+}
+
+func (d *driver) Wipe(volumeID string, method string) (*api.WipeCertificate, error) {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	cert := &api.WipeCertificate{
+		VolumeId:  volumeID,
+		Method:    method,
+		StartTime: time.Now(),
+	}
+	switch method {
+	case api.SecureDeleteOverwrite, api.SecureDeleteDiscard:
+		// Volumes have no backing storage
+	case api.SecureDeleteCryptoErase:
+		if !v.GetSpec().GetEncrypted() {
+			return nil, fmt.Errorf("Volume %s is not encrypted", volumeID)
+		}
+		var volKey fakeVolumeKey
+		if _, err := d.kv.GetVal(keysKeyPrefix+"/"+volumeID, &volKey); err != nil {
+			return nil, err
+		}
+		if _, err := d.kv.Delete(kekKeyPrefix + "/" + volKey.KekId); err != nil {
+			return nil, err
+		}
+		if _, err := d.kv.Delete(keysKeyPrefix + "/" + volumeID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("Unknown secure delete method %q", method)
+	}
+	cert.EndTime = time.Now()
+	return cert, nil
+}
+
+// checkCryptoPolicy verifies that the cipher and key derivation function
+// requested for an encrypted volume are allowed by the crypto policy.
+func checkCryptoPolicy(spec *api.VolumeSpec) error {
+	cipher, ok := spec.GetVolumeLabels()[api.SpecCipher]
+	if !ok {
+		cipher = crypto.DefaultCipher
+	}
+	kdf, ok := spec.GetVolumeLabels()[api.SpecKDF]
+	if !ok {
+		kdf = crypto.DefaultKDF
+	}
+
+	policy := crypto.GetPolicy()
+	if err := policy.CheckCipher(cipher); err != nil {
+		return err
+	}
+	return policy.CheckKDF(kdf)
+}
+
+// createVolumeKey generates a data encryption key for the volume and stores
+// it wrapped with a new key encryption key.
+func (d *driver) createVolumeKey(volumeID string) error {
+	dek, err := crypto.NewKey()
+	if err != nil {
+		return err
+	}
+	kekId, kek, err := d.newKek()
+	if err != nil {
+		return err
+	}
+	wrapped, err := crypto.WrapKey(kek, dek)
+	if err != nil {
+		return err
+	}
+	_, err = d.kv.Put(keysKeyPrefix+"/"+volumeID, &fakeVolumeKey{
+		KekId:      kekId,
+		WrappedKey: wrapped,
+	}, 0)
+	return err
+}
+
+// newKek generates and stores a new key encryption key. The fake driver
+// keeps these in its own kvdb in place of a secrets provider.
+func (d *driver) newKek() (string, []byte, error) {
+	kek, err := crypto.NewKey()
+	if err != nil {
+		return "", nil, err
+	}
+	id := strings.TrimSuffix(uuid.New(), "\n")
+	if _, err := d.kv.Put(kekKeyPrefix+"/"+id, kek, 0); err != nil {
+		return "", nil, err
+	}
+	return id, kek, nil
+}
+
+func (d *driver) getKek(id string) ([]byte, error) {
+	kvp, err := d.kv.Get(kekKeyPrefix + "/" + id)
+	if err != nil {
+		return nil, err
+	}
+	return kvp.Value, nil
+}
+
+// getVolumeKey returns the unwrapped data encryption key of a volume.
+func (d *driver) getVolumeKey(volumeID string) ([]byte, error) {
+	var volKey fakeVolumeKey
+	if _, err := d.kv.GetVal(keysKeyPrefix+"/"+volumeID, &volKey); err != nil {
+		return nil, err
+	}
+	kek, err := d.getKek(volKey.KekId)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.UnwrapKey(kek, volKey.WrappedKey)
+}
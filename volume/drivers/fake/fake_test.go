@@ -23,6 +23,7 @@ import (
 	"github.com/libopenstorage/openstorage/api"
 	clustermanager "github.com/libopenstorage/openstorage/cluster/manager"
 	"github.com/libopenstorage/openstorage/config"
+	"github.com/libopenstorage/openstorage/pkg/crypto"
 	"github.com/portworx/kvdb"
 	"github.com/portworx/kvdb/mem"
 	"github.com/sirupsen/logrus"
@@ -742,3 +743,200 @@ func TestFakeSet(t *testing.T) {
 	assert.Equal(t, spec.HaLevel, int64(1))
 	assert.Equal(t, spec.Journal, true)
 }
+
+func TestFakeRotateKey(t *testing.T) {
+	d, err := newFakeDriver(map[string]string{})
+	assert.NoError(t, err)
+
+	// Not encrypted
+	plainid, err := d.Create(&api.VolumeLocator{
+		Name: "plain",
+	}, &api.Source{}, &api.VolumeSpec{
+		Size:    1234,
+		HaLevel: 1,
+	})
+	assert.NoError(t, err)
+	err = d.RotateKey(plainid)
+	assert.Error(t, err)
+
+	// Not found
+	err = d.RotateKey("doesnotexist")
+	assert.Error(t, err)
+
+	volid, err := d.Create(&api.VolumeLocator{
+		Name: "encrypted",
+	}, &api.Source{}, &api.VolumeSpec{
+		Size:      1234,
+		HaLevel:   1,
+		Encrypted: true,
+	})
+	assert.NoError(t, err)
+
+	dek, err := d.getVolumeKey(volid)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, dek)
+
+	var before fakeVolumeKey
+	_, err = d.kv.GetVal(keysKeyPrefix+"/"+volid, &before)
+	assert.NoError(t, err)
+
+	err = d.RotateKey(volid)
+	assert.NoError(t, err)
+
+	var after fakeVolumeKey
+	_, err = d.kv.GetVal(keysKeyPrefix+"/"+volid, &after)
+	assert.NoError(t, err)
+	assert.NotEqual(t, before.KekId, after.KekId)
+	assert.NotEqual(t, before.WrappedKey, after.WrappedKey)
+
+	// The previous key encryption key is retired
+	_, err = d.getKek(before.KekId)
+	assert.Equal(t, kvdb.ErrNotFound, err)
+
+	// Data encryption key must not change
+	rotated, err := d.getVolumeKey(volid)
+	assert.NoError(t, err)
+	assert.Equal(t, dek, rotated)
+}
+
+func TestFakeCreateVolumeCryptoPolicy(t *testing.T) {
+	d, err := newFakeDriver(map[string]string{})
+	assert.NoError(t, err)
+
+	err = crypto.SetPolicy(&crypto.Policy{
+		Ciphers: []string{crypto.DefaultCipher},
+	})
+	assert.NoError(t, err)
+	defer crypto.SetPolicy(&crypto.Policy{})
+
+	_, err = d.Create(&api.VolumeLocator{
+		Name: "notallowed",
+	}, &api.Source{}, &api.VolumeSpec{
+		Size:      1234,
+		HaLevel:   1,
+		Encrypted: true,
+		VolumeLabels: map[string]string{
+			api.SpecCipher: "twofish-xts-plain64",
+		},
+	})
+	assert.Error(t, err)
+
+	vid, err := d.Create(&api.VolumeLocator{
+		Name: "allowed",
+	}, &api.Source{}, &api.VolumeSpec{
+		Size:      1234,
+		HaLevel:   1,
+		Encrypted: true,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, vid)
+}
+
+func TestFakeWipe(t *testing.T) {
+	d, err := newFakeDriver(map[string]string{})
+	assert.NoError(t, err)
+
+	volid, err := d.Create(&api.VolumeLocator{
+		Name: "encrypted",
+	}, &api.Source{}, &api.VolumeSpec{
+		Size:      1234,
+		HaLevel:   1,
+		Encrypted: true,
+	})
+	assert.NoError(t, err)
+
+	_, err = d.Wipe(volid, "shred")
+	assert.Error(t, err)
+
+	cert, err := d.Wipe(volid, api.SecureDeleteCryptoErase)
+	assert.NoError(t, err)
+	assert.Equal(t, volid, cert.VolumeId)
+	assert.Equal(t, api.SecureDeleteCryptoErase, cert.Method)
+
+	// Key material is gone
+	_, err = d.getVolumeKey(volid)
+	assert.Error(t, err)
+	err = d.RotateKey(volid)
+	assert.Error(t, err)
+}
+
+func TestFakeResize(t *testing.T) {
+	d, err := newFakeDriver(map[string]string{})
+	assert.NoError(t, err)
+
+	volid, err := d.Create(&api.VolumeLocator{
+		Name: "resized",
+	}, &api.Source{}, &api.VolumeSpec{
+		Size:    1234,
+		HaLevel: 1,
+	})
+	assert.NoError(t, err)
+
+	err = d.Resize(volid, 4321)
+	assert.NoError(t, err)
+	vols, err := d.Inspect([]string{volid})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4321), vols[0].GetSpec().GetSize())
+
+	// Volumes do not shrink
+	err = d.Resize(volid, 1234)
+	assert.Error(t, err)
+
+	err = d.Resize("doesnotexist", 4321)
+	assert.Error(t, err)
+}
+
+func TestFakeClone(t *testing.T) {
+	d, err := newFakeDriver(map[string]string{})
+	assert.NoError(t, err)
+
+	volid, err := d.Create(&api.VolumeLocator{
+		Name: "cloned",
+	}, &api.Source{}, &api.VolumeSpec{
+		Size:    1234,
+		HaLevel: 1,
+	})
+	assert.NoError(t, err)
+
+	cloneid, err := d.Clone(volid, &api.VolumeLocator{Name: "clone"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, volid, cloneid)
+	vols, err := d.Inspect([]string{cloneid})
+	assert.NoError(t, err)
+	assert.Equal(t, "clone", vols[0].GetLocator().GetName())
+	assert.Equal(t, uint64(1234), vols[0].GetSpec().GetSize())
+	assert.False(t, vols[0].GetReadonly())
+	assert.False(t, vols[0].IsSnapshot())
+
+	// The clone is independent of its source
+	err = d.Resize(cloneid, 4321)
+	assert.NoError(t, err)
+	vols, err = d.Inspect([]string{volid})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1234), vols[0].GetSpec().GetSize())
+
+	_, err = d.Clone(volid, &api.VolumeLocator{})
+	assert.Error(t, err)
+
+	_, err = d.Clone("doesnotexist", &api.VolumeLocator{Name: "clone2"})
+	assert.Error(t, err)
+}
+
+func TestFakePoolExpand(t *testing.T) {
+	d, err := newFakeDriver(map[string]string{})
+	assert.NoError(t, err)
+
+	pool, err := d.PoolExpand(1, &api.PoolExpandRequest{Device: "/dev/sdb"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pool.ID)
+	assert.Equal(t, uint64(fakeDeviceSize), pool.TotalSize)
+
+	pool, err = d.PoolExpand(1, &api.PoolExpandRequest{Device: "/dev/sdc", Rebalance: true})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2*fakeDeviceSize), pool.TotalSize)
+
+	_, err = d.PoolExpand(1, &api.PoolExpandRequest{Device: "/dev/sdb"})
+	assert.Error(t, err)
+	_, err = d.PoolExpand(1, &api.PoolExpandRequest{Device: "sdd"})
+	assert.Error(t, err)
+}
@@ -4,15 +4,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 
 	"bazil.org/fuse"
 	"bazil.org/fuse/fs"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/volumeid"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers/common"
-	"github.com/pborman/uuid"
 	"github.com/portworx/kvdb"
 )
 
@@ -26,6 +25,11 @@ type volumeDriver struct {
 	volume.CredsDriver
 	volume.CloudBackupDriver
 	volume.CloudMigrateDriver
+	volume.EncryptionDriver
+	volume.WipeDriver
+	volume.CloneDriver
+	volume.ResizeDriver
+	volume.PoolDriver
 	name        string
 	baseDirPath string
 	provider    Provider
@@ -49,6 +53,11 @@ func newVolumeDriver(
 		volume.CredsNotSupported,
 		volume.CloudBackupNotSupported,
 		volume.CloudMigrateNotSupported,
+		volume.EncryptionNotSupported,
+		volume.WipeNotSupported,
+		volume.CloneNotSupported,
+		volume.ResizeNotSupported,
+		volume.PoolNotSupported,
 		name,
 		baseDirPath,
 		provider,
@@ -75,7 +84,7 @@ func (v *volumeDriver) Create(
 	source *api.Source,
 	spec *api.VolumeSpec,
 ) (string, error) {
-	volumeID := strings.TrimSpace(string(uuid.New()))
+	volumeID := volumeid.New()
 	dirPath := filepath.Join(v.baseDirPath, volumeID)
 	if err := os.MkdirAll(dirPath, 0777); err != nil {
 		return "", err
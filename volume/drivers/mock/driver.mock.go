@@ -72,6 +72,19 @@ func (mr *MockVolumeDriverMockRecorder) Catalog(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Catalog", reflect.TypeOf((*MockVolumeDriver)(nil).Catalog), arg0, arg1, arg2)
 }
 
+// Clone mocks base method
+func (m *MockVolumeDriver) Clone(arg0 string, arg1 *api.VolumeLocator) (string, error) {
+	ret := m.ctrl.Call(m, "Clone", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clone indicates an expected call of Clone
+func (mr *MockVolumeDriverMockRecorder) Clone(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockVolumeDriver)(nil).Clone), arg0, arg1)
+}
+
 // CloudBackupCatalog mocks base method
 func (m *MockVolumeDriver) CloudBackupCatalog(arg0 *api.CloudBackupCatalogRequest) (*api.CloudBackupCatalogResponse, error) {
 	ret := m.ctrl.Call(m, "CloudBackupCatalog", arg0)
@@ -461,6 +474,19 @@ func (mr *MockVolumeDriverMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockVolumeDriver)(nil).Name))
 }
 
+// PoolExpand mocks base method
+func (m *MockVolumeDriver) PoolExpand(arg0 int32, arg1 *api.PoolExpandRequest) (*api.StoragePool, error) {
+	ret := m.ctrl.Call(m, "PoolExpand", arg0, arg1)
+	ret0, _ := ret[0].(*api.StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PoolExpand indicates an expected call of PoolExpand
+func (mr *MockVolumeDriverMockRecorder) PoolExpand(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PoolExpand", reflect.TypeOf((*MockVolumeDriver)(nil).PoolExpand), arg0, arg1)
+}
+
 // Quiesce mocks base method
 func (m *MockVolumeDriver) Quiesce(arg0 string, arg1 uint64, arg2 string) error {
 	ret := m.ctrl.Call(m, "Quiesce", arg0, arg1, arg2)
@@ -498,6 +524,30 @@ func (mr *MockVolumeDriverMockRecorder) Restore(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockVolumeDriver)(nil).Restore), arg0, arg1)
 }
 
+// Resize mocks base method
+func (m *MockVolumeDriver) Resize(arg0 string, arg1 uint64) error {
+	ret := m.ctrl.Call(m, "Resize", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resize indicates an expected call of Resize
+func (mr *MockVolumeDriverMockRecorder) Resize(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockVolumeDriver)(nil).Resize), arg0, arg1)
+}
+
+// RotateKey mocks base method
+func (m *MockVolumeDriver) RotateKey(arg0 string) error {
+	ret := m.ctrl.Call(m, "RotateKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateKey indicates an expected call of RotateKey
+func (mr *MockVolumeDriverMockRecorder) RotateKey(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockVolumeDriver)(nil).RotateKey), arg0)
+}
+
 // Set mocks base method
 func (m *MockVolumeDriver) Set(arg0 string, arg1 *api.VolumeLocator, arg2 *api.VolumeSpec) error {
 	ret := m.ctrl.Call(m, "Set", arg0, arg1, arg2)
@@ -646,6 +696,19 @@ func (mr *MockVolumeDriverMockRecorder) Version() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockVolumeDriver)(nil).Version))
 }
 
+// Wipe mocks base method
+func (m *MockVolumeDriver) Wipe(arg0, arg1 string) (*api.WipeCertificate, error) {
+	ret := m.ctrl.Call(m, "Wipe", arg0, arg1)
+	ret0, _ := ret[0].(*api.WipeCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Wipe indicates an expected call of Wipe
+func (mr *MockVolumeDriverMockRecorder) Wipe(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wipe", reflect.TypeOf((*MockVolumeDriver)(nil).Wipe), arg0, arg1)
+}
+
 // Write mocks base method
 func (m *MockVolumeDriver) Write(arg0 string, arg1 []byte, arg2 uint64, arg3 int64) (int64, error) {
 	ret := m.ctrl.Call(m, "Write", arg0, arg1, arg2, arg3)
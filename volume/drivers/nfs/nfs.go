@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 
 	"math/rand"
@@ -18,10 +19,12 @@ import (
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/config"
 	"github.com/libopenstorage/openstorage/pkg/mount"
+	"github.com/libopenstorage/openstorage/pkg/reflink"
 	"github.com/libopenstorage/openstorage/pkg/seed"
+	"github.com/libopenstorage/openstorage/pkg/volumeid"
+	"github.com/libopenstorage/openstorage/pkg/wipe"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers/common"
-	"github.com/pborman/uuid"
 	"github.com/portworx/kvdb"
 )
 
@@ -42,6 +45,9 @@ type driver struct {
 	volume.CredsDriver
 	volume.CloudBackupDriver
 	volume.CloudMigrateDriver
+	volume.EncryptionDriver
+	volume.ResizeDriver
+	volume.PoolDriver
 	nfsServers []string
 	nfsPath    string
 	mounter    mount.Manager
@@ -80,6 +86,9 @@ func Init(params map[string]string) (volume.VolumeDriver, error) {
 		mounter:            mounter,
 		CloudBackupDriver:  volume.CloudBackupNotSupported,
 		CloudMigrateDriver: volume.CloudMigrateNotSupported,
+		EncryptionDriver:   volume.EncryptionNotSupported,
+		ResizeDriver:       volume.ResizeNotSupported,
+		PoolDriver:         volume.PoolNotSupported,
 	}
 
 	//make directory for each nfs server
@@ -230,7 +239,7 @@ func (d *driver) Create(
 		return "", fmt.Errorf("volume name cannot contain space characters")
 	}
 
-	volumeID := strings.TrimSuffix(uuid.New(), "\n")
+	volumeID := volumeid.New()
 
 	if _, err := d.GetVol(volumeID); err == nil {
 		return "", fmt.Errorf("volume with that id already exists")
@@ -335,6 +344,40 @@ func (d *driver) Delete(volumeID string) error {
 	return nil
 }
 
+// Wipe overwrites the simulated block device and the files of the volume on
+// the nfs server.
+func (d *driver) Wipe(volumeID string, method string) (*api.WipeCertificate, error) {
+	if method != api.SecureDeleteOverwrite {
+		return nil, fmt.Errorf("Secure delete method %q not supported by %s driver", method, Name)
+	}
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return nil, err
+	}
+	nfsVolPath, err := d.getNFSVolumePath(v)
+	if err != nil {
+		return nil, err
+	}
+
+	cert := &api.WipeCertificate{
+		VolumeId:  volumeID,
+		Method:    method,
+		StartTime: time.Now(),
+	}
+	for _, p := range []string{v.DevicePath, nfsVolPath} {
+		if _, err := os.Stat(p); os.IsNotExist(err) {
+			continue
+		}
+		n, err := wipe.Overwrite(p)
+		cert.BytesWiped += n
+		if err != nil {
+			return nil, err
+		}
+	}
+	cert.EndTime = time.Now()
+	return cert, nil
+}
+
 func (d *driver) MountedAt(mountpath string) string {
 	return ""
 }
@@ -434,6 +477,47 @@ func (d *driver) Snapshot(volumeID string, readonly bool, locator *api.VolumeLoc
 	return newVolumeID, nil
 }
 
+// Clone copies the files of the volume to a new volume on the same NFS
+// server, sharing their extents where the server filesystem supports it.
+func (d *driver) Clone(volumeID string, locator *api.VolumeLocator) (string, error) {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return "", err
+	}
+	if locator == nil {
+		locator = &api.VolumeLocator{}
+	}
+	if locator.VolumeLabels == nil {
+		locator.VolumeLabels = make(map[string]string)
+	}
+	if _, ok := locator.VolumeLabels["server"]; !ok {
+		locator.VolumeLabels["server"] = v.GetLocator().GetVolumeLabels()["server"]
+	}
+	newVolumeID, err := d.Create(locator, &api.Source{}, proto.Clone(v.Spec).(*api.VolumeSpec))
+	if err != nil {
+		return "", err
+	}
+
+	if err := d.copyVolume(v, newVolumeID); err != nil {
+		d.Delete(newVolumeID)
+		return "", err
+	}
+	return newVolumeID, nil
+}
+
+// copyVolume copies the files of the volume v to the volume newVolumeID.
+func (d *driver) copyVolume(v *api.Volume, newVolumeID string) error {
+	nfsVolPath, err := d.getNFSVolumePath(v)
+	if err != nil {
+		return err
+	}
+	newNfsVolPath, err := d.getNFSVolumePathById(newVolumeID)
+	if err != nil {
+		return err
+	}
+	return reflink.CopyTree(nfsVolPath, newNfsVolPath)
+}
+
 func (d *driver) Restore(volumeID string, snapID string) error {
 	if _, err := d.Inspect([]string{volumeID, snapID}); err != nil {
 		return err
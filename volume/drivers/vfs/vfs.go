@@ -5,16 +5,19 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
-	"strings"
 	"syscall"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/mount"
+	"github.com/libopenstorage/openstorage/pkg/reflink"
+	"github.com/libopenstorage/openstorage/pkg/volumeid"
+	"github.com/libopenstorage/openstorage/pkg/wipe"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers/common"
-	"github.com/pborman/uuid"
 	"github.com/portworx/kvdb"
 )
 
@@ -36,19 +39,29 @@ type driver struct {
 	volume.CredsDriver
 	volume.CloudBackupDriver
 	volume.CloudMigrateDriver
+	volume.EncryptionDriver
+	volume.ResizeDriver
+	volume.PoolDriver
 }
 
 // Init Driver intialization.
 func Init(params map[string]string) (volume.VolumeDriver, error) {
+	store, err := common.NewStoreEnumerator(Name, kvdb.Instance(), params)
+	if err != nil {
+		return nil, err
+	}
 	return &driver{
 		volume.IONotSupported,
 		volume.BlockNotSupported,
 		volume.SnapshotNotSupported,
-		common.NewDefaultStoreEnumerator(Name, kvdb.Instance()),
+		store,
 		volume.StatsNotSupported,
 		volume.CredsNotSupported,
 		volume.CloudBackupNotSupported,
 		volume.CloudMigrateNotSupported,
+		volume.EncryptionNotSupported,
+		volume.ResizeNotSupported,
+		volume.PoolNotSupported,
 	}, nil
 }
 
@@ -68,7 +81,7 @@ func (d *driver) Version() (*api.StorageVersion, error) {
 }
 
 func (d *driver) Create(locator *api.VolumeLocator, source *api.Source, spec *api.VolumeSpec) (string, error) {
-	volumeID := strings.TrimSuffix(uuid.New(), "\n")
+	volumeID := volumeid.New()
 	// Create a directory on the Local machine with this UUID.
 	if err := os.MkdirAll(filepath.Join(volume.VolumeBase, string(volumeID)), 0744); err != nil {
 		return "", err
@@ -87,6 +100,27 @@ func (d *driver) Create(locator *api.VolumeLocator, source *api.Source, spec *ap
 	return v.Id, d.UpdateVol(v)
 }
 
+// Clone copies the files of the volume to a new volume, sharing their
+// extents where the filesystem of the volume base supports it.
+func (d *driver) Clone(volumeID string, locator *api.VolumeLocator) (string, error) {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return "", err
+	}
+	newVolumeID, err := d.Create(locator, &api.Source{}, proto.Clone(v.Spec).(*api.VolumeSpec))
+	if err != nil {
+		return "", err
+	}
+	if err := reflink.CopyTree(
+		filepath.Join(volume.VolumeBase, volumeID),
+		filepath.Join(volume.VolumeBase, newVolumeID),
+	); err != nil {
+		d.Delete(newVolumeID)
+		return "", err
+	}
+	return newVolumeID, nil
+}
+
 func (d *driver) Delete(volumeID string) error {
 	if _, err := d.GetVol(volumeID); err != nil {
 		return err
@@ -99,6 +133,29 @@ func (d *driver) Delete(volumeID string) error {
 
 }
 
+// Wipe overwrites the files of the volume.
+func (d *driver) Wipe(volumeID string, method string) (*api.WipeCertificate, error) {
+	if method != api.SecureDeleteOverwrite {
+		return nil, fmt.Errorf("Secure delete method %q not supported by %s driver", method, Name)
+	}
+	if _, err := d.GetVol(volumeID); err != nil {
+		return nil, err
+	}
+
+	cert := &api.WipeCertificate{
+		VolumeId:  volumeID,
+		Method:    method,
+		StartTime: time.Now(),
+	}
+	n, err := wipe.Overwrite(filepath.Join(volume.VolumeBase, string(volumeID)))
+	if err != nil {
+		return nil, err
+	}
+	cert.BytesWiped = n
+	cert.EndTime = time.Now()
+	return cert, nil
+}
+
 func (d *driver) MountedAt(mountpath string) string {
 	return ""
 }
@@ -114,12 +171,13 @@ func (d *driver) Mount(volumeID string, mountpath string, options map[string]str
 	if len(v.AttachPath) > 0 && len(v.AttachPath) > 0 {
 		return fmt.Errorf("Volume %q already mounted at %q", volumeID, v.AttachPath[0])
 	}
-	syscall.Unmount(mountpath, 0)
-	if err := syscall.Mount(
+	mounter := mount.DefaultMountImpl()
+	mounter.Unmount(mountpath, 0, 0)
+	if err := mounter.Mount(
 		filepath.Join(volume.VolumeBase, string(volumeID)),
 		mountpath,
 		string(v.Spec.Format),
-		syscall.MS_BIND, "",
+		syscall.MS_BIND, "", 0,
 	); err != nil {
 		logrus.Printf("Cannot mount %s at %s because %+v",
 			filepath.Join(volume.VolumeBase, string(volumeID)),
@@ -145,7 +203,7 @@ func (d *driver) Unmount(volumeID string, mountpath string, options map[string]s
 	if len(v.AttachPath) == 0 || len(v.AttachPath[0]) == 0 {
 		return fmt.Errorf("Device %v not mounted", volumeID)
 	}
-	if err := syscall.Unmount(v.AttachPath[0], 0); err != nil {
+	if err := mount.DefaultMountImpl().Unmount(v.AttachPath[0], 0, 0); err != nil {
 		return err
 	}
 	v.AttachPath = nil
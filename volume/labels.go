@@ -0,0 +1,160 @@
+package volume
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// ErrLabelConflict is returned when a label patch changes a label owned by
+// another manager without forcing it.
+type ErrLabelConflict struct {
+	// Key of the label
+	Key string
+	// Manager owning the label
+	Manager string
+}
+
+func (e *ErrLabelConflict) Error() string {
+	return fmt.Sprintf("Label %s is managed by %s", e.Key, e.Manager)
+}
+
+// LabelPatch changes the labels of a volume on behalf of a manager, such as a
+// controller, which owns the labels it sets. Managers change disjoint sets of
+// labels without clobbering each other.
+// swagger:model
+type LabelPatch struct {
+	// Manager identifies who applies the patch
+	Manager string
+	// Set adds or replaces these labels, owned by Manager afterwards
+	Set map[string]string
+	// Remove deletes the labels with these keys
+	Remove []string
+	// IfAbsent only sets the labels not present yet, leaving the others
+	// untouched
+	IfAbsent bool
+	// Force takes over the labels owned by other managers instead of
+	// failing with a conflict
+	Force bool
+}
+
+// Validate checks that the patch has a manager and does not change the
+// labels reserved for openstorage.
+func (p *LabelPatch) Validate() error {
+	if len(p.Manager) == 0 {
+		return fmt.Errorf("Label patch must have a manager")
+	}
+	check := func(k string) error {
+		if len(k) == 0 || strings.ContainsAny(k, " \t\n") {
+			return fmt.Errorf("Invalid label key %q", k)
+		}
+		if k == api.LabelManagers || k == api.LabelLineage {
+			return fmt.Errorf("Label %s is reserved", k)
+		}
+		return nil
+	}
+	for k, v := range p.Set {
+		if err := check(k); err != nil {
+			return err
+		}
+		if len(v) == 0 {
+			return fmt.Errorf("Label %s must have a value, remove it instead", k)
+		}
+	}
+	for _, k := range p.Remove {
+		if err := check(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LabelManagers returns the manager of each label of v set through a label
+// patch, as recorded by the api.LabelManagers label of its locator.
+func LabelManagers(v *api.Volume) map[string]string {
+	managers := make(map[string]string)
+	if value, ok := v.GetLocator().GetVolumeLabels()[api.LabelManagers]; ok {
+		// labels not patched have no manager
+		json.Unmarshal([]byte(value), &managers)
+	}
+	return managers
+}
+
+// PatchLabels applies p to the labels of v. It returns the locator to set on
+// v, whose removed labels have empty values, and the new labels. A label
+// owned by another manager may only be set to its current value, unless the
+// patch is forced. Labels without a manager are taken over when set.
+func PatchLabels(v *api.Volume, p *LabelPatch) (*api.VolumeLocator, map[string]string, error) {
+	current := v.GetLocator().GetVolumeLabels()
+	managers := LabelManagers(v)
+	owned := func(k string) error {
+		if m, ok := managers[k]; ok && m != p.Manager && !p.Force {
+			return &ErrLabelConflict{Key: k, Manager: m}
+		}
+		return nil
+	}
+
+	changes := make(map[string]string)
+	// managed is set if the managers changed
+	managed := false
+	for k, val := range p.Set {
+		cur, present := current[k]
+		if present && cur == val {
+			if _, ok := managers[k]; !ok && !p.IfAbsent {
+				managers[k] = p.Manager
+				managed = true
+			}
+			continue
+		}
+		if present && p.IfAbsent {
+			continue
+		}
+		if err := owned(k); err != nil {
+			return nil, nil, err
+		}
+		changes[k] = val
+		managers[k] = p.Manager
+		managed = true
+	}
+	for _, k := range p.Remove {
+		if _, present := current[k]; !present {
+			continue
+		}
+		if err := owned(k); err != nil {
+			return nil, nil, err
+		}
+		changes[k] = ""
+		if _, ok := managers[k]; ok {
+			delete(managers, k)
+			managed = true
+		}
+	}
+
+	labels := make(map[string]string, len(current)+len(changes))
+	for k, val := range current {
+		labels[k] = val
+	}
+	for k, val := range changes {
+		if len(val) == 0 {
+			delete(labels, k)
+		} else {
+			labels[k] = val
+		}
+	}
+	if managed {
+		if len(managers) == 0 {
+			changes[api.LabelManagers] = ""
+			delete(labels, api.LabelManagers)
+		} else {
+			value, err := json.Marshal(managers)
+			if err != nil {
+				return nil, nil, err
+			}
+			changes[api.LabelManagers] = string(value)
+			labels[api.LabelManagers] = string(value)
+		}
+	}
+	return &api.VolumeLocator{VolumeLabels: changes}, labels, nil
+}
@@ -0,0 +1,39 @@
+package volume
+
+import (
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// Lineage returns the ids of the ancestors of v, parent first, as recorded
+// by the api.LabelLineage label of its locator.
+func Lineage(v *api.Volume) []string {
+	lineage := v.GetLocator().GetVolumeLabels()[api.LabelLineage]
+	if len(lineage) == 0 {
+		if parent := v.GetSource().GetParent(); len(parent) != 0 {
+			return []string{parent}
+		}
+		return nil
+	}
+	return strings.Split(lineage, ",")
+}
+
+// InheritLocator returns the locator of a snapshot or a clone of parent:
+// the labels of parent, overridden by those of locator, and the lineage of
+// parent prefixed by parent itself.
+func InheritLocator(parent *api.Volume, locator *api.VolumeLocator) *api.VolumeLocator {
+	inherited := &api.VolumeLocator{
+		Name:         locator.GetName(),
+		VolumeLabels: make(map[string]string),
+	}
+	for k, v := range parent.GetLocator().GetVolumeLabels() {
+		inherited.VolumeLabels[k] = v
+	}
+	for k, v := range locator.GetVolumeLabels() {
+		inherited.VolumeLabels[k] = v
+	}
+	lineage := append([]string{parent.GetId()}, Lineage(parent)...)
+	inherited.VolumeLabels[api.LabelLineage] = strings.Join(lineage, ",")
+	return inherited
+}
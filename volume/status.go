@@ -0,0 +1,48 @@
+package volume
+
+import (
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// StatusReporter is implemented by the volume drivers reporting a structured
+// status.
+type StatusReporter interface {
+	// DriverStatus returns the status of the components of the driver.
+	DriverStatus() []*api.DriverStatus
+}
+
+// StatusOf returns the structured status of d. The key-value pairs of the
+// Status of the drivers which are not StatusReporters are converted to
+// components of unknown state detailed by the values.
+func StatusOf(d VolumeDriver) []*api.DriverStatus {
+	if r, ok := d.(StatusReporter); ok {
+		return r.DriverStatus()
+	}
+	lines := d.Status()
+	status := make([]*api.DriverStatus, 0, len(lines))
+	for _, line := range lines {
+		status = append(status, &api.DriverStatus{
+			Component: line[0],
+			State:     api.DriverStatusUnknown,
+			Details:   line[1],
+		})
+	}
+	return status
+}
+
+// StatusLines returns status as the key-value pairs of Status: the
+// components and their details, prefixed by their state if known.
+func StatusLines(status []*api.DriverStatus) [][2]string {
+	lines := make([][2]string, 0, len(status))
+	for _, s := range status {
+		line := s.Details
+		if s.State != api.DriverStatusUnknown && len(s.State) != 0 {
+			line = string(s.State)
+			if len(s.Details) != 0 {
+				line += ": " + s.Details
+			}
+		}
+		lines = append(lines, [2]string{s.Component, line})
+	}
+	return lines
+}
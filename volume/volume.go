@@ -15,6 +15,8 @@ var (
 	ErrDriverNotFound = errors.New("Driver implementation not found")
 	// ErrDriverInitializing returned when a driver is initializing
 	ErrDriverInitializing = errors.New("Driver is initializing")
+	// ErrDriverInitTimeout returned when a driver did not initialize in time
+	ErrDriverInitTimeout = errors.New("Driver initialization timed out")
 	// ErrEnoEnt returned when volume does not exist
 	ErrEnoEnt = errors.New("Volume does not exist.")
 	// ErrEnomem returned when we are out of memory
@@ -112,6 +114,17 @@ type SnapshotDriver interface {
 	SnapshotGroup(groupID string, labels map[string]string) (*api.GroupSnapCreateResponse, error)
 }
 
+// CloneDriver interface provides writable copies of volumes
+type CloneDriver interface {
+	// Clone creates a read-write copy of the specified volume with locator
+	// and returns its id. Unlike a snapshot, the clone is independent of the
+	// volume: it outlives it and does not restore to it. Drivers share the
+	// data of the volume with the clone where they can, copy-on-write, and
+	// copy it otherwise.
+	// Errors ErrEnoEnt may be returned.
+	Clone(volumeID string, locator *api.VolumeLocator) (string, error)
+}
+
 // StatsDriver interface provides stats features
 type StatsDriver interface {
 	// Stats for specified volume.
@@ -139,6 +152,40 @@ type QuiesceDriver interface {
 	Unquiesce(volumeID string) error
 }
 
+// EncryptionDriver interface provides key management for encrypted volumes
+type EncryptionDriver interface {
+	// RotateKey re-wraps the data encryption key of the specified volume with
+	// a new key encryption key, re-encrypting the volume data only where the
+	// driver requires it.
+	// Errors ErrEnoEnt, ErrEinval may be returned.
+	RotateKey(volumeID string) error
+}
+
+// WipeDriver interface provides secure wiping of volume storage
+type WipeDriver interface {
+	// Wipe destroys the data on the backing storage of the specified volume
+	// using method, one of the api.SecureDelete* values. The volume itself
+	// is not deleted.
+	// Errors ErrEnoEnt, ErrEinval may be returned.
+	Wipe(volumeID string, method string) (*api.WipeCertificate, error)
+}
+
+// ResizeDriver interface provides growing volumes in place
+type ResizeDriver interface {
+	// Resize grows the specified volume to newSize bytes, and its filesystem
+	// online if the volume is mounted. Volumes do not shrink.
+	// Errors ErrEnoEnt, ErrEinval may be returned.
+	Resize(volumeID string, newSize uint64) error
+}
+
+// PoolDriver interface provides storage pool management
+type PoolDriver interface {
+	// PoolExpand adds a device to the storage pool of this node with the
+	// given id and returns the pool with its new capacity.
+	// Errors ErrEnoEnt, ErrEinval may be returned.
+	PoolExpand(poolID int32, request *api.PoolExpandRequest) (*api.StoragePool, error)
+}
+
 // CloudBackupDriver interface provides Cloud backup features
 type CloudBackupDriver interface {
 	// CloudBackupCreate uploads snapshot of a volume to the cloud
@@ -185,11 +232,16 @@ type CloudMigrateDriver interface {
 // most basic functionality, such as creating and deleting volumes.
 type ProtoDriver interface {
 	SnapshotDriver
+	CloneDriver
 	StatsDriver
 	QuiesceDriver
 	CredsDriver
 	CloudBackupDriver
 	CloudMigrateDriver
+	EncryptionDriver
+	WipeDriver
+	ResizeDriver
+	PoolDriver
 	// Name returns the name of the driver.
 	Name() string
 	// Type of this driver
@@ -280,11 +332,22 @@ type VolumeDriverRegistry interface {
 	// If a VolumeDriver was already created for the given name, the error ErrExist is returned.
 	Register(name string, params map[string]string) error
 
+	// RegisterAll creates the VolumeDrivers of drivers, by name, as
+	// configured by config: concurrently, each within a timeout, and the lazy
+	// ones on their first Get. It returns the errors of the drivers which
+	// failed to be created, by name.
+	RegisterAll(drivers map[string]map[string]string, config *InitConfig) map[string]error
+
 	// Add inserts a new VolumeDriver provider with a well known name.
 	Add(name string, init func(map[string]string) (VolumeDriver, error)) error
 
 	// Removes driver from registry. Does nothing if driver name does not exist.
 	Remove(name string)
+
+	// Wrap replaces the VolumeDriver created for the given name with the one
+	// returned by wrap, to add behavior to every user of the driver.
+	// If a VolumeDriver was not created for the given name, the error ErrDriverNotFound is returned.
+	Wrap(name string, wrap func(VolumeDriver) VolumeDriver) error
 }
 
 // NewVolumeDriverRegistry constructs a new VolumeDriverRegistry.
@@ -1,18 +1,56 @@
 package volume
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
+
+// InitConfig configures how the volume drivers are created at start.
+type InitConfig struct {
+	// Parallel creates the drivers concurrently rather than one after the
+	// other
+	Parallel bool `yaml:"parallel"`
+	// Timeout bounds the creation of every driver, unbounded if unset
+	Timeout time.Duration `yaml:"timeout"`
+	// Lazy are the drivers created on first use rather than at start, such
+	// as the cloud drivers whose APIs may be slow to answer
+	Lazy []string `yaml:"lazy"`
+}
+
+// IsLazy returns true if the driver name is created on first use.
+func (c *InitConfig) IsLazy(name string) bool {
+	for _, lazy := range c.Lazy {
+		if lazy == name {
+			return true
+		}
+	}
+	return false
+}
 
 type volumeDriverRegistry struct {
 	nameToInitFunc     map[string]func(map[string]string) (VolumeDriver, error)
 	nameToVolumeDriver map[string]VolumeDriver
+	nameToLazyDriver   map[string]*lazyDriver
 	lock               *sync.RWMutex
 	isShutdown         bool
 }
 
+// lazyDriver is a driver registered to be created on its first Get.
+type lazyDriver struct {
+	initFunc func(map[string]string) (VolumeDriver, error)
+	params   map[string]string
+	timeout  time.Duration
+	// wraps are the wrappers of the driver, applied in order once created
+	wraps []func(VolumeDriver) VolumeDriver
+	// lock serializes the creations of the driver
+	lock sync.Mutex
+}
+
 func newVolumeDriverRegistry(nameToInitFunc map[string]func(map[string]string) (VolumeDriver, error)) *volumeDriverRegistry {
 	return &volumeDriverRegistry{
 		nameToInitFunc,
 		make(map[string]VolumeDriver),
+		make(map[string]*lazyDriver),
 		&sync.RWMutex{},
 		false,
 	}
@@ -20,14 +58,56 @@ func newVolumeDriverRegistry(nameToInitFunc map[string]func(map[string]string) (
 
 func (v *volumeDriverRegistry) Get(name string) (VolumeDriver, error) {
 	v.lock.RLock()
-	defer v.lock.RUnlock()
 	if v.isShutdown {
+		v.lock.RUnlock()
 		return nil, ErrAlreadyShutdown
 	}
 	volumeDriver, ok := v.nameToVolumeDriver[name]
-	if !ok {
+	lazy := v.nameToLazyDriver[name]
+	v.lock.RUnlock()
+	if ok {
+		return volumeDriver, nil
+	}
+	if lazy == nil {
+		return nil, ErrDriverNotFound
+	}
+	return v.initLazy(name, lazy)
+}
+
+// initLazy creates the lazy driver name, unless a concurrent Get did, and
+// registers it wrapped. The creation is retried by the next Get if it fails.
+func (v *volumeDriverRegistry) initLazy(name string, lazy *lazyDriver) (VolumeDriver, error) {
+	lazy.lock.Lock()
+	defer lazy.lock.Unlock()
+
+	v.lock.RLock()
+	volumeDriver, ok := v.nameToVolumeDriver[name]
+	v.lock.RUnlock()
+	if ok {
+		return volumeDriver, nil
+	}
+
+	volumeDriver, err := initWithTimeout(lazy.initFunc, lazy.params, lazy.timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if v.isShutdown {
+		volumeDriver.Shutdown()
+		return nil, ErrAlreadyShutdown
+	}
+	if v.nameToLazyDriver[name] != lazy {
+		// removed while created
+		volumeDriver.Shutdown()
 		return nil, ErrDriverNotFound
 	}
+	for _, wrap := range lazy.wraps {
+		volumeDriver = wrap(volumeDriver)
+	}
+	v.nameToVolumeDriver[name] = volumeDriver
+	delete(v.nameToLazyDriver, name)
 	return volumeDriver, nil
 }
 
@@ -46,26 +126,171 @@ func (v *volumeDriverRegistry) Remove(name string) {
 
 	delete(v.nameToInitFunc, name)
 	delete(v.nameToVolumeDriver, name)
+	delete(v.nameToLazyDriver, name)
 }
 
 func (v *volumeDriverRegistry) Register(name string, params map[string]string) error {
-	initFunc, ok := v.nameToInitFunc[name]
-	if !ok {
-		return ErrNotSupported
+	return v.register(name, params, 0)
+}
+
+// register creates the driver name within timeout, unbounded if zero. The
+// registry is not locked while the driver is created, so that drivers are
+// created concurrently.
+func (v *volumeDriverRegistry) register(name string, params map[string]string, timeout time.Duration) error {
+	initFunc, err := v.initFunc(name)
+	if err != nil {
+		return err
+	}
+	volumeDriver, err := initWithTimeout(initFunc, params, timeout)
+	if err != nil {
+		return err
 	}
 	v.lock.Lock()
 	defer v.lock.Unlock()
+	if err := v.checkRegister(name); err != nil {
+		volumeDriver.Shutdown()
+		return err
+	}
+	v.nameToVolumeDriver[name] = volumeDriver
+	return nil
+}
+
+// registerLazy registers the driver name to be created on its first Get.
+func (v *volumeDriverRegistry) registerLazy(name string, params map[string]string, timeout time.Duration) error {
+	initFunc, err := v.initFunc(name)
+	if err != nil {
+		return err
+	}
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if err := v.checkRegister(name); err != nil {
+		return err
+	}
+	v.nameToLazyDriver[name] = &lazyDriver{
+		initFunc: initFunc,
+		params:   params,
+		timeout:  timeout,
+	}
+	return nil
+}
+
+// initFunc returns the function creating the driver name, after checking it
+// can be registered.
+func (v *volumeDriverRegistry) initFunc(name string) (func(map[string]string) (VolumeDriver, error), error) {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	initFunc, ok := v.nameToInitFunc[name]
+	if !ok {
+		return nil, ErrNotSupported
+	}
+	if err := v.checkRegister(name); err != nil {
+		return nil, err
+	}
+	return initFunc, nil
+}
+
+// checkRegister returns an error if the driver name cannot be registered. The
+// registry must be locked.
+func (v *volumeDriverRegistry) checkRegister(name string) error {
 	if v.isShutdown {
 		return ErrAlreadyShutdown
 	}
 	if _, ok := v.nameToVolumeDriver[name]; ok {
 		return ErrExist
 	}
-	volumeDriver, err := initFunc(params)
-	if err != nil {
-		return err
+	if _, ok := v.nameToLazyDriver[name]; ok {
+		return ErrExist
 	}
-	v.nameToVolumeDriver[name] = volumeDriver
+	return nil
+}
+
+func (v *volumeDriverRegistry) RegisterAll(
+	drivers map[string]map[string]string,
+	config *InitConfig,
+) map[string]error {
+	var (
+		lock sync.Mutex
+		wg   sync.WaitGroup
+	)
+	errs := make(map[string]error)
+	register := func(name string, params map[string]string) {
+		var err error
+		if config.IsLazy(name) {
+			err = v.registerLazy(name, params, config.Timeout)
+		} else {
+			err = v.register(name, params, config.Timeout)
+		}
+		if err != nil {
+			lock.Lock()
+			errs[name] = err
+			lock.Unlock()
+		}
+	}
+	for name, params := range drivers {
+		if !config.Parallel {
+			register(name, params)
+			continue
+		}
+		wg.Add(1)
+		go func(name string, params map[string]string) {
+			defer wg.Done()
+			register(name, params)
+		}(name, params)
+	}
+	wg.Wait()
+	return errs
+}
+
+// initWithTimeout creates a driver with initFunc, returning
+// ErrDriverInitTimeout if it takes longer than timeout, unbounded if zero.
+// A driver created after its timeout is shut down.
+func initWithTimeout(
+	initFunc func(map[string]string) (VolumeDriver, error),
+	params map[string]string,
+	timeout time.Duration,
+) (VolumeDriver, error) {
+	if timeout <= 0 {
+		return initFunc(params)
+	}
+	type result struct {
+		volumeDriver VolumeDriver
+		err          error
+	}
+	done := make(chan result, 1)
+	go func() {
+		volumeDriver, err := initFunc(params)
+		done <- result{volumeDriver, err}
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		return r.volumeDriver, r.err
+	case <-timer.C:
+		go func() {
+			if r := <-done; r.err == nil {
+				r.volumeDriver.Shutdown()
+			}
+		}()
+		return nil, ErrDriverInitTimeout
+	}
+}
+
+func (v *volumeDriverRegistry) Wrap(name string, wrap func(VolumeDriver) VolumeDriver) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if v.isShutdown {
+		return ErrAlreadyShutdown
+	}
+	if lazy, ok := v.nameToLazyDriver[name]; ok {
+		lazy.wraps = append(lazy.wraps, wrap)
+		return nil
+	}
+	volumeDriver, ok := v.nameToVolumeDriver[name]
+	if !ok {
+		return ErrDriverNotFound
+	}
+	v.nameToVolumeDriver[name] = wrap(volumeDriver)
 	return nil
 }
 
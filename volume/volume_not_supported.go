@@ -12,6 +12,9 @@ var (
 	// SnapshotNotSupported is a null snapshot driver implementation. This can be used
 	// by drivers that do not want to implement the snapshot interface
 	SnapshotNotSupported = &snapshotNotSupported{}
+	// CloneNotSupported is a null clone driver implementation. This can be
+	// used by drivers that do not want to implement the clone interface
+	CloneNotSupported = &cloneNotSupported{}
 	// IONotSupported is a null IODriver interface
 	IONotSupported = &ioNotSupported{}
 	// StatsNotSupported is a null stats driver implementation. This can be used
@@ -28,6 +31,18 @@ var (
 	// CloudMigrateNotSupported implements cloudMigrateDriver by returning
 	// Not supported error
 	CloudMigrateNotSupported = &cloudMigrateNotSupported{}
+	// EncryptionNotSupported implements encryptionDriver by returning
+	// Not supported error
+	EncryptionNotSupported = &encryptionNotSupported{}
+	// WipeNotSupported implements wipeDriver by returning
+	// Not supported error
+	WipeNotSupported = &wipeNotSupported{}
+	// ResizeNotSupported implements resizeDriver by returning
+	// Not supported error
+	ResizeNotSupported = &resizeNotSupported{}
+	// PoolNotSupported implements poolDriver by returning
+	// Not supported error
+	PoolNotSupported = &poolNotSupported{}
 )
 
 type blockNotSupported struct{}
@@ -54,6 +69,12 @@ func (s *snapshotNotSupported) SnapshotGroup(groupID string, labels map[string]s
 	return nil, ErrNotSupported
 }
 
+type cloneNotSupported struct{}
+
+func (c *cloneNotSupported) Clone(volumeID string, locator *api.VolumeLocator) (string, error) {
+	return "", ErrNotSupported
+}
+
 type ioNotSupported struct{}
 
 func (i *ioNotSupported) Read(volumeID string, buffer []byte, size uint64, offset int64) (int64, error) {
@@ -231,3 +252,27 @@ func (cl *cloudMigrateNotSupported) CloudMigrateCancel(request *api.CloudMigrate
 func (cl *cloudMigrateNotSupported) CloudMigrateStatus() (*api.CloudMigrateStatusResponse, error) {
 	return nil, ErrNotSupported
 }
+
+type encryptionNotSupported struct{}
+
+func (e *encryptionNotSupported) RotateKey(volumeID string) error {
+	return ErrNotSupported
+}
+
+type wipeNotSupported struct{}
+
+func (w *wipeNotSupported) Wipe(volumeID string, method string) (*api.WipeCertificate, error) {
+	return nil, ErrNotSupported
+}
+
+type resizeNotSupported struct{}
+
+func (r *resizeNotSupported) Resize(volumeID string, newSize uint64) error {
+	return ErrNotSupported
+}
+
+type poolNotSupported struct{}
+
+func (p *poolNotSupported) PoolExpand(poolID int32, request *api.PoolExpandRequest) (*api.StoragePool, error) {
+	return nil, ErrNotSupported
+}